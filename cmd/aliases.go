@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/internal/config"
+)
+
+// aliasAnnotation marks the commands that run an alias, and holds the alias's definition.
+const aliasAnnotation = "alias"
+
+// addAliasCmds adds a command for each alias in the config file. Like git, an alias can't replace a built-in command,
+// so aliases with the same name as one are left out.
+func addAliasCmds(root *cobra.Command) {
+	aliases := viper.GetStringMapString("aliases")
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if existing, _, err := root.Find([]string{name}); err == nil && existing != root {
+			continue
+		}
+		root.AddCommand(newAliasCmd(name, aliases[name]))
+	}
+}
+
+func newAliasCmd(name string, definition string) *cobra.Command {
+	return &cobra.Command{
+		Annotations: map[string]string{aliasAnnotation: definition},
+		// the alias's arguments, flags included, are passed on to the command it stands for
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			expanded, err := config.ExpandAlias(name, definition, args)
+			if err != nil {
+				return err
+			}
+			if len(expanded) > 0 {
+				if target, _, err := cmd.Root().Find(expanded[:1]); err == nil && target.Annotations[aliasAnnotation] != "" {
+					return fmt.Errorf("alias %s runs another alias, %s, which isn't supported", name, target.Name())
+				}
+			}
+
+			cmd.Root().SetArgs(expanded)
+			return cmd.Root().Execute()
+		},
+		Short: fmt.Sprintf("Alias for `ldcli %s`", definition),
+		Use:   name,
+	}
+}
+
+// aliasCmds lists the commands that run aliases, for the usage template.
+func aliasCmds(cmd *cobra.Command) []*cobra.Command {
+	var aliases []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if _, ok := c.Annotations[aliasAnnotation]; ok {
+			aliases = append(aliases, c)
+		}
+	}
+
+	return aliases
+}
@@ -0,0 +1,62 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+)
+
+func TestAliases(t *testing.T) {
+	useAliases := func(t *testing.T) {
+		viper.Set("aliases", map[string]string{
+			"override": "dev-server add-override --project $1 --flag $2 --data true --port 8765 --dry-run",
+			"flags":    "dev-server list-projects",
+			"again":    "override proj flag",
+		})
+		t.Cleanup(viper.Reset)
+	}
+
+	t.Run("runs the command an alias stands for", func(t *testing.T) {
+		useAliases(t)
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"override", "proj", "new-checkout", "--access-token", "abcd1234"},
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "PUT http://localhost:8765/dev/projects/proj/overrides/new-checkout")
+	})
+
+	t.Run("with too few arguments is an error", func(t *testing.T) {
+		useAliases(t)
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), []string{"override", "proj"})
+
+		assert.EqualError(t, err, "alias override needs at least 2 arguments")
+	})
+
+	t.Run("running another alias is an error", func(t *testing.T) {
+		useAliases(t)
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), []string{"again"})
+
+		assert.EqualError(t, err, "alias again runs another alias, override, which isn't supported")
+	})
+
+	t.Run("doesn't replace built-in commands", func(t *testing.T) {
+		useAliases(t)
+
+		output, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), []string{"flags", "--help"})
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "ldcli flags [command]")
+	})
+}
@@ -8,31 +8,47 @@ const (
 	AccessTokenFlag  = "access-token"
 	AnalyticsOptOut  = "analytics-opt-out"
 	BaseURIFlag      = "base-uri"
+	ColumnsFlag      = "columns"
 	CorsEnabledFlag  = "cors-enabled"
 	CorsOriginFlag   = "cors-origin"
 	DataFlag         = "data"
 	DevStreamURIFlag = "dev-stream-uri"
+	DryRunFlag       = "dry-run"
 	EmailsFlag       = "emails"
 	EnvironmentFlag  = "environment"
 	FlagFlag         = "flag"
+	NoKeychainFlag   = "no-keychain"
 	OutputFlag       = "output"
 	PortFlag         = "port"
+	ProfileFlag      = "profile"
 	ProjectFlag      = "project"
+	ProxyFlag        = "proxy"
+	QueryFlag        = "query"
 	RoleFlag         = "role"
+	SecureModeFlag   = "secure-mode-secret"
 	SyncOnceFlag     = "sync-once"
+	TemplateFlag     = "template"
 
 	AccessTokenFlagDescription = "LaunchDarkly access token with write-level access"
 	AnalyticsOptOutDescription = "Opt out of analytics tracking"
 	BaseURIFlagDescription     = "LaunchDarkly base URI"
+	ColumnsFlagDescription     = "Comma separated fields to write as columns with --output csv, with dots between nested field names, e.g. key,name,_maintainer.email. Defaults to the fields that aren't objects or arrays"
 	CorsEnabledFlagDescription = "Enable CORS headers for browser-based developer tools (default: false)"
 	CorsOriginFlagDescription  = "Allowed CORS origin. Use '*' for all origins (default: '*')"
-	DevStreamURIDescription    = "Streaming service endpoint that the dev server uses to obtain authoritative flag data. This may be a LaunchDarkly or Relay Proxy endpoint"
+	DevStreamURIDescription    = "Streaming service endpoint that the dev server uses to obtain authoritative flag data. This may be a LaunchDarkly or Relay Proxy endpoint. Defaults to match --base-uri for the EU and federal instances"
+	DryRunFlagDescription      = "Print the requests that commands which make changes would send, with the access token redacted, instead of sending them"
 	EnvironmentFlagDescription = "Default environment key"
 	FlagFlagDescription        = "Default feature flag key"
-	OutputFlagDescription      = "Command response output format in either JSON or plain text"
+	NoKeychainFlagDescription  = "Store and read access tokens in the config file instead of the OS keychain"
+	OutputFlagDescription      = "Command response output format in JSON, YAML, plain text, or CSV for list commands"
 	PortFlagDescription        = "Port for the dev server to run on"
+	ProfileFlagDescription     = "Profile in the config file whose settings to use instead of the top-level ones. Defaults to the LDCLI_PROFILE environment variable"
 	ProjectFlagDescription     = "Default project key"
+	ProxyFlagDescription       = "HTTP(S) proxy URL for connections to LaunchDarkly. Defaults to the HTTPS_PROXY environment variable. Hosts in NO_PROXY are always connected to directly"
+	QueryFlagDescription       = "JMESPath expression to apply to the command's JSON output before printing it, e.g. \"items[?contains(tags, 'beta')].key\""
+	SecureModeFlagDescription  = "Secret used to validate secure mode hashes sent by client-side SDKs. Use the same secret your backend uses to generate hashes"
 	SyncOnceFlagDescription    = "Only sync new projects. Existing projects will neither be resynced nor have overrides specified by CLI flags applied."
+	TemplateFlagDescription    = "Execute template functions in the context JSON to generate values, e.g. {\"key\": \"{{uuid}}\", \"created\": \"{{now}}\", \"age\": {{randInt 18 99}}}"
 )
 
 func AllFlagsHelp() map[string]string {
@@ -48,6 +64,7 @@ func AllFlagsHelp() map[string]string {
 		OutputFlag:       OutputFlagDescription,
 		PortFlag:         PortFlagDescription,
 		ProjectFlag:      ProjectFlagDescription,
+		ProxyFlag:        ProxyFlagDescription,
 		SyncOnceFlag:     SyncOnceFlagDescription,
 	}
 }
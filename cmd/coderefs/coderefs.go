@@ -0,0 +1,23 @@
+package coderefs
+
+import (
+	"github.com/spf13/cobra"
+
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewCoderefsCmd(client resources.Client, analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "coderefs",
+		Short: "Find references to flags in code",
+		Long:  "Find references to feature flags in your code, to see which flags can be cleaned up",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(NewScanCmd(client, analyticsTrackerFn))
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	return cmd
+}
@@ -0,0 +1,365 @@
+package coderefs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cmdAnalytics "github.com/launchdarkly/ldcli/cmd/analytics"
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/coderefs"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+const (
+	branchFlag     = "branch"
+	delimitersFlag = "delimiters"
+	dirFlag        = "dir"
+	excludeFlag    = "exclude"
+	extensionsFlag = "extensions"
+	patternFlag    = "pattern"
+	pushFlag       = "push"
+	repoNameFlag   = "repo-name"
+
+	// flagsPageSize is how many flags are listed per request.
+	flagsPageSize = 100
+)
+
+type flagReferences struct {
+	Key        string               `json:"key"`
+	References []coderefs.Reference `json:"references"`
+}
+
+type scanResult struct {
+	Items        []flagReferences `json:"items"`
+	Unreferenced []string         `json:"unreferenced"`
+	TotalCount   int              `json:"totalCount"`
+}
+
+func NewScanCmd(client resources.Client, analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  validators.Validate(),
+		Use:   "scan",
+		Short: "Scan code for flag references",
+		Long: fmt.Sprintf(`Scan the files in a directory for references to the project's feature flags, and list the flags that are referenced and the ones that aren't.
+
+A flag key between quotes or backticks is a reference. Add patterns for references that aren't, with the flag key as the first capture group. Options can also be kept in the repository, in %s:
+
+  extensions: [go, ts, tsx]
+  exclude: ["testdata/*"]
+  patterns: ["flags\\.Is\\(\\w+, (\\w+)\\)"]
+
+With --%s, the references are sent to LaunchDarkly, to show on each flag's code references tab.`, coderefs.ConfigFile, pushFlag),
+		RunE: runScan(client),
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			tracker := analyticsTrackerFn(
+				viper.GetString(cliflags.AccessTokenFlag),
+				viper.GetString(cliflags.BaseURIFlag),
+				viper.GetBool(cliflags.AnalyticsOptOut),
+			)
+			tracker.SendCommandRunEvent(cmdAnalytics.CmdRunEventProperties(
+				cmd,
+				"coderefs",
+				map[string]interface{}{
+					"action": cmd.Name(),
+				}))
+		},
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	initFlags(cmd)
+
+	return cmd
+}
+
+func runScan(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		projectKey := viper.GetString(cliflags.ProjectFlag)
+		dir := viper.GetString(dirFlag)
+		if viper.GetBool(pushFlag) && viper.GetString(repoNameFlag) == "" {
+			return newScanErr(fmt.Sprintf("--%s is required with --%s", repoNameFlag, pushFlag))
+		}
+
+		flagKeys, err := listFlagKeys(client, projectKey)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		options, err := coderefs.ReadOptions(dir)
+		if err != nil {
+			return newScanErr(err.Error())
+		}
+		options = options.Merge(coderefs.Options{
+			FlagKeys:   flagKeys,
+			Delimiters: viper.GetString(delimitersFlag),
+			Patterns:   viper.GetStringSlice(patternFlag),
+			Extensions: viper.GetStringSlice(extensionsFlag),
+			Exclude:    viper.GetStringSlice(excludeFlag),
+		})
+		refs, err := coderefs.Scan(dir, options)
+		if err != nil {
+			return newScanErr(err.Error())
+		}
+
+		var pushed bool
+		if viper.GetBool(pushFlag) {
+			pushed, err = push(cmd, client, projectKey, dir, refs)
+			if err != nil {
+				return err
+			}
+		}
+
+		result := newScanResult(flagKeys, refs)
+		if output.IsStructured(viper.GetString(cliflags.OutputFlag)) || viper.GetString(cliflags.QueryFlag) != "" {
+			res, _ := json.Marshal(result)
+			out, err := resourcescmd.CmdOutput("list", res)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), out)
+
+			return nil
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), plaintextScanResult(result))
+		if pushed {
+			fmt.Fprintf(cmd.OutOrStdout(), "Pushed the references to the %s repository in LaunchDarkly\n", viper.GetString(repoNameFlag))
+		}
+
+		return nil
+	}
+}
+
+// listFlagKeys lists the keys of all the flags in a project, a page at a time.
+func listFlagKeys(client resources.Client, projectKey string) ([]string, error) {
+	path, _ := url.JoinPath(
+		viper.GetString(cliflags.BaseURIFlag),
+		"api/v2/flags",
+		projectKey,
+	)
+
+	var keys []string
+	for {
+		query := url.Values{
+			"limit":   []string{strconv.Itoa(flagsPageSize)},
+			"offset":  []string{strconv.Itoa(len(keys))},
+			"summary": []string{"true"},
+		}
+		res, err := client.MakeRequest(
+			viper.GetString(cliflags.AccessTokenFlag),
+			"GET",
+			path,
+			"application/json",
+			query,
+			nil,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Items []struct {
+				Key string `json:"key"`
+			} `json:"items"`
+			TotalCount int `json:"totalCount"`
+		}
+		if err := json.Unmarshal(res, &page); err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			keys = append(keys, item.Key)
+		}
+		if len(page.Items) == 0 || len(keys) >= page.TotalCount {
+			return keys, nil
+		}
+	}
+}
+
+// push sends the references to LaunchDarkly as the current branch of the repository, which is created if it doesn't
+// exist yet. sent is false for dry runs.
+func push(
+	cmd *cobra.Command,
+	client resources.Client,
+	projectKey string,
+	dir string,
+	refs map[string][]coderefs.Reference,
+) (sent bool, err error) {
+	repoName := viper.GetString(repoNameFlag)
+	branchName := viper.GetString(branchFlag)
+	if branchName == "" {
+		branchName, err = git(dir, "rev-parse", "--abbrev-ref", "HEAD")
+		if err != nil {
+			return false, newScanErr(fmt.Sprintf("unable to find the git branch of %s, use --%s to set it", dir, branchFlag))
+		}
+	}
+	head, err := git(dir, "rev-parse", "HEAD")
+	if err != nil {
+		return false, newScanErr(fmt.Sprintf("unable to find the git commit of %s: %s", dir, err))
+	}
+
+	reposPath, _ := url.JoinPath(viper.GetString(cliflags.BaseURIFlag), "api/v2/code-refs/repositories")
+	repoPath, _ := url.JoinPath(reposPath, repoName)
+	_, err = client.MakeRequest(
+		viper.GetString(cliflags.AccessTokenFlag),
+		"GET",
+		repoPath,
+		"application/json",
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		if !errs.IsNotFound(err) {
+			return false, output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		data, _ := json.Marshal(map[string]string{
+			"name":          repoName,
+			"type":          "custom",
+			"defaultBranch": branchName,
+		})
+		_, sent, err = resourcescmd.MakeRequest(
+			cmd,
+			client,
+			viper.GetString(cliflags.AccessTokenFlag),
+			"POST",
+			reposPath,
+			"application/json",
+			nil,
+			data,
+			false,
+		)
+		if err != nil {
+			return false, output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+	}
+
+	branchPath, _ := url.JoinPath(repoPath, "branches", url.PathEscape(branchName))
+	data, _ := json.Marshal(coderefs.NewBranch(branchName, head, projectKey, refs, time.Now()))
+	_, sent, err = resourcescmd.MakeRequest(
+		cmd,
+		client,
+		viper.GetString(cliflags.AccessTokenFlag),
+		"PUT",
+		branchPath,
+		"application/json",
+		nil,
+		data,
+		false,
+	)
+	if err != nil {
+		return false, output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+	}
+
+	return sent, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func newScanResult(flagKeys []string, refs map[string][]coderefs.Reference) scanResult {
+	result := scanResult{
+		Items:        make([]flagReferences, 0, len(refs)),
+		Unreferenced: coderefs.Unreferenced(flagKeys, refs),
+	}
+	for key, flagRefs := range refs {
+		result.Items = append(result.Items, flagReferences{Key: key, References: flagRefs})
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Key < result.Items[j].Key
+	})
+	result.TotalCount = len(result.Items)
+
+	return result
+}
+
+func plaintextScanResult(result scanResult) string {
+	var sb strings.Builder
+	if len(result.Items) == 0 {
+		sb.WriteString("No flags are referenced in code\n")
+	}
+	for _, item := range result.Items {
+		fmt.Fprintf(&sb, "* %s (%d %s)\n", item.Key, len(item.References), plural(len(item.References), "reference"))
+		for _, ref := range item.References {
+			fmt.Fprintf(&sb, "    %s:%d\n", ref.Path, ref.Line)
+		}
+	}
+	if len(result.Unreferenced) > 0 {
+		fmt.Fprintf(&sb, "\n%d %s not referenced in code:\n", len(result.Unreferenced), plural(len(result.Unreferenced), "flag is", "flags are"))
+		for _, key := range result.Unreferenced {
+			fmt.Fprintf(&sb, "* %s\n", key)
+		}
+	}
+
+	return sb.String()
+}
+
+func plural(n int, forms ...string) string {
+	if n == 1 {
+		return forms[0]
+	}
+	if len(forms) > 1 {
+		return forms[1]
+	}
+
+	return forms[0] + "s"
+}
+
+func newScanErr(message string) error {
+	return output.NewCmdOutputError(errors.New(message), viper.GetString(cliflags.OutputFlag))
+}
+
+func initFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(dirFlag, ".", "The directory to scan")
+	_ = viper.BindPFlag(dirFlag, cmd.Flags().Lookup(dirFlag))
+
+	cmd.Flags().StringSlice(extensionsFlag, nil, "Only scan files with these extensions, such as go,ts,tsx")
+	_ = viper.BindPFlag(extensionsFlag, cmd.Flags().Lookup(extensionsFlag))
+
+	cmd.Flags().StringSlice(excludeFlag, nil, "Glob patterns for paths to skip, such as testdata/*")
+	_ = viper.BindPFlag(excludeFlag, cmd.Flags().Lookup(excludeFlag))
+
+	cmd.Flags().StringArray(patternFlag, nil, "A regular expression for references, with the flag key as the first capture group. Can be repeated")
+	_ = viper.BindPFlag(patternFlag, cmd.Flags().Lookup(patternFlag))
+
+	cmd.Flags().String(delimitersFlag, "", "The characters a flag key has to be between to be a reference (default quotes and backticks)")
+	_ = viper.BindPFlag(delimitersFlag, cmd.Flags().Lookup(delimitersFlag))
+
+	cmd.Flags().Bool(pushFlag, false, "Send the references to LaunchDarkly")
+	_ = viper.BindPFlag(pushFlag, cmd.Flags().Lookup(pushFlag))
+
+	cmd.Flags().String(repoNameFlag, "", "The name of the repository in LaunchDarkly, required with --push")
+	_ = viper.BindPFlag(repoNameFlag, cmd.Flags().Lookup(repoNameFlag))
+
+	cmd.Flags().String(branchFlag, "", "The branch the references are on (default the current git branch)")
+	_ = viper.BindPFlag(branchFlag, cmd.Flags().Lookup(branchFlag))
+}
@@ -0,0 +1,148 @@
+package coderefs_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "main.go"),
+		[]byte("package main\n\nvar on = client.BoolVariation(\"new-checkout\", ctx, false)\n"),
+		0644,
+	))
+	mockClient := &resources.MockClient{
+		Response: []byte(`{
+			"items": [{"key": "new-checkout"}, {"key": "old-banner"}],
+			"totalCount": 2
+		}`),
+	}
+	args := []string{
+		"coderefs", "scan",
+		"--access-token", "abcd1234",
+		"--project", "test-proj",
+		"--dir", dir,
+	}
+
+	t.Run("lists the flags that are referenced and the ones that aren't", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `* new-checkout (1 reference)
+    main.go:3
+
+1 flag is not referenced in code:
+* old-banner
+`, string(output))
+	})
+
+	t.Run("with JSON output", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--output", "json"),
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"items": [
+				{
+					"key": "new-checkout",
+					"references": [{"path": "main.go", "line": 3, "content": "var on = client.BoolVariation(\"new-checkout\", ctx, false)"}]
+				}
+			],
+			"unreferenced": ["old-banner"],
+			"totalCount": 1
+		}`, string(output))
+	})
+
+	t.Run("with --push and without --repo-name", func(t *testing.T) {
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--push"),
+		)
+
+		assert.EqualError(t, err, "--repo-name is required with --push")
+	})
+
+	t.Run("with --push outside a git repository", func(t *testing.T) {
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: mockClient,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--push", "--repo-name", "my-repo", "--branch", "main"),
+		)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to find the git commit")
+	})
+}
+
+func TestScanPushDryRun(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(`variation("new-checkout")`+"\n"), 0644))
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch", "main"},
+		{"add", "main.go"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--message", "test"},
+	} {
+		require.NoError(t, exec.Command("git", append([]string{"-C", dir}, args...)...).Run())
+	}
+	mockClient := &resources.MockClient{
+		Response: []byte(`{"items": [{"key": "new-checkout"}], "totalCount": 1}`),
+	}
+	args := []string{
+		"coderefs", "scan",
+		"--access-token", "abcd1234",
+		"--project", "test-proj",
+		"--dir", dir,
+		"--push",
+		"--repo-name", "my-repo",
+		"--dry-run",
+	}
+
+	output, err := cmd.CallCmd(
+		t,
+		cmd.APIClients{
+			ResourcesClient: mockClient,
+		},
+		analytics.NoopClientFn{}.Tracker(),
+		args,
+	)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "Dry run, this request was not sent:\nPUT https://app.launchdarkly.com/api/v2/code-refs/repositories/my-repo/branches/main")
+	assert.Contains(t, string(output), `"path": "main.go"`)
+	assert.Contains(t, string(output), `"flagKey": "new-checkout"`)
+	assert.Contains(t, string(output), "* new-checkout (1 reference)\n    main.go:1\n")
+	assert.NotContains(t, string(output), "Pushed the references")
+}
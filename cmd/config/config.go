@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strings"
@@ -21,9 +22,10 @@ import (
 )
 
 const (
-	ListFlag  = "list"
-	SetFlag   = "set"
-	UnsetFlag = "unset"
+	ListFlag              = "list"
+	MigrateToKeychainFlag = "migrate-to-keychain"
+	SetFlag               = "set"
+	UnsetFlag             = "unset"
 )
 
 type ConfigCmd struct {
@@ -41,7 +43,7 @@ func (cmd ConfigCmd) HelpCalled() bool {
 
 func NewConfigCmd(service config.Service, analyticsTrackerFn analytics.TrackerFn) *ConfigCmd {
 	cmd := &cobra.Command{
-		Long:  "View and modify specific configuration values",
+		Long:  "View and modify specific configuration values. Use --profile to view and modify a named profile's values instead, and set alias.<name> to define a shortcut for a command",
 		RunE:  run(service),
 		Short: "View and modify specific configuration values",
 		Use:   "config",
@@ -86,6 +88,8 @@ func NewConfigCmd(service config.Service, analyticsTrackerFn analytics.TrackerFn
 
 	cmd.Flags().Bool(ListFlag, false, "List configs")
 	_ = viper.BindPFlag(ListFlag, cmd.Flags().Lookup(ListFlag))
+	cmd.Flags().Bool(MigrateToKeychainFlag, false, "Move access tokens from the config file to the OS keychain")
+	_ = viper.BindPFlag(MigrateToKeychainFlag, cmd.Flags().Lookup(MigrateToKeychainFlag))
 	cmd.Flags().Bool(SetFlag, false, "Set a config field to a value")
 	_ = viper.BindPFlag(SetFlag, cmd.Flags().Lookup(SetFlag))
 	cmd.Flags().String(UnsetFlag, "", "Unset a config field")
@@ -103,7 +107,15 @@ func run(service config.Service) func(*cobra.Command, []string) error {
 				return newErr(err.Error())
 			}
 
-			configJSON, err := json.Marshal(conf)
+			var listed interface{} = conf
+			if profile := viper.GetString(cliflags.ProfileFlag); profile != "" {
+				listed, err = conf.Profile(profile)
+				if err != nil {
+					return newErr(err.Error())
+				}
+			}
+
+			configJSON, err := json.Marshal(listed)
 			if err != nil {
 				return newErr(err.Error())
 			}
@@ -123,18 +135,33 @@ func run(service config.Service) func(*cobra.Command, []string) error {
 			if err != nil {
 				return newErr(err.Error())
 			}
-			conf, updatedFields, err := conf.Update(args)
-			if err != nil {
-				return newErr(err.Error())
+			var updatedFields []string
+			accessToken, baseURI := "", viper.GetString(cliflags.BaseURIFlag)
+			if profile := viper.GetString(cliflags.ProfileFlag); profile != "" {
+				conf, updatedFields, err = conf.UpdateProfile(profile, args)
+				if err != nil {
+					return newErr(err.Error())
+				}
+				accessToken = conf.Profiles[profile].AccessToken
+				if conf.Profiles[profile].BaseURI != "" && !cmd.Flags().Changed(cliflags.BaseURIFlag) {
+					baseURI = conf.Profiles[profile].BaseURI
+				}
+			} else {
+				conf, updatedFields, err = conf.Update(args)
+				if err != nil {
+					return newErr(err.Error())
+				}
+				accessToken = conf.AccessToken
 			}
 			if isUpdatingAccessToken(updatedFields) {
-				if !service.VerifyAccessToken(conf.AccessToken, viper.GetString(cliflags.BaseURIFlag)) {
+				if !service.VerifyAccessToken(accessToken, baseURI) {
 					errorMessage := fmt.Sprintf("%s is invalid. ", cliflags.AccessTokenFlag)
-					errorMessage += errs.AccessTokenInvalidErrMessage(viper.GetString(cliflags.BaseURIFlag))
+					errorMessage += errs.AccessTokenInvalidErrMessage(baseURI)
 					err := errors.New(errorMessage)
 
 					return newErr(err.Error())
 				}
+				conf, _ = moveAccessTokenToKeychain(conf, cmd.ErrOrStderr())
 			}
 			err = Write(conf, SetKey)
 			if err != nil {
@@ -152,11 +179,29 @@ func run(service config.Service) func(*cobra.Command, []string) error {
 			if err != nil {
 				return newErr(err.Error())
 			}
-			conf, err = conf.Remove(viper.GetString(UnsetFlag))
+			if viper.GetString(UnsetFlag) == cliflags.AccessTokenFlag && !viper.GetBool(cliflags.NoKeychainFlag) {
+				err = config.DeleteKeychainAccessToken(viper.GetString(cliflags.ProfileFlag))
+				if err != nil {
+					// without a keychain, the only access token to remove is the one in the config file
+					fmt.Fprintf(cmd.ErrOrStderr(), "Warning: unable to remove the access token from the OS keychain: %s\n", err)
+				}
+			}
+			filter := UnsetKey
+			if profile := viper.GetString(cliflags.ProfileFlag); profile != "" {
+				// the key is removed from the profile here, so the rest of the config is written as it is
+				conf, err = conf.RemoveFromProfile(profile, viper.GetString(UnsetFlag))
+				filter = SetKey
+			} else {
+				conf, err = conf.Remove(viper.GetString(UnsetFlag))
+				if strings.HasPrefix(viper.GetString(UnsetFlag), config.AliasPrefix) {
+					// the alias is removed here, so the rest of the config is written as it is
+					filter = SetKey
+				}
+			}
 			if err != nil {
 				return newErr(err.Error())
 			}
-			err = Write(conf, UnsetKey)
+			err = Write(conf, filter)
 			if err != nil {
 				return newErr(err.Error())
 			}
@@ -166,6 +211,34 @@ func run(service config.Service) func(*cobra.Command, []string) error {
 				return newErr(err.Error())
 			}
 
+			fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+		case viper.GetBool(MigrateToKeychainFlag):
+			conf, err := config.New(viper.ConfigFileUsed(), os.ReadFile)
+			if err != nil {
+				return newErr(err.Error())
+			}
+			conf, profiles, err := conf.MigrateToKeychain()
+			if err != nil {
+				return newErr(err.Error())
+			}
+			err = Write(conf, SetKey)
+			if err != nil {
+				return newErr(err.Error())
+			}
+
+			movedFields := make([]string, 0, len(profiles))
+			for _, profile := range profiles {
+				if profile == "" {
+					movedFields = append(movedFields, cliflags.AccessTokenFlag)
+				} else {
+					movedFields = append(movedFields, fmt.Sprintf("profiles.%s.%s", profile, cliflags.AccessTokenFlag))
+				}
+			}
+			output, err := outputSetAction(movedFields)
+			if err != nil {
+				return newErr(err.Error())
+			}
+
 			fmt.Fprint(cmd.OutOrStdout(), output+"\n")
 		default:
 			return cmd.Help()
@@ -175,6 +248,52 @@ func run(service config.Service) func(*cobra.Command, []string) error {
 	}
 }
 
+// Save sets config fields to values in the config file, in the profile selected with --profile if
+// there is one. An access token is stored in the OS keychain instead, unless --no-keychain is given
+// or there's no keychain. It returns the updated fields, and whether an access token was stored in
+// the keychain.
+func Save(kvs []string) ([]string, bool, error) {
+	conf, err := config.New(viper.ConfigFileUsed(), os.ReadFile)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var updatedFields []string
+	profile := viper.GetString(cliflags.ProfileFlag)
+	if profile != "" {
+		conf, updatedFields, err = conf.UpdateProfile(profile, kvs)
+	} else {
+		conf, updatedFields, err = conf.Update(kvs)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	inKeychain := false
+	if isUpdatingAccessToken(updatedFields) {
+		conf, inKeychain = moveAccessTokenToKeychain(conf, os.Stderr)
+	}
+
+	return updatedFields, inKeychain, Write(conf, SetKey)
+}
+
+// moveAccessTokenToKeychain moves the access token that was just set, in the profile selected with --profile if there is
+// one, to the OS keychain, unless --no-keychain is given. When there's no keychain to store it in, such as on a headless
+// machine without a Secret Service, the token is left in the config file and a warning is written to w.
+// It returns whether the token was moved to the keychain.
+func moveAccessTokenToKeychain(conf config.Config, w io.Writer) (config.Config, bool) {
+	if viper.GetBool(cliflags.NoKeychainFlag) {
+		return conf, false
+	}
+
+	moved, err := conf.MoveAccessTokenToKeychain(viper.GetString(cliflags.ProfileFlag))
+	if err != nil {
+		fmt.Fprintf(w, "Warning: %s. Storing it in the config file instead, use --%s to skip the keychain\n", err, cliflags.NoKeychainFlag)
+		return conf, false
+	}
+
+	return moved, true
+}
+
 // Write takes a Config and lets viper write it to the config file.
 func Write(conf config.Config, filterFn filterFn) error {
 	v, err := getViperWithConfigFile()
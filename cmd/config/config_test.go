@@ -1,13 +1,18 @@
 package config_test
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
 
 	"github.com/launchdarkly/ldcli/cmd"
+	configcmd "github.com/launchdarkly/ldcli/cmd/config"
 	"github.com/launchdarkly/ldcli/internal/analytics"
 )
 
@@ -29,3 +34,78 @@ func TestNoFlag(t *testing.T) {
 
 	assert.Equal(t, string(expected), string(output))
 }
+
+func TestSetAccessToken(t *testing.T) {
+	useConfigFile := func(t *testing.T) string {
+		configFile := filepath.Join(t.TempDir(), "config.yml")
+		require.NoError(t, os.WriteFile(configFile, []byte("project: test-proj\n"), 0600))
+		viper.SetConfigFile(configFile)
+		t.Cleanup(viper.Reset)
+
+		return configFile
+	}
+	args := []string{"config", "--set", "access-token", "test-token"}
+
+	t.Run("stores the access token in the OS keychain", func(t *testing.T) {
+		keyring.MockInit()
+		configFile := useConfigFile(t)
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), args)
+
+		require.NoError(t, err)
+		contents, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Equal(t, "project: test-proj\n", string(contents))
+		token, err := keyring.Get("ldcli", "default")
+		require.NoError(t, err)
+		assert.Equal(t, "test-token", token)
+	})
+
+	t.Run("without a keychain stores the access token in the config file", func(t *testing.T) {
+		keyring.MockInitWithError(errors.New("no secret service"))
+		t.Cleanup(keyring.MockInit)
+		configFile := useConfigFile(t)
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), args)
+
+		require.NoError(t, err)
+		contents, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Equal(t, "access-token: test-token\nproject: test-proj\n", string(contents))
+	})
+}
+
+func TestSave(t *testing.T) {
+	useConfigFile := func(t *testing.T) string {
+		configFile := filepath.Join(t.TempDir(), "config.yml")
+		require.NoError(t, os.WriteFile(configFile, []byte("project: test-proj\n"), 0600))
+		viper.SetConfigFile(configFile)
+		t.Cleanup(viper.Reset)
+
+		return configFile
+	}
+
+	t.Run("reports that the access token was stored in the OS keychain", func(t *testing.T) {
+		keyring.MockInit()
+		useConfigFile(t)
+
+		_, inKeychain, err := configcmd.Save([]string{"access-token", "test-token"})
+
+		require.NoError(t, err)
+		assert.True(t, inKeychain)
+	})
+
+	t.Run("reports that the access token was stored in the config file without a keychain", func(t *testing.T) {
+		keyring.MockInitWithError(errors.New("no secret service"))
+		t.Cleanup(keyring.MockInit)
+		configFile := useConfigFile(t)
+
+		_, inKeychain, err := configcmd.Save([]string{"access-token", "test-token"})
+
+		require.NoError(t, err)
+		assert.False(t, inKeychain)
+		contents, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(contents), "access-token: test-token")
+	})
+}
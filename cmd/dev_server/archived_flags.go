@@ -0,0 +1,84 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewArchivedOverridesCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long: `list the flags that are archived in LaunchDarkly but still have an active override. Archived flags aren't
+sent to SDKs, so their overrides no longer do anything and can be removed
+
+Examples:
+  # Find overrides left behind by archived flags
+  ldcli dev-server archived-overrides --project=my-project`,
+		RunE:  listArchivedOverrides(client),
+		Short: "list archived flags that still have overrides",
+		Use:   "archived-overrides",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+type archivedOverride struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+	Layer string          `json:"layer"`
+}
+
+func listArchivedOverrides(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		project := viper.GetString(cliflags.ProjectFlag)
+		path := fmt.Sprintf("%s/dev/projects/%s/flags", getDevServerUrl(), url.PathEscape(project))
+		query := url.Values{"archived": {"true"}, "overridden": {"true"}, "limit": {"1000"}}
+
+		res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		var response struct {
+			Items []archivedOverride `json:"items"`
+		}
+		err = json.Unmarshal(res, &response)
+		if err != nil {
+			return err
+		}
+
+		if isStructuredOutput() {
+			data, err := json.Marshal(response.Items)
+			if err != nil {
+				return err
+			}
+			return printData(cmd, data)
+		}
+
+		if len(response.Items) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "'%s' project has no overrides of archived flags\n", project)
+			return nil
+		}
+		for _, flag := range response.Items {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s is archived but overridden to %s in the %s layer\n", flag.Key, flag.Value, flag.Layer)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// exitCodeAssertionFailed is what dev-server commands exit with when a flag doesn't have the value it's asserted to.
+const exitCodeAssertionFailed = 2
+
+// assertContextKey is the key of the context flags are evaluated for when assert isn't given one.
+const assertContextKey = "ldcli-assert"
+
+func NewAssertCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long: `evaluate a flag with the dev server and exit with an error if it doesn't have the expected value
+
+Examples:
+  # Check a flag is on before running tests
+  ldcli dev-server assert --project=my-project --flag=new-checkout --equals=true
+
+  # Check the value a particular context gets
+  ldcli dev-server assert --project=my-project --flag=banner-text --equals='"Welcome back"' --context='{"key": "ci"}'`,
+		RunE:  assertFlagValue(client),
+		Short: "check the value of a flag",
+		Use:   "assert",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "The flag key")
+	_ = cmd.MarkFlagRequired(cliflags.FlagFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FlagFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().String(EqualsFlag, "", "The value the flag should have. The json representation of the value, or a string")
+	_ = cmd.MarkFlagRequired(EqualsFlag)
+	_ = cmd.Flags().SetAnnotation(EqualsFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(EqualsFlag, cmd.Flags().Lookup(EqualsFlag))
+
+	cmd.Flags().String(ContextFlag, "", fmt.Sprintf(`Stringified JSON representation of the context to evaluate the flag for ex. {"key": "ci"}. Defaults to a user with the key %s`, assertContextKey))
+	_ = viper.BindPFlag(ContextFlag, cmd.Flags().Lookup(ContextFlag))
+	cmd.Flags().String(ContextFileFlag, "", "Path to a JSON file with the context, instead of --context")
+	_ = viper.BindPFlag(ContextFileFlag, cmd.Flags().Lookup(ContextFileFlag))
+	cmd.Flags().Bool(cliflags.TemplateFlag, false, cliflags.TemplateFlagDescription)
+	_ = viper.BindPFlag(cliflags.TemplateFlag, cmd.Flags().Lookup(cliflags.TemplateFlag))
+
+	return cmd
+}
+
+func assertFlagValue(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		project, flagKey := viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag)
+		expected := parseExpectedValue(viper.GetString(EqualsFlag))
+
+		contextJSON, hasContext, err := getContextInput()
+		if err != nil {
+			return err
+		}
+		if !hasContext {
+			data, err := json.Marshal(ldcontext.New(assertContextKey))
+			if err != nil {
+				return err
+			}
+			contextJSON = string(data)
+		}
+
+		// the client-side SDK endpoint evaluates flags for a context the same way SDKs see them
+		path := fmt.Sprintf("%s/sdk/evalx/%s/contexts", getDevServerUrl(), project)
+		res, err := client.MakeUnauthenticatedRequest("REPORT", path, []byte(contextJSON))
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		var flags map[string]struct {
+			Value ldvalue.Value `json:"value"`
+		}
+		if err := json.Unmarshal(res, &flags); err != nil {
+			return err
+		}
+
+		flag, ok := flags[flagKey]
+		if !ok {
+			return fmt.Errorf("'%s' project has no flag '%s'", project, flagKey)
+		}
+		checkErr := checkFlagValue(flagKey, expected, flag.Value)
+		if viper.GetString(cliflags.OutputFlag) == output.OutputKindGitHubActions.String() {
+			err := output.WriteGitHubOutputs(map[string]string{
+				"value":  flag.Value.JSONString(),
+				"passed": strconv.FormatBool(checkErr == nil),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if checkErr != nil {
+			return errs.NewExitError(checkErr, exitCodeAssertionFailed)
+		}
+
+		if isStructuredOutput() {
+			data, err := json.Marshal(assertion{Flag: flagKey, Value: flag.Value, Expected: expected})
+			if err != nil {
+				return err
+			}
+			return printData(cmd, data)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "'%s' flag is %s\n", flagKey, flag.Value.JSONString())
+		return nil
+	}
+}
+
+type assertion struct {
+	Flag     string        `json:"flag"`
+	Value    ldvalue.Value `json:"value"`
+	Expected ldvalue.Value `json:"expected"`
+}
+
+// parseExpectedValue reads --equals as JSON, or as a string if it isn't JSON, so that string values don't need quoting.
+func parseExpectedValue(input string) ldvalue.Value {
+	var value ldvalue.Value
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		return ldvalue.String(input)
+	}
+	return value
+}
+
+func checkFlagValue(flagKey string, expected, actual ldvalue.Value) error {
+	if !actual.Equal(expected) {
+		return fmt.Errorf("'%s' flag is %s, expected %s", flagKey, actual.JSONString(), expected.JSONString())
+	}
+	return nil
+}
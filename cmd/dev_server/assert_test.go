@@ -0,0 +1,34 @@
+package dev_server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+func TestParseExpectedValue(t *testing.T) {
+	t.Run("reads JSON", func(t *testing.T) {
+		assert.Equal(t, ldvalue.Bool(true), parseExpectedValue("true"))
+		assert.Equal(t, ldvalue.Int(3), parseExpectedValue("3"))
+		assert.Equal(t, ldvalue.String("quoted"), parseExpectedValue(`"quoted"`))
+		assert.Equal(t, ldvalue.ObjectBuild().Set("a", ldvalue.Int(1)).Build(), parseExpectedValue(`{"a": 1}`))
+	})
+
+	t.Run("reads anything else as a string", func(t *testing.T) {
+		assert.Equal(t, ldvalue.String("on"), parseExpectedValue("on"))
+	})
+}
+
+func TestCheckFlagValue(t *testing.T) {
+	t.Run("passes for equal values", func(t *testing.T) {
+		assert.NoError(t, checkFlagValue("flag", ldvalue.Int(3), ldvalue.Float64(3)))
+		assert.NoError(t, checkFlagValue("flag", parseExpectedValue(`{"a": [1]}`), parseExpectedValue(`{"a":[1]}`)))
+	})
+
+	t.Run("fails with both values for unequal values", func(t *testing.T) {
+		err := checkFlagValue("flag", ldvalue.Bool(true), ldvalue.Bool(false))
+		assert.EqualError(t, err, "'flag' flag is false, expected true")
+	})
+}
@@ -0,0 +1,111 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewAuditCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long: `list who changed a project's overrides and when, most recent first
+
+Examples:
+  # Find out who turned a flag off in the last day
+  ldcli dev-server audit --project my-project --flag my-flag --since 24h`,
+		RunE:  printAudit(client),
+		Short: "list changes to overrides",
+		Use:   "audit",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "Only list changes to this flag's overrides")
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().Duration(SinceFlag, 0, "Only list changes made within this long, e.g. 24h")
+	_ = viper.BindPFlag(SinceFlag, cmd.Flags().Lookup(SinceFlag))
+
+	cmd.Flags().Int(LimitFlag, 0, "The most changes to list")
+	_ = viper.BindPFlag(LimitFlag, cmd.Flags().Lookup(LimitFlag))
+
+	return cmd
+}
+
+type auditEntry struct {
+	FlagKey    string          `json:"flagKey"`
+	Layer      string          `json:"layer"`
+	Value      json.RawMessage `json:"value"`
+	Override   bool            `json:"override"`
+	Locked     *bool           `json:"locked"`
+	Version    int             `json:"version"`
+	Actor      string          `json:"actor"`
+	RecordedAt time.Time       `json:"recordedAt"`
+}
+
+func printAudit(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag) + "/audit"
+		query := url.Values{}
+		if viper.GetString(cliflags.FlagFlag) != "" {
+			query.Set("flagKey", viper.GetString(cliflags.FlagFlag))
+		}
+		if since := viper.GetDuration(SinceFlag); since > 0 {
+			query.Set("since", time.Now().Add(-since).Format(time.RFC3339))
+		}
+		if limit := viper.GetInt(LimitFlag); limit > 0 {
+			query.Set("limit", strconv.Itoa(limit))
+		}
+
+		res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if isStructuredOutput() {
+			return printResponse(cmd, res)
+		}
+
+		var response struct {
+			Entries []auditEntry `json:"entries"`
+		}
+		err = json.Unmarshal(res, &response)
+		if err != nil {
+			return err
+		}
+		for _, entry := range response.Entries {
+			actor := entry.Actor
+			if actor == "" {
+				actor = "unknown"
+			}
+			change := fmt.Sprintf("set %s to %s", entry.FlagKey, entry.Value)
+			switch {
+			case entry.Locked != nil && *entry.Locked:
+				change = "locked the override for " + entry.FlagKey
+			case entry.Locked != nil:
+				change = "unlocked the override for " + entry.FlagKey
+			case !entry.Override:
+				change = "removed the override for " + entry.FlagKey
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s %s (%s layer, version %d)\n", entry.RecordedAt.Local().Format(time.DateTime), actor, change, entry.Layer, entry.Version)
+		}
+		return nil
+	}
+}
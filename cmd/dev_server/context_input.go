@@ -0,0 +1,39 @@
+package dev_server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	"github.com/launchdarkly/ldcli/internal/contexts"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+)
+
+// getContextInput returns the context JSON given with --context or --context-file, with template functions executed
+// when --template is set, and whether either flag was set.
+func getContextInput() (string, bool, error) {
+	template := viper.GetBool(cliflags.TemplateFlag)
+	switch {
+	case viper.IsSet(ContextFlag) && viper.IsSet(ContextFileFlag):
+		return "", false, fmt.Errorf("only one of --%s and --%s can be set", ContextFlag, ContextFileFlag)
+	case viper.IsSet(ContextFileFlag) && template:
+		rendered, err := contexts.RenderFile(viper.GetString(ContextFileFlag))
+		return rendered, true, err
+	case viper.IsSet(ContextFileFlag):
+		filename := viper.GetString(ContextFileFlag)
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return "", false, errs.NewErrorWrapped(fmt.Sprintf("unable to read context file %s", filename), err)
+		}
+		return string(data), true, nil
+	case viper.IsSet(ContextFlag) && template:
+		rendered, err := contexts.Render(viper.GetString(ContextFlag))
+		return rendered, true, err
+	case viper.IsSet(ContextFlag):
+		return viper.GetString(ContextFlag), true, nil
+	default:
+		return "", false, nil
+	}
+}
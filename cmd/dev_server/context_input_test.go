@@ -0,0 +1,53 @@
+package dev_server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+)
+
+func TestGetContextInput(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	t.Run("leaves template actions alone without --template", func(t *testing.T) {
+		viper.Reset()
+		viper.Set(ContextFlag, `{"kind": "user", "key": "{{uuid}}"}`)
+
+		input, hasContext, err := getContextInput()
+
+		require.NoError(t, err)
+		assert.True(t, hasContext)
+		assert.Equal(t, `{"kind": "user", "key": "{{uuid}}"}`, input)
+	})
+
+	t.Run("executes template functions with --template", func(t *testing.T) {
+		viper.Reset()
+		viper.Set(ContextFlag, `{"kind": "user", "key": "user-{{randInt 7 7}}"}`)
+		viper.Set(cliflags.TemplateFlag, true)
+
+		input, hasContext, err := getContextInput()
+
+		require.NoError(t, err)
+		assert.True(t, hasContext)
+		assert.JSONEq(t, `{"kind": "user", "key": "user-7"}`, input)
+	})
+
+	t.Run("reads --context-file as is without --template", func(t *testing.T) {
+		viper.Reset()
+		filename := filepath.Join(t.TempDir(), "context.json")
+		require.NoError(t, os.WriteFile(filename, []byte(`{"kind": "user", "key": "{{uuid}}"}`), 0o600))
+		viper.Set(ContextFileFlag, filename)
+
+		input, hasContext, err := getContextInput()
+
+		require.NoError(t, err)
+		assert.True(t, hasContext)
+		assert.Equal(t, `{"kind": "user", "key": "{{uuid}}"}`, input)
+	})
+}
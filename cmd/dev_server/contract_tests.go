@@ -0,0 +1,64 @@
+package dev_server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server/contract_tests"
+)
+
+func NewContractTestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "server",
+		Args:    validators.Validate(),
+		Long: `check that the running dev server's SDK endpoints behave the way LaunchDarkly SDKs expect
+
+Examples:
+  # Check streaming, polling and events endpoints using a project that has been added to the dev server
+  ldcli dev-server contract-tests --project=my-project`,
+		RunE:  runContractTests,
+		Short: "run SDK contract checks against the dev server",
+		Use:   "contract-tests",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key to use as the SDK key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.SecureModeFlag, "", "The secure mode secret the dev server was started with, if any")
+	_ = viper.BindPFlag(cliflags.SecureModeFlag, cmd.Flags().Lookup(cliflags.SecureModeFlag))
+
+	return cmd
+}
+
+func runContractTests(cmd *cobra.Command, args []string) error {
+	results := contract_tests.Run(cmd.Context(), contract_tests.Target{
+		BaseURL:          getDevServerUrl(),
+		ProjectKey:       viper.GetString(cliflags.ProjectFlag),
+		SecureModeSecret: viper.GetString(cliflags.SecureModeFlag),
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Passed() {
+			fmt.Fprintf(cmd.OutOrStdout(), "PASS %s\n", result.Name)
+			continue
+		}
+		failed++
+		fmt.Fprintf(cmd.OutOrStdout(), "FAIL %s: %s\n", result.Name, result.Err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d contract checks failed", failed, len(results))
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "all %d contract checks passed\n", len(results))
+	return nil
+}
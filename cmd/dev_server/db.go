@@ -0,0 +1,97 @@
+package dev_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+)
+
+func NewDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "server",
+		Long:    "manage the dev server database",
+		Short:   "manage the dev server database",
+		Use:     "db",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	cmd.AddCommand(NewDBUpgradeCmd())
+
+	return cmd
+}
+
+func NewDBUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `copy projects, overrides, available variations, and aliases from a database written by an older version of ldcli into the current dev server database
+
+The older database is only read from. Projects that already exist in the current database are skipped, and every
+migrated project is read back to verify that nothing was lost. The current database is the one the dev server uses
+with the same --db-path, so only the sqlite store can be upgraded.
+
+Examples:
+  # Bring over state from a database kept somewhere else
+  ldcli dev-server db upgrade --from=/path/to/old/dev_server.db`,
+		RunE:  upgradeDB,
+		Short: "migrate an older dev server database",
+		Use:   "upgrade",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(FromFlag, "", "Path to the older dev server database")
+	_ = cmd.MarkFlagRequired(FromFlag)
+	_ = cmd.Flags().SetAnnotation(FromFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(FromFlag, cmd.Flags().Lookup(FromFlag))
+
+	cmd.Flags().String(StoreFlag, dev_server.StoreSqlite, "Where the dev server keeps projects and overrides. Only sqlite can be upgraded")
+	_ = viper.BindPFlag(StoreFlag, cmd.Flags().Lookup(StoreFlag))
+
+	cmd.Flags().String(DBPathFlag, "", "Path to the database to upgrade. Defaults to dev_server.db in the ldcli state directory")
+	_ = viper.BindPFlag(DBPathFlag, cmd.Flags().Lookup(DBPathFlag))
+
+	return cmd
+}
+
+func upgradeDB(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if store := viper.GetString(StoreFlag); store != dev_server.StoreSqlite {
+		return fmt.Errorf("only the %s store can be upgraded, not %s", dev_server.StoreSqlite, store)
+	}
+	dbFilePath, err := dev_server.DBPath(viper.GetString(DBPathFlag))
+	if err != nil {
+		return fmt.Errorf("unable to get database path: %w", err)
+	}
+	sqlStore, err := db.NewSqlite(ctx, dbFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to open database: %w", err)
+	}
+
+	report, err := sqlStore.Upgrade(ctx, viper.GetString(FromFlag))
+	if err != nil {
+		return fmt.Errorf("unable to upgrade database: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Detected schema version %d (current is %d)\n", report.SchemaVersion, db.SchemaVersionCurrent)
+	for _, project := range report.Migrated {
+		fmt.Fprintf(out, "Migrated project '%s': %d flags, %d overrides, %d available variations\n",
+			project.Key, project.Flags, project.Overrides, project.AvailableVariations)
+	}
+	for _, projectKey := range report.Skipped {
+		fmt.Fprintf(out, "Skipped project '%s': it already exists in %s\n", projectKey, dbFilePath)
+	}
+	if report.Aliases > 0 {
+		fmt.Fprintf(out, "Migrated %d aliases\n", report.Aliases)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package dev_server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+)
+
+func TestUpgradeDB(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	t.Run("upgrades the database at --db-path", func(t *testing.T) {
+		viper.Reset()
+		legacyPath := filepath.Join(t.TempDir(), "legacy.db")
+		legacy, err := sql.Open("sqlite3", legacyPath)
+		require.NoError(t, err)
+		for _, statement := range []string{
+			`CREATE TABLE projects (key text PRIMARY KEY, source_environment_key text NOT NULL, context text NOT NULL, last_sync_time timestamp NOT NULL, flag_state TEXT NOT NULL)`,
+			`CREATE TABLE overrides (project_key text NOT NULL, flag_key text NOT NULL, value text NOT NULL)`,
+			`INSERT INTO projects VALUES ('legacy-proj', 'env-1', '{"kind":"user","key":"legacy-user"}', '2024-01-02 03:04:05', '{"flag-1":{"value":true,"version":3}}')`,
+		} {
+			_, err = legacy.Exec(statement)
+			require.NoError(t, err)
+		}
+		require.NoError(t, legacy.Close())
+		dbPath := filepath.Join(t.TempDir(), "current.db")
+		viper.Set(StoreFlag, dev_server.StoreSqlite)
+		viper.Set(DBPathFlag, dbPath)
+		viper.Set(FromFlag, legacyPath)
+
+		cmd := NewDBUpgradeCmd()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		require.NoError(t, upgradeDB(cmd, nil))
+		assert.Contains(t, out.String(), "Migrated project 'legacy-proj'")
+
+		store, err := db.NewSqlite(context.Background(), dbPath)
+		require.NoError(t, err)
+		_, err = store.GetDevProject(context.Background(), "legacy-proj")
+		assert.NoError(t, err)
+	})
+
+	t.Run("refuses to upgrade stores other than sqlite", func(t *testing.T) {
+		viper.Reset()
+		viper.Set(StoreFlag, dev_server.StoreRedis)
+		viper.Set(FromFlag, filepath.Join(t.TempDir(), "legacy.db"))
+
+		err := upgradeDB(NewDBUpgradeCmd(), nil)
+		assert.EqualError(t, err, "only the sqlite store can be upgraded, not redis")
+	})
+}
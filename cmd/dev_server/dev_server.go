@@ -1,7 +1,10 @@
 package dev_server
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,15 +14,34 @@ import (
 	resourcecmd "github.com/launchdarkly/ldcli/cmd/resources"
 	"github.com/launchdarkly/ldcli/internal/analytics"
 	"github.com/launchdarkly/ldcli/internal/dev_server"
+	"github.com/launchdarkly/ldcli/internal/output"
 	"github.com/launchdarkly/ldcli/internal/resources"
 )
 
 func NewDevServerCmd(client resources.Client, analyticsTrackerFn analytics.TrackerFn, ldClient dev_server.Client) *cobra.Command {
+	// what commands print with --output github-actions, which is also set as the step's result output
+	var captured *bytes.Buffer
+
 	cmd := &cobra.Command{
 		Use:   "dev-server",
 		Short: "Development server",
-		Long:  "Start and use a local development server for overriding flag values.",
+		Long: fmt.Sprintf(`Start and use a local development server for overriding flag values.
+
+Commands exit with 0 on success, %d if an assertion fails, and 1 for any other error. With --output github-actions,
+errors are reported as annotations and each command's JSON output is set as the step's "result" output.`, exitCodeAssertionFailed),
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			quiet := viper.GetBool(QuietFlag)
+			switch {
+			case viper.GetString(cliflags.OutputFlag) == output.OutputKindGitHubActions.String():
+				captured = &bytes.Buffer{}
+				if quiet {
+					cmd.SetOut(captured)
+				} else {
+					cmd.SetOut(io.MultiWriter(cmd.OutOrStdout(), captured))
+				}
+			case quiet:
+				cmd.SetOut(io.Discard)
+			}
 
 			tracker := analyticsTrackerFn(
 				viper.GetString(cliflags.AccessTokenFlag),
@@ -33,6 +55,12 @@ func NewDevServerCmd(client resources.Client, analyticsTrackerFn analytics.Track
 					"action": cmd.Name(),
 				}))
 		},
+		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			if captured == nil {
+				return nil
+			}
+			return output.WriteGitHubOutputs(map[string]string{"result": strings.TrimSpace(captured.String())})
+		},
 	}
 
 	cmd.PersistentFlags().String(
@@ -64,24 +92,75 @@ func NewDevServerCmd(client resources.Client, analyticsTrackerFn analytics.Track
 	)
 	_ = viper.BindPFlag(cliflags.CorsOriginFlag, cmd.PersistentFlags().Lookup(cliflags.CorsOriginFlag))
 
+	cmd.PersistentFlags().Bool(QuietFlag, false, "Don't print anything on success, so only the exit code is checked")
+	_ = viper.BindPFlag(QuietFlag, cmd.PersistentFlags().Lookup(QuietFlag))
+
 	// Add subcommands here
 	cmd.AddGroup(&cobra.Group{ID: "projects", Title: "Project commands:"})
+	cmd.AddCommand(NewProjectsCmd(client))
 	cmd.AddCommand(NewListProjectsCmd(client))
 	cmd.AddCommand(NewGetProjectCmd(client))
+	cmd.AddCommand(NewSearchFlagsCmd(client))
 	cmd.AddCommand(NewSyncProjectCmd(client))
+	cmd.AddCommand(NewSyncStatusCmd(client))
+	cmd.AddCommand(NewWatchCmd(client))
 	cmd.AddCommand(NewRemoveProjectCmd(client))
+	cmd.AddCommand(NewArchiveProjectCmd(client))
+	cmd.AddCommand(NewUnarchiveProjectCmd(client))
+	cmd.AddCommand(NewPurgeProjectCmd(client))
 	cmd.AddCommand(NewAddProjectCmd(client))
+	cmd.AddCommand(NewCloneProjectCmd(client))
 	cmd.AddCommand(NewUpdateProjectCmd(client))
 	cmd.AddCommand(NewImportProjectCmd())
+	cmd.AddCommand(NewListSdkKeysCmd(client))
+	cmd.AddCommand(NewAddSdkKeyCmd(client))
+	cmd.AddCommand(NewRemoveSdkKeyCmd(client))
+	cmd.AddCommand(NewListContextsCmd(client))
+	cmd.AddCommand(NewSaveContextCmd(client))
+	cmd.AddCommand(NewDeleteContextCmd(client))
+	cmd.AddCommand(NewListSnapshotsCmd(client))
+	cmd.AddCommand(NewTakeSnapshotCmd(client))
+	cmd.AddCommand(NewRestoreSnapshotCmd(client))
 
 	cmd.AddGroup(&cobra.Group{ID: "overrides", Title: "Override commands:"})
 	cmd.AddCommand(NewAddOverrideCmd(client))
+	cmd.AddCommand(NewAddRolloutOverrideCmd(client))
+	cmd.AddCommand(NewAddChaosOverrideCmd(client))
+	cmd.AddCommand(NewPinMigrationStageCmd(client))
+	cmd.AddCommand(NewAddAIConfigOverrideCmd(client))
+	cmd.AddCommand(NewSimulateExperimentCmd(client))
+	cmd.AddCommand(NewForceTreatmentCmd(client))
+	cmd.AddCommand(NewUnforceTreatmentCmd(client))
 	cmd.AddCommand(NewRemoveOverrideCmd(client))
 	cmd.AddCommand(NewDeleteOverridesCmd(client))
+	cmd.AddCommand(NewCopyOverridesCmd(client))
+	cmd.AddCommand(NewMirrorOverridesCmd(client))
+	cmd.AddCommand(NewLockOverrideCmd(client))
+	cmd.AddCommand(NewUnlockOverrideCmd(client))
+	cmd.AddCommand(NewScheduleOverrideCmd(client))
+	cmd.AddCommand(NewUnscheduleOverrideCmd(client))
+	cmd.AddCommand(NewListSchedulesCmd(client))
+	cmd.AddCommand(NewListTriggersCmd(client))
+	cmd.AddCommand(NewAddTriggerCmd(client))
+	cmd.AddCommand(NewRemoveTriggerCmd(client))
+	cmd.AddCommand(NewArchivedOverridesCmd(client))
+	cmd.AddCommand(NewAuditCmd(client))
+	cmd.AddCommand(NewAssertCmd(client))
+
+	cmd.AddGroup(&cobra.Group{ID: "events", Title: "Event commands:"})
+	cmd.AddCommand(NewEventsCmd(client))
+	cmd.AddCommand(NewRequestsCmd(client))
+	cmd.AddCommand(NewReplayCmd(client))
+	cmd.AddCommand(NewUsageCmd(client))
+
 	cmd.AddGroup(&cobra.Group{ID: "server", Title: "Server commands:"})
 
 	cmd.AddCommand(NewStartServerCmd(ldClient))
+	cmd.AddCommand(NewLogsCmd(client))
 	cmd.AddCommand(NewUICmd())
+	cmd.AddCommand(NewContractTestsCmd())
+	cmd.AddCommand(NewDBCmd())
+	cmd.AddCommand(NewDoctorCmd())
 
 	cmd.SetUsageTemplate(resourcecmd.SubcommandUsageTemplate())
 
@@ -91,3 +170,54 @@ func NewDevServerCmd(client resources.Client, analyticsTrackerFn analytics.Track
 func getDevServerUrl() string {
 	return fmt.Sprintf("http://localhost:%s", viper.GetString(cliflags.PortFlag))
 }
+
+// makeChangeRequest sends a request that changes the dev server's data, unless the command was run with --dry-run, in
+// which case it prints the request instead. sent is false for dry runs, and callers should return without using the
+// response.
+func makeChangeRequest(cmd *cobra.Command, client resources.Client, method, path string, data []byte) ([]byte, bool, error) {
+	return resourcecmd.MakeRequest(cmd, client, "", method, path, "application/json", nil, data, false)
+}
+
+// isStructuredOutput is whether a command prints data for other tools to read, because of --output or --query, instead
+// of text for people to read.
+func isStructuredOutput() bool {
+	return output.IsStructured(viper.GetString(cliflags.OutputFlag)) || viper.GetString(cliflags.QueryFlag) != ""
+}
+
+// printResponse prints a response from the dev server, converted to YAML with --output yaml.
+func printResponse(cmd *cobra.Command, res []byte) error {
+	if !output.IsYAML(viper.GetString(cliflags.OutputFlag)) && viper.GetString(cliflags.QueryFlag) == "" {
+		fmt.Fprint(cmd.OutOrStdout(), string(res))
+		return nil
+	}
+	return printData(cmd, res)
+}
+
+// printData prints JSON a command put together for --output json, or as YAML for --output yaml, after applying the
+// --query expression if there is one.
+func printData(cmd *cobra.Command, data []byte) error {
+	outputKind := viper.GetString(cliflags.OutputFlag)
+	var out string
+	var err error
+	if query := viper.GetString(cliflags.QueryFlag); query != "" {
+		out, err = output.QueryOutput(outputKind, data, query, viper.GetStringSlice(cliflags.ColumnsFlag))
+	} else {
+		out, err = output.FromJSON(outputKind, data)
+	}
+	if err != nil {
+		return err
+	}
+	if out != "" {
+		fmt.Fprintln(cmd.OutOrStdout(), out)
+	}
+	return nil
+}
+
+// printStreamedData prints one of many JSON values a command streams, one per line, or as its own YAML document for
+// --output yaml.
+func printStreamedData(cmd *cobra.Command, data []byte) error {
+	if output.IsYAML(viper.GetString(cliflags.OutputFlag)) {
+		fmt.Fprintln(cmd.OutOrStdout(), "---")
+	}
+	return printData(cmd, data)
+}
@@ -0,0 +1,106 @@
+package dev_server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server"
+)
+
+func NewDoctorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "server",
+		Args:    validators.Validate(),
+		Long: `check for problems that stop the dev server from starting or syncing: whether the port is free, whether the
+database is intact, whether LaunchDarkly accepts the access token, whether the streaming service can be reached, and
+whether the local clock is right. Each problem is printed with how to fix it, and the command fails if there are any
+
+Examples:
+  # Check the setup of a server that will run with Redis on another port
+  ldcli dev-server doctor --port 8766 --store redis --redis-url redis://localhost:6379/0`,
+		RunE:  runDoctor,
+		Short: "diagnose dev server problems",
+		Use:   "doctor",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(StoreFlag, dev_server.StoreSqlite, "Where the dev server keeps projects and overrides, either sqlite or redis")
+	_ = viper.BindPFlag(StoreFlag, cmd.Flags().Lookup(StoreFlag))
+
+	cmd.Flags().String(RedisURLFlag, "", "URL of the Redis server used with --store=redis")
+	_ = viper.BindPFlag(RedisURLFlag, cmd.Flags().Lookup(RedisURLFlag))
+
+	cmd.Flags().String(DBPathFlag, "", "Path to the database used with --store=sqlite. Defaults to dev_server.db in the ldcli state directory")
+	_ = viper.BindPFlag(DBPathFlag, cmd.Flags().Lookup(DBPathFlag))
+
+	return cmd
+}
+
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"`
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	dbFilePath, err := dev_server.DBPath(viper.GetString(DBPathFlag))
+	if err != nil {
+		return fmt.Errorf("unable to get database path: %w", err)
+	}
+	streamURI := serviceURI()
+	checks := dev_server.Diagnose(context.Background(), dev_server.DoctorParams{
+		Port:         viper.GetString(cliflags.PortFlag),
+		Store:        viper.GetString(StoreFlag),
+		DBPath:       dbFilePath,
+		StoreURL:     viper.GetString(RedisURLFlag),
+		AccessToken:  viper.GetString(cliflags.AccessTokenFlag),
+		BaseURI:      viper.GetString(cliflags.BaseURIFlag),
+		DevStreamURI: streamURI,
+	})
+
+	failed := 0
+	results := make([]doctorCheck, 0, len(checks))
+	for _, check := range checks {
+		if check.Status == dev_server.DoctorStatusFail {
+			failed++
+		}
+		results = append(results, doctorCheck{
+			Name:   check.Name,
+			Status: string(check.Status),
+			Detail: check.Detail,
+			Fix:    check.Fix,
+		})
+	}
+
+	out := cmd.OutOrStdout()
+	if isStructuredOutput() {
+		data, err := json.Marshal(results)
+		if err != nil {
+			return err
+		}
+		if err := printData(cmd, data); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			fmt.Fprintf(out, "[%s] %s: %s\n", result.Status, result.Name, result.Detail)
+			if result.Fix != "" {
+				fmt.Fprintf(out, "       fix: %s\n", result.Fix)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+	}
+	return nil
+}
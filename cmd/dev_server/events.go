@@ -0,0 +1,119 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// eventsPollInterval is how often `events tail --follow` asks the dev server for new events.
+const eventsPollInterval = time.Second
+
+func NewEventsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "events",
+		Long:    "inspect analytics events that SDKs have sent to the dev server",
+		Short:   "inspect SDK events",
+		Use:     "events",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	cmd.AddCommand(NewEventsTailCmd(client))
+
+	return cmd
+}
+
+func NewEventsTailCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `print the most recent events received from SDKs, then keep printing new ones as they arrive
+
+Examples:
+  # Watch for custom events sent by your app
+  ldcli dev-server events tail --kind=custom`,
+		RunE:  tailEvents(client),
+		Short: "tail SDK events",
+		Use:   "tail",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "Only show events sent by this project")
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(KindFlag, "", "Only show events of this kind (e.g. feature, custom, identify, summary, diagnostic)")
+	_ = viper.BindPFlag(KindFlag, cmd.Flags().Lookup(KindFlag))
+
+	cmd.Flags().Bool(FollowFlag, true, "Keep printing new events as they arrive")
+	_ = viper.BindPFlag(FollowFlag, cmd.Flags().Lookup(FollowFlag))
+
+	return cmd
+}
+
+type receivedEvent struct {
+	Id         int64           `json:"id"`
+	ReceivedAt time.Time       `json:"receivedAt"`
+	ProjectKey string          `json:"projectKey"`
+	Kind       string          `json:"kind"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type eventsResponse struct {
+	Events []receivedEvent `json:"events"`
+}
+
+func tailEvents(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/events"
+		var after int64
+		for {
+			query := url.Values{}
+			if after > 0 {
+				query.Set("after", strconv.FormatInt(after, 10))
+			}
+			if viper.IsSet(cliflags.ProjectFlag) {
+				query.Set("projectKey", viper.GetString(cliflags.ProjectFlag))
+			}
+			if viper.IsSet(KindFlag) {
+				query.Set("kind", viper.GetString(KindFlag))
+			}
+
+			res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			var response eventsResponse
+			err = json.Unmarshal(res, &response)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range response.Events {
+				after = event.Id
+				if isStructuredOutput() {
+					if err := printStreamedData(cmd, event.Data); err != nil {
+						return err
+					}
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s [%s] %s\n", event.ReceivedAt.Format(time.TimeOnly), event.Kind, string(event.Data))
+			}
+
+			if !viper.GetBool(FollowFlag) {
+				return nil
+			}
+			time.Sleep(eventsPollInterval)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewSimulateExperimentCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long: `show which treatment of an experiment's rollout each of a set of contexts would be bucketed into, and how many
+contexts each treatment gets. The flag's rollout override is simulated unless a rollout is given
+
+Examples:
+  # Check how a 50/50 split allocates three users
+  ldcli dev-server simulate-experiment --project=my-project --flag=checkout --data='{"rollout":{"variations":[{"value":"control","percent":50},{"value":"treatment","percent":50}]},"contexts":[{"key":"a"},{"key":"b"},{"key":"c"}]}'`,
+		RunE:  simulateExperiment(client),
+		Short: "simulate how an experiment buckets contexts",
+		Use:   "simulate-experiment",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(cliflags.DataFlag, "", `the contexts to bucket and, optionally, the rollout to bucket them with, e.g. '{"contexts":[{"key":"a"},{"kind":"org","key":"b"}]}'`)
+	_ = cmd.MarkFlagRequired(cliflags.DataFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.DataFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.DataFlag, cmd.Flags().Lookup(cliflags.DataFlag))
+
+	return cmd
+}
+
+func simulateExperiment(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var data interface{}
+		err := json.Unmarshal([]byte(viper.GetString(cliflags.DataFlag)), &data)
+		if err != nil {
+			return err
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/flags/%s/experiment/simulate", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewForceTreatmentCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "force a context into one of the variations of a flag's rollout override, so that a treatment can be tried out without finding a context that's bucketed into it",
+		RunE:    forceTreatment(client),
+		Short:   "force a context into a treatment",
+		Use:     "force-treatment",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(cliflags.DataFlag, "", `the context and the index of the rollout variation to force it into, e.g. '{"contextKey":"alice","variation":1}' or '{"contextKind":"org","contextKey":"acme","variation":0}'`)
+	_ = cmd.MarkFlagRequired(cliflags.DataFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.DataFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.DataFlag, cmd.Flags().Lookup(cliflags.DataFlag))
+
+	return cmd
+}
+
+func forceTreatment(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var data interface{}
+		err := json.Unmarshal([]byte(viper.GetString(cliflags.DataFlag)), &data)
+		if err != nil {
+			return err
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/treatments", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewUnforceTreatmentCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "stop forcing a context into a treatment, so that it's bucketed by the flag's rollout override again",
+		RunE:    unforceTreatment(client),
+		Short:   "stop forcing a context into a treatment",
+		Use:     "unforce-treatment",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(ContextKeyFlag, "", "The key of the context")
+	_ = cmd.MarkFlagRequired(ContextKeyFlag)
+	_ = cmd.Flags().SetAnnotation(ContextKeyFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(ContextKeyFlag, cmd.Flags().Lookup(ContextKeyFlag))
+
+	cmd.Flags().String(ContextKindFlag, "", "The kind of the context. Defaults to user")
+	_ = viper.BindPFlag(ContextKindFlag, cmd.Flags().Lookup(ContextKindFlag))
+
+	return cmd
+}
+
+func unforceTreatment(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/treatments", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		query := url.Values{"contextKey": {viper.GetString(ContextKeyFlag)}}
+		if kind := viper.GetString(ContextKindFlag); kind != "" {
+			query.Set("contextKind", kind)
+		}
+
+		res, sent, err := resourcescmd.MakeRequest(cmd, client, "", "DELETE", path, "application/json", query, nil, false)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
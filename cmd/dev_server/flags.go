@@ -1,7 +1,72 @@
 package dev_server
 
 const (
-	ContextFlag           = "context"
-	OverrideFlag          = "override"
-	SourceEnvironmentFlag = "source"
+	ActionFlag               = "action"
+	ActivateAtFlag           = "activate-at"
+	ActorFlag                = "actor"
+	ActorHeaderFlag          = "actor-header"
+	ActorTokensFlag          = "actor-tokens"
+	AutoConfigKeyFlag        = "auto-config-key"
+	AutoConfigEnvFlag        = "auto-config-environment"
+	AutoCreateProjectsFlag   = "auto-create-projects"
+	AutoResyncStaleFlag      = "auto-resync-stale"
+	ChaosSeedFlag            = "seed"
+	ContextFlag              = "context"
+	ContextEnrichmentFlag    = "context-enrichment-hook"
+	ContextFileFlag          = "context-file"
+	ContextKeyFlag           = "context-key"
+	ContextKindFlag          = "context-kind"
+	DashboardURLFlag         = "dashboard-url"
+	DBPathFlag               = "db-path"
+	DeactivateAtFlag         = "deactivate-at"
+	EqualsFlag               = "equals"
+	ExecHookFlag             = "exec-hook"
+	ExecHookFlagsFlag        = "exec-hook-flags"
+	FieldsFlag               = "fields"
+	FlagKeyPrefixesFlag      = "flag-key-prefixes"
+	FlagKeysFlag             = "flag-keys"
+	FlagTagsFlag             = "flag-tags"
+	FollowFlag               = "follow"
+	FromFlag                 = "from"
+	GraphQLFlag              = "graphql"
+	GrepFlag                 = "grep"
+	HistoryRetentionFlag     = "history-retention"
+	IncludeArchivedFlag      = "include-archived"
+	KeyPrefixFlag            = "key-prefix"
+	KindFlag                 = "kind"
+	LevelFlag                = "level"
+	LimitFlag                = "limit"
+	ListenFlag               = "listen"
+	NameFlag                 = "name"
+	NamespaceFlag            = "namespace"
+	NotificationDebounceFlag = "notification-debounce"
+	OffsetFlag               = "offset"
+	OverriddenFlag           = "overridden"
+	OverrideFlag             = "override"
+	PerContextFlag           = "per-context"
+	PrefetchKeysFlag         = "prefetch-keys"
+	QuietFlag                = "quiet"
+	RecordEvaluationsFlag    = "record-evaluations"
+	RecordingFlag            = "recording"
+	RedisURLFlag             = "redis-url"
+	ReloadHookFlag           = "reload-hook"
+	ReloadHookFlagsFlag      = "reload-hook-flags"
+	SdkKeyFlag               = "sdk-key"
+	SdkKeysFlag              = "sdk-keys"
+	SearchFlag               = "search"
+	ServerConfigFlag         = "server-config"
+	SinceFlag                = "since"
+	SnapshotFlag             = "snapshot"
+	SeedFileFlag             = "seed"
+	SourceEnvironmentFlag    = "source"
+	StageFlag                = "stage"
+	StaleAfterFlag           = "stale-after"
+	StatsdAddressFlag        = "statsd-address"
+	StatsdFormatFlag         = "statsd-format"
+	StatsdPrefixFlag         = "statsd-prefix"
+	StatsdTagsFlag           = "statsd-tags"
+	StoreFlag                = "store"
+	SyncIntervalFlag         = "sync-interval"
+	TagsFlag                 = "tags"
+	TriggerFlag              = "trigger"
 )
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/adrg/xdg"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
 	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
 	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server"
 	"github.com/launchdarkly/ldcli/internal/dev_server/db"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
@@ -47,6 +47,9 @@ Examples:
 	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
 	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
 
+	cmd.Flags().String(DBPathFlag, "", "Path to the dev server database. Defaults to dev_server.db in the ldcli state directory")
+	_ = viper.BindPFlag(DBPathFlag, cmd.Flags().Lookup(DBPathFlag))
+
 	cmd.Flags().String(ImportFileFlag, "", "Path to JSON file containing project data")
 	_ = cmd.MarkFlagRequired(ImportFileFlag)
 	_ = cmd.Flags().SetAnnotation(ImportFileFlag, "required", []string{"true"})
@@ -61,8 +64,7 @@ func importProject() func(*cobra.Command, []string) error {
 		projectKey := viper.GetString(cliflags.ProjectFlag)
 		filepath := viper.GetString(ImportFileFlag)
 
-		// Get database path (same logic as dev_server.go)
-		dbFilePath, err := xdg.StateFile("ldcli/dev_server.db")
+		dbFilePath, err := dev_server.DBPath(viper.GetString(DBPathFlag))
 		if err != nil {
 			return fmt.Errorf("unable to get database path: %w", err)
 		}
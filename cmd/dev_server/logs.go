@@ -0,0 +1,124 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// logsPollInterval is how often `logs --follow` asks the dev server for new messages once it's caught up.
+const logsPollInterval = time.Second
+
+// logsPageSize is how many messages are requested at a time. The first request gets the most recent ones, and
+// `logs --follow` then pages through the messages logged after them.
+const logsPageSize = 100
+
+func NewLogsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "server",
+		Args:    validators.Validate(),
+		Long: `print the most recent messages logged by a running dev server, filtered by the server
+
+Examples:
+  # Watch for warnings about overrides in one project
+  ldcli dev-server logs --follow --project my-project --level warn --grep override`,
+		RunE:  printLogs(client),
+		Short: "print server logs",
+		Use:   "logs",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "Only show messages about this project")
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(LevelFlag, "", "Only show messages at this level or more severe (debug, info, warn, error)")
+	_ = viper.BindPFlag(LevelFlag, cmd.Flags().Lookup(LevelFlag))
+
+	cmd.Flags().String(GrepFlag, "", "Only show messages matching this regular expression")
+	_ = viper.BindPFlag(GrepFlag, cmd.Flags().Lookup(GrepFlag))
+
+	cmd.Flags().Bool(FollowFlag, false, "Keep printing new messages as they're logged")
+	_ = viper.BindPFlag(FollowFlag, cmd.Flags().Lookup(FollowFlag))
+
+	return cmd
+}
+
+type logEntry struct {
+	Id         int64     `json:"id"`
+	Time       time.Time `json:"time"`
+	Level      string    `json:"level"`
+	ProjectKey string    `json:"projectKey,omitempty"`
+	Message    string    `json:"message"`
+}
+
+type logsResponse struct {
+	Logs []logEntry `json:"logs"`
+	Next int64      `json:"next"`
+}
+
+func printLogs(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/logs"
+		var after int64
+		for {
+			query := url.Values{"limit": []string{strconv.Itoa(logsPageSize)}}
+			if after > 0 {
+				query.Set("after", strconv.FormatInt(after, 10))
+			}
+			if viper.IsSet(cliflags.ProjectFlag) {
+				query.Set("projectKey", viper.GetString(cliflags.ProjectFlag))
+			}
+			if viper.IsSet(LevelFlag) {
+				query.Set("level", viper.GetString(LevelFlag))
+			}
+			if viper.IsSet(GrepFlag) {
+				query.Set("grep", viper.GetString(GrepFlag))
+			}
+
+			res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			var response logsResponse
+			err = json.Unmarshal(res, &response)
+			if err != nil {
+				return err
+			}
+
+			after = response.Next
+			for _, entry := range response.Logs {
+				if isStructuredOutput() {
+					data, err := json.Marshal(entry)
+					if err != nil {
+						return err
+					}
+					if err := printStreamedData(cmd, data); err != nil {
+						return err
+					}
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s [%s] %s\n", entry.Time.Format(time.TimeOnly), entry.Level, entry.Message)
+			}
+
+			if !viper.GetBool(FollowFlag) {
+				return nil
+			}
+			// a full page means there are more messages waiting
+			if len(response.Logs) < logsPageSize {
+				time.Sleep(logsPollInterval)
+			}
+		}
+	}
+}
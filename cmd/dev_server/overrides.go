@@ -10,6 +10,7 @@ import (
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
 	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
 	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/launchdarkly/ldcli/internal/output"
 	"github.com/launchdarkly/ldcli/internal/resources"
 )
@@ -58,18 +59,305 @@ func addOverride(client resources.Client) func(*cobra.Command, []string) error {
 		}
 
 		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
-		res, err := client.MakeUnauthenticatedRequest(
-			"PUT",
-			path,
-			jsonData,
-		)
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewAddRolloutOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "override flag with a percentage rollout, so that contexts are bucketed between values the way LaunchDarkly rollouts bucket them",
+		RunE:    addRolloutOverride(client),
+		Short:   "override flag with a percentage rollout",
+		Use:     "add-rollout-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(cliflags.DataFlag, "", `the rollout to override flag with, e.g. '{"variations":[{"value":true,"percent":20},{"value":false,"percent":80}]}'. "contextKind" and "bucketBy" choose what contexts are bucketed by and default to "user" and "key"`)
+	_ = cmd.MarkFlagRequired(cliflags.DataFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.DataFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.DataFlag, cmd.Flags().Lookup(cliflags.DataFlag))
+
+	return cmd
+}
+
+func addRolloutOverride(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var data interface{}
+		err := json.Unmarshal([]byte(viper.GetString(cliflags.DataFlag)), &data)
+		if err != nil {
+			return err
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/rollout", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewAddChaosOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "override flag with a random one of its variations, picked each time flags are served or once per context, to test how an app copes with values it doesn't expect. Server-side SDKs evaluate flags themselves, so they always get a random variation per context",
+		RunE:    addChaosOverride(client),
+		Short:   "override flag with random variations",
+		Use:     "add-chaos-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().Int64(ChaosSeedFlag, 0, "Seed for the random variations, so that runs can be repeated")
+	_ = viper.BindPFlag(ChaosSeedFlag, cmd.Flags().Lookup(ChaosSeedFlag))
+
+	cmd.Flags().Bool(PerContextFlag, false, "Give each context a random variation that it keeps")
+	_ = viper.BindPFlag(PerContextFlag, cmd.Flags().Lookup(PerContextFlag))
+
+	return cmd
+}
+
+func addChaosOverride(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		jsonData, err := json.Marshal(map[string]any{
+			"seed":       viper.GetInt64(ChaosSeedFlag),
+			"perContext": viper.GetBool(PerContextFlag),
+		})
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/chaos", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewPinMigrationStageCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "override a migration flag with one of its stages, so that SDKs run the migration in that stage. Remove it like any other override",
+		RunE:    pinMigrationStage(client),
+		Short:   "pin a migration flag to a stage",
+		Use:     "pin-migration-stage",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(StageFlag, "", "The stage to pin the flag to: off, dualwrite, shadow, live, rampdown or complete")
+	_ = cmd.MarkFlagRequired(StageFlag)
+	_ = cmd.Flags().SetAnnotation(StageFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(StageFlag, cmd.Flags().Lookup(StageFlag))
+
+	return cmd
+}
+
+func pinMigrationStage(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		jsonData, err := json.Marshal(map[string]any{
+			"stage": viper.GetString(StageFlag),
+		})
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/stage", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewAddAIConfigOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "override an AI Config with another of its variations, a different model or prompt messages, or both, so that AI SDKs are configured with them",
+		RunE:    addAIConfigOverride(client),
+		Short:   "override an AI Config",
+		Use:     "add-ai-config-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(cliflags.DataFlag, "", `the override, e.g. '{"variationKey":"smart"}' or '{"model":{"name":"gpt-4o"},"messages":[{"role":"system","content":"Be brief"}]}'. "model" and "messages" replace those of the variation, or of the AI Config's current value`)
+	_ = cmd.MarkFlagRequired(cliflags.DataFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.DataFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.DataFlag, cmd.Flags().Lookup(cliflags.DataFlag))
+
+	return cmd
+}
+
+func addAIConfigOverride(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var data interface{}
+		err := json.Unmarshal([]byte(viper.GetString(cliflags.DataFlag)), &data)
+		if err != nil {
+			return err
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/ai-config", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewCopyOverridesCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "copy another project's active overrides into a project, replacing its overrides for the same flags. Overrides for flags the project doesn't have, or whose override is locked, are skipped",
+		RunE:    copyOverrides(client),
+		Short:   "copy overrides from another project",
+		Use:     "copy-overrides",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key to copy overrides into")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(FromFlag, "", "The project key to copy overrides from")
+	_ = cmd.MarkFlagRequired(FromFlag)
+	_ = cmd.Flags().SetAnnotation(FromFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(FromFlag, cmd.Flags().Lookup(FromFlag))
+
+	cmd.Flags().StringSlice(FlagKeysFlag, nil, "Comma separated flag keys to copy overrides for. Along with --flag-key-prefixes, only matching overrides are copied")
+	_ = viper.BindPFlag(FlagKeysFlag, cmd.Flags().Lookup(FlagKeysFlag))
+
+	cmd.Flags().StringSlice(FlagKeyPrefixesFlag, nil, "Comma separated prefixes of flag keys to copy overrides for")
+	_ = viper.BindPFlag(FlagKeyPrefixesFlag, cmd.Flags().Lookup(FlagKeyPrefixesFlag))
+
+	return cmd
+}
+
+func copyOverrides(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		jsonData, err := json.Marshal(model.FlagFilter{
+			Keys:        viper.GetStringSlice(FlagKeysFlag),
+			KeyPrefixes: viper.GetStringSlice(FlagKeyPrefixesFlag),
+		})
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/copy-from/%s", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(FromFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewMirrorOverridesCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "override a project's flags with the values LaunchDarkly serves the project's context in another environment, replacing its overrides for the same flags. Flags whose override is locked are skipped",
+		RunE:    mirrorOverrides(client),
+		Short:   "mirror another environment's flag values as overrides",
+		Use:     "mirror-overrides",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key to override flags in")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.EnvironmentFlag, "", "The environment key to mirror flag values from")
+	_ = cmd.MarkFlagRequired(cliflags.EnvironmentFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.EnvironmentFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.EnvironmentFlag, cmd.Flags().Lookup(cliflags.EnvironmentFlag))
+
+	cmd.Flags().StringSlice(FlagKeysFlag, nil, "Comma separated flag keys to mirror. Along with --flag-key-prefixes and --flag-tags, only matching flags are mirrored")
+	_ = viper.BindPFlag(FlagKeysFlag, cmd.Flags().Lookup(FlagKeysFlag))
+
+	cmd.Flags().StringSlice(FlagKeyPrefixesFlag, nil, "Comma separated prefixes of flag keys to mirror")
+	_ = viper.BindPFlag(FlagKeyPrefixesFlag, cmd.Flags().Lookup(FlagKeyPrefixesFlag))
+
+	cmd.Flags().StringSlice(FlagTagsFlag, nil, "Comma separated tags of flags to mirror")
+	_ = viper.BindPFlag(FlagTagsFlag, cmd.Flags().Lookup(FlagTagsFlag))
 
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
+	return cmd
+}
+
+func mirrorOverrides(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		jsonData, err := json.Marshal(model.FlagFilter{
+			Keys:        viper.GetStringSlice(FlagKeysFlag),
+			KeyPrefixes: viper.GetStringSlice(FlagKeyPrefixesFlag),
+			Tags:        viper.GetStringSlice(FlagTagsFlag),
+		})
+		if err != nil {
+			return err
+		}
 
-		return nil
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/mirror-from/%s", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.EnvironmentFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
 	}
 }
 
@@ -96,18 +384,15 @@ func NewDeleteOverridesCmd(client resources.Client) *cobra.Command {
 func deleteOverrides(client resources.Client) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		path := fmt.Sprintf("%s/dev/projects/%s/overrides", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag))
-		res, err := client.MakeUnauthenticatedRequest(
-			"DELETE",
-			path,
-			nil,
-		)
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", path, nil)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
-
-		return nil
+		return printResponse(cmd, res)
 	}
 }
 
@@ -139,17 +424,73 @@ func NewRemoveOverrideCmd(client resources.Client) *cobra.Command {
 func removeOverride(client resources.Client) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
-		res, err := client.MakeUnauthenticatedRequest(
-			"DELETE",
-			path,
-			nil,
-		)
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", path, nil)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewLockOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "lock the override for a flag so that it can't be changed or removed until it's unlocked, including by syncs and remove-overrides",
+		RunE:    setOverrideLocked(client, "PUT"),
+		Short:   "lock override",
+		Use:     "lock-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	return cmd
+}
 
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
+func NewUnlockOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "unlock the override for a flag so that it can be changed or removed again",
+		RunE:    setOverrideLocked(client, "DELETE"),
+		Short:   "unlock override",
+		Use:     "unlock-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	return cmd
+}
+
+func addProjectAndFlagFlags(cmd *cobra.Command) {
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "The flag key")
+	_ = cmd.MarkFlagRequired(cliflags.FlagFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.FlagFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+}
+
+func setOverrideLocked(client resources.Client, method string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/lock", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, method, path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
 
-		return nil
+		return printResponse(cmd, res)
 	}
 }
@@ -3,6 +3,8 @@ package dev_server
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -10,6 +12,8 @@ import (
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
 	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
 	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/launchdarkly/ldcli/internal/output"
 	"github.com/launchdarkly/ldcli/internal/resources"
 )
@@ -26,6 +30,9 @@ func NewListProjectsCmd(client resources.Client) *cobra.Command {
 
 	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
 
+	cmd.Flags().Bool(IncludeArchivedFlag, false, "Also list archived projects")
+	_ = viper.BindPFlag(IncludeArchivedFlag, cmd.Flags().Lookup(IncludeArchivedFlag))
+
 	return cmd
 }
 
@@ -33,6 +40,9 @@ func listProjects(client resources.Client) func(*cobra.Command, []string) error
 	return func(cmd *cobra.Command, args []string) error {
 
 		path := getDevServerUrl() + "/dev/projects"
+		if viper.GetBool(IncludeArchivedFlag) {
+			path += "?includeArchived=true"
+		}
 		res, err := client.MakeUnauthenticatedRequest(
 			"GET",
 			path,
@@ -42,9 +52,7 @@ func listProjects(client resources.Client) func(*cobra.Command, []string) error
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
 
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
-
-		return nil
+		return printResponse(cmd, res)
 	}
 }
 
@@ -60,7 +68,10 @@ Examples:
 
   # Get project with all data (for import/backup)
   ldcli dev-server get-project --project=my-project \
-    --expand=overrides --expand=availableVariations > backup.json`,
+    --expand=overrides --expand=availableVariations > backup.json
+
+  # Get the values of the first 500 flags
+  ldcli dev-server get-project --project=my-project --limit=500 --fields=value`,
 		RunE:  getProject(client),
 		Short: "get a project",
 		Use:   "get-project",
@@ -73,9 +84,18 @@ Examples:
 	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
 	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
 
-	cmd.Flags().StringSlice("expand", []string{}, "Expand options: overrides, availableVariations")
+	cmd.Flags().StringSlice("expand", []string{}, "Expand options: overrides, availableVariations, syncStatus, metadata")
 	_ = viper.BindPFlag("expand", cmd.Flags().Lookup("expand"))
 
+	cmd.Flags().Int(OffsetFlag, 0, "Skip this many flags, in key order")
+	_ = viper.BindPFlag(OffsetFlag, cmd.Flags().Lookup(OffsetFlag))
+
+	cmd.Flags().Int(LimitFlag, 0, "Only get this many flags, in key order. Defaults to all of them")
+	_ = viper.BindPFlag(LimitFlag, cmd.Flags().Lookup(LimitFlag))
+
+	cmd.Flags().StringSlice(FieldsFlag, nil, "Only get these fields of each flag: value, version, trackEvents, rollout")
+	_ = viper.BindPFlag(FieldsFlag, cmd.Flags().Lookup(FieldsFlag))
+
 	return cmd
 }
 
@@ -96,6 +116,15 @@ func getProject(client resources.Client) func(*cobra.Command, []string) error {
 		if len(expandOptions) > 0 {
 			query["expand"] = expandOptions
 		}
+		if offset := viper.GetInt(OffsetFlag); offset > 0 {
+			query["offset"] = []string{strconv.Itoa(offset)}
+		}
+		if limit := viper.GetInt(LimitFlag); limit > 0 {
+			query["limit"] = []string{strconv.Itoa(limit)}
+		}
+		if fields := viper.GetStringSlice(FieldsFlag); len(fields) > 0 {
+			query["fields"] = []string{strings.Join(fields, ",")}
+		}
 
 		res, err := client.MakeRequest(
 			"",      // no auth token needed for dev server
@@ -109,9 +138,47 @@ func getProject(client resources.Client) func(*cobra.Command, []string) error {
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
+		return printResponse(cmd, res)
+	}
+}
 
-		return nil
+func NewCloneProjectCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long:    "add a project as a linked clone of another. The clone syncs from its base and inherits the base's overrides as they change, beneath any overrides set on the clone itself",
+		RunE:    cloneProject(client),
+		Short:   "add a linked clone of a project",
+		Use:     "clone-project",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The key of the clone")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(FromFlag, "", "The key of the project to clone")
+	_ = cmd.MarkFlagRequired(FromFlag)
+	_ = cmd.Flags().SetAnnotation(FromFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(FromFlag, cmd.Flags().Lookup(FromFlag))
+
+	return cmd
+}
+
+func cloneProject(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/clone-from/%s", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(FromFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
 	}
 }
 
@@ -139,15 +206,14 @@ func syncProject(client resources.Client) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		project := viper.GetString(cliflags.ProjectFlag)
 		path := fmt.Sprintf("%s/dev/projects/%s", getDevServerUrl(), project)
-		_, err := client.MakeUnauthenticatedRequest(
-			"PATCH",
-			path,
-			// An empty body sent to the patch project endpoint = sync project
-			[]byte("{}"),
-		)
+		// An empty body sent to the patch project endpoint = sync project
+		_, sent, err := makeChangeRequest(cmd, client, "PATCH", path, []byte("{}"))
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 		_, err = fmt.Fprintf(cmd.OutOrStdout(), "'%s' project synced successfully\n", project)
 		if err != nil {
 			return err
@@ -181,29 +247,51 @@ func deleteProject(client resources.Client) func(*cobra.Command, []string) error
 	return func(cmd *cobra.Command, args []string) error {
 
 		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag)
-		res, err := client.MakeUnauthenticatedRequest(
-			"DELETE",
-			path,
-			nil,
-		)
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", path, nil)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
-
-		return nil
+		return printResponse(cmd, res)
 	}
 }
 
-func NewAddProjectCmd(client resources.Client) *cobra.Command {
+func NewArchiveProjectCmd(client resources.Client) *cobra.Command {
+	return newProjectActionCmd(
+		client,
+		"archive",
+		"archive the specified project. Archived projects aren't synced or served to SDKs, but keep their overrides and can be unarchived",
+		"archive a project",
+		"archived",
+	)
+}
+
+func NewUnarchiveProjectCmd(client resources.Client) *cobra.Command {
+	return newProjectActionCmd(client, "unarchive", "make the specified archived project available again", "unarchive a project", "unarchived")
+}
+
+func NewPurgeProjectCmd(client resources.Client) *cobra.Command {
+	return newProjectActionCmd(
+		client,
+		"purge",
+		"permanently delete the specified archived project along with its overrides, aliases, and history",
+		"purge an archived project",
+		"purged",
+	)
+}
+
+// newProjectActionCmd builds the command for a POST /dev/projects/{projectKey}/{action} endpoint.
+func newProjectActionCmd(client resources.Client, action, long, short, done string) *cobra.Command {
 	cmd := &cobra.Command{
 		GroupID: "projects",
 		Args:    validators.Validate(),
-		Long:    "Add the project to the dev server",
-		RunE:    addProject(client),
-		Short:   "add a project",
-		Use:     "add-project",
+		Long:    long,
+		RunE:    projectAction(client, action, done),
+		Short:   short,
+		Use:     action + "-project",
 	}
 
 	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
@@ -213,27 +301,151 @@ func NewAddProjectCmd(client resources.Client) *cobra.Command {
 	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
 	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
 
-	cmd.Flags().String(SourceEnvironmentFlag, "", "The environment key to copy flag values from")
-	_ = cmd.MarkFlagRequired(SourceEnvironmentFlag)
-	_ = cmd.Flags().SetAnnotation(SourceEnvironmentFlag, "required", []string{"true"})
+	return cmd
+}
+
+func projectAction(client resources.Client, action, done string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		project := viper.GetString(cliflags.ProjectFlag)
+		path := fmt.Sprintf("%s/dev/projects/%s/%s", getDevServerUrl(), project, action)
+		_, sent, err := makeChangeRequest(cmd, client, "POST", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "'%s' project %s successfully\n", project, done)
+		return err
+	}
+}
+
+func NewAddProjectCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `Add the project to the dev server. Without --project, the project declared in the .ldcli.yaml of the current
+directory or the closest parent with one is added, along with its source environment, context, overrides, and SDK keys
+
+Examples:
+  # Add the project a repository's .ldcli.yaml declares, as everyone working on it does
+  ldcli dev-server add-project
+
+  # Add a project that copies its flag values from the test environment
+  ldcli dev-server add-project --project=my-project --source=test`,
+		RunE:  addProject(client),
+		Short: "add a project",
+		Use:   "add-project",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key. Defaults to the project in .ldcli.yaml")
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(SourceEnvironmentFlag, "", "The environment key to copy flag values from. Defaults to the source in .ldcli.yaml")
 	_ = viper.BindPFlag(SourceEnvironmentFlag, cmd.Flags().Lookup(SourceEnvironmentFlag))
 
 	cmd.Flags().String(ContextFlag, "", `Stringified JSON representation of your context object ex. {"user": { "email": "youremail@gmail.com", "username": "foo", "key": "bar"}}`)
 	_ = viper.BindPFlag(ContextFlag, cmd.Flags().Lookup(ContextFlag))
+	cmd.Flags().String(ContextFileFlag, "", "Path to a JSON file with your context object, instead of --context")
+	_ = viper.BindPFlag(ContextFileFlag, cmd.Flags().Lookup(ContextFileFlag))
+	cmd.Flags().Bool(cliflags.TemplateFlag, false, cliflags.TemplateFlagDescription)
+	_ = viper.BindPFlag(cliflags.TemplateFlag, cmd.Flags().Lookup(cliflags.TemplateFlag))
+
+	cmd.Flags().Bool(PrefetchKeysFlag, false, prefetchKeysHelp)
+	_ = viper.BindPFlag(PrefetchKeysFlag, cmd.Flags().Lookup(PrefetchKeysFlag))
+
+	addFlagFilterFlags(cmd)
 
 	return cmd
 }
 
+const prefetchKeysHelp = "Also fetch and cache the keys for every environment of the project, so switching the source environment later is instant and works offline. Costs extra API calls"
+
+// addFlagFilterFlags adds the flags that limit which of a project's flags are synced. A flag is synced if it matches
+// any of them.
+func addFlagFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagKeysFlag, nil, "Comma separated flag keys to sync. Along with the other flag filters, only matching flags are synced and served")
+	_ = viper.BindPFlag(FlagKeysFlag, cmd.Flags().Lookup(FlagKeysFlag))
+
+	cmd.Flags().StringSlice(FlagKeyPrefixesFlag, nil, "Comma separated prefixes of flag keys to sync")
+	_ = viper.BindPFlag(FlagKeyPrefixesFlag, cmd.Flags().Lookup(FlagKeyPrefixesFlag))
+
+	cmd.Flags().StringSlice(FlagTagsFlag, nil, "Comma separated tags of flags to sync")
+	_ = viper.BindPFlag(FlagTagsFlag, cmd.Flags().Lookup(FlagTagsFlag))
+}
+
+// getFlagFilterInput returns the flag filter given on the command line, and whether any filter flag was set. Setting
+// any of them replaces the project's whole filter, so e.g. `--flag-keys=` alone clears it.
+func getFlagFilterInput() (model.FlagFilter, bool) {
+	isSet := viper.IsSet(FlagKeysFlag) || viper.IsSet(FlagKeyPrefixesFlag) || viper.IsSet(FlagTagsFlag)
+	return model.FlagFilter{
+		Keys:        viper.GetStringSlice(FlagKeysFlag),
+		KeyPrefixes: viper.GetStringSlice(FlagKeyPrefixesFlag),
+		Tags:        viper.GetStringSlice(FlagTagsFlag),
+	}, isSet
+}
+
 type postBody struct {
-	SourceEnvironmentKey string          `json:"sourceEnvironmentKey"`
-	Context              json.RawMessage `json:"context,omitempty"`
+	SourceEnvironmentKey string            `json:"sourceEnvironmentKey"`
+	Context              json.RawMessage   `json:"context,omitempty"`
+	PrefetchKeys         bool              `json:"prefetchKeys,omitempty"`
+	FlagFilter           *model.FlagFilter `json:"flagFilter,omitempty"`
+}
+
+// repoProjectConfig returns the .ldcli.yaml closest to the working directory if it declares projectKey, or any project
+// when projectKey is empty, and whether there is one.
+func repoProjectConfig(projectKey string) (dev_server.RepoConfig, bool, error) {
+	repoConfig, path, err := dev_server.FindRepoConfig(".")
+	if err != nil || path == "" {
+		return dev_server.RepoConfig{}, false, err
+	}
+	if projectKey != "" && projectKey != repoConfig.Project {
+		return dev_server.RepoConfig{}, false, nil
+	}
+	return repoConfig, true, nil
 }
 
 func addProject(client resources.Client) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		body := postBody{SourceEnvironmentKey: viper.GetString("source")}
-		if viper.IsSet("context") {
-			body.Context = json.RawMessage(viper.GetString("context"))
+		projectKey := viper.GetString(cliflags.ProjectFlag)
+		repoConfig, hasRepoConfig, err := repoProjectConfig(projectKey)
+		if err != nil {
+			return err
+		}
+		if projectKey == "" {
+			if !hasRepoConfig {
+				return fmt.Errorf("--%s is required without a %s declaring the project", cliflags.ProjectFlag, dev_server.RepoConfigFileName)
+			}
+			projectKey = repoConfig.Project
+		}
+
+		body := postBody{
+			SourceEnvironmentKey: viper.GetString(SourceEnvironmentFlag),
+			PrefetchKeys:         viper.GetBool(PrefetchKeysFlag),
+		}
+		if body.SourceEnvironmentKey == "" {
+			body.SourceEnvironmentKey = repoConfig.Source
+		}
+		if body.SourceEnvironmentKey == "" {
+			return fmt.Errorf("--%s is required without a source in %s", SourceEnvironmentFlag, dev_server.RepoConfigFileName)
+		}
+		contextString, hasContext, err := getContextInput()
+		if err != nil {
+			return err
+		}
+		switch {
+		case hasContext:
+			body.Context = json.RawMessage(contextString)
+		case repoConfig.Context != nil:
+			body.Context, err = json.Marshal(repoConfig.Context)
+			if err != nil {
+				return err
+			}
+		}
+		if flagFilter, hasFlagFilter := getFlagFilterInput(); hasFlagFilter {
+			body.FlagFilter = &flagFilter
 		}
 
 		jsonData, err := json.Marshal(body)
@@ -241,20 +453,44 @@ func addProject(client resources.Client) func(*cobra.Command, []string) error {
 			return err
 		}
 
-		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag)
-		res, err := client.MakeUnauthenticatedRequest(
-			"POST",
-			path,
-			jsonData,
-		)
+		path := getDevServerUrl() + "/dev/projects/" + projectKey
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, jsonData)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if err := applyRepoConfig(cmd, client, projectKey, repoConfig); err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
 
-		fmt.Fprint(cmd.OutOrStdout(), string(res))
+		return printResponse(cmd, res)
+	}
+}
 
-		return nil
+// applyRepoConfig sets the overrides and maps the SDK keys that a .ldcli.yaml declares for a project that's been added.
+// With --dry-run, the requests are printed instead.
+func applyRepoConfig(cmd *cobra.Command, client resources.Client, projectKey string, repoConfig dev_server.RepoConfig) error {
+	for flagKey, value := range repoConfig.Overrides {
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s", getDevServerUrl(), projectKey, flagKey)
+		if _, _, err := makeChangeRequest(cmd, client, "PUT", path, []byte(value.JSONString())); err != nil {
+			return err
+		}
+	}
+	for _, sdkKey := range repoConfig.SdkKeys {
+		body, err := json.Marshal(map[string]string{
+			"alias":      sdkKey,
+			"projectKey": projectKey,
+		})
+		if err != nil {
+			return err
+		}
+		if _, _, err := makeChangeRequest(cmd, client, "POST", getDevServerUrl()+"/dev/aliases", body); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func NewUpdateProjectCmd(client resources.Client) *cobra.Command {
@@ -279,6 +515,12 @@ func NewUpdateProjectCmd(client resources.Client) *cobra.Command {
 
 	cmd.Flags().String(ContextFlag, "", `Stringified JSON representation of your context object ex. {"user": { "email": "test@gmail.com", "username": "foo", "key": "bar"}}`)
 	_ = viper.BindPFlag(ContextFlag, cmd.Flags().Lookup(ContextFlag))
+	cmd.Flags().String(ContextFileFlag, "", "Path to a JSON file with your context object, instead of --context")
+	_ = viper.BindPFlag(ContextFileFlag, cmd.Flags().Lookup(ContextFileFlag))
+	cmd.Flags().Bool(cliflags.TemplateFlag, false, cliflags.TemplateFlagDescription)
+	_ = viper.BindPFlag(cliflags.TemplateFlag, cmd.Flags().Lookup(cliflags.TemplateFlag))
+
+	addFlagFilterFlags(cmd)
 
 	return cmd
 }
@@ -286,6 +528,7 @@ func NewUpdateProjectCmd(client resources.Client) *cobra.Command {
 type patchBody struct {
 	SourceEnvironmentKey string                 `json:"sourceEnvironmentKey,omitempty"`
 	Context              map[string]interface{} `json:"context,omitempty"`
+	FlagFilter           *model.FlagFilter      `json:"flagFilter,omitempty"`
 }
 
 type patchResponse struct {
@@ -296,9 +539,12 @@ type patchResponse struct {
 func updateProject(client resources.Client) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		body := patchBody{}
-		if viper.IsSet(ContextFlag) {
-			contextString := viper.GetString(ContextFlag)
-			err := json.Unmarshal([]byte(contextString), &body.Context)
+		contextString, hasContext, err := getContextInput()
+		if err != nil {
+			return err
+		}
+		if hasContext {
+			err = json.Unmarshal([]byte(contextString), &body.Context)
 			if err != nil {
 				return err
 			}
@@ -307,6 +553,10 @@ func updateProject(client resources.Client) func(*cobra.Command, []string) error
 		if viper.IsSet(SourceEnvironmentFlag) {
 			body.SourceEnvironmentKey = viper.GetString(SourceEnvironmentFlag)
 		}
+		flagFilter, hasFlagFilter := getFlagFilterInput()
+		if hasFlagFilter {
+			body.FlagFilter = &flagFilter
+		}
 
 		jsonData, err := json.Marshal(body)
 		if err != nil {
@@ -314,14 +564,13 @@ func updateProject(client resources.Client) func(*cobra.Command, []string) error
 		}
 
 		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag)
-		res, err := client.MakeUnauthenticatedRequest(
-			"PATCH",
-			path,
-			jsonData,
-		)
+		res, sent, err := makeChangeRequest(cmd, client, "PATCH", path, jsonData)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 
 		var response patchResponse
 		err = json.Unmarshal(res, &response)
@@ -330,12 +579,12 @@ func updateProject(client resources.Client) func(*cobra.Command, []string) error
 		}
 
 		switch true {
-		case !viper.IsSet(ContextFlag) && !viper.IsSet(SourceEnvironmentFlag):
+		case !hasContext && !viper.IsSet(SourceEnvironmentFlag) && !hasFlagFilter:
 			fmt.Fprint(cmd.OutOrStdout(), "No input given, project synced successfully\n")
 		case viper.IsSet(SourceEnvironmentFlag):
 			fmt.Fprintf(cmd.OutOrStdout(), "Source environment updated successfully to '%s'\n", response.SourceEnvironmentKey)
 			fallthrough
-		case viper.IsSet(ContextFlag):
+		case hasContext:
 			// pretty print context
 			context, err := json.MarshalIndent(response.Context, "", "  ")
 			if err != nil {
@@ -0,0 +1,248 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// NewProjectsCmd groups the project commands under `projects`, with listings that show how each project is doing
+// instead of the dev server's raw responses.
+func NewProjectsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Long:    "manage the dev server's projects, showing their source environment, last sync, overrides and staleness",
+		Short:   "manage projects",
+		Use:     "projects",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	cmd.AddCommand(newProjectsListCmd(client))
+	cmd.AddCommand(newProjectsGetCmd(client))
+	cmd.AddCommand(newProjectsRemoveCmd(client))
+	cmd.AddCommand(newProjectsUpdateCmd(client))
+
+	return cmd
+}
+
+func newProjectsListCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `list the dev server's projects with their source environment, when they were last synced, how many of their
+flags are overridden, and whether they're stale
+
+Examples:
+  # Find the projects that need a sync
+  ldcli dev-server projects list
+
+  # Export the projects to a spreadsheet
+  ldcli dev-server projects list --output=csv --columns=key,sourceEnvironmentKey,overrides > projects.csv`,
+		RunE:  listProjectRows(client),
+		Short: "list projects",
+		Use:   "list",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().Bool(IncludeArchivedFlag, false, "Also list archived projects")
+	_ = viper.BindPFlag(IncludeArchivedFlag, cmd.Flags().Lookup(IncludeArchivedFlag))
+
+	resourcescmd.AddColumnsFlag(cmd)
+
+	return cmd
+}
+
+func newProjectsGetCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  validators.Validate(),
+		Long:  "show a project's source environment, when it was last synced, how many of its flags are overridden, and whether it's stale",
+		RunE:  getProjectRow(client),
+		Short: "show a project",
+		Use:   "get",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func newProjectsRemoveCmd(client resources.Client) *cobra.Command {
+	cmd := NewRemoveProjectCmd(client)
+	cmd.GroupID = ""
+	cmd.Use = "remove"
+	return cmd
+}
+
+func newProjectsUpdateCmd(client resources.Client) *cobra.Command {
+	cmd := NewUpdateProjectCmd(client)
+	cmd.GroupID = ""
+	cmd.Use = "update"
+	return cmd
+}
+
+// projectRow is how a project is shown by `projects list` and `projects get`.
+type projectRow struct {
+	Key                  string    `json:"key"`
+	SourceEnvironmentKey string    `json:"sourceEnvironmentKey"`
+	LastSyncedAt         time.Time `json:"lastSyncedAt"`
+	Flags                int       `json:"flags"`
+	Overrides            int       `json:"overrides"`
+	StaleSeconds         int       `json:"staleSeconds"`
+	Stale                bool      `json:"stale"`
+	Orphaned             bool      `json:"orphaned"`
+	Archived             bool      `json:"archived"`
+}
+
+func (r projectRow) status() string {
+	switch {
+	case r.Archived:
+		return "archived"
+	case r.Orphaned:
+		return "orphaned"
+	case r.Stale:
+		return "stale"
+	default:
+		return "ok"
+	}
+}
+
+// getProjectRowData puts together a project's row from its configuration, its summary and its status.
+func getProjectRowData(client resources.Client, projectKey string) (projectRow, error) {
+	path := getDevServerUrl() + "/dev/projects/" + projectKey
+	var project struct {
+		SourceEnvironmentKey string `json:"sourceEnvironmentKey"`
+		LastSyncedFromSource int64  `json:"_lastSyncedFromSource"`
+		ArchivedAt           *int64 `json:"_archivedAt"`
+	}
+	var summary struct {
+		Flags        int  `json:"flags"`
+		Overrides    int  `json:"overrides"`
+		StaleSeconds int  `json:"staleSeconds"`
+		Orphaned     bool `json:"orphaned"`
+	}
+	var status struct {
+		Stale bool `json:"stale"`
+	}
+	for _, request := range []struct {
+		path     string
+		response interface{}
+	}{
+		{path, &project},
+		{path + "/summary", &summary},
+		{path + "/status", &status},
+	} {
+		res, err := client.MakeUnauthenticatedRequest("GET", request.path, nil)
+		if err != nil {
+			return projectRow{}, err
+		}
+		if err := json.Unmarshal(res, request.response); err != nil {
+			return projectRow{}, err
+		}
+	}
+	return projectRow{
+		Key:                  projectKey,
+		SourceEnvironmentKey: project.SourceEnvironmentKey,
+		LastSyncedAt:         time.Unix(project.LastSyncedFromSource, 0),
+		Flags:                summary.Flags,
+		Overrides:            summary.Overrides,
+		StaleSeconds:         summary.StaleSeconds,
+		Stale:                status.Stale,
+		Orphaned:             summary.Orphaned,
+		Archived:             project.ArchivedAt != nil,
+	}, nil
+}
+
+func listProjectRows(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/projects"
+		if viper.GetBool(IncludeArchivedFlag) {
+			path += "?includeArchived=true"
+		}
+		res, err := client.MakeUnauthenticatedRequest("GET", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		var projectKeys []string
+		if err := json.Unmarshal(res, &projectKeys); err != nil {
+			return err
+		}
+
+		rows := make([]projectRow, 0, len(projectKeys))
+		for _, projectKey := range projectKeys {
+			row, err := getProjectRowData(client, projectKey)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			rows = append(rows, row)
+		}
+
+		if isStructuredOutput() {
+			data, err := json.Marshal(rows)
+			if err != nil {
+				return err
+			}
+			return printData(cmd, data)
+		}
+		if output.IsCSV(viper.GetString(cliflags.OutputFlag)) {
+			data, err := json.Marshal(rows)
+			if err != nil {
+				return err
+			}
+			out, err := output.CSV(data, viper.GetStringSlice(cliflags.ColumnsFlag))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), out)
+			return nil
+		}
+		if len(rows) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No projects have been added to the dev server")
+			return nil
+		}
+		return writeProjectTable(cmd.OutOrStdout(), rows)
+	}
+}
+
+func getProjectRow(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		row, err := getProjectRowData(client, viper.GetString(cliflags.ProjectFlag))
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		if isStructuredOutput() {
+			data, err := json.Marshal(row)
+			if err != nil {
+				return err
+			}
+			return printData(cmd, data)
+		}
+		return writeProjectTable(cmd.OutOrStdout(), []projectRow{row})
+	}
+}
+
+func writeProjectTable(out io.Writer, rows []projectRow) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tSOURCE\tLAST SYNCED\tFLAGS\tOVERRIDES\tSTATUS")
+	for _, row := range rows {
+		lastSynced := fmt.Sprintf("%s (%s ago)", row.LastSyncedAt.Local().Format(time.DateTime), time.Duration(row.StaleSeconds)*time.Second)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", row.Key, row.SourceEnvironmentKey, lastSynced, row.Flags, row.Overrides, row.status())
+	}
+	return w.Flush()
+}
@@ -0,0 +1,35 @@
+package dev_server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteProjectTable(t *testing.T) {
+	syncedAt := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	var out bytes.Buffer
+	require.NoError(t, writeProjectTable(&out, []projectRow{
+		{Key: "frontend", SourceEnvironmentKey: "test", LastSyncedAt: syncedAt, Flags: 12, Overrides: 3, StaleSeconds: 90},
+		{Key: "billing", SourceEnvironmentKey: "production", LastSyncedAt: syncedAt, Flags: 4, StaleSeconds: 7200, Stale: true},
+	}))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"KEY", "SOURCE", "LAST", "SYNCED", "FLAGS", "OVERRIDES", "STATUS"}, strings.Fields(lines[0]))
+	synced := syncedAt.Local().Format(time.DateTime)
+	assert.Equal(t, strings.Fields("frontend test "+synced+" (1m30s ago) 12 3 ok"), strings.Fields(lines[1]))
+	assert.Equal(t, strings.Fields("billing production "+synced+" (2h0m0s ago) 4 0 stale"), strings.Fields(lines[2]))
+	assert.Equal(t, strings.Index(lines[0], "FLAGS"), strings.Index(lines[1], "12"), "columns are aligned")
+}
+
+func TestProjectRowStatus(t *testing.T) {
+	assert.Equal(t, "ok", projectRow{}.status())
+	assert.Equal(t, "stale", projectRow{Stale: true}.status())
+	assert.Equal(t, "orphaned", projectRow{Stale: true, Orphaned: true}.status())
+	assert.Equal(t, "archived", projectRow{Orphaned: true, Archived: true}.status())
+}
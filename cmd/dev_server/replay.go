@@ -0,0 +1,233 @@
+package dev_server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/diff"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewReplayCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "events",
+		Args:    validators.Validate(),
+		Long: `evaluate the flags in a recording of SDK evaluations again, and exit with an error if any of them get a
+different value than they did when they were recorded. Record evaluations by starting the server with
+--record-evaluations. Evaluations SDKs only reported a count of, without the context, can't be replayed
+
+Examples:
+  # Check a change to overrides doesn't change what your app was served
+  ldcli dev-server start --record-evaluations=evaluations.jsonl
+  ldcli dev-server replay --recording=evaluations.jsonl
+
+  # Compare what another project serves the same contexts
+  ldcli dev-server replay --recording=evaluations.jsonl --project=staging-copy`,
+		RunE:  replayEvaluations(client),
+		Short: "replay recorded evaluations and diff the results",
+		Use:   "replay",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(RecordingFlag, "", "Path of the file evaluations were recorded to")
+	_ = cmd.MarkFlagRequired(RecordingFlag)
+	_ = cmd.Flags().SetAnnotation(RecordingFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(RecordingFlag, cmd.Flags().Lookup(RecordingFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "Replay every evaluation against this project instead of the one it was recorded for")
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+// replayedEvaluation is a recorded evaluation whose flag now has a different value.
+type replayedEvaluation struct {
+	ProjectKey string             `json:"projectKey"`
+	FlagKey    string             `json:"flagKey"`
+	Context    *ldcontext.Context `json:"context"`
+	Recorded   ldvalue.Value      `json:"recorded"`
+	Replayed   ldvalue.Value      `json:"replayed"`
+	// Missing is set when the project no longer has the flag.
+	Missing bool `json:"missing,omitempty"`
+}
+
+type replayResult struct {
+	Replayed    int
+	Skipped     int
+	Differences []replayedEvaluation
+}
+
+func replayEvaluations(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		recorded, err := readRecording(viper.GetString(RecordingFlag))
+		if err != nil {
+			return err
+		}
+
+		evaluate := func(projectKey string, ldCtx ldcontext.Context) (map[string]ldvalue.Value, error) {
+			data, err := json.Marshal(ldCtx)
+			if err != nil {
+				return nil, err
+			}
+			// the client-side SDK endpoint evaluates flags for a context the same way SDKs see them
+			path := fmt.Sprintf("%s/sdk/evalx/%s/contexts", getDevServerUrl(), projectKey)
+			res, err := client.MakeUnauthenticatedRequest("REPORT", path, data)
+			if err != nil {
+				return nil, output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			var flags map[string]struct {
+				Value ldvalue.Value `json:"value"`
+			}
+			if err := json.Unmarshal(res, &flags); err != nil {
+				return nil, err
+			}
+			values := make(map[string]ldvalue.Value, len(flags))
+			for flagKey, flag := range flags {
+				values[flagKey] = flag.Value
+			}
+			return values, nil
+		}
+		result, err := replay(recorded, viper.GetString(cliflags.ProjectFlag), evaluate)
+		if err != nil {
+			return err
+		}
+
+		d, err := result.diff()
+		if err != nil {
+			return err
+		}
+		if isStructuredOutput() {
+			if err := printData(cmd, []byte(d.JSON())); err != nil {
+				return err
+			}
+		} else {
+			d.Render(cmd.OutOrStdout(), diff.ColorEnabled(cmd.OutOrStdout()))
+			fmt.Fprintf(cmd.OutOrStdout(), "Replayed %d evaluations, %d differed, %d skipped without a context\n", result.Replayed, len(result.Differences), result.Skipped)
+		}
+		if len(result.Differences) > 0 {
+			return errs.NewExitError(fmt.Errorf("%d evaluations differed from the recording", len(result.Differences)), exitCodeAssertionFailed)
+		}
+		return nil
+	}
+}
+
+// diff compares the values the evaluations that differ were recorded with to the ones they got when they were
+// replayed, at paths like /<project>/<context>/<flag>, where the context is its fully qualified key. Evaluations whose
+// flag is missing from the project now are removed.
+func (r replayResult) diff() (diff.Diff, error) {
+	recorded := make(map[string]map[string]map[string]ldvalue.Value)
+	replayed := make(map[string]map[string]map[string]ldvalue.Value)
+	// flagValues are the values of the flags for the difference's project and context
+	flagValues := func(values map[string]map[string]map[string]ldvalue.Value, difference replayedEvaluation) map[string]ldvalue.Value {
+		if values[difference.ProjectKey] == nil {
+			values[difference.ProjectKey] = make(map[string]map[string]ldvalue.Value)
+		}
+		contextKey := difference.Context.FullyQualifiedKey()
+		if values[difference.ProjectKey][contextKey] == nil {
+			values[difference.ProjectKey][contextKey] = make(map[string]ldvalue.Value)
+		}
+		return values[difference.ProjectKey][contextKey]
+	}
+	for _, difference := range r.Differences {
+		flagValues(recorded, difference)[difference.FlagKey] = difference.Recorded
+		replayedValues := flagValues(replayed, difference)
+		if !difference.Missing {
+			replayedValues[difference.FlagKey] = difference.Replayed
+		}
+	}
+	return diff.Compare("recording", recorded, "replay", replayed)
+}
+
+func readRecording(path string) ([]model.RecordedEvaluation, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var recorded []model.RecordedEvaluation
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 10*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var evaluation model.RecordedEvaluation
+		if err := json.Unmarshal(scanner.Bytes(), &evaluation); err != nil {
+			return nil, fmt.Errorf("invalid evaluation on line %d of %s: %w", line, path, err)
+		}
+		recorded = append(recorded, evaluation)
+	}
+	return recorded, scanner.Err()
+}
+
+// replay evaluates each distinct recorded evaluation again with evaluate, which is called once for each project and
+// context, and returns the ones that get a different value. Evaluations are replayed against projectKey if it's set.
+func replay(recorded []model.RecordedEvaluation, projectKey string, evaluate func(string, ldcontext.Context) (map[string]ldvalue.Value, error)) (replayResult, error) {
+	var result replayResult
+	evaluated := make(map[string]map[string]ldvalue.Value)
+	seen := make(map[string]bool)
+	for _, evaluation := range recorded {
+		if evaluation.Context == nil {
+			result.Skipped++
+			continue
+		}
+		if projectKey != "" {
+			evaluation.ProjectKey = projectKey
+		}
+		contextKey := evaluation.ProjectKey + "\x00" + evaluation.Context.String()
+		evaluationKey := contextKey + "\x00" + evaluation.FlagKey + "\x00" + evaluation.Value.JSONString()
+		if seen[evaluationKey] {
+			continue
+		}
+		seen[evaluationKey] = true
+
+		values, ok := evaluated[contextKey]
+		if !ok {
+			var err error
+			values, err = evaluate(evaluation.ProjectKey, *evaluation.Context)
+			if err != nil {
+				return replayResult{}, err
+			}
+			evaluated[contextKey] = values
+		}
+		result.Replayed++
+
+		value, ok := values[evaluation.FlagKey]
+		switch {
+		case !ok && evaluation.Source == model.EvaluationSourceFallback:
+			// the project still doesn't have the flag, so the SDK would fall back to its default again
+		case !ok:
+			result.Differences = append(result.Differences, replayedEvaluation{
+				ProjectKey: evaluation.ProjectKey,
+				FlagKey:    evaluation.FlagKey,
+				Context:    evaluation.Context,
+				Recorded:   evaluation.Value,
+				Missing:    true,
+			})
+		case !value.Equal(evaluation.Value):
+			result.Differences = append(result.Differences, replayedEvaluation{
+				ProjectKey: evaluation.ProjectKey,
+				FlagKey:    evaluation.FlagKey,
+				Context:    evaluation.Context,
+				Recorded:   evaluation.Value,
+				Replayed:   value,
+			})
+		}
+	}
+	return result, nil
+}
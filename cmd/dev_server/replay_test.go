@@ -0,0 +1,68 @@
+package dev_server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/diff"
+)
+
+func TestReplay(t *testing.T) {
+	alice, bob := ldcontext.New("alice"), ldcontext.New("bob")
+	recorded := []model.RecordedEvaluation{
+		{ProjectKey: "proj", FlagKey: "new-checkout", Context: &alice, Value: ldvalue.Bool(true), Source: model.EvaluationSourceOverride},
+		{ProjectKey: "proj", FlagKey: "banner", Context: &alice, Value: ldvalue.String("hi"), Source: model.EvaluationSourceCloud},
+		{ProjectKey: "proj", FlagKey: "new-checkout", Context: &alice, Value: ldvalue.Bool(true), Source: model.EvaluationSourceOverride},
+		{ProjectKey: "proj", FlagKey: "new-checkout", Context: &bob, Value: ldvalue.Bool(false), Source: model.EvaluationSourceCloud},
+		{ProjectKey: "proj", FlagKey: "removed", Context: &bob, Value: ldvalue.Int(1), Source: model.EvaluationSourceCloud},
+		{ProjectKey: "proj", FlagKey: "unknown", Context: &bob, Value: ldvalue.Null(), Source: model.EvaluationSourceFallback},
+		{ProjectKey: "proj", FlagKey: "banner", Value: ldvalue.String("hi"), Source: model.EvaluationSourceCloud, Count: 4},
+	}
+	var calls []string
+	evaluate := func(projectKey string, ldCtx ldcontext.Context) (map[string]ldvalue.Value, error) {
+		calls = append(calls, projectKey+"/"+ldCtx.Key())
+		return map[string]ldvalue.Value{
+			"new-checkout": ldvalue.Bool(false),
+			"banner":       ldvalue.String("hi"),
+		}, nil
+	}
+
+	t.Run("reports evaluations that get a different value, evaluating each context once", func(t *testing.T) {
+		calls = nil
+		result, err := replay(recorded, "", evaluate)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"proj/alice", "proj/bob"}, calls)
+		assert.Equal(t, 5, result.Replayed)
+		assert.Equal(t, 1, result.Skipped)
+		assert.Equal(t, []replayedEvaluation{
+			{ProjectKey: "proj", FlagKey: "new-checkout", Context: &alice, Recorded: ldvalue.Bool(true), Replayed: ldvalue.Bool(false)},
+			{ProjectKey: "proj", FlagKey: "removed", Context: &bob, Recorded: ldvalue.Int(1), Missing: true},
+		}, result.Differences)
+	})
+
+	t.Run("replays against another project", func(t *testing.T) {
+		calls = nil
+		_, err := replay(recorded, "other", evaluate)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other/alice", "other/bob"}, calls)
+	})
+
+	t.Run("diffs the recorded and replayed values", func(t *testing.T) {
+		result, err := replay(recorded, "", evaluate)
+		require.NoError(t, err)
+
+		d, err := result.diff()
+		require.NoError(t, err)
+		assert.Equal(t, "recording", d.From)
+		assert.Equal(t, "replay", d.To)
+		assert.Equal(t, []diff.Change{{Path: "/proj/alice/new-checkout", From: true, To: false}}, d.Changed)
+		assert.Equal(t, []diff.Value{{Path: "/proj/bob/removed", Value: float64(1)}}, d.Removed)
+		assert.Empty(t, d.Added)
+	})
+}
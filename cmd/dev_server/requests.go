@@ -0,0 +1,134 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// requestsPollInterval is how often `requests tail --follow` asks the dev server for new evaluations.
+const requestsPollInterval = time.Second
+
+func NewRequestsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "events",
+		Long:    "inspect the flag evaluations that SDKs have reported to the dev server in their events",
+		Short:   "inspect SDK evaluations",
+		Use:     "requests",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	cmd.AddCommand(NewRequestsTailCmd(client))
+
+	return cmd
+}
+
+func NewRequestsTailCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `print the most recent flag evaluations SDKs reported for a project, with the context, the value served, and
+whether it came from an override, from LaunchDarkly, or was the SDK's fallback value for a flag the project doesn't
+have. Then keep printing new ones as they arrive. Evaluations are reported in SDK events, so SDKs need to send events to
+the dev server
+
+Examples:
+  # Check which value your app gets for a flag
+  ldcli dev-server requests tail --project=my-project --flag=new-checkout`,
+		RunE:  tailRequests(client),
+		Short: "tail SDK evaluations",
+		Use:   "tail",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(cliflags.FlagFlag, "", "Only show evaluations of this flag")
+	_ = viper.BindPFlag(cliflags.FlagFlag, cmd.Flags().Lookup(cliflags.FlagFlag))
+
+	cmd.Flags().Bool(FollowFlag, true, "Keep printing new evaluations as they arrive")
+	_ = viper.BindPFlag(FollowFlag, cmd.Flags().Lookup(FollowFlag))
+
+	return cmd
+}
+
+type evaluationRequest struct {
+	Id      int64           `json:"id"`
+	Time    time.Time       `json:"time"`
+	FlagKey string          `json:"flagKey"`
+	Context json.RawMessage `json:"context,omitempty"`
+	Value   json.RawMessage `json:"value"`
+	Source  string          `json:"source"`
+	Count   int64           `json:"count"`
+}
+
+type evaluationRequestsResponse struct {
+	Requests []evaluationRequest `json:"requests"`
+}
+
+func tailRequests(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag) + "/requests"
+		var after int64
+		for {
+			query := url.Values{}
+			if after > 0 {
+				query.Set("after", strconv.FormatInt(after, 10))
+			}
+			if viper.IsSet(cliflags.FlagFlag) {
+				query.Set("flagKey", viper.GetString(cliflags.FlagFlag))
+			}
+
+			res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			var response evaluationRequestsResponse
+			err = json.Unmarshal(res, &response)
+			if err != nil {
+				return err
+			}
+
+			for _, request := range response.Requests {
+				after = request.Id
+				if isStructuredOutput() {
+					data, err := json.Marshal(request)
+					if err != nil {
+						return err
+					}
+					if err := printStreamedData(cmd, data); err != nil {
+						return err
+					}
+					continue
+				}
+				line := fmt.Sprintf("%s [%s] %s = %s", request.Time.Format(time.TimeOnly), request.Source, request.FlagKey, string(request.Value))
+				if len(request.Context) > 0 {
+					line += " for " + string(request.Context)
+				}
+				if request.Count > 1 {
+					line += fmt.Sprintf(" (%d times)", request.Count)
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), line)
+			}
+
+			if !viper.GetBool(FollowFlag) {
+				return nil
+			}
+			time.Sleep(requestsPollInterval)
+		}
+	}
+}
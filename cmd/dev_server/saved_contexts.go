@@ -0,0 +1,146 @@
+package dev_server
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewListContextsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long:    "list the named contexts saved for a project",
+		RunE:    listContexts(client),
+		Short:   "list saved contexts",
+		Use:     "list-contexts",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func listContexts(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag) + "/contexts"
+		res, err := client.MakeUnauthenticatedRequest("GET", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewSaveContextCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `save a context under a name, replacing the context saved under the name before. Client-side SDKs and flag
+explanations evaluate a saved context in place of the one they were given when it's named with the X-LD-Saved-Context
+header or the savedContext query parameter
+
+Examples:
+  # Save a persona that the whole team can evaluate flags for
+  ldcli dev-server save-context --project=frontend --name=beta-tester --context='{"kind":"user","key":"beta","beta":true}'`,
+		RunE:  saveContext(client),
+		Short: "save a named context",
+		Use:   "save-context",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	addSavedContextFlags(cmd)
+
+	cmd.Flags().String(ContextFlag, "", `Stringified JSON representation of the context ex. {"kind": "user", "key": "beta"}`)
+	_ = viper.BindPFlag(ContextFlag, cmd.Flags().Lookup(ContextFlag))
+	cmd.Flags().String(ContextFileFlag, "", "Path to a JSON file with the context, instead of --context")
+	_ = viper.BindPFlag(ContextFileFlag, cmd.Flags().Lookup(ContextFileFlag))
+	cmd.Flags().Bool(cliflags.TemplateFlag, false, cliflags.TemplateFlagDescription)
+	_ = viper.BindPFlag(cliflags.TemplateFlag, cmd.Flags().Lookup(cliflags.TemplateFlag))
+
+	return cmd
+}
+
+func saveContext(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		contextJSON, hasContext, err := getContextInput()
+		if err != nil {
+			return err
+		}
+		if !hasContext {
+			return fmt.Errorf("one of --%s and --%s must be set", ContextFlag, ContextFileFlag)
+		}
+
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", savedContextPath(), []byte(contextJSON))
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewDeleteContextCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long:    "delete a context saved for a project",
+		RunE:    deleteContext(client),
+		Short:   "delete a saved context",
+		Use:     "delete-context",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	addSavedContextFlags(cmd)
+
+	return cmd
+}
+
+func deleteContext(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", savedContextPath(), nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func savedContextPath() string {
+	return getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag) + "/contexts/" + url.PathEscape(viper.GetString(NameFlag))
+}
+
+func addSavedContextFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(NameFlag, "", "The name the context is saved under, such as beta-tester")
+	_ = cmd.MarkFlagRequired(NameFlag)
+	_ = cmd.Flags().SetAnnotation(NameFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(NameFlag, cmd.Flags().Lookup(NameFlag))
+}
@@ -0,0 +1,170 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewScheduleOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long: `schedule a flag's override to be activated and removed at set times, replacing any schedule the flag already has. Times are RFC 3339 timestamps or durations from now
+
+Examples:
+  # Rehearse a launch: turn the flag on in 10 minutes and back off an hour later
+  ldcli dev-server schedule-override --project my-project --flag new-checkout --data true --activate-at 10m --deactivate-at 70m`,
+		RunE:  scheduleOverride(client),
+		Short: "schedule an override",
+		Use:   "schedule-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(cliflags.DataFlag, "", "flag value to override flag with when it's activated. The json representation of the variation value")
+	_ = viper.BindPFlag(cliflags.DataFlag, cmd.Flags().Lookup(cliflags.DataFlag))
+
+	cmd.Flags().String(ActivateAtFlag, "", "When to activate the override, e.g. 2024-06-01T09:00:00Z or 10m")
+	_ = viper.BindPFlag(ActivateAtFlag, cmd.Flags().Lookup(ActivateAtFlag))
+
+	cmd.Flags().String(DeactivateAtFlag, "", "When to remove the override, e.g. 2024-06-01T17:00:00Z or 8h")
+	_ = viper.BindPFlag(DeactivateAtFlag, cmd.Flags().Lookup(DeactivateAtFlag))
+
+	return cmd
+}
+
+// parseScheduleTime reads a time given either as an RFC 3339 timestamp or as a duration from now.
+func parseScheduleTime(value string, now time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, errors.Errorf("%q is neither an RFC 3339 timestamp nor a duration", value)
+	}
+	t := now.Add(duration)
+	return &t, nil
+}
+
+func scheduleOverride(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		now := time.Now()
+		activateAt, err := parseScheduleTime(viper.GetString(ActivateAtFlag), now)
+		if err != nil {
+			return err
+		}
+		deactivateAt, err := parseScheduleTime(viper.GetString(DeactivateAtFlag), now)
+		if err != nil {
+			return err
+		}
+		body := map[string]interface{}{}
+		if activateAt != nil {
+			body["activateAt"] = activateAt.Format(time.RFC3339)
+		}
+		if deactivateAt != nil {
+			body["deactivateAt"] = deactivateAt.Format(time.RFC3339)
+		}
+		if data := viper.GetString(cliflags.DataFlag); data != "" {
+			var value interface{}
+			if err := json.Unmarshal([]byte(data), &value); err != nil {
+				return err
+			}
+			body["value"] = value
+		}
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/schedule", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "PUT", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewUnscheduleOverrideCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "cancel a flag's override schedule without changing its override",
+		RunE:    unscheduleOverride(client),
+		Short:   "cancel a scheduled override",
+		Use:     "unschedule-override",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	return cmd
+}
+
+func unscheduleOverride(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/overrides/%s/schedule", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), viper.GetString(cliflags.FlagFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewListSchedulesCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "list a project's pending override schedules",
+		RunE:    listSchedules(client),
+		Short:   "list scheduled overrides",
+		Use:     "list-schedules",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func listSchedules(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/schedules", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag))
+		res, err := client.MakeUnauthenticatedRequest(
+			"GET",
+			path,
+			nil,
+		)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		return printResponse(cmd, res)
+	}
+}
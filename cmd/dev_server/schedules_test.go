@@ -0,0 +1,36 @@
+package dev_server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheduleTime(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("reads an RFC 3339 timestamp", func(t *testing.T) {
+		at, err := parseScheduleTime("2024-06-02T10:30:00Z", now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 6, 2, 10, 30, 0, 0, time.UTC), *at)
+	})
+
+	t.Run("reads a duration from now", func(t *testing.T) {
+		at, err := parseScheduleTime("90m", now)
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(90*time.Minute), *at)
+	})
+
+	t.Run("leaves an empty time unset", func(t *testing.T) {
+		at, err := parseScheduleTime("", now)
+		require.NoError(t, err)
+		assert.Nil(t, at)
+	})
+
+	t.Run("rejects anything else", func(t *testing.T) {
+		_, err := parseScheduleTime("tomorrow", now)
+		assert.Error(t, err)
+	})
+}
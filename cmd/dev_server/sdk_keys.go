@@ -0,0 +1,130 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewListSdkKeysCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long:    "list the SDK keys that select a project, such as placeholder keys that apps are configured with",
+		RunE:    listSdkKeys(client),
+		Short:   "list SDK keys mapped to projects",
+		Use:     "list-sdk-keys",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	return cmd
+}
+
+func listSdkKeys(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		res, err := client.MakeUnauthenticatedRequest("GET", getDevServerUrl()+"/dev/aliases", nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewAddSdkKeyCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `map an SDK key to a project, so SDKs configured with the key are served the project's flags. The key can be
+anything, so apps don't need a real environment's SDK key to select a project. A key that's already mapped is moved to
+the project
+
+Examples:
+  # Serve the frontend project to apps configured with a placeholder key
+  ldcli dev-server add-sdk-key --sdk-key=local-dev-key-frontend --project=frontend`,
+		RunE:  addSdkKey(client),
+		Short: "map an SDK key to a project",
+		Use:   "add-sdk-key",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	addSdkKeyFlag(cmd)
+
+	return cmd
+}
+
+func addSdkKey(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		body, err := json.Marshal(map[string]string{
+			"alias":      viper.GetString(SdkKeyFlag),
+			"projectKey": viper.GetString(cliflags.ProjectFlag),
+		})
+		if err != nil {
+			return err
+		}
+
+		res, sent, err := makeChangeRequest(cmd, client, "POST", getDevServerUrl()+"/dev/aliases", body)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewRemoveSdkKeyCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long:    "remove an SDK key's mapping to a project. SDKs configured with the key are then served the project with that key, if there is one",
+		RunE:    removeSdkKey(client),
+		Short:   "remove an SDK key mapping",
+		Use:     "remove-sdk-key",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	addSdkKeyFlag(cmd)
+
+	return cmd
+}
+
+func removeSdkKey(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/aliases/" + url.PathEscape(viper.GetString(SdkKeyFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func addSdkKeyFlag(cmd *cobra.Command) {
+	cmd.Flags().String(SdkKeyFlag, "", "The SDK key, which can be a placeholder such as local-dev-key-frontend")
+	_ = cmd.MarkFlagRequired(SdkKeyFlag)
+	_ = cmd.Flags().SetAnnotation(SdkKeyFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(SdkKeyFlag, cmd.Flags().Lookup(SdkKeyFlag))
+}
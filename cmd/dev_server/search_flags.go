@@ -0,0 +1,93 @@
+package dev_server
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewSearchFlagsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `search a project's flags, with overrides applied, rather than fetching the whole project. Flags are listed by
+key, and only the ones matching every filter given are listed
+
+Examples:
+  # Find the overridden checkout flags tagged frontend
+  ldcli dev-server search-flags --project=my-project --search=checkout --overridden --tags=frontend`,
+		RunE:  searchFlags(client),
+		Short: "search a project's flags",
+		Use:   "search-flags",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(SearchFlag, "", "Only list flags whose key contains this, ignoring case")
+	_ = viper.BindPFlag(SearchFlag, cmd.Flags().Lookup(SearchFlag))
+
+	cmd.Flags().String(KeyPrefixFlag, "", "Only list flags whose key starts with this")
+	_ = viper.BindPFlag(KeyPrefixFlag, cmd.Flags().Lookup(KeyPrefixFlag))
+
+	cmd.Flags().String(KindFlag, "", "Only list flags of this kind: boolean, string, number, or json")
+	_ = viper.BindPFlag(KindFlag, cmd.Flags().Lookup(KindFlag))
+
+	cmd.Flags().Bool(OverriddenFlag, false, "Only list flags with an active override")
+	_ = viper.BindPFlag(OverriddenFlag, cmd.Flags().Lookup(OverriddenFlag))
+
+	cmd.Flags().StringSlice(TagsFlag, nil, "Only list flags with any of these tags in LaunchDarkly")
+	_ = viper.BindPFlag(TagsFlag, cmd.Flags().Lookup(TagsFlag))
+
+	cmd.Flags().Int(OffsetFlag, 0, "Skip this many matching flags")
+	_ = viper.BindPFlag(OffsetFlag, cmd.Flags().Lookup(OffsetFlag))
+
+	cmd.Flags().Int(LimitFlag, 100, "The most flags to list")
+	_ = viper.BindPFlag(LimitFlag, cmd.Flags().Lookup(LimitFlag))
+
+	return cmd
+}
+
+func searchFlags(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/flags", getDevServerUrl(), url.PathEscape(viper.GetString(cliflags.ProjectFlag)))
+		query := url.Values{}
+		if search := viper.GetString(SearchFlag); search != "" {
+			query.Set("q", search)
+		}
+		if prefix := viper.GetString(KeyPrefixFlag); prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if kind := viper.GetString(KindFlag); kind != "" {
+			query.Set("kind", kind)
+		}
+		if viper.GetBool(OverriddenFlag) {
+			query.Set("overridden", "true")
+		}
+		for _, tag := range viper.GetStringSlice(TagsFlag) {
+			query.Add("tag", tag)
+		}
+		query.Set("offset", strconv.Itoa(viper.GetInt(OffsetFlag)))
+		query.Set("limit", strconv.Itoa(viper.GetInt(LimitFlag)))
+
+		res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		return printResponse(cmd, res)
+	}
+}
@@ -0,0 +1,29 @@
+package dev_server
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+)
+
+// instanceStreamURIs are the streaming endpoints of LaunchDarkly instances other than the default one, keyed by their
+// base URI.
+var instanceStreamURIs = map[string]string{
+	"https://app.eu.launchdarkly.com": "https://stream.eu.launchdarkly.com",
+	"https://app.launchdarkly.us":     "https://stream.launchdarkly.us",
+}
+
+// serviceURI returns the streaming endpoint the dev server should use. An endpoint set by flag or config file wins;
+// otherwise it follows the base URI when it's a known instance, so setting --base-uri alone is enough for EU and
+// federal accounts. Private instances have to set both.
+func serviceURI() string {
+	if viper.IsSet(cliflags.DevStreamURIFlag) {
+		return viper.GetString(cliflags.DevStreamURIFlag)
+	}
+	if streamURI, ok := instanceStreamURIs[strings.TrimSuffix(viper.GetString(cliflags.BaseURIFlag), "/")]; ok {
+		return streamURI
+	}
+	return viper.GetString(cliflags.DevStreamURIFlag)
+}
@@ -0,0 +1,37 @@
+package dev_server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+)
+
+func TestServiceURI(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	t.Run("follows the base URI of a known instance", func(t *testing.T) {
+		viper.Reset()
+		viper.Set(cliflags.BaseURIFlag, "https://app.eu.launchdarkly.com/")
+
+		assert.Equal(t, "https://stream.eu.launchdarkly.com", serviceURI())
+	})
+
+	t.Run("keeps an endpoint that was set explicitly", func(t *testing.T) {
+		viper.Reset()
+		viper.Set(cliflags.BaseURIFlag, "https://app.launchdarkly.us")
+		viper.Set(cliflags.DevStreamURIFlag, "http://relay.local")
+
+		assert.Equal(t, "http://relay.local", serviceURI())
+	})
+
+	t.Run("uses the configured endpoint for other instances", func(t *testing.T) {
+		viper.Reset()
+		viper.Set(cliflags.BaseURIFlag, "https://ld.example.com")
+		viper.Set(cliflags.DevStreamURIFlag, "https://stream.ld.example.com")
+
+		assert.Equal(t, "https://stream.ld.example.com", serviceURI())
+	})
+}
@@ -0,0 +1,130 @@
+package dev_server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewListSnapshotsCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `list the snapshots of a project's flag state, most recent first. One is recorded every time the project is
+synced, so starting the server with --sync-interval records them periodically. Pass a snapshot's ID as the snapshot
+query parameter of the flag-state and explain endpoints to see flags as they were configured then`,
+		RunE:  listSnapshots(client),
+		Short: "list flag state snapshots",
+		Use:   "list-snapshots",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	addSnapshotFlags(cmd)
+
+	return cmd
+}
+
+func listSnapshots(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		res, err := client.MakeUnauthenticatedRequest("GET", snapshotsPath(), nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewTakeSnapshotCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `record a project's current flag state as a snapshot without syncing it, e.g. to mark the configuration a bug
+was reported against, or as a checkpoint to roll back to with restore-snapshot
+
+Examples:
+  # Checkpoint before running a destructive test, then undo it
+  ldcli dev-server take-snapshot --project=my-project
+  ldcli dev-server restore-snapshot --project=my-project --snapshot=42`,
+		RunE:  takeSnapshot(client),
+		Short: "take a flag state snapshot",
+		Use:   "take-snapshot",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	addSnapshotFlags(cmd)
+
+	return cmd
+}
+
+func takeSnapshot(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		res, sent, err := makeChangeRequest(cmd, client, "POST", snapshotsPath(), nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewRestoreSnapshotCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `roll a project back to the flag state in a snapshot and the overrides it had when the snapshot was taken.
+Locked overrides are kept as they are. The next sync brings the flag state up to date again`,
+		RunE:  restoreSnapshot(client),
+		Short: "restore a flag state snapshot",
+		Use:   "restore-snapshot",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	addSnapshotFlags(cmd)
+
+	cmd.Flags().Int64(SnapshotFlag, 0, "The ID of the snapshot to restore")
+	_ = cmd.MarkFlagRequired(SnapshotFlag)
+	_ = cmd.Flags().SetAnnotation(SnapshotFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(SnapshotFlag, cmd.Flags().Lookup(SnapshotFlag))
+
+	return cmd
+}
+
+func restoreSnapshot(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/%d/restore", snapshotsPath(), viper.GetInt64(SnapshotFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func addSnapshotFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+}
+
+func snapshotsPath() string {
+	return getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag) + "/snapshots"
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"os/exec"
 	"runtime"
+	"strings"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -15,6 +19,7 @@ import (
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
 	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
 	"github.com/launchdarkly/ldcli/cmd/validators"
+	devstore "github.com/launchdarkly/ldcli/devserver/store"
 	"github.com/launchdarkly/ldcli/internal/dev_server"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
@@ -37,15 +42,120 @@ func NewStartServerCmd(client dev_server.Client) *cobra.Command {
 	cmd.Flags().String(SourceEnvironmentFlag, "", "environment to copy flag values from")
 	_ = viper.BindPFlag(SourceEnvironmentFlag, cmd.Flags().Lookup(SourceEnvironmentFlag))
 
+	cmd.Flags().StringSlice(ActorFlag, []string{actorOSUser}, "How to identify who made each change for history records, tried in order: header, token, or os-user. header trusts whatever name requests give, so only add it when every client is trusted")
+	_ = viper.BindPFlag(ActorFlag, cmd.Flags().Lookup(ActorFlag))
+
+	cmd.Flags().String(ActorHeaderFlag, model.ActorHeaderDefault, "Request header that identifies who made a change when --actor includes header")
+	_ = viper.BindPFlag(ActorHeaderFlag, cmd.Flags().Lookup(ActorHeaderFlag))
+
+	cmd.Flags().StringToString(ActorTokensFlag, nil, "Comma separated name=token pairs. Requests with a bearer token are attributed to its name when --actor includes token. Tokens are for attribution only, and aren't required")
+	_ = viper.BindPFlag(ActorTokensFlag, cmd.Flags().Lookup(ActorTokensFlag))
+
+	cmd.Flags().StringSlice(NamespaceFlag, nil, "How to pick each request's namespace, tried in order: header, from the "+model.NamespaceHeaderDefault+" header, token, the name of its --actor-tokens bearer token, or git-branch, the git branch checked out where the server was started. Each namespace gets its own overrides on top of the shared projects")
+	_ = viper.BindPFlag(NamespaceFlag, cmd.Flags().Lookup(NamespaceFlag))
+
+	cmd.Flags().Bool(PrefetchKeysFlag, false, prefetchKeysHelp)
+	_ = viper.BindPFlag(PrefetchKeysFlag, cmd.Flags().Lookup(PrefetchKeysFlag))
+
+	addFlagFilterFlags(cmd)
+
+	cmd.Flags().String(AutoConfigKeyFlag, "", "Relay Proxy auto-configuration key. A project is created and kept in sync for every project the key has access to")
+	_ = viper.BindPFlag(AutoConfigKeyFlag, cmd.Flags().Lookup(AutoConfigKeyFlag))
+
+	cmd.Flags().Bool(AutoCreateProjectsFlag, false, "Create a project when an SDK connects with the SDK key, mobile key, or client-side ID of a LaunchDarkly environment that has none, sourced from that environment")
+	_ = viper.BindPFlag(AutoCreateProjectsFlag, cmd.Flags().Lookup(AutoCreateProjectsFlag))
+
+	cmd.Flags().String(AutoConfigEnvFlag, "", "Environment key to source auto-configured projects from. Projects without it use their first environment by key")
+	_ = viper.BindPFlag(AutoConfigEnvFlag, cmd.Flags().Lookup(AutoConfigEnvFlag))
+
 	cmd.Flags().String(ContextFlag, "", `Stringified JSON representation of your context object ex. {"kind": "multi", "user": { "email": "test@gmail.com", "username": "foo", "key": "bar"}`)
 	_ = viper.BindPFlag(ContextFlag, cmd.Flags().Lookup(ContextFlag))
+	cmd.Flags().String(ContextFileFlag, "", "Path to a JSON file with your context object, instead of --context")
+	_ = viper.BindPFlag(ContextFileFlag, cmd.Flags().Lookup(ContextFileFlag))
+	cmd.Flags().Bool(cliflags.TemplateFlag, false, cliflags.TemplateFlagDescription)
+	_ = viper.BindPFlag(cliflags.TemplateFlag, cmd.Flags().Lookup(cliflags.TemplateFlag))
+
+	cmd.Flags().String(ContextEnrichmentFlag, "", "Command, or http(s) URL to POST to, that receives the context JSON and returns it with attributes added before flags are evaluated. It runs for the project's context when it syncs, and for the contexts client-side SDKs send")
+	_ = viper.BindPFlag(ContextEnrichmentFlag, cmd.Flags().Lookup(ContextEnrichmentFlag))
+
+	cmd.Flags().Duration(NotificationDebounceFlag, 0, "How long to wait for further changes to a flag's override before notifying SDKs, e.g. 100ms. Only the latest change is sent")
+	_ = viper.BindPFlag(NotificationDebounceFlag, cmd.Flags().Lookup(NotificationDebounceFlag))
+
+	cmd.Flags().Duration(StaleAfterFlag, 0, "How long after its last sync a project is reported stale to SDKs with the X-LD-Stale response header, e.g. 168h. 0 turns this off")
+	_ = viper.BindPFlag(StaleAfterFlag, cmd.Flags().Lookup(StaleAfterFlag))
+
+	cmd.Flags().Bool(AutoResyncStaleFlag, false, "Resync stale projects in the background when SDKs request them. Requires --"+StaleAfterFlag)
+	_ = viper.BindPFlag(AutoResyncStaleFlag, cmd.Flags().Lookup(AutoResyncStaleFlag))
 
 	cmd.Flags().String(OverrideFlag, "", `Stringified JSON representation of flag overrides ex. {"flagName": true, "stringFlagName": "test" }`)
 	_ = viper.BindPFlag(OverrideFlag, cmd.Flags().Lookup(OverrideFlag))
 
+	cmd.Flags().String(ReloadHookFlag, "", "Local URL to POST to when flags change, e.g. a frontend dev server endpoint that triggers a reload")
+	_ = viper.BindPFlag(ReloadHookFlag, cmd.Flags().Lookup(ReloadHookFlag))
+
+	cmd.Flags().StringSlice(ReloadHookFlagsFlag, nil, "Comma separated flag keys that trigger the reload hook. Defaults to all flags")
+	_ = viper.BindPFlag(ReloadHookFlagsFlag, cmd.Flags().Lookup(ReloadHookFlagsFlag))
+
+	cmd.Flags().String(ExecHookFlag, "", "Shell command to run when flags change, e.g. to restart a service. It's run once for each changed flag, with the flag in the LD_PROJECT_KEY, LD_FLAG_KEY, LD_FLAG_VALUE, LD_FLAG_VERSION, and LD_FLAG_DELETED environment variables")
+	_ = viper.BindPFlag(ExecHookFlag, cmd.Flags().Lookup(ExecHookFlag))
+
+	cmd.Flags().StringSlice(ExecHookFlagsFlag, nil, "Comma separated flag keys that run the exec hook. Defaults to all flags")
+	_ = viper.BindPFlag(ExecHookFlagsFlag, cmd.Flags().Lookup(ExecHookFlagsFlag))
+
+	cmd.Flags().String(DashboardURLFlag, "", "URL of the LaunchDarkly dashboard that projects and flags link to in responses. Defaults to --base-uri")
+	_ = viper.BindPFlag(DashboardURLFlag, cmd.Flags().Lookup(DashboardURLFlag))
+
+	cmd.Flags().Bool(GraphQLFlag, false, "Serve a GraphQL API over projects, flags, overrides, and variations at /dev/graphql")
+	_ = viper.BindPFlag(GraphQLFlag, cmd.Flags().Lookup(GraphQLFlag))
+
+	cmd.Flags().StringSlice(ListenFlag, nil, "Comma separated addresses to serve on as well as --port, e.g. unix:///tmp/ldcli.sock or tcp://127.0.0.1:9000")
+	_ = viper.BindPFlag(ListenFlag, cmd.Flags().Lookup(ListenFlag))
+
+	cmd.Flags().String(StoreFlag, dev_server.StoreSqlite, "Where to keep projects and overrides, either sqlite or redis")
+	_ = viper.BindPFlag(StoreFlag, cmd.Flags().Lookup(StoreFlag))
+
+	cmd.Flags().String(RedisURLFlag, "", "URL of the Redis server to use with --store=redis, e.g. redis://localhost:6379/0")
+	_ = viper.BindPFlag(RedisURLFlag, cmd.Flags().Lookup(RedisURLFlag))
+
+	cmd.Flags().String(DBPathFlag, "", "Path to the database to use with --store=sqlite. Defaults to dev_server.db in the ldcli state directory")
+	_ = viper.BindPFlag(DBPathFlag, cmd.Flags().Lookup(DBPathFlag))
+
+	cmd.Flags().String(SeedFileFlag, "", "Path to a JSON file of projects and overrides to create on startup. The server exits if any of them can't be created")
+	_ = viper.BindPFlag(SeedFileFlag, cmd.Flags().Lookup(SeedFileFlag))
+
+	cmd.Flags().StringToString(SdkKeysFlag, nil, "Comma separated sdk-key=project pairs. SDKs configured with one of the keys are served the project's flags, e.g. local-dev-key-frontend=frontend")
+	_ = viper.BindPFlag(SdkKeysFlag, cmd.Flags().Lookup(SdkKeysFlag))
+
+	cmd.Flags().Duration(SyncIntervalFlag, 0, "How often to sync every project from LaunchDarkly in the background, e.g. 15m. 0 turns this off")
+	_ = viper.BindPFlag(SyncIntervalFlag, cmd.Flags().Lookup(SyncIntervalFlag))
+
+	cmd.Flags().Duration(HistoryRetentionFlag, 30*24*time.Hour, "How long to keep flag state and override history, which past flag state and snapshots are restored from. 0 keeps it forever")
+	_ = viper.BindPFlag(HistoryRetentionFlag, cmd.Flags().Lookup(HistoryRetentionFlag))
+
+	cmd.Flags().String(ServerConfigFlag, "", "Path to a devserver.yaml whose settings take precedence over flags. It's re-applied on SIGHUP and whenever it changes")
+	_ = viper.BindPFlag(ServerConfigFlag, cmd.Flags().Lookup(ServerConfigFlag))
+
+	cmd.Flags().String(StatsdAddressFlag, "", "host:port of a StatsD server or Datadog agent to send eval counts, sync latency, and stream client counts to")
+	_ = viper.BindPFlag(StatsdAddressFlag, cmd.Flags().Lookup(StatsdAddressFlag))
+
+	cmd.Flags().String(StatsdFormatFlag, model.StatsdFormatStatsd, "Format to send metrics in, either statsd or dogstatsd. Tags are only sent with dogstatsd")
+	_ = viper.BindPFlag(StatsdFormatFlag, cmd.Flags().Lookup(StatsdFormatFlag))
+
+	cmd.Flags().String(StatsdPrefixFlag, "ldcli.dev_server.", "Prefix for the names of metrics sent to StatsD")
+	_ = viper.BindPFlag(StatsdPrefixFlag, cmd.Flags().Lookup(StatsdPrefixFlag))
+
+	cmd.Flags().StringSlice(StatsdTagsFlag, nil, "Comma separated key:value tags to add to every metric sent with --statsd-format=dogstatsd")
+	_ = viper.BindPFlag(StatsdTagsFlag, cmd.Flags().Lookup(StatsdTagsFlag))
+
+	cmd.Flags().String(RecordEvaluationsFlag, "", "Path of a file to append every evaluation SDKs report in their events to, for `ldcli dev-server replay`")
+	_ = viper.BindPFlag(RecordEvaluationsFlag, cmd.Flags().Lookup(RecordEvaluationsFlag))
+
 	cmd.Flags().Bool(cliflags.SyncOnceFlag, false, cliflags.SyncOnceFlagDescription)
 	_ = viper.BindPFlag(cliflags.SyncOnceFlag, cmd.Flags().Lookup(cliflags.SyncOnceFlag))
 
+	cmd.Flags().String(cliflags.SecureModeFlag, "", cliflags.SecureModeFlagDescription)
+	_ = viper.BindPFlag(cliflags.SecureModeFlag, cmd.Flags().Lookup(cliflags.SecureModeFlag))
+
 	return cmd
 }
 
@@ -58,15 +168,22 @@ func startServer(client dev_server.Client) func(*cobra.Command, []string) error
 		if viper.IsSet(cliflags.ProjectFlag) && viper.IsSet(SourceEnvironmentFlag) {
 
 			initialSetting = model.InitialProjectSettings{
-				Enabled:    true,
-				ProjectKey: viper.GetString(cliflags.ProjectFlag),
-				EnvKey:     viper.GetString(SourceEnvironmentFlag),
-				SyncOnce:   viper.GetBool(cliflags.SyncOnceFlag),
+				Enabled:      true,
+				ProjectKey:   viper.GetString(cliflags.ProjectFlag),
+				EnvKey:       viper.GetString(SourceEnvironmentFlag),
+				SyncOnce:     viper.GetBool(cliflags.SyncOnceFlag),
+				PrefetchKeys: viper.GetBool(PrefetchKeysFlag),
+			}
+			if flagFilter, hasFlagFilter := getFlagFilterInput(); hasFlagFilter {
+				initialSetting.FlagFilter = &flagFilter
 			}
-			if viper.IsSet(ContextFlag) {
+			contextString, hasContext, err := getContextInput()
+			if err != nil {
+				return err
+			}
+			if hasContext {
 				var c ldcontext.Context
-				contextString := viper.GetString(ContextFlag)
-				err := c.UnmarshalJSON([]byte(contextString))
+				err = c.UnmarshalJSON([]byte(contextString))
 				if err != nil {
 					return err
 				}
@@ -84,14 +201,118 @@ func startServer(client dev_server.Client) func(*cobra.Command, []string) error
 			}
 		}
 
+		store := viper.GetString(StoreFlag)
+		switch store {
+		case dev_server.StoreSqlite:
+		case dev_server.StoreRedis:
+			if viper.GetString(RedisURLFlag) == "" {
+				return fmt.Errorf("--%s is required with --%s=%s", RedisURLFlag, StoreFlag, dev_server.StoreRedis)
+			}
+		default:
+			if !lo.Contains(devstore.Backends(), store) {
+				return fmt.Errorf("unknown store %q, expected one of %s", store, strings.Join(devstore.Backends(), ", "))
+			}
+		}
+
+		listen := viper.GetStringSlice(ListenFlag)
+		for _, address := range listen {
+			if _, _, err := dev_server.ParseListenAddress(address); err != nil {
+				return err
+			}
+		}
+
+		var seed *model.Seed
+		if seedFile := viper.GetString(SeedFileFlag); seedFile != "" {
+			s, err := model.ReadSeedFile(seedFile)
+			if err != nil {
+				return err
+			}
+			seed = &s
+		}
+		// a repository's .ldcli.yaml seeds its project too, unless the seed file already declares it
+		repoConfig, repoConfigPath, err := dev_server.FindRepoConfig(".")
+		if err != nil {
+			return err
+		}
+		if repoConfigPath != "" && repoConfig.Source != "" {
+			if seed == nil {
+				seed = &model.Seed{}
+			}
+			if !lo.ContainsBy(seed.Projects, func(project model.SeedProject) bool { return project.Key == repoConfig.Project }) {
+				seed.Projects = append(seed.Projects, repoConfig.SeedProject())
+				if err := seed.Validate(); err != nil {
+					return fmt.Errorf("%s conflicts with the seed: %w", repoConfigPath, err)
+				}
+				log.Printf("Seeding project [%s] from %s", repoConfig.Project, repoConfigPath)
+			}
+		}
+
+		switch viper.GetString(StatsdFormatFlag) {
+		case model.StatsdFormatStatsd, model.StatsdFormatDogStatsd:
+		default:
+			return fmt.Errorf("unknown statsd format %q, expected %s or %s", viper.GetString(StatsdFormatFlag), model.StatsdFormatStatsd, model.StatsdFormatDogStatsd)
+		}
+
+		if viper.GetBool(AutoResyncStaleFlag) && viper.GetDuration(StaleAfterFlag) <= 0 {
+			return fmt.Errorf("--%s requires --%s", AutoResyncStaleFlag, StaleAfterFlag)
+		}
+
+		actorResolver, err := newActorResolver()
+		if err != nil {
+			return err
+		}
+		namespaceResolver, err := newNamespaceResolver()
+		if err != nil {
+			return err
+		}
+
+		var execHooks []model.ExecHookConfig
+		if viper.GetString(ExecHookFlag) != "" {
+			execHooks = append(execHooks, model.ExecHookConfig{
+				Command: viper.GetString(ExecHookFlag),
+				Flags:   viper.GetStringSlice(ExecHookFlagsFlag),
+			})
+		}
+
+		streamURI := serviceURI()
 		params := dev_server.ServerParams{
 			AccessToken:            viper.GetString(cliflags.AccessTokenFlag),
 			BaseURI:                viper.GetString(cliflags.BaseURIFlag),
-			DevStreamURI:           viper.GetString(cliflags.DevStreamURIFlag),
+			DevStreamURI:           streamURI,
+			DashboardURL:           viper.GetString(DashboardURLFlag),
+			Proxy:                  viper.GetString(cliflags.ProxyFlag),
 			Port:                   viper.GetString(cliflags.PortFlag),
+			Listen:                 listen,
 			CorsEnabled:            viper.GetBool(cliflags.CorsEnabledFlag),
 			CorsOrigin:             viper.GetString(cliflags.CorsOriginFlag),
+			SecureModeSecret:       viper.GetString(cliflags.SecureModeFlag),
+			ContextEnrichmentHook:  viper.GetString(ContextEnrichmentFlag),
+			NotificationDebounce:   viper.GetDuration(NotificationDebounceFlag),
+			ReloadHookURL:          viper.GetString(ReloadHookFlag),
+			ReloadHookFlags:        viper.GetStringSlice(ReloadHookFlagsFlag),
+			Store:                  store,
+			StoreURL:               storeURL(store),
+			ExecHooks:              execHooks,
+			ActorResolver:          actorResolver,
+			NamespaceResolver:      namespaceResolver,
+			ReloadAccessToken:      reloadAccessToken,
+			AutoConfigKey:          viper.GetString(AutoConfigKeyFlag),
+			AutoConfigEnvironment:  viper.GetString(AutoConfigEnvFlag),
+			AutoCreateProjects:     viper.GetBool(AutoCreateProjectsFlag),
 			InitialProjectSettings: initialSetting,
+			Seed:                   seed,
+			SdkKeys:                viper.GetStringMapString(SdkKeysFlag),
+			StaleAfter:             viper.GetDuration(StaleAfterFlag),
+			AutoResyncStale:        viper.GetBool(AutoResyncStaleFlag),
+			StatsdAddress:          viper.GetString(StatsdAddressFlag),
+			StatsdPrefix:           viper.GetString(StatsdPrefixFlag),
+			StatsdFormat:           viper.GetString(StatsdFormatFlag),
+			StatsdTags:             viper.GetStringSlice(StatsdTagsFlag),
+			RecordEvaluationsFile:  viper.GetString(RecordEvaluationsFlag),
+			GraphQL:                viper.GetBool(GraphQLFlag),
+			SyncInterval:           viper.GetDuration(SyncIntervalFlag),
+			HistoryRetention:       viper.GetDuration(HistoryRetentionFlag),
+			ConfigFile:             viper.GetString(ServerConfigFlag),
 		}
 
 		client.RunServer(ctx, params)
@@ -137,3 +358,81 @@ func openUI() func(*cobra.Command, []string) error {
 		return nil
 	}
 }
+
+// reloadAccessToken re-reads the config file so a rotated token there is picked up on SIGHUP. A token given with the
+// flag or environment variable still takes precedence.
+// storeURL is where the store keeps its data: the database file for sqlite, and the Redis server for everything else.
+func storeURL(store string) string {
+	if store == dev_server.StoreSqlite {
+		return viper.GetString(DBPathFlag)
+	}
+	return viper.GetString(RedisURLFlag)
+}
+
+func reloadAccessToken() (string, error) {
+	if err := viper.ReadInConfig(); err != nil {
+		return "", err
+	}
+
+	return viper.GetString(cliflags.AccessTokenFlag), nil
+}
+
+// Ways of identifying actors that --actor accepts.
+const (
+	actorHeader = "header"
+	actorToken  = "token"
+	actorOSUser = "os-user"
+)
+
+// namespaceGitBranch is the way of picking namespaces that --namespace accepts besides those of identifying actors.
+const namespaceGitBranch = "git-branch"
+
+func newActorResolver() (model.ActorResolver, error) {
+	var resolvers []model.ActorResolver
+	for _, kind := range viper.GetStringSlice(ActorFlag) {
+		switch kind {
+		case actorHeader:
+			resolvers = append(resolvers, model.HeaderActorResolver(viper.GetString(ActorHeaderFlag)))
+		case actorToken:
+			resolvers = append(resolvers, model.TokenActorResolver(actorTokenNames()))
+		case actorOSUser:
+			resolvers = append(resolvers, model.OSUserActorResolver())
+		default:
+			return nil, fmt.Errorf("unknown actor %q, expected %s, %s, or %s", kind, actorHeader, actorToken, actorOSUser)
+		}
+	}
+
+	return model.ChainActorResolvers(resolvers...), nil
+}
+
+// newNamespaceResolver returns nil unless --namespace is given, since namespaces are off by default.
+func newNamespaceResolver() (model.ActorResolver, error) {
+	kinds := viper.GetStringSlice(NamespaceFlag)
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+	var resolvers []model.ActorResolver
+	for _, kind := range kinds {
+		switch kind {
+		case actorHeader:
+			resolvers = append(resolvers, model.HeaderActorResolver(model.NamespaceHeaderDefault))
+		case actorToken:
+			resolvers = append(resolvers, model.TokenActorResolver(actorTokenNames()))
+		case namespaceGitBranch:
+			resolvers = append(resolvers, model.GitBranchNamespaceResolver(""))
+		default:
+			return nil, fmt.Errorf("unknown namespace source %q, expected %s, %s, or %s", kind, actorHeader, actorToken, namespaceGitBranch)
+		}
+	}
+
+	return model.ChainActorResolvers(resolvers...), nil
+}
+
+// actorTokenNames maps each --actor-tokens token to its name.
+func actorTokenNames() map[string]string {
+	names := make(map[string]string)
+	for name, token := range viper.GetStringMapString(ActorTokensFlag) {
+		names[token] = name
+	}
+	return names
+}
@@ -0,0 +1,38 @@
+package dev_server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestNewActorResolver(t *testing.T) {
+	t.Cleanup(viper.Reset)
+	req := httptest.NewRequest(http.MethodPut, "/dev/projects/proj/overrides/flag", nil)
+	req.Header.Set(model.ActorHeaderDefault, "someone-else")
+
+	t.Run("doesn't trust the actor header by default", func(t *testing.T) {
+		viper.Reset()
+		_ = NewStartServerCmd(nil)
+
+		resolver, err := newActorResolver()
+		require.NoError(t, err)
+		assert.Equal(t, model.CurrentOSUser(), resolver.ResolveActor(req))
+	})
+
+	t.Run("trusts the actor header when asked to", func(t *testing.T) {
+		viper.Reset()
+		_ = NewStartServerCmd(nil)
+		viper.Set(ActorFlag, []string{actorHeader, actorOSUser})
+
+		resolver, err := newActorResolver()
+		require.NoError(t, err)
+		assert.Equal(t, "someone-else", resolver.ResolveActor(req))
+	})
+}
@@ -0,0 +1,89 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewSyncStatusCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `show the result of the most recent attempt to sync the project from LaunchDarkly, including background syncs
+
+Examples:
+  # Check why a project's flag values look stale
+  ldcli dev-server sync-status --project=my-project`,
+		RunE:  printSyncStatus(client),
+		Short: "show the last sync of a project",
+		Use:   "sync-status",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+type syncStatus struct {
+	AttemptedAt int64  `json:"attemptedAt"`
+	DurationMs  int64  `json:"durationMs"`
+	Result      string `json:"result"`
+	Error       string `json:"error,omitempty"`
+}
+
+func printSyncStatus(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		project := viper.GetString(cliflags.ProjectFlag)
+		path := getDevServerUrl() + "/dev/projects/" + project
+		query := map[string][]string{"expand": {"syncStatus"}}
+
+		res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		var response struct {
+			SyncStatus *syncStatus `json:"syncStatus"`
+		}
+		err = json.Unmarshal(res, &response)
+		if err != nil {
+			return err
+		}
+
+		if isStructuredOutput() {
+			data, err := json.Marshal(response.SyncStatus)
+			if err != nil {
+				return err
+			}
+			return printData(cmd, data)
+		}
+
+		status := response.SyncStatus
+		if status == nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "'%s' project has no recorded sync attempts\n", project)
+			return nil
+		}
+		attemptedAt := time.Unix(status.AttemptedAt, 0).Format(time.RFC3339)
+		duration := time.Duration(status.DurationMs) * time.Millisecond
+		if status.Error != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "'%s' project sync failed at %s after %s: %s\n", project, attemptedAt, duration, status.Error)
+			return nil
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "'%s' project synced successfully at %s in %s\n", project, attemptedAt, duration)
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewListTriggersCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "list a project's flag triggers and the paths that fire them",
+		RunE:    listTriggers(client),
+		Short:   "list flag triggers",
+		Use:     "list-triggers",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+func listTriggers(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/triggers", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag))
+		res, err := client.MakeUnauthenticatedRequest(
+			"GET",
+			path,
+			nil,
+		)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewAddTriggerCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long: `add a trigger to a boolean flag. POSTing to the trigger's path, without credentials, overrides the flag to true if its action is turnFlagOn, or false if it's turnFlagOff
+
+Examples:
+  # Let a load test harness kill the new checkout flow
+  ldcli dev-server add-trigger --project my-project --flag new-checkout --action turnFlagOff`,
+		RunE:  addTrigger(client),
+		Short: "add a flag trigger",
+		Use:   "add-trigger",
+	}
+
+	addProjectAndFlagFlags(cmd)
+
+	cmd.Flags().String(ActionFlag, "", "What firing the trigger does to the flag, turnFlagOn or turnFlagOff")
+	_ = cmd.MarkFlagRequired(ActionFlag)
+	_ = cmd.Flags().SetAnnotation(ActionFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(ActionFlag, cmd.Flags().Lookup(ActionFlag))
+
+	return cmd
+}
+
+func addTrigger(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		jsonData, err := json.Marshal(map[string]string{
+			"flagKey": viper.GetString(cliflags.FlagFlag),
+			"action":  viper.GetString(ActionFlag),
+		})
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/dev/projects/%s/triggers", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag))
+		res, sent, err := makeChangeRequest(cmd, client, "POST", path, jsonData)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
+
+func NewRemoveTriggerCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "overrides",
+		Args:    validators.Validate(),
+		Long:    "remove a flag trigger, so its path stops working. The flag's override is left as it is",
+		RunE:    removeTrigger(client),
+		Short:   "remove a flag trigger",
+		Use:     "remove-trigger",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().String(TriggerFlag, "", "The trigger's ID")
+	_ = cmd.MarkFlagRequired(TriggerFlag)
+	_ = cmd.Flags().SetAnnotation(TriggerFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(TriggerFlag, cmd.Flags().Lookup(TriggerFlag))
+
+	return cmd
+}
+
+func removeTrigger(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := fmt.Sprintf("%s/dev/projects/%s/triggers/%s", getDevServerUrl(), viper.GetString(cliflags.ProjectFlag), url.PathEscape(viper.GetString(TriggerFlag)))
+		res, sent, err := makeChangeRequest(cmd, client, "DELETE", path, nil)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if !sent {
+			return nil
+		}
+
+		return printResponse(cmd, res)
+	}
+}
@@ -0,0 +1,95 @@
+package dev_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func NewUsageCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "events",
+		Args:    validators.Validate(),
+		Long: `summarize how many times SDKs evaluated each of a project's flags, from the events they sent to the dev server.
+Flags that weren't evaluated may no longer be in code, and evaluated flags that the project doesn't have may have been
+deleted in LaunchDarkly. Evaluations are counted by the hour, and only for a week
+
+Examples:
+  # Find the flags your app stopped evaluating
+  ldcli dev-server usage --project my-project --since 24h`,
+		RunE:  printUsage(client),
+		Short: "summarize flag usage",
+		Use:   "usage",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().Duration(SinceFlag, 0, "Only count evaluations made within this long, e.g. 24h")
+	_ = viper.BindPFlag(SinceFlag, cmd.Flags().Lookup(SinceFlag))
+
+	return cmd
+}
+
+type flagUsage struct {
+	FlagKey             string     `json:"flagKey"`
+	Evaluations         int64      `json:"evaluations"`
+	FallbackEvaluations int64      `json:"fallbackEvaluations"`
+	LastEvaluated       *time.Time `json:"lastEvaluated"`
+	InProject           bool       `json:"inProject"`
+}
+
+func printUsage(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path := getDevServerUrl() + "/dev/projects/" + viper.GetString(cliflags.ProjectFlag) + "/usage"
+		query := url.Values{}
+		if since := viper.GetDuration(SinceFlag); since > 0 {
+			query.Set("since", time.Now().Add(-since).Format(time.RFC3339))
+		}
+
+		res, err := client.MakeRequest("", "GET", path, "application/json", query, nil, false)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		if isStructuredOutput() {
+			return printResponse(cmd, res)
+		}
+
+		var response struct {
+			Flags []flagUsage `json:"flags"`
+		}
+		err = json.Unmarshal(res, &response)
+		if err != nil {
+			return err
+		}
+		for _, flag := range response.Flags {
+			fmt.Fprintln(cmd.OutOrStdout(), formatFlagUsage(flag))
+		}
+		return nil
+	}
+}
+
+func formatFlagUsage(flag flagUsage) string {
+	switch {
+	case flag.LastEvaluated == nil:
+		return fmt.Sprintf("%s: not evaluated", flag.FlagKey)
+	case !flag.InProject:
+		return fmt.Sprintf("%s: %d evaluations, last at %s, not in the project", flag.FlagKey, flag.Evaluations, flag.LastEvaluated.Local().Format(time.DateTime))
+	default:
+		return fmt.Sprintf("%s: %d evaluations, last at %s", flag.FlagKey, flag.Evaluations, flag.LastEvaluated.Local().Format(time.DateTime))
+	}
+}
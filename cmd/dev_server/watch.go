@@ -0,0 +1,307 @@
+package dev_server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/launchdarkly/eventsource"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// watchHighlight is how long a flag stays highlighted after its value changes.
+const watchHighlight = 5 * time.Second
+
+var (
+	watchHeaderStyle   = lipgloss.NewStyle().Bold(true)
+	watchOverrideStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("5"))
+	watchChangedStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3"))
+	watchErrorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+)
+
+func NewWatchCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: "projects",
+		Args:    validators.Validate(),
+		Long: `show a live table of a project's flags and the values served for the project's context, updated as they change.
+Overridden flags are marked, and flags that just changed are highlighted. Press q to quit
+
+Examples:
+  # Keep an eye on the flags while toggling overrides in the UI
+  ldcli dev-server watch --project=my-project`,
+		RunE:  runWatch(client),
+		Short: "watch a project's flag values",
+		Use:   "watch",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	return cmd
+}
+
+// Messages the watch table gets from the stream, from refetching overrides, and from its clock.
+type (
+	watchPutMsg   map[string]ldvalue.Value
+	watchPatchMsg struct {
+		key   string
+		value ldvalue.Value
+	}
+	watchDeleteMsg    string
+	watchOverridesMsg map[string]bool
+	watchErrMsg       struct{ err error }
+	watchTickMsg      time.Time
+)
+
+type watchProject struct {
+	Context   json.RawMessage            `json:"context"`
+	Overrides map[string]json.RawMessage `json:"overrides"`
+}
+
+func runWatch(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		projectKey := viper.GetString(cliflags.ProjectFlag)
+		project, err := fetchWatchProject(client, projectKey)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		model := newWatchModel(projectKey, func() tea.Msg {
+			project, err := fetchWatchProject(client, projectKey)
+			if err != nil {
+				return watchErrMsg{err}
+			}
+			return overriddenFlags(project)
+		})
+		model.overridden = overriddenFlags(project)
+
+		program := tea.NewProgram(model, tea.WithAltScreen(), tea.WithOutput(cmd.OutOrStdout()))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		streamURL := fmt.Sprintf("%s/eval/%s/%s", getDevServerUrl(), projectKey, base64.RawURLEncoding.EncodeToString(project.Context))
+		go streamWatchedFlags(ctx, streamURL, program.Send)
+
+		final, err := program.Run()
+		if err != nil {
+			return err
+		}
+		return final.(watchModel).err
+	}
+}
+
+func fetchWatchProject(client resources.Client, projectKey string) (watchProject, error) {
+	path := getDevServerUrl() + "/dev/projects/" + projectKey
+	res, err := client.MakeRequest("", "GET", path, "application/json", map[string][]string{"expand": {"overrides"}}, nil, false)
+	if err != nil {
+		return watchProject{}, err
+	}
+	var project watchProject
+	err = json.Unmarshal(res, &project)
+	return project, err
+}
+
+func overriddenFlags(project watchProject) watchOverridesMsg {
+	overridden := make(watchOverridesMsg, len(project.Overrides))
+	for flagKey := range project.Overrides {
+		overridden[flagKey] = true
+	}
+	return overridden
+}
+
+// streamWatchedFlags follows the client-side SDK stream for the project's context, which sends the values SDKs are
+// served, and passes its events on until ctx is done.
+func streamWatchedFlags(ctx context.Context, url string, send func(tea.Msg)) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		send(watchErrMsg{err})
+		return
+	}
+	stream, err := eventsource.SubscribeWithRequestAndOptions(request,
+		eventsource.StreamOptionInitialRetry(time.Second),
+		eventsource.StreamOptionErrorHandler(func(err error) eventsource.StreamErrorHandlerResult {
+			return eventsource.StreamErrorHandlerResult{CloseNow: ctx.Err() != nil}
+		}),
+	)
+	if err != nil {
+		send(watchErrMsg{fmt.Errorf("unable to connect to the dev server's stream: %w", err)})
+		return
+	}
+	defer stream.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-stream.Events:
+			if !ok {
+				return
+			}
+			if msg := parseWatchEvent(event.Event(), []byte(event.Data())); msg != nil {
+				send(msg)
+			}
+		}
+	}
+}
+
+// parseWatchEvent turns a client-side stream event into a message for the watch table, or nil if it isn't one.
+func parseWatchEvent(eventName string, data []byte) tea.Msg {
+	var flag struct {
+		Key   string        `json:"key"`
+		Value ldvalue.Value `json:"value"`
+	}
+	switch eventName {
+	case "put":
+		var flags map[string]struct {
+			Value ldvalue.Value `json:"value"`
+		}
+		if err := json.Unmarshal(data, &flags); err != nil {
+			return nil
+		}
+		values := make(watchPutMsg, len(flags))
+		for flagKey, flag := range flags {
+			values[flagKey] = flag.Value
+		}
+		return values
+	case "patch":
+		if err := json.Unmarshal(data, &flag); err != nil {
+			return nil
+		}
+		return watchPatchMsg{key: flag.Key, value: flag.Value}
+	case "delete":
+		if err := json.Unmarshal(data, &flag); err != nil {
+			return nil
+		}
+		return watchDeleteMsg(flag.Key)
+	}
+	return nil
+}
+
+type watchModel struct {
+	projectKey string
+	// loaded is whether the first put has arrived, before which there's nothing to show
+	loaded     bool
+	values     map[string]ldvalue.Value
+	overridden map[string]bool
+	changedAt  map[string]time.Time
+	now        time.Time
+	// refetchOverrides finds out which flags are overridden after a change
+	refetchOverrides tea.Cmd
+	err              error
+}
+
+func newWatchModel(projectKey string, refetchOverrides tea.Cmd) watchModel {
+	return watchModel{
+		projectKey:       projectKey,
+		values:           make(map[string]ldvalue.Value),
+		overridden:       make(map[string]bool),
+		changedAt:        make(map[string]time.Time),
+		now:              time.Now(),
+		refetchOverrides: refetchOverrides,
+	}
+}
+
+func watchTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg { return watchTickMsg(t) })
+}
+
+func (m watchModel) Init() tea.Cmd {
+	return watchTick()
+}
+
+func (m watchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+	case watchTickMsg:
+		m.now = time.Time(msg)
+		return m, watchTick()
+	case watchPutMsg:
+		for flagKey, value := range msg {
+			if previous, ok := m.values[flagKey]; m.loaded && (!ok || !previous.Equal(value)) {
+				m.changedAt[flagKey] = m.now
+			}
+		}
+		m.values = msg
+		m.loaded = true
+		return m, m.refetchOverrides
+	case watchPatchMsg:
+		m.values[msg.key] = msg.value
+		m.changedAt[msg.key] = m.now
+		return m, m.refetchOverrides
+	case watchDeleteMsg:
+		delete(m.values, string(msg))
+		return m, nil
+	case watchOverridesMsg:
+		m.overridden = msg
+	case watchErrMsg:
+		m.err = msg.err
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m watchModel) View() string {
+	var b strings.Builder
+	b.WriteString(watchHeaderStyle.Render(fmt.Sprintf("Watching project %s", m.projectKey)) + " (press q to quit)\n\n")
+	if m.err != nil {
+		b.WriteString(watchErrorStyle.Render(m.err.Error()) + "\n")
+		return b.String()
+	}
+	if !m.loaded {
+		b.WriteString("Connecting to the dev server...\n")
+		return b.String()
+	}
+
+	flagKeys := make([]string, 0, len(m.values))
+	keyWidth := len("FLAG")
+	for flagKey := range m.values {
+		flagKeys = append(flagKeys, flagKey)
+		keyWidth = max(keyWidth, len(flagKey))
+	}
+	sort.Strings(flagKeys)
+	fmt.Fprintf(&b, "  %-*s  %-8s  %s\n", keyWidth, "FLAG", "SOURCE", "VALUE")
+	for _, flagKey := range flagKeys {
+		marker, source := " ", "cloud"
+		if m.overridden[flagKey] {
+			source = "override"
+		}
+		row := fmt.Sprintf("%-*s  %-8s  %s", keyWidth, flagKey, source, m.values[flagKey].JSONString())
+		switch {
+		case m.recentlyChanged(flagKey):
+			marker = "*"
+			row = watchChangedStyle.Render(row)
+		case m.overridden[flagKey]:
+			row = watchOverrideStyle.Render(row)
+		}
+		b.WriteString(marker + " " + row + "\n")
+	}
+	return b.String()
+}
+
+func (m watchModel) recentlyChanged(flagKey string) bool {
+	changedAt, ok := m.changedAt[flagKey]
+	return ok && m.now.Sub(changedAt) < watchHighlight
+}
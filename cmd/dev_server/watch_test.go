@@ -0,0 +1,65 @@
+package dev_server
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+func TestParseWatchEvent(t *testing.T) {
+	assert.Equal(t, watchPutMsg{"new-checkout": ldvalue.Bool(true)}, parseWatchEvent("put", []byte(`{"new-checkout":{"value":true,"version":2}}`)))
+	assert.Equal(t, watchPatchMsg{key: "banner", value: ldvalue.String("blue")}, parseWatchEvent("patch", []byte(`{"key":"banner","value":"blue","version":3}`)))
+	assert.Equal(t, watchDeleteMsg("banner"), parseWatchEvent("delete", []byte(`{"key":"banner","version":4}`)))
+	assert.Nil(t, parseWatchEvent("ping", nil))
+	assert.Nil(t, parseWatchEvent("patch", []byte(`not json`)))
+}
+
+func TestWatchModel(t *testing.T) {
+	refetched := 0
+	var model = newWatchModel("proj", func() tea.Msg {
+		refetched++
+		return nil
+	})
+	update := func(msg tea.Msg) {
+		next, cmd := model.Update(msg)
+		model = next.(watchModel)
+		if cmd != nil {
+			cmd()
+		}
+	}
+	assert.Contains(t, model.View(), "Connecting")
+
+	update(watchPutMsg{"new-checkout": ldvalue.Bool(false), "banner": ldvalue.String("blue")})
+	update(watchOverridesMsg{"banner": true})
+	assert.Equal(t, 1, refetched)
+	lines := strings.Split(model.View(), "\n")
+	require.GreaterOrEqual(t, len(lines), 5)
+	assert.Equal(t, []string{"banner", "override", `"blue"`}, strings.Fields(lines[3]))
+	assert.Equal(t, []string{"new-checkout", "cloud", "false"}, strings.Fields(lines[4]))
+
+	t.Run("highlights flags that just changed", func(t *testing.T) {
+		update(watchPatchMsg{key: "new-checkout", value: ldvalue.Bool(true)})
+		assert.Equal(t, 2, refetched)
+		assert.Equal(t, []string{"*", "new-checkout", "cloud", "true"}, strings.Fields(strings.Split(model.View(), "\n")[4]))
+
+		update(watchTickMsg(model.now.Add(watchHighlight)))
+		assert.Equal(t, []string{"new-checkout", "cloud", "true"}, strings.Fields(strings.Split(model.View(), "\n")[4]))
+	})
+
+	t.Run("highlights flags a put changed", func(t *testing.T) {
+		update(watchPutMsg{"new-checkout": ldvalue.Bool(true), "banner": ldvalue.String("red")})
+		assert.True(t, model.recentlyChanged("banner"))
+		assert.False(t, model.recentlyChanged("new-checkout"))
+	})
+
+	t.Run("removes deleted flags", func(t *testing.T) {
+		update(watchDeleteMsg("banner"))
+		assert.NotContains(t, model.View(), "banner")
+	})
+
+}
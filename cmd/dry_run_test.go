@@ -0,0 +1,131 @@
+package cmd_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/config"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// dryRunSkippedCmds are commands that run until they're stopped or wait for someone, so they can't be run here. None
+// of them change resources.
+var dryRunSkippedCmds = map[string]bool{
+	"ldcli dev-server contract-tests": true,
+	"ldcli dev-server start":          true,
+	"ldcli dev-server ui":             true,
+	"ldcli dev-server watch":          true,
+	"ldcli login":                     true,
+	"ldcli setup":                     true,
+}
+
+func TestDryRunSendsNoChanges(t *testing.T) {
+	keyring.MockInit()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	var mu sync.Mutex
+	var changes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, "REPORT":
+		default:
+			mu.Lock()
+			changes = append(changes, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"_id": "test-id", "key": "test-key", "items": []}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rootCmd, err := cmd.NewRootCommand(
+		config.NewService(&resources.MockClient{}),
+		analytics.NoopClientFn{}.Tracker(),
+		cmd.APIClients{},
+		"test",
+		false,
+	)
+	require.NoError(t, err)
+
+	var leafCmds []*cobra.Command
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		if c.Runnable() && !c.HasSubCommands() {
+			leafCmds = append(leafCmds, c)
+		}
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd.Cmd())
+
+	for _, leafCmd := range leafCmds {
+		path := leafCmd.CommandPath()
+		if dryRunSkippedCmds[path] {
+			continue
+		}
+
+		args := strings.Fields(strings.TrimPrefix(path, "ldcli "))
+		args = append(args,
+			"--dry-run",
+			"--access-token", "test-token",
+			"--base-uri", server.URL,
+			"--port", serverURL.Port(),
+		)
+		leafCmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+			switch {
+			case flag.Name == "follow":
+				args = append(args, "--follow=false")
+			case flag.Name == "backend-url":
+				args = append(args, "--backend-url", server.URL)
+			case len(flag.Annotations[cobra.BashCompOneRequiredFlag]) > 0 || len(flag.Annotations["required"]) > 0:
+				args = append(args, "--"+flag.Name, requiredFlagValue(flag))
+			}
+		})
+
+		t.Run(path, func(t *testing.T) {
+			t.Cleanup(viper.Reset)
+			mu.Lock()
+			changes = nil
+			mu.Unlock()
+
+			// many commands fail on the placeholder flag values, which is fine as long as they don't send changes
+			_, _ = cmd.CallCmd(t, cmd.APIClients{ResourcesClient: resources.NewClient("test")}, analytics.NoopClientFn{}.Tracker(), args)
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Empty(t, changes, "%s sent changes with --dry-run", path)
+		})
+	}
+}
+
+// requiredFlagValue is a value for a required flag that commands can parse, so they get as far as sending requests.
+func requiredFlagValue(flag *pflag.Flag) string {
+	switch {
+	case flag.Name == "data":
+		return "{}"
+	case strings.HasPrefix(flag.Value.Type(), "int"), strings.HasPrefix(flag.Value.Type(), "uint"), flag.Value.Type() == "float64":
+		return "1"
+	case flag.Value.Type() == "bool":
+		return "true"
+	default:
+		return "test"
+	}
+}
@@ -0,0 +1,240 @@
+package flags
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/diff"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/flags"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+const FileFlag = "file"
+
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionUnchanged = "unchanged"
+)
+
+// flagPlan is what applying a manifest does to a flag.
+type flagPlan struct {
+	Key     string     `json:"key"`
+	Action  string     `json:"action"`
+	Changes *diff.Diff `json:"changes,omitempty"`
+
+	body   interface{}
+	method string
+}
+
+func NewApplyCmd(client resources.Client) *cobra.Command {
+	// validating sets the flags from the config too, so whether --project was given is checked first
+	var projectGiven bool
+	cmd := &cobra.Command{
+		Args: func(cmd *cobra.Command, args []string) error {
+			projectGiven = cmd.Flags().Changed(cliflags.ProjectFlag)
+			return validators.Validate()(cmd, args)
+		},
+		Long: `Create and update the flags a manifest file declares, so that a service can bootstrap its flags the same way every time. The changes are listed before they're made, and --dry-run lists them without making them.
+
+A manifest is YAML or JSON:
+
+  project: checkout
+  flags:
+    - key: new-checkout
+      name: New checkout
+      temporary: true
+      tags: [checkout]
+      clientSideAvailability:
+        usingEnvironmentId: true
+        usingMobileKey: false
+    - key: checkout-layout
+      name: Checkout layout
+      kind: multivariate
+      variations:
+        - value: one-page
+        - value: steps
+
+Flags are boolean unless their kind is multivariate. For flags that already exist, the fields a manifest leaves out aren't changed.`,
+		RunE:  runApply(client, &projectGiven),
+		Short: "Create and update flags from a manifest file",
+		Use:   "apply",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	initApplyFlags(cmd)
+
+	return cmd
+}
+
+func runApply(client resources.Client, projectGiven *bool) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		manifest, err := flags.ReadManifest(viper.GetString(FileFlag))
+		if err != nil {
+			return newApplyErr(err.Error())
+		}
+		// the manifest's project takes precedence over a default project from the config
+		projectKey := viper.GetString(cliflags.ProjectFlag)
+		if manifest.Project != "" && !*projectGiven {
+			projectKey = manifest.Project
+		}
+		if projectKey == "" {
+			return newApplyErr(fmt.Sprintf("a project is required, use --%s or set project in the manifest", cliflags.ProjectFlag))
+		}
+
+		plans := make([]flagPlan, 0, len(manifest.Flags))
+		for _, flag := range manifest.Flags {
+			plan, err := planFlag(client, projectKey, flag)
+			if err != nil {
+				return err
+			}
+			plans = append(plans, plan)
+		}
+
+		structured := output.IsStructured(viper.GetString(cliflags.OutputFlag)) || viper.GetString(cliflags.QueryFlag) != ""
+		if !structured {
+			writePlans(cmd.OutOrStdout(), plans)
+		}
+
+		sent := true
+		for _, plan := range plans {
+			if plan.Action == actionUnchanged {
+				continue
+			}
+			path, _ := url.JoinPath(viper.GetString(cliflags.BaseURIFlag), "api/v2/flags", projectKey)
+			if plan.Action == actionUpdate {
+				path, _ = url.JoinPath(path, plan.Key)
+			}
+			data, _ := json.Marshal(plan.body)
+			_, sent, err = resourcescmd.MakeRequest(
+				cmd,
+				client,
+				viper.GetString(cliflags.AccessTokenFlag),
+				plan.method,
+				path,
+				"application/json",
+				nil,
+				data,
+				false,
+			)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+		}
+
+		if structured {
+			res, _ := json.Marshal(struct {
+				Items      []flagPlan `json:"items"`
+				TotalCount int        `json:"totalCount"`
+			}{
+				Items:      plans,
+				TotalCount: len(plans),
+			})
+			out, err := resourcescmd.CmdOutput("list", res)
+			if err != nil {
+				return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), out)
+
+			return nil
+		}
+		if sent {
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully applied %s\n", viper.GetString(FileFlag))
+		}
+
+		return nil
+	}
+}
+
+// planFlag compares a flag in the manifest with the flag in LaunchDarkly, if it exists.
+func planFlag(client resources.Client, projectKey string, flag flags.ManifestFlag) (flagPlan, error) {
+	path, _ := url.JoinPath(viper.GetString(cliflags.BaseURIFlag), "api/v2/flags", projectKey, flag.Key)
+	res, err := client.MakeRequest(
+		viper.GetString(cliflags.AccessTokenFlag),
+		"GET",
+		path,
+		"application/json",
+		url.Values{"summary": []string{"true"}},
+		nil,
+		false,
+	)
+	if errs.IsNotFound(err) {
+		return flagPlan{
+			Key:    flag.Key,
+			Action: actionCreate,
+			body:   flag.NewFlag(),
+			method: "POST",
+		}, nil
+	}
+	if err != nil {
+		return flagPlan{}, output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+	}
+
+	var existing flags.Flag
+	if err := json.Unmarshal(res, &existing); err != nil {
+		return flagPlan{}, output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+	}
+	changes, patch, err := flag.Diff(existing)
+	if err != nil {
+		return flagPlan{}, newApplyErr(err.Error())
+	}
+	if changes.IsEmpty() {
+		return flagPlan{Key: flag.Key, Action: actionUnchanged}, nil
+	}
+
+	return flagPlan{
+		Key:     flag.Key,
+		Action:  actionUpdate,
+		Changes: &changes,
+		body:    patch,
+		method:  "PATCH",
+	}, nil
+}
+
+// writePlans previews the changes, like a diff: + for flags that are created, and ~ for flags that are updated, followed
+// by the differences in the flag.
+func writePlans(out io.Writer, plans []flagPlan) {
+	for _, plan := range plans {
+		switch plan.Action {
+		case actionCreate:
+			fmt.Fprintf(out, "+ %s (create)\n", plan.Key)
+		case actionUpdate:
+			fmt.Fprintf(out, "~ %s (update)\n", plan.Key)
+			changes := *plan.Changes
+			changes.From, changes.To = "", ""
+			var rendered strings.Builder
+			changes.Render(&rendered, diff.ColorEnabled(out))
+			for _, line := range strings.Split(strings.TrimSuffix(rendered.String(), "\n"), "\n") {
+				fmt.Fprintf(out, "    %s\n", line)
+			}
+		default:
+			fmt.Fprintf(out, "  %s (no changes)\n", plan.Key)
+		}
+	}
+}
+
+func newApplyErr(message string) error {
+	return output.NewCmdOutputError(errors.New(message), viper.GetString(cliflags.OutputFlag))
+}
+
+func initApplyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(FileFlag, "f", "", "The manifest file that declares the flags")
+	_ = cmd.MarkFlagRequired(FileFlag)
+	_ = cmd.Flags().SetAnnotation(FileFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(FileFlag, cmd.Flags().Lookup(FileFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key. Defaults to the project in the manifest")
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+}
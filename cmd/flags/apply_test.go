@@ -0,0 +1,158 @@
+package flags_test
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/errors"
+)
+
+// applyMockClient responds with the existing flags, and records the other requests.
+type applyMockClient struct {
+	flags    map[string]string
+	requests []string
+}
+
+func (c *applyMockClient) MakeRequest(accessToken, method, path, contentType string, query url.Values, data []byte, isBeta bool) ([]byte, error) {
+	if method != "GET" {
+		c.requests = append(c.requests, method+" "+path+" "+string(data))
+		return []byte(`{}`), nil
+	}
+	if flag, ok := c.flags[path[strings.LastIndex(path, "/")+1:]]; ok {
+		return []byte(flag), nil
+	}
+
+	return nil, errors.NewError(`{"code": "not_found", "message": "Unknown resource"}`)
+}
+
+func (c *applyMockClient) MakeUnauthenticatedRequest(method, path string, data []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func TestApply(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "flags.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(`
+project: checkout
+flags:
+  - key: new-checkout
+    name: New checkout
+    temporary: true
+    tags: [checkout]
+  - key: checkout-layout
+    name: Checkout layout
+    kind: multivariate
+    variations:
+      - value: one-page
+      - value: steps
+  - key: dark-mode
+    name: Dark mode
+`), 0644))
+	newClient := func() *applyMockClient {
+		return &applyMockClient{
+			flags: map[string]string{
+				"checkout-layout": `{"key": "checkout-layout", "name": "Layout", "kind": "multivariate", "variations": [{"value": "one-page"}, {"value": "steps"}]}`,
+				"dark-mode":       `{"key": "dark-mode", "name": "Dark mode", "kind": "boolean", "variations": [{"value": true}, {"value": false}]}`,
+			},
+		}
+	}
+	args := []string{
+		"flags", "apply",
+		"--access-token", "abcd1234",
+		"-f", manifest,
+	}
+
+	t.Run("previews the changes, and makes them", func(t *testing.T) {
+		client := newClient()
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `+ new-checkout (create)
+~ checkout-layout (update)
+    ~ /name: "Layout" -> "Checkout layout"
+  dark-mode (no changes)
+Successfully applied `+manifest+"\n", string(output))
+		assert.Equal(t, []string{
+			`POST https://app.launchdarkly.com/api/v2/flags/checkout {"key":"new-checkout","name":"New checkout","temporary":true,"tags":["checkout"],"variations":[{"value":true},{"value":false}]}`,
+			`PATCH https://app.launchdarkly.com/api/v2/flags/checkout/checkout-layout [{"op":"replace","path":"/name","value":"Checkout layout"}]`,
+		}, client.requests)
+	})
+
+	t.Run("with --project", func(t *testing.T) {
+		client := newClient()
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--project", "other"),
+		)
+
+		require.NoError(t, err)
+		require.Len(t, client.requests, 2)
+		assert.True(t, strings.HasPrefix(client.requests[0], "POST https://app.launchdarkly.com/api/v2/flags/other "))
+	})
+
+	t.Run("with --dry-run doesn't make the changes", func(t *testing.T) {
+		client := newClient()
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--dry-run"),
+		)
+
+		require.NoError(t, err)
+		assert.Empty(t, client.requests)
+		assert.Contains(t, string(output), "~ checkout-layout (update)\n")
+		assert.Contains(t, string(output), "Dry run, this request was not sent:\nPOST https://app.launchdarkly.com/api/v2/flags/checkout")
+		assert.NotContains(t, string(output), "Successfully applied")
+	})
+
+	t.Run("with JSON output", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: newClient(),
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--output", "json"),
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"items": [
+				{"key": "new-checkout", "action": "create"},
+				{"key": "checkout-layout", "action": "update", "changes": {
+					"from": "checkout-layout",
+					"to": "manifest",
+					"added": [],
+					"removed": [],
+					"changed": [{"path": "/name", "from": "Layout", "to": "Checkout layout"}]
+				}},
+				{"key": "dark-mode", "action": "unchanged"}
+			],
+			"totalCount": 3
+		}`, string(output))
+	})
+}
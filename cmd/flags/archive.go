@@ -38,7 +38,9 @@ func makeArchiveRequest(client resources.Client) func(*cobra.Command, []string)
 			viper.GetString(cliflags.ProjectFlag),
 			viper.GetString(cliflags.FlagFlag),
 		)
-		res, err := client.MakeRequest(
+		res, sent, err := resourcescmd.MakeRequest(
+			cmd,
+			client,
 			viper.GetString(cliflags.AccessTokenFlag),
 			"PATCH",
 			path,
@@ -50,8 +52,11 @@ func makeArchiveRequest(client resources.Client) func(*cobra.Command, []string)
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 
-		output, err := output.CmdOutput("update", viper.GetString(cliflags.OutputFlag), res)
+		output, err := resourcescmd.CmdOutput("update", res)
 		if err != nil {
 			return errors.NewError(err.Error())
 		}
@@ -0,0 +1,338 @@
+package flags
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	"github.com/launchdarkly/ldcli/internal/coderefs"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+const (
+	DirFlag        = "dir"
+	MinAgeDaysFlag = "min-age-days"
+
+	// flagsPageSize is how many flags are listed per request.
+	flagsPageSize = 100
+)
+
+// staleFlag is a flag that can probably be cleaned up, and why.
+type staleFlag struct {
+	Key            string   `json:"key"`
+	Name           string   `json:"name"`
+	Status         string   `json:"status"`
+	LastRequested  string   `json:"lastRequested,omitempty"`
+	AgeDays        int      `json:"ageDays"`
+	Temporary      bool     `json:"temporary"`
+	Maintainer     string   `json:"maintainer,omitempty"`
+	CodeReferences *int     `json:"codeReferences,omitempty"`
+	Score          int      `json:"score"`
+	Reasons        []string `json:"reasons"`
+}
+
+type flagItem struct {
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+	CreationDate int64  `json:"creationDate"`
+	Temporary    bool   `json:"temporary"`
+	Maintainer   struct {
+		Email string `json:"email"`
+	} `json:"_maintainer"`
+}
+
+type flagStatus struct {
+	Name          string `json:"name"`
+	LastRequested string `json:"lastRequested"`
+	Links         struct {
+		Self struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"_links"`
+}
+
+func NewStaleCmd(client resources.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: validators.Validate(),
+		Long: `List the flags in an environment that can probably be cleaned up, most likely first.
+
+A flag is stale if it's older than --min-age-days, and it's either launched, serving one variation to everyone, or inactive, not evaluated in the last seven days. Temporary flags, older flags, and, with --dir, flags that aren't referenced in the code in that directory are more likely to be ready to clean up.`,
+		RunE:  runStale(client),
+		Short: "List flags that can be cleaned up",
+		Use:   "stale",
+	}
+
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+	initStaleFlags(cmd)
+
+	return cmd
+}
+
+func runStale(client resources.Client) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		projectKey := viper.GetString(cliflags.ProjectFlag)
+		environmentKey := viper.GetString(cliflags.EnvironmentFlag)
+
+		flags, err := listFlags(client, projectKey, environmentKey)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		statuses, err := listFlagStatuses(client, projectKey, environmentKey)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+
+		var refs map[string][]coderefs.Reference
+		if dir := viper.GetString(DirFlag); dir != "" {
+			options, err := coderefs.ReadOptions(dir)
+			if err != nil {
+				return newStaleErr(err.Error())
+			}
+			for _, flag := range flags {
+				options.FlagKeys = append(options.FlagKeys, flag.Key)
+			}
+			refs, err = coderefs.Scan(dir, options)
+			if err != nil {
+				return newStaleErr(err.Error())
+			}
+		}
+
+		report := staleFlags(flags, statuses, refs, viper.GetInt(MinAgeDaysFlag), time.Now())
+		outputKind := viper.GetString(cliflags.OutputFlag)
+		if output.IsStructured(outputKind) || output.IsCSV(outputKind) || viper.GetString(cliflags.QueryFlag) != "" {
+			res, _ := json.Marshal(struct {
+				Items      []staleFlag `json:"items"`
+				TotalCount int         `json:"totalCount"`
+			}{
+				Items:      report,
+				TotalCount: len(report),
+			})
+			out, err := resourcescmd.CmdOutput("list", res)
+			if err != nil {
+				return output.NewCmdOutputError(err, outputKind)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), out)
+
+			return nil
+		}
+
+		if len(report) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No stale flags found")
+			return nil
+		}
+
+		return writeStaleTable(cmd.OutOrStdout(), report)
+	}
+}
+
+// staleFlags finds the stale flags, and scores them by how likely they are to be ready to clean up. refs is nil if
+// code wasn't scanned.
+func staleFlags(
+	flags []flagItem,
+	statuses map[string]flagStatus,
+	refs map[string][]coderefs.Reference,
+	minAgeDays int,
+	now time.Time,
+) []staleFlag {
+	report := make([]staleFlag, 0)
+	for _, flag := range flags {
+		status := statuses[flag.Key]
+		ageDays := int(now.Sub(time.UnixMilli(flag.CreationDate)).Hours() / 24)
+		if ageDays < minAgeDays {
+			continue
+		}
+
+		stale := staleFlag{
+			Key:           flag.Key,
+			Name:          flag.Name,
+			Status:        status.Name,
+			LastRequested: status.LastRequested,
+			AgeDays:       ageDays,
+			Temporary:     flag.Temporary,
+			Maintainer:    flag.Maintainer.Email,
+		}
+		switch status.Name {
+		case "launched":
+			stale.Score += 3
+			stale.Reasons = append(stale.Reasons, "serving one variation to everyone")
+		case "inactive":
+			stale.Score += 3
+			stale.Reasons = append(stale.Reasons, "not evaluated in the last 7 days")
+		default:
+			continue
+		}
+		if flag.Temporary {
+			stale.Score++
+			stale.Reasons = append(stale.Reasons, "temporary")
+		}
+		if ageDays >= 2*minAgeDays {
+			stale.Score++
+			stale.Reasons = append(stale.Reasons, fmt.Sprintf("created %d days ago", ageDays))
+		}
+		if refs != nil {
+			count := len(refs[flag.Key])
+			stale.CodeReferences = &count
+			if count == 0 {
+				stale.Score += 2
+				stale.Reasons = append(stale.Reasons, "not referenced in code")
+			}
+		}
+
+		report = append(report, stale)
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		if report[i].Score != report[j].Score {
+			return report[i].Score > report[j].Score
+		}
+		if report[i].AgeDays != report[j].AgeDays {
+			return report[i].AgeDays > report[j].AgeDays
+		}
+		return report[i].Key < report[j].Key
+	})
+
+	return report
+}
+
+// listFlags lists all the flags in a project, a page at a time.
+func listFlags(client resources.Client, projectKey string, environmentKey string) ([]flagItem, error) {
+	path, _ := url.JoinPath(
+		viper.GetString(cliflags.BaseURIFlag),
+		"api/v2/flags",
+		projectKey,
+	)
+
+	var flags []flagItem
+	for {
+		query := url.Values{
+			"env":     []string{environmentKey},
+			"limit":   []string{strconv.Itoa(flagsPageSize)},
+			"offset":  []string{strconv.Itoa(len(flags))},
+			"summary": []string{"true"},
+		}
+		res, err := client.MakeRequest(
+			viper.GetString(cliflags.AccessTokenFlag),
+			"GET",
+			path,
+			"application/json",
+			query,
+			nil,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Items      []flagItem `json:"items"`
+			TotalCount int        `json:"totalCount"`
+		}
+		if err := json.Unmarshal(res, &page); err != nil {
+			return nil, err
+		}
+		flags = append(flags, page.Items...)
+		if len(page.Items) == 0 || len(flags) >= page.TotalCount {
+			return flags, nil
+		}
+	}
+}
+
+// listFlagStatuses gets the evaluation status of each flag in an environment, by flag key.
+func listFlagStatuses(client resources.Client, projectKey string, environmentKey string) (map[string]flagStatus, error) {
+	statusesPath, _ := url.JoinPath(
+		viper.GetString(cliflags.BaseURIFlag),
+		"api/v2/flag-statuses",
+		projectKey,
+		environmentKey,
+	)
+	res, err := client.MakeRequest(
+		viper.GetString(cliflags.AccessTokenFlag),
+		"GET",
+		statusesPath,
+		"application/json",
+		nil,
+		nil,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var page struct {
+		Items []flagStatus `json:"items"`
+	}
+	if err := json.Unmarshal(res, &page); err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]flagStatus, len(page.Items))
+	for _, status := range page.Items {
+		// the status's own link ends with the flag key
+		statuses[path.Base(status.Links.Self.Href)] = status
+	}
+
+	return statuses, nil
+}
+
+func writeStaleTable(out io.Writer, report []staleFlag) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tSTATUS\tAGE\tLAST EVALUATED\tCODE REFS\tSCORE\tREASONS")
+	for _, flag := range report {
+		lastRequested := "never"
+		if t, err := time.Parse(time.RFC3339, flag.LastRequested); err == nil {
+			lastRequested = t.Local().Format(time.DateOnly)
+		}
+		codeRefs := "-"
+		if flag.CodeReferences != nil {
+			codeRefs = strconv.Itoa(*flag.CodeReferences)
+		}
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%dd\t%s\t%s\t%d\t%s\n",
+			flag.Key,
+			flag.Status,
+			flag.AgeDays,
+			lastRequested,
+			codeRefs,
+			flag.Score,
+			strings.Join(flag.Reasons, ", "),
+		)
+	}
+	return w.Flush()
+}
+
+func newStaleErr(message string) error {
+	return output.NewCmdOutputError(errors.New(message), viper.GetString(cliflags.OutputFlag))
+}
+
+func initStaleFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cliflags.EnvironmentFlag, "", "The environment key")
+	_ = cmd.MarkFlagRequired(cliflags.EnvironmentFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.EnvironmentFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.EnvironmentFlag, cmd.Flags().Lookup(cliflags.EnvironmentFlag))
+
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key")
+	_ = cmd.MarkFlagRequired(cliflags.ProjectFlag)
+	_ = cmd.Flags().SetAnnotation(cliflags.ProjectFlag, "required", []string{"true"})
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+
+	cmd.Flags().Int(MinAgeDaysFlag, 30, "Only list flags created at least this many days ago")
+	_ = viper.BindPFlag(MinAgeDaysFlag, cmd.Flags().Lookup(MinAgeDaysFlag))
+
+	cmd.Flags().String(DirFlag, "", "Scan the code in this directory for references to the flags, like coderefs scan")
+	_ = viper.BindPFlag(DirFlag, cmd.Flags().Lookup(DirFlag))
+}
@@ -0,0 +1,137 @@
+package flags_test
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+)
+
+// staleMockClient responds to the flags and flag statuses requests.
+type staleMockClient struct {
+	flags    string
+	statuses string
+}
+
+func (c staleMockClient) MakeRequest(accessToken, method, path, contentType string, query url.Values, data []byte, isBeta bool) ([]byte, error) {
+	if strings.Contains(path, "/api/v2/flag-statuses/") {
+		return []byte(c.statuses), nil
+	}
+
+	return []byte(c.flags), nil
+}
+
+func (c staleMockClient) MakeUnauthenticatedRequest(method, path string, data []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func TestStale(t *testing.T) {
+	daysAgo := func(days int) int64 {
+		return time.Now().Add(-time.Duration(days) * 24 * time.Hour).UnixMilli()
+	}
+	client := staleMockClient{
+		flags: fmt.Sprintf(`{
+			"items": [
+				{"key": "launched-flag", "name": "Launched flag", "creationDate": %d, "temporary": true},
+				{"key": "inactive-flag", "name": "Inactive flag", "creationDate": %d, "_maintainer": {"email": "test@example.com"}},
+				{"key": "active-flag", "name": "Active flag", "creationDate": %d},
+				{"key": "new-flag", "name": "New flag", "creationDate": %d}
+			],
+			"totalCount": 4
+		}`, daysAgo(40), daysAgo(90), daysAgo(100), daysAgo(5)),
+		statuses: `{
+			"items": [
+				{"name": "launched", "lastRequested": "2026-01-02T00:00:00Z", "_links": {"self": {"href": "/api/v2/flag-statuses/test-proj/test-env/launched-flag"}}},
+				{"name": "inactive", "_links": {"self": {"href": "/api/v2/flag-statuses/test-proj/test-env/inactive-flag"}}},
+				{"name": "active", "_links": {"self": {"href": "/api/v2/flag-statuses/test-proj/test-env/active-flag"}}},
+				{"name": "inactive", "_links": {"self": {"href": "/api/v2/flag-statuses/test-proj/test-env/new-flag"}}}
+			]
+		}`,
+	}
+	args := []string{
+		"flags", "stale",
+		"--access-token", "abcd1234",
+		"--environment", "test-env",
+		"--project", "test-proj",
+	}
+
+	t.Run("lists launched and inactive flags older than the minimum age, most likely to clean up first", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--output", "json"),
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"items": [
+				{
+					"key": "inactive-flag",
+					"name": "Inactive flag",
+					"status": "inactive",
+					"ageDays": 90,
+					"temporary": false,
+					"maintainer": "test@example.com",
+					"score": 4,
+					"reasons": ["not evaluated in the last 7 days", "created 90 days ago"]
+				},
+				{
+					"key": "launched-flag",
+					"name": "Launched flag",
+					"status": "launched",
+					"lastRequested": "2026-01-02T00:00:00Z",
+					"ageDays": 40,
+					"temporary": true,
+					"score": 4,
+					"reasons": ["serving one variation to everyone", "temporary"]
+				}
+			],
+			"totalCount": 2
+		}`, string(output))
+	})
+
+	t.Run("with code references", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(`variation("launched-flag")`+"\n"), 0644))
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--dir", dir, "--output", "json", "--query", "items[].[key, codeReferences, score]"),
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `[["inactive-flag", 0, 6], ["launched-flag", 1, 4]]`, string(output))
+	})
+
+	t.Run("as a table", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{
+				ResourcesClient: client,
+			},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--min-age-days", "60"),
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `KEY            STATUS    AGE  LAST EVALUATED  CODE REFS  SCORE  REASONS
+inactive-flag  inactive  90d  never           -          3      not evaluated in the last 7 days
+`, string(output))
+	})
+}
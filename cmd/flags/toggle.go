@@ -60,7 +60,9 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 			viper.GetString(cliflags.ProjectFlag),
 			viper.GetString(cliflags.FlagFlag),
 		)
-		res, err := client.MakeRequest(
+		res, sent, err := resourcescmd.MakeRequest(
+			cmd,
+			client,
 			viper.GetString(cliflags.AccessTokenFlag),
 			"PATCH",
 			path,
@@ -72,8 +74,11 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 
-		output, err := output.CmdOutput("update", viper.GetString(cliflags.OutputFlag), res)
+		output, err := resourcescmd.CmdOutput("update", res)
 		if err != nil {
 			return errors.NewError(err.Error())
 		}
@@ -38,3 +38,39 @@ func TestToggleOn(t *testing.T) {
 	assert.Equal(t, `[{"op": "replace", "path": "/environments/test-env/on", "value": true}]`, string(mockClient.Input))
 	assert.Equal(t, "Successfully updated test flag (test-flag)\n", string(output))
 }
+
+func TestToggleOnDryRun(t *testing.T) {
+	mockClient := &resources.MockClient{}
+	args := []string{
+		"flags", "toggle-on",
+		"--access-token", "abcd1234",
+		"--environment", "test-env",
+		"--flag", "test-flag",
+		"--project", "test-proj",
+		"--dry-run",
+	}
+	output, err := cmd.CallCmd(
+		t,
+		cmd.APIClients{
+			ResourcesClient: mockClient,
+		},
+		analytics.NoopClientFn{}.Tracker(),
+		args,
+	)
+
+	require.NoError(t, err)
+	assert.Nil(t, mockClient.Input)
+	assert.Equal(t, `Dry run, this request was not sent:
+PATCH https://app.launchdarkly.com/api/v2/flags/test-proj/test-flag
+Authorization: [REDACTED]
+Content-Type: application/json
+
+[
+  {
+    "op": "replace",
+    "path": "/environments/test-env/on",
+    "value": true
+  }
+]
+`, string(output))
+}
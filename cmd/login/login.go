@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
@@ -17,35 +18,60 @@ import (
 	"github.com/launchdarkly/ldcli/internal/resources"
 )
 
+const TTLFlag = "ttl"
+
 func NewLoginCmd(client resources.UnauthenticatedClient) *cobra.Command {
 	cmd := cobra.Command{
-		Long:  "",
+		Long: `Log in to your LaunchDarkly account in your browser, which provisions an access token for the CLI.
+Use --role and --ttl to scope the access token, and --profile to log in to another account as a profile
+
+Examples:
+  # Log in with an access token that can only read, and expires after a day
+  ldcli login --role reader --ttl 24h`,
 		RunE:  run(client),
 		Short: "Log in to your LaunchDarkly account to set up the CLI",
 		Use:   "login",
 	}
 
+	cmd.Flags().String(cliflags.RoleFlag, "", "Built-in role for the access token, such as reader or writer. Defaults to your own role")
+	cmd.Flags().Duration(TTLFlag, 0, "How long the access token lasts, e.g. 8h. Defaults to an access token that doesn't expire")
+
 	return &cmd
 }
 
 func run(client resources.UnauthenticatedClient) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		if ok, err := config.AccessTokenIsSet(viper.GetViper().ConfigFileUsed()); !ok {
+		profile := viper.GetString(cliflags.ProfileFlag)
+		if ok, err := config.AccessTokenIsSet(viper.GetViper().ConfigFileUsed(), profile); !ok {
 			return err
 		}
 
+		conf, err := config.New(viper.ConfigFileUsed(), os.ReadFile)
+		if err != nil {
+			return err
+		}
+		baseURI := viper.GetString(cliflags.BaseURIFlag)
+		if profile != "" && conf.Profiles[profile].BaseURI != "" && !cmd.Flags().Changed(cliflags.BaseURIFlag) {
+			baseURI = conf.Profiles[profile].BaseURI
+		}
+
+		var options login.TokenOptions
+		options.Role, _ = cmd.Flags().GetString(cliflags.RoleFlag)
+		options.TTL, _ = cmd.Flags().GetDuration(TTLFlag)
+
 		deviceAuthorization, err := login.FetchDeviceAuthorization(
 			client,
 			login.ClientID,
 			login.GetDeviceName(),
-			viper.GetString(cliflags.BaseURIFlag),
+			baseURI,
+			options,
 		)
 		if err != nil {
 			return err
 		}
 
 		fullURL, _ := url.JoinPath(
-			viper.GetString(cliflags.BaseURIFlag),
+			baseURI,
 			deviceAuthorization.VerificationURI,
 		)
 		var b strings.Builder
@@ -57,32 +83,33 @@ func run(client resources.UnauthenticatedClient) func(*cobra.Command, []string)
 
 		_ = browser.OpenURL(fullURL)
 
+		interval, maxAttempts := deviceAuthorization.PollingSchedule()
 		deviceAuthorizationToken, err := login.FetchToken(
 			client,
 			deviceAuthorization.DeviceCode,
-			viper.GetString(cliflags.BaseURIFlag),
-			login.TokenInterval,
-			login.MaxFetchTokenAttempts,
+			baseURI,
+			interval,
+			maxAttempts,
 		)
 		if err != nil {
 			return err
 		}
 
-		conf, err := config.New(viper.ConfigFileUsed(), os.ReadFile)
-		if err != nil {
-			return err
-		}
-		conf, _, err = conf.Update([]string{cliflags.AccessTokenFlag, deviceAuthorizationToken})
+		_, inKeychain, err := configcmd.Save([]string{cliflags.AccessTokenFlag, deviceAuthorizationToken.AccessToken})
 		if err != nil {
 			return err
 		}
 
-		err = configcmd.Write(conf, configcmd.SetKey)
-		if err != nil {
-			return err
+		message := "Your token has been written to the configuration file"
+		if inKeychain {
+			message = "Your token has been stored in the OS keychain"
 		}
 
-		fmt.Fprintln(cmd.OutOrStdout(), "Your token has been written to the configuration file")
+		if deviceAuthorizationToken.ExpiresAt > 0 {
+			expiresAt := time.UnixMilli(deviceAuthorizationToken.ExpiresAt).Local().Format(time.RFC1123)
+			message += fmt.Sprintf(". It expires at %s", expiresAt)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), message)
 
 		return nil
 	}
@@ -50,7 +50,9 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 			viper.GetString(cliflags.BaseURIFlag),
 			"api/v2/members",
 		)
-		res, err := client.MakeRequest(
+		res, sent, err := resourcescmd.MakeRequest(
+			cmd,
+			client,
 			viper.GetString(cliflags.AccessTokenFlag),
 			"POST",
 			path,
@@ -62,8 +64,11 @@ func runE(client resources.Client) func(*cobra.Command, []string) error {
 		if err != nil {
 			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 		}
+		if !sent {
+			return nil
+		}
 
-		output, err := output.CmdOutput("update", viper.GetString(cliflags.OutputFlag), res)
+		output, err := resourcescmd.CmdOutput("update", res)
 		if err != nil {
 			return errors.NewError(err.Error())
 		}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	"github.com/launchdarkly/ldcli/cmd/validators"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+)
+
+const (
+	// pluginPrefix is the start of the name of the executables that are run as plugins, e.g. ldcli-audit runs as
+	// ldcli audit.
+	pluginPrefix = "ldcli-"
+	// pluginAnnotation marks the commands that run plugins, and holds the plugin's executable.
+	pluginAnnotation = "plugin"
+)
+
+// addPluginCmds adds a command for each ldcli-<name> executable on the PATH. Like kubectl, a plugin can't replace a
+// built-in command, and the first executable on the PATH with a name is the one that's run.
+func addPluginCmds(root *cobra.Command, version string) {
+	plugins := findPlugins(filepath.SplitList(os.Getenv("PATH")))
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if existing, _, err := root.Find([]string{name}); err == nil && existing != root {
+			continue
+		}
+		root.AddCommand(newPluginCmd(name, plugins[name], version))
+	}
+}
+
+// findPlugins finds the ldcli-<name> executables in the directories, by name.
+func findPlugins(dirs []string) map[string]string {
+	plugins := make(map[string]string)
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := strings.CutPrefix(entry.Name(), pluginPrefix)
+			if !ok || entry.IsDir() {
+				continue
+			}
+			if runtime.GOOS == "windows" {
+				if name, ok = strings.CutSuffix(name, ".exe"); !ok {
+					continue
+				}
+			} else if info, err := entry.Info(); err != nil || info.Mode()&0111 == 0 {
+				continue
+			}
+			if _, ok := plugins[name]; name != "" && !ok {
+				plugins[name] = filepath.Join(dir, entry.Name())
+			}
+		}
+	}
+
+	return plugins
+}
+
+func newPluginCmd(name string, path string, version string) *cobra.Command {
+	return &cobra.Command{
+		Annotations: map[string]string{pluginAnnotation: path},
+		// the plugin gets all its arguments, flags included, as it was run with them
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validators.UseSettings(); err != nil {
+				return err
+			}
+
+			plugin := exec.Command(path, args...)
+			plugin.Env = append(os.Environ(), pluginEnv(version)...)
+			plugin.Stdin = cmd.InOrStdin()
+			plugin.Stdout = cmd.OutOrStdout()
+			plugin.Stderr = cmd.ErrOrStderr()
+			err := plugin.Run()
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				// the plugin has already explained what went wrong
+				return errs.NewExitError(errors.New(""), exitErr.ExitCode())
+			}
+
+			return err
+		},
+		Short: fmt.Sprintf("Plugin at %s", path),
+		Use:   name,
+	}
+}
+
+// pluginEnv is the configuration and auth context that plugins get as environment variables. The settings use the
+// same variables ldcli reads them from, so a plugin that runs ldcli passes them on.
+func pluginEnv(version string) []string {
+	env := []string{
+		"LDCLI_VERSION=" + version,
+		"LDCLI_CONFIG_FILE=" + viper.ConfigFileUsed(),
+	}
+	if executable, err := os.Executable(); err == nil {
+		env = append(env, "LDCLI_EXECUTABLE="+executable)
+	}
+	for _, flag := range []string{
+		cliflags.AccessTokenFlag,
+		cliflags.BaseURIFlag,
+		cliflags.EnvironmentFlag,
+		cliflags.FlagFlag,
+		cliflags.OutputFlag,
+		cliflags.ProjectFlag,
+		cliflags.ProxyFlag,
+	} {
+		if value := viper.GetString(flag); value != "" {
+			env = append(env, "LD_"+strings.ToUpper(strings.ReplaceAll(flag, "-", "_"))+"="+value)
+		}
+	}
+
+	return env
+}
+
+// pluginCmds lists the commands that run plugins, for the usage template.
+func pluginCmds(cmd *cobra.Command) []*cobra.Command {
+	var plugins []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if _, ok := c.Annotations[pluginAnnotation]; ok {
+			plugins = append(plugins, c)
+		}
+	}
+
+	return plugins
+}
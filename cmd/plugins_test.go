@@ -0,0 +1,62 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	errs "github.com/launchdarkly/ldcli/internal/errors"
+)
+
+func TestPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test plugins are shell scripts")
+	}
+	usePlugin := func(t *testing.T, name string, script string) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ldcli-"+name), []byte("#!/bin/sh\n"+script), 0755))
+		t.Setenv("PATH", dir+string(filepath.ListSeparator)+os.Getenv("PATH"))
+		t.Cleanup(viper.Reset)
+	}
+
+	t.Run("runs a plugin with its arguments and the CLI's settings", func(t *testing.T) {
+		usePlugin(t, "hello", `echo "$@"; echo "$LD_ACCESS_TOKEN $LD_PROJECT $LDCLI_VERSION"`)
+		t.Setenv("LD_ACCESS_TOKEN", "abcd1234")
+		t.Setenv("LD_PROJECT", "proj")
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{"hello", "world", "--flag", "new-checkout"},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "world --flag new-checkout\nabcd1234 proj test\n", string(output))
+	})
+
+	t.Run("exits with the plugin's exit code", func(t *testing.T) {
+		usePlugin(t, "fail", "exit 3")
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), []string{"fail"})
+
+		require.Error(t, err)
+		assert.Equal(t, 3, errs.ExitCode(err))
+	})
+
+	t.Run("doesn't replace built-in commands", func(t *testing.T) {
+		usePlugin(t, "flags", "echo plugin")
+
+		output, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), []string{"flags", "--help"})
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "ldcli flags [command]")
+	})
+}
@@ -0,0 +1,383 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cmdAnalytics "github.com/launchdarkly/ldcli/cmd/analytics"
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	resourcescmd "github.com/launchdarkly/ldcli/cmd/resources"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/queries"
+)
+
+const (
+	// FileFlag is read from the command rather than viper since dev-server import-project binds the same key.
+	FileFlag      = "file"
+	OverwriteFlag = "overwrite"
+)
+
+func NewQueryCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Save and run named queries",
+		Long: `Save commands you run often, such as audit-log or flag list queries, under a name and run them later.
+
+Queries are stored in queries.yml next to the config file. Export and import query files to share conventions across
+a team.
+
+Examples:
+  ldcli query save prod-changes "audit-log list --spec 'proj/*:env/production'"
+  ldcli query run prod-changes
+  ldcli query export --file team-queries.yml
+  ldcli query import --file team-queries.yml`,
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(newSaveCmd(analyticsTrackerFn))
+	cmd.AddCommand(newRunCmd(analyticsTrackerFn))
+	cmd.AddCommand(newListCmd(analyticsTrackerFn))
+	cmd.AddCommand(newDeleteCmd(analyticsTrackerFn))
+	cmd.AddCommand(newExportCmd(analyticsTrackerFn))
+	cmd.AddCommand(newImportCmd(analyticsTrackerFn))
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+
+	return cmd
+}
+
+func newSaveCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(2),
+		Long:  "Save a command under a name. Quote the command so its flags are saved rather than parsed.",
+		RunE:  saveQuery,
+		Short: "Save a query",
+		Use:   "save <name> <command>",
+	}
+	initCmd(cmd, analyticsTrackerFn)
+
+	return cmd
+}
+
+func saveQuery(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	queryArgs, err := queries.SplitArgs(args[1])
+	if err != nil {
+		return err
+	}
+	queryArgs = trimProgramName(queryArgs)
+	if err := validateQuery(cmd.Root(), queryArgs); err != nil {
+		return err
+	}
+
+	filename := queries.GetQueriesFile()
+	saved, err := queries.Load(filename)
+	if err != nil {
+		return err
+	}
+	saved[name] = strings.Join(quoteArgs(queryArgs), " ")
+	if err := saved.Write(filename); err != nil {
+		return errors.NewError(fmt.Sprintf("unable to write queries file %s", filename))
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Saved query %s\n", name)
+
+	return nil
+}
+
+func newRunCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args: cobra.MinimumNArgs(1),
+		// anything after the name is passed through to the saved command, flags included
+		DisableFlagParsing: true,
+		Long:               "Run a saved query. Any arguments after the name are appended to the saved command.",
+		RunE:               runQuery,
+		Short:              "Run a saved query",
+		Use:                "run <name> [args...]",
+	}
+	initCmd(cmd, analyticsTrackerFn)
+
+	return cmd
+}
+
+func runQuery(cmd *cobra.Command, args []string) error {
+	if args[0] == "-h" || args[0] == "--help" {
+		return cmd.Help()
+	}
+
+	saved, err := queries.Load(queries.GetQueriesFile())
+	if err != nil {
+		return err
+	}
+	query, ok := saved[args[0]]
+	if !ok {
+		return errors.NewError(fmt.Sprintf("no saved query named %s", args[0]))
+	}
+	queryArgs, err := queries.SplitArgs(query)
+	if err != nil {
+		return err
+	}
+	queryArgs = append(trimProgramName(queryArgs), args[1:]...)
+	if err := validateQuery(cmd.Root(), queryArgs); err != nil {
+		return err
+	}
+
+	root := cmd.Root()
+	root.SetArgs(queryArgs)
+
+	return root.Execute()
+}
+
+func newListCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Long:  "List saved queries",
+		RunE:  listQueries,
+		Short: "List saved queries",
+		Use:   "list",
+	}
+	initCmd(cmd, analyticsTrackerFn)
+
+	return cmd
+}
+
+func listQueries(cmd *cobra.Command, args []string) error {
+	saved, err := queries.Load(queries.GetQueriesFile())
+	if err != nil {
+		return err
+	}
+
+	if output.IsStructured(viper.GetString(cliflags.OutputFlag)) {
+		data, err := json.Marshal(saved)
+		if err != nil {
+			return err
+		}
+		out, err := output.FromJSON(viper.GetString(cliflags.OutputFlag), data)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), out)
+
+		return nil
+	}
+
+	if len(saved) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No saved queries")
+
+		return nil
+	}
+	for _, name := range saved.Names() {
+		fmt.Fprintf(cmd.OutOrStdout(), "* %s: %s\n", name, saved[name])
+	}
+
+	return nil
+}
+
+func newDeleteCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Long:  "Delete a saved query",
+		RunE:  deleteQuery,
+		Short: "Delete a saved query",
+		Use:   "delete <name>",
+	}
+	initCmd(cmd, analyticsTrackerFn)
+
+	return cmd
+}
+
+func deleteQuery(cmd *cobra.Command, args []string) error {
+	filename := queries.GetQueriesFile()
+	saved, err := queries.Load(filename)
+	if err != nil {
+		return err
+	}
+	if _, ok := saved[args[0]]; !ok {
+		return errors.NewError(fmt.Sprintf("no saved query named %s", args[0]))
+	}
+	delete(saved, args[0])
+	if err := saved.Write(filename); err != nil {
+		return errors.NewError(fmt.Sprintf("unable to write queries file %s", filename))
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Deleted query %s\n", args[0])
+
+	return nil
+}
+
+func newExportCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ArbitraryArgs,
+		Long:  "Export saved queries to a file that can be shared and imported. Exports every query if no names are given.",
+		RunE:  exportQueries,
+		Short: "Export saved queries",
+		Use:   "export [names...]",
+	}
+	initCmd(cmd, analyticsTrackerFn)
+	cmd.Flags().String(FileFlag, "", "File to write the queries to. Defaults to stdout")
+
+	return cmd
+}
+
+func exportQueries(cmd *cobra.Command, args []string) error {
+	saved, err := queries.Load(queries.GetQueriesFile())
+	if err != nil {
+		return err
+	}
+	exported := saved
+	if len(args) > 0 {
+		exported = queries.Queries{}
+		for _, name := range args {
+			query, ok := saved[name]
+			if !ok {
+				return errors.NewError(fmt.Sprintf("no saved query named %s", name))
+			}
+			exported[name] = query
+		}
+	}
+
+	data, err := exported.Marshal()
+	if err != nil {
+		return err
+	}
+	filename, _ := cmd.Flags().GetString(FileFlag)
+	if filename == "" {
+		fmt.Fprint(cmd.OutOrStdout(), string(data))
+
+		return nil
+	}
+	if err := os.WriteFile(filename, data, 0o644); err != nil {
+		return errors.NewError(fmt.Sprintf("unable to write %s", filename))
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Exported %d queries to %s\n", len(exported), filename)
+
+	return nil
+}
+
+func newImportCmd(analyticsTrackerFn analytics.TrackerFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Long:  "Import queries from an exported query file. Queries that already exist with a different command are skipped unless --overwrite is set.",
+		RunE:  importQueries,
+		Short: "Import queries from a file",
+		Use:   "import",
+	}
+	initCmd(cmd, analyticsTrackerFn)
+	cmd.Flags().String(FileFlag, "", "Query file to import")
+	_ = cmd.MarkFlagRequired(FileFlag)
+	_ = cmd.Flags().SetAnnotation(FileFlag, "required", []string{"true"})
+	cmd.Flags().Bool(OverwriteFlag, false, "Replace existing queries that have the same name")
+
+	return cmd
+}
+
+func importQueries(cmd *cobra.Command, args []string) error {
+	importFilename, _ := cmd.Flags().GetString(FileFlag)
+	if importFilename == "" {
+		return errors.NewError(fmt.Sprintf("required flag(s) \"%s\" not set", FileFlag))
+	}
+	data, err := os.ReadFile(importFilename)
+	if err != nil {
+		return errors.NewError(fmt.Sprintf("unable to read %s", importFilename))
+	}
+	imported, err := queries.Parse(data)
+	if err != nil {
+		return err
+	}
+	for _, name := range imported.Names() {
+		queryArgs, err := queries.SplitArgs(imported[name])
+		if err != nil {
+			return err
+		}
+		if err := validateQuery(cmd.Root(), trimProgramName(queryArgs)); err != nil {
+			return errors.NewError(fmt.Sprintf("query %s is invalid: %s", name, err))
+		}
+	}
+
+	filename := queries.GetQueriesFile()
+	saved, err := queries.Load(filename)
+	if err != nil {
+		return err
+	}
+	overwrite, _ := cmd.Flags().GetBool(OverwriteFlag)
+	merged, conflicts := saved.Merge(imported, overwrite)
+	if err := saved.Write(filename); err != nil {
+		return errors.NewError(fmt.Sprintf("unable to write queries file %s", filename))
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Imported %d queries\n", len(merged))
+	if len(conflicts) > 0 {
+		fmt.Fprintf(
+			cmd.OutOrStdout(),
+			"Skipped existing queries with different commands: %s. Use --%s to replace them.\n",
+			strings.Join(conflicts, ", "),
+			OverwriteFlag,
+		)
+	}
+
+	return nil
+}
+
+func initCmd(cmd *cobra.Command, analyticsTrackerFn analytics.TrackerFn) {
+	cmd.PreRun = func(cmd *cobra.Command, args []string) {
+		analyticsTrackerFn(
+			viper.GetString(cliflags.AccessTokenFlag),
+			viper.GetString(cliflags.BaseURIFlag),
+			viper.GetBool(cliflags.AnalyticsOptOut),
+		).SendCommandRunEvent(cmdAnalytics.CmdRunEventProperties(
+			cmd,
+			"query",
+			map[string]interface{}{
+				"action": cmd.Name(),
+			},
+		))
+	}
+	cmd.SetUsageTemplate(resourcescmd.SubcommandUsageTemplate())
+}
+
+// trimProgramName drops a leading "ldcli" so queries copied from a terminal work as-is.
+func trimProgramName(args []string) []string {
+	if len(args) > 0 && args[0] == "ldcli" {
+		return args[1:]
+	}
+
+	return args
+}
+
+// validateQuery checks that args name an ldcli command other than query itself, which would let a query run itself.
+func validateQuery(root *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.NewError("query is empty")
+	}
+	found, _, err := root.Find(args)
+	if err != nil || found == root {
+		return errors.NewError(fmt.Sprintf("unknown command %q", args[0]))
+	}
+	for c := found; c != nil && c != root; c = c.Parent() {
+		if c.Name() == "query" && c.Parent() == root {
+			return errors.NewError("queries can't run query commands")
+		}
+	}
+
+	return nil
+}
+
+// quoteArgs quotes arguments that contain whitespace or quotes so the saved query splits back into the same args.
+func quoteArgs(args []string) []string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\") {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+
+	return quoted
+}
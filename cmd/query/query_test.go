@@ -0,0 +1,83 @@
+package query_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/queries"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+func TestQuery(t *testing.T) {
+	callCmd := func(t *testing.T, client *resources.MockClient, args ...string) ([]byte, error) {
+		return cmd.CallCmd(t, cmd.APIClients{ResourcesClient: client}, analytics.NoopClientFn{}.Tracker(), args)
+	}
+
+	t.Run("saves and runs a query", func(t *testing.T) {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+		client := &resources.MockClient{Response: []byte(`{"key": "test-flag", "name": "test flag"}`)}
+
+		output, err := callCmd(t, client,
+			"query", "save", "toggle-test",
+			"ldcli flags toggle-on --environment test-env --flag test-flag --project 'test-proj'",
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "Saved query toggle-test\n", string(output))
+
+		output, err = callCmd(t, client, "query", "run", "toggle-test", "--access-token", "abcd1234")
+		require.NoError(t, err)
+		assert.Equal(t, `[{"op": "replace", "path": "/environments/test-env/on", "value": true}]`, string(client.Input))
+		assert.Equal(t, "Successfully updated test flag (test-flag)\n", string(output))
+	})
+
+	t.Run("rejects unknown commands", func(t *testing.T) {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+		_, err := callCmd(t, &resources.MockClient{}, "query", "save", "bad", "nope --flag x")
+
+		assert.EqualError(t, err, `unknown command "nope"`)
+	})
+
+	t.Run("rejects queries that run queries", func(t *testing.T) {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+		_, err := callCmd(t, &resources.MockClient{}, "query", "save", "loop", "query run loop")
+
+		assert.EqualError(t, err, "queries can't run query commands")
+	})
+
+	t.Run("exports and imports queries", func(t *testing.T) {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+		require.NoError(t, queries.Queries{
+			"prod-changes": "audit-log list --spec 'proj/*:env/production'",
+			"flags":        "flags list --project default",
+		}.Write(queries.GetQueriesFile()))
+		exportFile := filepath.Join(t.TempDir(), "team.yml")
+
+		_, err := callCmd(t, &resources.MockClient{}, "query", "export", "prod-changes", "--file", exportFile)
+		require.NoError(t, err)
+
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+		require.NoError(t, queries.Queries{"prod-changes": "audit-log list"}.Write(queries.GetQueriesFile()))
+
+		output, err := callCmd(t, &resources.MockClient{}, "query", "import", "--file", exportFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Imported 0 queries\nSkipped existing queries with different commands: prod-changes. Use --overwrite to replace them.\n", string(output))
+
+		output, err = callCmd(t, &resources.MockClient{}, "query", "import", "--file", exportFile, "--overwrite")
+		require.NoError(t, err)
+		assert.Equal(t, "Imported 1 queries\n", string(output))
+
+		data, err := os.ReadFile(queries.GetQueriesFile())
+		require.NoError(t, err)
+		saved, err := queries.Parse(data)
+		require.NoError(t, err)
+		assert.Equal(t, queries.Queries{"prod-changes": "audit-log list --spec 'proj/*:env/production'"}, saved)
+	})
+}
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -11,10 +14,13 @@ import (
 
 	cmdAnalytics "github.com/launchdarkly/ldcli/cmd/analytics"
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	configcmd "github.com/launchdarkly/ldcli/cmd/config"
+	resourcecmd "github.com/launchdarkly/ldcli/cmd/resources"
 	"github.com/launchdarkly/ldcli/cmd/validators"
 	"github.com/launchdarkly/ldcli/internal/analytics"
 	"github.com/launchdarkly/ldcli/internal/environments"
 	"github.com/launchdarkly/ldcli/internal/flags"
+	"github.com/launchdarkly/ldcli/internal/output"
 	"github.com/launchdarkly/ldcli/internal/quickstart"
 )
 
@@ -23,9 +29,15 @@ func NewQuickStartCmd(
 	environmentsClient environments.Client,
 	flagsClient flags.Client,
 ) *cobra.Command {
-	return &cobra.Command{
-		Args: validators.Validate(),
-		Long: "",
+	cmd := &cobra.Command{
+		Args: validateQuickStart(),
+		Long: `Setup guide to create your first feature flag.
+Use --ci to configure the CLI without the guide, such as in onboarding scripts and devcontainers. It checks that the
+access token can read the environment, and saves the project, environment, and access token as the CLI's defaults
+
+Examples:
+  # Configure the CLI in a devcontainer, with the access token in $LD_TOKEN
+  ldcli setup --ci --project my-project --environment test --token-env LD_TOKEN --output json`,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			analyticsTrackerFn(
 				viper.GetString(cliflags.AccessTokenFlag),
@@ -37,6 +49,38 @@ func NewQuickStartCmd(
 		Short: "Setup guide to create your first feature flag",
 		Use:   "setup",
 	}
+
+	cmd.Flags().Bool(CIFlag, false, "Configure the CLI without the interactive guide, and write the result as --output")
+	_ = viper.BindPFlag(CIFlag, cmd.Flags().Lookup(CIFlag))
+	cmd.Flags().String(cliflags.ProjectFlag, "", "The project key to configure with --ci")
+	_ = viper.BindPFlag(cliflags.ProjectFlag, cmd.Flags().Lookup(cliflags.ProjectFlag))
+	cmd.Flags().String(cliflags.EnvironmentFlag, "", "The environment key to configure with --ci")
+	_ = viper.BindPFlag(cliflags.EnvironmentFlag, cmd.Flags().Lookup(cliflags.EnvironmentFlag))
+	cmd.Flags().String(TokenEnvFlag, "", "The environment variable to read the access token from with --ci, which is saved in place of any other")
+	_ = viper.BindPFlag(TokenEnvFlag, cmd.Flags().Lookup(TokenEnvFlag))
+
+	return cmd
+}
+
+const (
+	CIFlag       = "ci"
+	TokenEnvFlag = "token-env"
+)
+
+// validateQuickStart reads the access token from the --token-env environment variable before validating the flags, so
+// that it counts as the required access token.
+func validateQuickStart() cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if tokenEnv := viper.GetString(TokenEnvFlag); tokenEnv != "" {
+			token := os.Getenv(tokenEnv)
+			if token == "" {
+				return validators.CmdError(fmt.Errorf("the %s environment variable is not set", tokenEnv), cmd.CommandPath(), "")
+			}
+			viper.Set(cliflags.AccessTokenFlag, token)
+		}
+
+		return validators.Validate()(cmd, args)
+	}
 }
 
 func runQuickStart(
@@ -45,6 +89,10 @@ func runQuickStart(
 	flagsClient flags.Client,
 ) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		if viper.GetBool(CIFlag) {
+			return runQuickStartCI(cmd, environmentsClient)
+		}
+
 		f, err := tea.LogToFile("debug.log", "")
 		if err != nil {
 			fmt.Println("could not open file for debugging:", err)
@@ -74,3 +122,74 @@ func runQuickStart(
 		return nil
 	}
 }
+
+// runQuickStartCI configures the CLI from flags and environment variables alone, without a TTY.
+func runQuickStartCI(cmd *cobra.Command, environmentsClient environments.Client) error {
+	projectKey := viper.GetString(cliflags.ProjectFlag)
+	environmentKey := viper.GetString(cliflags.EnvironmentFlag)
+	if projectKey == "" || environmentKey == "" {
+		return newQuickStartErr(fmt.Sprintf("--%s and --%s are required with --%s", cliflags.ProjectFlag, cliflags.EnvironmentFlag, CIFlag))
+	}
+
+	// getting the environment checks that the access token works, and that the project and environment exist
+	res, err := environmentsClient.Get(
+		context.Background(),
+		viper.GetString(cliflags.AccessTokenFlag),
+		viper.GetString(cliflags.BaseURIFlag),
+		environmentKey,
+		projectKey,
+	)
+	if err != nil {
+		return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+	}
+	var environment struct {
+		ClientSideID string `json:"_id"`
+		Name         string `json:"name"`
+	}
+	if err := json.Unmarshal(res, &environment); err != nil {
+		return newQuickStartErr(err.Error())
+	}
+
+	kvs := []string{cliflags.ProjectFlag, projectKey, cliflags.EnvironmentFlag, environmentKey}
+	if viper.GetString(TokenEnvFlag) != "" {
+		kvs = append(kvs, cliflags.AccessTokenFlag, viper.GetString(cliflags.AccessTokenFlag))
+	}
+	updatedFields, _, err := configcmd.Save(kvs)
+	if err != nil {
+		return newQuickStartErr(err.Error())
+	}
+
+	result, _ := json.Marshal(struct {
+		Project         string   `json:"project"`
+		Environment     string   `json:"environment"`
+		EnvironmentName string   `json:"environmentName"`
+		ClientSideID    string   `json:"clientSideId"`
+		Updated         []string `json:"updated"`
+	}{
+		Project:         projectKey,
+		Environment:     environmentKey,
+		EnvironmentName: environment.Name,
+		ClientSideID:    environment.ClientSideID,
+		Updated:         updatedFields,
+	})
+	if output.IsStructured(viper.GetString(cliflags.OutputFlag)) || viper.GetString(cliflags.QueryFlag) != "" {
+		out, err := resourcecmd.CmdOutput("update", result)
+		if err != nil {
+			return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), out)
+
+		return nil
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Configured the CLI for the %s environment (%s) in project %s\n", environment.Name, environmentKey, projectKey)
+	for _, field := range updatedFields {
+		fmt.Fprintf(cmd.OutOrStdout(), "* %s\n", field)
+	}
+
+	return nil
+}
+
+func newQuickStartErr(message string) error {
+	return output.NewCmdOutputError(errors.New(message), viper.GetString(cliflags.OutputFlag))
+}
@@ -0,0 +1,132 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"github.com/launchdarkly/ldcli/cmd"
+	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/environments"
+)
+
+func TestQuickStartCI(t *testing.T) {
+	useConfigFile := func(t *testing.T) string {
+		configFile := filepath.Join(t.TempDir(), "config.yml")
+		require.NoError(t, os.WriteFile(configFile, []byte("flag: test-flag\n"), 0600))
+		viper.SetConfigFile(configFile)
+		t.Cleanup(viper.Reset)
+
+		return configFile
+	}
+
+	t.Run("saves the settings and writes the result", func(t *testing.T) {
+		keyring.MockInit()
+		configFile := useConfigFile(t)
+		t.Setenv("TEST_LD_TOKEN", "test-token")
+		client := environments.MockClient{}
+		client.
+			On("Get", "test-token", "https://app.launchdarkly.com", "test-env", "test-proj").
+			Return([]byte(`{"_id": "test-client-side-id", "key": "test-env", "name": "Test"}`), nil)
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{EnvironmentsClient: &client},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"setup", "--ci",
+				"--project", "test-proj",
+				"--environment", "test-env",
+				"--token-env", "TEST_LD_TOKEN",
+				"--output", "json",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"project": "test-proj",
+			"environment": "test-env",
+			"environmentName": "Test",
+			"clientSideId": "test-client-side-id",
+			"updated": ["project", "environment", "access-token"]
+		}`, string(output))
+		config, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Equal(t, "environment: test-env\nflag: test-flag\nproject: test-proj\n", string(config))
+		token, err := keyring.Get("ldcli", "default")
+		require.NoError(t, err)
+		assert.Equal(t, "test-token", token)
+	})
+
+	t.Run("with --no-keychain saves the access token in the config file", func(t *testing.T) {
+		configFile := useConfigFile(t)
+		t.Setenv("TEST_LD_TOKEN", "test-token")
+		client := environments.MockClient{}
+		client.
+			On("Get", "test-token", "https://app.launchdarkly.com", "test-env", "test-proj").
+			Return([]byte(`{"_id": "test-client-side-id", "key": "test-env", "name": "Test"}`), nil)
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{EnvironmentsClient: &client},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"setup", "--ci",
+				"--project", "test-proj",
+				"--environment", "test-env",
+				"--token-env", "TEST_LD_TOKEN",
+				"--no-keychain",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `Configured the CLI for the Test environment (test-env) in project test-proj
+* project
+* environment
+* access-token
+`, string(output))
+		config, err := os.ReadFile(configFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(config), "access-token: test-token\n")
+	})
+
+	t.Run("without an environment is an error", func(t *testing.T) {
+		useConfigFile(t)
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"setup", "--ci",
+				"--access-token", "test-token",
+				"--project", "test-proj",
+			},
+		)
+
+		assert.EqualError(t, err, "--project and --environment are required with --ci")
+	})
+
+	t.Run("with an unset token environment variable is an error", func(t *testing.T) {
+		useConfigFile(t)
+
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"setup", "--ci",
+				"--project", "test-proj",
+				"--environment", "test-env",
+				"--token-env", "TEST_LD_UNSET_TOKEN",
+			},
+		)
+
+		assert.ErrorContains(t, err, "the TEST_LD_UNSET_TOKEN environment variable is not set")
+	})
+}
@@ -0,0 +1,14 @@
+package resources
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+)
+
+// AddColumnsFlag adds the --columns flag to a list command, to pick the columns it writes with --output csv.
+func AddColumnsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(cliflags.ColumnsFlag, nil, cliflags.ColumnsFlagDescription)
+	_ = viper.BindPFlag(cliflags.ColumnsFlag, cmd.Flags().Lookup(cliflags.ColumnsFlag))
+}
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	"github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/output"
+	"github.com/launchdarkly/ldcli/internal/resources"
+)
+
+// MakeRequest sends a request on behalf of a command that changes resources, unless the command was run with
+// --dry-run, in which case it prints the request instead. sent is false for dry runs, and callers should return
+// without using the response.
+func MakeRequest(
+	cmd *cobra.Command,
+	client resources.Client,
+	accessToken, method, path, contentType string,
+	query url.Values,
+	data []byte,
+	isBeta bool,
+) (res []byte, sent bool, err error) {
+	if !viper.GetBool(cliflags.DryRunFlag) {
+		res, err = client.MakeRequest(accessToken, method, path, contentType, query, data, isBeta)
+		return res, true, err
+	}
+
+	req := resources.NewDryRunRequest(accessToken, method, path, contentType, query, data, isBeta)
+	if outputKind := viper.GetString(cliflags.OutputFlag); outputKind == output.OutputKindJSON.String() || output.IsYAML(outputKind) {
+		reqJSON, err := json.Marshal(req)
+		if err != nil {
+			return nil, false, errors.NewError(err.Error())
+		}
+		out, err := output.FromJSON(outputKind, reqJSON)
+		if err != nil {
+			return nil, false, errors.NewError(err.Error())
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), out)
+		return nil, false, nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Dry run, this request was not sent:\n%s", req)
+
+	return nil, false, nil
+}
@@ -0,0 +1,17 @@
+package resources
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	"github.com/launchdarkly/ldcli/internal/output"
+)
+
+// CmdOutput formats a response for --output, after applying the --query expression if there is one.
+func CmdOutput(action string, res []byte) (string, error) {
+	outputKind := viper.GetString(cliflags.OutputFlag)
+	if query := viper.GetString(cliflags.QueryFlag); query != "" {
+		return output.QueryOutput(outputKind, res, query, viper.GetStringSlice(cliflags.ColumnsFlag))
+	}
+	return output.CmdOutput(action, outputKind, res)
+}
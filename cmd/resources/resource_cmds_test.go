@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/launchdarkly/ldcli/cmd"
 	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/resources"
 )
 
 func TestCreateTeam(t *testing.T) {
@@ -51,3 +53,242 @@ func TestCreateTeam(t *testing.T) {
 		assert.Contains(t, s, "would be making a post request to /api/v2/teams here, with args: map[data:map[key:team-key name:Team Name] expand:]\n")
 	})
 }
+
+func TestDryRun(t *testing.T) {
+	args := []string{
+		"teams", "create",
+		"--access-token", "abcd1234",
+		"--data", `{"key": "team-key", "name": "Team Name"}`,
+		"--dry-run",
+	}
+
+	t.Run("prints the request without sending it", func(t *testing.T) {
+		mockClient := &resources.MockClient{}
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: mockClient},
+			analytics.NoopClientFn{}.Tracker(),
+			args,
+		)
+
+		require.NoError(t, err)
+		assert.Nil(t, mockClient.Input)
+		assert.Equal(t, `Dry run, this request was not sent:
+POST https://app.launchdarkly.com/api/v2/teams
+Authorization: [REDACTED]
+Content-Type: application/json
+
+{
+  "key": "team-key",
+  "name": "Team Name"
+}
+`, string(output))
+		assert.NotContains(t, string(output), "abcd1234")
+	})
+
+	t.Run("prints the request as JSON", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{}},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--output", "json"),
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"method": "POST",
+			"url": "https://app.launchdarkly.com/api/v2/teams",
+			"headers": {"Authorization": "[REDACTED]", "Content-Type": "application/json"},
+			"body": {"key": "team-key", "name": "Team Name"}
+		}`, string(output))
+	})
+}
+
+func TestYAMLOutput(t *testing.T) {
+	t.Run("prints the response as YAML", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{
+				Response: []byte(`{"key": "team-key", "name": "Team Name", "roles": {"totalCount": 0}}`),
+			}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+				"--data", `{"key": "team-key", "name": "Team Name"}`,
+				"--output", "yaml",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "key: team-key\nname: Team Name\nroles:\n  totalCount: 0\n", string(output))
+	})
+
+	t.Run("prints dry runs as YAML", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+				"--data", `{"key": "team-key", "name": "Team Name"}`,
+				"--dry-run",
+				"--output", "yaml",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `method: POST
+url: https://app.launchdarkly.com/api/v2/teams
+headers:
+  Authorization: '[REDACTED]'
+  Content-Type: application/json
+body:
+  key: team-key
+  name: Team Name
+`, string(output))
+	})
+}
+
+func TestCSVOutput(t *testing.T) {
+	t.Run("writes list items as rows with the chosen columns", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{
+				Response: []byte(`{"items": [{"key": "team-1", "name": "Team 1", "roles": {"totalCount": 2}}, {"key": "team-2", "name": "Team 2"}], "totalCount": 2}`),
+			}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "list",
+				"--access-token", "abcd1234",
+				"--output", "csv",
+				"--columns", "key,roles.totalCount",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "key,roles.totalCount\nteam-1,2\nteam-2,\n", string(output))
+	})
+
+	t.Run("only list commands have --columns", func(t *testing.T) {
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+				"--data", `{"key": "team-key", "name": "Team Name"}`,
+				"--columns", "key",
+			},
+		)
+
+		assert.ErrorContains(t, err, "unknown flag: --columns")
+	})
+}
+
+func TestQuery(t *testing.T) {
+	response := []byte(`{"items": [{"key": "team-1", "name": "Team 1"}, {"key": "team-2", "name": "Team 2"}], "totalCount": 2}`)
+
+	t.Run("prints the result of the expression as JSON", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{Response: response}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "list",
+				"--access-token", "abcd1234",
+				"--query", "items[?name == 'Team 2'].key",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "[\"team-2\"]\n", string(output))
+	})
+
+	t.Run("rejects invalid expressions before sending the request", func(t *testing.T) {
+		mockClient := &resources.MockClient{}
+		_, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: mockClient},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+				"--data", `{"key": "team-key", "name": "Team Name"}`,
+				"--query", "items[?",
+			},
+		)
+
+		assert.ErrorContains(t, err, "query is invalid")
+		assert.Nil(t, mockClient.Input)
+	})
+}
+
+func TestContextsDataTemplates(t *testing.T) {
+	t.Run("executes template functions in contexts data", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"contexts", "evaluate-instance",
+				"--access-token", "abcd1234",
+				"--project", "proj",
+				"--environment", "env",
+				"--data", `{"kind": "user", "key": "{{uuid}}", "age": {{randInt 18 18}}}`,
+				"--template",
+				"--dry-run",
+				"--output", "json",
+			},
+		)
+
+		require.NoError(t, err)
+		var request struct {
+			Body map[string]interface{} `json:"body"`
+		}
+		require.NoError(t, json.Unmarshal(output, &request))
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, request.Body["key"])
+		assert.Equal(t, float64(18), request.Body["age"])
+	})
+
+	t.Run("leaves contexts data alone without --template", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"contexts", "evaluate-instance",
+				"--access-token", "abcd1234",
+				"--project", "proj",
+				"--environment", "env",
+				"--data", `{"kind": "user", "key": "user", "bio": "{{not a template}}"}`,
+				"--dry-run",
+				"--output", "json",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), `"bio":"{{not a template}}"`)
+	})
+
+	t.Run("leaves other resources' data alone", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{ResourcesClient: &resources.MockClient{}},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"teams", "create",
+				"--access-token", "abcd1234",
+				"--data", `{"key": "team-key", "name": "{{uuid}}"}`,
+				"--dry-run",
+				"--output", "json",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), `"name":"{{uuid}}"`)
+	})
+}
@@ -170,7 +170,11 @@ func isListResponse(op *openapi3.Operation, spec *openapi3.T) bool {
 }
 
 var mapParamToFlagName = map[string]string{
+	// --dry-run is reserved for printing requests without sending them
+	"dry-run":      "api-dry-run",
 	"feature-flag": "flag",
+	// --query is reserved for querying commands' JSON output
+	"query": "api-query",
 }
 
 func stripFlagName(flagName string) string {
@@ -19,6 +19,7 @@ import (
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
 	"github.com/launchdarkly/ldcli/cmd/validators"
 	"github.com/launchdarkly/ldcli/internal/analytics"
+	"github.com/launchdarkly/ldcli/internal/contexts"
 	"github.com/launchdarkly/ldcli/internal/errors"
 	"github.com/launchdarkly/ldcli/internal/output"
 	"github.com/launchdarkly/ldcli/internal/resources"
@@ -253,6 +254,14 @@ func (op *OperationCmd) initFlags() error {
 		}
 	}
 
+	if op.HasBody && op.isContexts() {
+		op.cmd.Flags().Bool(cliflags.TemplateFlag, false, cliflags.TemplateFlagDescription)
+		err := viper.BindPFlag(cliflags.TemplateFlag, op.cmd.Flags().Lookup(cliflags.TemplateFlag))
+		if err != nil {
+			return err
+		}
+	}
+
 	if op.SupportsSemanticPatch {
 		op.cmd.Flags().Bool("semantic-patch", false, "Perform a semantic patch request")
 		err := viper.BindPFlag("semantic-patch", op.cmd.Flags().Lookup("semantic-patch"))
@@ -261,6 +270,10 @@ func (op *OperationCmd) initFlags() error {
 		}
 	}
 
+	if op.isList() {
+		AddColumnsFlag(op.cmd)
+	}
+
 	for _, p := range op.Params {
 		flagName := getFlagName(p.Name)
 
@@ -282,6 +295,22 @@ func (op *OperationCmd) initFlags() error {
 	return nil
 }
 
+// isContexts is true for contexts operations, whose bodies are mostly context JSON that scripts can vary with template
+// functions when --template is set.
+func (op *OperationCmd) isContexts() bool {
+	return op.cmd.Parent() != nil && op.cmd.Parent().Name() == "contexts"
+}
+
+// isList is true for operations that list resources, which can be written as CSV with chosen columns.
+func (op *OperationCmd) isList() bool {
+	return strings.HasPrefix(op.Use, "list")
+}
+
+// isMutating is true for operations that change resources, which can be rehearsed with --dry-run.
+func (op *OperationCmd) isMutating() bool {
+	return !strings.EqualFold(op.HTTPMethod, "GET")
+}
+
 func buildURLWithParams(baseURI, path string, urlParams []string) string {
 	baseURI = strings.TrimSuffix(baseURI, "/")
 	s := make([]interface{}, len(urlParams))
@@ -298,7 +327,15 @@ func buildURLWithParams(baseURI, path string, urlParams []string) string {
 func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 	var data interface{}
 	if op.RequiresBody {
-		err := json.Unmarshal([]byte(viper.GetString(cliflags.DataFlag)), &data)
+		dataString := viper.GetString(cliflags.DataFlag)
+		if op.isContexts() && viper.GetBool(cliflags.TemplateFlag) {
+			rendered, err := contexts.Render(dataString)
+			if err != nil {
+				return err
+			}
+			dataString = rendered
+		}
+		err := json.Unmarshal([]byte(dataString), &data)
 		if err != nil {
 			return err
 		}
@@ -330,15 +367,34 @@ func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 		contentType += "; domain-model=launchdarkly.semanticpatch"
 	}
 
-	res, err := op.client.MakeRequest(
-		viper.GetString(cliflags.AccessTokenFlag),
-		strings.ToUpper(op.HTTPMethod),
-		path,
-		contentType,
-		query,
-		jsonData,
-		op.IsBeta,
-	)
+	var res []byte
+	if op.isMutating() {
+		var sent bool
+		res, sent, err = MakeRequest(
+			cmd,
+			op.client,
+			viper.GetString(cliflags.AccessTokenFlag),
+			strings.ToUpper(op.HTTPMethod),
+			path,
+			contentType,
+			query,
+			jsonData,
+			op.IsBeta,
+		)
+		if err == nil && !sent {
+			return nil
+		}
+	} else {
+		res, err = op.client.MakeRequest(
+			viper.GetString(cliflags.AccessTokenFlag),
+			strings.ToUpper(op.HTTPMethod),
+			path,
+			contentType,
+			query,
+			jsonData,
+			op.IsBeta,
+		)
+	}
 	if err != nil {
 		return output.NewCmdOutputError(err, viper.GetString(cliflags.OutputFlag))
 	}
@@ -349,12 +405,17 @@ func (op *OperationCmd) makeRequest(cmd *cobra.Command, args []string) error {
 		res = []byte(fmt.Sprintf(`{"key": %q}`, urlParms[len(urlParms)-1]))
 	}
 
-	output, err := output.CmdOutput(cmd.Use, viper.GetString(cliflags.OutputFlag), res)
+	var out string
+	if output.IsCSV(viper.GetString(cliflags.OutputFlag)) && op.isList() && viper.GetString(cliflags.QueryFlag) == "" {
+		out, err = output.CSV(res, viper.GetStringSlice(cliflags.ColumnsFlag))
+	} else {
+		out, err = CmdOutput(cmd.Use, res)
+	}
 	if err != nil {
 		return errors.NewError(err.Error())
 	}
 
-	fmt.Fprint(cmd.OutOrStdout(), output+"\n")
+	fmt.Fprint(cmd.OutOrStdout(), out+"\n")
 
 	return nil
 }
@@ -382,9 +443,8 @@ func NewOperationCmd(
 	cmd.SetUsageTemplate(SubcommandUsageTemplate())
 
 	opCmd.cmd = cmd
-	_ = opCmd.initFlags()
-
 	parentCmd.AddCommand(cmd)
+	_ = opCmd.initFlags()
 
 	return cmd
 }
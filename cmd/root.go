@@ -16,11 +16,13 @@ import (
 
 	cmdAnalytics "github.com/launchdarkly/ldcli/cmd/analytics"
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	coderefscmd "github.com/launchdarkly/ldcli/cmd/coderefs"
 	configcmd "github.com/launchdarkly/ldcli/cmd/config"
 	devcmd "github.com/launchdarkly/ldcli/cmd/dev_server"
 	flagscmd "github.com/launchdarkly/ldcli/cmd/flags"
 	logincmd "github.com/launchdarkly/ldcli/cmd/login"
 	memberscmd "github.com/launchdarkly/ldcli/cmd/members"
+	querycmd "github.com/launchdarkly/ldcli/cmd/query"
 	resourcecmd "github.com/launchdarkly/ldcli/cmd/resources"
 	sourcemapscmd "github.com/launchdarkly/ldcli/cmd/sourcemaps"
 	"github.com/launchdarkly/ldcli/internal/analytics"
@@ -30,7 +32,9 @@ import (
 	errs "github.com/launchdarkly/ldcli/internal/errors"
 	"github.com/launchdarkly/ldcli/internal/flags"
 	"github.com/launchdarkly/ldcli/internal/members"
+	"github.com/launchdarkly/ldcli/internal/output"
 	"github.com/launchdarkly/ldcli/internal/projects"
+	"github.com/launchdarkly/ldcli/internal/proxy"
 	"github.com/launchdarkly/ldcli/internal/resources"
 )
 
@@ -79,6 +83,8 @@ func init() {
 	cobra.AddTemplateFunc("WrappedOptionalFlagUsages", WrappedOptionalFlagUsages)
 	cobra.AddTemplateFunc("HasRequiredFlags", HasRequiredFlags)
 	cobra.AddTemplateFunc("HasOptionalFlags", HasOptionalFlags)
+	cobra.AddTemplateFunc("AliasCmds", aliasCmds)
+	cobra.AddTemplateFunc("PluginCmds", pluginCmds)
 }
 
 func NewRootCommand(
@@ -100,6 +106,7 @@ func NewRootCommand(
 				"config",
 				"help",
 				"login",
+				"query",
 			} {
 				if cmd.HasParent() && cmd.Parent().Name() == name {
 					cmd.DisableFlagParsing = true
@@ -187,6 +194,60 @@ func NewRootCommand(
 		return nil, err
 	}
 
+	cmd.PersistentFlags().String(
+		cliflags.ProxyFlag,
+		"",
+		cliflags.ProxyFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.ProxyFlag, cmd.PersistentFlags().Lookup(cliflags.ProxyFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().Bool(
+		cliflags.DryRunFlag,
+		false,
+		cliflags.DryRunFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.DryRunFlag, cmd.PersistentFlags().Lookup(cliflags.DryRunFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().Bool(
+		cliflags.NoKeychainFlag,
+		false,
+		cliflags.NoKeychainFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.NoKeychainFlag, cmd.PersistentFlags().Lookup(cliflags.NoKeychainFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().String(
+		cliflags.ProfileFlag,
+		"",
+		cliflags.ProfileFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.ProfileFlag, cmd.PersistentFlags().Lookup(cliflags.ProfileFlag))
+	if err != nil {
+		return nil, err
+	}
+	err = viper.BindEnv(cliflags.ProfileFlag, config.ProfileEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.PersistentFlags().String(
+		cliflags.QueryFlag,
+		"",
+		cliflags.QueryFlagDescription,
+	)
+	err = viper.BindPFlag(cliflags.QueryFlag, cmd.PersistentFlags().Lookup(cliflags.QueryFlag))
+	if err != nil {
+		return nil, err
+	}
+
 	cmd.PersistentFlags().StringP(
 		cliflags.OutputFlag,
 		"o",
@@ -205,6 +266,8 @@ func NewRootCommand(
 	cmd.AddCommand(resourcecmd.NewResourcesCmd())
 	cmd.AddCommand(devcmd.NewDevServerCmd(resources.NewClient(version), analyticsTrackerFn, dev_server.NewClient(version)))
 	cmd.AddCommand(sourcemapscmd.NewSourcemapsCmd(resources.NewClient(version), analyticsTrackerFn))
+	cmd.AddCommand(coderefscmd.NewCoderefsCmd(clients.ResourcesClient, analyticsTrackerFn))
+	cmd.AddCommand(querycmd.NewQueryCmd(analyticsTrackerFn))
 	resourcecmd.AddAllResourceCmds(cmd, clients.ResourcesClient, analyticsTrackerFn)
 
 	// add non-generated commands
@@ -213,12 +276,17 @@ func NewRootCommand(
 			c.AddCommand(flagscmd.NewToggleOnCmd(clients.ResourcesClient))
 			c.AddCommand(flagscmd.NewToggleOffCmd(clients.ResourcesClient))
 			c.AddCommand(flagscmd.NewArchiveCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewStaleCmd(clients.ResourcesClient))
+			c.AddCommand(flagscmd.NewApplyCmd(clients.ResourcesClient))
 		}
 		if c.Name() == "members" {
 			c.AddCommand(memberscmd.NewMembersInviteCmd(clients.ResourcesClient))
 		}
 	}
 
+	addPluginCmds(cmd, version)
+	addAliasCmds(cmd)
+
 	rootCmd.Commands = append(rootCmd.Commands, configCmd)
 
 	return rootCmd, nil
@@ -233,6 +301,9 @@ func Execute(version string) {
 		ProjectsClient:     projects.NewClient(version),
 		ResourcesClient:    resources.NewClient(version),
 	}
+	proxy.UseForDefaultTransport(func() string {
+		return viper.GetString(cliflags.ProxyFlag)
+	})
 	configService := config.NewService(resources.NewClient(version))
 	trackerFn := analytics.ClientFn{
 		ID:      uuid.New().String(),
@@ -269,8 +340,15 @@ See each command's help for details on how to use the generated script.`, rootCm
 		outcome = analytics.HELP
 	case err != nil:
 		outcome = analytics.ERROR
-		fmt.Fprintln(os.Stderr, err.Error())
-		os.Exit(1)
+		switch {
+		case err.Error() == "":
+			// the error has already been explained, such as by a plugin
+		case viper.GetString(cliflags.OutputFlag) == output.OutputKindGitHubActions.String():
+			fmt.Fprintln(os.Stdout, output.GitHubErrorAnnotation(err.Error()))
+		default:
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+		os.Exit(errs.ExitCode(err))
 	default:
 		outcome = analytics.SUCCESS
 	}
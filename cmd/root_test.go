@@ -5,8 +5,10 @@ import (
 	"github.com/launchdarkly/ldcli/internal/analytics"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
 )
 
 func TestCreate(t *testing.T) {
@@ -26,3 +28,147 @@ func TestCreate(t *testing.T) {
 		assert.Contains(t, string(output), `ldcli version test`)
 	})
 }
+
+func TestDryRun(t *testing.T) {
+	t.Run("prints dev server override changes without sending them", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"dev-server", "add-override",
+				"--access-token", "abcd1234",
+				"--project", "proj",
+				"--flag", "new-checkout",
+				"--data", "true",
+				"--port", "8765",
+				"--dry-run",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, `Dry run, this request was not sent:
+PUT http://localhost:8765/dev/projects/proj/overrides/new-checkout
+Content-Type: application/json
+
+true
+`, string(output))
+	})
+
+	t.Run("prints dev server project creation without sending it", func(t *testing.T) {
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			[]string{
+				"dev-server", "add-project",
+				"--access-token", "abcd1234",
+				"--project", "proj",
+				"--source", "test",
+				"--port", "8765",
+				"--dry-run",
+				"--output", "json",
+			},
+		)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"method": "POST",
+			"url": "http://localhost:8765/dev/projects/proj",
+			"headers": {"Content-Type": "application/json"},
+			"body": {"sourceEnvironmentKey": "test"}
+		}`, string(output))
+	})
+}
+
+func TestProfile(t *testing.T) {
+	useProfiles := func(t *testing.T) {
+		viper.Set("profiles", map[string]interface{}{
+			"work": map[string]interface{}{
+				"access-token": "work-token",
+				"project":      "work-project",
+			},
+		})
+		t.Cleanup(viper.Reset)
+	}
+	args := []string{
+		"dev-server", "add-override",
+		"--flag", "new-checkout",
+		"--data", "true",
+		"--port", "8765",
+		"--dry-run",
+	}
+
+	t.Run("uses the settings in the profile", func(t *testing.T) {
+		useProfiles(t)
+
+		output, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), append(args, "--profile", "work"))
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "PUT http://localhost:8765/dev/projects/work-project/overrides/new-checkout")
+	})
+
+	t.Run("selects the profile from the environment", func(t *testing.T) {
+		useProfiles(t)
+		t.Setenv("LDCLI_PROFILE", "work")
+
+		output, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), args)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "PUT http://localhost:8765/dev/projects/work-project/overrides/new-checkout")
+	})
+
+	t.Run("uses flags over the settings in the profile", func(t *testing.T) {
+		useProfiles(t)
+
+		output, err := cmd.CallCmd(
+			t,
+			cmd.APIClients{},
+			analytics.NoopClientFn{}.Tracker(),
+			append(args, "--profile", "work", "--project", "other-project"),
+		)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "PUT http://localhost:8765/dev/projects/other-project/overrides/new-checkout")
+	})
+
+	t.Run("with a profile that doesn't exist is an error", func(t *testing.T) {
+		useProfiles(t)
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), append(args, "--profile", "home"))
+
+		assert.ErrorContains(t, err, "profile home does not exist")
+	})
+}
+
+func TestKeychain(t *testing.T) {
+	args := []string{
+		"dev-server", "add-override",
+		"--project", "proj",
+		"--flag", "new-checkout",
+		"--data", "true",
+		"--port", "8765",
+		"--dry-run",
+	}
+
+	t.Run("uses the access token in the keychain", func(t *testing.T) {
+		keyring.MockInit()
+		require.NoError(t, keyring.Set("ldcli", "default", "keychain-token"))
+		t.Cleanup(viper.Reset)
+
+		output, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), args)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "PUT http://localhost:8765/dev/projects/proj/overrides/new-checkout")
+	})
+
+	t.Run("with --no-keychain doesn't use the access token in the keychain", func(t *testing.T) {
+		keyring.MockInit()
+		require.NoError(t, keyring.Set("ldcli", "default", "keychain-token"))
+		t.Cleanup(viper.Reset)
+
+		_, err := cmd.CallCmd(t, cmd.APIClients{}, analytics.NoopClientFn{}.Tracker(), append(args, "--no-keychain"))
+
+		assert.ErrorContains(t, err, `required flag(s) "access-token" not set`)
+	})
+}
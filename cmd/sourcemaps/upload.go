@@ -152,6 +152,14 @@ func runE(client resources.Client) func(cmd *cobra.Command, args []string) error
 			s3Keys = append(s3Keys, getS3Key(appVersion, basePath, file.Name))
 		}
 
+		if viper.GetBool(cliflags.DryRunFlag) {
+			fmt.Fprintln(cmd.OutOrStdout(), "Dry run, these source maps were not uploaded:")
+			for i, file := range files {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s as %s\n", file.Path, s3Keys[i])
+			}
+			return nil
+		}
+
 		uploadUrls, err := getSourceMapUploadUrls(viper.GetString(cliflags.AccessTokenFlag), projectResult.ID, s3Keys, backendUrl)
 		if err != nil {
 			return fmt.Errorf("failed to get upload URLs: %w", err)
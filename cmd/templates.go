@@ -16,6 +16,7 @@ Commands:
   {{rpad "completion" 29}} Enable command autocompletion within supported shells
   {{rpad "login" 29}} Log in to your LaunchDarkly account
   {{rpad "dev-server" 29}} Run a development server to serve flags locally
+  {{rpad "coderefs" 29}} Find references to flags in code
 
 Common resource commands:
   {{rpad "flags" 29}} List, create, and modify feature flags and their targeting
@@ -25,7 +26,13 @@ Common resource commands:
   {{rpad "segments" 29}} List, create, modify, and delete segments
   {{rpad "sourcemaps" 29}} Manage sourcemaps for error monitoring
   {{rpad "..." 29}} To see more resource commands, run 'ldcli resources'
-
+{{with PluginCmds .}}
+Plugins:{{range .}}
+  {{rpad .Name 29}} {{.Short}}{{end}}
+{{end}}{{with AliasCmds .}}
+Aliases:{{range .}}
+  {{rpad .Name 29}} {{.Short}}{{end}}
+{{end}}
 Flags:
 {{.LocalFlags.FlagUsages | trimTrailingWhitespaces}}
 `
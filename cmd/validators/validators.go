@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/launchdarkly/ldcli/cmd/cliflags"
+	"github.com/launchdarkly/ldcli/internal/config"
 	errs "github.com/launchdarkly/ldcli/internal/errors"
 	"github.com/launchdarkly/ldcli/internal/output"
 )
@@ -18,9 +19,14 @@ import (
 // Validate is a validator for commands to print an error when the user input is invalid.
 func Validate() cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
+		err := UseSettings()
+		if err != nil {
+			return CmdError(err, cmd.CommandPath(), "")
+		}
+
 		rebindFlags(cmd, cmd.ValidArgs) // rebind flags before validating them below
 
-		_, err := url.ParseRequestURI(viper.GetString(cliflags.BaseURIFlag))
+		_, err = url.ParseRequestURI(viper.GetString(cliflags.BaseURIFlag))
 		if err != nil {
 			return CmdError(errs.ErrInvalidBaseURI, cmd.CommandPath(), "")
 		}
@@ -35,6 +41,13 @@ func Validate() cobra.PositionalArgs {
 			return CmdError(err, cmd.CommandPath(), viper.GetString(cliflags.BaseURIFlag))
 		}
 
+		if query := viper.GetString(cliflags.QueryFlag); query != "" {
+			err = output.ValidateQuery(query)
+			if err != nil {
+				return CmdError(err, cmd.CommandPath(), viper.GetString(cliflags.BaseURIFlag))
+			}
+		}
+
 		return nil
 	}
 }
@@ -67,6 +80,60 @@ func validateOutput(outputFlag string) error {
 	return nil
 }
 
+// UseSettings puts the selected profile's settings, and the access token in the OS keychain, in place. Validate does
+// this for commands, and commands that don't use it, such as plugins, can do it themselves.
+func UseSettings() error {
+	if err := useProfile(); err != nil {
+		return err
+	}
+	useKeychain()
+
+	return nil
+}
+
+// useProfile puts the selected profile's settings in place of the top-level ones from the config file. Flags and
+// environment variables still take precedence over them.
+func useProfile() error {
+	name := viper.GetString(cliflags.ProfileFlag)
+	if name == "" {
+		return nil
+	}
+
+	key := "profiles." + name
+	token := keychainAccessToken(name)
+	if !viper.IsSet(key) && token == "" {
+		return fmt.Errorf("profile %s does not exist. Use `ldcli config --profile %s --set <key> <value>` to create it", name, name)
+	}
+
+	settings := viper.GetStringMap(key)
+	if _, ok := settings[cliflags.AccessTokenFlag]; !ok && token != "" {
+		settings[cliflags.AccessTokenFlag] = token
+	}
+
+	return viper.MergeConfigMap(settings)
+}
+
+// useKeychain falls back to the access token in the OS keychain when one isn't set any other way.
+func useKeychain() {
+	if viper.GetString(cliflags.AccessTokenFlag) != "" {
+		return
+	}
+	if token := keychainAccessToken(""); token != "" {
+		viper.SetDefault(cliflags.AccessTokenFlag, token)
+	}
+}
+
+// keychainAccessToken gets the access token for a profile from the OS keychain, unless it's turned off. The keychain
+// may not be available, such as on a server without a Secret Service, in which case there's no token.
+func keychainAccessToken(profile string) string {
+	if viper.GetBool(cliflags.NoKeychainFlag) {
+		return ""
+	}
+	token, _ := config.KeychainAccessToken(profile)
+
+	return token
+}
+
 // rebindFlags sets the command's flags based on the values stored in viper because they may not
 // be set yet when they (the flags) are set from environment variables or a configuration file.
 func rebindFlags(cmd *cobra.Command, _ []string) {
@@ -0,0 +1,196 @@
+// Package devserver runs the LaunchDarkly dev server inside a Go program, so that integration tests can serve flags
+// to LaunchDarkly SDKs without shelling out to ldcli. Like httptest.Server, it listens on a random local port by
+// default, and its flags are set by the test rather than synced from LaunchDarkly:
+//
+//	server := devserver.New(devserver.Options{Flags: map[string]ldvalue.Value{"new-checkout": ldvalue.Bool(false)}})
+//	if err := server.Start(ctx); err != nil {
+//		t.Fatal(err)
+//	}
+//	defer server.Close()
+//
+//	config := ldclient.Config{}
+//	config.ServiceEndpoints = ldcomponents.RelayProxyEndpoints(server.URL())
+//	client, _ := ldclient.MakeCustomClient(server.SDKKey(), config, 5*time.Second)
+//
+//	_ = server.SetOverride("new-checkout", ldvalue.Bool(true))
+//
+// Changes to overrides are streamed to connected SDKs, the same as with the dev server run by ldcli.
+package devserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// DefaultProjectKey is the project flags are served for if Options.ProjectKey isn't set.
+const DefaultProjectKey = "test-project"
+
+// ErrNotStarted is returned for changes made to a server before it's started.
+var ErrNotStarted = errors.New("the dev server hasn't been started")
+
+// Options configure a Server.
+type Options struct {
+	// ProjectKey is the key of the project flags are served for. SDKs use it as their SDK key, mobile key and
+	// client-side ID. It defaults to DefaultProjectKey.
+	ProjectKey string
+	// Flags are the flags to serve and the values they're served with until they're overridden. Boolean flags can be
+	// overridden with either value, and other flags with any value.
+	Flags map[string]ldvalue.Value
+	// Addr is the address to listen on. It defaults to a random port on the loopback interface.
+	Addr string
+}
+
+// Server is a dev server run in-process. Everything it has is kept in memory and is lost when it's closed.
+type Server struct {
+	opts Options
+
+	mu       sync.Mutex
+	embedded *dev_server.EmbeddedServer
+	listener net.Listener
+	server   *http.Server
+	cancel   context.CancelFunc
+}
+
+// New returns a server with the given options. It doesn't serve anything until it's started.
+func New(opts Options) *Server {
+	if opts.ProjectKey == "" {
+		opts.ProjectKey = DefaultProjectKey
+	}
+	if opts.Addr == "" {
+		opts.Addr = "127.0.0.1:0"
+	}
+	return &Server{opts: opts}
+}
+
+// Start creates the server's project and starts serving it in the background. It returns once the server is
+// listening. The server stops when ctx is done or it's closed.
+func (s *Server) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.server != nil {
+		return errors.New("the dev server has already been started")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	embedded, err := dev_server.NewEmbeddedServer(ctx)
+	if err != nil {
+		cancel()
+		return errors.Wrap(err, "unable to create dev server")
+	}
+	if err := model.ImportProject(embedded.Context(), s.opts.ProjectKey, model.ImportDataFromValues(s.opts.Flags)); err != nil {
+		cancel()
+		return errors.Wrap(err, "unable to create project")
+	}
+	listener, err := net.Listen("tcp", s.opts.Addr)
+	if err != nil {
+		cancel()
+		return errors.Wrapf(err, "unable to listen on %s", s.opts.Addr)
+	}
+
+	server := &http.Server{Handler: embedded.Handler}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	go func() {
+		<-ctx.Done()
+		// streams stay open until they're closed, so connections are closed rather than waited for
+		_ = server.Close()
+	}()
+	s.embedded, s.listener, s.server, s.cancel = embedded, listener, server, cancel
+	return nil
+}
+
+// Close stops the server, closing any connections to it.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.server == nil {
+		return nil
+	}
+	s.cancel()
+	return s.server.Close()
+}
+
+// URL is the base URL of the server, e.g. http://127.0.0.1:54321. SDKs should use it for their streaming, polling
+// and events endpoints, and the dev server's API and UI are at /dev and /ui.
+func (s *Server) URL() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return ""
+	}
+	return "http://" + s.listener.Addr().String()
+}
+
+// SDKKey is the key SDKs authenticate with. Server-side SDKs use it as their SDK key, and client-side and mobile
+// SDKs as their client-side ID or mobile key.
+func (s *Server) SDKKey() string {
+	return s.opts.ProjectKey
+}
+
+// SetOverride serves value for the flag, in place of its value from Options.Flags, until it's removed. Only flags in
+// Options.Flags can be overridden.
+func (s *Server) SetOverride(flagKey string, value ldvalue.Value) error {
+	ctx, err := s.modelContext()
+	if err != nil {
+		return err
+	}
+	_, err = model.UpsertOverride(ctx, s.opts.ProjectKey, flagKey, value)
+	return err
+}
+
+// RemoveOverride goes back to serving the flag's value from Options.Flags.
+func (s *Server) RemoveOverride(flagKey string) error {
+	ctx, err := s.modelContext()
+	if err != nil {
+		return err
+	}
+	return model.DeleteOverride(ctx, s.opts.ProjectKey, flagKey)
+}
+
+// RemoveOverrides goes back to serving every flag's value from Options.Flags, e.g. between tests.
+func (s *Server) RemoveOverrides() error {
+	ctx, err := s.modelContext()
+	if err != nil {
+		return err
+	}
+	return model.DeleteOverrides(ctx, s.opts.ProjectKey)
+}
+
+// Flags returns the value each flag is being served with, overridden or not.
+func (s *Server) Flags() (map[string]ldvalue.Value, error) {
+	ctx, err := s.modelContext()
+	if err != nil {
+		return nil, err
+	}
+	project, err := model.StoreFromContext(ctx).GetDevProject(ctx, s.opts.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	flagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]ldvalue.Value, len(flagsState))
+	for flagKey, state := range flagsState {
+		values[flagKey] = state.Value
+	}
+	return values, nil
+}
+
+func (s *Server) modelContext() (context.Context, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.embedded == nil {
+		return nil, ErrNotStarted
+	}
+	return s.embedded.Context(), nil
+}
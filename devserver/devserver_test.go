@@ -0,0 +1,79 @@
+package devserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	ldclient "github.com/launchdarkly/go-server-sdk/v7"
+	"github.com/launchdarkly/go-server-sdk/v7/ldcomponents"
+	"github.com/launchdarkly/ldcli/devserver"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	server := devserver.New(devserver.Options{
+		Flags: map[string]ldvalue.Value{
+			"bool-flag":   ldvalue.Bool(false),
+			"string-flag": ldvalue.String("initial"),
+		},
+	})
+
+	t.Run("overrides can't be set before the server starts", func(t *testing.T) {
+		assert.ErrorIs(t, server.SetOverride("bool-flag", ldvalue.Bool(true)), devserver.ErrNotStarted)
+	})
+
+	require.NoError(t, server.Start(ctx))
+	defer func() {
+		assert.NoError(t, server.Close())
+	}()
+
+	config := ldclient.Config{}
+	config.ServiceEndpoints = ldcomponents.RelayProxyEndpoints(server.URL())
+	client, err := ldclient.MakeCustomClient(server.SDKKey(), config, 5*time.Second)
+	require.NoError(t, err)
+	defer client.Close()
+	ldContext := ldcontext.New(t.Name())
+
+	t.Run("serves the initial flag values", func(t *testing.T) {
+		value, err := client.BoolVariation("bool-flag", ldContext, true)
+		require.NoError(t, err)
+		assert.False(t, value)
+		str, err := client.StringVariation("string-flag", ldContext, "")
+		require.NoError(t, err)
+		assert.Equal(t, "initial", str)
+	})
+
+	t.Run("streams overrides to the SDK", func(t *testing.T) {
+		require.NoError(t, server.SetOverride("string-flag", ldvalue.String("overridden")))
+
+		assert.Eventually(t, func() bool {
+			str, _ := client.StringVariation("string-flag", ldContext, "")
+			return str == "overridden"
+		}, 5*time.Second, 10*time.Millisecond)
+		flags, err := server.Flags()
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.String("overridden"), flags["string-flag"])
+	})
+
+	t.Run("removing overrides restores the initial values", func(t *testing.T) {
+		require.NoError(t, server.SetOverride("bool-flag", ldvalue.Bool(true)))
+		require.NoError(t, server.RemoveOverrides())
+
+		assert.Eventually(t, func() bool {
+			value, _ := client.BoolVariation("bool-flag", ldContext, true)
+			str, _ := client.StringVariation("string-flag", ldContext, "")
+			return !value && str == "initial"
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("returns an error for flags it doesn't have", func(t *testing.T) {
+		err := server.SetOverride("nope", ldvalue.Bool(true))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,129 @@
+// Package store lets programs keep the dev server's projects and overrides somewhere other than the built-in sqlite
+// and redis stores. Implement Store, check it with the storetest suite, and register it from an init function:
+//
+//	func init() {
+//		store.Register("postgres", func(ctx context.Context, config store.Config) (store.Store, error) {
+//			return NewPostgresStore(ctx, config.URL)
+//		})
+//	}
+//
+// The dev server then uses it when it's started with --store=postgres, and --redis-url as Config.URL.
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// Store is what the dev server keeps projects and overrides in. The types its methods take and return are aliased
+// below so that stores outside this module can name them.
+type Store = model.Store
+
+type (
+	Alias            = model.Alias
+	AuditEntry       = model.AuditEntry
+	AuditQuery       = model.AuditQuery
+	ContextKind      = model.ContextKind
+	EnvironmentKeys  = model.EnvironmentKeys
+	FlagFilter       = model.FlagFilter
+	FlagMetadata     = model.FlagMetadata
+	FlagState        = model.FlagState
+	FlagsState       = model.FlagsState
+	FlagTrigger      = model.FlagTrigger
+	FlagVariation    = model.FlagVariation
+	ForcedTreatment  = model.ForcedTreatment
+	LayeredOverrides = model.LayeredOverrides
+	Orphaned         = model.Orphaned
+	Override         = model.Override
+	OverrideLayer    = model.OverrideLayer
+	Overrides        = model.Overrides
+	OverrideSchedule = model.OverrideSchedule
+	Project          = model.Project
+	ProjectDeletion  = model.ProjectDeletion
+	Rollout          = model.Rollout
+	SavedContext     = model.SavedContext
+	Snapshot         = model.Snapshot
+	SyncStatus       = model.SyncStatus
+	TriggerAction    = model.TriggerAction
+	Variation        = model.Variation
+	WeightedValue    = model.WeightedValue
+)
+
+const (
+	LayerSource    = model.LayerSource
+	LayerInherited = model.LayerInherited
+	LayerScenario  = model.LayerScenario
+	LayerUser      = model.LayerUser
+
+	TriggerActionTurnFlagOn  = model.TriggerActionTurnFlagOn
+	TriggerActionTurnFlagOff = model.TriggerActionTurnFlagOff
+)
+
+// The errors stores return, as the Store methods describe.
+type (
+	ErrAlreadyExists = model.ErrAlreadyExists
+	ErrLocked        = model.ErrLocked
+	ErrNotFound      = model.ErrNotFound
+)
+
+var (
+	NewErrAlreadyExists = model.NewErrAlreadyExists
+	NewErrLocked        = model.NewErrLocked
+	NewErrNotFound      = model.NewErrNotFound
+)
+
+// Config is how the dev server was configured to reach its store.
+type Config struct {
+	// URL locates the store's data, e.g. the Redis server to use. It's empty for backends that don't need one.
+	URL string
+}
+
+// Factory creates a store from its configuration. The store should check it can reach its data before returning.
+type Factory func(ctx context.Context, config Config) (Store, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = make(map[string]Factory)
+)
+
+// Register makes a store available to the dev server by name. Stores should pass the storetest suite. Like
+// database/sql drivers, it's meant to be called from init functions, and it panics if the name is already registered.
+func Register(name string, factory Factory) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if factory == nil {
+		panic("store backend factory is nil for " + name)
+	}
+	if _, exists := backends[name]; exists {
+		panic("store backend registered twice: " + name)
+	}
+	backends[name] = factory
+}
+
+// Backends returns the names of the registered store backends, sorted.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates a store with the backend registered with the given name.
+func New(ctx context.Context, backend string, config Config) (Store, error) {
+	backendsMu.RLock()
+	factory, ok := backends[backend]
+	backendsMu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("unknown store backend %q", backend)
+	}
+	return factory(ctx, config)
+}
@@ -0,0 +1,43 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/devserver/store"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestRegister(t *testing.T) {
+	mockStore := mocks.NewMockStore(gomock.NewController(t))
+	var gotConfig store.Config
+	store.Register("test-backend", func(ctx context.Context, config store.Config) (store.Store, error) {
+		gotConfig = config
+		return mockStore, nil
+	})
+
+	t.Run("creates stores with the registered backend", func(t *testing.T) {
+		created, err := store.New(context.Background(), "test-backend", store.Config{URL: "test://store"})
+		require.NoError(t, err)
+		assert.Same(t, mockStore, created)
+		assert.Equal(t, store.Config{URL: "test://store"}, gotConfig)
+		assert.Contains(t, store.Backends(), "test-backend")
+	})
+
+	t.Run("backends can't be registered twice", func(t *testing.T) {
+		assert.Panics(t, func() {
+			store.Register("test-backend", func(ctx context.Context, config store.Config) (store.Store, error) {
+				return nil, nil
+			})
+		})
+	})
+
+	t.Run("returns an error for unknown backends", func(t *testing.T) {
+		_, err := store.New(context.Background(), "nope", store.Config{})
+		assert.ErrorContains(t, err, `unknown store backend "nope"`)
+	})
+}
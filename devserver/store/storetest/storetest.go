@@ -0,0 +1,1223 @@
+// Package storetest is a suite of tests for implementations of store.Store, so that stores other than the built-in ones
+// can be checked against the same contract.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	devstore "github.com/launchdarkly/ldcli/devserver/store"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// Run checks that store keeps to the Store contract, as the built-in stores do. store must be empty.
+func Run(t *testing.T, store devstore.Store) {
+	ctx := context.Background()
+	ldContext := ldcontext.New(t.Name())
+	now := time.Now()
+
+	projects := []model.Project{
+		{
+			Key:                  "main-test-proj",
+			SourceEnvironmentKey: "env-1",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState: model.FlagsState{
+				"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 2},
+				"flag-2": model.FlagState{Value: ldvalue.String("cool"), Version: 2},
+			},
+			AvailableVariations: []model.FlagVariation{
+				{
+					FlagKey: "flag-1",
+					Variation: model.Variation{
+						Id:    "1",
+						Value: ldvalue.Bool(true),
+					},
+				},
+				{
+					FlagKey: "flag-1",
+					Variation: model.Variation{
+						Id:    "2",
+						Value: ldvalue.Bool(false),
+					},
+				},
+				{
+					FlagKey: "flag-2",
+					Variation: model.Variation{
+						Id:          "3",
+						Description: lo.ToPtr("cool description"),
+						Name:        lo.ToPtr("cool name"),
+						Value:       ldvalue.String("Cool"),
+					},
+				},
+			},
+			FlagMetadata: map[string]model.FlagMetadata{
+				"flag-2": {Name: "Flag 2", Description: "cool flag", Tags: []string{"frontend", "checkout"}, Maintainer: "dev@example.com"},
+			},
+			ContextKinds: []model.ContextKind{{Key: "user", Name: "User"}, {Key: "org", Name: "Organization", Description: "a customer"}},
+		},
+		{
+			Key:                  "proj-to-delete",
+			SourceEnvironmentKey: "env-2",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState: model.FlagsState{
+				"flag-1": model.FlagState{Value: ldvalue.Int(123), Version: 2},
+				"flag-2": model.FlagState{Value: ldvalue.Float64(99.99), Version: 2},
+			},
+			AvailableVariations: []model.FlagVariation{
+				{
+					FlagKey: "flag-1",
+					Variation: model.Variation{
+						Id:    "1",
+						Value: ldvalue.Int(123),
+					},
+				},
+				{
+					FlagKey: "flag-2",
+					Variation: model.Variation{
+						Id:    "2",
+						Value: ldvalue.Float64(99.99),
+					},
+				},
+			},
+		},
+		{
+			Key:                  "proj-to-test-override-deletion-on-update",
+			SourceEnvironmentKey: "env-3",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState: model.FlagsState{
+				"flag-1": model.FlagState{Value: ldvalue.Int(123), Version: 2},
+				"flag-2": model.FlagState{Value: ldvalue.Float64(99.99), Version: 2},
+			},
+			AvailableVariations: []model.FlagVariation{
+				{
+					FlagKey: "flag-1",
+					Variation: model.Variation{
+						Id:    "1",
+						Value: ldvalue.Bool(true),
+					},
+				},
+				{
+					FlagKey: "flag-1",
+					Variation: model.Variation{
+						Id:    "2",
+						Value: ldvalue.Bool(false),
+					},
+				},
+				{
+					FlagKey: "flag-2",
+					Variation: model.Variation{
+						Id:          "3",
+						Description: lo.ToPtr("cool description"),
+						Name:        lo.ToPtr("cool name"),
+						Value:       ldvalue.String("Cool"),
+					},
+				},
+			},
+		},
+	}
+	actualProjectKeys := make(map[string]bool, len(projects))
+
+	for _, proj := range projects {
+		err := store.InsertProject(ctx, proj)
+		require.NoError(t, err)
+		actualProjectKeys[proj.Key] = true
+	}
+
+	t.Run("InsertProject returns ErrAlreadyExists if the project already exists", func(t *testing.T) {
+		err := store.InsertProject(ctx, projects[0])
+		assert.ErrorAs(t, err, &model.ErrAlreadyExists{})
+	})
+
+	t.Run("GetDevProjectKeys returns keys in projects", func(t *testing.T) {
+		keys, err := store.GetDevProjectKeys(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, keys, len(projects))
+
+		for _, key := range keys {
+			_, ok := actualProjectKeys[key]
+			assert.True(t, ok)
+		}
+	})
+
+	t.Run("GetDevProject returns ErrNotFound for fake project keys", func(t *testing.T) {
+		p, err := store.GetDevProject(ctx, "THIS-DOES-NOT-EXIST")
+		assert.Nil(t, p)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("GetDevProject returns project", func(t *testing.T) {
+		expected := projects[0]
+		p, err := store.GetDevProject(ctx, expected.Key)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, p)
+		assert.Equal(t, expected.Key, p.Key)
+		assert.Equal(t, expected.AllFlagsState, p.AllFlagsState)
+		assert.Equal(t, expected.SourceEnvironmentKey, p.SourceEnvironmentKey)
+		assert.Equal(t, expected.Context, p.Context)
+		assert.True(t, expected.LastSyncTime.Equal(p.LastSyncTime))
+		assert.Equal(t, expected.FlagMetadata, p.FlagMetadata)
+		assert.Equal(t, expected.ContextKinds, p.ContextKinds)
+	})
+
+	t.Run("GetAvailableVariations returns variations", func(t *testing.T) {
+		availableVariations, err := store.GetAvailableVariationsForProject(ctx, projects[0].Key)
+		require.NoError(t, err)
+		require.Len(t, availableVariations, 2)
+		flag1Variations := availableVariations["flag-1"]
+		assert.Len(t, flag1Variations, 2)
+		flag2Variations := availableVariations["flag-2"]
+		assert.Len(t, flag2Variations, 1)
+
+		expectedFlagVariations := projects[0].AvailableVariations
+		assert.Equal(t, expectedFlagVariations[2].Id, flag2Variations[0].Id)
+		assert.Equal(t, *expectedFlagVariations[2].Name, *flag2Variations[0].Name)
+		assert.Equal(t, *expectedFlagVariations[2].Description, *flag2Variations[0].Description)
+		assert.Equal(t, expectedFlagVariations[2].Value.String(), flag2Variations[0].Value.String())
+		assert.Equal(t, ldvalue.StringType, flag2Variations[0].Value.Type())
+		for _, variation := range flag1Variations {
+			if variation.Value.BoolValue() {
+				assert.Equal(t, expectedFlagVariations[0].Variation, variation)
+			} else {
+				assert.Equal(t, expectedFlagVariations[1].Variation, variation)
+			}
+		}
+	})
+
+	t.Run("UpdateProject updates flag state, sync time, context and source environment key", func(t *testing.T) {
+		project := projects[0]
+		project.Context = ldcontext.New(t.Name() + "blah")
+		project.AllFlagsState = model.FlagsState{
+			"flag-1": model.FlagState{Value: ldvalue.Bool(false), Version: 3},
+			"flag-2": model.FlagState{Value: ldvalue.String("cool beeans"), Version: 3},
+		}
+		project.LastSyncTime = time.Now().Add(time.Hour)
+		project.SourceEnvironmentKey = "new-env"
+		project.FlagMetadata = map[string]model.FlagMetadata{"flag-1": {Name: "Flag 1", Tags: []string{"backend"}}}
+		project.ContextKinds = []model.ContextKind{{Key: "user"}}
+		project.AvailableVariations = []model.FlagVariation{
+			{
+				FlagKey: "flag-1",
+				Variation: model.Variation{
+					Id:    "1",
+					Value: ldvalue.Bool(true),
+				},
+			},
+			{
+				FlagKey: "flag-1",
+				Variation: model.Variation{
+					Id:    "2",
+					Value: ldvalue.Bool(false),
+				},
+			},
+			{
+				FlagKey: "flag-2",
+				Variation: model.Variation{
+					Id:          "3",
+					Description: lo.ToPtr("cool description"),
+					Name:        lo.ToPtr("cool name"),
+					Value:       ldvalue.String("cool beans"),
+				},
+			},
+		}
+
+		updated, err := store.UpdateProject(ctx, project)
+		assert.NoError(t, err)
+		assert.True(t, updated)
+
+		newProj, err := store.GetDevProject(ctx, project.Key)
+		assert.NoError(t, err)
+		assert.NotNil(t, newProj)
+		assert.Equal(t, project.Key, newProj.Key)
+		assert.Equal(t, project.AllFlagsState, newProj.AllFlagsState)
+		assert.Equal(t, project.SourceEnvironmentKey, newProj.SourceEnvironmentKey)
+		assert.Equal(t, project.Context, newProj.Context)
+		assert.True(t, project.LastSyncTime.Equal(newProj.LastSyncTime))
+		assert.Equal(t, project.FlagMetadata, newProj.FlagMetadata)
+		assert.Equal(t, project.ContextKinds, newProj.ContextKinds)
+
+		availableVariations, err := store.GetAvailableVariationsForProject(ctx, projects[0].Key)
+		require.NoError(t, err)
+		require.Len(t, availableVariations, 2)
+		flag1Variations := availableVariations["flag-1"]
+		assert.Len(t, flag1Variations, 2)
+		flag2Variations := availableVariations["flag-2"]
+		assert.Len(t, flag2Variations, 1)
+
+		expectedFlagVariation := project.AvailableVariations[2]
+		assert.Equal(t, expectedFlagVariation.Id, flag2Variations[0].Id)
+		assert.Equal(t, *expectedFlagVariation.Name, *flag2Variations[0].Name)
+		assert.Equal(t, *expectedFlagVariation.Description, *flag2Variations[0].Description)
+		assert.Equal(t, expectedFlagVariation.Value.String(), flag2Variations[0].Value.String())
+		assert.Equal(t, ldvalue.StringType, flag2Variations[0].Value.Type())
+	})
+
+	t.Run("UpdateProject returns false if project does not exist", func(t *testing.T) {
+		updated, err := store.UpdateProject(ctx, model.Project{Key: "nope"})
+		assert.NoError(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("OrphanProject marks the project until it's next updated", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "orphaned-proj",
+			SourceEnvironmentKey: "env-1",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1}},
+			AvailableVariations: []model.FlagVariation{{
+				FlagKey:   "flag-1",
+				Variation: model.Variation{Id: "1", Value: ldvalue.Bool(true)},
+			}},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		defer func() {
+			_, _, err := store.DeleteDevProject(ctx, project.Key)
+			require.NoError(t, err)
+		}()
+
+		since := time.UnixMilli(now.UnixMilli())
+		updated, err := store.OrphanProject(ctx, project.Key, model.Orphaned{Detail: "environment not found", Since: since})
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		orphaned, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.NotNil(t, orphaned.Orphaned)
+		assert.Equal(t, "environment not found", orphaned.Orphaned.Detail)
+		assert.True(t, since.Equal(orphaned.Orphaned.Since))
+		assert.Equal(t, project.AllFlagsState, orphaned.AllFlagsState)
+		availableVariations, err := store.GetAvailableVariationsForProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Len(t, availableVariations["flag-1"], 1)
+
+		updated, err = store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		require.True(t, updated)
+		synced, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Nil(t, synced.Orphaned)
+
+		updated, err = store.OrphanProject(ctx, "nope", model.Orphaned{Detail: "environment not found", Since: since})
+		require.NoError(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("SetEnvironmentKeys keeps the keys through updates", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "prefetched-proj",
+			SourceEnvironmentKey: "env-1",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		defer func() {
+			_, _, err := store.DeleteDevProject(ctx, project.Key)
+			require.NoError(t, err)
+		}()
+
+		inserted, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Empty(t, inserted.EnvironmentKeys)
+
+		keys := map[string]model.EnvironmentKeys{
+			"env-1": {SdkKey: "sdk-1", MobileKey: "mob-1", ClientSideId: "id-1"},
+			"env-2": {SdkKey: "sdk-2", MobileKey: "mob-2", ClientSideId: "id-2"},
+		}
+		updated, err := store.SetEnvironmentKeys(ctx, project.Key, keys)
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		updated, err = store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		require.True(t, updated)
+		synced, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, keys, synced.EnvironmentKeys)
+
+		updated, err = store.SetEnvironmentKeys(ctx, "nope", keys)
+		require.NoError(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("DeleteProject returns false if project does not exist", func(t *testing.T) {
+		_, deleted, err := store.DeleteDevProject(ctx, "nope")
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("DeleteProject succeeds if project exists", func(t *testing.T) {
+		_, deleted, err := store.DeleteDevProject(ctx, projects[1].Key)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	flagKeys := []string{"flag-1", "flag-2"}
+
+	overrides := map[string]model.Override{
+		flagKeys[0]: {
+			ProjectKey: projects[0].Key,
+			FlagKey:    flagKeys[0],
+			Value:      ldvalue.Bool(true),
+			Active:     true,
+			Version:    1,
+		},
+		flagKeys[1]: {
+			ProjectKey: projects[0].Key,
+			FlagKey:    flagKeys[1],
+			Value:      ldvalue.Int(100),
+			Active:     true,
+			Version:    1,
+		},
+	}
+
+	// test inserts
+	for _, o := range overrides {
+		_, err := store.UpsertOverride(ctx, o)
+		require.NoError(t, err)
+	}
+
+	overridesResult, err := store.GetOverridesForProject(ctx, projects[0].Key)
+	require.NoError(t, err)
+	require.Len(t, overridesResult, 2)
+
+	for _, r := range overridesResult {
+		originalOverride, ok := overrides[r.FlagKey]
+		require.True(t, ok)
+		require.Equal(t, originalOverride, r)
+	}
+
+	t.Run("UpsertOverride updates when override exists", func(t *testing.T) {
+		updated := overrides[flagKeys[1]]
+		updated.Value = ldvalue.Int(101)
+
+		_, err := store.UpsertOverride(ctx, updated)
+		assert.NoError(t, err)
+
+		overridesResult, err := store.GetOverridesForProject(ctx, projects[0].Key)
+		assert.NoError(t, err)
+		assert.Len(t, overridesResult, 2)
+
+		found := false // prevent test from erroneously succeeding because override not in array
+		for _, r := range overridesResult {
+			if r.FlagKey != flagKeys[1] {
+				continue
+			}
+
+			found = true
+			assert.Equal(t, updated.Value, r.Value)
+		}
+
+		assert.True(t, found)
+	})
+
+	t.Run("DeactivateOverride returns error when override not found", func(t *testing.T) {
+		_, err := store.DeactivateOverride(ctx, projects[0].Key, "nope")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("DeactivateOverride sets the override inactive and returns the current version", func(t *testing.T) {
+		toDelete := overrides[flagKeys[0]]
+		version, err := store.DeactivateOverride(ctx, toDelete.ProjectKey, toDelete.FlagKey)
+		assert.NoError(t, err)
+
+		result, err := store.GetOverridesForProject(ctx, toDelete.ProjectKey)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		found := false // prevent test from erroneously succeeding because override not in array
+		for _, r := range result {
+			if r.FlagKey != toDelete.FlagKey {
+				continue
+			}
+
+			found = true
+			assert.False(t, r.Active)
+			assert.Equal(t, version, r.Version)
+		}
+
+		assert.True(t, found)
+	})
+
+	t.Run("UpdateProject deletes overrides for flags that are no longer in the project", func(t *testing.T) {
+		project := projects[2]
+
+		// create a new override for flag-1
+		override, err := store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(false),
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(t, err)
+
+		// verify the override was created
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, override, overrides[0])
+
+		// update the project to remove flag-1
+		project.AvailableVariations = []model.FlagVariation{
+			{
+				FlagKey: "flag-2",
+			},
+		}
+		updated, err := store.UpdateProject(ctx, project)
+		assert.NoError(t, err)
+		assert.True(t, updated)
+
+		// verify the override for flag-1 was deleted
+		overrides, err = store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 0)
+	})
+
+	t.Run("stores the project's flag filter", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "filtered-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+			FlagFilter:           model.FlagFilter{KeyPrefixes: []string{"checkout-"}},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		inserted, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, project.FlagFilter, inserted.FlagFilter)
+
+		project.FlagFilter = model.FlagFilter{Tags: []string{"search"}}
+		updated, err := store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		require.True(t, updated)
+		refiltered, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, project.FlagFilter, refiltered.FlagFilter)
+	})
+
+	t.Run("locked overrides can't be changed or removed until unlocked", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "locked-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+			AvailableVariations:  []model.FlagVariation{{FlagKey: "flag-1"}},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		_, err := store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(true),
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(t, err)
+
+		_, err = store.SetOverrideLocked(ctx, project.Key, "nope", true)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+
+		locked, err := store.SetOverrideLocked(ctx, project.Key, "flag-1", true)
+		require.NoError(t, err)
+		assert.True(t, locked.Locked)
+		assert.True(t, locked.Active)
+
+		_, err = store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(false),
+			Active:     true,
+			Version:    1,
+		})
+		assert.ErrorAs(t, err, &model.ErrLocked{})
+		_, err = store.DeactivateOverride(ctx, project.Key, "flag-1")
+		assert.ErrorAs(t, err, &model.ErrLocked{})
+
+		// a sync that drops the flag keeps the locked override
+		project.AvailableVariations = nil
+		updated, err := store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		require.True(t, updated)
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, ldvalue.Bool(true), overrides[0].Value)
+		assert.True(t, overrides[0].Locked)
+
+		unlocked, err := store.SetOverrideLocked(ctx, project.Key, "flag-1", false)
+		require.NoError(t, err)
+		assert.False(t, unlocked.Locked)
+		_, err = store.DeactivateOverride(ctx, project.Key, "flag-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("rollout overrides round trip", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "rollout-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+			AvailableVariations:  []model.FlagVariation{{FlagKey: "flag-1"}},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		rollout := &model.Rollout{
+			Variations: []model.WeightedValue{
+				{Value: ldvalue.String("a"), Weight: 40000},
+				{Value: ldvalue.String("b"), Weight: 60000},
+			},
+			ContextKind: "org",
+		}
+		_, err := store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.String("a"),
+			Rollout:    rollout,
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(t, err)
+
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, rollout, overrides[0].Rollout)
+
+		// a plain override replaces the rollout
+		_, err = store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.String("b"),
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(t, err)
+		overrides, err = store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Nil(t, overrides[0].Rollout)
+	})
+
+	t.Run("GetAuditLog lists override changes and who made them, most recent first", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "audited-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		started := time.Now()
+		_, err := store.UpsertOverride(model.ContextWithActor(ctx, "alice"), model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(true),
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(t, err)
+		_, err = store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-2",
+			Value:      ldvalue.String("on"),
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(t, err)
+		_, err = store.DeactivateOverride(model.ContextWithActor(ctx, "bob"), project.Key, "flag-1")
+		require.NoError(t, err)
+		_, err = store.SetOverrideLocked(model.ContextWithActor(ctx, "carol"), project.Key, "flag-2", true)
+		require.NoError(t, err)
+
+		entries, err := store.GetAuditLog(ctx, project.Key, model.AuditQuery{})
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		assert.Equal(t, "carol", entries[0].Actor)
+		assert.Equal(t, "flag-2", entries[0].FlagKey)
+		assert.Equal(t, ldvalue.String("on"), entries[0].Value)
+		require.NotNil(t, entries[0].Locked)
+		assert.True(t, *entries[0].Locked)
+		assert.Equal(t, "bob", entries[1].Actor)
+		assert.Equal(t, "flag-1", entries[1].FlagKey)
+		assert.False(t, entries[1].Active)
+		assert.Nil(t, entries[1].Locked)
+		assert.Equal(t, "", entries[2].Actor)
+		assert.Equal(t, ldvalue.String("on"), entries[2].Value)
+		assert.Equal(t, "alice", entries[3].Actor)
+		assert.Equal(t, model.LayerUser, entries[3].Layer)
+		assert.True(t, entries[3].Active)
+		assert.False(t, entries[3].RecordedAt.Before(started.Truncate(time.Millisecond)))
+
+		entries, err = store.GetAuditLog(ctx, project.Key, model.AuditQuery{FlagKey: "flag-1", Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "bob", entries[0].Actor)
+
+		entries, err = store.GetAuditLog(ctx, project.Key, model.AuditQuery{Since: time.Now().Add(time.Hour)})
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("override schedules can be written, listed and deleted", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "scheduled-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		activateAt := time.UnixMilli(now.Add(time.Hour).UnixMilli())
+		schedule := model.OverrideSchedule{
+			ProjectKey:  project.Key,
+			FlagKey:     "flag-1",
+			Value:       ldvalue.String("on"),
+			ActivateAt:  &activateAt,
+			ScheduledBy: "alice",
+		}
+		require.NoError(t, store.UpsertOverrideSchedule(ctx, schedule))
+
+		schedules, err := store.GetOverrideSchedules(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		assert.Equal(t, "alice", schedules[0].ScheduledBy)
+		assert.Equal(t, ldvalue.String("on"), schedules[0].Value)
+		require.NotNil(t, schedules[0].ActivateAt)
+		assert.True(t, activateAt.Equal(*schedules[0].ActivateAt))
+		assert.Nil(t, schedules[0].DeactivateAt)
+
+		deactivateAt := activateAt.Add(time.Hour)
+		schedule.ActivateAt = nil
+		schedule.DeactivateAt = &deactivateAt
+		require.NoError(t, store.UpsertOverrideSchedule(ctx, schedule))
+		schedules, err = store.GetOverrideSchedules(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		assert.Nil(t, schedules[0].ActivateAt)
+		require.NotNil(t, schedules[0].DeactivateAt)
+
+		deleted, err := store.DeleteOverrideSchedule(ctx, project.Key, "flag-1")
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		deleted, err = store.DeleteOverrideSchedule(ctx, project.Key, "flag-1")
+		require.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("saved contexts can be written, listed and deleted", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "saved-contexts-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		beta := ldcontext.NewBuilder("beta").SetBool("beta", true).Build()
+		multi := ldcontext.NewMulti(ldcontext.New("admin"), ldcontext.NewWithKind("org", "acme"))
+		require.NoError(t, store.UpsertSavedContext(ctx, model.SavedContext{ProjectKey: project.Key, Name: "tester", Context: beta}))
+		require.NoError(t, store.UpsertSavedContext(ctx, model.SavedContext{ProjectKey: project.Key, Name: "admin", Context: multi}))
+
+		savedContexts, err := store.GetSavedContexts(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, []model.SavedContext{
+			{ProjectKey: project.Key, Name: "admin", Context: multi},
+			{ProjectKey: project.Key, Name: "tester", Context: beta},
+		}, savedContexts)
+
+		require.NoError(t, store.UpsertSavedContext(ctx, model.SavedContext{ProjectKey: project.Key, Name: "tester", Context: ldContext}))
+		savedContext, err := store.GetSavedContext(ctx, project.Key, "tester")
+		require.NoError(t, err)
+		assert.Equal(t, ldContext, savedContext.Context)
+
+		deleted, err := store.DeleteSavedContext(ctx, project.Key, "tester")
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		deleted, err = store.DeleteSavedContext(ctx, project.Key, "tester")
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		_, err = store.GetSavedContext(ctx, project.Key, "tester")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("flag triggers can be written, found by ID, listed and deleted", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "triggers-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		createdAt := time.UnixMilli(now.UnixMilli())
+		kill := model.FlagTrigger{ID: "trigger-b", ProjectKey: project.Key, FlagKey: "flag-2", Action: model.TriggerActionTurnFlagOff, CreatedAt: createdAt}
+		restore := model.FlagTrigger{ID: "trigger-a", ProjectKey: project.Key, FlagKey: "flag-2", Action: model.TriggerActionTurnFlagOn, CreatedAt: createdAt.Add(time.Second)}
+		other := model.FlagTrigger{ID: "trigger-c", ProjectKey: project.Key, FlagKey: "flag-1", Action: model.TriggerActionTurnFlagOn, CreatedAt: createdAt.Add(time.Minute)}
+		for _, trigger := range []model.FlagTrigger{kill, restore, other} {
+			require.NoError(t, store.InsertFlagTrigger(ctx, trigger))
+		}
+
+		triggers, err := store.GetFlagTriggers(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, triggers, 3)
+		assert.Equal(t, []string{"trigger-c", "trigger-b", "trigger-a"}, []string{triggers[0].ID, triggers[1].ID, triggers[2].ID})
+		assert.True(t, createdAt.Equal(triggers[1].CreatedAt))
+
+		found, err := store.GetFlagTrigger(ctx, "trigger-b")
+		require.NoError(t, err)
+		assert.Equal(t, project.Key, found.ProjectKey)
+		assert.Equal(t, "flag-2", found.FlagKey)
+		assert.Equal(t, model.TriggerActionTurnFlagOff, found.Action)
+
+		deleted, err := store.DeleteFlagTrigger(ctx, "trigger-b")
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		deleted, err = store.DeleteFlagTrigger(ctx, "trigger-b")
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		_, err = store.GetFlagTrigger(ctx, "trigger-b")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("SetSyncStatus survives updates to the project", func(t *testing.T) {
+		attemptedAt := time.UnixMilli(now.UnixMilli())
+		project := model.Project{
+			Key:                  "synced-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+			SyncStatus:           &model.SyncStatus{AttemptedAt: attemptedAt, Duration: 20 * time.Millisecond},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		inserted, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.NotNil(t, inserted.SyncStatus)
+		assert.True(t, inserted.SyncStatus.Succeeded())
+		assert.Equal(t, 20*time.Millisecond, inserted.SyncStatus.Duration)
+
+		failed := model.SyncStatus{AttemptedAt: attemptedAt.Add(time.Minute), Duration: 3 * time.Second, Error: "timed out"}
+		updated, err := store.SetSyncStatus(ctx, project.Key, failed)
+		require.NoError(t, err)
+		assert.True(t, updated)
+		updated, err = store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		require.True(t, updated)
+		synced, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.NotNil(t, synced.SyncStatus)
+		assert.True(t, failed.AttemptedAt.Equal(synced.SyncStatus.AttemptedAt))
+		assert.Equal(t, failed.Duration, synced.SyncStatus.Duration)
+		assert.Equal(t, "timed out", synced.SyncStatus.Error)
+
+		updated, err = store.SetSyncStatus(ctx, "nope", failed)
+		require.NoError(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("ArchiveProject keeps the project until it's deleted", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "archived-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1}},
+			AvailableVariations:  []model.FlagVariation{{FlagKey: "flag-1", Variation: model.Variation{Id: "1", Value: ldvalue.Bool(true)}}},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		_, err := store.UpsertOverride(ctx, model.Override{ProjectKey: project.Key, FlagKey: "flag-1", Value: ldvalue.Bool(false), Active: true, Version: 1})
+		require.NoError(t, err)
+		require.NoError(t, store.UpsertAlias(ctx, model.Alias{Alias: "archived-alias", ProjectKey: project.Key}))
+		require.NoError(t, store.UpsertSavedContext(ctx, model.SavedContext{ProjectKey: project.Key, Name: "persona", Context: ldContext}))
+		require.NoError(t, store.InsertFlagTrigger(ctx, model.FlagTrigger{ID: "archived-trigger", ProjectKey: project.Key, FlagKey: "flag-1", Action: model.TriggerActionTurnFlagOff, CreatedAt: now}))
+
+		archivedAt := time.UnixMilli(now.UnixMilli())
+		updated, err := store.ArchiveProject(ctx, project.Key, &archivedAt)
+		require.NoError(t, err)
+		assert.True(t, updated)
+		updated, err = store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		require.True(t, updated)
+		archived, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.NotNil(t, archived.ArchivedAt)
+		assert.True(t, archivedAt.Equal(*archived.ArchivedAt))
+
+		updated, err = store.ArchiveProject(ctx, project.Key, nil)
+		require.NoError(t, err)
+		assert.True(t, updated)
+		unarchived, err := store.GetDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Nil(t, unarchived.ArchivedAt)
+
+		deletion, deleted, err := store.DeleteDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		// the insert and update each recorded the flag state
+		assert.Equal(t, model.ProjectDeletion{
+			Overrides:           1,
+			AvailableVariations: 1,
+			Aliases:             1,
+			FlagStateHistory:    2,
+			OverrideHistory:     1,
+			SavedContexts:       1,
+			FlagTriggers:        1,
+		}, deletion)
+		_, err = store.GetDevProject(ctx, project.Key)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+		_, err = store.GetAlias(ctx, "archived-alias")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		_, err = store.GetFlagTrigger(ctx, "archived-trigger")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+
+		_, deleted, err = store.DeleteDevProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		updated, err = store.ArchiveProject(ctx, "nope", &archivedAt)
+		require.NoError(t, err)
+		assert.False(t, updated)
+	})
+
+	t.Run("GetProjectStateAt returns the flag state and overrides as of the given time", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "history-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         time.Now(),
+			AllFlagsState: model.FlagsState{
+				"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1},
+			},
+			AvailableVariations: []model.FlagVariation{{FlagKey: "flag-1"}},
+		}
+		beforeInsert := time.Now().Add(-time.Second)
+		require.NoError(t, store.InsertProject(ctx, project))
+		_, err := store.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(false),
+			Active:     true,
+		})
+		require.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+		afterOverride := time.Now()
+		time.Sleep(5 * time.Millisecond)
+
+		project.AllFlagsState = model.FlagsState{
+			"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 2},
+		}
+		_, err = store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		_, err = store.DeactivateOverride(ctx, project.Key, "flag-1")
+		require.NoError(t, err)
+
+		_, _, err = store.GetProjectStateAt(ctx, project.Key, beforeInsert)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+
+		flagsState, overrides, err := store.GetProjectStateAt(ctx, project.Key, afterOverride)
+		require.NoError(t, err)
+		assert.Equal(t, 1, flagsState["flag-1"].Version)
+		require.Len(t, overrides.User, 1)
+		assert.True(t, overrides.User[0].Active)
+		assert.Equal(t, ldvalue.Bool(false), overrides.User[0].Value)
+		assert.Empty(t, overrides.Scenario)
+
+		flagsState, overrides, err = store.GetProjectStateAt(ctx, project.Key, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 2, flagsState["flag-1"].Version)
+		require.Len(t, overrides.User, 1)
+		assert.False(t, overrides.User[0].Active)
+	})
+
+	t.Run("snapshots are recorded on sync and can be taken and listed, most recent first", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "snapshot-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         time.Now(),
+			AllFlagsState: model.FlagsState{
+				"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1},
+			},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		time.Sleep(5 * time.Millisecond)
+		project.AllFlagsState = model.FlagsState{
+			"flag-1": model.FlagState{Value: ldvalue.Bool(false), Version: 2},
+		}
+		_, err := store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		taken, err := store.InsertSnapshot(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, project.Key, taken.ProjectKey)
+
+		snapshots, err := store.GetSnapshots(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 3)
+		assert.Equal(t, taken, snapshots[0])
+		assert.True(t, snapshots[1].RecordedAt.Before(snapshots[0].RecordedAt))
+		assert.True(t, snapshots[2].RecordedAt.Before(snapshots[1].RecordedAt))
+
+		flagsState, _, err := store.GetProjectStateAt(ctx, project.Key, taken.RecordedAt)
+		require.NoError(t, err)
+		assert.Equal(t, 2, flagsState["flag-1"].Version)
+
+		_, err = store.InsertSnapshot(ctx, "nope")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		snapshots, err = store.GetSnapshots(ctx, "nope")
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("PruneHistory keeps what's needed to reconstruct the project from the cutoff on", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "prune-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         time.Now(),
+			AllFlagsState: model.FlagsState{
+				"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1},
+				"flag-2": model.FlagState{Value: ldvalue.Bool(true), Version: 1},
+			},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		for _, value := range []bool{false, true, false} {
+			_, err := store.UpsertOverride(ctx, model.Override{ProjectKey: project.Key, FlagKey: "flag-1", Value: ldvalue.Bool(value), Active: true})
+			require.NoError(t, err)
+		}
+		_, err := store.UpsertOverride(ctx, model.Override{ProjectKey: project.Key, FlagKey: "flag-2", Value: ldvalue.Bool(false), Active: true})
+		require.NoError(t, err)
+		project.AllFlagsState["flag-1"] = model.FlagState{Value: ldvalue.Bool(true), Version: 2}
+		_, err = store.UpdateProject(ctx, project)
+		require.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+		cutoff := time.Now()
+		time.Sleep(5 * time.Millisecond)
+		_, err = store.UpsertOverride(ctx, model.Override{ProjectKey: project.Key, FlagKey: "flag-1", Value: ldvalue.Bool(true), Active: true})
+		require.NoError(t, err)
+
+		// one flag state and two changes to flag-1's override from before the cutoff are superseded
+		pruned, err := store.PruneHistory(ctx, project.Key, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, 3, pruned)
+		pruned, err = store.PruneHistory(ctx, project.Key, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, 0, pruned)
+
+		flagsState, overrides, err := store.GetProjectStateAt(ctx, project.Key, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, 2, flagsState["flag-1"].Version)
+		require.Len(t, overrides.User, 2)
+		assert.Equal(t, ldvalue.Bool(false), overrides.User[0].Value)
+		assert.Equal(t, ldvalue.Bool(false), overrides.User[1].Value)
+
+		entries, err := store.GetAuditLog(ctx, project.Key, model.AuditQuery{})
+		require.NoError(t, err)
+		assert.Len(t, entries, 3)
+		snapshots, err := store.GetSnapshots(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Len(t, snapshots, 1)
+	})
+
+	t.Run("aliases can be upserted, fetched, listed and deleted", func(t *testing.T) {
+		_, err := store.GetAlias(ctx, "mob-key")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+
+		require.NoError(t, store.UpsertAlias(ctx, model.Alias{Alias: "mob-key", ProjectKey: projects[0].Key}))
+		require.NoError(t, store.UpsertAlias(ctx, model.Alias{Alias: "mob-key", ProjectKey: projects[2].Key}))
+
+		alias, err := store.GetAlias(ctx, "mob-key")
+		require.NoError(t, err)
+		assert.Equal(t, projects[2].Key, alias.ProjectKey)
+
+		aliases, err := store.GetAliases(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Alias{{Alias: "mob-key", ProjectKey: projects[2].Key}}, aliases)
+
+		deleted, err := store.DeleteAlias(ctx, "mob-key")
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		deleted, err = store.DeleteAlias(ctx, "mob-key")
+		require.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("linked clones are listed on their base project", func(t *testing.T) {
+		clone := model.Project{
+			Key:                  "linked-clone",
+			BaseProjectKey:       projects[0].Key,
+			SourceEnvironmentKey: projects[0].SourceEnvironmentKey,
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{},
+		}
+		require.NoError(t, store.InsertProject(ctx, clone))
+
+		fetched, err := store.GetDevProject(ctx, clone.Key)
+		require.NoError(t, err)
+		assert.Equal(t, projects[0].Key, fetched.BaseProjectKey)
+		assert.Empty(t, fetched.LinkedCloneKeys)
+
+		base, err := store.GetDevProject(ctx, projects[0].Key)
+		require.NoError(t, err)
+		assert.Equal(t, []string{clone.Key}, base.LinkedCloneKeys)
+
+		_, deleted, err := store.DeleteDevProject(ctx, clone.Key)
+		require.NoError(t, err)
+		require.True(t, deleted)
+		base, err = store.GetDevProject(ctx, projects[0].Key)
+		require.NoError(t, err)
+		assert.Empty(t, base.LinkedCloneKeys)
+	})
+
+	t.Run("ReplaceScenarioOverrides replaces the scenario layer and bumps versions", func(t *testing.T) {
+		projectKey := projects[0].Key
+		overrides, err := store.ReplaceScenarioOverrides(ctx, projectKey, map[string]ldvalue.Value{
+			"flag-1": ldvalue.Bool(false),
+			"flag-2": ldvalue.String("scenario"),
+		})
+		require.NoError(t, err)
+		require.Len(t, overrides, 2)
+
+		overrides, err = store.ReplaceScenarioOverrides(ctx, projectKey, map[string]ldvalue.Value{
+			"flag-2": ldvalue.String("scenario 2"),
+		})
+		require.NoError(t, err)
+		flag1, ok := overrides.GetFlag("flag-1")
+		require.True(t, ok)
+		assert.False(t, flag1.Active)
+		assert.Equal(t, 2, flag1.Version)
+		flag2, ok := overrides.GetFlag("flag-2")
+		require.True(t, ok)
+		assert.True(t, flag2.Active)
+		assert.Equal(t, ldvalue.String("scenario 2"), flag2.Value)
+		assert.Equal(t, 2, flag2.Version)
+
+		overrides, err = store.ReplaceScenarioOverrides(ctx, projectKey, map[string]ldvalue.Value{
+			"flag-1": ldvalue.Bool(true),
+		})
+		require.NoError(t, err)
+		flag1, _ = overrides.GetFlag("flag-1")
+		assert.True(t, flag1.Active)
+		assert.Equal(t, 3, flag1.Version)
+
+		fetched, err := store.GetScenarioOverridesForProject(ctx, projectKey)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, overrides, fetched)
+
+		_, history, err := store.GetProjectStateAt(ctx, projectKey, time.Now())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, overrides, history.Scenario)
+	})
+
+	t.Run("WithTx commits the writes made in it if fn succeeds", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "tx-commit-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1}},
+		}
+
+		err := store.WithTx(ctx, func(ctx context.Context) error {
+			if err := store.InsertProject(ctx, project); err != nil {
+				return err
+			}
+			_, err := store.UpsertOverride(ctx, model.Override{
+				ProjectKey: project.Key,
+				FlagKey:    "flag-1",
+				Value:      ldvalue.Bool(false),
+				Active:     true,
+				Version:    1,
+			})
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = store.GetDevProject(ctx, project.Key)
+		assert.NoError(t, err)
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, ldvalue.Bool(false), overrides[0].Value)
+	})
+
+	t.Run("WithTx rolls back the writes made in it, including in nested transactions, if fn fails", func(t *testing.T) {
+		project := model.Project{
+			Key:                  "tx-rollback-proj",
+			SourceEnvironmentKey: "env",
+			Context:              ldContext,
+			LastSyncTime:         now,
+			AllFlagsState:        model.FlagsState{"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1}},
+		}
+		require.NoError(t, store.InsertProject(ctx, project))
+		override := model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(false),
+			Active:     true,
+			Version:    1,
+		}
+		_, err := store.UpsertOverride(ctx, override)
+		require.NoError(t, err)
+
+		failed := errors.New("failed")
+		err = store.WithTx(ctx, func(ctx context.Context) error {
+			_, err := store.UpsertOverride(ctx, model.Override{
+				ProjectKey: project.Key,
+				FlagKey:    "flag-1",
+				Value:      ldvalue.Bool(true),
+				Active:     true,
+				Version:    2,
+			})
+			if err != nil {
+				return err
+			}
+			err = store.WithTx(ctx, func(ctx context.Context) error {
+				return store.InsertProject(ctx, model.Project{
+					Key:                  "tx-rolled-back-proj",
+					SourceEnvironmentKey: "env",
+					Context:              ldContext,
+					LastSyncTime:         now,
+					AllFlagsState:        model.FlagsState{},
+				})
+			})
+			if err != nil {
+				return err
+			}
+			if _, _, err := store.DeleteDevProject(ctx, project.Key); err != nil {
+				return err
+			}
+			return failed
+		})
+		assert.ErrorIs(t, err, failed)
+
+		_, err = store.GetDevProject(ctx, project.Key)
+		assert.NoError(t, err, "the deleted project is restored")
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, model.Overrides{override}, overrides)
+		_, err = store.GetDevProject(ctx, "tx-rolled-back-proj")
+		assert.ErrorAs(t, err, &model.ErrNotFound{}, "the project inserted in the nested transaction is removed")
+	})
+}
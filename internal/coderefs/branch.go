@@ -0,0 +1,64 @@
+package coderefs
+
+import (
+	"sort"
+	"time"
+)
+
+// Branch is the code references on a branch of a repository, as LaunchDarkly stores them.
+type Branch struct {
+	Name       string          `json:"name"`
+	Head       string          `json:"head"`
+	SyncTime   int64           `json:"syncTime"`
+	References []FileReference `json:"references"`
+}
+
+// FileReference is the references in a file.
+type FileReference struct {
+	Path  string `json:"path"`
+	Hunks []Hunk `json:"hunks"`
+}
+
+// Hunk is a reference to a flag, and the code around it.
+type Hunk struct {
+	StartingLineNumber int    `json:"startingLineNumber"`
+	Lines              string `json:"lines"`
+	ProjKey            string `json:"projKey"`
+	FlagKey            string `json:"flagKey"`
+}
+
+// NewBranch groups the references to the flags in a project by file, sorted by path and line.
+func NewBranch(name string, head string, projectKey string, refs map[string][]Reference, syncTime time.Time) Branch {
+	hunks := make(map[string][]Hunk)
+	for flagKey, flagRefs := range refs {
+		for _, ref := range flagRefs {
+			hunks[ref.Path] = append(hunks[ref.Path], Hunk{
+				StartingLineNumber: ref.Line,
+				Lines:              ref.Content,
+				ProjKey:            projectKey,
+				FlagKey:            flagKey,
+			})
+		}
+	}
+
+	files := make([]FileReference, 0, len(hunks))
+	for path, fileHunks := range hunks {
+		sort.Slice(fileHunks, func(i, j int) bool {
+			if fileHunks[i].StartingLineNumber != fileHunks[j].StartingLineNumber {
+				return fileHunks[i].StartingLineNumber < fileHunks[j].StartingLineNumber
+			}
+			return fileHunks[i].FlagKey < fileHunks[j].FlagKey
+		})
+		files = append(files, FileReference{Path: path, Hunks: fileHunks})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return Branch{
+		Name:       name,
+		Head:       head,
+		SyncTime:   syncTime.UnixMilli(),
+		References: files,
+	}
+}
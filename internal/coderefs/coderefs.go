@@ -0,0 +1,246 @@
+package coderefs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ConfigFile is where a repository keeps its scan options, relative to its root.
+	ConfigFile = ".launchdarkly/coderefs.yaml"
+	// DefaultDelimiters are the characters a flag key is between when code refers to it, such as the quotes of a
+	// string.
+	DefaultDelimiters = "\"'`"
+
+	// maxFileSize is the size of the largest file that's scanned. Larger files are usually generated or minified.
+	maxFileSize = 1 << 20
+	// binarySniffLen is how much of a file is checked for NUL bytes to tell whether it's binary.
+	binarySniffLen = 8000
+)
+
+// skippedDirs hold dependencies and build output, not the repository's own code.
+var skippedDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+	"build":        true,
+	"target":       true,
+}
+
+// Reference is a line of code that refers to a flag.
+type Reference struct {
+	Path    string `json:"path"`
+	Line    int    `json:"line"`
+	Content string `json:"content"`
+}
+
+// Options describe what to scan for, and where.
+type Options struct {
+	// FlagKeys are the flags to look for.
+	FlagKeys []string
+	// Delimiters are the characters that a flag key has to be between to count as a reference. DefaultDelimiters are
+	// used if it's empty.
+	Delimiters string `yaml:"delimiters"`
+	// Patterns are regular expressions for references that aren't between delimiters, such as in a function name.
+	// The first capture group of a match is the flag key.
+	Patterns []string `yaml:"patterns"`
+	// Extensions limits the scan to files with these extensions, without the dot, such as go or tsx.
+	Extensions []string `yaml:"extensions"`
+	// Exclude are glob patterns for paths to skip, relative to the root, such as testdata/*.
+	Exclude []string `yaml:"exclude"`
+}
+
+// ReadOptions reads the scan options a repository keeps in its ConfigFile. It returns empty options if there isn't
+// one.
+func ReadOptions(root string) (Options, error) {
+	data, err := os.ReadFile(filepath.Join(root, ConfigFile))
+	if os.IsNotExist(err) {
+		return Options{}, nil
+	}
+	if err != nil {
+		return Options{}, err
+	}
+
+	var options Options
+	if err := yaml.Unmarshal(data, &options); err != nil {
+		return Options{}, fmt.Errorf("%s is invalid: %w", ConfigFile, err)
+	}
+
+	return options, nil
+}
+
+// Merge adds the other options to these ones. The other delimiters replace these ones if they're set.
+func (o Options) Merge(other Options) Options {
+	o.FlagKeys = append(append([]string{}, o.FlagKeys...), other.FlagKeys...)
+	if other.Delimiters != "" {
+		o.Delimiters = other.Delimiters
+	}
+	o.Patterns = append(append([]string{}, o.Patterns...), other.Patterns...)
+	o.Extensions = append(append([]string{}, o.Extensions...), other.Extensions...)
+	o.Exclude = append(append([]string{}, o.Exclude...), other.Exclude...)
+
+	return o
+}
+
+// Scan finds the references to flags in the files under root, by flag key. It skips hidden directories, such as .git,
+// directories of dependencies and build output, such as node_modules, and files that aren't text.
+func Scan(root string, options Options) (map[string][]Reference, error) {
+	patterns := make([]*regexp.Regexp, 0, len(options.Patterns))
+	for _, p := range options.Patterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("pattern %s is invalid: %w", p, err)
+		}
+		if pattern.NumSubexp() < 1 {
+			return nil, fmt.Errorf("pattern %s needs a capture group for the flag key", p)
+		}
+		patterns = append(patterns, pattern)
+	}
+	for _, exclude := range options.Exclude {
+		if _, err := filepath.Match(exclude, ""); err != nil {
+			return nil, fmt.Errorf("exclude pattern %s is invalid: %w", exclude, err)
+		}
+	}
+	delimiters := options.Delimiters
+	if delimiters == "" {
+		delimiters = DefaultDelimiters
+	}
+	flagKeys := make(map[string]bool, len(options.FlagKeys))
+	for _, key := range options.FlagKeys {
+		flagKeys[key] = true
+	}
+	extensions := make(map[string]bool, len(options.Extensions))
+	for _, ext := range options.Extensions {
+		extensions[strings.TrimPrefix(ext, ".")] = true
+	}
+
+	refs := make(map[string][]Reference)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, _ := filepath.Rel(root, path)
+		relPath = filepath.ToSlash(relPath)
+		if entry.IsDir() {
+			if path != root && (strings.HasPrefix(entry.Name(), ".") || skippedDirs[entry.Name()] || isExcluded(relPath, options.Exclude)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() || isExcluded(relPath, options.Exclude) {
+			return nil
+		}
+		if len(extensions) > 0 && !extensions[strings.TrimPrefix(filepath.Ext(path), ".")] {
+			return nil
+		}
+
+		return scanFile(path, relPath, flagKeys, delimiters, patterns, refs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+func isExcluded(relPath string, exclude []string) bool {
+	for _, pattern := range exclude {
+		if ok, _ := filepath.Match(pattern, relPath); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, filepath.Base(relPath)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scanFile(
+	path string,
+	relPath string,
+	flagKeys map[string]bool,
+	delimiters string,
+	patterns []*regexp.Regexp,
+	refs map[string][]Reference,
+) error {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() > maxFileSize {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if bytes.IndexByte(data[:min(len(data), binarySniffLen)], 0) >= 0 {
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFileSize)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		for _, key := range lineFlagKeys(line, flagKeys, delimiters, patterns) {
+			refs[key] = append(refs[key], Reference{
+				Path:    relPath,
+				Line:    lineNumber,
+				Content: strings.TrimSpace(line),
+			})
+		}
+	}
+
+	return scanner.Err()
+}
+
+// lineFlagKeys finds the flags a line refers to, each once, in order.
+func lineFlagKeys(line string, flagKeys map[string]bool, delimiters string, patterns []*regexp.Regexp) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	add := func(key string) {
+		if flagKeys[key] && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	// any text between two delimiters could be a flag key
+	start := -1
+	for i, r := range line {
+		if !strings.ContainsRune(delimiters, r) {
+			continue
+		}
+		if start >= 0 {
+			add(line[start:i])
+		}
+		start = i + len(string(r))
+	}
+	for _, pattern := range patterns {
+		for _, match := range pattern.FindAllStringSubmatch(line, -1) {
+			add(match[1])
+		}
+	}
+
+	return keys
+}
+
+// Unreferenced lists the flags that no code refers to, sorted by key.
+func Unreferenced(flagKeys []string, refs map[string][]Reference) []string {
+	unreferenced := make([]string, 0)
+	for _, key := range flagKeys {
+		if len(refs[key]) == 0 {
+			unreferenced = append(unreferenced, key)
+		}
+	}
+	sort.Strings(unreferenced)
+
+	return unreferenced
+}
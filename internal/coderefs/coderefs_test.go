@@ -0,0 +1,153 @@
+package coderefs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/coderefs"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	return dir
+}
+
+func TestScan(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"main.go":                   "package main\n\nif client.BoolVariation(\"new-checkout\", ctx, false) {\n\tlog(`dark-mode`, 'new-checkout-v2')\n}\n",
+		"web/app.tsx":               "const on = useFlags()['dark-mode'];\nconst key = \"dark-mode-beta\";\n",
+		"web/flags.ts":              "isEnabled(FLAG_NEW_CHECKOUT, new_checkout)\n",
+		"node_modules/lib/index.js": "variation('new-checkout')\n",
+		".git/config":               "\"new-checkout\"\n",
+		"testdata/fixture.go":       "\"new-checkout\"\n",
+		"image.png":                 "\x00\"new-checkout\"",
+	})
+	options := coderefs.Options{
+		FlagKeys: []string{"new-checkout", "dark-mode", "unused"},
+	}
+
+	t.Run("finds flag keys between delimiters", func(t *testing.T) {
+		refs, err := coderefs.Scan(dir, options)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]coderefs.Reference{
+			"new-checkout": {
+				{Path: "main.go", Line: 3, Content: `if client.BoolVariation("new-checkout", ctx, false) {`},
+				{Path: "testdata/fixture.go", Line: 1, Content: `"new-checkout"`},
+			},
+			"dark-mode": {
+				{Path: "main.go", Line: 4, Content: "log(`dark-mode`, 'new-checkout-v2')"},
+				{Path: "web/app.tsx", Line: 1, Content: "const on = useFlags()['dark-mode'];"},
+			},
+		}, refs)
+		assert.Equal(t, []string{"unused"}, coderefs.Unreferenced(options.FlagKeys, refs))
+	})
+
+	t.Run("with extensions and exclude patterns", func(t *testing.T) {
+		refs, err := coderefs.Scan(dir, options.Merge(coderefs.Options{
+			Extensions: []string{"go"},
+			Exclude:    []string{"testdata"},
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.go"}, paths(refs["new-checkout"]))
+		assert.Equal(t, []string{"main.go"}, paths(refs["dark-mode"]))
+	})
+
+	t.Run("with a pattern", func(t *testing.T) {
+		refs, err := coderefs.Scan(dir, options.Merge(coderefs.Options{
+			FlagKeys: []string{"new_checkout"},
+			Patterns: []string{`isEnabled\(\w+, (\w+)\)`},
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, []coderefs.Reference{
+			{Path: "web/flags.ts", Line: 1, Content: "isEnabled(FLAG_NEW_CHECKOUT, new_checkout)"},
+		}, refs["new_checkout"])
+	})
+
+	t.Run("with a pattern without a capture group", func(t *testing.T) {
+		_, err := coderefs.Scan(dir, coderefs.Options{Patterns: []string{`isEnabled`}})
+
+		assert.EqualError(t, err, "pattern isEnabled needs a capture group for the flag key")
+	})
+}
+
+func TestReadOptions(t *testing.T) {
+	t.Run("without a config file", func(t *testing.T) {
+		options, err := coderefs.ReadOptions(t.TempDir())
+
+		require.NoError(t, err)
+		assert.Equal(t, coderefs.Options{}, options)
+	})
+
+	t.Run("with a config file", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{
+			coderefs.ConfigFile: "extensions: [go, ts]\nexclude: [\"testdata/*\"]\npatterns: ['flag\\((\\w+)\\)']\n",
+		})
+
+		options, err := coderefs.ReadOptions(dir)
+
+		require.NoError(t, err)
+		assert.Equal(t, coderefs.Options{
+			Extensions: []string{"go", "ts"},
+			Exclude:    []string{"testdata/*"},
+			Patterns:   []string{`flag\((\w+)\)`},
+		}, options)
+	})
+}
+
+func TestNewBranch(t *testing.T) {
+	refs := map[string][]coderefs.Reference{
+		"dark-mode": {
+			{Path: "b.go", Line: 2, Content: `"dark-mode"`},
+			{Path: "a.go", Line: 7, Content: `"dark-mode"`},
+		},
+		"new-checkout": {
+			{Path: "a.go", Line: 3, Content: `"new-checkout"`},
+		},
+	}
+
+	branch := coderefs.NewBranch("main", "abc123", "default", refs, time.UnixMilli(1700000000000))
+
+	assert.Equal(t, coderefs.Branch{
+		Name:     "main",
+		Head:     "abc123",
+		SyncTime: 1700000000000,
+		References: []coderefs.FileReference{
+			{
+				Path: "a.go",
+				Hunks: []coderefs.Hunk{
+					{StartingLineNumber: 3, Lines: `"new-checkout"`, ProjKey: "default", FlagKey: "new-checkout"},
+					{StartingLineNumber: 7, Lines: `"dark-mode"`, ProjKey: "default", FlagKey: "dark-mode"},
+				},
+			},
+			{
+				Path: "b.go",
+				Hunks: []coderefs.Hunk{
+					{StartingLineNumber: 2, Lines: `"dark-mode"`, ProjKey: "default", FlagKey: "dark-mode"},
+				},
+			},
+		},
+	}, branch)
+}
+
+func paths(refs []coderefs.Reference) []string {
+	paths := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		paths = append(paths, ref.Path)
+	}
+
+	return paths
+}
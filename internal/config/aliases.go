@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/launchdarkly/ldcli/internal/errors"
+)
+
+// AliasPrefix is the prefix of the config keys that define aliases, e.g. alias.toggle.
+const AliasPrefix = "alias."
+
+// aliasArgPattern matches the placeholders for an alias's arguments, $1 for the first one and so on.
+var aliasArgPattern = regexp.MustCompile(`\$(\d+)`)
+
+// ExpandAlias turns an alias's definition and the arguments it was run with into the arguments for the command it
+// stands for. The definition is split into words like a shell would, and then $1, $2, etc. are replaced with the
+// alias's arguments, and $@ with all of them. Arguments that aren't used by a placeholder are added to the end, so an
+// alias without placeholders passes its arguments on.
+func ExpandAlias(name string, definition string, args []string) ([]string, error) {
+	words, err := splitWords(definition)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("alias %s is invalid: %s", name, err))
+	}
+
+	used := make([]bool, len(args))
+	expanded := make([]string, 0, len(words)+len(args))
+	for _, word := range words {
+		if word == "$@" {
+			expanded = append(expanded, args...)
+			for i := range used {
+				used[i] = true
+			}
+			continue
+		}
+
+		var missing int
+		word = aliasArgPattern.ReplaceAllStringFunc(word, func(placeholder string) string {
+			i, _ := strconv.Atoi(placeholder[1:])
+			if i < 1 || i > len(args) {
+				missing = max(missing, i)
+				return placeholder
+			}
+			used[i-1] = true
+			return args[i-1]
+		})
+		if missing > 0 {
+			return nil, errors.NewError(fmt.Sprintf("alias %s needs at least %d arguments", name, missing))
+		}
+		expanded = append(expanded, word)
+	}
+
+	for i, arg := range args {
+		if !used[i] {
+			expanded = append(expanded, arg)
+		}
+	}
+
+	return expanded, nil
+}
+
+// splitWords splits a command line into words at whitespace, keeping quoted text together. Backslashes escape the
+// next character outside single quotes.
+func splitWords(s string) ([]string, error) {
+	var (
+		words  []string
+		word   strings.Builder
+		inWord bool
+		quote  rune
+		escape bool
+	)
+	for _, r := range s {
+		switch {
+		case escape:
+			word.WriteRune(r)
+			escape = false
+		case r == '\\' && quote != '\'':
+			escape = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
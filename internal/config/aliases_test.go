@@ -0,0 +1,99 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/config"
+)
+
+func TestExpandAlias(t *testing.T) {
+	tests := map[string]struct {
+		definition string
+		args       []string
+		expected   []string
+	}{
+		"without placeholders adds the arguments to the end": {
+			definition: "flags list --project default",
+			args:       []string{"--limit", "5"},
+			expected:   []string{"flags", "list", "--project", "default", "--limit", "5"},
+		},
+		"with numbered placeholders": {
+			definition: "flags toggle-on --flag $1 --environment $2",
+			args:       []string{"new-checkout", "production", "--project", "default"},
+			expected:   []string{"flags", "toggle-on", "--flag", "new-checkout", "--environment", "production", "--project", "default"},
+		},
+		"with a placeholder inside a word": {
+			definition: "flags get --flag=$1",
+			args:       []string{"new-checkout"},
+			expected:   []string{"flags", "get", "--flag=new-checkout"},
+		},
+		"with all the arguments": {
+			definition: "members invite --emails $@ --role reader",
+			args:       []string{"a@example.com", "b@example.com"},
+			expected:   []string{"members", "invite", "--emails", "a@example.com", "b@example.com", "--role", "reader"},
+		},
+		"with quotes": {
+			definition: `flags create --data '{"key": "$1", "name": "$1"}' --note "a \"quoted\" note"`,
+			args:       []string{"new-checkout"},
+			expected:   []string{"flags", "create", "--data", `{"key": "new-checkout", "name": "new-checkout"}`, "--note", `a "quoted" note`},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := config.ExpandAlias("test", tt.definition, tt.args)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("with too few arguments is an error", func(t *testing.T) {
+		_, err := config.ExpandAlias("toggle", "flags toggle-on --flag $1 --environment $2", []string{"new-checkout"})
+
+		assert.EqualError(t, err, "alias toggle needs at least 2 arguments")
+	})
+
+	t.Run("with an unterminated quote is an error", func(t *testing.T) {
+		_, err := config.ExpandAlias("create", `flags create --data '{"key": "$1"}`, []string{"new-checkout"})
+
+		assert.EqualError(t, err, "alias create is invalid: unterminated ' quote")
+	})
+}
+
+func TestAliases(t *testing.T) {
+	c, err := config.New("test", mockReadFile{}.readFile)
+	require.NoError(t, err)
+
+	t.Run("sets an alias", func(t *testing.T) {
+		result, updatedFields, err := c.Update([]string{"alias.toggle", "flags toggle-on --flag $1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alias.toggle"}, updatedFields)
+		assert.Equal(t, map[string]string{"toggle": "flags toggle-on --flag $1"}, result.Aliases)
+	})
+
+	t.Run("removes an alias", func(t *testing.T) {
+		c, _, err := c.Update([]string{"alias.toggle", "flags toggle-on --flag $1", "alias.list", "flags list"})
+		require.NoError(t, err)
+
+		result, err := c.Remove("alias.toggle")
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"list": "flags list"}, result.Aliases)
+	})
+
+	t.Run("removing an alias that doesn't exist is an error", func(t *testing.T) {
+		_, err := c.Remove("alias.toggle")
+
+		assert.EqualError(t, err, "alias toggle does not exist")
+	})
+
+	t.Run("setting an alias in a profile is an error", func(t *testing.T) {
+		_, _, err := c.UpdateProfile("work", []string{"alias.toggle", "flags toggle-on"})
+
+		assert.EqualError(t, err, "aliases can't be set in a profile")
+	})
+}
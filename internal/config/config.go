@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/mitchellh/go-homedir"
 	"gopkg.in/yaml.v3"
@@ -16,6 +17,9 @@ import (
 
 const Filename = ".ldcli-config.yml"
 
+// ProfileEnvVar is the environment variable that selects a profile when --profile isn't given.
+const ProfileEnvVar = "LDCLI_PROFILE"
+
 type ReadFile func(name string) ([]byte, error)
 
 // Config represents the data stored in the config file.
@@ -28,6 +32,12 @@ type Config struct {
 	Flag            string `json:"flag,omitempty" yaml:"flag,omitempty"`
 	Output          string `json:"output,omitempty" yaml:"output,omitempty"`
 	Project         string `json:"project,omitempty" yaml:"project,omitempty"`
+	Proxy           string `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+	// Aliases are shortcuts for commands, by name, which are defined with alias.<name> keys.
+	Aliases map[string]string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	// Profiles are named sets of settings, such as for another account or role, that are used instead of the
+	// settings above when selected with --profile.
+	Profiles map[string]Config `json:"profiles,omitempty" yaml:"profiles,omitempty"`
 }
 
 func New(filename string, readFile ReadFile) (Config, error) {
@@ -58,7 +68,7 @@ func (c Config) Update(kvs []string) (Config, []string, error) {
 	for i := 0; i < len(kvs)-1; i += 2 {
 		// TODO: move this list to this package?
 		_, ok := cliflags.AllFlagsHelp()[kvs[i]]
-		if !ok {
+		if !ok && !isAliasKey(kvs[i]) {
 			return Config{}, updatedFields, errors.NewError(fmt.Sprintf("%s is not a valid configuration option", kvs[i]))
 		}
 	}
@@ -95,6 +105,15 @@ func (c Config) Update(kvs []string) (Config, []string, error) {
 				c.Output = val.String()
 			case cliflags.ProjectFlag:
 				c.Project = v
+			case cliflags.ProxyFlag:
+				c.Proxy = v
+			default:
+				aliases := make(map[string]string, len(c.Aliases)+1)
+				for name, definition := range c.Aliases {
+					aliases[name] = definition
+				}
+				aliases[strings.TrimPrefix(currField, AliasPrefix)] = v
+				c.Aliases = aliases
 			}
 		}
 	}
@@ -103,8 +122,24 @@ func (c Config) Update(kvs []string) (Config, []string, error) {
 }
 
 // Remove validates the key exists but doesn't do anything else since we unset the value when
-// writing to disk.
+// writing to disk. Aliases are the exception, and are removed from the Config.
 func (c Config) Remove(key string) (Config, error) {
+	if isAliasKey(key) {
+		name := strings.TrimPrefix(key, AliasPrefix)
+		if _, ok := c.Aliases[name]; !ok {
+			return Config{}, errors.NewError(fmt.Sprintf("alias %s does not exist", name))
+		}
+		aliases := make(map[string]string, len(c.Aliases))
+		for n, definition := range c.Aliases {
+			if n != name {
+				aliases[n] = definition
+			}
+		}
+		c.Aliases = aliases
+
+		return c, nil
+	}
+
 	_, ok := cliflags.AllFlagsHelp()[key]
 	if !ok {
 		return Config{}, errors.NewError(fmt.Sprintf("%s is not a valid configuration option", key))
@@ -113,6 +148,87 @@ func (c Config) Remove(key string) (Config, error) {
 	return c, nil
 }
 
+func isAliasKey(key string) bool {
+	return strings.HasPrefix(key, AliasPrefix) && len(key) > len(AliasPrefix)
+}
+
+// Profile gets the settings in the named profile.
+func (c Config) Profile(name string) (Config, error) {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return Config{}, errors.NewError(fmt.Sprintf("profile %s does not exist", name))
+	}
+
+	return profile, nil
+}
+
+// UpdateProfile validates the updating fields and sets them on the named profile, creating the profile if it doesn't
+// exist yet. It returns the updated fields in addition to the Config.
+func (c Config) UpdateProfile(name string, kvs []string) (Config, []string, error) {
+	for i := 0; i < len(kvs); i += 2 {
+		if isAliasKey(kvs[i]) {
+			return Config{}, nil, errors.NewError("aliases can't be set in a profile")
+		}
+	}
+	profile, updatedFields, err := c.Profiles[name].Update(kvs)
+	if err != nil {
+		return Config{}, nil, err
+	}
+
+	profiles := make(map[string]Config, len(c.Profiles)+1)
+	for k, v := range c.Profiles {
+		profiles[k] = v
+	}
+	profiles[name] = profile
+	c.Profiles = profiles
+
+	return c, updatedFields, nil
+}
+
+// RemoveFromProfile validates the key exists and unsets it on the named profile.
+func (c Config) RemoveFromProfile(name string, key string) (Config, error) {
+	if isAliasKey(key) {
+		return Config{}, errors.NewError("aliases can't be set in a profile")
+	}
+	if _, err := c.Remove(key); err != nil {
+		return Config{}, err
+	}
+	profile, err := c.Profile(name)
+	if err != nil {
+		return Config{}, err
+	}
+
+	switch key {
+	case cliflags.AccessTokenFlag:
+		profile.AccessToken = ""
+	case cliflags.AnalyticsOptOut:
+		profile.AnalyticsOptOut = nil
+	case cliflags.BaseURIFlag:
+		profile.BaseURI = ""
+	case cliflags.DevStreamURIFlag:
+		profile.DevStreamURI = ""
+	case cliflags.EnvironmentFlag:
+		profile.Environment = ""
+	case cliflags.FlagFlag:
+		profile.Flag = ""
+	case cliflags.OutputFlag:
+		profile.Output = ""
+	case cliflags.ProjectFlag:
+		profile.Project = ""
+	case cliflags.ProxyFlag:
+		profile.Proxy = ""
+	}
+
+	profiles := make(map[string]Config, len(c.Profiles))
+	for k, v := range c.Profiles {
+		profiles[k] = v
+	}
+	profiles[name] = profile
+	c.Profiles = profiles
+
+	return c, nil
+}
+
 // GetConfigFile gets the full path to the config file.
 func GetConfigFile() string {
 	configPath := os.Getenv("XDG_CONFIG_HOME")
@@ -128,14 +244,22 @@ func GetConfigFile() string {
 	return filepath.Join(configFilePath, "config.yml")
 }
 
-func AccessTokenIsSet(filename string) (bool, error) {
+// AccessTokenIsSet checks that the access token for a profile, or for the top-level settings if
+// profile is empty, isn't set yet, so logging in doesn't replace it.
+func AccessTokenIsSet(filename string, profile string) (bool, error) {
 	config, err := New(filename, os.ReadFile)
 	if err != nil {
 		return false, err
 	}
+	if profile != "" {
+		config = config.Profiles[profile]
+	}
 	if config.AccessToken != "" {
 		return false, errors.NewError("Your access token is already set. Remove it from the config if you wish to reset it.")
 	}
+	if token, _ := KeychainAccessToken(profile); token != "" {
+		return false, errors.NewError("Your access token is already set in the OS keychain. Remove it with `ldcli config --unset access-token` if you wish to reset it.")
+	}
 
 	return true, nil
 }
@@ -110,7 +110,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("with an invalid output flag", func(t *testing.T) {
 		_, _, err = c.Update([]string{"output", "invalid"})
 
-		assert.EqualError(t, err, "output is invalid. Use 'json' or 'plaintext'")
+		assert.EqualError(t, err, "output is invalid. Use 'json', 'yaml', 'csv', 'plaintext', or 'github-actions'")
 	})
 
 	t.Run("with an invalid analytics-opt-out flag", func(t *testing.T) {
@@ -149,3 +149,65 @@ func TestRemove(t *testing.T) {
 		assert.EqualError(t, err, "invalid is not a valid configuration option")
 	})
 }
+
+func TestProfiles(t *testing.T) {
+	mock := mockReadFile{
+		contents: []byte(`
+access-token: test-access-token
+profiles:
+  work:
+    access-token: work-access-token
+    project: work-project
+`,
+		),
+	}
+	c, err := config.New("test", mock.readFile)
+	require.NoError(t, err)
+
+	t.Run("gets a profile", func(t *testing.T) {
+		profile, err := c.Profile("work")
+
+		require.NoError(t, err)
+		assert.Equal(t, "work-access-token", profile.AccessToken)
+		assert.Equal(t, "work-project", profile.Project)
+	})
+
+	t.Run("with a profile that doesn't exist is an error", func(t *testing.T) {
+		_, err := c.Profile("home")
+
+		assert.EqualError(t, err, "profile home does not exist")
+	})
+
+	t.Run("updates a profile", func(t *testing.T) {
+		result, updatedFields, err := c.UpdateProfile("work", []string{"environment", "production"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"environment"}, updatedFields)
+		assert.Equal(t, "test-access-token", result.AccessToken)
+		assert.Equal(t, "work-project", result.Profiles["work"].Project)
+		assert.Equal(t, "production", result.Profiles["work"].Environment)
+		assert.Empty(t, c.Profiles["work"].Environment, "the original config is unchanged")
+	})
+
+	t.Run("creates a profile when updating one that doesn't exist", func(t *testing.T) {
+		result, _, err := c.UpdateProfile("home", []string{"project", "home-project"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "home-project", result.Profiles["home"].Project)
+		assert.Equal(t, "work-project", result.Profiles["work"].Project)
+	})
+
+	t.Run("removes a field from a profile", func(t *testing.T) {
+		result, err := c.RemoveFromProfile("work", "project")
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Profiles["work"].Project)
+		assert.Equal(t, "work-access-token", result.Profiles["work"].AccessToken)
+	})
+
+	t.Run("with an invalid flag is an error when removing from a profile", func(t *testing.T) {
+		_, err := c.RemoveFromProfile("work", "invalid")
+
+		assert.EqualError(t, err, "invalid is not a valid configuration option")
+	})
+}
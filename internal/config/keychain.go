@@ -0,0 +1,105 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/zalando/go-keyring"
+
+	"github.com/launchdarkly/ldcli/cmd/cliflags"
+)
+
+// keychainService is the service that access tokens are stored under in the OS keychain.
+const keychainService = "ldcli"
+
+// keychainAccount is the account that the access token for a profile is stored as in the OS keychain. An empty profile
+// is for the top-level settings.
+func keychainAccount(profile string) string {
+	if profile == "" {
+		return "default"
+	}
+
+	return "profiles." + profile
+}
+
+// KeychainAccessToken gets the access token for a profile from the OS keychain, which is the macOS Keychain, Windows
+// Credential Manager, or a Secret Service such as GNOME Keyring. It returns an empty string if there isn't one.
+func KeychainAccessToken(profile string) (string, error) {
+	token, err := keyring.Get(keychainService, keychainAccount(profile))
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", nil
+	}
+
+	return token, err
+}
+
+// SetKeychainAccessToken stores the access token for a profile in the OS keychain.
+func SetKeychainAccessToken(profile string, token string) error {
+	err := keyring.Set(keychainService, keychainAccount(profile), token)
+	if err != nil {
+		return fmt.Errorf("unable to store the access token in the OS keychain: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteKeychainAccessToken removes the access token for a profile from the OS keychain, if it's there.
+func DeleteKeychainAccessToken(profile string) error {
+	err := keyring.Delete(keychainService, keychainAccount(profile))
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// MoveAccessTokenToKeychain stores the access token for a profile, or for the top-level settings if profile is empty, in
+// the OS keychain, and removes it from the Config.
+func (c Config) MoveAccessTokenToKeychain(profile string) (Config, error) {
+	if profile == "" {
+		if c.AccessToken == "" {
+			return c, nil
+		}
+		if err := SetKeychainAccessToken("", c.AccessToken); err != nil {
+			return Config{}, err
+		}
+		c.AccessToken = ""
+
+		return c, nil
+	}
+
+	if c.Profiles[profile].AccessToken == "" {
+		return c, nil
+	}
+	if err := SetKeychainAccessToken(profile, c.Profiles[profile].AccessToken); err != nil {
+		return Config{}, err
+	}
+
+	return c.RemoveFromProfile(profile, cliflags.AccessTokenFlag)
+}
+
+// MigrateToKeychain moves every access token in the Config, the top-level one and the profiles', to the OS keychain.
+// It returns the names of the profiles whose tokens were moved, with an empty name for the top-level one.
+func (c Config) MigrateToKeychain() (Config, []string, error) {
+	profiles := make([]string, 0, len(c.Profiles)+1)
+	if c.AccessToken != "" {
+		profiles = append(profiles, "")
+	}
+	for name, profile := range c.Profiles {
+		if profile.AccessToken != "" {
+			profiles = append(profiles, name)
+		}
+	}
+	sort.Strings(profiles)
+
+	var err error
+	for _, profile := range profiles {
+		c, err = c.MoveAccessTokenToKeychain(profile)
+		if err != nil {
+			return Config{}, nil, err
+		}
+	}
+
+	return c, profiles, nil
+}
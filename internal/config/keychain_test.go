@@ -0,0 +1,79 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"github.com/launchdarkly/ldcli/internal/config"
+)
+
+func TestKeychainAccessToken(t *testing.T) {
+	keyring.MockInit()
+
+	t.Run("without a token is empty", func(t *testing.T) {
+		token, err := config.KeychainAccessToken("")
+
+		require.NoError(t, err)
+		assert.Empty(t, token)
+	})
+
+	t.Run("gets a token that was set", func(t *testing.T) {
+		require.NoError(t, config.SetKeychainAccessToken("work", "work-access-token"))
+
+		token, err := config.KeychainAccessToken("work")
+
+		require.NoError(t, err)
+		assert.Equal(t, "work-access-token", token)
+	})
+
+	t.Run("deletes a token", func(t *testing.T) {
+		require.NoError(t, config.SetKeychainAccessToken("", "test-access-token"))
+
+		require.NoError(t, config.DeleteKeychainAccessToken(""))
+
+		token, err := config.KeychainAccessToken("")
+		require.NoError(t, err)
+		assert.Empty(t, token)
+	})
+
+	t.Run("deleting a token that isn't there isn't an error", func(t *testing.T) {
+		assert.NoError(t, config.DeleteKeychainAccessToken("home"))
+	})
+}
+
+func TestMigrateToKeychain(t *testing.T) {
+	keyring.MockInit()
+	mock := mockReadFile{
+		contents: []byte(`
+access-token: test-access-token
+project: test-project
+profiles:
+  work:
+    access-token: work-access-token
+    project: work-project
+  home:
+    project: home-project
+`,
+		),
+	}
+	c, err := config.New("test", mock.readFile)
+	require.NoError(t, err)
+
+	result, profiles, err := c.MigrateToKeychain()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "work"}, profiles)
+	assert.Empty(t, result.AccessToken)
+	assert.Empty(t, result.Profiles["work"].AccessToken)
+	assert.Equal(t, "test-project", result.Project)
+	assert.Equal(t, "work-project", result.Profiles["work"].Project)
+	token, err := config.KeychainAccessToken("")
+	require.NoError(t, err)
+	assert.Equal(t, "test-access-token", token)
+	token, err = config.KeychainAccessToken("work")
+	require.NoError(t, err)
+	assert.Equal(t, "work-access-token", token)
+}
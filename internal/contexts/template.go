@@ -0,0 +1,63 @@
+package contexts
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/launchdarkly/ldcli/internal/errors"
+)
+
+var templateFuncs = template.FuncMap{
+	// uuid returns a new random UUID each time it's called. Assign it to a variable, e.g. {{$id := uuid}}, to use the
+	// same one twice.
+	"uuid": func() string {
+		return uuid.NewString()
+	},
+	// now returns the current time in RFC 3339 format.
+	"now": func() string {
+		return time.Now().UTC().Format(time.RFC3339)
+	},
+	// randInt returns a random integer between min and max, inclusive.
+	"randInt": func(min, max int) (int, error) {
+		if max < min {
+			return 0, fmt.Errorf("randInt max %d is less than min %d", max, min)
+		}
+		return min + rand.IntN(max-min+1), nil
+	},
+}
+
+// Render executes the template functions in a context JSON input, so scripts can generate varied contexts without
+// other tooling, e.g. {"kind": "user", "key": "{{uuid}}", "age": {{randInt 18 99}}}. Input without any template
+// actions is returned as is.
+func Render(input string) (string, error) {
+	if !strings.Contains(input, "{{") {
+		return input, nil
+	}
+
+	tmpl, err := template.New("context").Funcs(templateFuncs).Option("missingkey=error").Parse(input)
+	if err != nil {
+		return "", errors.NewErrorWrapped(fmt.Sprintf("context template is invalid: %s", err), err)
+	}
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, nil); err != nil {
+		return "", errors.NewErrorWrapped(fmt.Sprintf("unable to render context template: %s", err), err)
+	}
+
+	return rendered.String(), nil
+}
+
+// RenderFile reads a context JSON file and executes the template functions in it.
+func RenderFile(filename string) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", errors.NewErrorWrapped(fmt.Sprintf("unable to read context file %s", filename), err)
+	}
+
+	return Render(string(data))
+}
@@ -0,0 +1,75 @@
+package contexts_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/contexts"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("leaves input without templates alone", func(t *testing.T) {
+		input := `{"kind": "user", "key": "a {b} c"}`
+
+		rendered, err := contexts.Render(input)
+
+		require.NoError(t, err)
+		assert.Equal(t, input, rendered)
+	})
+
+	t.Run("executes template functions", func(t *testing.T) {
+		rendered, err := contexts.Render(`{"key": "{{uuid}}", "other": "{{uuid}}", "created": "{{now}}", "age": {{randInt 1 3}}}`)
+
+		require.NoError(t, err)
+		var c struct {
+			Key     string `json:"key"`
+			Other   string `json:"other"`
+			Created string `json:"created"`
+			Age     int    `json:"age"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(rendered), &c))
+		assert.Len(t, c.Key, 36)
+		assert.NotEqual(t, c.Key, c.Other)
+		_, err = time.Parse(time.RFC3339, c.Created)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, c.Age, 1)
+		assert.LessOrEqual(t, c.Age, 3)
+	})
+
+	t.Run("reuses a value assigned to a variable", func(t *testing.T) {
+		rendered, err := contexts.Render(`{{$id := uuid}}["{{$id}}", "{{$id}}"]`)
+
+		require.NoError(t, err)
+		var keys []string
+		require.NoError(t, json.Unmarshal([]byte(rendered), &keys))
+		assert.Equal(t, keys[0], keys[1])
+	})
+
+	t.Run("returns an error for an invalid template", func(t *testing.T) {
+		_, err := contexts.Render(`{"key": "{{unknown}}"}`)
+
+		assert.ErrorContains(t, err, "context template is invalid")
+	})
+
+	t.Run("returns an error when randInt's range is empty", func(t *testing.T) {
+		_, err := contexts.Render(`{"age": {{randInt 10 1}}}`)
+
+		assert.ErrorContains(t, err, "randInt max 1 is less than min 10")
+	})
+}
+
+func TestRenderFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "context.json")
+	require.NoError(t, os.WriteFile(filename, []byte(`{"kind": "user", "key": "user-{{randInt 7 7}}"}`), 0o600))
+
+	rendered, err := contexts.RenderFile(filename)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind": "user", "key": "user-7"}`, rendered)
+}
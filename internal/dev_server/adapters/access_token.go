@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"sync"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+)
+
+const ctxKeyAccessToken = ctxKey("adapters.accessToken")
+
+// AccessToken holds the access token the dev server uses for the LaunchDarkly API along with a client that sends it.
+// The token can be rotated while the server runs. Requests that start after a rotation use the new token, and nothing
+// else is restarted, so SDKs stay connected.
+type AccessToken struct {
+	newClient func(token string) ldapi.APIClient
+
+	mu     sync.RWMutex
+	token  string
+	client ldapi.APIClient
+}
+
+// NewAccessToken returns an AccessToken for token. newClient is called to build an API client for it and for every
+// token it's rotated to.
+func NewAccessToken(token string, newClient func(token string) ldapi.APIClient) *AccessToken {
+	return &AccessToken{
+		newClient: newClient,
+		token:     token,
+		client:    newClient(token),
+	}
+}
+
+// Client returns an API client that uses the current token.
+func (t *AccessToken) Client() ldapi.APIClient {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.client
+}
+
+// Matches reports whether token, optionally with a "Bearer " prefix, is the current token.
+func (t *AccessToken) Matches(token string) bool {
+	token = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(token), "Bearer "))
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(t.token)) == 1
+}
+
+// Rotate replaces the current token. It returns false, leaving the token alone, if token is already current.
+func (t *AccessToken) Rotate(token string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if token == t.token {
+		return false
+	}
+	t.token = token
+	t.client = t.newClient(token)
+	return true
+}
+
+func WithAccessToken(ctx context.Context, token *AccessToken) context.Context {
+	return context.WithValue(ctx, ctxKeyAccessToken, token)
+}
+
+func GetAccessToken(ctx context.Context) *AccessToken {
+	token, _ := ctx.Value(ctxKeyAccessToken).(*AccessToken)
+	return token
+}
@@ -0,0 +1,44 @@
+package adapters_test
+
+import (
+	"testing"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+)
+
+func TestAccessToken(t *testing.T) {
+	var built []string
+	accessToken := adapters.NewAccessToken("old-token", func(token string) ldapi.APIClient {
+		built = append(built, token)
+		config := ldapi.NewConfiguration()
+		config.AddDefaultHeader("Authorization", token)
+		return *ldapi.NewAPIClient(config)
+	})
+	authorization := func() string {
+		client := accessToken.Client()
+		return client.GetConfig().DefaultHeader["Authorization"]
+	}
+
+	assert.Equal(t, "old-token", authorization())
+	assert.True(t, accessToken.Matches("old-token"))
+	assert.True(t, accessToken.Matches("Bearer old-token"))
+	assert.False(t, accessToken.Matches("new-token"))
+	assert.False(t, accessToken.Matches(""))
+
+	t.Run("rotating builds a client with the new token", func(t *testing.T) {
+		assert.True(t, accessToken.Rotate("new-token"))
+
+		assert.Equal(t, "new-token", authorization())
+		assert.True(t, accessToken.Matches("new-token"))
+		assert.False(t, accessToken.Matches("old-token"))
+	})
+
+	t.Run("rotating to the current token does nothing", func(t *testing.T) {
+		assert.False(t, accessToken.Rotate("new-token"))
+
+		assert.Equal(t, []string{"old-token", "new-token"}, built)
+	})
+}
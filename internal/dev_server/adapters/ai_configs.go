@@ -0,0 +1,126 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters/internal"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// AIConfig is a LaunchDarkly AI Config. AI SDKs evaluate it like a JSON flag with the same key, whose variations are
+// the config's variations.
+type AIConfig struct {
+	Key         string              `json:"key"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Tags        []string            `json:"tags"`
+	Variations  []AIConfigVariation `json:"variations"`
+}
+
+// AIConfigVariation is one of the models and prompts an AI Config can serve.
+type AIConfigVariation struct {
+	Key     string `json:"key"`
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+	// Model is the model's name and parameters, passed through to the AI SDKs as is.
+	Model    map[string]any    `json:"model,omitempty"`
+	Messages []AIConfigMessage `json:"messages,omitempty"`
+	Provider *AIConfigProvider `json:"provider,omitempty"`
+}
+
+type AIConfigMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type AIConfigProvider struct {
+	Name string `json:"name"`
+}
+
+type aiConfigs struct {
+	Items []AIConfig            `json:"items"`
+	Links map[string]ldapi.Link `json:"_links"`
+}
+
+func (c *aiConfigs) GetItems() []AIConfig {
+	return c.Items
+}
+
+func (c *aiConfigs) GetLinks() map[string]ldapi.Link {
+	return c.Links
+}
+
+func (a apiClientApi) GetAllAIConfigs(ctx context.Context, projectKey string) ([]AIConfig, error) {
+	logs.Printf(logs.Debug, projectKey, "Fetching all AI Configs for project '%s'", projectKey)
+	configs, err := internal.GetPaginatedItems(ctx, projectKey, nil, func(ctx context.Context, projectKey string, limit, offset *int64) (*aiConfigs, error) {
+		return internal.Retry429s(func() (*aiConfigs, *http.Response, error) {
+			return a.getAIConfigs(ctx, projectKey, limit, offset)
+		})
+	})
+	var notFound ErrSourceNotFound
+	if errors.As(err, &notFound) {
+		// accounts without AI Configs don't have the endpoint, and the project itself was found when its flags were
+		logs.Printf(logs.Debug, projectKey, "No AI Configs found for project '%s'", projectKey)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get AI Configs from LD API")
+	}
+	return configs, nil
+}
+
+// getAIConfigs fetches a page of AI Configs. The API client doesn't cover AI Configs yet, so this makes the request
+// itself with the client's settings.
+func (a apiClientApi) getAIConfigs(ctx context.Context, projectKey string, limit, offset *int64) (*aiConfigs, *http.Response, error) {
+	config := a.apiClient.GetConfig()
+	baseURL, err := config.Servers.URL(0, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := url.Values{"limit": {"100"}}
+	if limit != nil {
+		query.Set("limit", fmt.Sprint(*limit))
+	}
+	if offset != nil {
+		query.Set("offset", fmt.Sprint(*offset))
+	}
+	requestURL := fmt.Sprintf("%s/api/v2/projects/%s/ai-configs?%s", strings.TrimSuffix(baseURL, "/"), url.PathEscape(projectKey), query.Encode())
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, value := range config.DefaultHeader {
+		request.Header.Set(name, value)
+	}
+	request.Header.Set("User-Agent", config.UserAgent)
+	request.Header.Set("LD-API-Version", "beta")
+
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(request)
+	if err != nil {
+		return nil, res, err
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusForbidden:
+		return nil, res, errors.WithStack(NewErrSourceNotFound("AI Configs for project", projectKey))
+	case res.StatusCode >= http.StatusMultipleChoices:
+		return nil, res, errors.Errorf("unexpected status %s", res.Status)
+	}
+	var configs aiConfigs
+	if err := json.NewDecoder(res.Body).Decode(&configs); err != nil {
+		return nil, res, errors.Wrap(err, "unable to decode AI Configs")
+	}
+	return &configs, res, nil
+}
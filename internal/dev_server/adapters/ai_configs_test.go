@@ -0,0 +1,70 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+)
+
+func TestGetAllAIConfigs(t *testing.T) {
+	newApi := func(handler http.HandlerFunc) adapters.Api {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		config := ldapi.NewConfiguration()
+		config.AddDefaultHeader("Authorization", "api-key")
+		config.Servers[0].URL = server.URL
+		return adapters.NewApi(*ldapi.NewAPIClient(config))
+	}
+
+	t.Run("fetches every page of AI Configs", func(t *testing.T) {
+		api := newApi(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/projects/proj/ai-configs", r.URL.Path)
+			assert.Equal(t, "api-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "beta", r.Header.Get("LD-API-Version"))
+			if r.URL.Query().Get("offset") == "" {
+				_, _ = fmt.Fprint(w, `{"items":[{"key":"first","variations":[{"key":"v1","version":1,"model":{"name":"small"},"messages":[{"role":"system","content":"Be brief"}]}]}],"_links":{"next":{"href":"/api/v2/projects/proj/ai-configs?limit=1&offset=1"}}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"items":[{"key":"second"}]}`)
+		})
+
+		configs, err := api.GetAllAIConfigs(context.Background(), "proj")
+		require.NoError(t, err)
+		require.Len(t, configs, 2)
+		assert.Equal(t, "first", configs[0].Key)
+		assert.Equal(t, []adapters.AIConfigVariation{{
+			Key:      "v1",
+			Version:  1,
+			Model:    map[string]any{"name": "small"},
+			Messages: []adapters.AIConfigMessage{{Role: "system", Content: "Be brief"}},
+		}}, configs[0].Variations)
+		assert.Equal(t, "second", configs[1].Key)
+	})
+
+	t.Run("has none when the account doesn't have AI Configs", func(t *testing.T) {
+		api := newApi(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		configs, err := api.GetAllAIConfigs(context.Background(), "proj")
+		require.NoError(t, err)
+		assert.Empty(t, configs)
+	})
+
+	t.Run("returns other errors", func(t *testing.T) {
+		api := newApi(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		_, err := api.GetAllAIConfigs(context.Background(), "proj")
+		assert.Error(t, err)
+	})
+}
@@ -3,9 +3,10 @@ package adapters
 import (
 	"context"
 	"fmt"
-	"log"
+	"net/http"
 
 	"github.com/launchdarkly/ldcli/internal/dev_server/adapters/internal"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/pkg/errors"
 
 	ldapi "github.com/launchdarkly/api-client-go/v14"
@@ -24,8 +25,45 @@ func GetApi(ctx context.Context) Api {
 //go:generate go run go.uber.org/mock/mockgen -destination mocks/api.go -package mocks . Api
 type Api interface {
 	GetSdkKey(ctx context.Context, projectKey, environmentKey string) (string, error)
-	GetAllFlags(ctx context.Context, projectKey string) ([]ldapi.FeatureFlag, error)
+	// GetAllFlags fetches every flag in the project, including archived ones, which have Archived set. With filters,
+	// which are LaunchDarkly flag list filters like tags:beta or query:checkout, only the flags matching at least one
+	// of them are fetched.
+	GetAllFlags(ctx context.Context, projectKey string, filters []string) ([]ldapi.FeatureFlag, error)
+	// GetAllAIConfigs fetches every AI Config in the project. Projects without any, including ones in accounts that
+	// don't have AI Configs, have none.
+	GetAllAIConfigs(ctx context.Context, projectKey string) ([]AIConfig, error)
+	// GetContextKinds fetches the context kinds configured for the project, including archived ones. Access tokens
+	// that can't read them get none.
+	GetContextKinds(ctx context.Context, projectKey string) ([]ldapi.ContextKindRep, error)
 	GetProjectEnvironments(ctx context.Context, projectKey string, query string, limit *int) ([]ldapi.Environment, error)
+	// GetAllEnvironments fetches every environment in the project, following pagination.
+	GetAllEnvironments(ctx context.Context, projectKey string) ([]ldapi.Environment, error)
+	// GetAllProjects fetches every project the access token can see, following pagination, with the first page of each
+	// project's environments.
+	GetAllProjects(ctx context.Context) ([]ldapi.Project, error)
+}
+
+// ErrSourceNotFound is returned when LaunchDarkly has nothing with the requested key, which usually means the project
+// or environment a dev server project was created from has since been deleted or renamed.
+type ErrSourceNotFound struct {
+	kind string
+	key  string
+}
+
+func NewErrSourceNotFound(kind, key string) ErrSourceNotFound {
+	return ErrSourceNotFound{kind: kind, key: key}
+}
+
+func (e ErrSourceNotFound) Error() string {
+	return fmt.Sprintf("%s %s not found in LaunchDarkly", e.kind, e.key)
+}
+
+// sourceNotFound replaces err with ErrSourceNotFound if res is a 404.
+func sourceNotFound(res *http.Response, err error, kind, key string) error {
+	if err != nil && res != nil && res.StatusCode == http.StatusNotFound {
+		return errors.WithStack(NewErrSourceNotFound(kind, key))
+	}
+	return err
 }
 
 type apiClientApi struct {
@@ -37,25 +75,61 @@ func NewApi(client ldapi.APIClient) Api {
 }
 
 func (a apiClientApi) GetSdkKey(ctx context.Context, projectKey, environmentKey string) (string, error) {
-	log.Printf("GetSdkKey - projectKey: %s, environmentKey: %s", projectKey, environmentKey)
-	environment, _, err := a.apiClient.EnvironmentsApi.GetEnvironment(ctx, projectKey, environmentKey).Execute()
+	logs.Printf(logs.Debug, projectKey, "GetSdkKey - projectKey: %s, environmentKey: %s", projectKey, environmentKey)
+	environment, res, err := a.apiClient.EnvironmentsApi.GetEnvironment(ctx, projectKey, environmentKey).Execute()
+	err = sourceNotFound(res, err, "project or environment", projectKey+"/"+environmentKey)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to get SDK key from LD API")
 	}
 	return environment.ApiKey, nil
 }
 
-func (a apiClientApi) GetAllFlags(ctx context.Context, projectKey string) ([]ldapi.FeatureFlag, error) {
-	log.Printf("Fetching all flags for project '%s'", projectKey)
-	flags, err := a.getFlags(ctx, projectKey, nil)
+func (a apiClientApi) GetAllFlags(ctx context.Context, projectKey string, filters []string) ([]ldapi.FeatureFlag, error) {
+	logs.Printf(logs.Debug, projectKey, "Fetching all flags for project '%s'", projectKey)
+	if len(filters) == 0 {
+		filters = []string{""}
+	}
+	// the API ANDs the fields of a filter together, so each is fetched separately and flags matching several are kept once
+	var allFlags []ldapi.FeatureFlag
+	fetched := make(map[string]struct{})
+	for _, filter := range filters {
+		if filter != "" {
+			filter = "," + filter
+		}
+		flags, err := a.getFlags(ctx, projectKey, nil, "purpose:all+!(holdout)"+filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get all flags from LD API")
+		}
+		// archived flags are only listed when asked for on their own
+		archivedFlags, err := a.getFlags(ctx, projectKey, nil, "purpose:all+!(holdout),archived:true"+filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get archived flags from LD API")
+		}
+		for _, flag := range append(flags, archivedFlags...) {
+			if _, ok := fetched[flag.Key]; !ok {
+				fetched[flag.Key] = struct{}{}
+				allFlags = append(allFlags, flag)
+			}
+		}
+	}
+	return allFlags, nil
+}
+
+func (a apiClientApi) GetContextKinds(ctx context.Context, projectKey string) ([]ldapi.ContextKindRep, error) {
+	logs.Printf(logs.Debug, projectKey, "Fetching context kinds for project '%s'", projectKey)
+	kinds, res, err := a.apiClient.ContextsApi.GetContextKindsByProjectKey(ctx, projectKey).Execute()
+	if err != nil && res != nil && res.StatusCode == http.StatusForbidden {
+		return nil, nil
+	}
+	err = sourceNotFound(res, err, "project", projectKey)
 	if err != nil {
-		err = errors.Wrap(err, "unable to get all flags from LD API")
+		return nil, errors.Wrap(err, "unable to get context kinds from LD API")
 	}
-	return flags, err
+	return kinds.Items, nil
 }
 
 func (a apiClientApi) GetProjectEnvironments(ctx context.Context, projectKey string, query string, limit *int) ([]ldapi.Environment, error) {
-	log.Printf("Fetching all environments for project '%s'", projectKey)
+	logs.Printf(logs.Debug, projectKey, "Fetching all environments for project '%s'", projectKey)
 	environments, err := a.getEnvironments(ctx, projectKey, nil, query, limit)
 	if err != nil {
 		err = errors.Wrap(err, "unable to get environments from LD API")
@@ -63,11 +137,52 @@ func (a apiClientApi) GetProjectEnvironments(ctx context.Context, projectKey str
 	return environments, err
 }
 
-func (a apiClientApi) getFlags(ctx context.Context, projectKey string, href *string) ([]ldapi.FeatureFlag, error) {
+func (a apiClientApi) GetAllEnvironments(ctx context.Context, projectKey string) ([]ldapi.Environment, error) {
+	logs.Printf(logs.Debug, projectKey, "Fetching every environment for project '%s'", projectKey)
+	environments, err := internal.GetPaginatedItems(ctx, projectKey, nil, func(ctx context.Context, projectKey string, limit, offset *int64) (*ldapi.Environments, error) {
+		query := a.apiClient.EnvironmentsApi.GetEnvironmentsByProject(ctx, projectKey).Limit(100)
+		if limit != nil {
+			query = query.Limit(*limit)
+		}
+		if offset != nil {
+			query = query.Offset(*offset)
+		}
+		return internal.Retry429s(func() (*ldapi.Environments, *http.Response, error) {
+			environments, res, err := query.Execute()
+			return environments, res, sourceNotFound(res, err, "project", projectKey)
+		})
+	})
+	if err != nil {
+		err = errors.Wrap(err, "unable to get environments from LD API")
+	}
+	return environments, err
+}
+
+func (a apiClientApi) GetAllProjects(ctx context.Context) ([]ldapi.Project, error) {
+	logs.Printf(logs.Debug, "", "Fetching every project")
+	projects, err := internal.GetPaginatedItems(ctx, "", nil, func(ctx context.Context, _ string, limit, offset *int64) (*ldapi.Projects, error) {
+		query := a.apiClient.ProjectsApi.GetProjects(ctx).Limit(100).Expand("environments")
+		if limit != nil {
+			query = query.Limit(*limit)
+		}
+		if offset != nil {
+			query = query.Offset(*offset)
+		}
+		return internal.Retry429s(func() (*ldapi.Projects, *http.Response, error) {
+			return query.Execute()
+		})
+	})
+	if err != nil {
+		err = errors.Wrap(err, "unable to get projects from LD API")
+	}
+	return projects, err
+}
+
+func (a apiClientApi) getFlags(ctx context.Context, projectKey string, href *string, filter string) ([]ldapi.FeatureFlag, error) {
 	return internal.GetPaginatedItems(ctx, projectKey, href, func(ctx context.Context, projectKey string, limit, offset *int64) (flags *ldapi.FeatureFlags, err error) {
 		// loop until we do not get rate limited
 		query := a.apiClient.FeatureFlagsApi.GetFeatureFlags(ctx, projectKey).Limit(100)
-		query = query.Filter("purpose:all+!(holdout)")
+		query = query.Filter(filter)
 
 		if limit != nil {
 			query = query.Limit(*limit)
@@ -76,7 +191,10 @@ func (a apiClientApi) getFlags(ctx context.Context, projectKey string, href *str
 		if offset != nil {
 			query = query.Offset(*offset)
 		}
-		return internal.Retry429s(query.Execute)
+		return internal.Retry429s(func() (*ldapi.FeatureFlags, *http.Response, error) {
+			flags, res, err := query.Execute()
+			return flags, res, sourceNotFound(res, err, "project", projectKey)
+		})
 	})
 }
 
@@ -91,10 +209,10 @@ func (a apiClientApi) getEnvironments(ctx context.Context, projectKey string, hr
 		request = request.Sort("name").Filter(fmt.Sprintf("query:%s", query))
 	}
 
-	envs, _, err := request.
+	envs, res, err := request.
 		Execute()
 	if err != nil {
-		return nil, err
+		return nil, sourceNotFound(res, err, "project", projectKey)
 	}
 
 	if envs == nil {
@@ -0,0 +1,64 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+)
+
+func TestGetAllFlags(t *testing.T) {
+	var mu sync.Mutex
+	var filters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		filter := r.URL.Query().Get("filter")
+		mu.Lock()
+		filters = append(filters, filter)
+		mu.Unlock()
+		switch filter {
+		case "purpose:all+!(holdout),tags:search", "purpose:all+!(holdout),query:checkout-":
+			_, _ = fmt.Fprint(w, `{"items":[{"key":"checkout-search"}]}`)
+		case "purpose:all+!(holdout),archived:true,query:checkout-":
+			_, _ = fmt.Fprint(w, `{"items":[{"key":"checkout-old","archived":true}]}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"items":[]}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	config := ldapi.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	api := adapters.NewApi(*ldapi.NewAPIClient(config))
+
+	t.Run("fetches only the flags matching the filters", func(t *testing.T) {
+		filters = nil
+		flags, err := api.GetAllFlags(context.Background(), "proj", []string{"tags:search", "query:checkout-"})
+		require.NoError(t, err)
+
+		require.Len(t, flags, 2)
+		assert.Equal(t, "checkout-search", flags[0].Key)
+		assert.Equal(t, "checkout-old", flags[1].Key)
+		assert.Equal(t, []string{
+			"purpose:all+!(holdout),tags:search",
+			"purpose:all+!(holdout),archived:true,tags:search",
+			"purpose:all+!(holdout),query:checkout-",
+			"purpose:all+!(holdout),archived:true,query:checkout-",
+		}, filters)
+	})
+
+	t.Run("fetches every flag without filters", func(t *testing.T) {
+		filters = nil
+		_, err := api.GetAllFlags(context.Background(), "proj", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"purpose:all+!(holdout)", "purpose:all+!(holdout),archived:true"}, filters)
+	})
+}
@@ -0,0 +1,162 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/launchdarkly/eventsource"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// autoConfigPath is where the Relay Proxy auto-configuration stream is served on the streaming host.
+const autoConfigPath = "/relay_auto_config"
+
+const autoConfigEnvironmentsPrefix = "/environments/"
+
+// AutoConfigEnvironment is an environment a Relay Proxy auto-configuration key has access to.
+type AutoConfigEnvironment struct {
+	EnvID    string `json:"envId"`
+	EnvKey   string `json:"envKey"`
+	EnvName  string `json:"envName"`
+	ProjKey  string `json:"projKey"`
+	ProjName string `json:"projName"`
+	Version  int    `json:"version"`
+}
+
+// AutoConfigEnvironments are keyed by environment ID.
+type AutoConfigEnvironments map[string]AutoConfigEnvironment
+
+type autoConfigPut struct {
+	Data struct {
+		Environments AutoConfigEnvironments `json:"environments"`
+	} `json:"data"`
+}
+
+type autoConfigPatch struct {
+	Path string                `json:"path"`
+	Data AutoConfigEnvironment `json:"data"`
+}
+
+type autoConfigDelete struct {
+	Path    string `json:"path"`
+	Version int    `json:"version"`
+}
+
+// StreamAutoConfig connects to the auto-configuration stream at streamURI with a Relay Proxy auto-configuration key
+// and calls onChange with every environment the key has access to, first when connected and then whenever they
+// change. It reconnects after errors until ctx is done, or until the key is rejected.
+func StreamAutoConfig(ctx context.Context, streamURI, key string, onChange func(AutoConfigEnvironments)) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(streamURI, "/")+autoConfigPath, nil)
+	if err != nil {
+		return errors.Wrap(err, "unable to build auto-config request")
+	}
+	request.Header.Set("Authorization", key)
+
+	var rejected error
+	stream, err := eventsource.SubscribeWithRequestAndOptions(request,
+		eventsource.StreamOptionInitialRetry(time.Second),
+		eventsource.StreamOptionUseBackoff(time.Minute),
+		eventsource.StreamOptionUseJitter(0.5),
+		eventsource.StreamOptionCanRetryFirstConnection(-1),
+		eventsource.StreamOptionErrorHandler(func(err error) eventsource.StreamErrorHandlerResult {
+			if ctx.Err() != nil {
+				return eventsource.StreamErrorHandlerResult{CloseNow: true}
+			}
+			var subscriptionErr eventsource.SubscriptionError
+			if errors.As(err, &subscriptionErr) && (subscriptionErr.Code == http.StatusUnauthorized || subscriptionErr.Code == http.StatusForbidden) {
+				rejected = errors.New("auto-config key was rejected by LaunchDarkly")
+				return eventsource.StreamErrorHandlerResult{CloseNow: true}
+			}
+			logs.Printf(logs.Warn, "", "Auto-config stream error, reconnecting: %s", err)
+			return eventsource.StreamErrorHandlerResult{}
+		}),
+	)
+	if err != nil {
+		if rejected != nil || ctx.Err() != nil {
+			return rejected
+		}
+		return errors.Wrap(err, "unable to connect to auto-config stream")
+	}
+	defer stream.Close()
+
+	environments := make(AutoConfigEnvironments)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-stream.Events:
+			if !ok {
+				return rejected
+			}
+			changed, err := environments.apply(event.Event(), []byte(event.Data()))
+			if err != nil {
+				logs.Printf(logs.Warn, "", "Ignoring auto-config %s event: %s", event.Event(), err)
+				continue
+			}
+			if changed {
+				onChange(environments.clone())
+			}
+		}
+	}
+}
+
+// apply updates e with a put, patch, or delete event from the auto-config stream, returning whether anything changed.
+func (e AutoConfigEnvironments) apply(eventName string, data []byte) (bool, error) {
+	switch eventName {
+	case "put":
+		var put autoConfigPut
+		if err := json.Unmarshal(data, &put); err != nil {
+			return false, errors.Wrap(err, "invalid put")
+		}
+		for envID := range e {
+			delete(e, envID)
+		}
+		for envID, env := range put.Data.Environments {
+			e[envID] = env
+		}
+		return true, nil
+	case "patch":
+		var patch autoConfigPatch
+		if err := json.Unmarshal(data, &patch); err != nil {
+			return false, errors.Wrap(err, "invalid patch")
+		}
+		envID, ok := strings.CutPrefix(patch.Path, autoConfigEnvironmentsPrefix)
+		if !ok {
+			return false, nil
+		}
+		if existing, ok := e[envID]; ok && existing.Version >= patch.Data.Version {
+			return false, nil
+		}
+		e[envID] = patch.Data
+		return true, nil
+	case "delete":
+		var del autoConfigDelete
+		if err := json.Unmarshal(data, &del); err != nil {
+			return false, errors.Wrap(err, "invalid delete")
+		}
+		envID, ok := strings.CutPrefix(del.Path, autoConfigEnvironmentsPrefix)
+		if !ok {
+			return false, nil
+		}
+		if existing, ok := e[envID]; !ok || existing.Version > del.Version {
+			return false, nil
+		}
+		delete(e, envID)
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (e AutoConfigEnvironments) clone() AutoConfigEnvironments {
+	clone := make(AutoConfigEnvironments, len(e))
+	for envID, env := range e {
+		clone[envID] = env
+	}
+	return clone
+}
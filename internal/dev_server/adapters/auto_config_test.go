@@ -0,0 +1,91 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+)
+
+func TestStreamAutoConfig(t *testing.T) {
+	t.Run("tracks environments through puts, patches, and deletes", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/relay_auto_config", r.URL.Path)
+			assert.Equal(t, "rel-key", r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "text/event-stream")
+			events := []string{
+				`event: put
+data: {"path": "/", "data": {"environments": {"env-1": {"envId": "env-1", "envKey": "test", "projKey": "proj", "version": 1}}}}`,
+				// stale patches are ignored
+				`event: patch
+data: {"path": "/environments/env-1", "data": {"envId": "env-1", "envKey": "stale", "projKey": "proj", "version": 1}}`,
+				`event: patch
+data: {"path": "/environments/env-2", "data": {"envId": "env-2", "envKey": "production", "projKey": "proj", "version": 1}}`,
+				`event: delete
+data: {"path": "/environments/env-1", "version": 2}`,
+			}
+			for _, event := range events {
+				_, _ = fmt.Fprintf(w, "%s\n\n", event)
+			}
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		changes := make(chan adapters.AutoConfigEnvironments, 10)
+		done := make(chan error)
+		go func() {
+			done <- adapters.StreamAutoConfig(ctx, server.URL, "rel-key", func(environments adapters.AutoConfigEnvironments) {
+				changes <- environments
+			})
+		}()
+
+		next := func() adapters.AutoConfigEnvironments {
+			select {
+			case environments := <-changes:
+				return environments
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "timed out waiting for auto-config change")
+				return nil
+			}
+		}
+		assert.Equal(t, []string{"env-1"}, envIDs(next()))
+		assert.Equal(t, []string{"env-1", "env-2"}, envIDs(next()))
+		assert.Equal(t, []string{"env-2"}, envIDs(next()))
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("stops if the key is rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		err := adapters.StreamAutoConfig(context.Background(), server.URL, "bad-key", func(adapters.AutoConfigEnvironments) {
+			assert.Fail(t, "unexpected change")
+		})
+
+		assert.EqualError(t, err, "auto-config key was rejected by LaunchDarkly")
+	})
+}
+
+func envIDs(environments adapters.AutoConfigEnvironments) []string {
+	var ids []string
+	for envID := range environments {
+		ids = append(ids, envID)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,57 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldtime"
+	"github.com/launchdarkly/go-server-sdk/v7/subsystems"
+	"github.com/launchdarkly/go-server-sdk/v7/subsystems/ldstoreimpl"
+)
+
+const ctxKeyBigSegmentMembership = ctxKey("adapters.bigSegmentMembership")
+
+// BigSegmentMembership returns the big segments that the context whose key hashes to contextHash is included in
+// (true) or excluded from (false), keyed by segment reference.
+type BigSegmentMembership func(contextHash string) map[string]bool
+
+// WithBigSegmentMembership has the SDK look up big segment membership with membership when it evaluates flags, so
+// that rules matching big segments match. Without it, the SDK treats big segments as not configured.
+func WithBigSegmentMembership(ctx context.Context, membership BigSegmentMembership) context.Context {
+	return context.WithValue(ctx, ctxKeyBigSegmentMembership, membership)
+}
+
+func getBigSegmentMembership(ctx context.Context) (BigSegmentMembership, bool) {
+	membership, ok := ctx.Value(ctxKeyBigSegmentMembership).(BigSegmentMembership)
+	return membership, ok && membership != nil
+}
+
+// bigSegmentStore is the SDK's big segment store, backed by a BigSegmentMembership. It's always up to date.
+type bigSegmentStore struct {
+	membership BigSegmentMembership
+}
+
+var _ subsystems.ComponentConfigurer[subsystems.BigSegmentStore] = bigSegmentStore{}
+
+func (s bigSegmentStore) Build(subsystems.ClientContext) (subsystems.BigSegmentStore, error) {
+	return s, nil
+}
+
+func (s bigSegmentStore) GetMetadata() (subsystems.BigSegmentStoreMetadata, error) {
+	return subsystems.BigSegmentStoreMetadata{LastUpToDate: ldtime.UnixMillisNow()}, nil
+}
+
+func (s bigSegmentStore) GetMembership(contextHash string) (subsystems.BigSegmentMembership, error) {
+	var included, excluded []string
+	for segmentRef, isIncluded := range s.membership(contextHash) {
+		if isIncluded {
+			included = append(included, segmentRef)
+		} else {
+			excluded = append(excluded, segmentRef)
+		}
+	}
+	return ldstoreimpl.NewBigSegmentMembershipFromSegmentRefs(included, excluded), nil
+}
+
+func (s bigSegmentStore) Close() error {
+	return nil
+}
@@ -2,11 +2,15 @@ package adapters
 
 import (
 	"context"
+
 	ldapi "github.com/launchdarkly/api-client-go/v14"
 )
 
-func WithApiAndSdk(ctx context.Context, client ldapi.APIClient, streamingUrl string) context.Context {
-	ctx = WithSdk(ctx, newSdk(streamingUrl))
-	ctx = WithApi(ctx, NewApi(client))
+// WithApiAndSdk puts adapters on the context that talk to the LaunchDarkly instance at client's base URI and the SDK
+// endpoints, so the dev server works against EU, federal, and private instances as well as the default one. Calls to
+// them are traced.
+func WithApiAndSdk(ctx context.Context, client ldapi.APIClient, sdkConfig SdkConfig) context.Context {
+	ctx = WithSdk(ctx, tracingSdk{newSdk(sdkConfig)})
+	ctx = WithApi(ctx, tracingApi{NewApi(client)})
 	return ctx
 }
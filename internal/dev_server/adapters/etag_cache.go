@@ -0,0 +1,100 @@
+package adapters
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ETagCache is an http.RoundTripper that caches GET responses from the LaunchDarkly API by URL, which includes the
+// project key, and access token, and revalidates them with If-None-Match. Flag and environment listings rarely change
+// between syncs, so this saves re-downloading them and counts less against rate limits.
+type ETagCache struct {
+	next     http.RoundTripper
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]etagCacheEntry
+	// keys are the entries' keys, oldest first, so the oldest can be evicted when there are too many.
+	keys []string
+}
+
+type etagCacheEntry struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// NewETagCache caches up to capacity responses, evicting the oldest beyond that.
+func NewETagCache(next http.RoundTripper, capacity int) *ETagCache {
+	return &ETagCache{
+		next:     next,
+		capacity: capacity,
+		entries:  make(map[string]etagCacheEntry),
+	}
+}
+
+// etagCacheKey keys responses by the token they were fetched with as well as their URL, since what a token can see
+// differs. The token is hashed so that it isn't kept in memory.
+func etagCacheKey(req *http.Request) string {
+	token := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(token[:]) + " " + req.URL.String()
+}
+
+func (c *ETagCache) store(key string, entry etagCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		if len(c.keys) >= c.capacity {
+			delete(c.entries, c.keys[0])
+			c.keys = c.keys[1:]
+		}
+		c.keys = append(c.keys, key)
+	}
+	c.entries[key] = entry
+}
+
+func (c *ETagCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return c.next.RoundTrip(req)
+	}
+	key := etagCacheKey(req)
+
+	c.mu.Lock()
+	entry, cached := c.entries[key]
+	c.mu.Unlock()
+	if cached {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case cached && resp.StatusCode == http.StatusNotModified:
+		_ = resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Header = entry.header.Clone()
+		resp.Body = io.NopCloser(bytes.NewReader(entry.body))
+		resp.ContentLength = int64(len(entry.body))
+		return resp, nil
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, etagCacheEntry{etag: resp.Header.Get("ETag"), header: resp.Header.Clone(), body: body})
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
+	default:
+		return resp, nil
+	}
+}
@@ -0,0 +1,101 @@
+package adapters_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+)
+
+func TestETagCache(t *testing.T) {
+	var requests, downloads int
+	body := `{"items": []}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		etag := `"v1"`
+		if r.URL.Query().Get("changed") == "true" && requests > 1 {
+			etag = `"v2"`
+		}
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads++
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: adapters.NewETagCache(http.DefaultTransport, 2)}
+
+	getWithToken := func(t *testing.T, url, token string) string {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(data)
+	}
+	get := func(t *testing.T, url string) string {
+		return getWithToken(t, url, "")
+	}
+
+	t.Run("serves unchanged responses from the cache", func(t *testing.T) {
+		requests, downloads = 0, 0
+
+		assert.Equal(t, body, get(t, server.URL+"/api/v2/flags/proj"))
+		assert.Equal(t, body, get(t, server.URL+"/api/v2/flags/proj"))
+
+		assert.Equal(t, 2, requests)
+		assert.Equal(t, 1, downloads)
+	})
+
+	t.Run("downloads changed responses", func(t *testing.T) {
+		requests, downloads = 0, 0
+
+		get(t, server.URL+"/api/v2/flags/proj?changed=true")
+		get(t, server.URL+"/api/v2/flags/proj?changed=true")
+
+		assert.Equal(t, 2, downloads)
+	})
+
+	t.Run("caches each project separately", func(t *testing.T) {
+		requests, downloads = 0, 0
+
+		get(t, server.URL+"/api/v2/flags/other-proj")
+
+		assert.Equal(t, 1, downloads)
+	})
+
+	t.Run("caches each access token separately", func(t *testing.T) {
+		requests, downloads = 0, 0
+
+		getWithToken(t, server.URL+"/api/v2/flags/proj", "token-1")
+		getWithToken(t, server.URL+"/api/v2/flags/proj", "token-2")
+		getWithToken(t, server.URL+"/api/v2/flags/proj", "token-1")
+
+		assert.Equal(t, 2, downloads)
+	})
+
+	t.Run("evicts the oldest responses beyond its capacity", func(t *testing.T) {
+		requests, downloads = 0, 0
+
+		get(t, server.URL+"/api/v2/flags/a")
+		get(t, server.URL+"/api/v2/flags/b")
+		get(t, server.URL+"/api/v2/flags/c")
+		get(t, server.URL+"/api/v2/flags/c")
+		get(t, server.URL+"/api/v2/flags/a")
+
+		assert.Equal(t, 4, downloads)
+	})
+}
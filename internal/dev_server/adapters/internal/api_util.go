@@ -2,7 +2,6 @@ package internal
 
 import (
 	"context"
-	"log"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -10,6 +9,8 @@ import (
 
 	"github.com/launchdarkly/api-client-go/v14"
 	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 func GetPaginatedItems[T any, R interface {
@@ -91,7 +92,7 @@ func Retry429s[T any](requester func() (T, *http.Response, error)) (result T, er
 	for {
 		var res *http.Response
 		result, res, err = requester()
-		if res.StatusCode == 429 {
+		if res != nil && res.StatusCode == 429 {
 			resetUnixMillisString := res.Header.Get("X-Ratelimit-Reset")
 			resetUnixMillis, strconvErr := strconv.ParseInt(resetUnixMillisString, 10, 64)
 			if strconvErr != nil {
@@ -99,7 +100,7 @@ func Retry429s[T any](requester func() (T, *http.Response, error)) (result T, er
 				return
 			}
 			sleep := resetUnixMillis - timeImpl.Now().UnixMilli()
-			log.Printf("Got 429 in API response. Retrying in %d milliseconds.", sleep)
+			logs.Printf(logs.Warn, "", "Got 429 in API response. Retrying in %d milliseconds.", sleep)
 			timeImpl.Sleep(time.Duration(sleep) * time.Millisecond)
 		} else {
 			return
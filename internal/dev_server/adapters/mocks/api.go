@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	ldapi "github.com/launchdarkly/api-client-go/v14"
+	adapters "github.com/launchdarkly/ldcli/internal/dev_server/adapters"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,19 +42,79 @@ func (m *MockApi) EXPECT() *MockApiMockRecorder {
 	return m.recorder
 }
 
+// GetAllAIConfigs mocks base method.
+func (m *MockApi) GetAllAIConfigs(ctx context.Context, projectKey string) ([]adapters.AIConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAIConfigs", ctx, projectKey)
+	ret0, _ := ret[0].([]adapters.AIConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAIConfigs indicates an expected call of GetAllAIConfigs.
+func (mr *MockApiMockRecorder) GetAllAIConfigs(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAIConfigs", reflect.TypeOf((*MockApi)(nil).GetAllAIConfigs), ctx, projectKey)
+}
+
+// GetAllEnvironments mocks base method.
+func (m *MockApi) GetAllEnvironments(ctx context.Context, projectKey string) ([]ldapi.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEnvironments", ctx, projectKey)
+	ret0, _ := ret[0].([]ldapi.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllEnvironments indicates an expected call of GetAllEnvironments.
+func (mr *MockApiMockRecorder) GetAllEnvironments(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEnvironments", reflect.TypeOf((*MockApi)(nil).GetAllEnvironments), ctx, projectKey)
+}
+
 // GetAllFlags mocks base method.
-func (m *MockApi) GetAllFlags(ctx context.Context, projectKey string) ([]ldapi.FeatureFlag, error) {
+func (m *MockApi) GetAllFlags(ctx context.Context, projectKey string, filters []string) ([]ldapi.FeatureFlag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllFlags", ctx, projectKey)
+	ret := m.ctrl.Call(m, "GetAllFlags", ctx, projectKey, filters)
 	ret0, _ := ret[0].([]ldapi.FeatureFlag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllFlags indicates an expected call of GetAllFlags.
-func (mr *MockApiMockRecorder) GetAllFlags(ctx, projectKey any) *gomock.Call {
+func (mr *MockApiMockRecorder) GetAllFlags(ctx, projectKey, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFlags", reflect.TypeOf((*MockApi)(nil).GetAllFlags), ctx, projectKey, filters)
+}
+
+// GetAllProjects mocks base method.
+func (m *MockApi) GetAllProjects(ctx context.Context) ([]ldapi.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProjects", ctx)
+	ret0, _ := ret[0].([]ldapi.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProjects indicates an expected call of GetAllProjects.
+func (mr *MockApiMockRecorder) GetAllProjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjects", reflect.TypeOf((*MockApi)(nil).GetAllProjects), ctx)
+}
+
+// GetContextKinds mocks base method.
+func (m *MockApi) GetContextKinds(ctx context.Context, projectKey string) ([]ldapi.ContextKindRep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContextKinds", ctx, projectKey)
+	ret0, _ := ret[0].([]ldapi.ContextKindRep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContextKinds indicates an expected call of GetContextKinds.
+func (mr *MockApiMockRecorder) GetContextKinds(ctx, projectKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFlags", reflect.TypeOf((*MockApi)(nil).GetAllFlags), ctx, projectKey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContextKinds", reflect.TypeOf((*MockApi)(nil).GetContextKinds), ctx, projectKey)
 }
 
 // GetProjectEnvironments mocks base method.
@@ -2,15 +2,20 @@ package adapters
 
 import (
 	"context"
-	"log"
+	"net"
+	"net/http"
 	"time"
 
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldlog"
 	ldsdk "github.com/launchdarkly/go-server-sdk/v7"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces"
 	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
 	"github.com/launchdarkly/go-server-sdk/v7/ldcomponents"
 	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+	"github.com/launchdarkly/ldcli/internal/proxy"
 )
 
 const ctxKeySdk = ctxKey("adapters.sdk")
@@ -28,24 +33,45 @@ type Sdk interface {
 	GetAllFlagsState(ctx context.Context, ldContext ldcontext.Context, sdkKey string) (flagstate.AllFlags, error)
 }
 
+// SdkConfig is how the SDK adapter connects to LaunchDarkly.
+type SdkConfig struct {
+	Endpoints interfaces.ServiceEndpoints
+	// ProxyURL is used for SDK connections if set, other than to hosts in NO_PROXY. Otherwise the SDK uses the proxy
+	// environment variables.
+	ProxyURL string
+}
+
 type streamingSdk struct {
-	streamingUrl string
+	config SdkConfig
 }
 
-func newSdk(streamingUrl string) Sdk {
+func newSdk(config SdkConfig) Sdk {
 	return streamingSdk{
-		streamingUrl: streamingUrl,
+		config: config,
 	}
 }
 
+// proxiedHTTPClient connects through ProxyURL the same way the rest of ldcli does, which respects NO_PROXY.
+func (s streamingSdk) proxiedHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxy.Func(func() string { return s.config.ProxyURL })
+	transport.DialContext = (&net.Dialer{Timeout: ldcomponents.DefaultConnectTimeout, KeepAlive: 30 * time.Second}).DialContext
+	return &http.Client{Transport: transport}
+}
+
 func (s streamingSdk) GetAllFlagsState(ctx context.Context, ldContext ldcontext.Context, sdkKey string) (flagstate.AllFlags, error) {
 	config := ldsdk.Config{
 		DiagnosticOptOut: true,
 		Events:           ldcomponents.NoEvents(),
 		Logging:          ldcomponents.Logging().MinLevel(ldlog.Debug),
+		ServiceEndpoints: s.config.Endpoints,
+	}
+	if s.config.ProxyURL != "" {
+		// the SDK's own ProxyURL sends every request through the proxy, even to hosts in NO_PROXY
+		config.HTTP = ldcomponents.HTTPConfiguration().HTTPClientFactory(s.proxiedHTTPClient)
 	}
-	if s.streamingUrl != "" {
-		config.ServiceEndpoints.Streaming = s.streamingUrl
+	if membership, ok := getBigSegmentMembership(ctx); ok {
+		config.BigSegments = ldcomponents.BigSegments(bigSegmentStore{membership: membership})
 	}
 	ldClient, err := ldsdk.MakeCustomClient(sdkKey, config, 5*time.Second)
 	if err != nil {
@@ -54,9 +80,9 @@ func (s streamingSdk) GetAllFlagsState(ctx context.Context, ldContext ldcontext.
 	defer func() {
 		err := ldClient.Close()
 		if err != nil {
-			log.Printf("error while closing SDK client: %+v", err)
+			logs.Printf(logs.Error, "", "error while closing SDK client: %+v", err)
 		}
 	}()
-	flags := ldClient.AllFlagsState(ldContext)
+	flags := ldClient.AllFlagsState(ldContext, flagstate.OptionWithReasons())
 	return flags, nil
 }
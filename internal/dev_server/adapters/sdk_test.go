@@ -0,0 +1,82 @@
+package adapters_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces"
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// bigSegmentFlagData has a flag that serves true to contexts in the beta-users big segment, and false otherwise.
+const bigSegmentFlagData = `{"path": "/", "data": {
+	"flags": {"beta": {
+		"key": "beta", "version": 1, "on": true, "salt": "beta",
+		"variations": [false, true], "offVariation": 0, "fallthrough": {"variation": 0},
+		"rules": [{"id": "in-beta", "variation": 1, "clauses": [{"attribute": "key", "op": "segmentMatch", "values": ["beta-users"]}]}]
+	}},
+	"segments": {"beta-users": {"key": "beta-users", "version": 1, "unbounded": true, "generation": 1}}
+}}`
+
+func TestGetAllFlagsState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		var data bytes.Buffer
+		_ = json.Compact(&data, []byte(bigSegmentFlagData))
+		fmt.Fprintf(w, "event: put\ndata: %s\n\n", data.String())
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := adapters.WithApiAndSdk(context.Background(), ldapi.APIClient{}, adapters.SdkConfig{
+		Endpoints: interfaces.ServiceEndpoints{Streaming: server.URL},
+	})
+	membership := map[string]map[string]bool{
+		model.BigSegmentContextHash("alice"): {"beta-users.g1": true},
+	}
+	withMembership := adapters.WithBigSegmentMembership(ctx, func(contextHash string) map[string]bool {
+		return membership[contextHash]
+	})
+
+	t.Run("matches rules on big segments the context is in", func(t *testing.T) {
+		flags, err := adapters.GetSdk(withMembership).GetAllFlagsState(withMembership, ldcontext.New("alice"), "sdk-key")
+		require.NoError(t, err)
+		assert.True(t, flags.GetValue("beta").BoolValue())
+	})
+
+	t.Run("doesn't match rules on big segments the context isn't in", func(t *testing.T) {
+		flags, err := adapters.GetSdk(withMembership).GetAllFlagsState(withMembership, ldcontext.New("bob"), "sdk-key")
+		require.NoError(t, err)
+		assert.False(t, flags.GetValue("beta").BoolValue())
+	})
+
+	t.Run("big segments aren't configured without membership", func(t *testing.T) {
+		flags, err := adapters.GetSdk(ctx).GetAllFlagsState(ctx, ldcontext.New("alice"), "sdk-key")
+		require.NoError(t, err)
+		assert.False(t, flags.GetValue("beta").BoolValue())
+	})
+
+	t.Run("connects directly to hosts in NO_PROXY despite an explicit proxy", func(t *testing.T) {
+		t.Setenv("NO_PROXY", "127.0.0.1")
+		proxiedCtx := adapters.WithApiAndSdk(withMembership, ldapi.APIClient{}, adapters.SdkConfig{
+			Endpoints: interfaces.ServiceEndpoints{Streaming: server.URL},
+			ProxyURL:  "http://127.0.0.1:1",
+		})
+
+		flags, err := adapters.GetSdk(proxiedCtx).GetAllFlagsState(proxiedCtx, ldcontext.New("alice"), "sdk-key")
+		require.NoError(t, err)
+		assert.True(t, flags.GetValue("beta").BoolValue())
+	})
+}
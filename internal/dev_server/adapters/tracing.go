@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"context"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+)
+
+var tracer = otel.Tracer("github.com/launchdarkly/ldcli/internal/dev_server/adapters")
+
+const (
+	projectKeyAttribute     = attribute.Key("ldcli.project.key")
+	environmentKeyAttribute = attribute.Key("ldcli.environment.key")
+)
+
+// tracingApi makes each call to LaunchDarkly's API a span, with the HTTP requests it makes as its children.
+type tracingApi struct {
+	Api
+}
+
+func (a tracingApi) GetSdkKey(ctx context.Context, projectKey, environmentKey string) (sdkKey string, err error) {
+	ctx, span := startSpan(ctx, "api.GetSdkKey", projectKeyAttribute.String(projectKey), environmentKeyAttribute.String(environmentKey))
+	defer func() { endSpan(span, err) }()
+	return a.Api.GetSdkKey(ctx, projectKey, environmentKey)
+}
+
+func (a tracingApi) GetAllFlags(ctx context.Context, projectKey string, filters []string) (flags []ldapi.FeatureFlag, err error) {
+	ctx, span := startSpan(ctx, "api.GetAllFlags", projectKeyAttribute.String(projectKey))
+	defer func() {
+		span.SetAttributes(attribute.Int("ldcli.flag.count", len(flags)))
+		endSpan(span, err)
+	}()
+	return a.Api.GetAllFlags(ctx, projectKey, filters)
+}
+
+func (a tracingApi) GetAllAIConfigs(ctx context.Context, projectKey string) (configs []AIConfig, err error) {
+	ctx, span := startSpan(ctx, "api.GetAllAIConfigs", projectKeyAttribute.String(projectKey))
+	defer func() {
+		span.SetAttributes(attribute.Int("ldcli.ai_config.count", len(configs)))
+		endSpan(span, err)
+	}()
+	return a.Api.GetAllAIConfigs(ctx, projectKey)
+}
+
+func (a tracingApi) GetContextKinds(ctx context.Context, projectKey string) (kinds []ldapi.ContextKindRep, err error) {
+	ctx, span := startSpan(ctx, "api.GetContextKinds", projectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return a.Api.GetContextKinds(ctx, projectKey)
+}
+
+func (a tracingApi) GetProjectEnvironments(ctx context.Context, projectKey string, query string, limit *int) (environments []ldapi.Environment, err error) {
+	ctx, span := startSpan(ctx, "api.GetProjectEnvironments", projectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return a.Api.GetProjectEnvironments(ctx, projectKey, query, limit)
+}
+
+func (a tracingApi) GetAllEnvironments(ctx context.Context, projectKey string) (environments []ldapi.Environment, err error) {
+	ctx, span := startSpan(ctx, "api.GetAllEnvironments", projectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return a.Api.GetAllEnvironments(ctx, projectKey)
+}
+
+func (a tracingApi) GetAllProjects(ctx context.Context) (projects []ldapi.Project, err error) {
+	ctx, span := startSpan(ctx, "api.GetAllProjects")
+	defer func() { endSpan(span, err) }()
+	return a.Api.GetAllProjects(ctx)
+}
+
+// tracingSdk makes each evaluation of a source environment's flags with the SDK a span.
+type tracingSdk struct {
+	Sdk
+}
+
+func (s tracingSdk) GetAllFlagsState(ctx context.Context, ldContext ldcontext.Context, sdkKey string) (flags flagstate.AllFlags, err error) {
+	ctx, span := startSpan(ctx, "sdk.GetAllFlagsState")
+	defer func() { endSpan(span, err) }()
+	return s.Sdk.GetAllFlagsState(ctx, ldContext, sdkKey)
+}
+
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
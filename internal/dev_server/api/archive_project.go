@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) ArchiveProject(ctx context.Context, request ArchiveProjectRequestObject) (ArchiveProjectResponseObject, error) {
+	err := model.ArchiveProject(ctx, request.ProjectKey)
+	switch {
+	case errors.As(err, &model.ErrNotFound{}):
+		return ArchiveProject404JSONResponse{ErrorResponseJSONResponse{
+			Code:    "not_found",
+			Message: "project not found",
+		}}, nil
+	case err != nil:
+		return nil, err
+	}
+	return ArchiveProject204Response{}, nil
+}
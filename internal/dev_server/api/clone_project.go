@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) CloneProject(ctx context.Context, request CloneProjectRequestObject) (CloneProjectResponseObject, error) {
+	project, err := model.CloneProject(ctx, request.ProjectKey, request.BaseProjectKey)
+	switch {
+	case errors.As(err, &model.ErrLinkedClone{}):
+		return CloneProject400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.As(err, &model.ErrNotFound{}):
+		return CloneProject404JSONResponse{
+			Code:    "not_found",
+			Message: err.Error(),
+		}, nil
+	case errors.As(err, &model.ErrAlreadyExists{}):
+		return CloneProject409JSONResponse{
+			Code:    "conflict",
+			Message: err.Error(),
+		}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return CloneProject201JSONResponse{
+		ProjectJSONResponse{
+			LastSyncedFromSource: project.LastSyncTime.Unix(),
+			Context:              project.Context,
+			SourceEnvironmentKey: project.SourceEnvironmentKey,
+			FlagsState:           &project.AllFlagsState,
+			FlagFilter:           flagFilterToResponseFormat(project.FlagFilter),
+			BaseProjectKey:       lo.EmptyableToPtr(project.BaseProjectKey),
+		},
+	}, nil
+}
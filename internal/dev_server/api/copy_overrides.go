@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) CopyOverrides(ctx context.Context, request CopyOverridesRequestObject) (CopyOverridesResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty flag filter body")
+	}
+	if len(request.Body.Tags) > 0 {
+		return CopyOverrides400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: "overrides can't be copied by tag",
+			},
+		}, nil
+	}
+	copied, err := model.CopyOverrides(ctx, request.ProjectKey, request.SourceProjectKey, *request.Body)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return CopyOverrides404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return CopyOverrides200JSONResponse{
+		Copied:  copied.Copied,
+		Skipped: copied.Skipped,
+	}, nil
+}
@@ -1,6 +1,12 @@
 package api
 
-import "github.com/launchdarkly/ldcli/internal/dev_server/model"
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
 
 func availableVariationsToResponseFormat(availableVariations map[string][]model.Variation) map[string][]Variation {
 	respAvailableVariations := make(map[string][]Variation, len(availableVariations))
@@ -18,3 +24,250 @@ func availableVariationsToResponseFormat(availableVariations map[string][]model.
 	}
 	return respAvailableVariations
 }
+
+func archivedAtToResponseFormat(archivedAt *time.Time) *int64 {
+	if archivedAt == nil {
+		return nil
+	}
+	unix := archivedAt.Unix()
+	return &unix
+}
+
+func overrideToResponseFormat(override model.Override) FlagOverrideJSONResponse {
+	return FlagOverrideJSONResponse{
+		Override: override.Active,
+		Value:    override.Value,
+		Locked:   override.Locked,
+		Rollout:  rolloutToResponseFormat(override.Rollout),
+	}
+}
+
+func rolloutToResponseFormat(rollout *model.Rollout) *Rollout {
+	if rollout == nil {
+		return nil
+	}
+	result := Rollout{
+		Variations: make([]RolloutVariation, 0, len(rollout.Variations)),
+	}
+	if rollout.ContextKind != "" {
+		result.ContextKind = &rollout.ContextKind
+	}
+	if rollout.BucketBy != "" {
+		result.BucketBy = &rollout.BucketBy
+	}
+	if rollout.Seed != 0 {
+		result.Seed = &rollout.Seed
+	}
+	if rollout.PerEvaluation {
+		result.PerEvaluation = &rollout.PerEvaluation
+	}
+	for _, variation := range rollout.Variations {
+		result.Variations = append(result.Variations, RolloutVariation{
+			Value:   variation.Value,
+			Percent: float64(variation.Weight) * 100 / model.RolloutTotalWeight,
+		})
+	}
+	if len(rollout.ForcedTreatments) > 0 {
+		forcedTreatments := make([]ForcedTreatment, 0, len(rollout.ForcedTreatments))
+		for _, forced := range rollout.ForcedTreatments {
+			forcedTreatments = append(forcedTreatments, ForcedTreatment{
+				ContextKind: lo.EmptyableToPtr(forced.ContextKind),
+				ContextKey:  forced.ContextKey,
+				Variation:   forced.Variation,
+			})
+		}
+		result.ForcedTreatments = &forcedTreatments
+	}
+	return &result
+}
+
+func experimentSimulationToResponseFormat(simulation model.ExperimentSimulation) ExperimentSimulation {
+	result := ExperimentSimulation{
+		Rollout:     *rolloutToResponseFormat(&simulation.Rollout),
+		Assignments: make([]ExperimentAssignment, 0, len(simulation.Assignments)),
+		Counts:      simulation.Counts,
+	}
+	for _, assignment := range simulation.Assignments {
+		result.Assignments = append(result.Assignments, ExperimentAssignment{
+			Context:   assignment.Context,
+			Bucket:    assignment.Bucket,
+			Variation: assignment.Variation,
+			Value:     assignment.Value,
+			Forced:    assignment.Forced,
+		})
+	}
+	return result
+}
+
+func linkedClonesToResponseFormat(cloneKeys []string) *[]string {
+	if len(cloneKeys) == 0 {
+		return nil
+	}
+	return &cloneKeys
+}
+
+func contextKindsToResponseFormat(kinds []model.ContextKind) *[]ContextKind {
+	if len(kinds) == 0 {
+		return nil
+	}
+	return &kinds
+}
+
+func flagFilterToResponseFormat(filter model.FlagFilter) *FlagFilter {
+	if filter.IsEmpty() {
+		return nil
+	}
+	return &filter
+}
+
+func syncStatusToResponseFormat(status *model.SyncStatus) *SyncStatus {
+	if status == nil {
+		return nil
+	}
+	response := SyncStatus{
+		AttemptedAt: status.AttemptedAt.Unix(),
+		DurationMs:  status.Duration.Milliseconds(),
+		Result:      SyncResultSuccess,
+	}
+	if !status.Succeeded() {
+		response.Result = SyncResultError
+		response.Error = &status.Error
+	}
+	return &response
+}
+
+func orphanedToResponseFormat(orphaned *model.Orphaned) *Orphaned {
+	if orphaned == nil {
+		return nil
+	}
+	return &Orphaned{
+		Detail: orphaned.Detail,
+		Since:  orphaned.Since.Unix(),
+	}
+}
+
+func projectDeletionToResponseFormat(deletion model.ProjectDeletion) ProjectDeletionJSONResponse {
+	return ProjectDeletionJSONResponse{
+		Overrides:           deletion.Overrides,
+		ScenarioOverrides:   deletion.ScenarioOverrides,
+		AvailableVariations: deletion.AvailableVariations,
+		Aliases:             deletion.Aliases,
+		FlagStateHistory:    deletion.FlagStateHistory,
+		OverrideHistory:     deletion.OverrideHistory,
+		OverrideSchedules:   deletion.OverrideSchedules,
+		SavedContexts:       deletion.SavedContexts,
+		FlagTriggers:        deletion.FlagTriggers,
+	}
+}
+
+func savedContextToResponseFormat(savedContext model.SavedContext) SavedContext {
+	return SavedContext{
+		Name:    savedContext.Name,
+		Context: savedContext.Context,
+	}
+}
+
+func snapshotToResponseFormat(snapshot model.Snapshot) Snapshot {
+	return Snapshot{
+		Id:         snapshot.ID,
+		RecordedAt: snapshot.RecordedAt,
+	}
+}
+
+func auditEntriesToResponseFormat(entries []model.AuditEntry) []AuditEntry {
+	respEntries := make([]AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		respEntries = append(respEntries, AuditEntry{
+			Actor:      entry.Actor,
+			FlagKey:    entry.FlagKey,
+			Layer:      entry.Layer,
+			Locked:     entry.Locked,
+			Override:   entry.Active,
+			RecordedAt: entry.RecordedAt,
+			Value:      entry.Value,
+			Version:    entry.Version,
+		})
+	}
+	return respEntries
+}
+
+func overrideScheduleToResponseFormat(schedule model.OverrideSchedule) OverrideSchedule {
+	return OverrideSchedule{
+		ActivateAt:   schedule.ActivateAt,
+		DeactivateAt: schedule.DeactivateAt,
+		FlagKey:      schedule.FlagKey,
+		ScheduledBy:  schedule.ScheduledBy,
+		Value:        schedule.Value,
+	}
+}
+
+func flagExplanationToResponseFormat(explanation model.FlagExplanation) FlagExplanation {
+	response := FlagExplanation{
+		FlagKey: explanation.FlagKey,
+		Context: explanation.Context,
+		Source: SourceEvaluation{
+			Value:    explanation.Source.Value,
+			Version:  explanation.Source.Version,
+			Reason:   explanation.Source.Reason,
+			SyncedAt: explanation.LastSyncTime.Unix(),
+		},
+		Value:  explanation.Value,
+		Layer:  explanation.Layer,
+		Reason: explanation.Reason,
+	}
+	if explanation.Override != nil {
+		response.Override = &AppliedOverride{
+			Layer:   explanation.Override.Layer,
+			Value:   explanation.Override.Value,
+			Rollout: rolloutToResponseFormat(explanation.Override.Rollout),
+		}
+	}
+	if len(explanation.Prerequisites) > 0 {
+		prerequisites := make([]PrerequisiteOutcome, 0, len(explanation.Prerequisites))
+		for _, prerequisite := range explanation.Prerequisites {
+			prerequisites = append(prerequisites, PrerequisiteOutcome{
+				FlagKey:     prerequisite.FlagKey,
+				SourceValue: prerequisite.SourceValue,
+				Value:       prerequisite.Value,
+				Layer:       prerequisite.Layer,
+			})
+		}
+		response.Prerequisites = &prerequisites
+	}
+	return response
+}
+
+func evaluationRequestToResponseFormat(request model.EvaluationRequest) EvaluationRequest {
+	response := EvaluationRequest{
+		Id:      request.ID,
+		Time:    request.Time,
+		FlagKey: request.FlagKey,
+		Value:   request.Value,
+		Source:  EvaluationSource(request.Source),
+		Count:   request.Count,
+	}
+	if request.Context.IsDefined() {
+		response.Context = &request.Context
+	}
+	return response
+}
+
+func flagUsageToResponseFormat(flagUsage model.FlagUsage) FlagUsage {
+	return FlagUsage{
+		FlagKey:             flagUsage.FlagKey,
+		Evaluations:         flagUsage.Evaluations,
+		FallbackEvaluations: flagUsage.FallbackEvaluations,
+		LastEvaluated:       flagUsage.LastEvaluated,
+		InProject:           flagUsage.InProject,
+	}
+}
+
+func flagTriggerToResponseFormat(trigger model.FlagTrigger) FlagTrigger {
+	return FlagTrigger{
+		Id:        trigger.ID,
+		FlagKey:   trigger.FlagKey,
+		Action:    TriggerAction(trigger.Action),
+		Path:      "/dev/triggers/" + trigger.ID,
+		CreatedAt: trigger.CreatedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteAlias(ctx context.Context, request DeleteAliasRequestObject) (DeleteAliasResponseObject, error) {
+	store := model.StoreFromContext(ctx)
+	deleted, err := store.DeleteAlias(ctx, request.Alias)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return DeleteAlias404JSONResponse{ErrorResponseJSONResponse{
+			Code:    "not_found",
+			Message: "alias not found",
+		}}, nil
+	}
+	return DeleteAlias204Response{}, nil
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteBigSegment(ctx context.Context, request DeleteBigSegmentRequestObject) (DeleteBigSegmentResponseObject, error) {
+	deleted := model.BigSegmentsFromContext(ctx).DeleteMembership(request.ProjectKey, request.SegmentKey)
+	if !deleted {
+		return DeleteBigSegment404JSONResponse{ErrorResponseJSONResponse{
+			Code:    "not_found",
+			Message: "big segment not found",
+		}}, nil
+	}
+	model.ResyncForBigSegments(ctx, request.ProjectKey)
+	return DeleteBigSegment204Response{}, nil
+}
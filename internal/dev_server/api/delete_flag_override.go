@@ -11,6 +11,12 @@ import (
 func (s server) DeleteFlagOverride(ctx context.Context, request DeleteFlagOverrideRequestObject) (DeleteFlagOverrideResponseObject, error) {
 	err := model.DeleteOverride(ctx, request.ProjectKey, request.FlagKey)
 	if err != nil {
+		if errors.As(err, &model.ErrLocked{}) {
+			return DeleteFlagOverride409JSONResponse{ErrorResponseJSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}}, nil
+		}
 		if errors.As(err, &model.ErrNotFound{}) {
 			return DeleteFlagOverride404Response{}, nil
 		}
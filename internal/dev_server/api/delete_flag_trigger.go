@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteFlagTrigger(ctx context.Context, request DeleteFlagTriggerRequestObject) (DeleteFlagTriggerResponseObject, error) {
+	err := model.DeleteFlagTrigger(ctx, request.ProjectKey, request.TriggerId)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return DeleteFlagTrigger404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return DeleteFlagTrigger204Response{}, nil
+}
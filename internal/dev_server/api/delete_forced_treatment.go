@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteForcedTreatment(ctx context.Context, request DeleteForcedTreatmentRequestObject) (DeleteForcedTreatmentResponseObject, error) {
+	override, err := model.UnforceTreatment(ctx, request.ProjectKey, request.FlagKey, lo.FromPtr(request.Params.ContextKind), request.Params.ContextKey)
+	if err != nil {
+		if errors.As(err, &model.ErrLocked{}) {
+			return DeleteForcedTreatment409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return DeleteForcedTreatment404JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "not_found",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	return DeleteForcedTreatment200JSONResponse{overrideToResponseFormat(override)}, nil
+}
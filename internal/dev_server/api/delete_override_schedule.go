@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteOverrideSchedule(ctx context.Context, request DeleteOverrideScheduleRequestObject) (DeleteOverrideScheduleResponseObject, error) {
+	err := model.UnscheduleOverride(ctx, request.ProjectKey, request.FlagKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return DeleteOverrideSchedule404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return DeleteOverrideSchedule204Response{}, nil
+}
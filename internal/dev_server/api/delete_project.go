@@ -3,20 +3,26 @@ package api
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
 func (s server) DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error) {
-	store := model.StoreFromContext(ctx)
-	deleted, err := store.DeleteDevProject(ctx, request.ProjectKey)
-	if err != nil {
-		return nil, err
-	}
-	if !deleted {
+	deletion, err := model.DeleteProject(ctx, request.ProjectKey)
+	switch {
+	case errors.As(err, &model.ErrNotFound{}):
 		return DeleteProject404JSONResponse{ErrorResponseJSONResponse{
 			Code:    "not_found",
 			Message: "project not found",
 		}}, nil
+	case errors.As(err, &model.ErrHasLinkedClones{}):
+		return DeleteProject409JSONResponse{
+			Code:    "has_linked_clones",
+			Message: err.Error(),
+		}, nil
+	case err != nil:
+		return nil, err
 	}
-	return DeleteProject204Response{}, nil
+	return DeleteProject200JSONResponse{projectDeletionToResponseFormat(deletion)}, nil
 }
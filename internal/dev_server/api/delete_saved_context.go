@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteSavedContext(ctx context.Context, request DeleteSavedContextRequestObject) (DeleteSavedContextResponseObject, error) {
+	err := model.DeleteSavedContext(ctx, request.ProjectKey, request.ContextName)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return DeleteSavedContext404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return DeleteSavedContext204Response{}, nil
+}
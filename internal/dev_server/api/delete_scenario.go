@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) DeleteScenario(ctx context.Context, request DeleteScenarioRequestObject) (DeleteScenarioResponseObject, error) {
+	err := model.ApplyScenario(ctx, request.ProjectKey, nil)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return DeleteScenario404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return DeleteScenario204Response{}, nil
+}
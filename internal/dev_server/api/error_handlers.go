@@ -2,8 +2,9 @@ package api
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 type errorHandler struct {
@@ -12,7 +13,7 @@ type errorHandler struct {
 }
 
 func (eh errorHandler) HandleError(w http.ResponseWriter, r *http.Request, err error) {
-	log.Printf("Error while handling request: %+v", err)
+	logs.Printf(logs.Error, "", "Error while handling request: %+v", err)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(eh.statusCode)
 	err = json.NewEncoder(w).Encode(ErrorResponseJSONResponse{
@@ -20,7 +21,17 @@ func (eh errorHandler) HandleError(w http.ResponseWriter, r *http.Request, err e
 		Message: err.Error(),
 	})
 	if err != nil {
-		log.Printf("Error while writing error response: %+v", err)
+		logs.Printf(logs.Error, "", "Error while writing error response: %+v", err)
+	}
+}
+
+// writeErrorResponse responds with an error from outside the generated handlers, such as from middleware.
+func writeErrorResponse(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	err := json.NewEncoder(w).Encode(ErrorResponse{Code: code, Message: message})
+	if err != nil {
+		logs.Printf(logs.Error, "", "Error while writing error response: %+v", err)
 	}
 }
 
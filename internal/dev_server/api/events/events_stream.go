@@ -3,10 +3,10 @@ package events
 import (
 	"context"
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/pkg/errors"
 
@@ -16,20 +16,20 @@ import (
 type sdkEventObserver struct {
 	ctx             context.Context
 	debugSessionKey string
-	updateChan      chan<- sdk.Message
+	stream          *sdk.Stream
 }
 
-func newSdkEventObserver(updateChan chan<- sdk.Message, ctx context.Context) sdkEventObserver {
+func newSdkEventObserver(stream *sdk.Stream, ctx context.Context) sdkEventObserver {
 	debugSessionKey := uuid.New().String()
 	db := model.EventStoreFromContext(ctx)
 	err := db.CreateDebugSession(ctx, debugSessionKey)
 	if err != nil {
-		log.Printf("sdkEventObserver: error writting debug session: %v", err)
+		logs.Printf(logs.Error, "", "sdkEventObserver: error writting debug session: %v", err)
 	}
 	return sdkEventObserver{
 		debugSessionKey: debugSessionKey,
 		ctx:             ctx,
-		updateChan:      updateChan,
+		stream:          stream,
 	}
 }
 
@@ -42,7 +42,7 @@ func (o sdkEventObserver) Handle(message interface{}) {
 	event := sdk.SDKEventBase{}
 	err := json.Unmarshal(str, &event)
 	if err != nil {
-		log.Printf("sdkEventObserver: error unmarshaling event: %v", err)
+		logs.Printf(logs.Error, "", "sdkEventObserver: error unmarshaling event: %v", err)
 		return
 	}
 
@@ -50,27 +50,28 @@ func (o sdkEventObserver) Handle(message interface{}) {
 
 	err = db.WriteEvent(o.ctx, o.debugSessionKey, event.Kind, str)
 	if err != nil {
-		log.Printf("sdkEventObserver: error writting event: %v", err)
+		logs.Printf(logs.Error, "", "sdkEventObserver: error writting event: %v", err)
 		return
 	}
 
-	o.updateChan <- sdk.Message{Event: sdk.TYPE_PUT, Data: str}
+	o.stream.Send(sdk.Message{Event: sdk.TYPE_PUT, Data: str})
 }
 
 func SdkEventsTeeHandler(writer http.ResponseWriter, request *http.Request) {
-	updateChan, errChan := sdk.OpenStream(
+	// events aren't state that can be resent, so a client that falls behind just misses some
+	stream, errChan := sdk.OpenStream(
 		writer,
 		request.Context().Done(),
 		sdk.Message{Event: sdk.TYPE_PUT, Data: []byte{}},
+		nil,
 	)
-	defer close(updateChan)
 	observers := model.GetObserversFromContext(request.Context())
 
-	observerId := observers.RegisterObserver(newSdkEventObserver(updateChan, request.Context()))
+	observerId := observers.RegisterObserver(newSdkEventObserver(stream, request.Context()))
 	defer func() {
 		ok := observers.DeregisterObserver(observerId)
 		if !ok {
-			log.Printf("unable to remove observer")
+			logs.Printf(logs.Error, "", "unable to remove observer")
 		}
 	}()
 
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) FireFlagTrigger(ctx context.Context, request FireFlagTriggerRequestObject) (FireFlagTriggerResponseObject, error) {
+	trigger, err := model.FireFlagTrigger(ctx, request.TriggerId)
+	if err != nil {
+		if errors.As(err, &model.ErrLocked{}) {
+			return FireFlagTrigger409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return FireFlagTrigger404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return FireFlagTrigger200JSONResponse(flagTriggerToResponseFormat(trigger)), nil
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+var flagStateFields = []string{"value", "version", "trackEvents", "rollout", "archived"}
+
+// flagsPage is the page of a project's flags asked for with the offset and limit parameters, and which fields of each
+// flag's state to return, from the fields parameter.
+type flagsPage struct {
+	offset int
+	limit  int
+	fields []string
+}
+
+func newFlagsPage(offset *FlagsOffset, limit *FlagsLimit, fields *FlagFields) (flagsPage, error) {
+	page := flagsPage{offset: lo.FromPtr(offset), fields: lo.FromPtr(fields)}
+	if limit != nil {
+		if *limit < 1 {
+			return flagsPage{}, errors.New("limit must be positive")
+		}
+		page.limit = *limit
+	}
+	if page.offset < 0 {
+		return flagsPage{}, errors.New("offset can't be negative")
+	}
+	for _, field := range page.fields {
+		if !lo.Contains(flagStateFields, field) {
+			return flagsPage{}, errors.Errorf("unknown flag state field %q", field)
+		}
+	}
+	return page, nil
+}
+
+// paginated is whether only some of the flags were asked for.
+func (p flagsPage) paginated() bool {
+	return p.offset > 0 || p.limit > 0
+}
+
+func (p flagsPage) apply(flags model.FlagsState) model.FlagsState {
+	if !p.paginated() {
+		return flags
+	}
+	return flags.Page(p.offset, p.limit)
+}
+
+// sparse returns what to marshal in place of flags: just the fields asked for of each flag's state.
+func (p flagsPage) sparse(flags model.FlagsState) (any, error) {
+	if len(p.fields) == 0 {
+		return flags, nil
+	}
+	sparse := make(map[string]map[string]json.RawMessage, len(flags))
+	for key, state := range flags {
+		data, err := json.Marshal(state)
+		if err != nil {
+			return nil, err
+		}
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			return nil, err
+		}
+		sparse[key] = lo.PickByKeys(all, p.fields)
+	}
+	return sparse, nil
+}
+
+// onPage returns the entries of values for the flags on the page.
+func onPage[V any](values map[string]V, page model.FlagsState) map[string]V {
+	return lo.PickBy(values, func(key string, _ V) bool {
+		_, ok := page[key]
+		return ok
+	})
+}
+
+// sparseProjectFlagStateResponse is a flag state response with only some fields of each flag's state.
+type sparseProjectFlagStateResponse struct {
+	GetProjectFlagState200JSONResponse
+	FlagsState any `json:"flagsState"`
+}
+
+func (response sparseProjectFlagStateResponse) VisitGetProjectFlagStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// sparseProjectResponse is a project response with only some fields of each flag's state and override.
+type sparseProjectResponse struct {
+	GetProject200JSONResponse
+	FlagsState any `json:"flagsState,omitempty"`
+	Overrides  any `json:"overrides,omitempty"`
+}
+
+func (response sparseProjectResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
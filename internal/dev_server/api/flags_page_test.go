@@ -0,0 +1,101 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestFlagsPagination(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{
+		"a": ldvalue.Bool(true),
+		"b": ldvalue.String("b"),
+		"c": ldvalue.Int(3),
+	})))
+	_, err = model.UpsertOverride(ctx, "proj", "b", ldvalue.String("overridden"))
+	require.NoError(t, err)
+	server := api.NewStrictServer()
+
+	t.Run("flag state is paginated by key", func(t *testing.T) {
+		response, err := server.GetProjectFlagState(ctx, api.GetProjectFlagStateRequestObject{
+			ProjectKey: "proj",
+			Params:     api.GetProjectFlagStateParams{Offset: lo.ToPtr(1), Limit: lo.ToPtr(1)},
+		})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, response.VisitGetProjectFlagStateResponse(rec))
+		assert.JSONEq(t, `{
+			"flagsState": {"b": {"value": "overridden", "version": 2, "trackEvents": true}},
+			"layers": {"b": "user"},
+			"totalCount": 3
+		}`, rec.Body.String())
+	})
+
+	t.Run("flag state can have only some fields", func(t *testing.T) {
+		response, err := server.GetProjectFlagState(ctx, api.GetProjectFlagStateRequestObject{
+			ProjectKey: "proj",
+			Params:     api.GetProjectFlagStateParams{Fields: &[]string{"value"}},
+		})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, response.VisitGetProjectFlagStateResponse(rec))
+		assert.JSONEq(t, `{
+			"flagsState": {"a": {"value": true}, "b": {"value": "overridden"}, "c": {"value": 3}},
+			"layers": {"a": "source", "b": "user", "c": "source"},
+			"totalCount": 3
+		}`, rec.Body.String())
+	})
+
+	t.Run("a project's overrides and variations are limited to its page of flags", func(t *testing.T) {
+		expand := api.ProjectExpand{"overrides", "availableVariations"}
+		response, err := server.GetProject(ctx, api.GetProjectRequestObject{
+			ProjectKey: "proj",
+			Params:     api.GetProjectParams{Expand: &expand, Limit: lo.ToPtr(2), Fields: &[]string{"value"}},
+		})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, response.VisitGetProjectResponse(rec))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			FlagsState          map[string]map[string]any `json:"flagsState"`
+			Overrides           map[string]map[string]any `json:"overrides"`
+			AvailableVariations map[string]any            `json:"availableVariations"`
+			TotalFlags          int                       `json:"totalFlags"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, map[string]map[string]any{"a": {"value": true}, "b": {"value": "b"}}, body.FlagsState)
+		assert.Equal(t, map[string]map[string]any{"b": {"value": "overridden"}}, body.Overrides)
+		assert.ElementsMatch(t, []string{"a", "b"}, lo.Keys(body.AvailableVariations))
+		assert.Equal(t, 3, body.TotalFlags)
+	})
+
+	invalid := map[string]api.GetProjectFlagStateParams{
+		"limit of 0":      {Limit: lo.ToPtr(0)},
+		"negative offset": {Offset: lo.ToPtr(-1)},
+		"unknown field":   {Fields: &[]string{"colour"}},
+	}
+	for name, params := range invalid {
+		t.Run(name, func(t *testing.T) {
+			response, err := server.GetProjectFlagState(ctx, api.GetProjectFlagStateRequestObject{ProjectKey: "proj", Params: params})
+			require.NoError(t, err)
+			assert.IsType(t, api.GetProjectFlagState400JSONResponse{}, response)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetAliases(ctx context.Context, request GetAliasesRequestObject) (GetAliasesResponseObject, error) {
+	store := model.StoreFromContext(ctx)
+	aliases, err := store.GetAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := make(GetAliases200JSONResponse, 0, len(aliases))
+	for _, alias := range aliases {
+		response = append(response, Alias{
+			Alias:      alias.Alias,
+			ProjectKey: alias.ProjectKey,
+		})
+	}
+	return response, nil
+}
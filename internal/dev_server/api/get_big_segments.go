@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetBigSegments(ctx context.Context, request GetBigSegmentsRequestObject) (GetBigSegmentsResponseObject, error) {
+	store := model.StoreFromContext(ctx)
+	_, err := store.GetDevProject(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetBigSegments404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	memberships := model.BigSegmentsFromContext(ctx).GetMemberships(request.ProjectKey)
+	response := make(GetBigSegments200JSONResponse, len(memberships))
+	for segmentKey, membership := range memberships {
+		response[segmentKey] = bigSegmentMembershipFromModel(membership)
+	}
+	return response, nil
+}
+
+func bigSegmentMembershipFromModel(membership model.BigSegmentMembership) BigSegmentMembership {
+	included := append([]string{}, membership.Included...)
+	excluded := append([]string{}, membership.Excluded...)
+	return BigSegmentMembership{
+		Included: &included,
+		Excluded: &excluded,
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetEvaluationRequests(ctx context.Context, request GetEvaluationRequestsRequestObject) (GetEvaluationRequestsResponseObject, error) {
+	query := model.EvaluationRequestsQuery{ProjectKey: request.ProjectKey, Limit: 100}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+	if query.Limit < 1 {
+		return GetEvaluationRequests400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "limit must be positive",
+		}}, nil
+	}
+	if request.Params.After != nil {
+		query.AfterID = *request.Params.After
+	}
+	if request.Params.FlagKey != nil {
+		query.FlagKey = *request.Params.FlagKey
+	}
+	if request.Params.Source != nil {
+		query.Source = model.EvaluationSource(*request.Params.Source)
+	}
+
+	requests := make([]EvaluationRequest, 0)
+	if buffer := model.EvaluationRequestsFromContext(ctx); buffer != nil {
+		for _, evaluation := range buffer.Query(query) {
+			requests = append(requests, evaluationRequestToResponseFormat(evaluation))
+		}
+	}
+
+	return GetEvaluationRequests200JSONResponse{Requests: requests}, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error) {
+	buffer := model.EventsBufferFromContext(ctx)
+
+	query := model.EventsQuery{Limit: 100}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+	if query.Limit < 1 {
+		return GetEvents400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "limit must be positive",
+		}}, nil
+	}
+	if request.Params.After != nil {
+		query.AfterID = *request.Params.After
+	}
+	if request.Params.Kind != nil {
+		query.Kind = *request.Params.Kind
+	}
+	if request.Params.ProjectKey != nil {
+		query.ProjectKey = *request.Params.ProjectKey
+	}
+
+	events := buffer.Query(query)
+	apiEvents := make([]ReceivedEvent, 0, len(events))
+	for _, event := range events {
+		apiEvents = append(apiEvents, ReceivedEvent{
+			Id:         event.ID,
+			ReceivedAt: event.ReceivedAt,
+			ProjectKey: lo.EmptyableToPtr(event.ProjectKey),
+			Kind:       event.Kind,
+			Data:       event.Data,
+		})
+	}
+
+	return GetEvents200JSONResponse{Events: apiEvents}, nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetFlagExplanation(ctx context.Context, request GetFlagExplanationRequestObject) (GetFlagExplanationResponseObject, error) {
+	ldCtx := request.Params.Context
+	savedContextName := lo.CoalesceOrEmpty(lo.FromPtr(request.Params.SavedContext), lo.FromPtr(request.Params.XLDSavedContext))
+	if savedContextName != "" {
+		if ldCtx != nil {
+			return GetFlagExplanation400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_request",
+					Message: "only one of context and a saved context can be given",
+				},
+			}, nil
+		}
+		savedContext, err := model.GetSavedContext(ctx, request.ProjectKey, savedContextName)
+		if err != nil {
+			if errors.As(err, &model.ErrNotFound{}) {
+				return GetFlagExplanation404JSONResponse{
+					Code:    "not_found",
+					Message: err.Error(),
+				}, nil
+			}
+			return nil, err
+		}
+		ldCtx = &savedContext.Context
+	}
+	at, err := asOf(ctx, request.ProjectKey, request.Params.At, request.Params.Snapshot)
+	if err != nil {
+		if errors.Is(err, errAtAndSnapshot) {
+			return GetFlagExplanation400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_parameter",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetFlagExplanation404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	var explanation model.FlagExplanation
+	if at != nil {
+		explanation, err = model.ExplainFlagAt(ctx, request.ProjectKey, request.FlagKey, ldCtx, *at)
+	} else {
+		explanation, err = model.ExplainFlag(ctx, request.ProjectKey, request.FlagKey, ldCtx)
+	}
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetFlagExplanation404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return GetFlagExplanation200JSONResponse(flagExplanationToResponseFormat(explanation)), nil
+}
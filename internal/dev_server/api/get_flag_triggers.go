@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetFlagTriggers(ctx context.Context, request GetFlagTriggersRequestObject) (GetFlagTriggersResponseObject, error) {
+	triggers, err := model.GetFlagTriggers(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetFlagTriggers404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	respTriggers := make([]FlagTrigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		respTriggers = append(respTriggers, flagTriggerToResponseFormat(trigger))
+	}
+	return GetFlagTriggers200JSONResponse{Triggers: respTriggers}, nil
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetFlagUsage(ctx context.Context, request GetFlagUsageRequestObject) (GetFlagUsageResponseObject, error) {
+	report, err := model.GetUsageReport(ctx, request.ProjectKey, lo.FromPtr(request.Params.Since))
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetFlagUsage404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	flags := make([]FlagUsage, 0, len(report.Flags))
+	for _, flagUsage := range report.Flags {
+		flags = append(flags, flagUsageToResponseFormat(flagUsage))
+	}
+	return GetFlagUsage200JSONResponse{Flags: flags}, nil
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+func (s server) GetLogs(ctx context.Context, request GetLogsRequestObject) (GetLogsResponseObject, error) {
+	buffer := logs.BufferFromContext(ctx)
+
+	query := logs.Query{Limit: 100}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+	if query.Limit < 1 {
+		return GetLogs400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "limit must be positive",
+		}}, nil
+	}
+	if request.Params.After != nil {
+		query.AfterID = *request.Params.After
+	}
+	if request.Params.Level != nil {
+		level, err := logs.ParseLevel(string(*request.Params.Level))
+		if err != nil {
+			return GetLogs400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_parameter",
+				Message: err.Error(),
+			}}, nil
+		}
+		query.Level = level
+	}
+	if request.Params.ProjectKey != nil {
+		query.ProjectKey = *request.Params.ProjectKey
+	}
+	if request.Params.Grep != nil {
+		grep, err := regexp.Compile(*request.Params.Grep)
+		if err != nil {
+			return GetLogs400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_parameter",
+				Message: "grep must be a valid regular expression: " + err.Error(),
+			}}, nil
+		}
+		query.Grep = grep
+	}
+
+	entries, next := buffer.Query(query)
+	logEntries := make([]LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		logEntries = append(logEntries, LogEntry{
+			Id:         entry.ID,
+			Time:       entry.Time,
+			Level:      LogLevel(entry.Level.String()),
+			ProjectKey: lo.EmptyableToPtr(entry.ProjectKey),
+			Message:    entry.Message,
+		})
+	}
+
+	return GetLogs200JSONResponse{Logs: logEntries, Next: next}, nil
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetOverrideSchedules(ctx context.Context, request GetOverrideSchedulesRequestObject) (GetOverrideSchedulesResponseObject, error) {
+	schedules, err := model.GetOverrideSchedules(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetOverrideSchedules404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	respSchedules := make([]OverrideSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		respSchedules = append(respSchedules, overrideScheduleToResponseFormat(schedule))
+	}
+	return GetOverrideSchedules200JSONResponse{Schedules: respSchedules}, nil
+}
@@ -3,10 +3,20 @@ package api
 import (
 	"context"
 
+	"github.com/samber/lo"
+
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
 func (s server) GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error) {
+	page, err := newFlagsPage(request.Params.Offset, request.Params.Limit, request.Params.Fields)
+	if err != nil {
+		return GetProject400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: err.Error(),
+		}}, nil
+	}
+
 	store := model.StoreFromContext(ctx)
 	project, err := store.GetDevProject(ctx, request.ProjectKey)
 	if err != nil {
@@ -15,12 +25,26 @@ func (s server) GetProject(ctx context.Context, request GetProjectRequestObject)
 	if project == nil {
 		return GetProject404Response{}, nil
 	}
+	allFlagsState := project.AllFlagsState
+	if !lo.FromPtr(request.Params.IncludeArchived) {
+		allFlagsState = allFlagsState.WithoutArchived()
+	}
+	flagsState := page.apply(allFlagsState)
 
+	dashboard := model.DashboardFromContext(ctx)
 	response := ProjectJSONResponse{
 		LastSyncedFromSource: project.LastSyncTime.Unix(),
 		Context:              project.Context,
 		SourceEnvironmentKey: project.SourceEnvironmentKey,
-		FlagsState:           &project.AllFlagsState,
+		FlagsState:           &flagsState,
+		Orphaned:             orphanedToResponseFormat(project.Orphaned),
+		ArchivedAt:           archivedAtToResponseFormat(project.ArchivedAt),
+		FlagFilter:           flagFilterToResponseFormat(project.FlagFilter),
+		BaseProjectKey:       lo.EmptyableToPtr(project.BaseProjectKey),
+		LinkedClones:         linkedClonesToResponseFormat(project.LinkedCloneKeys),
+		ContextKinds:         contextKindsToResponseFormat(project.ContextKinds),
+		DashboardUrl:         lo.EmptyableToPtr(dashboard.ProjectURL(*project)),
+		FlagDashboardUrls:    lo.EmptyableToPtr(dashboard.FlagURLs(*project, flagsState)),
 	}
 
 	if request.Params.Expand != nil {
@@ -40,6 +64,7 @@ func (s server) GetProject(ctx context.Context, request GetProjectRequestObject)
 						Version: override.Version,
 					}
 				}
+				respOverrides = onPage(respOverrides, flagsState)
 				response.Overrides = &respOverrides
 			}
 			if item == "availableVariations" {
@@ -47,14 +72,38 @@ func (s server) GetProject(ctx context.Context, request GetProjectRequestObject)
 				if err != nil {
 					return nil, err
 				}
-				respAvailableVariations := availableVariationsToResponseFormat(availableVariations)
+				respAvailableVariations := availableVariationsToResponseFormat(onPage(availableVariations, flagsState))
 				response.AvailableVariations = &respAvailableVariations
 			}
+			if item == "syncStatus" {
+				response.SyncStatus = syncStatusToResponseFormat(project.SyncStatus)
+			}
+			if item == "metadata" {
+				flagMetadata := onPage(project.FlagMetadata, flagsState)
+				response.FlagMetadata = &flagMetadata
+			}
 		}
 
 	}
+	if page.paginated() {
+		response.TotalFlags = lo.ToPtr(len(allFlagsState))
+	}
 
-	return GetProject200JSONResponse{
-		response,
-	}, nil
+	if len(page.fields) == 0 {
+		return GetProject200JSONResponse{
+			response,
+		}, nil
+	}
+	sparse := sparseProjectResponse{GetProject200JSONResponse: GetProject200JSONResponse{response}}
+	sparse.FlagsState, err = page.sparse(flagsState)
+	if err != nil {
+		return nil, err
+	}
+	if response.Overrides != nil {
+		sparse.Overrides, err = page.sparse(*response.Overrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sparse, nil
 }
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetProjectAudit(ctx context.Context, request GetProjectAuditRequestObject) (GetProjectAuditResponseObject, error) {
+	entries, err := model.GetAuditLog(ctx, request.ProjectKey, model.AuditQuery{
+		FlagKey: lo.FromPtr(request.Params.FlagKey),
+		Since:   lo.FromPtr(request.Params.Since),
+		Limit:   lo.FromPtr(request.Params.Limit),
+	})
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectAudit404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return GetProjectAudit200JSONResponse{Entries: auditEntriesToResponseFormat(entries)}, nil
+}
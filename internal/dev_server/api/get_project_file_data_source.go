@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
+)
+
+func (s server) GetProjectFileDataSource(ctx context.Context, request GetProjectFileDataSourceRequestObject) (GetProjectFileDataSourceResponseObject, error) {
+	store := model.StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectFileDataSource404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	flagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return GetProjectFileDataSource200JSONResponse{
+		Flags:    sdk.ServerFlagsFromFlagsState(flagsState.WithoutArchived()),
+		Segments: map[string]interface{}{},
+	}, nil
+}
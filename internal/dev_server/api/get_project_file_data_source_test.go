@@ -0,0 +1,64 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestGetProjectFileDataSource(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{
+		"a": ldvalue.Bool(true),
+		"b": ldvalue.String("b"),
+	})))
+	_, err = model.UpsertOverride(ctx, "proj", "b", ldvalue.String("overridden"))
+	require.NoError(t, err)
+	server := api.NewStrictServer()
+
+	t.Run("exports the flags with their overrides", func(t *testing.T) {
+		response, err := server.GetProjectFileDataSource(ctx, api.GetProjectFileDataSourceRequestObject{ProjectKey: "proj"})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, response.VisitGetProjectFileDataSourceResponse(rec))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var dataSource struct {
+			Flags map[string]struct {
+				Variations  []interface{} `json:"variations"`
+				Fallthrough struct {
+					Variation int `json:"variation"`
+				} `json:"fallthrough"`
+			} `json:"flags"`
+			Segments map[string]interface{} `json:"segments"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &dataSource))
+		require.Len(t, dataSource.Flags, 2)
+		assert.Equal(t, []interface{}{true}, dataSource.Flags["a"].Variations)
+		assert.Equal(t, []interface{}{"overridden"}, dataSource.Flags["b"].Variations)
+		assert.Zero(t, dataSource.Flags["b"].Fallthrough.Variation)
+		assert.Empty(t, dataSource.Segments)
+	})
+
+	t.Run("404s for unknown projects", func(t *testing.T) {
+		response, err := server.GetProjectFileDataSource(ctx, api.GetProjectFileDataSourceRequestObject{ProjectKey: "nope"})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, response.VisitGetProjectFileDataSourceResponse(rec))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
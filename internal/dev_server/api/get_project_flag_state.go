@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetProjectFlagState(ctx context.Context, request GetProjectFlagStateRequestObject) (GetProjectFlagStateResponseObject, error) {
+	page, err := newFlagsPage(request.Params.Offset, request.Params.Limit, request.Params.Fields)
+	if err != nil {
+		return GetProjectFlagState400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: err.Error(),
+		}}, nil
+	}
+
+	at, err := asOf(ctx, request.ProjectKey, request.Params.At, request.Params.Snapshot)
+	if err != nil {
+		if errors.Is(err, errAtAndSnapshot) {
+			return GetProjectFlagState400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_parameter",
+				Message: err.Error(),
+			}}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectFlagState404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	expandMetadata := lo.Contains(lo.FromPtr(request.Params.Expand), "metadata")
+	var flagsState model.FlagsState
+	var layers map[string]model.OverrideLayer
+	var project *model.Project
+	if at != nil {
+		flagsState, layers, err = model.GetFlagStateAt(ctx, request.ProjectKey, *at)
+		if err == nil && expandMetadata {
+			project, err = model.StoreFromContext(ctx).GetDevProject(ctx, request.ProjectKey)
+		}
+	} else {
+		project, err = model.StoreFromContext(ctx).GetDevProject(ctx, request.ProjectKey)
+		if err == nil {
+			flagsState, layers, err = project.GetFlagStateWithLayersForProject(ctx)
+		}
+	}
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectFlagState404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !lo.FromPtr(request.Params.IncludeArchived) {
+		flagsState = flagsState.WithoutArchived()
+	}
+	paged := page.apply(flagsState)
+	response := GetProjectFlagState200JSONResponse{FlagsState: paged, Layers: onPage(layers, paged), TotalCount: len(flagsState)}
+	if expandMetadata {
+		flagMetadata := onPage(project.FlagMetadata, paged)
+		response.Metadata = &flagMetadata
+	}
+	if len(page.fields) == 0 {
+		return response, nil
+	}
+	sparse, err := page.sparse(paged)
+	if err != nil {
+		return nil, err
+	}
+	return sparseProjectFlagStateResponse{GetProjectFlagState200JSONResponse: response, FlagsState: sparse}, nil
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetProjectFlags(ctx context.Context, request GetProjectFlagsRequestObject) (GetProjectFlagsResponseObject, error) {
+	params := request.Params
+	query := model.FlagQuery{
+		Search:          lo.FromPtr(params.Q),
+		KeyPrefix:       lo.FromPtr(params.Prefix),
+		Kind:            lo.FromPtr(params.Kind),
+		Overridden:      lo.FromPtr(params.Overridden),
+		Tags:            lo.FromPtr(params.Tag),
+		Archived:        lo.FromPtr(params.Archived),
+		IncludeArchived: lo.FromPtr(params.IncludeArchived),
+		Offset:          lo.FromPtr(params.Offset),
+		Limit:           100,
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	switch {
+	case query.Limit < 1:
+		return GetProjectFlags400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "limit must be positive",
+		}}, nil
+	case query.Offset < 0:
+		return GetProjectFlags400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "offset can't be negative",
+		}}, nil
+	case query.Kind != "" && !lo.Contains([]model.FlagKind{model.FlagKindBoolean, model.FlagKindString, model.FlagKindNumber, model.FlagKindJson}, query.Kind):
+		return GetProjectFlags400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_parameter",
+			Message: fmt.Sprintf("unknown flag kind %q", query.Kind),
+		}}, nil
+	}
+
+	result, err := model.SearchFlags(ctx, request.ProjectKey, query)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectFlags404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	expandMetadata := lo.Contains(lo.FromPtr(params.Expand), "metadata")
+	items := make([]FoundFlag, 0, len(result.Flags))
+	for _, flag := range result.Flags {
+		item := FoundFlag{
+			Key:          flag.Key,
+			Value:        flag.State.Value,
+			Version:      flag.State.Version,
+			Kind:         model.FlagKindOf(flag.State.Value),
+			Layer:        flag.Layer,
+			Tags:         lo.EmptyableToPtr(flag.Metadata.Tags),
+			Archived:     lo.EmptyableToPtr(flag.State.Archived),
+			DashboardUrl: lo.EmptyableToPtr(flag.DashboardURL),
+		}
+		if expandMetadata {
+			item.Metadata = lo.ToPtr(flag.Metadata)
+		}
+		items = append(items, item)
+	}
+	return GetProjectFlags200JSONResponse{Items: items, TotalCount: result.TotalCount}, nil
+}
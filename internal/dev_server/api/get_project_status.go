@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetProjectStatus(ctx context.Context, request GetProjectStatusRequestObject) (GetProjectStatusResponseObject, error) {
+	status, err := model.GetProjectStatus(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectStatus404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return GetProjectStatus200JSONResponse{
+		FlagsWithSynthesizedVariationIds: status.FlagsWithSynthesizedVariationIds,
+		Orphaned:                         orphanedToResponseFormat(status.Orphaned),
+		SyncStatus:                       syncStatusToResponseFormat(status.SyncStatus),
+		Stale:                            status.Stale,
+	}, nil
+}
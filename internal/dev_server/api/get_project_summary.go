@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetProjectSummary(ctx context.Context, request GetProjectSummaryRequestObject) (GetProjectSummaryResponseObject, error) {
+	summary, err := model.GetProjectSummary(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetProjectSummary404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return GetProjectSummary200JSONResponse{
+		Flags:        summary.TotalFlags,
+		Overrides:    summary.ActiveOverrides,
+		Conflicts:    summary.Conflicts,
+		StaleSeconds: summary.StalenessSeconds,
+		Orphaned:     summary.Orphaned,
+	}, nil
+}
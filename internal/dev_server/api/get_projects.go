@@ -6,14 +6,40 @@ import (
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
-func (s server) GetProjects(ctx context.Context, _ GetProjectsRequestObject) (GetProjectsResponseObject, error) {
+func (s server) GetProjects(ctx context.Context, request GetProjectsRequestObject) (GetProjectsResponseObject, error) {
 	store := model.StoreFromContext(ctx)
 	projectKeys, err := store.GetDevProjectKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
+	includeArchived := request.Params.IncludeArchived != nil && *request.Params.IncludeArchived
+	if request.Params.Orphaned != nil || !includeArchived {
+		projectKeys, err = filterProjects(ctx, store, projectKeys, func(project *model.Project) bool {
+			if !includeArchived && project.ArchivedAt != nil {
+				return false
+			}
+			return request.Params.Orphaned == nil || (project.Orphaned != nil) == *request.Params.Orphaned
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 	if projectKeys == nil {
 		projectKeys = make([]string, 0) // HACK to make the json behavior compatible with go.
 	}
 	return GetProjects200JSONResponse(projectKeys), nil
 }
+
+func filterProjects(ctx context.Context, store model.Store, projectKeys []string, include func(*model.Project) bool) ([]string, error) {
+	var filtered []string
+	for _, projectKey := range projectKeys {
+		project, err := store.GetDevProject(ctx, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		if include(project) {
+			filtered = append(filtered, projectKey)
+		}
+	}
+	return filtered, nil
+}
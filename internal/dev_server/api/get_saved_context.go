@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetSavedContext(ctx context.Context, request GetSavedContextRequestObject) (GetSavedContextResponseObject, error) {
+	savedContext, err := model.GetSavedContext(ctx, request.ProjectKey, request.ContextName)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetSavedContext404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return GetSavedContext200JSONResponse(savedContextToResponseFormat(savedContext)), nil
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetSavedContexts(ctx context.Context, request GetSavedContextsRequestObject) (GetSavedContextsResponseObject, error) {
+	savedContexts, err := model.GetSavedContexts(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetSavedContexts404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	respContexts := make([]SavedContext, 0, len(savedContexts))
+	for _, savedContext := range savedContexts {
+		respContexts = append(respContexts, savedContextToResponseFormat(savedContext))
+	}
+	return GetSavedContexts200JSONResponse{Contexts: respContexts}, nil
+}
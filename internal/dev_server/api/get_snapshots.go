@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetSnapshots(ctx context.Context, request GetSnapshotsRequestObject) (GetSnapshotsResponseObject, error) {
+	snapshots, err := model.GetSnapshots(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return GetSnapshots404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	respSnapshots := make([]Snapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		respSnapshots = append(respSnapshots, snapshotToResponseFormat(snapshot))
+	}
+	return GetSnapshots200JSONResponse{Snapshots: respSnapshots}, nil
+}
+
+// errAtAndSnapshot is returned by asOf when a request says both when and which snapshot to reconstruct flags from.
+var errAtAndSnapshot = errors.New("only one of at and snapshot can be given")
+
+// asOf is the time a request asks to see the project's flags as of, either directly or by naming the snapshot to see
+// them from. It's nil when the request is for the current flags.
+func asOf(ctx context.Context, projectKey string, at *time.Time, snapshotID *int64) (*time.Time, error) {
+	if snapshotID == nil {
+		return at, nil
+	}
+	if at != nil {
+		return nil, errAtAndSnapshot
+	}
+	snapshot, err := model.GetSnapshot(ctx, projectKey, *snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot.RecordedAt, nil
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) GetSourceEnvironments(ctx context.Context, request GetSourceEnvironmentsRequestObject) (GetSourceEnvironmentsResponseObject, error) {
+	var query string
+	if request.Params.Name != nil {
+		query = *request.Params.Name
+	}
+
+	environments, err := model.GetEnvironmentsForProject(ctx, request.ProjectKey, query, request.Params.Limit)
+	switch {
+	case errors.As(err, &adapters.ErrSourceNotFound{}):
+		return GetSourceEnvironments404JSONResponse{ErrorResponseJSONResponse{
+			Code:    "not_found",
+			Message: "project not found in LaunchDarkly",
+		}}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	envReps := make([]Environment, 0, len(environments))
+	for _, env := range environments {
+		envReps = append(envReps, Environment{
+			Key:  env.Key,
+			Name: env.Name,
+		})
+	}
+	return GetSourceEnvironments200JSONResponse(envReps), nil
+}
@@ -0,0 +1,84 @@
+// Package gql is a GraphQL API over the dev server's projects, flags, overrides, and variations, for tools that want
+// nested data in one request. Subscriptions are streamed as server-sent events.
+package gql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// BindRoutes serves GraphQL at /dev/graphql. Requests that accept text/event-stream get each response a subscription
+// produces as an event, following the GraphQL over SSE protocol's distinct connections mode.
+func BindRoutes(router *mux.Router) {
+	schema := graphql.MustParseSchema(schemaSDL, &resolver{})
+	router.Handle("/dev/graphql", handler(schema)).Methods(http.MethodPost)
+	router.HandleFunc("/dev/graphql/schema.graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(schemaSDL))
+	}).Methods(http.MethodGet)
+}
+
+func handler(schema *graphql.Schema) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "request body must be JSON with a query", http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			stream(w, r, schema, req)
+			return
+		}
+		response := schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logs.Printf(logs.Error, "", "Error while writing GraphQL response: %+v", err)
+		}
+	}
+}
+
+func stream(w http.ResponseWriter, r *http.Request, schema *graphql.Schema, req request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming isn't supported", http.StatusInternalServerError)
+		return
+	}
+	responses, err := schema.Subscribe(r.Context(), req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for response := range responses {
+		data, err := json.Marshal(response)
+		if err != nil {
+			logs.Printf(logs.Error, "", "Error while writing GraphQL response: %+v", err)
+			return
+		}
+		if _, err := w.Write([]byte("event: next\ndata: " + string(data) + "\n\n")); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+	_, _ = w.Write([]byte("event: complete\ndata:\n\n"))
+	flusher.Flush()
+}
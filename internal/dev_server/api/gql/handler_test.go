@@ -0,0 +1,111 @@
+package gql_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api/gql"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestGraphQL(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	observers := model.NewObservers()
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, observers)
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{
+		"bool-flag":   ldvalue.Bool(false),
+		"string-flag": ldvalue.String("initial"),
+	})))
+	_, err = model.UpsertOverride(ctx, "proj", "bool-flag", ldvalue.Bool(true))
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	router.Use(model.StoreMiddleware(store))
+	router.Use(model.ObserversMiddleware(observers))
+	gql.BindRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	post := func(t *testing.T, query string, accept string) *http.Response {
+		body := `{"query": ` + ldvalue.String(query).JSONString() + `}`
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/dev/graphql", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("queries nested data", func(t *testing.T) {
+		res := post(t, `{
+			project(key: "proj") {
+				key
+				flags {
+					key
+					value
+					override { value locked }
+					variations { value }
+				}
+			}
+			missing: project(key: "missing") { key }
+		}`, "")
+		defer res.Body.Close()
+		var body strings.Builder
+		_, err := bufio.NewReader(res.Body).WriteTo(&body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data": {
+			"project": {
+				"key": "proj",
+				"flags": [
+					{"key": "bool-flag", "value": true, "override": {"value": true, "locked": false}, "variations": [{"value": false}, {"value": true}]},
+					{"key": "string-flag", "value": "initial", "override": null, "variations": [{"value": "initial"}]}
+				]
+			},
+			"missing": null
+		}}`, body.String())
+	})
+
+	t.Run("streams subscriptions", func(t *testing.T) {
+		res := post(t, `subscription { flagChanged(projectKey: "proj") { flagKey value deleted } }`, "text/event-stream")
+		defer res.Body.Close()
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		received := make(chan string, 1)
+		go func() {
+			scanner := bufio.NewScanner(res.Body)
+			for scanner.Scan() {
+				if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+					received <- data
+					return
+				}
+			}
+		}()
+		// the subscription's observer is registered before its response starts
+		_, err := model.UpsertOverride(ctx, "proj", "string-flag", ldvalue.String("changed"))
+		require.NoError(t, err)
+
+		select {
+		case data := <-received:
+			assert.JSONEq(t, `{"data": {"flagChanged": {"flagKey": "string-flag", "value": "changed", "deleted": false}}}`, data)
+		case <-time.After(time.Second):
+			t.Fatal("no change received")
+		}
+	})
+}
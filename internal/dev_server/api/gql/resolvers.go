@@ -0,0 +1,355 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// changesBufferSize is how many flag changes can be waiting to be sent to a subscriber before further changes are
+// dropped.
+const changesBufferSize = 100
+
+// JSON is the schema's JSON scalar.
+type JSON struct {
+	raw json.RawMessage
+}
+
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (j *JSON) UnmarshalGraphQL(input interface{}) error {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	j.raw = raw
+	return nil
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if j.raw == nil {
+		return []byte("null"), nil
+	}
+	return j.raw, nil
+}
+
+func jsonOf(v interface{}) (JSON, error) {
+	raw, err := json.Marshal(v)
+	return JSON{raw: raw}, err
+}
+
+type resolver struct{}
+
+func (resolver) Projects(ctx context.Context) ([]*projectResolver, error) {
+	store := model.StoreFromContext(ctx)
+	keys, err := store.GetDevProjectKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	projects := make([]*projectResolver, 0, len(keys))
+	for _, key := range keys {
+		project, err := store.GetDevProject(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, &projectResolver{project: *project})
+	}
+	return projects, nil
+}
+
+func (resolver) Project(ctx context.Context, args struct{ Key string }) (*projectResolver, error) {
+	project, err := model.StoreFromContext(ctx).GetDevProject(ctx, args.Key)
+	if errors.As(err, &model.ErrNotFound{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &projectResolver{project: *project}, nil
+}
+
+func (resolver) FlagChanged(ctx context.Context, args struct{ ProjectKey *string }) (<-chan *flagChangeResolver, error) {
+	changes := make(chan *flagChangeResolver, changesBufferSize)
+	observer := &flagChangeObserver{changes: changes}
+	if args.ProjectKey != nil {
+		observer.projectKey = *args.ProjectKey
+	}
+	observers := model.GetObserversFromContext(ctx)
+	observerId := observers.RegisterObserver(observer)
+	go func() {
+		<-ctx.Done()
+		if !observers.DeregisterObserver(observerId) {
+			logs.Printf(logs.Error, "", "unable to remove observer")
+		}
+		observer.close()
+	}()
+	return changes, nil
+}
+
+// projectResolver loads the project's flags, overrides, and variations once, when they're first asked for, however
+// many of its flags are selected.
+type projectResolver struct {
+	project model.Project
+
+	loadFlags     sync.Once
+	flags         map[string]*flagResolver
+	flagsErr      error
+	loadOverrides sync.Once
+	overrides     model.Overrides
+	overridesErr  error
+	loadVars      sync.Once
+	variations    map[string][]model.Variation
+	variationsErr error
+}
+
+func (p *projectResolver) Key() string {
+	return p.project.Key
+}
+
+func (p *projectResolver) SourceEnvironmentKey() string {
+	return p.project.SourceEnvironmentKey
+}
+
+func (p *projectResolver) Context() (JSON, error) {
+	return jsonOf(p.project.Context)
+}
+
+func (p *projectResolver) LastSyncedFromSource() string {
+	return p.project.LastSyncTime.UTC().Format(time.RFC3339)
+}
+
+func (p *projectResolver) Archived() bool {
+	return p.project.ArchivedAt != nil
+}
+
+func (p *projectResolver) Flags(ctx context.Context, args struct{ Keys *[]string }) ([]*flagResolver, error) {
+	flags, err := p.getFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	if args.Keys != nil {
+		keys = *args.Keys
+	} else {
+		for key := range flags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	}
+	result := make([]*flagResolver, 0, len(keys))
+	for _, key := range keys {
+		if flag, ok := flags[key]; ok {
+			result = append(result, flag)
+		}
+	}
+	return result, nil
+}
+
+func (p *projectResolver) Flag(ctx context.Context, args struct{ Key string }) (*flagResolver, error) {
+	flags, err := p.getFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return flags[args.Key], nil
+}
+
+func (p *projectResolver) getFlags(ctx context.Context) (map[string]*flagResolver, error) {
+	p.loadFlags.Do(func() {
+		flagsState, err := p.project.GetFlagStateWithOverridesForProject(ctx)
+		if err != nil {
+			p.flagsErr = errors.Wrapf(err, "unable to get flags for project %s", p.project.Key)
+			return
+		}
+		p.flags = make(map[string]*flagResolver, len(flagsState))
+		for key, state := range flagsState {
+			p.flags[key] = &flagResolver{project: p, key: key, state: state}
+		}
+	})
+	return p.flags, p.flagsErr
+}
+
+func (p *projectResolver) getOverrides(ctx context.Context) (model.Overrides, error) {
+	p.loadOverrides.Do(func() {
+		p.overrides, p.overridesErr = model.StoreFromContext(ctx).GetOverridesForProject(ctx, p.project.Key)
+	})
+	return p.overrides, p.overridesErr
+}
+
+func (p *projectResolver) getVariations(ctx context.Context) (map[string][]model.Variation, error) {
+	p.loadVars.Do(func() {
+		p.variations, p.variationsErr = model.StoreFromContext(ctx).GetAvailableVariationsForProject(ctx, p.project.Key)
+	})
+	return p.variations, p.variationsErr
+}
+
+type flagResolver struct {
+	project *projectResolver
+	key     string
+	state   model.FlagState
+}
+
+func (f *flagResolver) Key() string {
+	return f.key
+}
+
+func (f *flagResolver) Value() (JSON, error) {
+	return jsonOf(f.state.Value)
+}
+
+func (f *flagResolver) Version() int32 {
+	return int32(f.state.Version)
+}
+
+func (f *flagResolver) Override(ctx context.Context) (*overrideResolver, error) {
+	overrides, err := f.project.getOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	override, ok := overrides.GetFlag(f.key)
+	if !ok || !override.Active {
+		return nil, nil
+	}
+	return &overrideResolver{override: override}, nil
+}
+
+func (f *flagResolver) Variations(ctx context.Context) ([]*variationResolver, error) {
+	variations, err := f.project.getVariations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*variationResolver, 0, len(variations[f.key]))
+	for _, variation := range variations[f.key] {
+		result = append(result, &variationResolver{variation: variation})
+	}
+	return result, nil
+}
+
+type overrideResolver struct {
+	override model.Override
+}
+
+func (o *overrideResolver) Value() (JSON, error) {
+	return jsonOf(o.override.Value)
+}
+
+func (o *overrideResolver) Locked() bool {
+	return o.override.Locked
+}
+
+type variationResolver struct {
+	variation model.Variation
+}
+
+func (v *variationResolver) Id() string {
+	return v.variation.Id
+}
+
+func (v *variationResolver) Name() *string {
+	return v.variation.Name
+}
+
+func (v *variationResolver) Description() *string {
+	return v.variation.Description
+}
+
+func (v *variationResolver) Value() (JSON, error) {
+	return jsonOf(v.variation.Value)
+}
+
+type flagChangeResolver struct {
+	projectKey string
+	flagKey    string
+	state      *model.FlagState
+}
+
+func (c *flagChangeResolver) ProjectKey() string {
+	return c.projectKey
+}
+
+func (c *flagChangeResolver) FlagKey() string {
+	return c.flagKey
+}
+
+func (c *flagChangeResolver) Deleted() bool {
+	return c.state == nil
+}
+
+func (c *flagChangeResolver) Value() (*JSON, error) {
+	if c.state == nil {
+		return nil, nil
+	}
+	value, err := jsonOf(c.state.Value)
+	return &value, err
+}
+
+func (c *flagChangeResolver) Version() *int32 {
+	if c.state == nil {
+		return nil
+	}
+	version := int32(c.state.Version)
+	return &version
+}
+
+// flagChangeObserver passes flag changes on to a subscription. Observers are notified from whatever goroutine made
+// the change, so changes are dropped rather than wait for a subscriber that isn't keeping up.
+type flagChangeObserver struct {
+	projectKey string
+	mu         sync.Mutex
+	closed     bool
+	changes    chan *flagChangeResolver
+}
+
+func (o *flagChangeObserver) Handle(event interface{}) {
+	switch event := event.(type) {
+	case model.OverrideEvent:
+		state := event.FlagState
+		o.send(&flagChangeResolver{projectKey: event.ProjectKey, flagKey: event.FlagKey, state: &state})
+	case model.FlagDeletedEvent:
+		o.send(&flagChangeResolver{projectKey: event.ProjectKey, flagKey: event.FlagKey})
+	case model.SyncEvent:
+		keys := make([]string, 0, len(event.AllFlagsState))
+		for key := range event.AllFlagsState {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			state := event.AllFlagsState[key]
+			o.send(&flagChangeResolver{projectKey: event.ProjectKey, flagKey: key, state: &state})
+		}
+	}
+}
+
+func (o *flagChangeObserver) send(change *flagChangeResolver) {
+	if o.projectKey != "" && change.projectKey != o.projectKey {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return
+	}
+	select {
+	case o.changes <- change:
+	default:
+		logs.Printf(logs.Warn, "", "GraphQL subscriber is not keeping up; dropping the change to %s", change.flagKey)
+	}
+}
+
+func (o *flagChangeObserver) close() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.closed = true
+	close(o.changes)
+}
+
+var _ model.Observer = &flagChangeObserver{}
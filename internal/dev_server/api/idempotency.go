@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// IdempotencyKeyHeader is the request header clients set to retry a request safely. A retry with the same key gets the
+// first attempt's response instead of being handled again.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses that were replayed for a retried request.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// IdempotencyKeys remembers the responses to requests made with an idempotency key, until they expire.
+type IdempotencyKeys struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*idempotentResponse
+}
+
+type idempotentResponse struct {
+	// digest identifies the request, so that a key reused for a different request is caught
+	digest [sha256.Size]byte
+	// done is closed once the first request with the key has been handled
+	done    chan struct{}
+	saved   bool
+	expires time.Time
+	status  int
+	header  http.Header
+	body    []byte
+}
+
+func NewIdempotencyKeys(ttl time.Duration) *IdempotencyKeys {
+	return &IdempotencyKeys{ttl: ttl, entries: make(map[string]*idempotentResponse)}
+}
+
+// Middleware replays the response to requests retried with the same idempotency key. Responses to server errors aren't
+// kept, so those requests are handled again when retried.
+func (k *IdempotencyKeys) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			RequestErrorHandler(w, r, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		digest := requestDigest(r, body)
+
+		for {
+			entry, first := k.claim(key, digest)
+			if first {
+				recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+				defer func() {
+					if err := recover(); err != nil {
+						// let retries through rather than have them wait on a request that's never finishing
+						recorder.status = http.StatusInternalServerError
+						k.finish(key, entry, recorder)
+						panic(err)
+					}
+					k.finish(key, entry, recorder)
+				}()
+				next.ServeHTTP(recorder, r)
+				return
+			}
+			if entry.digest != digest {
+				writeErrorResponse(w, http.StatusUnprocessableEntity, "idempotency_key_reused", "idempotency key was already used for a different request")
+				return
+			}
+			select {
+			case <-entry.done:
+			case <-r.Context().Done():
+				return
+			}
+			if entry.saved {
+				entry.replay(w)
+				return
+			}
+			// the first attempt failed, so this one is handled instead
+		}
+	})
+}
+
+// claim returns the entry for the key, creating it if there isn't one yet, in which case the request is the first with
+// the key and should be handled.
+func (k *IdempotencyKeys) claim(key string, digest [sha256.Size]byte) (*idempotentResponse, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := time.Now()
+	for existingKey, entry := range k.entries {
+		if entry.saved && now.After(entry.expires) {
+			delete(k.entries, existingKey)
+		}
+	}
+	if entry, ok := k.entries[key]; ok {
+		return entry, false
+	}
+	entry := &idempotentResponse{digest: digest, done: make(chan struct{})}
+	k.entries[key] = entry
+	return entry, true
+}
+
+func (k *IdempotencyKeys) finish(key string, entry *idempotentResponse, recorder *responseRecorder) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if recorder.status >= http.StatusInternalServerError {
+		delete(k.entries, key)
+	} else {
+		entry.saved = true
+		entry.expires = time.Now().Add(k.ttl)
+		entry.status = recorder.status
+		entry.header = recorder.Header().Clone()
+		entry.body = recorder.body.Bytes()
+	}
+	close(entry.done)
+}
+
+func (e *idempotentResponse) replay(w http.ResponseWriter) {
+	for name, values := range e.header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(e.status)
+	if _, err := w.Write(e.body); err != nil {
+		logs.Printf(logs.Error, "", "Error while replaying response: %+v", err)
+	}
+}
+
+func requestDigest(r *http.Request, body []byte) [sha256.Size]byte {
+	return sha256.Sum256(append([]byte(r.Method+" "+r.URL.RequestURI()+"\n"), body...))
+}
+
+// responseRecorder keeps a copy of the response it writes.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,76 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	var handled atomic.Int32
+	status := http.StatusCreated
+	handler := api.NewIdempotencyKeys(time.Hour).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := handled.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintf(w, `{"attempt":%d}`, n)
+	}))
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/dev/projects/proj", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(api.IdempotencyKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("replays the response to retries", func(t *testing.T) {
+		handled.Store(0)
+		first := send("retry", `{"sourceEnvironmentKey":"test"}`)
+		retry := send("retry", `{"sourceEnvironmentKey":"test"}`)
+
+		assert.Equal(t, int32(1), handled.Load())
+		assert.Equal(t, http.StatusCreated, retry.Code)
+		assert.Equal(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+		assert.Equal(t, "true", retry.Header().Get(api.IdempotentReplayedHeader))
+		assert.Empty(t, first.Header().Get(api.IdempotentReplayedHeader))
+	})
+
+	t.Run("rejects keys reused for different requests", func(t *testing.T) {
+		send("reused", `{"sourceEnvironmentKey":"test"}`)
+		rec := send("reused", `{"sourceEnvironmentKey":"production"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), "idempotency_key_reused")
+	})
+
+	t.Run("handles retries of server errors again", func(t *testing.T) {
+		handled.Store(0)
+		status = http.StatusInternalServerError
+		send("failing", `{}`)
+		status = http.StatusCreated
+		rec := send("failing", `{}`)
+
+		assert.Equal(t, int32(2), handled.Load())
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("handles requests without a key every time", func(t *testing.T) {
+		handled.Store(0)
+		send("", `{}`)
+		send("", `{}`)
+
+		assert.Equal(t, int32(2), handled.Load())
+	})
+}
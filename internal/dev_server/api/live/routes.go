@@ -0,0 +1,27 @@
+package live
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+// BindRoutes serves the WebSocket API at /dev/ws. Browsers can only connect from the dev server's own pages, or from
+// allowedOrigin if it isn't empty, e.g. the origin allowed with CORS.
+func BindRoutes(router *mux.Router, allowedOrigin string) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			// clients other than browsers, like editor plugins, don't send an origin
+			if origin == "" || allowedOrigin == "*" || origin == allowedOrigin {
+				return true
+			}
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		},
+	}
+	router.HandleFunc("/dev/ws", Handler(upgrader)).Methods(http.MethodGet)
+}
@@ -0,0 +1,228 @@
+// Package live is a WebSocket API for the dev server's UI and editor plugins. It carries the same flag changes SDKs are
+// streamed, and takes override changes over the same connection, so a toggle is one message rather than a request and
+// a refetch.
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// Types of messages the server sends.
+const (
+	// TypeFlag is sent when a flag's value changes, whether from an override, a scenario or a sync.
+	TypeFlag = "flag"
+	// TypeFlagDeleted is sent when a sync finds a flag no longer exists.
+	TypeFlagDeleted = "flagDeleted"
+	// TypeSync is sent when a project's flags are all replaced, e.g. by restoring a backup.
+	TypeSync = "sync"
+	// TypeResult answers a request, with the override it changed.
+	TypeResult = "result"
+	// TypeError answers a request that failed.
+	TypeError = "error"
+)
+
+// Types of messages clients send.
+const (
+	TypeSetOverride    = "setOverride"
+	TypeRemoveOverride = "removeOverride"
+)
+
+// sendBufferSize is how many messages can be waiting to be written to a client. Clients that fall further behind are
+// disconnected, and get current values when they reconnect.
+const sendBufferSize = 100
+
+const pingInterval = 30 * time.Second
+
+// Message is each message sent over the connection. Which fields are set depends on its type.
+type Message struct {
+	Type string `json:"type"`
+	// ID is chosen by the client for its requests, and is repeated in the answer.
+	ID         string           `json:"id,omitempty"`
+	ProjectKey string           `json:"projectKey,omitempty"`
+	FlagKey    string           `json:"flagKey,omitempty"`
+	Value      *ldvalue.Value   `json:"value,omitempty"`
+	State      *model.FlagState `json:"state,omitempty"`
+	Flags      model.FlagsState `json:"flags,omitempty"`
+	Override   *Override        `json:"override,omitempty"`
+	Code       string           `json:"code,omitempty"`
+	Error      string           `json:"message,omitempty"`
+}
+
+// Override is an override as a request to change it left it.
+type Override struct {
+	Active bool          `json:"override"`
+	Value  ldvalue.Value `json:"value"`
+	Locked bool          `json:"locked"`
+}
+
+// Handler upgrades requests to WebSockets. The projectKey query parameter, which can be repeated, limits the changes
+// sent to those projects.
+func Handler(upgrader websocket.Upgrader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader has already responded
+			return
+		}
+		c := &connection{
+			conn:     conn,
+			messages: make(chan Message, sendBufferSize),
+			slow:     make(chan struct{}),
+			projects: make(map[string]bool),
+		}
+		for _, projectKey := range r.URL.Query()["projectKey"] {
+			c.projects[projectKey] = true
+		}
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		observers := model.GetObserversFromContext(ctx)
+		observerId := observers.RegisterObserver(c)
+		defer func() {
+			if !observers.DeregisterObserver(observerId) {
+				logs.Printf(logs.Error, "", "unable to remove observer")
+			}
+		}()
+
+		go c.writeMessages(ctx, cancel)
+		c.readRequests(ctx)
+		_ = conn.Close()
+	}
+}
+
+type connection struct {
+	conn     *websocket.Conn
+	messages chan Message
+	// slow is closed once the client has fallen too far behind, to disconnect it
+	slow      chan struct{}
+	closeSlow sync.Once
+	projects  map[string]bool
+}
+
+// Handle sends flag changes to the client. Observers are notified from whatever goroutine made the change, so this
+// never blocks.
+func (c *connection) Handle(event interface{}) {
+	var msg Message
+	switch event := event.(type) {
+	case model.OverrideEvent:
+		msg = Message{Type: TypeFlag, ProjectKey: event.ProjectKey, FlagKey: event.FlagKey, State: &event.FlagState}
+	case model.FlagDeletedEvent:
+		msg = Message{Type: TypeFlagDeleted, ProjectKey: event.ProjectKey, FlagKey: event.FlagKey}
+	case model.SyncEvent:
+		msg = Message{Type: TypeSync, ProjectKey: event.ProjectKey, Flags: event.AllFlagsState}
+	default:
+		return
+	}
+	if len(c.projects) > 0 && !c.projects[msg.ProjectKey] {
+		return
+	}
+	c.send(msg)
+}
+
+func (c *connection) send(msg Message) {
+	select {
+	case c.messages <- msg:
+	case <-c.slow:
+	default:
+		c.closeSlow.Do(func() {
+			logs.Printf(logs.Warn, "", "WebSocket client is not keeping up; disconnecting it")
+			close(c.slow)
+		})
+	}
+}
+
+func (c *connection) writeMessages(ctx context.Context, cancel context.CancelFunc) {
+	defer cancel()
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		var err error
+		select {
+		case msg := <-c.messages:
+			err = c.conn.WriteJSON(msg)
+		case <-ticker.C:
+			err = c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingInterval))
+		case <-c.slow:
+			_ = c.conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "not keeping up"), time.Now().Add(time.Second))
+			_ = c.conn.Close()
+			return
+		case <-ctx.Done():
+			_ = c.conn.Close()
+			return
+		}
+		if err != nil {
+			_ = c.conn.Close()
+			return
+		}
+	}
+}
+
+// readRequests handles the client's requests until it disconnects.
+func (c *connection) readRequests(ctx context.Context) {
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var request Message
+		if err := json.Unmarshal(data, &request); err != nil {
+			c.send(Message{Type: TypeError, Code: "invalid_request", Error: err.Error()})
+			continue
+		}
+		c.send(handleRequest(ctx, request))
+	}
+}
+
+func handleRequest(ctx context.Context, request Message) Message {
+	if request.ProjectKey == "" || request.FlagKey == "" {
+		return errorMessage(request, "invalid_request", "projectKey and flagKey are required")
+	}
+	var override model.Override
+	var err error
+	switch request.Type {
+	case TypeSetOverride:
+		if request.Value == nil {
+			return errorMessage(request, "invalid_request", "value is required")
+		}
+		override, err = model.UpsertOverride(ctx, request.ProjectKey, request.FlagKey, *request.Value)
+	case TypeRemoveOverride:
+		err = model.DeleteOverride(ctx, request.ProjectKey, request.FlagKey)
+		override = model.Override{Value: ldvalue.Null()}
+	default:
+		return errorMessage(request, "invalid_request", "unknown message type "+request.Type)
+	}
+	if err != nil {
+		switch {
+		case errors.As(err, &model.ErrLocked{}):
+			return errorMessage(request, "locked", err.Error())
+		case errors.As(err, &model.ErrNotFound{}):
+			return errorMessage(request, "not_found", err.Error())
+		default:
+			logs.Printf(logs.Error, "", "WebSocket %s request failed: %+v", request.Type, err)
+			return errorMessage(request, "internal_server_error", err.Error())
+		}
+	}
+	return Message{
+		Type:       TypeResult,
+		ID:         request.ID,
+		ProjectKey: request.ProjectKey,
+		FlagKey:    request.FlagKey,
+		Override:   &Override{Active: override.Active, Value: override.Value, Locked: override.Locked},
+	}
+}
+
+func errorMessage(request Message, code, message string) Message {
+	return Message{Type: TypeError, ID: request.ID, ProjectKey: request.ProjectKey, FlagKey: request.FlagKey, Code: code, Error: message}
+}
@@ -0,0 +1,103 @@
+package live_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api/live"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestWebSocket(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	observers := model.NewObservers()
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, observers)
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{
+		"flag": ldvalue.Bool(false),
+	})))
+	require.NoError(t, model.ImportProject(ctx, "other", model.ImportDataFromValues(map[string]ldvalue.Value{
+		"flag": ldvalue.Bool(false),
+	})))
+
+	router := mux.NewRouter()
+	router.Use(model.StoreMiddleware(store))
+	router.Use(model.ObserversMiddleware(observers))
+	live.BindRoutes(router, "")
+	server := httptest.NewServer(router)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/dev/ws?projectKey=proj"
+
+	dial := func(t *testing.T) *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+	receive := func(t *testing.T, conn *websocket.Conn) live.Message {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		var msg live.Message
+		require.NoError(t, conn.ReadJSON(&msg))
+		return msg
+	}
+
+	t.Run("sets overrides and sends the change", func(t *testing.T) {
+		conn := dial(t)
+		value := ldvalue.Bool(true)
+		require.NoError(t, conn.WriteJSON(live.Message{Type: live.TypeSetOverride, ID: "1", ProjectKey: "proj", FlagKey: "flag", Value: &value}))
+
+		change := receive(t, conn)
+		assert.Equal(t, live.TypeFlag, change.Type)
+		assert.Equal(t, "flag", change.FlagKey)
+		require.NotNil(t, change.State)
+		assert.Equal(t, ldvalue.Bool(true), change.State.Value)
+
+		result := receive(t, conn)
+		assert.Equal(t, live.TypeResult, result.Type)
+		assert.Equal(t, "1", result.ID)
+		assert.Equal(t, &live.Override{Active: true, Value: ldvalue.Bool(true)}, result.Override)
+	})
+
+	t.Run("sends changes made elsewhere", func(t *testing.T) {
+		conn := dial(t)
+		// a change to a project the client isn't interested in is skipped
+		_, err := model.UpsertOverride(ctx, "other", "flag", ldvalue.Bool(true))
+		require.NoError(t, err)
+		require.NoError(t, model.DeleteOverride(ctx, "proj", "flag"))
+
+		change := receive(t, conn)
+		assert.Equal(t, live.TypeFlag, change.Type)
+		assert.Equal(t, "proj", change.ProjectKey)
+		assert.Equal(t, ldvalue.Bool(false), change.State.Value)
+	})
+
+	t.Run("answers requests that fail with errors", func(t *testing.T) {
+		conn := dial(t)
+		require.NoError(t, conn.WriteJSON(live.Message{Type: live.TypeRemoveOverride, ID: "2", ProjectKey: "proj", FlagKey: "missing"}))
+		result := receive(t, conn)
+		assert.Equal(t, live.TypeError, result.Type)
+		assert.Equal(t, "2", result.ID)
+		assert.Equal(t, "not_found", result.Code)
+
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("{")))
+		assert.Equal(t, "invalid_request", receive(t, conn).Code)
+	})
+
+	t.Run("rejects browsers on other origins", func(t *testing.T) {
+		_, res, err := websocket.DefaultDialer.Dial(url, map[string][]string{"Origin": {"http://example.com"}})
+		require.Error(t, err)
+		assert.Equal(t, 403, res.StatusCode)
+	})
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) LockOverride(ctx context.Context, request LockOverrideRequestObject) (LockOverrideResponseObject, error) {
+	override, err := model.SetOverrideLocked(ctx, request.ProjectKey, request.FlagKey, true)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return LockOverride404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return LockOverride200JSONResponse{overrideToResponseFormat(override)}, nil
+}
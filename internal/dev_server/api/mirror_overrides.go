@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) MirrorOverrides(ctx context.Context, request MirrorOverridesRequestObject) (MirrorOverridesResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty flag filter body")
+	}
+	mirrored, err := model.MirrorOverrides(ctx, request.ProjectKey, request.EnvironmentKey, *request.Body)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) || errors.As(err, &adapters.ErrSourceNotFound{}) {
+			return MirrorOverrides404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return MirrorOverrides200JSONResponse{
+		Copied:  mirrored.Copied,
+		Skipped: mirrored.Skipped,
+	}, nil
+}
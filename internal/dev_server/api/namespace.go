@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// namespacedRoutes are the routes that act on the request's namespace's project in place of the one in the path: the
+// ones for reading flag values and changing overrides. Managing the project itself, e.g. syncing or deleting it, acts on
+// the shared project.
+var namespacedRoutes = map[string][]string{
+	"/dev/projects/{projectKey}":                                     {http.MethodGet},
+	"/dev/projects/{projectKey}/file-data-source":                    {http.MethodGet},
+	"/dev/projects/{projectKey}/flag-state":                          {http.MethodGet},
+	"/dev/projects/{projectKey}/flags":                               {http.MethodGet},
+	"/dev/projects/{projectKey}/flags/{flagKey}/explain":             {http.MethodGet},
+	"/dev/projects/{projectKey}/flags/{flagKey}/experiment/simulate": {http.MethodPost},
+	"/dev/projects/{projectKey}/summary":                             {http.MethodGet},
+	"/dev/projects/{projectKey}/schedules":                           {http.MethodGet},
+	"/dev/projects/{projectKey}/scenario":                            {http.MethodPut, http.MethodDelete},
+	"/dev/projects/{projectKey}/triggers":                            {http.MethodGet, http.MethodPost},
+	"/dev/projects/{projectKey}/triggers/{triggerId}":                {http.MethodDelete},
+}
+
+// NamespaceMiddleware points requests with a namespace, see model.ResolveNamespacedProjectKey, at the namespace's
+// project for the one in their path.
+func NamespaceMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := mux.CurrentRoute(r)
+		if model.NamespaceFromContext(r.Context()) == "" || route == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		template, err := route.GetPathTemplate()
+		if err != nil || !isNamespacedRoute(r.Method, template) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		vars := mux.Vars(r)
+		projectKey, err := model.ResolveNamespacedProjectKey(r.Context(), vars["projectKey"])
+		if err != nil {
+			ResponseErrorHandler(w, r, err)
+			return
+		}
+		namespaced := make(map[string]string, len(vars))
+		for name, value := range vars {
+			namespaced[name] = value
+		}
+		namespaced["projectKey"] = projectKey
+		next.ServeHTTP(w, mux.SetURLVars(r, namespaced))
+	})
+}
+
+func isNamespacedRoute(method, template string) bool {
+	if strings.HasPrefix(template, "/dev/projects/{projectKey}/overrides") {
+		return true
+	}
+	for _, namespacedMethod := range namespacedRoutes[template] {
+		if method == namespacedMethod {
+			return true
+		}
+	}
+	return false
+}
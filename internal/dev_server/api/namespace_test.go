@@ -0,0 +1,58 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestNamespaceMiddleware(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{"flag": ldvalue.Bool(true)})))
+
+	var projectKey string
+	router := mux.NewRouter()
+	router.Use(model.StoreMiddleware(store))
+	router.Use(model.ObserversMiddleware(model.NewObservers()))
+	router.Use(model.NamespaceMiddleware(model.HeaderActorResolver(model.NamespaceHeaderDefault)))
+	router.Use(api.NamespaceMiddleware)
+	recordProjectKey := func(w http.ResponseWriter, r *http.Request) {
+		projectKey = mux.Vars(r)["projectKey"]
+	}
+	router.HandleFunc("/dev/projects/{projectKey}", recordProjectKey).Methods(http.MethodGet, http.MethodDelete)
+	router.HandleFunc("/dev/projects/{projectKey}/overrides/{flagKey}", recordProjectKey).Methods(http.MethodPut)
+	router.HandleFunc("/dev/projects/{projectKey}/triggers", recordProjectKey).Methods(http.MethodGet, http.MethodPost)
+	router.HandleFunc("/dev/projects/{projectKey}/triggers/{triggerId}", recordProjectKey).Methods(http.MethodDelete)
+
+	request := func(method, path, namespace string) string {
+		projectKey = ""
+		req := httptest.NewRequest(method, path, nil)
+		if namespace != "" {
+			req.Header.Set(model.NamespaceHeaderDefault, namespace)
+		}
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		return projectKey
+	}
+
+	assert.Equal(t, "proj~alice", request(http.MethodPut, "/dev/projects/proj/overrides/flag", "alice"))
+	assert.Equal(t, "proj~alice", request(http.MethodGet, "/dev/projects/proj", "alice"))
+	assert.Equal(t, "proj", request(http.MethodDelete, "/dev/projects/proj", "alice"), "managing the project acts on the shared one")
+	assert.Equal(t, "proj", request(http.MethodPut, "/dev/projects/proj/overrides/flag", ""))
+	assert.Equal(t, "proj~alice", request(http.MethodPost, "/dev/projects/proj/triggers", "alice"), "triggers override the namespace's flags")
+	assert.Equal(t, "proj~alice", request(http.MethodGet, "/dev/projects/proj/triggers", "alice"))
+	assert.Equal(t, "proj~alice", request(http.MethodDelete, "/dev/projects/proj/triggers/abc", "alice"))
+}
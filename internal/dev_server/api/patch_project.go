@@ -3,12 +3,51 @@ package api
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
 func (s server) PatchProject(ctx context.Context, request PatchProjectRequestObject) (PatchProjectResponseObject, error) {
 	store := model.StoreFromContext(ctx)
-	project, err := model.UpdateProject(ctx, request.ProjectKey, request.Body.Context, request.Body.SourceEnvironmentKey)
+	body := request.JSONBody
+	if body == nil {
+		body = &PatchProjectJSONRequestBody{}
+	}
+	project, err := model.UpdateProject(ctx, request.ProjectKey, body.Context, body.SourceEnvironmentKey, body.FlagFilter)
+	if errors.As(err, &model.ErrUnknownContextKind{}) {
+		return PatchProject400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if errors.As(err, &model.ErrOrphaned{}) {
+		return PatchProject409JSONResponse{
+			Code:    "orphaned",
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.As(err, &model.ErrArchived{}) {
+		return PatchProject409JSONResponse{
+			Code:    "archived",
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.As(err, &model.ErrProjectChanged{}) {
+		return PatchProject409JSONResponse{
+			Code:    "conflict",
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.As(err, &model.ErrLinkedClone{}) {
+		return PatchProject409JSONResponse{
+			Code:    "linked_clone",
+			Message: err.Error(),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -16,11 +55,20 @@ func (s server) PatchProject(ctx context.Context, request PatchProjectRequestObj
 		return PatchProject404Response{}, nil
 	}
 
+	dashboard := model.DashboardFromContext(ctx)
 	response := ProjectJSONResponse{
 		LastSyncedFromSource: project.LastSyncTime.Unix(),
 		Context:              project.Context,
 		SourceEnvironmentKey: project.SourceEnvironmentKey,
 		FlagsState:           &project.AllFlagsState,
+		Orphaned:             orphanedToResponseFormat(project.Orphaned),
+		ArchivedAt:           archivedAtToResponseFormat(project.ArchivedAt),
+		FlagFilter:           flagFilterToResponseFormat(project.FlagFilter),
+		BaseProjectKey:       lo.EmptyableToPtr(project.BaseProjectKey),
+		LinkedClones:         linkedClonesToResponseFormat(project.LinkedCloneKeys),
+		ContextKinds:         contextKindsToResponseFormat(project.ContextKinds),
+		DashboardUrl:         lo.EmptyableToPtr(dashboard.ProjectURL(project)),
+		FlagDashboardUrls:    lo.EmptyableToPtr(dashboard.FlagURLs(project, project.AllFlagsState)),
 	}
 
 	if request.Params.Expand != nil {
@@ -50,6 +98,13 @@ func (s server) PatchProject(ctx context.Context, request PatchProjectRequestObj
 				respAvailableVariations := availableVariationsToResponseFormat(availableVariations)
 				response.AvailableVariations = &respAvailableVariations
 			}
+			if item == "syncStatus" {
+				response.SyncStatus = syncStatusToResponseFormat(project.SyncStatus)
+			}
+			if item == "metadata" {
+				flagMetadata := project.FlagMetadata
+				response.FlagMetadata = &flagMetadata
+			}
 		}
 
 	}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// errPatchTestFailed is returned when a JSON Patch's test operation doesn't match the project, usually because someone
+// else changed it since it was read.
+var errPatchTestFailed = errors.New("project doesn't match the patch's test operation")
+
+const jsonPatchContentType = "application/json-patch+json"
+
+// JSONPatchMiddleware turns JSON Patches sent to PATCH /dev/projects/{projectKey} into the changes they make, so that
+// the handler gets the same body it does for a partial update. It has to come before the generated handler, which would
+// otherwise decode the patch as a partial update, since its content type starts with application/json.
+func JSONPatchMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := mux.CurrentRoute(r)
+		if r.Method != http.MethodPatch || !strings.HasPrefix(r.Header.Get("Content-Type"), jsonPatchContentType) || route == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if template, err := route.GetPathTemplate(); err != nil || template != "/dev/projects/{projectKey}" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		patch, err := io.ReadAll(r.Body)
+		if err != nil {
+			RequestErrorHandler(w, r, err)
+			return
+		}
+		changes, precondition, err := applyProjectPatch(r.Context(), mux.Vars(r)["projectKey"], patch)
+		switch {
+		case errors.As(err, &model.ErrNotFound{}):
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case errors.Is(err, errPatchTestFailed):
+			writeErrorResponse(w, http.StatusConflict, "conflict", err.Error())
+			return
+		case err != nil:
+			writeErrorResponse(w, http.StatusBadRequest, "invalid_request", err.Error())
+			return
+		}
+		body, err := json.Marshal(changes)
+		if err != nil {
+			ResponseErrorHandler(w, r, err)
+			return
+		}
+		r = r.WithContext(model.ContextWithProjectPrecondition(r.Context(), precondition))
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.Header.Set("Content-Type", "application/json")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// applyProjectPatch applies a JSON Patch to the project's settings as PATCH takes them, returning a body with the
+// settings the patch changed. Since the patch, and any test operations in it, applied to the settings as they were
+// read, it also returns a precondition that they haven't changed since, for the update to check as it writes them.
+func applyProjectPatch(ctx context.Context, projectKey string, patch JSONPatch) (PatchProjectJSONRequestBody, model.ProjectPrecondition, error) {
+	project, err := model.StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err != nil {
+		return PatchProjectJSONRequestBody{}, nil, err
+	}
+	original := PatchProjectJSONRequestBody{
+		SourceEnvironmentKey: &project.SourceEnvironmentKey,
+		Context:              &project.Context,
+		FlagFilter:           &project.FlagFilter,
+	}
+	document, err := json.Marshal(original)
+	if err != nil {
+		return PatchProjectJSONRequestBody{}, nil, err
+	}
+
+	decoded, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return PatchProjectJSONRequestBody{}, nil, errors.Wrap(err, "invalid JSON Patch")
+	}
+	patchedDocument, err := decoded.Apply(document)
+	if errors.Is(err, jsonpatch.ErrTestFailed) {
+		return PatchProjectJSONRequestBody{}, nil, errPatchTestFailed
+	}
+	if err != nil {
+		return PatchProjectJSONRequestBody{}, nil, errors.Wrap(err, "unable to apply JSON Patch")
+	}
+	var patched PatchProjectJSONRequestBody
+	if err := json.Unmarshal(patchedDocument, &patched); err != nil {
+		return PatchProjectJSONRequestBody{}, nil, errors.Wrap(err, "invalid project after applying JSON Patch")
+	}
+
+	// only pass on what changed, so that e.g. a patch that only tests forces a sync like an empty body does
+	var changes PatchProjectJSONRequestBody
+	if patched.SourceEnvironmentKey != nil && *patched.SourceEnvironmentKey != project.SourceEnvironmentKey {
+		changes.SourceEnvironmentKey = patched.SourceEnvironmentKey
+	}
+	if patched.Context != nil && !sameJSON(*patched.Context, project.Context) {
+		changes.Context = patched.Context
+	}
+	if patched.FlagFilter == nil {
+		patched.FlagFilter = &model.FlagFilter{}
+	}
+	if !sameJSON(*patched.FlagFilter, project.FlagFilter) {
+		changes.FlagFilter = patched.FlagFilter
+	}
+	unchanged := func(current model.Project) bool {
+		return current.SourceEnvironmentKey == project.SourceEnvironmentKey &&
+			sameJSON(current.Context, project.Context) &&
+			sameJSON(current.FlagFilter, project.FlagFilter)
+	}
+	return changes, unchanged, nil
+}
+
+func sameJSON(a, b interface{}) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestJSONPatchMiddleware(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{"flag": ldvalue.Bool(true)})))
+	project, err := store.GetDevProject(ctx, "proj")
+	require.NoError(t, err)
+	project.SourceEnvironmentKey = "test"
+	_, err = store.UpdateProject(ctx, *project)
+	require.NoError(t, err)
+
+	var received string
+	router := mux.NewRouter()
+	router.Use(model.StoreMiddleware(store))
+	router.Use(api.JSONPatchMiddleware)
+	router.HandleFunc("/dev/projects/{projectKey}", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = r.Header.Get("Content-Type") + " " + string(body)
+	}).Methods(http.MethodPatch)
+
+	patch := func(projectKey, contentType, body string) *httptest.ResponseRecorder {
+		received = ""
+		req := httptest.NewRequest(http.MethodPatch, "/dev/projects/"+projectKey, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("passes on only what a patch changes", func(t *testing.T) {
+		rec := patch("proj", "application/json-patch+json", `[
+			{"op": "test", "path": "/sourceEnvironmentKey", "value": "test"},
+			{"op": "replace", "path": "/sourceEnvironmentKey", "value": "production"},
+			{"op": "add", "path": "/flagFilter/keys", "value": ["flag"]}
+		]`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"sourceEnvironmentKey": "production", "flagFilter": {"keys": ["flag"]}}`, strings.TrimPrefix(received, "application/json "))
+	})
+
+	t.Run("rejects patches whose tests fail", func(t *testing.T) {
+		rec := patch("proj", "application/json-patch+json", `[
+			{"op": "test", "path": "/sourceEnvironmentKey", "value": "production"},
+			{"op": "replace", "path": "/sourceEnvironmentKey", "value": "staging"}
+		]`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Empty(t, received)
+	})
+
+	t.Run("rejects invalid patches", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, patch("proj", "application/json-patch+json", `{"op": "replace"}`).Code)
+		assert.Equal(t, http.StatusBadRequest, patch("proj", "application/json-patch+json", `[{"op": "remove", "path": "/missing"}]`).Code)
+		assert.Empty(t, received)
+	})
+
+	t.Run("responds not found for missing projects", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, patch("missing", "application/json-patch+json", `[]`).Code)
+	})
+
+	t.Run("leaves partial updates alone", func(t *testing.T) {
+		patch("proj", "application/json", `{"sourceEnvironmentKey": "production"}`)
+		assert.Equal(t, `application/json {"sourceEnvironmentKey": "production"}`, received)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
@@ -19,22 +20,41 @@ func (s server) PostAddProject(ctx context.Context, request PostAddProjectReques
 	}
 
 	store := model.StoreFromContext(ctx)
-	project, err := model.CreateProject(ctx, request.ProjectKey, request.Body.SourceEnvironmentKey, request.Body.Context)
+	project, err := model.CreateProject(ctx, request.ProjectKey, request.Body.SourceEnvironmentKey, request.Body.Context, lo.FromPtr(request.Body.FlagFilter))
 	switch {
 	case errors.As(err, &model.ErrAlreadyExists{}):
 		return PostAddProject409JSONResponse{
 			Code:    "conflict",
 			Message: err.Error(),
 		}, nil
+	case errors.As(err, &model.ErrUnknownContextKind{}):
+		return PostAddProject400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			},
+		}, nil
 	case err != nil:
 		return nil, err
 	}
+	if request.Body.PrefetchKeys != nil && *request.Body.PrefetchKeys {
+		model.PrefetchEnvironmentKeysInBackground(ctx, project.Key)
+	}
 
+	dashboard := model.DashboardFromContext(ctx)
 	response := ProjectJSONResponse{
 		LastSyncedFromSource: project.LastSyncTime.Unix(),
 		Context:              project.Context,
 		SourceEnvironmentKey: project.SourceEnvironmentKey,
 		FlagsState:           &project.AllFlagsState,
+		Orphaned:             orphanedToResponseFormat(project.Orphaned),
+		ArchivedAt:           archivedAtToResponseFormat(project.ArchivedAt),
+		FlagFilter:           flagFilterToResponseFormat(project.FlagFilter),
+		BaseProjectKey:       lo.EmptyableToPtr(project.BaseProjectKey),
+		LinkedClones:         linkedClonesToResponseFormat(project.LinkedCloneKeys),
+		ContextKinds:         contextKindsToResponseFormat(project.ContextKinds),
+		DashboardUrl:         lo.EmptyableToPtr(dashboard.ProjectURL(project)),
+		FlagDashboardUrls:    lo.EmptyableToPtr(dashboard.FlagURLs(project, project.AllFlagsState)),
 	}
 
 	if request.Params.Expand != nil {
@@ -64,6 +84,13 @@ func (s server) PostAddProject(ctx context.Context, request PostAddProjectReques
 				respAvailableVariations := availableVariationsToResponseFormat(availableVariations)
 				response.AvailableVariations = &respAvailableVariations
 			}
+			if item == "syncStatus" {
+				response.SyncStatus = syncStatusToResponseFormat(project.SyncStatus)
+			}
+			if item == "metadata" {
+				flagMetadata := project.FlagMetadata
+				response.FlagMetadata = &flagMetadata
+			}
 		}
 
 	}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PostAlias(ctx context.Context, request PostAliasRequestObject) (PostAliasResponseObject, error) {
+	if request.Body == nil || request.Body.Alias == "" || request.Body.ProjectKey == "" {
+		return PostAlias400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_request",
+			Message: "alias and projectKey are required",
+		}}, nil
+	}
+	err := model.CreateAlias(ctx, model.Alias{
+		Alias:      request.Body.Alias,
+		ProjectKey: request.Body.ProjectKey,
+	})
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PostAlias400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return PostAlias201JSONResponse(*request.Body), nil
+}
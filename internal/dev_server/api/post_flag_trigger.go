@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PostFlagTrigger(ctx context.Context, request PostFlagTriggerRequestObject) (PostFlagTriggerResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty flag trigger body")
+	}
+	trigger, err := model.CreateFlagTrigger(ctx, request.ProjectKey, request.Body.FlagKey, model.TriggerAction(request.Body.Action))
+	if err != nil {
+		if errors.As(err, &model.ErrInvalidTrigger{}) {
+			return PostFlagTrigger400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PostFlagTrigger404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return PostFlagTrigger201JSONResponse(flagTriggerToResponseFormat(trigger)), nil
+}
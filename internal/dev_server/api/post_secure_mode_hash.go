@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
+)
+
+func (s server) PostSecureModeHash(ctx context.Context, request PostSecureModeHashRequestObject) (PostSecureModeHashResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty context body")
+	}
+	secret := sdk.GetSecureModeSecretFromContext(ctx)
+	if secret == "" {
+		return PostSecureModeHash400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: "secure mode is not enabled. Start the dev server with --secure-mode-secret to enable it",
+			},
+		}, nil
+	}
+	return PostSecureModeHash200JSONResponse{
+		Hash: sdk.SecureModeHash(secret, *request.Body),
+	}, nil
+}
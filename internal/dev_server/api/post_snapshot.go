@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PostSnapshot(ctx context.Context, request PostSnapshotRequestObject) (PostSnapshotResponseObject, error) {
+	snapshot, err := model.TakeSnapshot(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PostSnapshot404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return PostSnapshot200JSONResponse(snapshotToResponseFormat(snapshot)), nil
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PurgeProject(ctx context.Context, request PurgeProjectRequestObject) (PurgeProjectResponseObject, error) {
+	deletion, err := model.PurgeProject(ctx, request.ProjectKey)
+	switch {
+	case errors.As(err, &model.ErrNotFound{}):
+		return PurgeProject404JSONResponse{ErrorResponseJSONResponse{
+			Code:    "not_found",
+			Message: "project not found",
+		}}, nil
+	case errors.As(err, &model.ErrNotArchived{}):
+		return PurgeProject409JSONResponse{
+			Code:    "not_archived",
+			Message: err.Error(),
+		}, nil
+	case errors.As(err, &model.ErrHasLinkedClones{}):
+		return PurgeProject409JSONResponse{
+			Code:    "has_linked_clones",
+			Message: err.Error(),
+		}, nil
+	case err != nil:
+		return nil, err
+	}
+	return PurgeProject200JSONResponse{projectDeletionToResponseFormat(deletion)}, nil
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+func (s server) PutAccessToken(ctx context.Context, request PutAccessTokenRequestObject) (PutAccessTokenResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty access token body")
+	}
+	accessToken := adapters.GetAccessToken(ctx)
+	if accessToken == nil || !accessToken.Matches(request.Params.Authorization) {
+		return PutAccessToken401JSONResponse{
+			Code:    "unauthorized",
+			Message: "authorize the request with the access token the dev server is currently using",
+		}, nil
+	}
+	newToken := strings.TrimSpace(request.Body.AccessToken)
+	if newToken == "" {
+		return PutAccessToken400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: "accessToken is required",
+			},
+		}, nil
+	}
+	if accessToken.Rotate(newToken) {
+		logs.Printf(logs.Info, "", "Access token rotated")
+	}
+	return PutAccessToken204Response{}, nil
+}
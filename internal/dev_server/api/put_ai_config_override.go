@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutAIConfigOverride(ctx context.Context, request PutAIConfigOverrideRequestObject) (PutAIConfigOverrideResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty AI Config override body")
+	}
+	aiConfigOverride := model.AIConfigOverride{
+		VariationKey: lo.FromPtr(request.Body.VariationKey),
+		Model:        request.Body.Model,
+	}
+	if request.Body.Messages != nil {
+		aiConfigOverride.Messages = lo.Map(*request.Body.Messages, func(message AIConfigMessage, _ int) adapters.AIConfigMessage {
+			return adapters.AIConfigMessage{Role: message.Role, Content: message.Content}
+		})
+	}
+	override, err := model.OverrideAIConfig(ctx, request.ProjectKey, request.FlagKey, aiConfigOverride)
+	if err != nil {
+		if errors.As(err, &model.ErrLocked{}) {
+			return PutAIConfigOverride409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutAIConfigOverride404JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "not_found",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	return PutAIConfigOverride200JSONResponse{overrideToResponseFormat(override)}, nil
+}
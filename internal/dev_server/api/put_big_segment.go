@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutBigSegment(ctx context.Context, request PutBigSegmentRequestObject) (PutBigSegmentResponseObject, error) {
+	if request.Body == nil {
+		return PutBigSegment400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_request",
+			Message: "body is required",
+		}}, nil
+	}
+	store := model.StoreFromContext(ctx)
+	_, err := store.GetDevProject(ctx, request.ProjectKey)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutBigSegment404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	var membership model.BigSegmentMembership
+	if request.Body.Included != nil {
+		membership.Included = *request.Body.Included
+	}
+	if request.Body.Excluded != nil {
+		membership.Excluded = *request.Body.Excluded
+	}
+	model.BigSegmentsFromContext(ctx).SetMembership(request.ProjectKey, request.SegmentKey, membership)
+	model.ResyncForBigSegments(ctx, request.ProjectKey)
+	return PutBigSegment200JSONResponse(bigSegmentMembershipFromModel(membership)), nil
+}
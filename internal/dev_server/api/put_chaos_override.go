@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutChaosOverride(ctx context.Context, request PutChaosOverrideRequestObject) (PutChaosOverrideResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty chaos body")
+	}
+	override, err := model.UpsertChaosOverride(ctx, request.ProjectKey, request.FlagKey, model.Chaos{
+		Seed:       lo.FromPtr(request.Body.Seed),
+		PerContext: lo.FromPtr(request.Body.PerContext),
+	})
+	if err != nil {
+		if errors.As(err, &model.ErrLocked{}) {
+			return PutChaosOverride409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutChaosOverride404JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "not_found",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	return PutChaosOverride200JSONResponse{overrideToResponseFormat(override)}, nil
+}
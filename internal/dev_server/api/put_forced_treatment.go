@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutForcedTreatment(ctx context.Context, request PutForcedTreatmentRequestObject) (PutForcedTreatmentResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty forced treatment body")
+	}
+	override, err := model.ForceTreatment(ctx, request.ProjectKey, request.FlagKey, forcedTreatmentFromRequestFormat(*request.Body))
+	if err != nil {
+		if errors.As(err, &model.ErrLocked{}) {
+			return PutForcedTreatment409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutForcedTreatment404JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "not_found",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	return PutForcedTreatment200JSONResponse{overrideToResponseFormat(override)}, nil
+}
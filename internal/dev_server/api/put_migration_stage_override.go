@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutMigrationStageOverride(ctx context.Context, request PutMigrationStageOverrideRequestObject) (PutMigrationStageOverrideResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty stage body")
+	}
+	override, err := model.PinMigrationStage(ctx, request.ProjectKey, request.FlagKey, string(request.Body.Stage))
+	if err != nil {
+		if errors.As(err, &model.ErrInvalidMigrationStage{}) {
+			return PutMigrationStageOverride400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_request",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.As(err, &model.ErrLocked{}) {
+			return PutMigrationStageOverride409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutMigrationStageOverride404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return PutMigrationStageOverride200JSONResponse{overrideToResponseFormat(override)}, nil
+}
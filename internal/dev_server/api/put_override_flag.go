@@ -14,6 +14,20 @@ func (s server) PutOverrideFlag(ctx context.Context, request PutOverrideFlagRequ
 	}
 	override, err := model.UpsertOverride(ctx, request.ProjectKey, request.FlagKey, *request.Body)
 	if err != nil {
+		if errors.As(err, &model.ErrInvalidMigrationStage{}) {
+			return PutOverrideFlag400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_request",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.As(err, &model.ErrLocked{}) {
+			return PutOverrideFlag409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
 		if errors.As(err, &model.ErrNotFound{}) {
 			return PutOverrideFlag400JSONResponse{
 				ErrorResponseJSONResponse{
@@ -24,8 +38,5 @@ func (s server) PutOverrideFlag(ctx context.Context, request PutOverrideFlagRequ
 		}
 		return nil, err
 	}
-	return PutOverrideFlag200JSONResponse{FlagOverrideJSONResponse{
-		Override: override.Active,
-		Value:    override.Value,
-	}}, nil
+	return PutOverrideFlag200JSONResponse{overrideToResponseFormat(override)}, nil
 }
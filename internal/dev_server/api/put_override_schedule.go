@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutOverrideSchedule(ctx context.Context, request PutOverrideScheduleRequestObject) (PutOverrideScheduleResponseObject, error) {
+	body := request.Body
+	if body == nil || (body.ActivateAt == nil && body.DeactivateAt == nil) {
+		return PutOverrideSchedule400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_request",
+			Message: "activateAt or deactivateAt is required",
+		}}, nil
+	}
+	if body.ActivateAt != nil && body.Value == nil {
+		return PutOverrideSchedule400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_request",
+			Message: "value is required to schedule an activation",
+		}}, nil
+	}
+	if body.ActivateAt != nil && body.DeactivateAt != nil && !body.DeactivateAt.After(*body.ActivateAt) {
+		return PutOverrideSchedule400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_request",
+			Message: "deactivateAt must be after activateAt",
+		}}, nil
+	}
+	value := ldvalue.Null()
+	if body.Value != nil {
+		value = *body.Value
+	}
+
+	schedule, err := model.ScheduleOverride(ctx, model.OverrideSchedule{
+		ProjectKey:   request.ProjectKey,
+		FlagKey:      request.FlagKey,
+		Value:        value,
+		ActivateAt:   body.ActivateAt,
+		DeactivateAt: body.DeactivateAt,
+	})
+	if err != nil {
+		if errors.As(err, &model.ErrInvalidMigrationStage{}) {
+			return PutOverrideSchedule400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutOverrideSchedule404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return PutOverrideSchedule200JSONResponse(overrideScheduleToResponseFormat(schedule)), nil
+}
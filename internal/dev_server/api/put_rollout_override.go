@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutRolloutOverride(ctx context.Context, request PutRolloutOverrideRequestObject) (PutRolloutOverrideResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty rollout body")
+	}
+	rollout := rolloutFromRequestFormat(*request.Body)
+	if err := rollout.Validate(); err != nil {
+		return PutRolloutOverride400JSONResponse{
+			ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	override, err := model.UpsertRolloutOverride(ctx, request.ProjectKey, request.FlagKey, rollout)
+	if err != nil {
+		if errors.As(err, &model.ErrInvalidMigrationStage{}) {
+			return PutRolloutOverride400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_request",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.As(err, &model.ErrLocked{}) {
+			return PutRolloutOverride409JSONResponse{
+				Code:    "locked",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutRolloutOverride404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return PutRolloutOverride200JSONResponse{overrideToResponseFormat(override)}, nil
+}
+
+func rolloutFromRequestFormat(rollout Rollout) model.Rollout {
+	result := model.Rollout{
+		Variations: make([]model.WeightedValue, 0, len(rollout.Variations)),
+	}
+	if rollout.ContextKind != nil {
+		result.ContextKind = *rollout.ContextKind
+	}
+	if rollout.BucketBy != nil {
+		result.BucketBy = *rollout.BucketBy
+	}
+	if rollout.Seed != nil {
+		result.Seed = *rollout.Seed
+	}
+	if rollout.PerEvaluation != nil {
+		result.PerEvaluation = *rollout.PerEvaluation
+	}
+	for _, variation := range rollout.Variations {
+		result.Variations = append(result.Variations, model.WeightedValue{
+			Value: variation.Value,
+			// percentages are kept to a thousandth of a percent, like LaunchDarkly rollouts
+			Weight: int(math.Round(variation.Percent * model.RolloutTotalWeight / 100)),
+		})
+	}
+	if rollout.ForcedTreatments != nil {
+		for _, forced := range *rollout.ForcedTreatments {
+			result.ForcedTreatments = append(result.ForcedTreatments, forcedTreatmentFromRequestFormat(forced))
+		}
+	}
+	return result
+}
+
+func forcedTreatmentFromRequestFormat(forced ForcedTreatment) model.ForcedTreatment {
+	return model.ForcedTreatment{
+		ContextKind: lo.FromPtr(forced.ContextKind),
+		ContextKey:  forced.ContextKey,
+		Variation:   forced.Variation,
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutSavedContext(ctx context.Context, request PutSavedContextRequestObject) (PutSavedContextResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty saved context body")
+	}
+	savedContext, err := model.SaveContext(ctx, model.SavedContext{
+		ProjectKey: request.ProjectKey,
+		Name:       request.ContextName,
+		Context:    *request.Body,
+	})
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return PutSavedContext404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.As(err, &model.ErrUnknownContextKind{}) || request.Body.Err() != nil {
+			return PutSavedContext400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_request",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	return PutSavedContext200JSONResponse(savedContextToResponseFormat(savedContext)), nil
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) PutScenario(ctx context.Context, request PutScenarioRequestObject) (PutScenarioResponseObject, error) {
+	if request.Body == nil {
+		return PutScenario400JSONResponse{ErrorResponseJSONResponse{
+			Code:    "invalid_request",
+			Message: "body is required",
+		}}, nil
+	}
+	err := model.ApplyScenario(ctx, request.ProjectKey, request.Body.Overrides)
+	if err != nil {
+		var notFound model.ErrNotFound
+		if errors.As(err, &notFound) {
+			if notFound.Kind() == "project" {
+				return PutScenario404JSONResponse{
+					Code:    "not_found",
+					Message: err.Error(),
+				}, nil
+			}
+			return PutScenario400JSONResponse{ErrorResponseJSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return PutScenario204Response{}, nil
+}
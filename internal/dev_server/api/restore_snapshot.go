@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) RestoreSnapshot(ctx context.Context, request RestoreSnapshotRequestObject) (RestoreSnapshotResponseObject, error) {
+	restore, err := model.RestoreSnapshot(ctx, request.ProjectKey, request.SnapshotId)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return RestoreSnapshot404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return RestoreSnapshot200JSONResponse{
+		Snapshot: snapshotToResponseFormat(restore.Snapshot),
+		Skipped:  restore.Skipped,
+	}, nil
+}
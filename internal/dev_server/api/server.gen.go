@@ -9,37 +9,172 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldreason"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+// Defines values for EvaluationSource.
+const (
+	Cloud    EvaluationSource = "cloud"
+	Fallback EvaluationSource = "fallback"
+	Override EvaluationSource = "override"
+)
+
+// Defines values for LogLevel.
+const (
+	LogLevelDebug LogLevel = "debug"
+	LogLevelError LogLevel = "error"
+	LogLevelInfo  LogLevel = "info"
+	LogLevelWarn  LogLevel = "warn"
+)
+
+// Defines values for MigrationStage.
+const (
+	MigrationStageComplete  MigrationStage = "complete"
+	MigrationStageDualwrite MigrationStage = "dualwrite"
+	MigrationStageLive      MigrationStage = "live"
+	MigrationStageOff       MigrationStage = "off"
+	MigrationStageRampdown  MigrationStage = "rampdown"
+	MigrationStageShadow    MigrationStage = "shadow"
+)
+
+// Defines values for SyncStatusResult.
+const (
+	SyncResultError   SyncStatusResult = "error"
+	SyncResultSuccess SyncStatusResult = "success"
+)
+
+// Defines values for TriggerAction.
+const (
+	TurnFlagOff TriggerAction = "turnFlagOff"
+	TurnFlagOn  TriggerAction = "turnFlagOn"
+)
+
 // Defines values for GetProjectParamsExpand.
 const (
 	GetProjectParamsExpandAvailableVariations GetProjectParamsExpand = "availableVariations"
+	GetProjectParamsExpandMetadata            GetProjectParamsExpand = "metadata"
 	GetProjectParamsExpandOverrides           GetProjectParamsExpand = "overrides"
+	GetProjectParamsExpandSyncStatus          GetProjectParamsExpand = "syncStatus"
+)
+
+// Defines values for GetProjectParamsFields.
+const (
+	GetProjectParamsFieldsArchived    GetProjectParamsFields = "archived"
+	GetProjectParamsFieldsRollout     GetProjectParamsFields = "rollout"
+	GetProjectParamsFieldsTrackEvents GetProjectParamsFields = "trackEvents"
+	GetProjectParamsFieldsValue       GetProjectParamsFields = "value"
+	GetProjectParamsFieldsVersion     GetProjectParamsFields = "version"
 )
 
 // Defines values for PatchProjectParamsExpand.
 const (
 	PatchProjectParamsExpandAvailableVariations PatchProjectParamsExpand = "availableVariations"
+	PatchProjectParamsExpandMetadata            PatchProjectParamsExpand = "metadata"
 	PatchProjectParamsExpandOverrides           PatchProjectParamsExpand = "overrides"
+	PatchProjectParamsExpandSyncStatus          PatchProjectParamsExpand = "syncStatus"
 )
 
 // Defines values for PostAddProjectParamsExpand.
 const (
-	AvailableVariations PostAddProjectParamsExpand = "availableVariations"
-	Overrides           PostAddProjectParamsExpand = "overrides"
+	PostAddProjectParamsExpandAvailableVariations PostAddProjectParamsExpand = "availableVariations"
+	PostAddProjectParamsExpandMetadata            PostAddProjectParamsExpand = "metadata"
+	PostAddProjectParamsExpandOverrides           PostAddProjectParamsExpand = "overrides"
+	PostAddProjectParamsExpandSyncStatus          PostAddProjectParamsExpand = "syncStatus"
+)
+
+// Defines values for GetProjectFlagStateParamsFields.
+const (
+	GetProjectFlagStateParamsFieldsArchived    GetProjectFlagStateParamsFields = "archived"
+	GetProjectFlagStateParamsFieldsRollout     GetProjectFlagStateParamsFields = "rollout"
+	GetProjectFlagStateParamsFieldsTrackEvents GetProjectFlagStateParamsFields = "trackEvents"
+	GetProjectFlagStateParamsFieldsValue       GetProjectFlagStateParamsFields = "value"
+	GetProjectFlagStateParamsFieldsVersion     GetProjectFlagStateParamsFields = "version"
 )
 
+// AIConfigMessage defines model for AIConfigMessage.
+type AIConfigMessage struct {
+	Content string `json:"content"`
+
+	// Role e.g. system, user, or assistant
+	Role string `json:"role"`
+}
+
+// AIConfigOverride defines model for AIConfigOverride.
+type AIConfigOverride struct {
+	// Messages replace the prompt messages
+	Messages *[]AIConfigMessage `json:"messages,omitempty"`
+
+	// Model value of a feature flag variation
+	Model *FlagValue `json:"model,omitempty"`
+
+	// VariationKey key of the variation to serve. Defaults to the AI Config's current value
+	VariationKey *string `json:"variationKey,omitempty"`
+}
+
+// Alias SDK credential that should be treated as a dev project key
+type Alias struct {
+	// Alias credential sent by the SDK, such as an SDK key, mobile key, or client-side ID
+	Alias string `json:"alias"`
+
+	// ProjectKey dev project to serve for the credential
+	ProjectKey string `json:"projectKey"`
+}
+
+// AppliedOverride the override that replaced a flag's source value
+type AppliedOverride struct {
+	// Layer what produced a flag's effective value
+	Layer OverrideLayer `json:"layer"`
+
+	// Rollout a percentage split of a flag between values
+	Rollout *Rollout `json:"rollout,omitempty"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
+// AuditEntry a change to one of a project's overrides
+type AuditEntry struct {
+	// Actor who made the change. Empty if they couldn't be identified
+	Actor   string `json:"actor"`
+	FlagKey string `json:"flagKey"`
+
+	// Layer what produced a flag's effective value
+	Layer OverrideLayer `json:"layer"`
+
+	// Locked only set on changes that locked (true) or unlocked (false) the override
+	Locked *bool `json:"locked,omitempty"`
+
+	// Override whether the override was active after the change. False means it was removed
+	Override   bool      `json:"override"`
+	RecordedAt time.Time `json:"recordedAt"`
+
+	// Value value of a feature flag variation
+	Value   FlagValue `json:"value"`
+	Version int       `json:"version"`
+}
+
+// BigSegmentMembership context keys that are explicitly part of a big segment
+type BigSegmentMembership struct {
+	Excluded *[]string `json:"excluded,omitempty"`
+	Included *[]string `json:"included,omitempty"`
+}
+
 // Context context object to use when evaluating flags in source environment
 type Context = ldcontext.Context
 
+// ContextKind a context kind configured for a project in LaunchDarkly
+type ContextKind = model.ContextKind
+
 // DebugSession Debug session with event count
 type DebugSession struct {
 	// EventCount number of events associated with this debug session
@@ -70,6 +205,32 @@ type Environment struct {
 	Name string `json:"name"`
 }
 
+// EvaluationReason LaunchDarkly's evaluation reason, e.g. {"kind":"RULE_MATCH","ruleIndex":0,"ruleId":"..."}
+type EvaluationReason = ldreason.EvaluationReason
+
+// EvaluationRequest an evaluation of a flag that an SDK reported in its events
+type EvaluationRequest struct {
+	// Context context object to use when evaluating flags in source environment
+	Context *Context `json:"context,omitempty"`
+
+	// Count how many times the flag was evaluated to the value
+	Count   int64  `json:"count"`
+	FlagKey string `json:"flagKey"`
+
+	// Id sequence number of the evaluation. Increases with every evaluation recorded
+	Id int64 `json:"id"`
+
+	// Source where the value came from. override is an override in any layer, cloud is the value synced from LaunchDarkly, and fallback is the SDK's default value for a flag the project doesn't have
+	Source EvaluationSource `json:"source"`
+	Time   time.Time        `json:"time"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
+// EvaluationSource where the value came from. override is an override in any layer, cloud is the value synced from LaunchDarkly, and fallback is the SDK's default value for a flag the project doesn't have
+type EvaluationSource string
+
 // Event A stored event with metadata
 type Event struct {
 	// Data raw event data as JSON
@@ -97,30 +258,360 @@ type EventsPage struct {
 	TotalCount int64 `json:"total_count"`
 }
 
+// ExperimentAssignment the treatment a context gets
+type ExperimentAssignment struct {
+	// Bucket where the context hashed to, from 0 up to 1. It picks the variation unless the context is forced into one
+	Bucket float32 `json:"bucket"`
+
+	// Context context object to use when evaluating flags in source environment
+	Context Context `json:"context"`
+	Forced  bool    `json:"forced"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+
+	// Variation the index of the rollout's variation the context gets
+	Variation int `json:"variation"`
+}
+
+// ExperimentSimulation how a rollout allocates contexts between an experiment's treatments
+type ExperimentSimulation struct {
+	Assignments []ExperimentAssignment `json:"assignments"`
+
+	// Counts how many of the contexts each of the rollout's variations got
+	Counts []int `json:"counts"`
+
+	// Rollout a percentage split of a flag between values
+	Rollout Rollout `json:"rollout"`
+}
+
+// FlagExplanation how the dev server arrived at the value it serves a context for a flag
+type FlagExplanation struct {
+	// Context context object to use when evaluating flags in source environment
+	Context Context `json:"context"`
+	FlagKey string  `json:"flagKey"`
+
+	// Layer what produced a flag's effective value
+	Layer OverrideLayer `json:"layer"`
+
+	// Override the override that replaced a flag's source value
+	Override *AppliedOverride `json:"override,omitempty"`
+
+	// Prerequisites how the flags LaunchDarkly checked before evaluating this one turned out. Overriding a prerequisite doesn't change the value of the flags that depend on it
+	Prerequisites *[]PrerequisiteOutcome `json:"prerequisites,omitempty"`
+
+	// Reason why the value is served
+	Reason string `json:"reason"`
+
+	// Source what LaunchDarkly evaluated a flag to for the project's context when the project was last synced. It's the same for every context, since the dev server doesn't re-evaluate flags itself
+	Source SourceEvaluation `json:"source"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
+// FlagFilter limits which flags are synced from the source environment and served. A flag is included if it matches any of keys, keyPrefixes, or tags. An empty filter includes every flag.
+type FlagFilter = model.FlagFilter
+
+// FlagKind the type of a flag's variations
+type FlagKind = model.FlagKind
+
+// FlagMetadata what LaunchDarkly says about a flag besides its variations, synced along with it
+type FlagMetadata = model.FlagMetadata
+
+// FlagTrigger a local version of a LaunchDarkly flag trigger, which automation can POST to without credentials to turn a boolean flag on or off
+type FlagTrigger struct {
+	// Action what firing a trigger does to its flag
+	Action    TriggerAction `json:"action"`
+	CreatedAt time.Time     `json:"createdAt"`
+	FlagKey   string        `json:"flagKey"`
+	Id        string        `json:"id"`
+
+	// Path the path on the dev server to POST to, to fire the trigger
+	Path string `json:"path"`
+}
+
+// FlagUsage how many times SDKs reported evaluating a flag
+type FlagUsage struct {
+	Evaluations int64 `json:"evaluations"`
+
+	// FallbackEvaluations how many of the evaluations got the SDK's default value, as they do for flags the project doesn't have
+	FallbackEvaluations int64  `json:"fallbackEvaluations"`
+	FlagKey             string `json:"flagKey"`
+
+	// InProject whether the project has the flag
+	InProject bool `json:"inProject"`
+
+	// LastEvaluated left out if the flag wasn't evaluated
+	LastEvaluated *time.Time `json:"lastEvaluated,omitempty"`
+}
+
 // FlagValue value of a feature flag variation
 type FlagValue = ldvalue.Value
 
+// ForcedTreatment puts a context in one of a rollout's variations
+type ForcedTreatment struct {
+	ContextKey string `json:"contextKey"`
+
+	// ContextKind the kind of the context. Defaults to user
+	ContextKind *string `json:"contextKind,omitempty"`
+
+	// Variation the index of the rollout's variation the context gets
+	Variation int `json:"variation"`
+}
+
+// FoundFlag A flag that matched a search, with its effective value
+type FoundFlag struct {
+	// Archived whether the flag is archived in LaunchDarkly. Archived flags aren't sent to SDKs
+	Archived *bool `json:"archived,omitempty"`
+
+	// DashboardUrl the flag's targeting page in the LaunchDarkly dashboard. Not set for projects without a source environment
+	DashboardUrl *string `json:"dashboardUrl,omitempty"`
+	Key          string  `json:"key"`
+
+	// Kind the type of a flag's variations
+	Kind FlagKind `json:"kind"`
+
+	// Layer what produced a flag's effective value
+	Layer OverrideLayer `json:"layer"`
+
+	// Metadata what LaunchDarkly says about a flag besides its variations, synced along with it
+	Metadata *FlagMetadata `json:"metadata,omitempty"`
+
+	// Tags the flag's tags in LaunchDarkly
+	Tags *[]string `json:"tags,omitempty"`
+
+	// Value value of a feature flag variation
+	Value   FlagValue `json:"value"`
+	Version int       `json:"version"`
+}
+
+// JSONPatch an RFC 6902 JSON Patch
+type JSONPatch = json.RawMessage
+
+// LogEntry A message the server logged
+type LogEntry struct {
+	// Id sequence number of the message. Increases with every message logged
+	Id      int64    `json:"id"`
+	Level   LogLevel `json:"level"`
+	Message string   `json:"message"`
+
+	// ProjectKey project the message is about, if any
+	ProjectKey *string   `json:"projectKey,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+// LogLevel defines model for LogLevel.
+type LogLevel string
+
+// MigrationStage a stage of a migration flag
+type MigrationStage string
+
+// Orphaned set when the project or environment the project syncs from was deleted or renamed in LaunchDarkly. The project keeps the flag values from its last successful sync.
+type Orphaned struct {
+	// Detail the error that showed the source was gone
+	Detail string `json:"detail"`
+
+	// Since unix timestamp for when the project was found to be orphaned
+	Since int64 `json:"since"`
+}
+
+// OverrideLayer what produced a flag's effective value
+type OverrideLayer = model.OverrideLayer
+
+// OverrideSchedule when a flag's override will be activated and removed. Times that are unset or have already passed are omitted
+type OverrideSchedule struct {
+	ActivateAt   *time.Time `json:"activateAt,omitempty"`
+	DeactivateAt *time.Time `json:"deactivateAt,omitempty"`
+	FlagKey      string     `json:"flagKey"`
+
+	// ScheduledBy who created the schedule. The changes it makes are attributed to them
+	ScheduledBy string `json:"scheduledBy"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
+// PrerequisiteOutcome what a prerequisite flag evaluated to
+type PrerequisiteOutcome struct {
+	FlagKey string `json:"flagKey"`
+
+	// Layer what produced a flag's effective value
+	Layer OverrideLayer `json:"layer"`
+
+	// SourceValue value of a feature flag variation
+	SourceValue FlagValue `json:"sourceValue"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
 // Project Project
 type Project struct {
+	// ArchivedAt unix timestamp for when the project was archived. Only set while it's archived
+	ArchivedAt *int64 `json:"_archivedAt,omitempty"`
+
 	// LastSyncedFromSource unix timestamp for the lat time the flag values were synced from the source environment
 	LastSyncedFromSource int64 `json:"_lastSyncedFromSource"`
 
+	// Orphaned set when the project or environment the project syncs from was deleted or renamed in LaunchDarkly. The project keeps the flag values from its last successful sync.
+	Orphaned *Orphaned `json:"_orphaned,omitempty"`
+
 	// AvailableVariations variations
 	AvailableVariations *map[string][]Variation `json:"availableVariations,omitempty"`
 
+	// BaseProjectKey set on linked clones to the project they were cloned from, which they sync from and inherit overrides from
+	BaseProjectKey *string `json:"baseProjectKey,omitempty"`
+
 	// Context context object to use when evaluating flags in source environment
 	Context Context `json:"context"`
 
+	// ContextKinds the context kinds configured for the project in LaunchDarkly, which the kinds of its context must be one of. Not set if they couldn't be fetched
+	ContextKinds *[]ContextKind `json:"contextKinds,omitempty"`
+
+	// DashboardUrl the page listing the project's flags in its source environment in the LaunchDarkly dashboard. Not set for projects without a source environment
+	DashboardUrl *string `json:"dashboardUrl,omitempty"`
+
+	// FlagDashboardUrls the targeting page in the LaunchDarkly dashboard of each flag in flagsState, by flag key. Not set for projects without a source environment
+	FlagDashboardUrls *map[string]string `json:"flagDashboardUrls,omitempty"`
+
+	// FlagFilter limits which flags are synced from the source environment and served. A flag is included if it matches any of keys, keyPrefixes, or tags. An empty filter includes every flag.
+	FlagFilter *FlagFilter `json:"flagFilter,omitempty"`
+
+	// FlagMetadata what LaunchDarkly says about each flag. Only included with expand=metadata
+	FlagMetadata *map[string]FlagMetadata `json:"flagMetadata,omitempty"`
+
 	// FlagsState flags and their values and version for a given project in the source environment
 	FlagsState *model.FlagsState `json:"flagsState,omitempty"`
 
+	// LinkedClones keys of the project's linked clones
+	LinkedClones *[]string `json:"linkedClones,omitempty"`
+
 	// Overrides overridden flags for the project
 	Overrides *model.FlagsState `json:"overrides,omitempty"`
 
 	// SourceEnvironmentKey environment to copy flag values from
 	SourceEnvironmentKey string `json:"sourceEnvironmentKey"`
+
+	// SyncStatus the most recent attempt to sync the project from its source environment
+	SyncStatus *SyncStatus `json:"syncStatus,omitempty"`
+
+	// TotalFlags how many flags the project has, including the ones that aren't in flagsState. Only set when the flags were paginated with offset or limit
+	TotalFlags *int `json:"totalFlags,omitempty"`
+}
+
+// ProjectDeletion how many of each thing referencing a project were removed along with it
+type ProjectDeletion struct {
+	Aliases             int `json:"aliases"`
+	AvailableVariations int `json:"availableVariations"`
+
+	// FlagStateHistory flag values recorded from syncs
+	FlagStateHistory int `json:"flagStateHistory"`
+	FlagTriggers     int `json:"flagTriggers"`
+
+	// OverrideHistory recorded override changes
+	OverrideHistory int `json:"overrideHistory"`
+
+	// OverrideSchedules pending override schedules
+	OverrideSchedules int `json:"overrideSchedules"`
+	Overrides         int `json:"overrides"`
+	SavedContexts     int `json:"savedContexts"`
+	ScenarioOverrides int `json:"scenarioOverrides"`
+}
+
+// ReceivedEvent An analytics event received from an SDK
+type ReceivedEvent struct {
+	// Data raw event data as JSON
+	Data json.RawMessage `json:"data"`
+
+	// Id sequence number of the event. Increases with every event received
+	Id int64 `json:"id"`
+
+	// Kind type of event (e.g., feature, custom, identify, summary, diagnostic)
+	Kind string `json:"kind"`
+
+	// ProjectKey project that sent the event, if known
+	ProjectKey *string `json:"projectKey,omitempty"`
+
+	// ReceivedAt timestamp when the event was received
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// Rollout a percentage split of a flag between values
+type Rollout struct {
+	// BucketBy the context attribute to bucket by. Defaults to key
+	BucketBy *string `json:"bucketBy,omitempty"`
+
+	// ContextKind the kind of context to bucket. Defaults to user
+	ContextKind *string `json:"contextKind,omitempty"`
+
+	// ForcedTreatments contexts that get a particular variation whatever their bucket
+	ForcedTreatments *[]ForcedTreatment `json:"forcedTreatments,omitempty"`
+
+	// PerEvaluation pick a value at random each time flags are served to a client-side SDK rather than bucketing by context. Server-side SDKs evaluate flags themselves, so they still bucket by context
+	PerEvaluation *bool `json:"perEvaluation,omitempty"`
+
+	// Seed mixed into the bucketing hash so that different seeds bucket contexts differently, and seeds the values picked when perEvaluation is set
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Variations the values to split the flag between. Their percentages must add up to 100
+	Variations []RolloutVariation `json:"variations"`
+}
+
+// RolloutVariation a value in a rollout and the share of contexts it's served to
+type RolloutVariation struct {
+	// Percent the percentage of contexts served the value, to up to three decimal places
+	Percent float64 `json:"percent"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
+// SavedContext a context saved with a project under a name
+type SavedContext struct {
+	// Context context object to use when evaluating flags in source environment
+	Context Context `json:"context"`
+	Name    string  `json:"name"`
+}
+
+// Snapshot a copy of the flag state synced from the source environment, which flags can be evaluated as of
+type Snapshot struct {
+	Id         int64     `json:"id"`
+	RecordedAt time.Time `json:"recordedAt"`
 }
 
+// SourceEvaluation what LaunchDarkly evaluated a flag to for the project's context when the project was last synced. It's the same for every context, since the dev server doesn't re-evaluate flags itself
+type SourceEvaluation struct {
+	// Reason LaunchDarkly's evaluation reason, e.g. {"kind":"RULE_MATCH","ruleIndex":0,"ruleId":"..."}
+	Reason *EvaluationReason `json:"reason,omitempty"`
+
+	// SyncedAt unix timestamp of the last sync
+	SyncedAt int64 `json:"syncedAt"`
+
+	// Value value of a feature flag variation
+	Value   FlagValue `json:"value"`
+	Version int       `json:"version"`
+}
+
+// SyncStatus the most recent attempt to sync the project from its source environment
+type SyncStatus struct {
+	// AttemptedAt unix timestamp for when the sync was attempted
+	AttemptedAt int64 `json:"attemptedAt"`
+
+	// DurationMs how long the sync took, in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Error why the sync failed. Only set if it did
+	Error  *string          `json:"error,omitempty"`
+	Result SyncStatusResult `json:"result"`
+}
+
+// SyncStatusResult defines model for SyncStatus.Result.
+type SyncStatusResult string
+
+// TriggerAction what firing a trigger does to its flag
+type TriggerAction string
+
 // Variation variation of a flag
 type Variation struct {
 	Id          string  `json:"_id"`
@@ -131,15 +622,54 @@ type Variation struct {
 	Value FlagValue `json:"value"`
 }
 
+// FlagExpand defines model for flagExpand.
+type FlagExpand = []string
+
+// At defines model for at.
+type At = time.Time
+
+// ContextName defines model for contextName.
+type ContextName = string
+
+// FlagFields defines model for flagFields.
+type FlagFields = []string
+
 // FlagKey defines model for flagKey.
 type FlagKey = string
 
+// FlagsLimit defines model for flagsLimit.
+type FlagsLimit = int
+
+// FlagsOffset defines model for flagsOffset.
+type FlagsOffset = int
+
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
+// IncludeArchivedFlags defines model for includeArchivedFlags.
+type IncludeArchivedFlags = bool
+
 // ProjectExpand defines model for projectExpand.
 type ProjectExpand = []string
 
 // ProjectKey defines model for projectKey.
 type ProjectKey = string
 
+// SavedContextName defines model for savedContextName.
+type SavedContextName = string
+
+// SavedContextNameHeader defines model for savedContextNameHeader.
+type SavedContextNameHeader = string
+
+// SegmentKey defines model for segmentKey.
+type SegmentKey = string
+
+// SnapshotId defines model for snapshotId.
+type SnapshotId = int64
+
+// TriggerId defines model for triggerId.
+type TriggerId = string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// Code specific error code encountered
@@ -151,13 +681,31 @@ type ErrorResponse struct {
 
 // FlagOverride defines model for FlagOverride.
 type FlagOverride struct {
+	// Locked whether the override is locked against changes and removal
+	Locked bool `json:"locked"`
+
 	// Override whether or not this is an overridden value or one from the source environment
 	Override bool `json:"override"`
 
+	// Rollout a percentage split of a flag between values
+	Rollout *Rollout `json:"rollout,omitempty"`
+
 	// Value value of a feature flag variation
 	Value FlagValue `json:"value"`
 }
 
+// PutAccessTokenJSONBody defines parameters for PutAccessToken.
+type PutAccessTokenJSONBody struct {
+	// AccessToken the new access token
+	AccessToken string `json:"accessToken"`
+}
+
+// PutAccessTokenParams defines parameters for PutAccessToken.
+type PutAccessTokenParams struct {
+	// Authorization the access token the dev server is currently using
+	Authorization string `json:"Authorization"`
+}
+
 // GetDebugSessionsParams defines parameters for GetDebugSessions.
 type GetDebugSessionsParams struct {
 	// Limit limit the number of debug sessions returned
@@ -179,20 +727,80 @@ type GetDebugSessionEventsParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// After only return events with an id greater than this one, oldest first up to the limit. Use the last id seen to tail events
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Kind filter events by kind (e.g., feature, custom, identify, summary, diagnostic)
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// ProjectKey filter events by the project that sent them
+	ProjectKey *string `form:"projectKey,omitempty" json:"projectKey,omitempty"`
+
+	// Limit limit the number of events returned
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetLogsParams defines parameters for GetLogs.
+type GetLogsParams struct {
+	// After only return messages logged after the one with this id, oldest first up to the limit. Pass the next id of the previous response to follow the log
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Level only return messages at this level or more severe
+	Level *LogLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// ProjectKey only return messages about this project
+	ProjectKey *string `form:"projectKey,omitempty" json:"projectKey,omitempty"`
+
+	// Grep only return messages matching this regular expression
+	Grep *string `form:"grep,omitempty" json:"grep,omitempty"`
+
+	// Limit limit the number of messages returned
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetProjectsParams defines parameters for GetProjects.
+type GetProjectsParams struct {
+	// Orphaned only list projects that are, or with false aren't, orphaned because their source was deleted or renamed in LaunchDarkly
+	Orphaned *bool `form:"orphaned,omitempty" json:"orphaned,omitempty"`
+
+	// IncludeArchived also list archived projects
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
 // GetProjectParams defines parameters for GetProject.
 type GetProjectParams struct {
 	// Expand Available expand options for this endpoint.
 	Expand *ProjectExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Offset skip this many flags, in key order
+	Offset *FlagsOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit return at most this many flags, in key order. Flags' overrides, variations, and layers are limited to the same flags. Without it, every flag from offset on is returned
+	Limit *FlagsLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Fields only return these fields of each flag's state, e.g. fields=value to leave out versions. Defaults to all of them
+	Fields *FlagFields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// IncludeArchived also return flags that are archived in LaunchDarkly. They're never sent to SDKs, but their overrides are kept
+	IncludeArchived *IncludeArchivedFlags `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
 }
 
 // GetProjectParamsExpand defines parameters for GetProject.
 type GetProjectParamsExpand string
 
+// GetProjectParamsFields defines parameters for GetProject.
+type GetProjectParamsFields string
+
 // PatchProjectJSONBody defines parameters for PatchProject.
 type PatchProjectJSONBody struct {
 	// Context context object to use when evaluating flags in source environment
 	Context *Context `json:"context,omitempty"`
 
+	// FlagFilter limits which flags are synced from the source environment and served. A flag is included if it matches any of keys, keyPrefixes, or tags. An empty filter includes every flag.
+	FlagFilter *FlagFilter `json:"flagFilter,omitempty"`
+
 	// SourceEnvironmentKey environment to copy flag values from
 	SourceEnvironmentKey *string `json:"sourceEnvironmentKey,omitempty"`
 }
@@ -211,6 +819,12 @@ type PostAddProjectJSONBody struct {
 	// Context context object to use when evaluating flags in source environment
 	Context *Context `json:"context,omitempty"`
 
+	// FlagFilter limits which flags are synced from the source environment and served. A flag is included if it matches any of keys, keyPrefixes, or tags. An empty filter includes every flag.
+	FlagFilter *FlagFilter `json:"flagFilter,omitempty"`
+
+	// PrefetchKeys also fetch and cache the keys for every environment of the project in the background, so that switching the source environment later doesn't need to look them up. Costs an extra API call per page of environments.
+	PrefetchKeys *bool `json:"prefetchKeys,omitempty"`
+
 	// SourceEnvironmentKey environment to copy flag values from
 	SourceEnvironmentKey string `json:"sourceEnvironmentKey"`
 }
@@ -219,11 +833,32 @@ type PostAddProjectJSONBody struct {
 type PostAddProjectParams struct {
 	// Expand Available expand options for this endpoint.
 	Expand *ProjectExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // PostAddProjectParamsExpand defines parameters for PostAddProject.
 type PostAddProjectParamsExpand string
 
+// GetProjectAuditParams defines parameters for GetProjectAudit.
+type GetProjectAuditParams struct {
+	// FlagKey only list changes to this flag's overrides
+	FlagKey *string `form:"flagKey,omitempty" json:"flagKey,omitempty"`
+
+	// Since RFC 3339 timestamp. Only list changes made at or after it
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit the most changes to list
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CloneProjectParams defines parameters for CloneProject.
+type CloneProjectParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetEnvironmentsParams defines parameters for GetEnvironments.
 type GetEnvironmentsParams struct {
 	// Name filter by environment name
@@ -233,25 +868,289 @@ type GetEnvironmentsParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// PatchProjectJSONRequestBody defines body for PatchProject for application/json ContentType.
-type PatchProjectJSONRequestBody PatchProjectJSONBody
+// GetProjectFlagStateParams defines parameters for GetProjectFlagState.
+type GetProjectFlagStateParams struct {
+	// At RFC 3339 timestamp to reconstruct the flag state at. Defaults to now.
+	At *At `form:"at,omitempty" json:"at,omitempty"`
 
-// PostAddProjectJSONRequestBody defines body for PostAddProject for application/json ContentType.
-type PostAddProjectJSONRequestBody PostAddProjectJSONBody
+	// Snapshot the ID of a snapshot to reconstruct the flag state as of. Can't be given along with at
+	Snapshot *SnapshotId `form:"snapshot,omitempty" json:"snapshot,omitempty"`
 
-// PutOverrideFlagJSONRequestBody defines body for PutOverrideFlag for application/json ContentType.
-type PutOverrideFlagJSONRequestBody = FlagValue
+	// Offset skip this many flags, in key order
+	Offset *FlagsOffset `form:"offset,omitempty" json:"offset,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// get the backup
-	// (GET /backup)
-	GetBackup(w http.ResponseWriter, r *http.Request)
-	// post backup
-	// (POST /backup)
-	RestoreBackup(w http.ResponseWriter, r *http.Request)
-	// list all debug sessions with event counts
-	// (GET /debug-sessions)
+	// Limit return at most this many flags, in key order. Flags' overrides, variations, and layers are limited to the same flags. Without it, every flag from offset on is returned
+	Limit *FlagsLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Fields only return these fields of each flag's state, e.g. fields=value to leave out versions. Defaults to all of them
+	Fields *FlagFields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Expand expand=metadata includes each flag's name, description, tags, and maintainer
+	Expand *FlagExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// IncludeArchived also return flags that are archived in LaunchDarkly. They're never sent to SDKs, but their overrides are kept
+	IncludeArchived *IncludeArchivedFlags `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// GetProjectFlagStateParamsFields defines parameters for GetProjectFlagState.
+type GetProjectFlagStateParamsFields string
+
+// GetProjectFlagsParams defines parameters for GetProjectFlags.
+type GetProjectFlagsParams struct {
+	// Q only return flags whose key contains this, ignoring case
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Prefix only return flags whose key starts with this
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Kind only return flags of this kind
+	Kind *FlagKind `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Overridden only return flags with an active override in any layer
+	Overridden *bool `form:"overridden,omitempty" json:"overridden,omitempty"`
+
+	// Tag only return flags with any of these tags in LaunchDarkly
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Archived only return archived flags
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+
+	// IncludeArchived also return flags that are archived in LaunchDarkly. They're never sent to SDKs, but their overrides are kept
+	IncludeArchived *IncludeArchivedFlags `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+
+	// Offset skip this many matching flags
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit the most flags to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand expand=metadata includes each flag's name, description, tags, and maintainer
+	Expand *FlagExpand `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// SimulateExperimentJSONBody defines parameters for SimulateExperiment.
+type SimulateExperimentJSONBody struct {
+	// Contexts the contexts to bucket
+	Contexts []Context `json:"contexts"`
+
+	// Rollout a percentage split of a flag between values
+	Rollout *Rollout `json:"rollout,omitempty"`
+}
+
+// GetFlagExplanationParams defines parameters for GetFlagExplanation.
+type GetFlagExplanationParams struct {
+	// Context the context JSON to explain the flag's value for. Defaults to the project's context
+	Context *Context `form:"context,omitempty" json:"context,omitempty"`
+
+	// SavedContext the name of a context saved with the project to use. Takes precedence over the X-LD-Saved-Context header
+	SavedContext *SavedContextName `form:"savedContext,omitempty" json:"savedContext,omitempty"`
+
+	// At RFC 3339 timestamp to reconstruct the flag state at. Defaults to now.
+	At *At `form:"at,omitempty" json:"at,omitempty"`
+
+	// Snapshot the ID of a snapshot to reconstruct the flag state as of. Can't be given along with at
+	Snapshot *SnapshotId `form:"snapshot,omitempty" json:"snapshot,omitempty"`
+
+	// XLDSavedContext the name of a context saved with the project to use
+	XLDSavedContext *SavedContextNameHeader `json:"X-LD-Saved-Context,omitempty"`
+}
+
+// DeleteOverridesParams defines parameters for DeleteOverrides.
+type DeleteOverridesParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CopyOverridesParams defines parameters for CopyOverrides.
+type CopyOverridesParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// MirrorOverridesParams defines parameters for MirrorOverrides.
+type MirrorOverridesParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PutChaosOverrideJSONBody defines parameters for PutChaosOverride.
+type PutChaosOverrideJSONBody struct {
+	// PerContext give each context a random variation that it keeps, rather than a new one each time flags are served
+	PerContext *bool `json:"perContext,omitempty"`
+
+	// Seed makes the values served repeatable. Defaults to 0
+	Seed *int64 `json:"seed,omitempty"`
+}
+
+// PutOverrideScheduleJSONBody defines parameters for PutOverrideSchedule.
+type PutOverrideScheduleJSONBody struct {
+	// ActivateAt when to override the flag with value. Requires value
+	ActivateAt *time.Time `json:"activateAt,omitempty"`
+
+	// DeactivateAt when to remove the flag's override. Must be after activateAt if both are set
+	DeactivateAt *time.Time `json:"deactivateAt,omitempty"`
+
+	// Value value of a feature flag variation
+	Value *FlagValue `json:"value,omitempty"`
+}
+
+// PutMigrationStageOverrideJSONBody defines parameters for PutMigrationStageOverride.
+type PutMigrationStageOverrideJSONBody struct {
+	// Stage a stage of a migration flag
+	Stage MigrationStage `json:"stage"`
+}
+
+// DeleteForcedTreatmentParams defines parameters for DeleteForcedTreatment.
+type DeleteForcedTreatmentParams struct {
+	ContextKey string `form:"contextKey" json:"contextKey"`
+
+	// ContextKind defaults to user
+	ContextKind *string `form:"contextKind,omitempty" json:"contextKind,omitempty"`
+}
+
+// GetEvaluationRequestsParams defines parameters for GetEvaluationRequests.
+type GetEvaluationRequestsParams struct {
+	// After only return evaluations with an id greater than this one. Use the last id seen to tail evaluations
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// FlagKey only return evaluations of this flag
+	FlagKey *string `form:"flagKey,omitempty" json:"flagKey,omitempty"`
+
+	// Source only return evaluations whose value came from this source
+	Source *EvaluationSource `form:"source,omitempty" json:"source,omitempty"`
+
+	// Limit limit the number of evaluations returned
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PutScenarioJSONBody defines parameters for PutScenario.
+type PutScenarioJSONBody struct {
+	// Overrides flag values to apply, keyed by flag key
+	Overrides map[string]FlagValue `json:"overrides"`
+}
+
+// PutScenarioParams defines parameters for PutScenario.
+type PutScenarioParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostSnapshotParams defines parameters for PostSnapshot.
+type PostSnapshotParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RestoreSnapshotParams defines parameters for RestoreSnapshot.
+type RestoreSnapshotParams struct {
+	// IdempotencyKey A unique key for the request, such as a UUID, so that it can be retried safely. A retry with the same key gets the response to the first attempt, with an Idempotent-Replayed header, rather than being handled again. Keys are kept for 24 hours, and reusing one for a different request is rejected with a 422. Responses to server errors aren't kept, so those requests are handled again.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostFlagTriggerJSONBody defines parameters for PostFlagTrigger.
+type PostFlagTriggerJSONBody struct {
+	// Action what firing a trigger does to its flag
+	Action  TriggerAction `json:"action"`
+	FlagKey string        `json:"flagKey"`
+}
+
+// GetFlagUsageParams defines parameters for GetFlagUsage.
+type GetFlagUsageParams struct {
+	// Since RFC 3339 timestamp. Only count evaluations made at or after it. Evaluations are counted by the hour, and only for a week
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetSourceEnvironmentsParams defines parameters for GetSourceEnvironments.
+type GetSourceEnvironmentsParams struct {
+	// Name filter by environment name
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Limit limit the number of environments returned
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PutAccessTokenJSONRequestBody defines body for PutAccessToken for application/json ContentType.
+type PutAccessTokenJSONRequestBody PutAccessTokenJSONBody
+
+// PostAliasJSONRequestBody defines body for PostAlias for application/json ContentType.
+type PostAliasJSONRequestBody = Alias
+
+// PatchProjectJSONRequestBody defines body for PatchProject for application/json ContentType.
+type PatchProjectJSONRequestBody PatchProjectJSONBody
+
+// PatchProjectApplicationJSONPatchPlusJSONRequestBody defines body for PatchProject for application/json-patch+json ContentType.
+type PatchProjectApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// PostAddProjectJSONRequestBody defines body for PostAddProject for application/json ContentType.
+type PostAddProjectJSONRequestBody PostAddProjectJSONBody
+
+// PutBigSegmentJSONRequestBody defines body for PutBigSegment for application/json ContentType.
+type PutBigSegmentJSONRequestBody = BigSegmentMembership
+
+// PutSavedContextJSONRequestBody defines body for PutSavedContext for application/json ContentType.
+type PutSavedContextJSONRequestBody = Context
+
+// SimulateExperimentJSONRequestBody defines body for SimulateExperiment for application/json ContentType.
+type SimulateExperimentJSONRequestBody SimulateExperimentJSONBody
+
+// CopyOverridesJSONRequestBody defines body for CopyOverrides for application/json ContentType.
+type CopyOverridesJSONRequestBody = FlagFilter
+
+// MirrorOverridesJSONRequestBody defines body for MirrorOverrides for application/json ContentType.
+type MirrorOverridesJSONRequestBody = FlagFilter
+
+// PutOverrideFlagJSONRequestBody defines body for PutOverrideFlag for application/json ContentType.
+type PutOverrideFlagJSONRequestBody = FlagValue
+
+// PutAIConfigOverrideJSONRequestBody defines body for PutAIConfigOverride for application/json ContentType.
+type PutAIConfigOverrideJSONRequestBody = AIConfigOverride
+
+// PutChaosOverrideJSONRequestBody defines body for PutChaosOverride for application/json ContentType.
+type PutChaosOverrideJSONRequestBody PutChaosOverrideJSONBody
+
+// PutRolloutOverrideJSONRequestBody defines body for PutRolloutOverride for application/json ContentType.
+type PutRolloutOverrideJSONRequestBody = Rollout
+
+// PutOverrideScheduleJSONRequestBody defines body for PutOverrideSchedule for application/json ContentType.
+type PutOverrideScheduleJSONRequestBody PutOverrideScheduleJSONBody
+
+// PutMigrationStageOverrideJSONRequestBody defines body for PutMigrationStageOverride for application/json ContentType.
+type PutMigrationStageOverrideJSONRequestBody PutMigrationStageOverrideJSONBody
+
+// PutForcedTreatmentJSONRequestBody defines body for PutForcedTreatment for application/json ContentType.
+type PutForcedTreatmentJSONRequestBody = ForcedTreatment
+
+// PutScenarioJSONRequestBody defines body for PutScenario for application/json ContentType.
+type PutScenarioJSONRequestBody PutScenarioJSONBody
+
+// PostFlagTriggerJSONRequestBody defines body for PostFlagTrigger for application/json ContentType.
+type PostFlagTriggerJSONRequestBody PostFlagTriggerJSONBody
+
+// PostSecureModeHashJSONRequestBody defines body for PostSecureModeHash for application/json ContentType.
+type PostSecureModeHashJSONRequestBody = Context
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// replace the LaunchDarkly access token the dev server uses, without restarting it or disconnecting SDKs. The request must be authorized with the current token
+	// (PUT /access-token)
+	PutAccessToken(w http.ResponseWriter, r *http.Request, params PutAccessTokenParams)
+	// list the aliases that map SDK credentials to dev projects
+	// (GET /aliases)
+	GetAliases(w http.ResponseWriter, r *http.Request)
+	// map an SDK credential, such as a production mobile key or client-side ID, to a dev project. Replaces any existing alias with the same name
+	// (POST /aliases)
+	PostAlias(w http.ResponseWriter, r *http.Request)
+	// remove the alias
+	// (DELETE /aliases/{alias})
+	DeleteAlias(w http.ResponseWriter, r *http.Request, alias string)
+	// get the backup
+	// (GET /backup)
+	GetBackup(w http.ResponseWriter, r *http.Request)
+	// post backup
+	// (POST /backup)
+	RestoreBackup(w http.ResponseWriter, r *http.Request)
+	// list all debug sessions with event counts
+	// (GET /debug-sessions)
 	GetDebugSessions(w http.ResponseWriter, r *http.Request, params GetDebugSessionsParams)
 	// delete a specific debug session and all its events
 	// (DELETE /debug-sessions/{debugSessionKey})
@@ -259,33 +1158,174 @@ type ServerInterface interface {
 	// get events for a specific debug session
 	// (GET /debug-sessions/{debugSessionKey}/events)
 	GetDebugSessionEvents(w http.ResponseWriter, r *http.Request, debugSessionKey string, params GetDebugSessionEventsParams)
+	// list the most recent analytics events received from SDKs, oldest first
+	// (GET /events)
+	GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams)
+	// list the most recent messages the server logged, oldest first
+	// (GET /logs)
+	GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams)
 	// lists all projects that have been configured for the dev server
 	// (GET /projects)
-	GetProjects(w http.ResponseWriter, r *http.Request)
-	// remove the specified project from the dev server
+	GetProjects(w http.ResponseWriter, r *http.Request, params GetProjectsParams)
+	// remove the specified project from the dev server. Projects with linked clones can't be removed until the clones are
 	// (DELETE /projects/{projectKey})
 	DeleteProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
 	// get the specified project and its configuration for syncing from the LaunchDarkly Service
 	// (GET /projects/{projectKey})
 	GetProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectParams)
-	// updates the project context or sourceEnvironmentKey then syncs.  Input an empty body to only force a sync.
+	// updates the project context or sourceEnvironmentKey then syncs.  Input an empty body to only force a sync. Orphaned projects are only synced again when sourceEnvironmentKey is set, though a new context alone is saved without syncing, and archived projects aren't synced. Changes can also be sent as an RFC 6902 JSON Patch of the project's sourceEnvironmentKey, context and flagFilter, with a test operation to only make them if the project hasn't been changed by someone else.
 	// (PATCH /projects/{projectKey})
 	PatchProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PatchProjectParams)
 	// Add the project to the dev server
 	// (POST /projects/{projectKey})
 	PostAddProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PostAddProjectParams)
+	// archive the project. Archived projects aren't synced or served to SDKs, but keep their overrides and can be unarchived
+	// (POST /projects/{projectKey}/archive)
+	ArchiveProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// list who changed the project's overrides and when, most recent first
+	// (GET /projects/{projectKey}/audit)
+	GetProjectAudit(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectAuditParams)
+	// list the emulated big segments for the project and their members
+	// (GET /projects/{projectKey}/big-segments)
+	GetBigSegments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// remove the emulated big segment. The project is synced again so that its flags' values reflect the removal
+	// (DELETE /projects/{projectKey}/big-segments/{segmentKey})
+	DeleteBigSegment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, segmentKey SegmentKey)
+	// set which context keys are included in or excluded from the emulated big segment. The project is synced again so that its flags' values reflect the membership
+	// (PUT /projects/{projectKey}/big-segments/{segmentKey})
+	PutBigSegment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, segmentKey SegmentKey)
+	// add the project as a linked clone of the base project. The clone starts with the base's flags, is synced whenever the base is, and inherits the base's overrides as they change, beneath any overrides set on the clone itself
+	// (POST /projects/{projectKey}/clone-from/{baseProjectKey})
+	CloneProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, baseProjectKey string, params CloneProjectParams)
+	// list the contexts saved with the project
+	// (GET /projects/{projectKey}/contexts)
+	GetSavedContexts(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// remove a context saved with the project
+	// (DELETE /projects/{projectKey}/contexts/{contextName})
+	DeleteSavedContext(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, contextName ContextName)
+	// get a context saved with the project
+	// (GET /projects/{projectKey}/contexts/{contextName})
+	GetSavedContext(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, contextName ContextName)
+	// save a context with the project under a name, replacing whichever context was saved under it
+	// (PUT /projects/{projectKey}/contexts/{contextName})
+	PutSavedContext(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, contextName ContextName)
 	// list all environments for the given project
 	// (GET /projects/{projectKey}/environments)
 	GetEnvironments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetEnvironmentsParams)
-	// remove all overrides for the given project
+	// render the project's flags, with overrides applied, as a LaunchDarkly SDK file data source
+	// (GET /projects/{projectKey}/file-data-source)
+	GetProjectFileDataSource(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// get the effective flag values for the project, with overrides applied. Pass `at` or `snapshot` to see them as they were at a past moment
+	// (GET /projects/{projectKey}/flag-state)
+	GetProjectFlagState(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectFlagStateParams)
+	// search the project's flags, with overrides applied. Flags are ordered by key, and only the flags matching every filter given are returned
+	// (GET /projects/{projectKey}/flags)
+	GetProjectFlags(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectFlagsParams)
+	// bucket contexts into an experiment's treatments the way SDKs would, without serving anything
+	// (POST /projects/{projectKey}/flags/{flagKey}/experiment/simulate)
+	SimulateExperiment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// explain why a context is served the value it gets for a flag
+	// (GET /projects/{projectKey}/flags/{flagKey}/explain)
+	GetFlagExplanation(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey, params GetFlagExplanationParams)
+	// remove all unlocked overrides for the given project
 	// (DELETE /projects/{projectKey}/overrides)
-	DeleteOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	DeleteOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params DeleteOverridesParams)
+	// copy the source project's active overrides into the project, replacing the project's overrides for the same flags. Overrides for flags the project doesn't have, or whose override is locked, are skipped
+	// (POST /projects/{projectKey}/overrides/copy-from/{sourceProjectKey})
+	CopyOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, sourceProjectKey string, params CopyOverridesParams)
+	// override the project's flags with the values LaunchDarkly serves the project's context in another environment, replacing the project's overrides for the same flags. Flags the project doesn't have are left out, and flags whose override is locked are skipped
+	// (POST /projects/{projectKey}/overrides/mirror-from/{environmentKey})
+	MirrorOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, environmentKey string, params MirrorOverridesParams)
 	// remove override for flag
 	// (DELETE /projects/{projectKey}/overrides/{flagKey})
 	DeleteFlagOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
 	// override flag value with value provided in the body
 	// (PUT /projects/{projectKey}/overrides/{flagKey})
 	PutOverrideFlag(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// override an AI Config with another of its variations, a different model or prompt messages, or both. Remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/ai-config)
+	PutAIConfigOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// override the flag with a random one of its variations, for testing how an app copes with values it doesn't expect. The override is an equal rollout between the flag's variations, so remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/chaos)
+	PutChaosOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// unlock the flag's override
+	// (DELETE /projects/{projectKey}/overrides/{flagKey}/lock)
+	UnlockOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// lock the flag's override so that it can't be changed or removed, even by removing all overrides, until it's unlocked. Locked overrides are also kept if a sync finds their flag gone
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/lock)
+	LockOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// override the flag with a percentage rollout. Contexts are bucketed by key the way LaunchDarkly rollouts are, so each keeps getting the same value. Remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/rollout)
+	PutRolloutOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// cancel the flag's schedule without changing its override
+	// (DELETE /projects/{projectKey}/overrides/{flagKey}/schedule)
+	DeleteOverrideSchedule(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// schedule the flag's override to be activated with a value at activateAt and removed at deactivateAt, replacing any schedule the flag already has
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/schedule)
+	PutOverrideSchedule(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// pin a migration flag to one of its stages, so that SDKs run the migration in that stage. Remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/stage)
+	PutMigrationStageOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// let a context be bucketed into a variation of the flag's rollout override again
+	// (DELETE /projects/{projectKey}/overrides/{flagKey}/treatments)
+	DeleteForcedTreatment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey, params DeleteForcedTreatmentParams)
+	// force a context into one of the variations of the flag's rollout override, so that an experiment's treatment can be tried out
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/treatments)
+	PutForcedTreatment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey)
+	// permanently delete an archived project along with its overrides, aliases, and history
+	// (POST /projects/{projectKey}/purge)
+	PurgeProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// list the most recent evaluations SDKs reported for the project in their events, oldest first
+	// (GET /projects/{projectKey}/requests)
+	GetEvaluationRequests(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetEvaluationRequestsParams)
+	// clear the project's scenario layer
+	// (DELETE /projects/{projectKey}/scenario)
+	DeleteScenario(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// replace the project's scenario layer. Scenario overrides apply underneath manual overrides, so applying or clearing a scenario leaves manual overrides in place
+	// (PUT /projects/{projectKey}/scenario)
+	PutScenario(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PutScenarioParams)
+	// list the project's pending override schedules
+	// (GET /projects/{projectKey}/schedules)
+	GetOverrideSchedules(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// list the snapshots of the project's flag state that flags can be evaluated as of. One is recorded every time the project is synced
+	// (GET /projects/{projectKey}/snapshots)
+	GetSnapshots(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// take a snapshot of the project's current flag state, without syncing it, to restore the project to later
+	// (POST /projects/{projectKey}/snapshots)
+	PostSnapshot(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PostSnapshotParams)
+	// roll the project back to the flag state in the snapshot and the overrides it had when the snapshot was taken. Locked overrides are kept as they are. Linked clones only have their overrides restored
+	// (POST /projects/{projectKey}/snapshots/{snapshotId}/restore)
+	RestoreSnapshot(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, snapshotId int64, params RestoreSnapshotParams)
+	// report problems with the project's data that the dev server worked around
+	// (GET /projects/{projectKey}/status)
+	GetProjectStatus(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// get compact counts for the project, for status bar widgets that poll frequently
+	// (GET /projects/{projectKey}/summary)
+	GetProjectSummary(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// list the project's flag triggers
+	// (GET /projects/{projectKey}/triggers)
+	GetFlagTriggers(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// add a trigger for a boolean flag, a local version of a LaunchDarkly flag trigger. POSTing to the trigger's path overrides the flag to true if it turns the flag on, or false if it turns it off
+	// (POST /projects/{projectKey}/triggers)
+	PostFlagTrigger(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// remove a flag trigger, so its path stops working
+	// (DELETE /projects/{projectKey}/triggers/{triggerId})
+	DeleteFlagTrigger(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, triggerId TriggerId)
+	// make an archived project available again
+	// (POST /projects/{projectKey}/unarchive)
+	UnarchiveProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey)
+	// summarize the evaluations SDKs reported for the project by flag, including the project's flags that weren't evaluated and the flags that were evaluated but that the project doesn't have
+	// (GET /projects/{projectKey}/usage)
+	GetFlagUsage(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetFlagUsageParams)
+	// generate the secure mode hash for a context using the secret the dev server was started with
+	// (POST /secure-mode-hash)
+	PostSecureModeHash(w http.ResponseWriter, r *http.Request)
+	// list the environments of a LaunchDarkly project, whether or not it has been added to the dev server, e.g. to choose a source environment before adding it
+	// (GET /source-projects/{projectKey}/environments)
+	GetSourceEnvironments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetSourceEnvironmentsParams)
+	// fire a flag trigger, overriding its flag to true if it turns the flag on, or false if it turns it off. Like LaunchDarkly's trigger URLs, it needs no credentials and ignores the request body
+	// (POST /triggers/{triggerId})
+	FireFlagTrigger(w http.ResponseWriter, r *http.Request, triggerId TriggerId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -297,6 +1337,103 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// PutAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) PutAccessToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAccessTokenParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err = fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAccessToken(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAliases operation middleware
+func (siw *ServerInterfaceWrapper) GetAliases(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAliases(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAlias operation middleware
+func (siw *ServerInterfaceWrapper) PostAlias(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAlias(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAlias operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlias(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "alias" -------------
+	var alias string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alias", mux.Vars(r)["alias"], &alias, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alias", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAlias(w, r, alias)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetBackup operation middleware
 func (siw *ServerInterfaceWrapper) GetBackup(w http.ResponseWriter, r *http.Request) {
 
@@ -437,36 +1574,48 @@ func (siw *ServerInterfaceWrapper) GetDebugSessionEvents(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjects operation middleware
-func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
+// GetEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetEvents(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjects(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "after" -------------
 
-// DeleteProject operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "kind" -------------
 
-	// ------------- Path parameter "projectKey" -------------
-	var projectKey ProjectKey
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "projectKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectKey", r.URL.Query(), &params.ProjectKey)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProject(w, r, projectKey)
+		siw.Handler.GetEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -476,33 +1625,56 @@ func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetProject operation middleware
-func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+// GetLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectKey" -------------
-	var projectKey ProjectKey
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectKey", r.URL.Query(), &params.ProjectKey)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProjectParams
+	// ------------- Optional query parameter "grep" -------------
 
-	// ------------- Optional query parameter "expand" -------------
+	err = runtime.BindQueryParameter("form", true, false, "grep", r.URL.Query(), &params.Grep)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grep", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProject(w, r, projectKey, params)
+		siw.Handler.GetLogs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -512,33 +1684,32 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PatchProject operation middleware
-func (siw *ServerInterfaceWrapper) PatchProject(w http.ResponseWriter, r *http.Request) {
+// GetProjects operation middleware
+func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectKey" -------------
-	var projectKey ProjectKey
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "orphaned" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orphaned", r.URL.Query(), &params.Orphaned)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orphaned", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PatchProjectParams
-
-	// ------------- Optional query parameter "expand" -------------
+	// ------------- Optional query parameter "includeArchived" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchProject(w, r, projectKey, params)
+		siw.Handler.GetProjects(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -548,7 +1719,136 @@ func (siw *ServerInterfaceWrapper) PatchProject(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PostAddProject operation middleware
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProject(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectParams
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeArchived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProject(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchProject operation middleware
+func (siw *ServerInterfaceWrapper) PatchProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchProjectParams
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchProject(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAddProject operation middleware
 func (siw *ServerInterfaceWrapper) PostAddProject(w http.ResponseWriter, r *http.Request) {
 
 	var err error
@@ -573,6 +1873,27 @@ func (siw *ServerInterfaceWrapper) PostAddProject(w http.ResponseWriter, r *http
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.PostAddProject(w, r, projectKey, params)
 	}))
@@ -584,8 +1905,33 @@ func (siw *ServerInterfaceWrapper) PostAddProject(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetEnvironments operation middleware
-func (siw *ServerInterfaceWrapper) GetEnvironments(w http.ResponseWriter, r *http.Request) {
+// ArchiveProject operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveProject(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectAudit(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -599,13 +1945,21 @@ func (siw *ServerInterfaceWrapper) GetEnvironments(w http.ResponseWriter, r *htt
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEnvironmentsParams
+	var params GetProjectAuditParams
 
-	// ------------- Optional query parameter "name" -------------
+	// ------------- Optional query parameter "flagKey" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "flagKey", r.URL.Query(), &params.FlagKey)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
 		return
 	}
 
@@ -618,7 +1972,7 @@ func (siw *ServerInterfaceWrapper) GetEnvironments(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnvironments(w, r, projectKey, params)
+		siw.Handler.GetProjectAudit(w, r, projectKey, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -628,8 +1982,8 @@ func (siw *ServerInterfaceWrapper) GetEnvironments(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteOverrides operation middleware
-func (siw *ServerInterfaceWrapper) DeleteOverrides(w http.ResponseWriter, r *http.Request) {
+// GetBigSegments operation middleware
+func (siw *ServerInterfaceWrapper) GetBigSegments(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -643,7 +1997,7 @@ func (siw *ServerInterfaceWrapper) DeleteOverrides(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteOverrides(w, r, projectKey)
+		siw.Handler.GetBigSegments(w, r, projectKey)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -653,8 +2007,8 @@ func (siw *ServerInterfaceWrapper) DeleteOverrides(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteFlagOverride operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFlagOverride(w http.ResponseWriter, r *http.Request) {
+// DeleteBigSegment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBigSegment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -667,17 +2021,17 @@ func (siw *ServerInterfaceWrapper) DeleteFlagOverride(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "flagKey" -------------
-	var flagKey FlagKey
+	// ------------- Path parameter "segmentKey" -------------
+	var segmentKey SegmentKey
 
-	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "segmentKey", mux.Vars(r)["segmentKey"], &segmentKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segmentKey", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFlagOverride(w, r, projectKey, flagKey)
+		siw.Handler.DeleteBigSegment(w, r, projectKey, segmentKey)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -687,8 +2041,8 @@ func (siw *ServerInterfaceWrapper) DeleteFlagOverride(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PutOverrideFlag operation middleware
-func (siw *ServerInterfaceWrapper) PutOverrideFlag(w http.ResponseWriter, r *http.Request) {
+// PutBigSegment operation middleware
+func (siw *ServerInterfaceWrapper) PutBigSegment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -701,17 +2055,17 @@ func (siw *ServerInterfaceWrapper) PutOverrideFlag(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Path parameter "flagKey" -------------
-	var flagKey FlagKey
+	// ------------- Path parameter "segmentKey" -------------
+	var segmentKey SegmentKey
 
-	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "segmentKey", mux.Vars(r)["segmentKey"], &segmentKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segmentKey", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutOverrideFlag(w, r, projectKey, flagKey)
+		siw.Handler.PutBigSegment(w, r, projectKey, segmentKey)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -721,657 +2075,5910 @@ func (siw *ServerInterfaceWrapper) PutOverrideFlag(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// CloneProject operation middleware
+func (siw *ServerInterfaceWrapper) CloneProject(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "baseProjectKey" -------------
+	var baseProjectKey string
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "baseProjectKey", mux.Vars(r)["baseProjectKey"], &baseProjectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "baseProjectKey", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CloneProjectParams
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	headers := r.Header
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneProject(w, r, projectKey, baseProjectKey, params)
+	}))
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	handler.ServeHTTP(w, r)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+// GetSavedContexts operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedContexts(w http.ResponseWriter, r *http.Request) {
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
-
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedContexts(w, r, projectKey)
+	}))
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.HandleFunc(options.BaseURL+"/backup", wrapper.GetBackup).Methods("GET")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/backup", wrapper.RestoreBackup).Methods("POST")
+// DeleteSavedContext operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedContext(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/debug-sessions", wrapper.GetDebugSessions).Methods("GET")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/debug-sessions/{debugSessionKey}", wrapper.DeleteDebugSession).Methods("DELETE")
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-	r.HandleFunc(options.BaseURL+"/debug-sessions/{debugSessionKey}/events", wrapper.GetDebugSessionEvents).Methods("GET")
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/projects", wrapper.GetProjects).Methods("GET")
+	// ------------- Path parameter "contextName" -------------
+	var contextName ContextName
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.DeleteProject).Methods("DELETE")
+	err = runtime.BindStyledParameterWithOptions("simple", "contextName", mux.Vars(r)["contextName"], &contextName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contextName", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.GetProject).Methods("GET")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSavedContext(w, r, projectKey, contextName)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.PatchProject).Methods("PATCH")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.PostAddProject).Methods("POST")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/environments", wrapper.GetEnvironments).Methods("GET")
+// GetSavedContext operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedContext(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides", wrapper.DeleteOverrides).Methods("DELETE")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}", wrapper.DeleteFlagOverride).Methods("DELETE")
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}", wrapper.PutOverrideFlag).Methods("PUT")
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-	return r
-}
+	// ------------- Path parameter "contextName" -------------
+	var contextName ContextName
 
-type DbBackupApplicationvndSqlite3Response struct {
-	Body io.Reader
+	err = runtime.BindStyledParameterWithOptions("simple", "contextName", mux.Vars(r)["contextName"], &contextName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contextName", Err: err})
+		return
+	}
 
-	ContentLength int64
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedContext(w, r, projectKey, contextName)
+	}))
 
-type ErrorResponseJSONResponse struct {
-	// Code specific error code encountered
-	Code string `json:"code"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// Message description of the error
-	Message string `json:"message"`
+	handler.ServeHTTP(w, r)
 }
 
-type FlagOverrideJSONResponse struct {
-	// Override whether or not this is an overridden value or one from the source environment
-	Override bool `json:"override"`
-
-	// Value value of a feature flag variation
-	Value FlagValue `json:"value"`
-}
+// PutSavedContext operation middleware
+func (siw *ServerInterfaceWrapper) PutSavedContext(w http.ResponseWriter, r *http.Request) {
 
-type ProjectJSONResponse Project
+	var err error
 
-type GetBackupRequestObject struct {
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-type GetBackupResponseObject interface {
-	VisitGetBackupResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-type GetBackup200ApplicationvndSqlite3Response struct {
-	DbBackupApplicationvndSqlite3Response
-}
+	// ------------- Path parameter "contextName" -------------
+	var contextName ContextName
 
-func (response GetBackup200ApplicationvndSqlite3Response) VisitGetBackupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/vnd.sqlite3")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	err = runtime.BindStyledParameterWithOptions("simple", "contextName", mux.Vars(r)["contextName"], &contextName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contextName", Err: err})
+		return
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutSavedContext(w, r, projectKey, contextName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
 
-type RestoreBackupRequestObject struct {
-	Body io.Reader
+	handler.ServeHTTP(w, r)
 }
 
-type RestoreBackupResponseObject interface {
-	VisitRestoreBackupResponse(w http.ResponseWriter) error
-}
+// GetEnvironments operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvironments(w http.ResponseWriter, r *http.Request) {
 
-type RestoreBackup200Response struct {
-}
+	var err error
 
-func (response RestoreBackup200Response) VisitRestoreBackupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-type GetDebugSessionsRequestObject struct {
-	Params GetDebugSessionsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-type GetDebugSessionsResponseObject interface {
-	VisitGetDebugSessionsResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnvironmentsParams
 
-type GetDebugSessions200JSONResponse DebugSessionsPage
+	// ------------- Optional query parameter "name" -------------
 
-func (response GetDebugSessions200JSONResponse) VisitGetDebugSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetDebugSessions400JSONResponse struct{ ErrorResponseJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetDebugSessions400JSONResponse) VisitGetDebugSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnvironments(w, r, projectKey, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteDebugSessionRequestObject struct {
-	DebugSessionKey string `json:"debugSessionKey"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteDebugSessionResponseObject interface {
-	VisitDeleteDebugSessionResponse(w http.ResponseWriter) error
-}
+// GetProjectFileDataSource operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectFileDataSource(w http.ResponseWriter, r *http.Request) {
 
-type DeleteDebugSession204Response struct {
-}
+	var err error
 
-func (response DeleteDebugSession204Response) VisitDeleteDebugSessionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-type DeleteDebugSession404JSONResponse struct{ ErrorResponseJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-func (response DeleteDebugSession404JSONResponse) VisitDeleteDebugSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectFileDataSource(w, r, projectKey)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetDebugSessionEventsRequestObject struct {
-	DebugSessionKey string `json:"debugSessionKey"`
-	Params          GetDebugSessionEventsParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetDebugSessionEventsResponseObject interface {
-	VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error
-}
+// GetProjectFlagState operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectFlagState(w http.ResponseWriter, r *http.Request) {
 
-type GetDebugSessionEvents200JSONResponse EventsPage
+	var err error
 
-func (response GetDebugSessionEvents200JSONResponse) VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-type GetDebugSessionEvents400JSONResponse struct{ ErrorResponseJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-func (response GetDebugSessionEvents400JSONResponse) VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectFlagStateParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "at" -------------
 
-type GetDebugSessionEvents404JSONResponse struct {
-	// Code specific error code encountered
-	Code string `json:"code"`
+	err = runtime.BindQueryParameter("form", true, false, "at", r.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "at", Err: err})
+		return
+	}
 
-	// Message description of the error
-	Message string `json:"message"`
-}
+	// ------------- Optional query parameter "snapshot" -------------
 
-func (response GetDebugSessionEvents404JSONResponse) VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "snapshot", r.URL.Query(), &params.Snapshot)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type GetProjectsRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type GetProjectsResponseObject interface {
-	VisitGetProjectsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetProjects200JSONResponse []string
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetProjects200JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "fields" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
 
-type DeleteProjectRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-}
+	// ------------- Optional query parameter "expand" -------------
 
-type DeleteProjectResponseObject interface {
-	VisitDeleteProjectResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		return
+	}
 
-type DeleteProject204Response struct {
-}
+	// ------------- Optional query parameter "includeArchived" -------------
 
-func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
+		return
+	}
 
-type DeleteProject404JSONResponse struct{ ErrorResponseJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectFlagState(w, r, projectKey, params)
+	}))
 
-func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetProjectRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-	Params     GetProjectParams
-}
+// GetProjectFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectFlags(w http.ResponseWriter, r *http.Request) {
 
-type GetProjectResponseObject interface {
-	VisitGetProjectResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetProject200JSONResponse struct{ ProjectJSONResponse }
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectFlagsParams
 
-type GetProject404Response struct {
-}
+	// ------------- Optional query parameter "q" -------------
 
-func (response GetProject404Response) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
 
-type PatchProjectRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-	Params     PatchProjectParams
-	Body       *PatchProjectJSONRequestBody
-}
+	// ------------- Optional query parameter "prefix" -------------
 
-type PatchProjectResponseObject interface {
-	VisitPatchProjectResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
 
-type PatchProject200JSONResponse struct{ ProjectJSONResponse }
+	// ------------- Optional query parameter "kind" -------------
 
-func (response PatchProject200JSONResponse) VisitPatchProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "overridden" -------------
 
-type PatchProject404Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "overridden", r.URL.Query(), &params.Overridden)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overridden", Err: err})
+		return
+	}
 
-func (response PatchProject404Response) VisitPatchProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// ------------- Optional query parameter "tag" -------------
 
-type PostAddProjectRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-	Params     PostAddProjectParams
-	Body       *PostAddProjectJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
 
-type PostAddProjectResponseObject interface {
-	VisitPostAddProjectResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "archived" -------------
 
-type PostAddProject201JSONResponse struct{ ProjectJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archived", Err: err})
+		return
+	}
 
-func (response PostAddProject201JSONResponse) VisitPostAddProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "includeArchived" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
+		return
+	}
 
-type PostAddProject400JSONResponse struct{ ErrorResponseJSONResponse }
+	// ------------- Optional query parameter "offset" -------------
 
-func (response PostAddProject400JSONResponse) VisitPostAddProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostAddProject409JSONResponse struct {
-	// Code specific error code encountered
-	Code string `json:"code"`
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	// Message description of the error
-	Message string `json:"message"`
-}
+	// ------------- Optional query parameter "expand" -------------
 
-func (response PostAddProject409JSONResponse) VisitPostAddProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectFlags(w, r, projectKey, params)
+	}))
 
-type GetEnvironmentsRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-	Params     GetEnvironmentsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetEnvironmentsResponseObject interface {
-	VisitGetEnvironmentsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetEnvironments200JSONResponse []Environment
+// SimulateExperiment operation middleware
+func (siw *ServerInterfaceWrapper) SimulateExperiment(w http.ResponseWriter, r *http.Request) {
 
-func (response GetEnvironments200JSONResponse) VisitGetEnvironmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
 
-type GetEnvironments400JSONResponse struct{ ErrorResponseJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
 
-func (response GetEnvironments400JSONResponse) VisitGetEnvironmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
 
-type GetEnvironments404JSONResponse struct {
-	// Code specific error code encountered
-	Code string `json:"code"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SimulateExperiment(w, r, projectKey, flagKey)
+	}))
 
-	// Message description of the error
-	Message string `json:"message"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFlagExplanation operation middleware
+func (siw *ServerInterfaceWrapper) GetFlagExplanation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFlagExplanationParams
+
+	// ------------- Optional query parameter "context" -------------
+
+	if paramValue := r.URL.Query().Get("context"); paramValue != "" {
+
+		var value Context
+		err = json.Unmarshal([]byte(paramValue), &value)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &UnmarshalingParamError{ParamName: "context", Err: err})
+			return
+		}
+
+		params.Context = &value
+
+	}
+
+	// ------------- Optional query parameter "savedContext" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "savedContext", r.URL.Query(), &params.SavedContext)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "savedContext", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", r.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "at", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "snapshot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "snapshot", r.URL.Query(), &params.Snapshot)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-LD-Saved-Context" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-LD-Saved-Context")]; found {
+		var XLDSavedContext SavedContextNameHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-LD-Saved-Context", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-LD-Saved-Context", valueList[0], &XLDSavedContext, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-LD-Saved-Context", Err: err})
+			return
+		}
+
+		params.XLDSavedContext = &XLDSavedContext
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFlagExplanation(w, r, projectKey, flagKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOverrides operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOverrides(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteOverridesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOverrides(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CopyOverrides operation middleware
+func (siw *ServerInterfaceWrapper) CopyOverrides(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sourceProjectKey" -------------
+	var sourceProjectKey string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceProjectKey", mux.Vars(r)["sourceProjectKey"], &sourceProjectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceProjectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CopyOverridesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CopyOverrides(w, r, projectKey, sourceProjectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MirrorOverrides operation middleware
+func (siw *ServerInterfaceWrapper) MirrorOverrides(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "environmentKey" -------------
+	var environmentKey string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environmentKey", mux.Vars(r)["environmentKey"], &environmentKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "environmentKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MirrorOverridesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MirrorOverrides(w, r, projectKey, environmentKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFlagOverride operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFlagOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFlagOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutOverrideFlag operation middleware
+func (siw *ServerInterfaceWrapper) PutOverrideFlag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutOverrideFlag(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAIConfigOverride operation middleware
+func (siw *ServerInterfaceWrapper) PutAIConfigOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAIConfigOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutChaosOverride operation middleware
+func (siw *ServerInterfaceWrapper) PutChaosOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutChaosOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnlockOverride operation middleware
+func (siw *ServerInterfaceWrapper) UnlockOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlockOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LockOverride operation middleware
+func (siw *ServerInterfaceWrapper) LockOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LockOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutRolloutOverride operation middleware
+func (siw *ServerInterfaceWrapper) PutRolloutOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutRolloutOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOverrideSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOverrideSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOverrideSchedule(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutOverrideSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PutOverrideSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutOverrideSchedule(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutMigrationStageOverride operation middleware
+func (siw *ServerInterfaceWrapper) PutMigrationStageOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutMigrationStageOverride(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteForcedTreatment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteForcedTreatment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteForcedTreatmentParams
+
+	// ------------- Required query parameter "contextKey" -------------
+
+	if paramValue := r.URL.Query().Get("contextKey"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "contextKey"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "contextKey", r.URL.Query(), &params.ContextKey)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contextKey", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "contextKind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "contextKind", r.URL.Query(), &params.ContextKind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contextKind", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteForcedTreatment(w, r, projectKey, flagKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutForcedTreatment operation middleware
+func (siw *ServerInterfaceWrapper) PutForcedTreatment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", mux.Vars(r)["flagKey"], &flagKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutForcedTreatment(w, r, projectKey, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PurgeProject operation middleware
+func (siw *ServerInterfaceWrapper) PurgeProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PurgeProject(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvaluationRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetEvaluationRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEvaluationRequestsParams
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "flagKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "flagKey", r.URL.Query(), &params.FlagKey)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEvaluationRequests(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScenario operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScenario(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScenario(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutScenario operation middleware
+func (siw *ServerInterfaceWrapper) PutScenario(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutScenarioParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutScenario(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOverrideSchedules operation middleware
+func (siw *ServerInterfaceWrapper) GetOverrideSchedules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOverrideSchedules(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSnapshots(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) PostSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSnapshotParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSnapshot(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) RestoreSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", mux.Vars(r)["snapshotId"], &snapshotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreSnapshotParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreSnapshot(w, r, projectKey, snapshotId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectStatus(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectSummary(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFlagTriggers operation middleware
+func (siw *ServerInterfaceWrapper) GetFlagTriggers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFlagTriggers(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFlagTrigger operation middleware
+func (siw *ServerInterfaceWrapper) PostFlagTrigger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFlagTrigger(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFlagTrigger operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFlagTrigger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "triggerId" -------------
+	var triggerId TriggerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "triggerId", mux.Vars(r)["triggerId"], &triggerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "triggerId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFlagTrigger(w, r, projectKey, triggerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnarchiveProject operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnarchiveProject(w, r, projectKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFlagUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetFlagUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFlagUsageParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFlagUsage(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSecureModeHash operation middleware
+func (siw *ServerInterfaceWrapper) PostSecureModeHash(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSecureModeHash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSourceEnvironments operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceEnvironments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectKey" -------------
+	var projectKey ProjectKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectKey", mux.Vars(r)["projectKey"], &projectKey, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectKey", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSourceEnvironmentsParams
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourceEnvironments(w, r, projectKey, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FireFlagTrigger operation middleware
+func (siw *ServerInterfaceWrapper) FireFlagTrigger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "triggerId" -------------
+	var triggerId TriggerId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "triggerId", mux.Vars(r)["triggerId"], &triggerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "triggerId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FireFlagTrigger(w, r, triggerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/access-token", wrapper.PutAccessToken).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/aliases", wrapper.GetAliases).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/aliases", wrapper.PostAlias).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/aliases/{alias}", wrapper.DeleteAlias).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/backup", wrapper.GetBackup).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/backup", wrapper.RestoreBackup).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/debug-sessions", wrapper.GetDebugSessions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/debug-sessions/{debugSessionKey}", wrapper.DeleteDebugSession).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/debug-sessions/{debugSessionKey}/events", wrapper.GetDebugSessionEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/events", wrapper.GetEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/logs", wrapper.GetLogs).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects", wrapper.GetProjects).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.DeleteProject).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.GetProject).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.PatchProject).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}", wrapper.PostAddProject).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/archive", wrapper.ArchiveProject).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/audit", wrapper.GetProjectAudit).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/big-segments", wrapper.GetBigSegments).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/big-segments/{segmentKey}", wrapper.DeleteBigSegment).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/big-segments/{segmentKey}", wrapper.PutBigSegment).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/clone-from/{baseProjectKey}", wrapper.CloneProject).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/contexts", wrapper.GetSavedContexts).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/contexts/{contextName}", wrapper.DeleteSavedContext).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/contexts/{contextName}", wrapper.GetSavedContext).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/contexts/{contextName}", wrapper.PutSavedContext).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/environments", wrapper.GetEnvironments).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/file-data-source", wrapper.GetProjectFileDataSource).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/flag-state", wrapper.GetProjectFlagState).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/flags", wrapper.GetProjectFlags).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/flags/{flagKey}/experiment/simulate", wrapper.SimulateExperiment).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/flags/{flagKey}/explain", wrapper.GetFlagExplanation).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides", wrapper.DeleteOverrides).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/copy-from/{sourceProjectKey}", wrapper.CopyOverrides).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/mirror-from/{environmentKey}", wrapper.MirrorOverrides).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}", wrapper.DeleteFlagOverride).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}", wrapper.PutOverrideFlag).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/ai-config", wrapper.PutAIConfigOverride).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/chaos", wrapper.PutChaosOverride).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/lock", wrapper.UnlockOverride).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/lock", wrapper.LockOverride).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/rollout", wrapper.PutRolloutOverride).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/schedule", wrapper.DeleteOverrideSchedule).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/schedule", wrapper.PutOverrideSchedule).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/stage", wrapper.PutMigrationStageOverride).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/treatments", wrapper.DeleteForcedTreatment).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/overrides/{flagKey}/treatments", wrapper.PutForcedTreatment).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/purge", wrapper.PurgeProject).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/requests", wrapper.GetEvaluationRequests).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/scenario", wrapper.DeleteScenario).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/scenario", wrapper.PutScenario).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/schedules", wrapper.GetOverrideSchedules).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/snapshots", wrapper.GetSnapshots).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/snapshots", wrapper.PostSnapshot).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/snapshots/{snapshotId}/restore", wrapper.RestoreSnapshot).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/status", wrapper.GetProjectStatus).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/summary", wrapper.GetProjectSummary).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/triggers", wrapper.GetFlagTriggers).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/triggers", wrapper.PostFlagTrigger).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/triggers/{triggerId}", wrapper.DeleteFlagTrigger).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/unarchive", wrapper.UnarchiveProject).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{projectKey}/usage", wrapper.GetFlagUsage).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/secure-mode-hash", wrapper.PostSecureModeHash).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/source-projects/{projectKey}/environments", wrapper.GetSourceEnvironments).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/triggers/{triggerId}", wrapper.FireFlagTrigger).Methods("POST")
+
+	return r
+}
+
+type DbBackupApplicationvndSqlite3Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+type ErrorResponseJSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+type FlagOverrideJSONResponse struct {
+	// Locked whether the override is locked against changes and removal
+	Locked bool `json:"locked"`
+
+	// Override whether or not this is an overridden value or one from the source environment
+	Override bool `json:"override"`
+
+	// Rollout a percentage split of a flag between values
+	Rollout *Rollout `json:"rollout,omitempty"`
+
+	// Value value of a feature flag variation
+	Value FlagValue `json:"value"`
+}
+
+type ProjectJSONResponse Project
+
+type ProjectDeletionJSONResponse ProjectDeletion
+
+type PutAccessTokenRequestObject struct {
+	Params PutAccessTokenParams
+	Body   *PutAccessTokenJSONRequestBody
+}
+
+type PutAccessTokenResponseObject interface {
+	VisitPutAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type PutAccessToken204Response struct {
+}
+
+func (response PutAccessToken204Response) VisitPutAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutAccessToken400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutAccessToken400JSONResponse) VisitPutAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAccessToken401JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutAccessToken401JSONResponse) VisitPutAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAliasesRequestObject struct {
+}
+
+type GetAliasesResponseObject interface {
+	VisitGetAliasesResponse(w http.ResponseWriter) error
+}
+
+type GetAliases200JSONResponse []Alias
+
+func (response GetAliases200JSONResponse) VisitGetAliasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAliasRequestObject struct {
+	Body *PostAliasJSONRequestBody
+}
+
+type PostAliasResponseObject interface {
+	VisitPostAliasResponse(w http.ResponseWriter) error
+}
+
+type PostAlias201JSONResponse Alias
+
+func (response PostAlias201JSONResponse) VisitPostAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAlias400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PostAlias400JSONResponse) VisitPostAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAliasRequestObject struct {
+	Alias string `json:"alias"`
+}
+
+type DeleteAliasResponseObject interface {
+	VisitDeleteAliasResponse(w http.ResponseWriter) error
+}
+
+type DeleteAlias204Response struct {
+}
+
+func (response DeleteAlias204Response) VisitDeleteAliasResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAlias404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteAlias404JSONResponse) VisitDeleteAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBackupRequestObject struct {
+}
+
+type GetBackupResponseObject interface {
+	VisitGetBackupResponse(w http.ResponseWriter) error
+}
+
+type GetBackup200ApplicationvndSqlite3Response struct {
+	DbBackupApplicationvndSqlite3Response
+}
+
+func (response GetBackup200ApplicationvndSqlite3Response) VisitGetBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.sqlite3")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type RestoreBackupRequestObject struct {
+	Body io.Reader
+}
+
+type RestoreBackupResponseObject interface {
+	VisitRestoreBackupResponse(w http.ResponseWriter) error
+}
+
+type RestoreBackup200Response struct {
+}
+
+func (response RestoreBackup200Response) VisitRestoreBackupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetDebugSessionsRequestObject struct {
+	Params GetDebugSessionsParams
+}
+
+type GetDebugSessionsResponseObject interface {
+	VisitGetDebugSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetDebugSessions200JSONResponse DebugSessionsPage
+
+func (response GetDebugSessions200JSONResponse) VisitGetDebugSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebugSessions400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetDebugSessions400JSONResponse) VisitGetDebugSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDebugSessionRequestObject struct {
+	DebugSessionKey string `json:"debugSessionKey"`
+}
+
+type DeleteDebugSessionResponseObject interface {
+	VisitDeleteDebugSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteDebugSession204Response struct {
+}
+
+func (response DeleteDebugSession204Response) VisitDeleteDebugSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDebugSession404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteDebugSession404JSONResponse) VisitDeleteDebugSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebugSessionEventsRequestObject struct {
+	DebugSessionKey string `json:"debugSessionKey"`
+	Params          GetDebugSessionEventsParams
+}
+
+type GetDebugSessionEventsResponseObject interface {
+	VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error
+}
+
+type GetDebugSessionEvents200JSONResponse EventsPage
+
+func (response GetDebugSessionEvents200JSONResponse) VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebugSessionEvents400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetDebugSessionEvents400JSONResponse) VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebugSessionEvents404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response GetDebugSessionEvents404JSONResponse) VisitGetDebugSessionEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsRequestObject struct {
+	Params GetEventsParams
+}
+
+type GetEventsResponseObject interface {
+	VisitGetEventsResponse(w http.ResponseWriter) error
+}
+
+type GetEvents200JSONResponse struct {
+	Events []ReceivedEvent `json:"events"`
+}
+
+func (response GetEvents200JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetEvents400JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogsRequestObject struct {
+	Params GetLogsParams
+}
+
+type GetLogsResponseObject interface {
+	VisitGetLogsResponse(w http.ResponseWriter) error
+}
+
+type GetLogs200JSONResponse struct {
+	Logs []LogEntry `json:"logs"`
+
+	// Next pass as after to get the messages logged after these ones
+	Next int64 `json:"next"`
+}
+
+func (response GetLogs200JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogs400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetLogs400JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectsRequestObject struct {
+	Params GetProjectsParams
+}
+
+type GetProjectsResponseObject interface {
+	VisitGetProjectsResponse(w http.ResponseWriter) error
+}
+
+type GetProjects200JSONResponse []string
+
+func (response GetProjects200JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteProject200JSONResponse struct{ ProjectDeletionJSONResponse }
+
+func (response DeleteProject200JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response DeleteProject409JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetProjectParams
+}
+
+type GetProjectResponseObject interface {
+	VisitGetProjectResponse(w http.ResponseWriter) error
+}
+
+type GetProject200JSONResponse struct{ ProjectJSONResponse }
+
+func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProject400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProject400JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProject404Response struct {
+}
+
+func (response GetProject404Response) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PatchProjectRequestObject struct {
+	ProjectKey                       ProjectKey `json:"projectKey"`
+	Params                           PatchProjectParams
+	JSONBody                         *PatchProjectJSONRequestBody
+	ApplicationJSONPatchPlusJSONBody *PatchProjectApplicationJSONPatchPlusJSONRequestBody
+}
+
+type PatchProjectResponseObject interface {
+	VisitPatchProjectResponse(w http.ResponseWriter) error
+}
+
+type PatchProject200JSONResponse struct{ ProjectJSONResponse }
+
+func (response PatchProject200JSONResponse) VisitPatchProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProject400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PatchProject400JSONResponse) VisitPatchProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProject404Response struct {
+}
+
+func (response PatchProject404Response) VisitPatchProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PatchProject409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PatchProject409JSONResponse) VisitPatchProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAddProjectRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     PostAddProjectParams
+	Body       *PostAddProjectJSONRequestBody
+}
+
+type PostAddProjectResponseObject interface {
+	VisitPostAddProjectResponse(w http.ResponseWriter) error
+}
+
+type PostAddProject201JSONResponse struct{ ProjectJSONResponse }
+
+func (response PostAddProject201JSONResponse) VisitPostAddProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAddProject400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PostAddProject400JSONResponse) VisitPostAddProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAddProject409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PostAddProject409JSONResponse) VisitPostAddProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveProjectRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type ArchiveProjectResponseObject interface {
+	VisitArchiveProjectResponse(w http.ResponseWriter) error
+}
+
+type ArchiveProject204Response struct {
+}
+
+func (response ArchiveProject204Response) VisitArchiveProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ArchiveProject404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response ArchiveProject404JSONResponse) VisitArchiveProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectAuditRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetProjectAuditParams
+}
+
+type GetProjectAuditResponseObject interface {
+	VisitGetProjectAuditResponse(w http.ResponseWriter) error
+}
+
+type GetProjectAudit200JSONResponse struct {
+	Entries []AuditEntry `json:"entries"`
+}
+
+func (response GetProjectAudit200JSONResponse) VisitGetProjectAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectAudit404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProjectAudit404JSONResponse) VisitGetProjectAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBigSegmentsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetBigSegmentsResponseObject interface {
+	VisitGetBigSegmentsResponse(w http.ResponseWriter) error
+}
+
+type GetBigSegments200JSONResponse map[string]BigSegmentMembership
+
+func (response GetBigSegments200JSONResponse) VisitGetBigSegmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBigSegments404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetBigSegments404JSONResponse) VisitGetBigSegmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBigSegmentRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	SegmentKey SegmentKey `json:"segmentKey"`
+}
+
+type DeleteBigSegmentResponseObject interface {
+	VisitDeleteBigSegmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteBigSegment204Response struct {
+}
+
+func (response DeleteBigSegment204Response) VisitDeleteBigSegmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBigSegment404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteBigSegment404JSONResponse) VisitDeleteBigSegmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBigSegmentRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	SegmentKey SegmentKey `json:"segmentKey"`
+	Body       *PutBigSegmentJSONRequestBody
+}
+
+type PutBigSegmentResponseObject interface {
+	VisitPutBigSegmentResponse(w http.ResponseWriter) error
+}
+
+type PutBigSegment200JSONResponse BigSegmentMembership
+
+func (response PutBigSegment200JSONResponse) VisitPutBigSegmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBigSegment400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutBigSegment400JSONResponse) VisitPutBigSegmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBigSegment404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutBigSegment404JSONResponse) VisitPutBigSegmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneProjectRequestObject struct {
+	ProjectKey     ProjectKey `json:"projectKey"`
+	BaseProjectKey string     `json:"baseProjectKey"`
+	Params         CloneProjectParams
+}
+
+type CloneProjectResponseObject interface {
+	VisitCloneProjectResponse(w http.ResponseWriter) error
+}
+
+type CloneProject201JSONResponse struct{ ProjectJSONResponse }
+
+func (response CloneProject201JSONResponse) VisitCloneProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneProject400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response CloneProject400JSONResponse) VisitCloneProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneProject404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response CloneProject404JSONResponse) VisitCloneProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneProject409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response CloneProject409JSONResponse) VisitCloneProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedContextsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetSavedContextsResponseObject interface {
+	VisitGetSavedContextsResponse(w http.ResponseWriter) error
+}
+
+type GetSavedContexts200JSONResponse struct {
+	Contexts []SavedContext `json:"contexts"`
+}
+
+func (response GetSavedContexts200JSONResponse) VisitGetSavedContextsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedContexts404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetSavedContexts404JSONResponse) VisitGetSavedContextsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedContextRequestObject struct {
+	ProjectKey  ProjectKey  `json:"projectKey"`
+	ContextName ContextName `json:"contextName"`
+}
+
+type DeleteSavedContextResponseObject interface {
+	VisitDeleteSavedContextResponse(w http.ResponseWriter) error
+}
+
+type DeleteSavedContext204Response struct {
+}
+
+func (response DeleteSavedContext204Response) VisitDeleteSavedContextResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSavedContext404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteSavedContext404JSONResponse) VisitDeleteSavedContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedContextRequestObject struct {
+	ProjectKey  ProjectKey  `json:"projectKey"`
+	ContextName ContextName `json:"contextName"`
+}
+
+type GetSavedContextResponseObject interface {
+	VisitGetSavedContextResponse(w http.ResponseWriter) error
+}
+
+type GetSavedContext200JSONResponse SavedContext
+
+func (response GetSavedContext200JSONResponse) VisitGetSavedContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedContext404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetSavedContext404JSONResponse) VisitGetSavedContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedContextRequestObject struct {
+	ProjectKey  ProjectKey  `json:"projectKey"`
+	ContextName ContextName `json:"contextName"`
+	Body        *PutSavedContextJSONRequestBody
+}
+
+type PutSavedContextResponseObject interface {
+	VisitPutSavedContextResponse(w http.ResponseWriter) error
+}
+
+type PutSavedContext200JSONResponse SavedContext
+
+func (response PutSavedContext200JSONResponse) VisitPutSavedContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedContext400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutSavedContext400JSONResponse) VisitPutSavedContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSavedContext404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutSavedContext404JSONResponse) VisitPutSavedContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnvironmentsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetEnvironmentsParams
+}
+
+type GetEnvironmentsResponseObject interface {
+	VisitGetEnvironmentsResponse(w http.ResponseWriter) error
+}
+
+type GetEnvironments200JSONResponse []Environment
+
+func (response GetEnvironments200JSONResponse) VisitGetEnvironmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnvironments400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetEnvironments400JSONResponse) VisitGetEnvironmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnvironments404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response GetEnvironments404JSONResponse) VisitGetEnvironmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFileDataSourceRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetProjectFileDataSourceResponseObject interface {
+	VisitGetProjectFileDataSourceResponse(w http.ResponseWriter) error
+}
+
+type GetProjectFileDataSource200JSONResponse struct {
+	// Flags full server-side flag models, keyed by flag key
+	Flags sdk.ServerFlags `json:"flags"`
+
+	// Segments always empty, since the dev server evaluates flags without segments
+	Segments map[string]interface{} `json:"segments"`
+}
+
+func (response GetProjectFileDataSource200JSONResponse) VisitGetProjectFileDataSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFileDataSource404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProjectFileDataSource404JSONResponse) VisitGetProjectFileDataSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFlagStateRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetProjectFlagStateParams
+}
+
+type GetProjectFlagStateResponseObject interface {
+	VisitGetProjectFlagStateResponse(w http.ResponseWriter) error
+}
+
+type GetProjectFlagState200JSONResponse struct {
+	// FlagsState flags and their effective values and versions
+	FlagsState model.FlagsState `json:"flagsState"`
+
+	// Layers the layer that produced each flag's value. User overrides take precedence over scenario overrides, which take precedence over overrides a linked clone inherits from its base, which take precedence over the source environment.
+	Layers map[string]OverrideLayer `json:"layers"`
+
+	// Metadata what LaunchDarkly says about each flag. Only included with expand=metadata
+	Metadata *map[string]FlagMetadata `json:"metadata,omitempty"`
+
+	// TotalCount how many flags the project has, including the ones that aren't on this page
+	TotalCount int `json:"totalCount"`
+}
+
+func (response GetProjectFlagState200JSONResponse) VisitGetProjectFlagStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFlagState400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProjectFlagState400JSONResponse) VisitGetProjectFlagStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFlagState404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response GetProjectFlagState404JSONResponse) VisitGetProjectFlagStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFlagsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetProjectFlagsParams
+}
+
+type GetProjectFlagsResponseObject interface {
+	VisitGetProjectFlagsResponse(w http.ResponseWriter) error
+}
+
+type GetProjectFlags200JSONResponse struct {
+	Items []FoundFlag `json:"items"`
+
+	// TotalCount how many flags matched, including the ones that aren't on this page
+	TotalCount int `json:"totalCount"`
+}
+
+func (response GetProjectFlags200JSONResponse) VisitGetProjectFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFlags400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProjectFlags400JSONResponse) VisitGetProjectFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectFlags404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response GetProjectFlags404JSONResponse) VisitGetProjectFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateExperimentRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *SimulateExperimentJSONRequestBody
+}
+
+type SimulateExperimentResponseObject interface {
+	VisitSimulateExperimentResponse(w http.ResponseWriter) error
+}
+
+type SimulateExperiment200JSONResponse ExperimentSimulation
+
+func (response SimulateExperiment200JSONResponse) VisitSimulateExperimentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateExperiment400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response SimulateExperiment400JSONResponse) VisitSimulateExperimentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateExperiment404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response SimulateExperiment404JSONResponse) VisitSimulateExperimentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagExplanationRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Params     GetFlagExplanationParams
+}
+
+type GetFlagExplanationResponseObject interface {
+	VisitGetFlagExplanationResponse(w http.ResponseWriter) error
+}
+
+type GetFlagExplanation200JSONResponse FlagExplanation
+
+func (response GetFlagExplanation200JSONResponse) VisitGetFlagExplanationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagExplanation400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetFlagExplanation400JSONResponse) VisitGetFlagExplanationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagExplanation404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response GetFlagExplanation404JSONResponse) VisitGetFlagExplanationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOverridesRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     DeleteOverridesParams
+}
+
+type DeleteOverridesResponseObject interface {
+	VisitDeleteOverridesResponse(w http.ResponseWriter) error
+}
+
+type DeleteOverrides204Response struct {
+}
+
+func (response DeleteOverrides204Response) VisitDeleteOverridesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOverrides404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteOverrides404JSONResponse) VisitDeleteOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyOverridesRequestObject struct {
+	ProjectKey       ProjectKey `json:"projectKey"`
+	SourceProjectKey string     `json:"sourceProjectKey"`
+	Params           CopyOverridesParams
+	Body             *CopyOverridesJSONRequestBody
+}
+
+type CopyOverridesResponseObject interface {
+	VisitCopyOverridesResponse(w http.ResponseWriter) error
+}
+
+type CopyOverrides200JSONResponse struct {
+	// Copied keys of the flags whose overrides were copied
+	Copied []string `json:"copied"`
+
+	// Skipped why overrides weren't copied, keyed by flag key
+	Skipped map[string]string `json:"skipped"`
+}
+
+func (response CopyOverrides200JSONResponse) VisitCopyOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyOverrides400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response CopyOverrides400JSONResponse) VisitCopyOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyOverrides404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response CopyOverrides404JSONResponse) VisitCopyOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MirrorOverridesRequestObject struct {
+	ProjectKey     ProjectKey `json:"projectKey"`
+	EnvironmentKey string     `json:"environmentKey"`
+	Params         MirrorOverridesParams
+	Body           *MirrorOverridesJSONRequestBody
+}
+
+type MirrorOverridesResponseObject interface {
+	VisitMirrorOverridesResponse(w http.ResponseWriter) error
+}
+
+type MirrorOverrides200JSONResponse struct {
+	// Copied keys of the flags that were overridden with the environment's values
+	Copied []string `json:"copied"`
+
+	// Skipped why flags weren't overridden, keyed by flag key
+	Skipped map[string]string `json:"skipped"`
+}
+
+func (response MirrorOverrides200JSONResponse) VisitMirrorOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MirrorOverrides404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response MirrorOverrides404JSONResponse) VisitMirrorOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFlagOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+}
+
+type DeleteFlagOverrideResponseObject interface {
+	VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error
+}
+
+type DeleteFlagOverride204Response struct {
+}
+
+func (response DeleteFlagOverride204Response) VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteFlagOverride404Response struct {
+}
+
+func (response DeleteFlagOverride404Response) VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteFlagOverride409JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteFlagOverride409JSONResponse) VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOverrideFlagRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutOverrideFlagJSONRequestBody
+}
+
+type PutOverrideFlagResponseObject interface {
+	VisitPutOverrideFlagResponse(w http.ResponseWriter) error
+}
+
+type PutOverrideFlag200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response PutOverrideFlag200JSONResponse) VisitPutOverrideFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOverrideFlag400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutOverrideFlag400JSONResponse) VisitPutOverrideFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOverrideFlag409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutOverrideFlag409JSONResponse) VisitPutOverrideFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAIConfigOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutAIConfigOverrideJSONRequestBody
+}
+
+type PutAIConfigOverrideResponseObject interface {
+	VisitPutAIConfigOverrideResponse(w http.ResponseWriter) error
+}
+
+type PutAIConfigOverride200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response PutAIConfigOverride200JSONResponse) VisitPutAIConfigOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAIConfigOverride404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutAIConfigOverride404JSONResponse) VisitPutAIConfigOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAIConfigOverride409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutAIConfigOverride409JSONResponse) VisitPutAIConfigOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutChaosOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutChaosOverrideJSONRequestBody
+}
+
+type PutChaosOverrideResponseObject interface {
+	VisitPutChaosOverrideResponse(w http.ResponseWriter) error
+}
+
+type PutChaosOverride200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response PutChaosOverride200JSONResponse) VisitPutChaosOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutChaosOverride404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutChaosOverride404JSONResponse) VisitPutChaosOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutChaosOverride409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutChaosOverride409JSONResponse) VisitPutChaosOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+}
+
+type UnlockOverrideResponseObject interface {
+	VisitUnlockOverrideResponse(w http.ResponseWriter) error
+}
+
+type UnlockOverride200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response UnlockOverride200JSONResponse) VisitUnlockOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockOverride404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response UnlockOverride404JSONResponse) VisitUnlockOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LockOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+}
+
+type LockOverrideResponseObject interface {
+	VisitLockOverrideResponse(w http.ResponseWriter) error
+}
+
+type LockOverride200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response LockOverride200JSONResponse) VisitLockOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LockOverride404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response LockOverride404JSONResponse) VisitLockOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolloutOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutRolloutOverrideJSONRequestBody
+}
+
+type PutRolloutOverrideResponseObject interface {
+	VisitPutRolloutOverrideResponse(w http.ResponseWriter) error
+}
+
+type PutRolloutOverride200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response PutRolloutOverride200JSONResponse) VisitPutRolloutOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolloutOverride400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutRolloutOverride400JSONResponse) VisitPutRolloutOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolloutOverride404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutRolloutOverride404JSONResponse) VisitPutRolloutOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolloutOverride409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutRolloutOverride409JSONResponse) VisitPutRolloutOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOverrideScheduleRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+}
+
+type DeleteOverrideScheduleResponseObject interface {
+	VisitDeleteOverrideScheduleResponse(w http.ResponseWriter) error
+}
+
+type DeleteOverrideSchedule204Response struct {
+}
+
+func (response DeleteOverrideSchedule204Response) VisitDeleteOverrideScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOverrideSchedule404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteOverrideSchedule404JSONResponse) VisitDeleteOverrideScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOverrideScheduleRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutOverrideScheduleJSONRequestBody
+}
+
+type PutOverrideScheduleResponseObject interface {
+	VisitPutOverrideScheduleResponse(w http.ResponseWriter) error
+}
+
+type PutOverrideSchedule200JSONResponse OverrideSchedule
+
+func (response PutOverrideSchedule200JSONResponse) VisitPutOverrideScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOverrideSchedule400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutOverrideSchedule400JSONResponse) VisitPutOverrideScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOverrideSchedule404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutOverrideSchedule404JSONResponse) VisitPutOverrideScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMigrationStageOverrideRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutMigrationStageOverrideJSONRequestBody
+}
+
+type PutMigrationStageOverrideResponseObject interface {
+	VisitPutMigrationStageOverrideResponse(w http.ResponseWriter) error
+}
+
+type PutMigrationStageOverride200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response PutMigrationStageOverride200JSONResponse) VisitPutMigrationStageOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMigrationStageOverride400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutMigrationStageOverride400JSONResponse) VisitPutMigrationStageOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMigrationStageOverride404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutMigrationStageOverride404JSONResponse) VisitPutMigrationStageOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMigrationStageOverride409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutMigrationStageOverride409JSONResponse) VisitPutMigrationStageOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteForcedTreatmentRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Params     DeleteForcedTreatmentParams
+}
+
+type DeleteForcedTreatmentResponseObject interface {
+	VisitDeleteForcedTreatmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteForcedTreatment200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response DeleteForcedTreatment200JSONResponse) VisitDeleteForcedTreatmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteForcedTreatment404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteForcedTreatment404JSONResponse) VisitDeleteForcedTreatmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteForcedTreatment409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response DeleteForcedTreatment409JSONResponse) VisitDeleteForcedTreatmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutForcedTreatmentRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	FlagKey    FlagKey    `json:"flagKey"`
+	Body       *PutForcedTreatmentJSONRequestBody
+}
+
+type PutForcedTreatmentResponseObject interface {
+	VisitPutForcedTreatmentResponse(w http.ResponseWriter) error
+}
+
+type PutForcedTreatment200JSONResponse struct{ FlagOverrideJSONResponse }
+
+func (response PutForcedTreatment200JSONResponse) VisitPutForcedTreatmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutForcedTreatment404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutForcedTreatment404JSONResponse) VisitPutForcedTreatmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutForcedTreatment409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutForcedTreatment409JSONResponse) VisitPutForcedTreatmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeProjectRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type PurgeProjectResponseObject interface {
+	VisitPurgeProjectResponse(w http.ResponseWriter) error
+}
+
+type PurgeProject200JSONResponse struct{ ProjectDeletionJSONResponse }
+
+func (response PurgeProject200JSONResponse) VisitPurgeProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeProject404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PurgeProject404JSONResponse) VisitPurgeProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeProject409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PurgeProject409JSONResponse) VisitPurgeProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvaluationRequestsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetEvaluationRequestsParams
+}
+
+type GetEvaluationRequestsResponseObject interface {
+	VisitGetEvaluationRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetEvaluationRequests200JSONResponse struct {
+	Requests []EvaluationRequest `json:"requests"`
+}
+
+func (response GetEvaluationRequests200JSONResponse) VisitGetEvaluationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvaluationRequests400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetEvaluationRequests400JSONResponse) VisitGetEvaluationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScenarioRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type DeleteScenarioResponseObject interface {
+	VisitDeleteScenarioResponse(w http.ResponseWriter) error
+}
+
+type DeleteScenario204Response struct {
+}
+
+func (response DeleteScenario204Response) VisitDeleteScenarioResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScenario404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteScenario404JSONResponse) VisitDeleteScenarioResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScenarioRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     PutScenarioParams
+	Body       *PutScenarioJSONRequestBody
+}
+
+type PutScenarioResponseObject interface {
+	VisitPutScenarioResponse(w http.ResponseWriter) error
+}
+
+type PutScenario204Response struct {
+}
+
+func (response PutScenario204Response) VisitPutScenarioResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutScenario400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PutScenario400JSONResponse) VisitPutScenarioResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScenario404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PutScenario404JSONResponse) VisitPutScenarioResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOverrideSchedulesRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetOverrideSchedulesResponseObject interface {
+	VisitGetOverrideSchedulesResponse(w http.ResponseWriter) error
+}
+
+type GetOverrideSchedules200JSONResponse struct {
+	Schedules []OverrideSchedule `json:"schedules"`
+}
+
+func (response GetOverrideSchedules200JSONResponse) VisitGetOverrideSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOverrideSchedules404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetOverrideSchedules404JSONResponse) VisitGetOverrideSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshotsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetSnapshotsResponseObject interface {
+	VisitGetSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type GetSnapshots200JSONResponse struct {
+	Snapshots []Snapshot `json:"snapshots"`
+}
+
+func (response GetSnapshots200JSONResponse) VisitGetSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshots404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetSnapshots404JSONResponse) VisitGetSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSnapshotRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     PostSnapshotParams
+}
+
+type PostSnapshotResponseObject interface {
+	VisitPostSnapshotResponse(w http.ResponseWriter) error
+}
+
+type PostSnapshot200JSONResponse Snapshot
+
+func (response PostSnapshot200JSONResponse) VisitPostSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSnapshot404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PostSnapshot404JSONResponse) VisitPostSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreSnapshotRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	SnapshotId int64      `json:"snapshotId"`
+	Params     RestoreSnapshotParams
+}
+
+type RestoreSnapshotResponseObject interface {
+	VisitRestoreSnapshotResponse(w http.ResponseWriter) error
+}
+
+type RestoreSnapshot200JSONResponse struct {
+	// Skipped why overrides weren't restored, keyed by flag key
+	Skipped map[string]string `json:"skipped"`
+
+	// Snapshot a copy of the flag state synced from the source environment, which flags can be evaluated as of
+	Snapshot Snapshot `json:"snapshot"`
+}
+
+func (response RestoreSnapshot200JSONResponse) VisitRestoreSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreSnapshot404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response RestoreSnapshot404JSONResponse) VisitRestoreSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectStatusRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetProjectStatusResponseObject interface {
+	VisitGetProjectStatusResponse(w http.ResponseWriter) error
+}
+
+type GetProjectStatus200JSONResponse struct {
+	// FlagsWithSynthesizedVariationIds keys of flags whose variations had no IDs in LaunchDarkly, so the dev server made up IDs for them
+	FlagsWithSynthesizedVariationIds []string `json:"flagsWithSynthesizedVariationIds"`
+
+	// Orphaned set when the project or environment the project syncs from was deleted or renamed in LaunchDarkly. The project keeps the flag values from its last successful sync.
+	Orphaned *Orphaned `json:"orphaned,omitempty"`
+
+	// Stale whether the project hasn't been synced within the dev server's staleness threshold
+	Stale bool `json:"stale"`
+
+	// SyncStatus the most recent attempt to sync the project from its source environment
+	SyncStatus *SyncStatus `json:"syncStatus,omitempty"`
+}
+
+func (response GetProjectStatus200JSONResponse) VisitGetProjectStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectStatus404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProjectStatus404JSONResponse) VisitGetProjectStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectSummaryRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetProjectSummaryResponseObject interface {
+	VisitGetProjectSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetProjectSummary200JSONResponse struct {
+	// Conflicts number of flags whose user override hides an active scenario override
+	Conflicts int `json:"conflicts"`
+
+	// Flags number of flags in the project
+	Flags int `json:"flags"`
+
+	// Orphaned whether the project's source was deleted or renamed in LaunchDarkly
+	Orphaned bool `json:"orphaned"`
+
+	// Overrides number of flags with an active override in any layer
+	Overrides int `json:"overrides"`
+
+	// StaleSeconds seconds since the project was last synced from LaunchDarkly
+	StaleSeconds int `json:"staleSeconds"`
+}
+
+func (response GetProjectSummary200JSONResponse) VisitGetProjectSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectSummary404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetProjectSummary404JSONResponse) VisitGetProjectSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagTriggersRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type GetFlagTriggersResponseObject interface {
+	VisitGetFlagTriggersResponse(w http.ResponseWriter) error
+}
+
+type GetFlagTriggers200JSONResponse struct {
+	Triggers []FlagTrigger `json:"triggers"`
+}
+
+func (response GetFlagTriggers200JSONResponse) VisitGetFlagTriggersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagTriggers404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetFlagTriggers404JSONResponse) VisitGetFlagTriggersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFlagTriggerRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Body       *PostFlagTriggerJSONRequestBody
+}
+
+type PostFlagTriggerResponseObject interface {
+	VisitPostFlagTriggerResponse(w http.ResponseWriter) error
+}
+
+type PostFlagTrigger201JSONResponse FlagTrigger
+
+func (response PostFlagTrigger201JSONResponse) VisitPostFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFlagTrigger400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PostFlagTrigger400JSONResponse) VisitPostFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFlagTrigger404JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response PostFlagTrigger404JSONResponse) VisitPostFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFlagTriggerRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	TriggerId  TriggerId  `json:"triggerId"`
+}
+
+type DeleteFlagTriggerResponseObject interface {
+	VisitDeleteFlagTriggerResponse(w http.ResponseWriter) error
+}
+
+type DeleteFlagTrigger204Response struct {
+}
+
+func (response DeleteFlagTrigger204Response) VisitDeleteFlagTriggerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteFlagTrigger404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response DeleteFlagTrigger404JSONResponse) VisitDeleteFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveProjectRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+}
+
+type UnarchiveProjectResponseObject interface {
+	VisitUnarchiveProjectResponse(w http.ResponseWriter) error
+}
+
+type UnarchiveProject204Response struct {
+}
+
+func (response UnarchiveProject204Response) VisitUnarchiveProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnarchiveProject404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response UnarchiveProject404JSONResponse) VisitUnarchiveProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagUsageRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetFlagUsageParams
+}
+
+type GetFlagUsageResponseObject interface {
+	VisitGetFlagUsageResponse(w http.ResponseWriter) error
+}
+
+type GetFlagUsage200JSONResponse struct {
+	Flags []FlagUsage `json:"flags"`
+}
+
+func (response GetFlagUsage200JSONResponse) VisitGetFlagUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFlagUsage404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetFlagUsage404JSONResponse) VisitGetFlagUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSecureModeHashRequestObject struct {
+	Body *PostSecureModeHashJSONRequestBody
+}
+
+type PostSecureModeHashResponseObject interface {
+	VisitPostSecureModeHashResponse(w http.ResponseWriter) error
+}
+
+type PostSecureModeHash200JSONResponse struct {
+	// Hash hex encoded HMAC-SHA256 of the context's fully qualified key
+	Hash string `json:"hash"`
+}
+
+func (response PostSecureModeHash200JSONResponse) VisitPostSecureModeHashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSecureModeHash400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response PostSecureModeHash400JSONResponse) VisitPostSecureModeHashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceEnvironmentsRequestObject struct {
+	ProjectKey ProjectKey `json:"projectKey"`
+	Params     GetSourceEnvironmentsParams
+}
+
+type GetSourceEnvironmentsResponseObject interface {
+	VisitGetSourceEnvironmentsResponse(w http.ResponseWriter) error
+}
+
+type GetSourceEnvironments200JSONResponse []Environment
+
+func (response GetSourceEnvironments200JSONResponse) VisitGetSourceEnvironmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceEnvironments404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetSourceEnvironments404JSONResponse) VisitGetSourceEnvironmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FireFlagTriggerRequestObject struct {
+	TriggerId TriggerId `json:"triggerId"`
+}
+
+type FireFlagTriggerResponseObject interface {
+	VisitFireFlagTriggerResponse(w http.ResponseWriter) error
+}
+
+type FireFlagTrigger200JSONResponse FlagTrigger
+
+func (response FireFlagTrigger200JSONResponse) VisitFireFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FireFlagTrigger404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response FireFlagTrigger404JSONResponse) VisitFireFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FireFlagTrigger409JSONResponse struct {
+	// Code specific error code encountered
+	Code string `json:"code"`
+
+	// Message description of the error
+	Message string `json:"message"`
+}
+
+func (response FireFlagTrigger409JSONResponse) VisitFireFlagTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// replace the LaunchDarkly access token the dev server uses, without restarting it or disconnecting SDKs. The request must be authorized with the current token
+	// (PUT /access-token)
+	PutAccessToken(ctx context.Context, request PutAccessTokenRequestObject) (PutAccessTokenResponseObject, error)
+	// list the aliases that map SDK credentials to dev projects
+	// (GET /aliases)
+	GetAliases(ctx context.Context, request GetAliasesRequestObject) (GetAliasesResponseObject, error)
+	// map an SDK credential, such as a production mobile key or client-side ID, to a dev project. Replaces any existing alias with the same name
+	// (POST /aliases)
+	PostAlias(ctx context.Context, request PostAliasRequestObject) (PostAliasResponseObject, error)
+	// remove the alias
+	// (DELETE /aliases/{alias})
+	DeleteAlias(ctx context.Context, request DeleteAliasRequestObject) (DeleteAliasResponseObject, error)
+	// get the backup
+	// (GET /backup)
+	GetBackup(ctx context.Context, request GetBackupRequestObject) (GetBackupResponseObject, error)
+	// post backup
+	// (POST /backup)
+	RestoreBackup(ctx context.Context, request RestoreBackupRequestObject) (RestoreBackupResponseObject, error)
+	// list all debug sessions with event counts
+	// (GET /debug-sessions)
+	GetDebugSessions(ctx context.Context, request GetDebugSessionsRequestObject) (GetDebugSessionsResponseObject, error)
+	// delete a specific debug session and all its events
+	// (DELETE /debug-sessions/{debugSessionKey})
+	DeleteDebugSession(ctx context.Context, request DeleteDebugSessionRequestObject) (DeleteDebugSessionResponseObject, error)
+	// get events for a specific debug session
+	// (GET /debug-sessions/{debugSessionKey}/events)
+	GetDebugSessionEvents(ctx context.Context, request GetDebugSessionEventsRequestObject) (GetDebugSessionEventsResponseObject, error)
+	// list the most recent analytics events received from SDKs, oldest first
+	// (GET /events)
+	GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error)
+	// list the most recent messages the server logged, oldest first
+	// (GET /logs)
+	GetLogs(ctx context.Context, request GetLogsRequestObject) (GetLogsResponseObject, error)
+	// lists all projects that have been configured for the dev server
+	// (GET /projects)
+	GetProjects(ctx context.Context, request GetProjectsRequestObject) (GetProjectsResponseObject, error)
+	// remove the specified project from the dev server. Projects with linked clones can't be removed until the clones are
+	// (DELETE /projects/{projectKey})
+	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
+	// get the specified project and its configuration for syncing from the LaunchDarkly Service
+	// (GET /projects/{projectKey})
+	GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error)
+	// updates the project context or sourceEnvironmentKey then syncs.  Input an empty body to only force a sync. Orphaned projects are only synced again when sourceEnvironmentKey is set, though a new context alone is saved without syncing, and archived projects aren't synced. Changes can also be sent as an RFC 6902 JSON Patch of the project's sourceEnvironmentKey, context and flagFilter, with a test operation to only make them if the project hasn't been changed by someone else.
+	// (PATCH /projects/{projectKey})
+	PatchProject(ctx context.Context, request PatchProjectRequestObject) (PatchProjectResponseObject, error)
+	// Add the project to the dev server
+	// (POST /projects/{projectKey})
+	PostAddProject(ctx context.Context, request PostAddProjectRequestObject) (PostAddProjectResponseObject, error)
+	// archive the project. Archived projects aren't synced or served to SDKs, but keep their overrides and can be unarchived
+	// (POST /projects/{projectKey}/archive)
+	ArchiveProject(ctx context.Context, request ArchiveProjectRequestObject) (ArchiveProjectResponseObject, error)
+	// list who changed the project's overrides and when, most recent first
+	// (GET /projects/{projectKey}/audit)
+	GetProjectAudit(ctx context.Context, request GetProjectAuditRequestObject) (GetProjectAuditResponseObject, error)
+	// list the emulated big segments for the project and their members
+	// (GET /projects/{projectKey}/big-segments)
+	GetBigSegments(ctx context.Context, request GetBigSegmentsRequestObject) (GetBigSegmentsResponseObject, error)
+	// remove the emulated big segment. The project is synced again so that its flags' values reflect the removal
+	// (DELETE /projects/{projectKey}/big-segments/{segmentKey})
+	DeleteBigSegment(ctx context.Context, request DeleteBigSegmentRequestObject) (DeleteBigSegmentResponseObject, error)
+	// set which context keys are included in or excluded from the emulated big segment. The project is synced again so that its flags' values reflect the membership
+	// (PUT /projects/{projectKey}/big-segments/{segmentKey})
+	PutBigSegment(ctx context.Context, request PutBigSegmentRequestObject) (PutBigSegmentResponseObject, error)
+	// add the project as a linked clone of the base project. The clone starts with the base's flags, is synced whenever the base is, and inherits the base's overrides as they change, beneath any overrides set on the clone itself
+	// (POST /projects/{projectKey}/clone-from/{baseProjectKey})
+	CloneProject(ctx context.Context, request CloneProjectRequestObject) (CloneProjectResponseObject, error)
+	// list the contexts saved with the project
+	// (GET /projects/{projectKey}/contexts)
+	GetSavedContexts(ctx context.Context, request GetSavedContextsRequestObject) (GetSavedContextsResponseObject, error)
+	// remove a context saved with the project
+	// (DELETE /projects/{projectKey}/contexts/{contextName})
+	DeleteSavedContext(ctx context.Context, request DeleteSavedContextRequestObject) (DeleteSavedContextResponseObject, error)
+	// get a context saved with the project
+	// (GET /projects/{projectKey}/contexts/{contextName})
+	GetSavedContext(ctx context.Context, request GetSavedContextRequestObject) (GetSavedContextResponseObject, error)
+	// save a context with the project under a name, replacing whichever context was saved under it
+	// (PUT /projects/{projectKey}/contexts/{contextName})
+	PutSavedContext(ctx context.Context, request PutSavedContextRequestObject) (PutSavedContextResponseObject, error)
+	// list all environments for the given project
+	// (GET /projects/{projectKey}/environments)
+	GetEnvironments(ctx context.Context, request GetEnvironmentsRequestObject) (GetEnvironmentsResponseObject, error)
+	// render the project's flags, with overrides applied, as a LaunchDarkly SDK file data source
+	// (GET /projects/{projectKey}/file-data-source)
+	GetProjectFileDataSource(ctx context.Context, request GetProjectFileDataSourceRequestObject) (GetProjectFileDataSourceResponseObject, error)
+	// get the effective flag values for the project, with overrides applied. Pass `at` or `snapshot` to see them as they were at a past moment
+	// (GET /projects/{projectKey}/flag-state)
+	GetProjectFlagState(ctx context.Context, request GetProjectFlagStateRequestObject) (GetProjectFlagStateResponseObject, error)
+	// search the project's flags, with overrides applied. Flags are ordered by key, and only the flags matching every filter given are returned
+	// (GET /projects/{projectKey}/flags)
+	GetProjectFlags(ctx context.Context, request GetProjectFlagsRequestObject) (GetProjectFlagsResponseObject, error)
+	// bucket contexts into an experiment's treatments the way SDKs would, without serving anything
+	// (POST /projects/{projectKey}/flags/{flagKey}/experiment/simulate)
+	SimulateExperiment(ctx context.Context, request SimulateExperimentRequestObject) (SimulateExperimentResponseObject, error)
+	// explain why a context is served the value it gets for a flag
+	// (GET /projects/{projectKey}/flags/{flagKey}/explain)
+	GetFlagExplanation(ctx context.Context, request GetFlagExplanationRequestObject) (GetFlagExplanationResponseObject, error)
+	// remove all unlocked overrides for the given project
+	// (DELETE /projects/{projectKey}/overrides)
+	DeleteOverrides(ctx context.Context, request DeleteOverridesRequestObject) (DeleteOverridesResponseObject, error)
+	// copy the source project's active overrides into the project, replacing the project's overrides for the same flags. Overrides for flags the project doesn't have, or whose override is locked, are skipped
+	// (POST /projects/{projectKey}/overrides/copy-from/{sourceProjectKey})
+	CopyOverrides(ctx context.Context, request CopyOverridesRequestObject) (CopyOverridesResponseObject, error)
+	// override the project's flags with the values LaunchDarkly serves the project's context in another environment, replacing the project's overrides for the same flags. Flags the project doesn't have are left out, and flags whose override is locked are skipped
+	// (POST /projects/{projectKey}/overrides/mirror-from/{environmentKey})
+	MirrorOverrides(ctx context.Context, request MirrorOverridesRequestObject) (MirrorOverridesResponseObject, error)
+	// remove override for flag
+	// (DELETE /projects/{projectKey}/overrides/{flagKey})
+	DeleteFlagOverride(ctx context.Context, request DeleteFlagOverrideRequestObject) (DeleteFlagOverrideResponseObject, error)
+	// override flag value with value provided in the body
+	// (PUT /projects/{projectKey}/overrides/{flagKey})
+	PutOverrideFlag(ctx context.Context, request PutOverrideFlagRequestObject) (PutOverrideFlagResponseObject, error)
+	// override an AI Config with another of its variations, a different model or prompt messages, or both. Remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/ai-config)
+	PutAIConfigOverride(ctx context.Context, request PutAIConfigOverrideRequestObject) (PutAIConfigOverrideResponseObject, error)
+	// override the flag with a random one of its variations, for testing how an app copes with values it doesn't expect. The override is an equal rollout between the flag's variations, so remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/chaos)
+	PutChaosOverride(ctx context.Context, request PutChaosOverrideRequestObject) (PutChaosOverrideResponseObject, error)
+	// unlock the flag's override
+	// (DELETE /projects/{projectKey}/overrides/{flagKey}/lock)
+	UnlockOverride(ctx context.Context, request UnlockOverrideRequestObject) (UnlockOverrideResponseObject, error)
+	// lock the flag's override so that it can't be changed or removed, even by removing all overrides, until it's unlocked. Locked overrides are also kept if a sync finds their flag gone
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/lock)
+	LockOverride(ctx context.Context, request LockOverrideRequestObject) (LockOverrideResponseObject, error)
+	// override the flag with a percentage rollout. Contexts are bucketed by key the way LaunchDarkly rollouts are, so each keeps getting the same value. Remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/rollout)
+	PutRolloutOverride(ctx context.Context, request PutRolloutOverrideRequestObject) (PutRolloutOverrideResponseObject, error)
+	// cancel the flag's schedule without changing its override
+	// (DELETE /projects/{projectKey}/overrides/{flagKey}/schedule)
+	DeleteOverrideSchedule(ctx context.Context, request DeleteOverrideScheduleRequestObject) (DeleteOverrideScheduleResponseObject, error)
+	// schedule the flag's override to be activated with a value at activateAt and removed at deactivateAt, replacing any schedule the flag already has
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/schedule)
+	PutOverrideSchedule(ctx context.Context, request PutOverrideScheduleRequestObject) (PutOverrideScheduleResponseObject, error)
+	// pin a migration flag to one of its stages, so that SDKs run the migration in that stage. Remove it like any other override
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/stage)
+	PutMigrationStageOverride(ctx context.Context, request PutMigrationStageOverrideRequestObject) (PutMigrationStageOverrideResponseObject, error)
+	// let a context be bucketed into a variation of the flag's rollout override again
+	// (DELETE /projects/{projectKey}/overrides/{flagKey}/treatments)
+	DeleteForcedTreatment(ctx context.Context, request DeleteForcedTreatmentRequestObject) (DeleteForcedTreatmentResponseObject, error)
+	// force a context into one of the variations of the flag's rollout override, so that an experiment's treatment can be tried out
+	// (PUT /projects/{projectKey}/overrides/{flagKey}/treatments)
+	PutForcedTreatment(ctx context.Context, request PutForcedTreatmentRequestObject) (PutForcedTreatmentResponseObject, error)
+	// permanently delete an archived project along with its overrides, aliases, and history
+	// (POST /projects/{projectKey}/purge)
+	PurgeProject(ctx context.Context, request PurgeProjectRequestObject) (PurgeProjectResponseObject, error)
+	// list the most recent evaluations SDKs reported for the project in their events, oldest first
+	// (GET /projects/{projectKey}/requests)
+	GetEvaluationRequests(ctx context.Context, request GetEvaluationRequestsRequestObject) (GetEvaluationRequestsResponseObject, error)
+	// clear the project's scenario layer
+	// (DELETE /projects/{projectKey}/scenario)
+	DeleteScenario(ctx context.Context, request DeleteScenarioRequestObject) (DeleteScenarioResponseObject, error)
+	// replace the project's scenario layer. Scenario overrides apply underneath manual overrides, so applying or clearing a scenario leaves manual overrides in place
+	// (PUT /projects/{projectKey}/scenario)
+	PutScenario(ctx context.Context, request PutScenarioRequestObject) (PutScenarioResponseObject, error)
+	// list the project's pending override schedules
+	// (GET /projects/{projectKey}/schedules)
+	GetOverrideSchedules(ctx context.Context, request GetOverrideSchedulesRequestObject) (GetOverrideSchedulesResponseObject, error)
+	// list the snapshots of the project's flag state that flags can be evaluated as of. One is recorded every time the project is synced
+	// (GET /projects/{projectKey}/snapshots)
+	GetSnapshots(ctx context.Context, request GetSnapshotsRequestObject) (GetSnapshotsResponseObject, error)
+	// take a snapshot of the project's current flag state, without syncing it, to restore the project to later
+	// (POST /projects/{projectKey}/snapshots)
+	PostSnapshot(ctx context.Context, request PostSnapshotRequestObject) (PostSnapshotResponseObject, error)
+	// roll the project back to the flag state in the snapshot and the overrides it had when the snapshot was taken. Locked overrides are kept as they are. Linked clones only have their overrides restored
+	// (POST /projects/{projectKey}/snapshots/{snapshotId}/restore)
+	RestoreSnapshot(ctx context.Context, request RestoreSnapshotRequestObject) (RestoreSnapshotResponseObject, error)
+	// report problems with the project's data that the dev server worked around
+	// (GET /projects/{projectKey}/status)
+	GetProjectStatus(ctx context.Context, request GetProjectStatusRequestObject) (GetProjectStatusResponseObject, error)
+	// get compact counts for the project, for status bar widgets that poll frequently
+	// (GET /projects/{projectKey}/summary)
+	GetProjectSummary(ctx context.Context, request GetProjectSummaryRequestObject) (GetProjectSummaryResponseObject, error)
+	// list the project's flag triggers
+	// (GET /projects/{projectKey}/triggers)
+	GetFlagTriggers(ctx context.Context, request GetFlagTriggersRequestObject) (GetFlagTriggersResponseObject, error)
+	// add a trigger for a boolean flag, a local version of a LaunchDarkly flag trigger. POSTing to the trigger's path overrides the flag to true if it turns the flag on, or false if it turns it off
+	// (POST /projects/{projectKey}/triggers)
+	PostFlagTrigger(ctx context.Context, request PostFlagTriggerRequestObject) (PostFlagTriggerResponseObject, error)
+	// remove a flag trigger, so its path stops working
+	// (DELETE /projects/{projectKey}/triggers/{triggerId})
+	DeleteFlagTrigger(ctx context.Context, request DeleteFlagTriggerRequestObject) (DeleteFlagTriggerResponseObject, error)
+	// make an archived project available again
+	// (POST /projects/{projectKey}/unarchive)
+	UnarchiveProject(ctx context.Context, request UnarchiveProjectRequestObject) (UnarchiveProjectResponseObject, error)
+	// summarize the evaluations SDKs reported for the project by flag, including the project's flags that weren't evaluated and the flags that were evaluated but that the project doesn't have
+	// (GET /projects/{projectKey}/usage)
+	GetFlagUsage(ctx context.Context, request GetFlagUsageRequestObject) (GetFlagUsageResponseObject, error)
+	// generate the secure mode hash for a context using the secret the dev server was started with
+	// (POST /secure-mode-hash)
+	PostSecureModeHash(ctx context.Context, request PostSecureModeHashRequestObject) (PostSecureModeHashResponseObject, error)
+	// list the environments of a LaunchDarkly project, whether or not it has been added to the dev server, e.g. to choose a source environment before adding it
+	// (GET /source-projects/{projectKey}/environments)
+	GetSourceEnvironments(ctx context.Context, request GetSourceEnvironmentsRequestObject) (GetSourceEnvironmentsResponseObject, error)
+	// fire a flag trigger, overriding its flag to true if it turns the flag on, or false if it turns it off. Like LaunchDarkly's trigger URLs, it needs no credentials and ignores the request body
+	// (POST /triggers/{triggerId})
+	FireFlagTrigger(ctx context.Context, request FireFlagTriggerRequestObject) (FireFlagTriggerResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// PutAccessToken operation middleware
+func (sh *strictHandler) PutAccessToken(w http.ResponseWriter, r *http.Request, params PutAccessTokenParams) {
+	var request PutAccessTokenRequestObject
+
+	request.Params = params
+
+	var body PutAccessTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAccessToken(ctx, request.(PutAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAccessToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAccessTokenResponseObject); ok {
+		if err := validResponse.VisitPutAccessTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAliases operation middleware
+func (sh *strictHandler) GetAliases(w http.ResponseWriter, r *http.Request) {
+	var request GetAliasesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAliases(ctx, request.(GetAliasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAliases")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAliasesResponseObject); ok {
+		if err := validResponse.VisitGetAliasesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAlias operation middleware
+func (sh *strictHandler) PostAlias(w http.ResponseWriter, r *http.Request) {
+	var request PostAliasRequestObject
+
+	var body PostAliasJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAlias(ctx, request.(PostAliasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAlias")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAliasResponseObject); ok {
+		if err := validResponse.VisitPostAliasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAlias operation middleware
+func (sh *strictHandler) DeleteAlias(w http.ResponseWriter, r *http.Request, alias string) {
+	var request DeleteAliasRequestObject
+
+	request.Alias = alias
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAlias(ctx, request.(DeleteAliasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAlias")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAliasResponseObject); ok {
+		if err := validResponse.VisitDeleteAliasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBackup operation middleware
+func (sh *strictHandler) GetBackup(w http.ResponseWriter, r *http.Request) {
+	var request GetBackupRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBackup(ctx, request.(GetBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBackup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBackupResponseObject); ok {
+		if err := validResponse.VisitGetBackupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreBackup operation middleware
+func (sh *strictHandler) RestoreBackup(w http.ResponseWriter, r *http.Request) {
+	var request RestoreBackupRequestObject
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreBackup(ctx, request.(RestoreBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreBackup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreBackupResponseObject); ok {
+		if err := validResponse.VisitRestoreBackupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDebugSessions operation middleware
+func (sh *strictHandler) GetDebugSessions(w http.ResponseWriter, r *http.Request, params GetDebugSessionsParams) {
+	var request GetDebugSessionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDebugSessions(ctx, request.(GetDebugSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDebugSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDebugSessionsResponseObject); ok {
+		if err := validResponse.VisitGetDebugSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteDebugSession operation middleware
+func (sh *strictHandler) DeleteDebugSession(w http.ResponseWriter, r *http.Request, debugSessionKey string) {
+	var request DeleteDebugSessionRequestObject
+
+	request.DebugSessionKey = debugSessionKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDebugSession(ctx, request.(DeleteDebugSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDebugSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDebugSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteDebugSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDebugSessionEvents operation middleware
+func (sh *strictHandler) GetDebugSessionEvents(w http.ResponseWriter, r *http.Request, debugSessionKey string, params GetDebugSessionEventsParams) {
+	var request GetDebugSessionEventsRequestObject
+
+	request.DebugSessionKey = debugSessionKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDebugSessionEvents(ctx, request.(GetDebugSessionEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDebugSessionEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDebugSessionEventsResponseObject); ok {
+		if err := validResponse.VisitGetDebugSessionEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEvents operation middleware
+func (sh *strictHandler) GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams) {
+	var request GetEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvents(ctx, request.(GetEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEventsResponseObject); ok {
+		if err := validResponse.VisitGetEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLogs operation middleware
+func (sh *strictHandler) GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams) {
+	var request GetLogsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogs(ctx, request.(GetLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogsResponseObject); ok {
+		if err := validResponse.VisitGetLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjects operation middleware
+func (sh *strictHandler) GetProjects(w http.ResponseWriter, r *http.Request, params GetProjectsParams) {
+	var request GetProjectsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjects(ctx, request.(GetProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectsResponseObject); ok {
+		if err := validResponse.VisitGetProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProject operation middleware
+func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request DeleteProjectRequestObject
+
+	request.ProjectKey = projectKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProject operation middleware
+func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectParams) {
+	var request GetProjectRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
+		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchProject operation middleware
+func (sh *strictHandler) PatchProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PatchProjectParams) {
+	var request PatchProjectRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body PatchProjectJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json-patch+json") {
+
+		var body PatchProjectApplicationJSONPatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProject(ctx, request.(PatchProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchProjectResponseObject); ok {
+		if err := validResponse.VisitPatchProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAddProject operation middleware
+func (sh *strictHandler) PostAddProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PostAddProjectParams) {
+	var request PostAddProjectRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	var body PostAddProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAddProject(ctx, request.(PostAddProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAddProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAddProjectResponseObject); ok {
+		if err := validResponse.VisitPostAddProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveProject operation middleware
+func (sh *strictHandler) ArchiveProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request ArchiveProjectRequestObject
+
+	request.ProjectKey = projectKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveProject(ctx, request.(ArchiveProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveProjectResponseObject); ok {
+		if err := validResponse.VisitArchiveProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjectAudit operation middleware
+func (sh *strictHandler) GetProjectAudit(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectAuditParams) {
+	var request GetProjectAuditRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectAudit(ctx, request.(GetProjectAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectAuditResponseObject); ok {
+		if err := validResponse.VisitGetProjectAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBigSegments operation middleware
+func (sh *strictHandler) GetBigSegments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetBigSegmentsRequestObject
+
+	request.ProjectKey = projectKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBigSegments(ctx, request.(GetBigSegmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBigSegments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBigSegmentsResponseObject); ok {
+		if err := validResponse.VisitGetBigSegmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteBigSegment operation middleware
+func (sh *strictHandler) DeleteBigSegment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, segmentKey SegmentKey) {
+	var request DeleteBigSegmentRequestObject
+
+	request.ProjectKey = projectKey
+	request.SegmentKey = segmentKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBigSegment(ctx, request.(DeleteBigSegmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBigSegment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBigSegmentResponseObject); ok {
+		if err := validResponse.VisitDeleteBigSegmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutBigSegment operation middleware
+func (sh *strictHandler) PutBigSegment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, segmentKey SegmentKey) {
+	var request PutBigSegmentRequestObject
+
+	request.ProjectKey = projectKey
+	request.SegmentKey = segmentKey
+
+	var body PutBigSegmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBigSegment(ctx, request.(PutBigSegmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBigSegment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutBigSegmentResponseObject); ok {
+		if err := validResponse.VisitPutBigSegmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CloneProject operation middleware
+func (sh *strictHandler) CloneProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, baseProjectKey string, params CloneProjectParams) {
+	var request CloneProjectRequestObject
+
+	request.ProjectKey = projectKey
+	request.BaseProjectKey = baseProjectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CloneProject(ctx, request.(CloneProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloneProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CloneProjectResponseObject); ok {
+		if err := validResponse.VisitCloneProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSavedContexts operation middleware
+func (sh *strictHandler) GetSavedContexts(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetSavedContextsRequestObject
+
+	request.ProjectKey = projectKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSavedContexts(ctx, request.(GetSavedContextsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSavedContexts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSavedContextsResponseObject); ok {
+		if err := validResponse.VisitGetSavedContextsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSavedContext operation middleware
+func (sh *strictHandler) DeleteSavedContext(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, contextName ContextName) {
+	var request DeleteSavedContextRequestObject
+
+	request.ProjectKey = projectKey
+	request.ContextName = contextName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSavedContext(ctx, request.(DeleteSavedContextRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSavedContext")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSavedContextResponseObject); ok {
+		if err := validResponse.VisitDeleteSavedContextResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSavedContext operation middleware
+func (sh *strictHandler) GetSavedContext(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, contextName ContextName) {
+	var request GetSavedContextRequestObject
+
+	request.ProjectKey = projectKey
+	request.ContextName = contextName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSavedContext(ctx, request.(GetSavedContextRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSavedContext")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSavedContextResponseObject); ok {
+		if err := validResponse.VisitGetSavedContextResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutSavedContext operation middleware
+func (sh *strictHandler) PutSavedContext(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, contextName ContextName) {
+	var request PutSavedContextRequestObject
+
+	request.ProjectKey = projectKey
+	request.ContextName = contextName
+
+	var body PutSavedContextJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutSavedContext(ctx, request.(PutSavedContextRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutSavedContext")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutSavedContextResponseObject); ok {
+		if err := validResponse.VisitPutSavedContextResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEnvironments operation middleware
+func (sh *strictHandler) GetEnvironments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetEnvironmentsParams) {
+	var request GetEnvironmentsRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnvironments(ctx, request.(GetEnvironmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnvironments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEnvironmentsResponseObject); ok {
+		if err := validResponse.VisitGetEnvironmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjectFileDataSource operation middleware
+func (sh *strictHandler) GetProjectFileDataSource(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetProjectFileDataSourceRequestObject
+
+	request.ProjectKey = projectKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectFileDataSource(ctx, request.(GetProjectFileDataSourceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectFileDataSource")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectFileDataSourceResponseObject); ok {
+		if err := validResponse.VisitGetProjectFileDataSourceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjectFlagState operation middleware
+func (sh *strictHandler) GetProjectFlagState(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectFlagStateParams) {
+	var request GetProjectFlagStateRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectFlagState(ctx, request.(GetProjectFlagStateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectFlagState")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectFlagStateResponseObject); ok {
+		if err := validResponse.VisitGetProjectFlagStateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjectFlags operation middleware
+func (sh *strictHandler) GetProjectFlags(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectFlagsParams) {
+	var request GetProjectFlagsRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectFlags(ctx, request.(GetProjectFlagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectFlags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectFlagsResponseObject); ok {
+		if err := validResponse.VisitGetProjectFlagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SimulateExperiment operation middleware
+func (sh *strictHandler) SimulateExperiment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request SimulateExperimentRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	var body SimulateExperimentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SimulateExperiment(ctx, request.(SimulateExperimentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SimulateExperiment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SimulateExperimentResponseObject); ok {
+		if err := validResponse.VisitSimulateExperimentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFlagExplanation operation middleware
+func (sh *strictHandler) GetFlagExplanation(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey, params GetFlagExplanationParams) {
+	var request GetFlagExplanationRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFlagExplanation(ctx, request.(GetFlagExplanationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFlagExplanation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFlagExplanationResponseObject); ok {
+		if err := validResponse.VisitGetFlagExplanationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOverrides operation middleware
+func (sh *strictHandler) DeleteOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params DeleteOverridesParams) {
+	var request DeleteOverridesRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOverrides(ctx, request.(DeleteOverridesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOverrides")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOverridesResponseObject); ok {
+		if err := validResponse.VisitDeleteOverridesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CopyOverrides operation middleware
+func (sh *strictHandler) CopyOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, sourceProjectKey string, params CopyOverridesParams) {
+	var request CopyOverridesRequestObject
+
+	request.ProjectKey = projectKey
+	request.SourceProjectKey = sourceProjectKey
+	request.Params = params
+
+	var body CopyOverridesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CopyOverrides(ctx, request.(CopyOverridesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CopyOverrides")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CopyOverridesResponseObject); ok {
+		if err := validResponse.VisitCopyOverridesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MirrorOverrides operation middleware
+func (sh *strictHandler) MirrorOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, environmentKey string, params MirrorOverridesParams) {
+	var request MirrorOverridesRequestObject
+
+	request.ProjectKey = projectKey
+	request.EnvironmentKey = environmentKey
+	request.Params = params
+
+	var body MirrorOverridesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MirrorOverrides(ctx, request.(MirrorOverridesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MirrorOverrides")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MirrorOverridesResponseObject); ok {
+		if err := validResponse.VisitMirrorOverridesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteFlagOverride operation middleware
+func (sh *strictHandler) DeleteFlagOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request DeleteFlagOverrideRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFlagOverride(ctx, request.(DeleteFlagOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFlagOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteFlagOverrideResponseObject); ok {
+		if err := validResponse.VisitDeleteFlagOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutOverrideFlag operation middleware
+func (sh *strictHandler) PutOverrideFlag(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutOverrideFlagRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	var body PutOverrideFlagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutOverrideFlag(ctx, request.(PutOverrideFlagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutOverrideFlag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutOverrideFlagResponseObject); ok {
+		if err := validResponse.VisitPutOverrideFlagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAIConfigOverride operation middleware
+func (sh *strictHandler) PutAIConfigOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutAIConfigOverrideRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	var body PutAIConfigOverrideJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetEnvironments404JSONResponse) VisitGetEnvironmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAIConfigOverride(ctx, request.(PutAIConfigOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAIConfigOverride")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteOverridesRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAIConfigOverrideResponseObject); ok {
+		if err := validResponse.VisitPutAIConfigOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteOverridesResponseObject interface {
-	VisitDeleteOverridesResponse(w http.ResponseWriter) error
-}
+// PutChaosOverride operation middleware
+func (sh *strictHandler) PutChaosOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutChaosOverrideRequestObject
 
-type DeleteOverrides204Response struct {
-}
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
 
-func (response DeleteOverrides204Response) VisitDeleteOverridesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var body PutChaosOverrideJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteOverrides404JSONResponse struct{ ErrorResponseJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutChaosOverride(ctx, request.(PutChaosOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutChaosOverride")
+	}
 
-func (response DeleteOverrides404JSONResponse) VisitDeleteOverridesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutChaosOverrideResponseObject); ok {
+		if err := validResponse.VisitPutChaosOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteFlagOverrideRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-	FlagKey    FlagKey    `json:"flagKey"`
-}
+// UnlockOverride operation middleware
+func (sh *strictHandler) UnlockOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request UnlockOverrideRequestObject
 
-type DeleteFlagOverrideResponseObject interface {
-	VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error
-}
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
 
-type DeleteFlagOverride204Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlockOverride(ctx, request.(UnlockOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlockOverride")
+	}
 
-func (response DeleteFlagOverride204Response) VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteFlagOverride404Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnlockOverrideResponseObject); ok {
+		if err := validResponse.VisitUnlockOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteFlagOverride404Response) VisitDeleteFlagOverrideResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// LockOverride operation middleware
+func (sh *strictHandler) LockOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request LockOverrideRequestObject
 
-type PutOverrideFlagRequestObject struct {
-	ProjectKey ProjectKey `json:"projectKey"`
-	FlagKey    FlagKey    `json:"flagKey"`
-	Body       *PutOverrideFlagJSONRequestBody
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LockOverride(ctx, request.(LockOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LockOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LockOverrideResponseObject); ok {
+		if err := validResponse.VisitLockOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutOverrideFlagResponseObject interface {
-	VisitPutOverrideFlagResponse(w http.ResponseWriter) error
+// PutRolloutOverride operation middleware
+func (sh *strictHandler) PutRolloutOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutRolloutOverrideRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	var body PutRolloutOverrideJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutRolloutOverride(ctx, request.(PutRolloutOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutRolloutOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutRolloutOverrideResponseObject); ok {
+		if err := validResponse.VisitPutRolloutOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutOverrideFlag200JSONResponse struct{ FlagOverrideJSONResponse }
+// DeleteOverrideSchedule operation middleware
+func (sh *strictHandler) DeleteOverrideSchedule(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request DeleteOverrideScheduleRequestObject
 
-func (response PutOverrideFlag200JSONResponse) VisitPutOverrideFlagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOverrideSchedule(ctx, request.(DeleteOverrideScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOverrideSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOverrideScheduleResponseObject); ok {
+		if err := validResponse.VisitDeleteOverrideScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutOverrideFlag400JSONResponse struct{ ErrorResponseJSONResponse }
+// PutOverrideSchedule operation middleware
+func (sh *strictHandler) PutOverrideSchedule(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutOverrideScheduleRequestObject
 
-func (response PutOverrideFlag400JSONResponse) VisitPutOverrideFlagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
 
-	return json.NewEncoder(w).Encode(response)
+	var body PutOverrideScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutOverrideSchedule(ctx, request.(PutOverrideScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutOverrideSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutOverrideScheduleResponseObject); ok {
+		if err := validResponse.VisitPutOverrideScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// get the backup
-	// (GET /backup)
-	GetBackup(ctx context.Context, request GetBackupRequestObject) (GetBackupResponseObject, error)
-	// post backup
-	// (POST /backup)
-	RestoreBackup(ctx context.Context, request RestoreBackupRequestObject) (RestoreBackupResponseObject, error)
-	// list all debug sessions with event counts
-	// (GET /debug-sessions)
-	GetDebugSessions(ctx context.Context, request GetDebugSessionsRequestObject) (GetDebugSessionsResponseObject, error)
-	// delete a specific debug session and all its events
-	// (DELETE /debug-sessions/{debugSessionKey})
-	DeleteDebugSession(ctx context.Context, request DeleteDebugSessionRequestObject) (DeleteDebugSessionResponseObject, error)
-	// get events for a specific debug session
-	// (GET /debug-sessions/{debugSessionKey}/events)
-	GetDebugSessionEvents(ctx context.Context, request GetDebugSessionEventsRequestObject) (GetDebugSessionEventsResponseObject, error)
-	// lists all projects that have been configured for the dev server
-	// (GET /projects)
-	GetProjects(ctx context.Context, request GetProjectsRequestObject) (GetProjectsResponseObject, error)
-	// remove the specified project from the dev server
-	// (DELETE /projects/{projectKey})
-	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
-	// get the specified project and its configuration for syncing from the LaunchDarkly Service
-	// (GET /projects/{projectKey})
-	GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error)
-	// updates the project context or sourceEnvironmentKey then syncs.  Input an empty body to only force a sync.
-	// (PATCH /projects/{projectKey})
-	PatchProject(ctx context.Context, request PatchProjectRequestObject) (PatchProjectResponseObject, error)
-	// Add the project to the dev server
-	// (POST /projects/{projectKey})
-	PostAddProject(ctx context.Context, request PostAddProjectRequestObject) (PostAddProjectResponseObject, error)
-	// list all environments for the given project
-	// (GET /projects/{projectKey}/environments)
-	GetEnvironments(ctx context.Context, request GetEnvironmentsRequestObject) (GetEnvironmentsResponseObject, error)
-	// remove all overrides for the given project
-	// (DELETE /projects/{projectKey}/overrides)
-	DeleteOverrides(ctx context.Context, request DeleteOverridesRequestObject) (DeleteOverridesResponseObject, error)
-	// remove override for flag
-	// (DELETE /projects/{projectKey}/overrides/{flagKey})
-	DeleteFlagOverride(ctx context.Context, request DeleteFlagOverrideRequestObject) (DeleteFlagOverrideResponseObject, error)
-	// override flag value with value provided in the body
-	// (PUT /projects/{projectKey}/overrides/{flagKey})
-	PutOverrideFlag(ctx context.Context, request PutOverrideFlagRequestObject) (PutOverrideFlagResponseObject, error)
+// PutMigrationStageOverride operation middleware
+func (sh *strictHandler) PutMigrationStageOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutMigrationStageOverrideRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+
+	var body PutMigrationStageOverrideJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMigrationStageOverride(ctx, request.(PutMigrationStageOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMigrationStageOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutMigrationStageOverrideResponseObject); ok {
+		if err := validResponse.VisitPutMigrationStageOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// DeleteForcedTreatment operation middleware
+func (sh *strictHandler) DeleteForcedTreatment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey, params DeleteForcedTreatmentParams) {
+	var request DeleteForcedTreatmentRequestObject
+
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteForcedTreatment(ctx, request.(DeleteForcedTreatmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteForcedTreatment")
+	}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteForcedTreatmentResponseObject); ok {
+		if err := validResponse.VisitDeleteForcedTreatmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+// PutForcedTreatment operation middleware
+func (sh *strictHandler) PutForcedTreatment(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
+	var request PutForcedTreatmentRequestObject
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+	request.ProjectKey = projectKey
+	request.FlagKey = flagKey
 
-// GetBackup operation middleware
-func (sh *strictHandler) GetBackup(w http.ResponseWriter, r *http.Request) {
-	var request GetBackupRequestObject
+	var body PutForcedTreatmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBackup(ctx, request.(GetBackupRequestObject))
+		return sh.ssi.PutForcedTreatment(ctx, request.(PutForcedTreatmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBackup")
+		handler = middleware(handler, "PutForcedTreatment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBackupResponseObject); ok {
-		if err := validResponse.VisitGetBackupResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutForcedTreatmentResponseObject); ok {
+		if err := validResponse.VisitPutForcedTreatmentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1379,25 +7986,25 @@ func (sh *strictHandler) GetBackup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RestoreBackup operation middleware
-func (sh *strictHandler) RestoreBackup(w http.ResponseWriter, r *http.Request) {
-	var request RestoreBackupRequestObject
+// PurgeProject operation middleware
+func (sh *strictHandler) PurgeProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request PurgeProjectRequestObject
 
-	request.Body = r.Body
+	request.ProjectKey = projectKey
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RestoreBackup(ctx, request.(RestoreBackupRequestObject))
+		return sh.ssi.PurgeProject(ctx, request.(PurgeProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RestoreBackup")
+		handler = middleware(handler, "PurgeProject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RestoreBackupResponseObject); ok {
-		if err := validResponse.VisitRestoreBackupResponse(w); err != nil {
+	} else if validResponse, ok := response.(PurgeProjectResponseObject); ok {
+		if err := validResponse.VisitPurgeProjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1405,25 +8012,26 @@ func (sh *strictHandler) RestoreBackup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetDebugSessions operation middleware
-func (sh *strictHandler) GetDebugSessions(w http.ResponseWriter, r *http.Request, params GetDebugSessionsParams) {
-	var request GetDebugSessionsRequestObject
+// GetEvaluationRequests operation middleware
+func (sh *strictHandler) GetEvaluationRequests(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetEvaluationRequestsParams) {
+	var request GetEvaluationRequestsRequestObject
 
+	request.ProjectKey = projectKey
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDebugSessions(ctx, request.(GetDebugSessionsRequestObject))
+		return sh.ssi.GetEvaluationRequests(ctx, request.(GetEvaluationRequestsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDebugSessions")
+		handler = middleware(handler, "GetEvaluationRequests")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDebugSessionsResponseObject); ok {
-		if err := validResponse.VisitGetDebugSessionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEvaluationRequestsResponseObject); ok {
+		if err := validResponse.VisitGetEvaluationRequestsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1431,25 +8039,25 @@ func (sh *strictHandler) GetDebugSessions(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteDebugSession operation middleware
-func (sh *strictHandler) DeleteDebugSession(w http.ResponseWriter, r *http.Request, debugSessionKey string) {
-	var request DeleteDebugSessionRequestObject
+// DeleteScenario operation middleware
+func (sh *strictHandler) DeleteScenario(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request DeleteScenarioRequestObject
 
-	request.DebugSessionKey = debugSessionKey
+	request.ProjectKey = projectKey
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDebugSession(ctx, request.(DeleteDebugSessionRequestObject))
+		return sh.ssi.DeleteScenario(ctx, request.(DeleteScenarioRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDebugSession")
+		handler = middleware(handler, "DeleteScenario")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDebugSessionResponseObject); ok {
-		if err := validResponse.VisitDeleteDebugSessionResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteScenarioResponseObject); ok {
+		if err := validResponse.VisitDeleteScenarioResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1457,26 +8065,33 @@ func (sh *strictHandler) DeleteDebugSession(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetDebugSessionEvents operation middleware
-func (sh *strictHandler) GetDebugSessionEvents(w http.ResponseWriter, r *http.Request, debugSessionKey string, params GetDebugSessionEventsParams) {
-	var request GetDebugSessionEventsRequestObject
+// PutScenario operation middleware
+func (sh *strictHandler) PutScenario(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PutScenarioParams) {
+	var request PutScenarioRequestObject
 
-	request.DebugSessionKey = debugSessionKey
+	request.ProjectKey = projectKey
 	request.Params = params
 
+	var body PutScenarioJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDebugSessionEvents(ctx, request.(GetDebugSessionEventsRequestObject))
+		return sh.ssi.PutScenario(ctx, request.(PutScenarioRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDebugSessionEvents")
+		handler = middleware(handler, "PutScenario")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDebugSessionEventsResponseObject); ok {
-		if err := validResponse.VisitGetDebugSessionEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutScenarioResponseObject); ok {
+		if err := validResponse.VisitPutScenarioResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1484,23 +8099,25 @@ func (sh *strictHandler) GetDebugSessionEvents(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetProjects operation middleware
-func (sh *strictHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
-	var request GetProjectsRequestObject
+// GetOverrideSchedules operation middleware
+func (sh *strictHandler) GetOverrideSchedules(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetOverrideSchedulesRequestObject
+
+	request.ProjectKey = projectKey
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjects(ctx, request.(GetProjectsRequestObject))
+		return sh.ssi.GetOverrideSchedules(ctx, request.(GetOverrideSchedulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjects")
+		handler = middleware(handler, "GetOverrideSchedules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectsResponseObject); ok {
-		if err := validResponse.VisitGetProjectsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOverrideSchedulesResponseObject); ok {
+		if err := validResponse.VisitGetOverrideSchedulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1508,25 +8125,25 @@ func (sh *strictHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteProject operation middleware
-func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
-	var request DeleteProjectRequestObject
+// GetSnapshots operation middleware
+func (sh *strictHandler) GetSnapshots(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetSnapshotsRequestObject
 
 	request.ProjectKey = projectKey
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+		return sh.ssi.GetSnapshots(ctx, request.(GetSnapshotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProject")
+		handler = middleware(handler, "GetSnapshots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSnapshotsResponseObject); ok {
+		if err := validResponse.VisitGetSnapshotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1534,26 +8151,26 @@ func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// GetProject operation middleware
-func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetProjectParams) {
-	var request GetProjectRequestObject
+// PostSnapshot operation middleware
+func (sh *strictHandler) PostSnapshot(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PostSnapshotParams) {
+	var request PostSnapshotRequestObject
 
 	request.ProjectKey = projectKey
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+		return sh.ssi.PostSnapshot(ctx, request.(PostSnapshotRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProject")
+		handler = middleware(handler, "PostSnapshot")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
-		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostSnapshotResponseObject); ok {
+		if err := validResponse.VisitPostSnapshotResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1561,33 +8178,53 @@ func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, proj
 	}
 }
 
-// PatchProject operation middleware
-func (sh *strictHandler) PatchProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PatchProjectParams) {
-	var request PatchProjectRequestObject
+// RestoreSnapshot operation middleware
+func (sh *strictHandler) RestoreSnapshot(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, snapshotId int64, params RestoreSnapshotParams) {
+	var request RestoreSnapshotRequestObject
 
 	request.ProjectKey = projectKey
+	request.SnapshotId = snapshotId
 	request.Params = params
 
-	var body PatchProjectJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreSnapshot(ctx, request.(RestoreSnapshotRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreSnapshot")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreSnapshotResponseObject); ok {
+		if err := validResponse.VisitRestoreSnapshotResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjectStatus operation middleware
+func (sh *strictHandler) GetProjectStatus(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetProjectStatusRequestObject
+
+	request.ProjectKey = projectKey
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProject(ctx, request.(PatchProjectRequestObject))
+		return sh.ssi.GetProjectStatus(ctx, request.(GetProjectStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProject")
+		handler = middleware(handler, "GetProjectStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchProjectResponseObject); ok {
-		if err := validResponse.VisitPatchProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectStatusResponseObject); ok {
+		if err := validResponse.VisitGetProjectStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1595,14 +8232,65 @@ func (sh *strictHandler) PatchProject(w http.ResponseWriter, r *http.Request, pr
 	}
 }
 
-// PostAddProject operation middleware
-func (sh *strictHandler) PostAddProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params PostAddProjectParams) {
-	var request PostAddProjectRequestObject
+// GetProjectSummary operation middleware
+func (sh *strictHandler) GetProjectSummary(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetProjectSummaryRequestObject
 
 	request.ProjectKey = projectKey
-	request.Params = params
 
-	var body PostAddProjectJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectSummary(ctx, request.(GetProjectSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectSummary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectSummaryResponseObject); ok {
+		if err := validResponse.VisitGetProjectSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFlagTriggers operation middleware
+func (sh *strictHandler) GetFlagTriggers(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request GetFlagTriggersRequestObject
+
+	request.ProjectKey = projectKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFlagTriggers(ctx, request.(GetFlagTriggersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFlagTriggers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFlagTriggersResponseObject); ok {
+		if err := validResponse.VisitGetFlagTriggersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostFlagTrigger operation middleware
+func (sh *strictHandler) PostFlagTrigger(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request PostFlagTriggerRequestObject
+
+	request.ProjectKey = projectKey
+
+	var body PostFlagTriggerJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1610,18 +8298,18 @@ func (sh *strictHandler) PostAddProject(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAddProject(ctx, request.(PostAddProjectRequestObject))
+		return sh.ssi.PostFlagTrigger(ctx, request.(PostFlagTriggerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAddProject")
+		handler = middleware(handler, "PostFlagTrigger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostAddProjectResponseObject); ok {
-		if err := validResponse.VisitPostAddProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostFlagTriggerResponseObject); ok {
+		if err := validResponse.VisitPostFlagTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1629,26 +8317,26 @@ func (sh *strictHandler) PostAddProject(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetEnvironments operation middleware
-func (sh *strictHandler) GetEnvironments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetEnvironmentsParams) {
-	var request GetEnvironmentsRequestObject
+// DeleteFlagTrigger operation middleware
+func (sh *strictHandler) DeleteFlagTrigger(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, triggerId TriggerId) {
+	var request DeleteFlagTriggerRequestObject
 
 	request.ProjectKey = projectKey
-	request.Params = params
+	request.TriggerId = triggerId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEnvironments(ctx, request.(GetEnvironmentsRequestObject))
+		return sh.ssi.DeleteFlagTrigger(ctx, request.(DeleteFlagTriggerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEnvironments")
+		handler = middleware(handler, "DeleteFlagTrigger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEnvironmentsResponseObject); ok {
-		if err := validResponse.VisitGetEnvironmentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteFlagTriggerResponseObject); ok {
+		if err := validResponse.VisitDeleteFlagTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1656,25 +8344,25 @@ func (sh *strictHandler) GetEnvironments(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteOverrides operation middleware
-func (sh *strictHandler) DeleteOverrides(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
-	var request DeleteOverridesRequestObject
+// UnarchiveProject operation middleware
+func (sh *strictHandler) UnarchiveProject(w http.ResponseWriter, r *http.Request, projectKey ProjectKey) {
+	var request UnarchiveProjectRequestObject
 
 	request.ProjectKey = projectKey
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteOverrides(ctx, request.(DeleteOverridesRequestObject))
+		return sh.ssi.UnarchiveProject(ctx, request.(UnarchiveProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteOverrides")
+		handler = middleware(handler, "UnarchiveProject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteOverridesResponseObject); ok {
-		if err := validResponse.VisitDeleteOverridesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UnarchiveProjectResponseObject); ok {
+		if err := validResponse.VisitUnarchiveProjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1682,26 +8370,26 @@ func (sh *strictHandler) DeleteOverrides(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteFlagOverride operation middleware
-func (sh *strictHandler) DeleteFlagOverride(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
-	var request DeleteFlagOverrideRequestObject
+// GetFlagUsage operation middleware
+func (sh *strictHandler) GetFlagUsage(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetFlagUsageParams) {
+	var request GetFlagUsageRequestObject
 
 	request.ProjectKey = projectKey
-	request.FlagKey = flagKey
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFlagOverride(ctx, request.(DeleteFlagOverrideRequestObject))
+		return sh.ssi.GetFlagUsage(ctx, request.(GetFlagUsageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFlagOverride")
+		handler = middleware(handler, "GetFlagUsage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteFlagOverrideResponseObject); ok {
-		if err := validResponse.VisitDeleteFlagOverrideResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetFlagUsageResponseObject); ok {
+		if err := validResponse.VisitGetFlagUsageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1709,14 +8397,11 @@ func (sh *strictHandler) DeleteFlagOverride(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// PutOverrideFlag operation middleware
-func (sh *strictHandler) PutOverrideFlag(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, flagKey FlagKey) {
-	var request PutOverrideFlagRequestObject
-
-	request.ProjectKey = projectKey
-	request.FlagKey = flagKey
+// PostSecureModeHash operation middleware
+func (sh *strictHandler) PostSecureModeHash(w http.ResponseWriter, r *http.Request) {
+	var request PostSecureModeHashRequestObject
 
-	var body PutOverrideFlagJSONRequestBody
+	var body PostSecureModeHashJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1724,18 +8409,71 @@ func (sh *strictHandler) PutOverrideFlag(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutOverrideFlag(ctx, request.(PutOverrideFlagRequestObject))
+		return sh.ssi.PostSecureModeHash(ctx, request.(PostSecureModeHashRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutOverrideFlag")
+		handler = middleware(handler, "PostSecureModeHash")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutOverrideFlagResponseObject); ok {
-		if err := validResponse.VisitPutOverrideFlagResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostSecureModeHashResponseObject); ok {
+		if err := validResponse.VisitPostSecureModeHashResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSourceEnvironments operation middleware
+func (sh *strictHandler) GetSourceEnvironments(w http.ResponseWriter, r *http.Request, projectKey ProjectKey, params GetSourceEnvironmentsParams) {
+	var request GetSourceEnvironmentsRequestObject
+
+	request.ProjectKey = projectKey
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSourceEnvironments(ctx, request.(GetSourceEnvironmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSourceEnvironments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSourceEnvironmentsResponseObject); ok {
+		if err := validResponse.VisitGetSourceEnvironmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FireFlagTrigger operation middleware
+func (sh *strictHandler) FireFlagTrigger(w http.ResponseWriter, r *http.Request, triggerId TriggerId) {
+	var request FireFlagTriggerRequestObject
+
+	request.TriggerId = triggerId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FireFlagTrigger(ctx, request.(FireFlagTriggerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FireFlagTrigger")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FireFlagTriggerResponseObject); ok {
+		if err := validResponse.VisitFireFlagTriggerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
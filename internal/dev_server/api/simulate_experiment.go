@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) SimulateExperiment(ctx context.Context, request SimulateExperimentRequestObject) (SimulateExperimentResponseObject, error) {
+	if request.Body == nil {
+		return nil, errors.New("empty simulation body")
+	}
+	var rollout *model.Rollout
+	if request.Body.Rollout != nil {
+		requested := rolloutFromRequestFormat(*request.Body.Rollout)
+		if err := requested.Validate(); err != nil {
+			return SimulateExperiment400JSONResponse{
+				ErrorResponseJSONResponse{
+					Code:    "invalid_request",
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		rollout = &requested
+	}
+	simulation, err := model.SimulateExperiment(ctx, request.ProjectKey, request.FlagKey, rollout, request.Body.Contexts)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return SimulateExperiment404JSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	return SimulateExperiment200JSONResponse(experimentSimulationToResponseFormat(simulation)), nil
+}
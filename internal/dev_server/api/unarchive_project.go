@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) UnarchiveProject(ctx context.Context, request UnarchiveProjectRequestObject) (UnarchiveProjectResponseObject, error) {
+	err := model.UnarchiveProject(ctx, request.ProjectKey)
+	switch {
+	case errors.As(err, &model.ErrNotFound{}):
+		return UnarchiveProject404JSONResponse{ErrorResponseJSONResponse{
+			Code:    "not_found",
+			Message: "project not found",
+		}}, nil
+	case err != nil:
+		return nil, err
+	}
+	return UnarchiveProject204Response{}, nil
+}
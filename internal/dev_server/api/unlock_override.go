@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func (s server) UnlockOverride(ctx context.Context, request UnlockOverrideRequestObject) (UnlockOverrideResponseObject, error) {
+	override, err := model.SetOverrideLocked(ctx, request.ProjectKey, request.FlagKey, false)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound{}) {
+			return UnlockOverride404JSONResponse{ErrorResponseJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+	return UnlockOverride200JSONResponse{overrideToResponseFormat(override)}, nil
+}
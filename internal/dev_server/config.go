@@ -0,0 +1,140 @@
+package dev_server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// ServerConfig is what can be set in the dev server's config file, devserver.yaml. Settings in the file take
+// precedence over the equivalent ServerParams, and everything but the port and store is re-applied when the file is
+// reloaded.
+type ServerConfig struct {
+	Port json.Number `json:"port,omitempty"`
+	// AuthTokens maps names to bearer tokens. When there are any, every request but the SDKs' and flag triggers' needs
+	// one of them in its Authorization header, and is attributed to its name in history.
+	AuthTokens map[string]string `json:"authTokens,omitempty"`
+	// SyncInterval is how often every project is synced from LaunchDarkly, e.g. 15m. Projects aren't synced on a
+	// schedule if it's empty or 0.
+	SyncInterval string             `json:"syncInterval,omitempty"`
+	Cors         *ServerConfigCors  `json:"cors,omitempty"`
+	Store        *ServerConfigStore `json:"store,omitempty"`
+	// ExecHooks replace any exec hook given with flags.
+	ExecHooks []model.ExecHookConfig `json:"execHooks,omitempty"`
+	Projects  []model.SeedProject    `json:"projects,omitempty"`
+}
+
+type ServerConfigCors struct {
+	Enabled bool   `json:"enabled"`
+	Origin  string `json:"origin,omitempty"`
+}
+
+type ServerConfigStore struct {
+	Backend string `json:"backend"`
+	URL     string `json:"url,omitempty"`
+}
+
+// ReadServerConfig reads and validates a config file. The file is YAML, so JSON works too.
+func ReadServerConfig(path string) (ServerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ServerConfig{}, fmt.Errorf("unable to read config file %s: %w", path, err)
+	}
+	var config ServerConfig
+	if err := decodeYAML(data, &config); err != nil {
+		return ServerConfig{}, fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return ServerConfig{}, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// decodeYAML decodes a YAML document into v, rejecting fields v doesn't have. An empty document leaves v as it is.
+func decodeYAML(data []byte, v interface{}) error {
+	// contexts and flag values only know how to be read from JSON
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+	if document == nil {
+		return nil
+	}
+	asJSON, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(asJSON))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+func (c ServerConfig) Validate() error {
+	if _, err := c.syncInterval(); err != nil {
+		return err
+	}
+	if c.Store != nil && c.Store.Backend == "" {
+		return fmt.Errorf("store needs a backend")
+	}
+	for _, hook := range c.ExecHooks {
+		if hook.Command == "" {
+			return fmt.Errorf("execHooks need a command")
+		}
+	}
+	return model.Seed{Projects: c.Projects}.Validate()
+}
+
+func (c ServerConfig) syncInterval() (time.Duration, error) {
+	if c.SyncInterval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(c.SyncInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid syncInterval: %w", err)
+	}
+	if interval < 0 {
+		return 0, fmt.Errorf("invalid syncInterval: %s is negative", c.SyncInterval)
+	}
+	return interval, nil
+}
+
+// applyTo returns the params with the config's settings in place of theirs. The config's projects are seeded after
+// any in the params' seed.
+func (c ServerConfig) applyTo(params ServerParams) (ServerParams, error) {
+	if c.Port != "" {
+		params.Port = c.Port.String()
+	}
+	if c.Cors != nil {
+		params.CorsEnabled = c.Cors.Enabled
+		if c.Cors.Origin != "" {
+			params.CorsOrigin = c.Cors.Origin
+		}
+	}
+	if c.Store != nil {
+		params.Store = c.Store.Backend
+		params.StoreURL = c.Store.URL
+	}
+	if c.SyncInterval != "" {
+		params.SyncInterval, _ = c.syncInterval()
+	}
+	if len(c.ExecHooks) > 0 {
+		params.ExecHooks = c.ExecHooks
+	}
+	if len(c.Projects) > 0 {
+		seed := model.Seed{Projects: c.Projects}
+		if params.Seed != nil {
+			seed.Projects = append(append([]model.SeedProject(nil), params.Seed.Projects...), c.Projects...)
+		}
+		if err := seed.Validate(); err != nil {
+			return params, err
+		}
+		params.Seed = &seed
+	}
+	return params, nil
+}
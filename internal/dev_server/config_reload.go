@@ -0,0 +1,226 @@
+package dev_server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
+)
+
+// configWatchDebounce is how long the config file has to stop changing before it's reloaded, since editors often
+// write files in several steps.
+const configWatchDebounce = 250 * time.Millisecond
+
+// swappableHandler serves with whichever handler it was last given, so the routes can be rebuilt while the server runs.
+type swappableHandler struct {
+	handler atomic.Pointer[http.Handler]
+}
+
+func newSwappableHandler(handler http.Handler) *swappableHandler {
+	h := &swappableHandler{}
+	h.swap(handler)
+	return h
+}
+
+func (h *swappableHandler) swap(handler http.Handler) {
+	h.handler.Store(&handler)
+}
+
+func (h *swappableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*h.handler.Load()).ServeHTTP(w, r)
+}
+
+// withAuthTokens attributes requests with one of the config's auth tokens to its name, before trying resolver.
+func withAuthTokens(resolver model.ActorResolver, authTokens map[string]string) model.ActorResolver {
+	if len(authTokens) == 0 {
+		return resolver
+	}
+	names := make(map[string]string, len(authTokens))
+	for name, token := range authTokens {
+		names[token] = name
+	}
+	if resolver == nil {
+		return model.TokenActorResolver(names)
+	}
+	return model.ChainActorResolvers(model.TokenActorResolver(names), resolver)
+}
+
+// requireAuthTokens responds 401 to requests without one of authTokens as their bearer token, if there are any. SDKs
+// authenticate with their keys instead, flag triggers' paths are their secret, and CORS preflights can't carry tokens,
+// so those are let through.
+func requireAuthTokens(authTokens map[string]string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if len(authTokens) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodOptions || sdk.IsSdkPath(r.URL.Path) || strings.HasPrefix(r.URL.Path, "/dev/triggers/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			for _, authToken := range authTokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(authToken)) == 1 {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "a valid bearer token is required", http.StatusUnauthorized)
+		})
+	}
+}
+
+// registerExecHooks has observers run each hook's command when its flags change, returning the hooks' observer IDs.
+func registerExecHooks(observers *model.Observers, hooks []model.ExecHookConfig) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(hooks))
+	for _, hook := range hooks {
+		ids = append(ids, observers.RegisterObserver(model.NewExecHook(hook)))
+		logs.Printf(logs.Info, "", "Running `%s` when flags change", hook.Command)
+	}
+	return ids
+}
+
+// configReloader re-applies the config file to the running server.
+type configReloader struct {
+	path string
+	// params are the server's params before the config file was applied to them, so that settings removed from the file
+	// go back to what they were started with.
+	params ServerParams
+	// applied are the params the server is currently running with, and config the file they came from.
+	applied ServerParams
+	config  ServerConfig
+	routes  routes
+	handler *swappableHandler
+	sync    *periodicSync
+	// execHooks are the IDs of the exec hooks registered with the routes' observers.
+	execHooks []uuid.UUID
+	// seedContext returns a context to seed projects with, using the current access token.
+	seedContext func() context.Context
+}
+
+func (r *configReloader) reload() {
+	config, err := ReadServerConfig(r.path)
+	if err != nil {
+		logs.Printf(logs.Error, "", "Not reloading config: %s", err)
+		return
+	}
+	params, err := config.applyTo(r.params)
+	if err != nil {
+		logs.Printf(logs.Error, "", "Not reloading config: %s", err)
+		return
+	}
+	if params.Port != r.applied.Port {
+		logs.Printf(logs.Warn, "", "The port in %s changed to %s; restart the dev server to use it", r.path, params.Port)
+	}
+	if params.Store != r.applied.Store || params.StoreURL != r.applied.StoreURL {
+		logs.Printf(logs.Warn, "", "The store in %s changed; restart the dev server to use it", r.path)
+	}
+
+	rt := r.routes
+	rt.corsEnabled = params.CorsEnabled
+	rt.corsOrigin = params.CorsOrigin
+	rt.authTokens = config.AuthTokens
+	rt.actorResolver = withAuthTokens(params.ActorResolver, config.AuthTokens)
+	r.handler.swap(rt.router())
+	r.sync.setInterval(params.SyncInterval)
+	if !reflect.DeepEqual(params.ExecHooks, r.applied.ExecHooks) {
+		for _, id := range r.execHooks {
+			r.routes.observers.DeregisterObserver(id)
+		}
+		r.execHooks = registerExecHooks(r.routes.observers, params.ExecHooks)
+	}
+
+	if changed := changedProjects(r.config.Projects, config.Projects); len(changed) > 0 {
+		if err := model.SeedProjects(r.seedContext(), model.Seed{Projects: changed}); err != nil {
+			logs.Printf(logs.Error, "", "Unable to seed projects from %s: %s", r.path, err)
+		}
+	}
+
+	r.config = config
+	r.applied = params
+	logs.Printf(logs.Info, "", "Reloaded config from %s", r.path)
+}
+
+// changedProjects returns the projects in after that aren't declared the same way in before. Projects that were removed
+// are left in the store.
+func changedProjects(before, after []model.SeedProject) []model.SeedProject {
+	declared := make(map[string]string, len(before))
+	for _, project := range before {
+		data, _ := json.Marshal(project)
+		declared[project.Key] = string(data)
+	}
+	var changed []model.SeedProject
+	for _, project := range after {
+		data, _ := json.Marshal(project)
+		if declared[project.Key] != string(data) {
+			changed = append(changed, project)
+		}
+	}
+	return changed
+}
+
+// reloadOnChange reloads the config on SIGHUP and whenever its file is written.
+func (r *configReloader) reloadOnChange() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	// the directory is watched rather than the file, so that files replaced by renaming another over them, as many
+	// editors do, keep being watched
+	var events chan fsnotify.Event
+	var errs chan error
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		if err = watcher.Add(filepath.Dir(r.path)); err != nil {
+			_ = watcher.Close()
+		}
+	}
+	if err != nil {
+		logs.Printf(logs.Warn, "", "Unable to watch %s for changes, send SIGHUP to reload it instead: %s", r.path, err)
+	} else {
+		events, errs = watcher.Events, watcher.Errors
+		defer watcher.Close()
+	}
+
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-signals:
+			r.reload()
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(r.path) || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			debounce = time.After(configWatchDebounce)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			logs.Printf(logs.Error, "", "Error watching %s for changes: %s", r.path, err)
+		case <-debounce:
+			debounce = nil
+			r.reload()
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package dev_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/events_db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func writeConfig(t *testing.T, path, contents string) {
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+}
+
+func TestReadServerConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devserver.yaml")
+
+	t.Run("reads every setting", func(t *testing.T) {
+		writeConfig(t, path, `
+port: 9000
+authTokens:
+  ci: secret-1
+syncInterval: 15m
+cors:
+  enabled: true
+  origin: http://localhost:3000
+store:
+  backend: redis
+  url: redis://localhost:6379
+execHooks:
+  - command: ./restart-api.sh
+    flags: [api-flag]
+projects:
+  - key: local
+    flags:
+      flag: true
+`)
+		config, err := ReadServerConfig(path)
+		require.NoError(t, err)
+
+		params, err := config.applyTo(ServerParams{Port: "8765", CorsOrigin: "*"})
+		require.NoError(t, err)
+		assert.Equal(t, "9000", params.Port)
+		assert.Equal(t, 15*time.Minute, params.SyncInterval)
+		assert.True(t, params.CorsEnabled)
+		assert.Equal(t, "http://localhost:3000", params.CorsOrigin)
+		assert.Equal(t, "redis", params.Store)
+		assert.Equal(t, "redis://localhost:6379", params.StoreURL)
+		assert.Equal(t, []model.ExecHookConfig{{Command: "./restart-api.sh", Flags: []string{"api-flag"}}}, params.ExecHooks)
+		require.NotNil(t, params.Seed)
+		require.Len(t, params.Seed.Projects, 1)
+		assert.Equal(t, "local", params.Seed.Projects[0].Key)
+		assert.Equal(t, map[string]string{"ci": "secret-1"}, config.AuthTokens)
+	})
+
+	t.Run("settings it doesn't have are left as they are", func(t *testing.T) {
+		writeConfig(t, path, "syncInterval: 1h\n")
+		config, err := ReadServerConfig(path)
+		require.NoError(t, err)
+
+		params, err := config.applyTo(ServerParams{Port: "8765", CorsEnabled: true, CorsOrigin: "*", Store: StoreSqlite})
+		require.NoError(t, err)
+		assert.Equal(t, ServerParams{Port: "8765", CorsEnabled: true, CorsOrigin: "*", Store: StoreSqlite, SyncInterval: time.Hour}, params)
+	})
+
+	t.Run("an empty file has no settings", func(t *testing.T) {
+		writeConfig(t, path, "")
+		config, err := ReadServerConfig(path)
+		require.NoError(t, err)
+		assert.Equal(t, ServerConfig{}, config)
+	})
+
+	t.Run("projects can't be seeded twice", func(t *testing.T) {
+		writeConfig(t, path, "projects: [{key: local, flags: {flag: true}}]\n")
+		config, err := ReadServerConfig(path)
+		require.NoError(t, err)
+
+		_, err = config.applyTo(ServerParams{Seed: &model.Seed{Projects: config.Projects}})
+		assert.Error(t, err)
+	})
+
+	invalid := map[string]string{
+		"unknown setting":           "prot: 9000\n",
+		"invalid interval":          "syncInterval: often\n",
+		"negative interval":         "syncInterval: -1m\n",
+		"store without backend":     "store: {url: redis://localhost:6379}\n",
+		"exec hook without command": "execHooks: [{flags: [flag]}]\n",
+		"project without key":       "projects: [{flags: {flag: true}}]\n",
+		"not yaml":                  "port: [\n",
+	}
+	for name, contents := range invalid {
+		t.Run(name, func(t *testing.T) {
+			writeConfig(t, path, contents)
+			_, err := ReadServerConfig(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestConfigReloader(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	eventStore, err := events_db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	accessToken := adapters.NewAccessToken("", func(string) ldapi.APIClient {
+		return *ldapi.NewAPIClient(ldapi.NewConfiguration())
+	})
+	observers := model.NewObservers()
+	ctx = model.SetObserversOnContext(ctx, observers)
+	ctx = model.ContextWithStore(ctx, store)
+
+	rt := routes{
+		accessToken:        accessToken,
+		store:              store,
+		eventStore:         eventStore,
+		observers:          observers,
+		eventsBuffer:       model.NewEventsBuffer(eventsBufferCapacity),
+		evaluationRequests: model.NewEvaluationRequests(evaluationRequestsCapacity),
+		logsBuffer:         logs.NewBuffer(logsBufferCapacity),
+		bigSegments:        model.NewBigSegments(),
+		idempotencyKeys:    api.NewIdempotencyKeys(idempotencyKeyTTL),
+	}
+	handler := newSwappableHandler(rt.router())
+	path := filepath.Join(t.TempDir(), "devserver.yaml")
+	params := ServerParams{Port: "8765", CorsOrigin: "*"}
+	reloader := &configReloader{
+		path:        path,
+		params:      params,
+		applied:     params,
+		routes:      rt,
+		handler:     handler,
+		sync:        newPeriodicSync(0, func() {}),
+		seedContext: func() context.Context { return ctx },
+	}
+
+	corsHeader := func() string {
+		req := httptest.NewRequest(http.MethodOptions, "/dev/projects", nil)
+		req.Header.Set("Origin", "http://localhost:3000")
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Header().Get("Access-Control-Allow-Origin")
+	}
+	assert.Empty(t, corsHeader())
+
+	writeConfig(t, path, `
+syncInterval: 10m
+cors:
+  enabled: true
+  origin: http://localhost:3000
+projects:
+  - key: local
+    flags:
+      flag: true
+`)
+	reloader.reload()
+
+	assert.Equal(t, "http://localhost:3000", corsHeader())
+	assert.Equal(t, 10*time.Minute, reloader.sync.getInterval())
+	_, err = store.GetDevProject(ctx, "local")
+	require.NoError(t, err)
+
+	t.Run("an invalid config is not applied", func(t *testing.T) {
+		writeConfig(t, path, "syncInterval: often\n")
+		reloader.reload()
+		assert.Equal(t, "http://localhost:3000", corsHeader())
+		assert.Equal(t, 10*time.Minute, reloader.sync.getInterval())
+	})
+
+	t.Run("exec hooks are registered", func(t *testing.T) {
+		writeConfig(t, path, "execHooks: [{command: 'true', flags: [flag]}]\n")
+		reloader.reload()
+		assert.Len(t, reloader.execHooks, 1)
+	})
+
+	t.Run("settings removed from the file go back to the flags'", func(t *testing.T) {
+		writeConfig(t, path, "authTokens: {alice: secret-2}\n")
+		reloader.reload()
+		assert.Empty(t, corsHeader())
+		assert.Zero(t, reloader.sync.getInterval())
+		assert.Empty(t, reloader.execHooks)
+	})
+
+	t.Run("auth tokens are required once they're configured", func(t *testing.T) {
+		status := func(method, path, token string) int {
+			req := httptest.NewRequest(method, path, strings.NewReader("[]"))
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec.Code
+		}
+		writeConfig(t, path, "authTokens: {alice: secret-2}\n")
+		reloader.reload()
+		assert.Equal(t, http.StatusUnauthorized, status(http.MethodGet, "/dev/projects", ""))
+		assert.Equal(t, http.StatusUnauthorized, status(http.MethodGet, "/dev/projects", "secret-1"))
+		assert.Equal(t, http.StatusOK, status(http.MethodGet, "/dev/projects", "secret-2"))
+		assert.NotEqual(t, http.StatusUnauthorized, status(http.MethodPost, "/bulk", ""), "SDK routes authenticate with SDK keys")
+
+		writeConfig(t, path, "")
+		reloader.reload()
+		assert.Equal(t, http.StatusOK, status(http.MethodGet, "/dev/projects", ""))
+	})
+}
+
+func TestWithAuthTokens(t *testing.T) {
+	resolver := withAuthTokens(model.TokenActorResolver(map[string]string{"secret-1": "ci"}), map[string]string{"alice": "secret-2"})
+	for token, expected := range map[string]string{"secret-1": "ci", "secret-2": "alice", "other": ""} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		assert.Equal(t, expected, resolver.ResolveActor(req), token)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer secret-2")
+	assert.Equal(t, "alice", withAuthTokens(nil, map[string]string{"alice": "secret-2"}).ResolveActor(req))
+}
+
+func TestChangedProjects(t *testing.T) {
+	unchanged := model.SeedProject{Key: "unchanged", SourceEnvironmentKey: "test"}
+	before := []model.SeedProject{unchanged, {Key: "changed", SourceEnvironmentKey: "test"}, {Key: "removed"}}
+	after := []model.SeedProject{unchanged, {Key: "changed", SourceEnvironmentKey: "production"}, {Key: "added"}}
+
+	var keys []string
+	for _, project := range changedProjects(before, after) {
+		keys = append(keys, project.Key)
+	}
+	assert.Equal(t, []string{"changed", "added"}, keys)
+}
+
+func TestPeriodicSync(t *testing.T) {
+	var syncs atomic.Int32
+	p := newPeriodicSync(0, func() { syncs.Add(1) })
+	stop := make(chan struct{})
+	defer close(stop)
+	go p.run(stop)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, syncs.Load(), "nothing is synced without an interval")
+
+	p.setInterval(10 * time.Millisecond)
+	assert.Eventually(t, func() bool { return syncs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+
+	p.setInterval(0)
+	time.Sleep(20 * time.Millisecond)
+	stopped := syncs.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, syncs.Load(), "syncing stops when the interval is set to 0")
+}
@@ -0,0 +1,366 @@
+package contract_tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
+)
+
+// Target describes the dev server that the checks are run against.
+type Target struct {
+	// BaseURL is the root of the dev server, e.g. http://localhost:8765
+	BaseURL string
+	// ProjectKey stands in for the SDK key, mobile key and client side ID.
+	ProjectKey string
+	// SecureModeSecret must match the dev server's secure mode secret, if it was started with one.
+	SecureModeSecret string
+	Client           *http.Client
+}
+
+// Check is a single expectation an SDK has about how an SDK-facing endpoint behaves.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, target Target) error
+}
+
+type Result struct {
+	Name string
+	Err  error
+}
+
+func (r Result) Passed() bool {
+	return r.Err == nil
+}
+
+// streamTimeout bounds how long a streaming check waits for the initial put event.
+const streamTimeout = 5 * time.Second
+
+var testContext = ldcontext.NewBuilder("user").Key("contract-tests").Build()
+
+// Checks are run in order by Run.
+var Checks = []Check{
+	{"server-side streaming sends an initial put", checkServerStream},
+	{"server-side polling returns flags and segments", checkServerPolling},
+	{"server-side flag polling returns flags by key", checkServerFlagsPolling},
+	{"client-side polling evaluates flags for a context", checkClientPolling},
+	{"client-side REPORT evaluates flags for a context", checkClientReport},
+	{"client-side polling answers CORS preflight", checkClientCors},
+	{"client-side streaming sends an initial put", checkClientStream},
+	{"mobile polling evaluates flags for a context", checkMobilePolling},
+	{"mobile streaming sends an initial put", checkMobileStream},
+	{"server-side events are accepted", checkServerEvents},
+	{"client-side events are accepted", checkClientEvents},
+}
+
+// Run runs every check against the target, returning a result for each.
+func Run(ctx context.Context, target Target) []Result {
+	if target.Client == nil {
+		target.Client = http.DefaultClient
+	}
+	target.BaseURL = strings.TrimSuffix(target.BaseURL, "/")
+	results := make([]Result, 0, len(Checks))
+	for _, check := range Checks {
+		results = append(results, Result{Name: check.Name, Err: check.Run(ctx, target)})
+	}
+	return results
+}
+
+func (t Target) do(ctx context.Context, method, path string, body []byte, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, t.BaseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	return t.Client.Do(req)
+}
+
+func (t Target) clientQuery() string {
+	if t.SecureModeSecret == "" {
+		return ""
+	}
+	return "?h=" + sdk.SecureModeHash(t.SecureModeSecret, testContext)
+}
+
+func encodedContext() string {
+	return base64.URLEncoding.EncodeToString([]byte(testContext.JSONString()))
+}
+
+func expectStatus(res *http.Response, status int) error {
+	if res.StatusCode != status {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("expected status %d, got %d: %s", status, res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func decodeJSON(res *http.Response, target interface{}) error {
+	if err := json.NewDecoder(res.Body).Decode(target); err != nil {
+		return errors.Wrap(err, "response body is not the expected JSON")
+	}
+	return nil
+}
+
+type evaluatedFlag struct {
+	Value   *json.RawMessage `json:"value"`
+	Version *int             `json:"version"`
+}
+
+func validateEvaluatedFlags(flags map[string]evaluatedFlag) error {
+	for key, flag := range flags {
+		if flag.Value == nil {
+			return fmt.Errorf("flag %q is missing value", key)
+		}
+		if flag.Version == nil {
+			return fmt.Errorf("flag %q is missing version", key)
+		}
+	}
+	return nil
+}
+
+func validateServerData(data map[string]json.RawMessage) error {
+	for _, key := range []string{"flags", "segments"} {
+		raw, ok := data[key]
+		if !ok {
+			return fmt.Errorf("payload is missing %q", key)
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return fmt.Errorf("%q must be an object", key)
+		}
+	}
+	return nil
+}
+
+// readFirstEvent reads server-sent events until the first one with data, returning its name and data.
+func readFirstEvent(res *http.Response) (string, []byte, error) {
+	if contentType := res.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/event-stream") {
+		return "", nil, fmt.Errorf("expected content type text/event-stream, got %q", contentType)
+	}
+	reader := bufio.NewReader(res.Body)
+	var event string
+	var data []byte
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return "", nil, errors.Wrap(err, "stream ended before an event was received")
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "":
+			if data != nil {
+				return event, data, nil
+			}
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimSpace(strings.TrimPrefix(line, "data:"))...)
+		}
+	}
+}
+
+func expectPut(ctx context.Context, target Target, method, path string, body []byte, headers map[string]string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, streamTimeout)
+	defer cancel()
+	res, err := target.do(ctx, method, path, body, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if err := expectStatus(res, http.StatusOK); err != nil {
+		return nil, err
+	}
+	event, data, err := readFirstEvent(res)
+	if err != nil {
+		return nil, err
+	}
+	if event != "put" {
+		return nil, fmt.Errorf("expected the first event to be put, got %q", event)
+	}
+	return data, nil
+}
+
+func checkServerStream(ctx context.Context, target Target) error {
+	data, err := expectPut(ctx, target, http.MethodGet, "/all", nil, map[string]string{"Authorization": target.ProjectKey})
+	if err != nil {
+		return err
+	}
+	var payload struct {
+		Path *string                    `json:"path"`
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return errors.Wrap(err, "put event data is not the expected JSON")
+	}
+	if payload.Path == nil {
+		return errors.New("put event is missing path")
+	}
+	return validateServerData(payload.Data)
+}
+
+func checkServerPolling(ctx context.Context, target Target) error {
+	res, err := target.do(ctx, http.MethodGet, "/sdk/latest-all", nil, map[string]string{"Authorization": target.ProjectKey})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if err := expectStatus(res, http.StatusOK); err != nil {
+		return err
+	}
+	var data map[string]json.RawMessage
+	if err := decodeJSON(res, &data); err != nil {
+		return err
+	}
+	return validateServerData(data)
+}
+
+func checkServerFlagsPolling(ctx context.Context, target Target) error {
+	res, err := target.do(ctx, http.MethodGet, "/sdk/flags", nil, map[string]string{"Authorization": target.ProjectKey})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if err := expectStatus(res, http.StatusOK); err != nil {
+		return err
+	}
+	var flags map[string]struct {
+		Key     string `json:"key"`
+		Version *int   `json:"version"`
+	}
+	if err := decodeJSON(res, &flags); err != nil {
+		return err
+	}
+	for key, flag := range flags {
+		if flag.Key != key {
+			return fmt.Errorf("flag %q has mismatched key %q", key, flag.Key)
+		}
+		if flag.Version == nil {
+			return fmt.Errorf("flag %q is missing version", key)
+		}
+	}
+	return nil
+}
+
+func checkEvaluatedFlags(res *http.Response) error {
+	defer res.Body.Close()
+	if err := expectStatus(res, http.StatusOK); err != nil {
+		return err
+	}
+	var flags map[string]evaluatedFlag
+	if err := decodeJSON(res, &flags); err != nil {
+		return err
+	}
+	return validateEvaluatedFlags(flags)
+}
+
+func checkClientPolling(ctx context.Context, target Target) error {
+	path := fmt.Sprintf("/sdk/evalx/%s/contexts/%s%s", target.ProjectKey, encodedContext(), target.clientQuery())
+	res, err := target.do(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return err
+	}
+	return checkEvaluatedFlags(res)
+}
+
+func checkClientReport(ctx context.Context, target Target) error {
+	path := fmt.Sprintf("/sdk/evalx/%s/context%s", target.ProjectKey, target.clientQuery())
+	res, err := target.do(ctx, "REPORT", path, []byte(testContext.JSONString()), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return err
+	}
+	return checkEvaluatedFlags(res)
+}
+
+func checkClientCors(ctx context.Context, target Target) error {
+	path := fmt.Sprintf("/sdk/evalx/%s/contexts/%s", target.ProjectKey, encodedContext())
+	res, err := target.do(ctx, http.MethodOptions, path, nil, map[string]string{
+		"Origin":                        "http://localhost:3000",
+		"Access-Control-Request-Method": http.MethodGet,
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.Header.Get("Access-Control-Allow-Origin") == "" {
+		return errors.New("preflight response is missing Access-Control-Allow-Origin")
+	}
+	return nil
+}
+
+func checkClientStream(ctx context.Context, target Target) error {
+	path := fmt.Sprintf("/eval/%s/%s%s", target.ProjectKey, encodedContext(), target.clientQuery())
+	data, err := expectPut(ctx, target, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return err
+	}
+	var flags map[string]evaluatedFlag
+	if err := json.Unmarshal(data, &flags); err != nil {
+		return errors.Wrap(err, "put event data is not the expected JSON")
+	}
+	return validateEvaluatedFlags(flags)
+}
+
+func checkMobilePolling(ctx context.Context, target Target) error {
+	res, err := target.do(ctx, http.MethodGet, "/msdk/evalx/contexts/"+encodedContext(), nil, map[string]string{"Authorization": "api_key " + target.ProjectKey})
+	if err != nil {
+		return err
+	}
+	return checkEvaluatedFlags(res)
+}
+
+func checkMobileStream(ctx context.Context, target Target) error {
+	data, err := expectPut(ctx, target, http.MethodGet, "/meval/"+encodedContext(), nil, map[string]string{"Authorization": "api_key " + target.ProjectKey})
+	if err != nil {
+		return err
+	}
+	var flags map[string]evaluatedFlag
+	if err := json.Unmarshal(data, &flags); err != nil {
+		return errors.Wrap(err, "put event data is not the expected JSON")
+	}
+	return validateEvaluatedFlags(flags)
+}
+
+func identifyEvent() []byte {
+	event, _ := json.Marshal([]interface{}{map[string]interface{}{
+		"kind":         "identify",
+		"creationDate": time.Now().UnixMilli(),
+		"context":      testContext,
+	}})
+	return event
+}
+
+func checkServerEvents(ctx context.Context, target Target) error {
+	res, err := target.do(ctx, http.MethodPost, "/bulk", identifyEvent(), map[string]string{
+		"Authorization": target.ProjectKey,
+		"Content-Type":  "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return expectStatus(res, http.StatusAccepted)
+}
+
+func checkClientEvents(ctx context.Context, target Target) error {
+	res, err := target.do(ctx, http.MethodPost, "/events/bulk/"+target.ProjectKey, identifyEvent(), map[string]string{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return expectStatus(res, http.StatusAccepted)
+}
@@ -0,0 +1,57 @@
+package contract_tests_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/contract_tests"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
+)
+
+func TestChecksPassAgainstSdkRoutes(t *testing.T) {
+	const projectKey = "my-project"
+	project := &model.Project{
+		Key: projectKey,
+		AllFlagsState: model.FlagsState{
+			"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1},
+		},
+	}
+
+	for _, secret := range []string{"", "shh"} {
+		t.Run("secure mode secret "+secret, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			store := mocks.NewMockStore(mockController)
+			store.EXPECT().GetDevProject(gomock.Any(), projectKey).Return(project, nil).AnyTimes()
+			store.EXPECT().GetOverridesForProject(gomock.Any(), projectKey).Return(nil, nil).AnyTimes()
+			store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projectKey).Return(nil, nil).AnyTimes()
+			store.EXPECT().GetAlias(gomock.Any(), projectKey).Return(model.Alias{}, model.NewErrNotFound("alias", projectKey)).AnyTimes()
+
+			router := mux.NewRouter()
+			router.Use(model.StoreMiddleware(store))
+			router.Use(model.ObserversMiddleware(model.NewObservers()))
+			router.Use(model.EventsBufferMiddleware(model.NewEventsBuffer(10)))
+			router.Use(sdk.SecureModeMiddleware(secret))
+			sdk.BindRoutes(router)
+			server := httptest.NewServer(router)
+			defer server.Close()
+
+			results := contract_tests.Run(context.Background(), contract_tests.Target{
+				BaseURL:          server.URL,
+				ProjectKey:       projectKey,
+				SecureModeSecret: secret,
+			})
+			assert.Len(t, results, len(contract_tests.Checks))
+			for _, result := range results {
+				assert.NoError(t, result.Err, result.Name)
+			}
+		})
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"database/sql"
 	"fmt"
 	"io"
-	"log"
 	"os"
 	"sync"
 	"sync/atomic"
 
 	sqllite "github.com/mattn/go-sqlite3"
 	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 var c atomic.Int32
@@ -110,7 +111,7 @@ func (m *Manager) RestoreToFile(ctx context.Context, stream io.Reader) (string,
 	defer func(copiedDb *sql.DB) {
 		err := copiedDb.Close()
 		if err != nil {
-			log.Println(err)
+			logs.Printf(logs.Error, "", "%s", err)
 		}
 	}(copiedDb)
 
@@ -152,7 +153,7 @@ func (m *Manager) MakeBackupFile(ctx context.Context) (string, error) {
 	defer func() {
 		err := sourceDb.Close()
 		if err != nil {
-			log.Printf("unable to close source connection: %s", err)
+			logs.Printf(logs.Error, "", "unable to close source connection: %s", err)
 		}
 	}()
 
@@ -165,7 +166,7 @@ func (m *Manager) MakeBackupFile(ctx context.Context) (string, error) {
 	defer func() {
 		err := backupDb.Close()
 		if err != nil {
-			log.Printf("unable to close source connection: %s", err)
+			logs.Printf(logs.Error, "", "unable to close source connection: %s", err)
 		}
 	}()
 
@@ -191,7 +192,7 @@ func runBackup(backupDbConn *sqllite.SQLiteConn, srcDbConn *sqllite.SQLiteConn,
 	defer func(backup *sqllite.SQLiteBackup) {
 		err := backup.Close()
 		if err != nil {
-			log.Printf("unable to close backup connection: %s", err)
+			logs.Printf(logs.Error, "", "unable to close backup connection: %s", err)
 		}
 	}(backup)
 
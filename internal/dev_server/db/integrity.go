@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// CheckSqliteIntegrity runs SQLite's integrity check on the database at dbPath, which is only read from, and returns
+// the problems it found. A healthy database has none.
+func CheckSqliteIntegrity(ctx context.Context, dbPath string) ([]string, error) {
+	database, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro", dbPath))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open database %s", dbPath)
+	}
+	defer database.Close()
+
+	rows, err := database.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to check database %s", dbPath)
+	}
+	defer rows.Close()
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, err
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	return problems, rows.Err()
+}
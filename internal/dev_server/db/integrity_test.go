@@ -0,0 +1,36 @@
+package db_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+)
+
+func TestCheckSqliteIntegrity(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	t.Run("finds no problems with a healthy database", func(t *testing.T) {
+		dbPath := filepath.Join(dir, "dev_server.db")
+		_, err := db.NewSqlite(ctx, dbPath)
+		require.NoError(t, err)
+
+		problems, err := db.CheckSqliteIntegrity(ctx, dbPath)
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+	})
+
+	t.Run("fails for files that aren't databases", func(t *testing.T) {
+		dbPath := filepath.Join(dir, "garbage.db")
+		require.NoError(t, os.WriteFile(dbPath, []byte("this is not a database, just some text that's long enough to have a header"), 0o644))
+
+		_, err := db.CheckSqliteIntegrity(ctx, dbPath)
+		assert.Error(t, err)
+	})
+}
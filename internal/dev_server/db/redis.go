@@ -0,0 +1,1241 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// redisKeyPrefix namespaces everything the dev server writes so the Redis instance can be shared with other data.
+const redisKeyPrefix = "ldcli:dev_server:"
+
+// redisTxRetries is how many times a transaction is retried when a watched key changes underneath it, which only
+// happens when several dev servers write to the same project at once.
+const redisTxRetries = 10
+
+// Redis is a Store backed by Redis so that dev servers running in ephemeral containers can share state and be
+// restarted without losing overrides.
+//
+// Keys, all under redisKeyPrefix:
+//   - projects: set of project keys
+//   - project:{key}: project JSON
+//   - variations:{key}: available variations JSON
+//   - overrides:{key}, scenario_overrides:{key}: hashes of flag key to override JSON
+//   - override_schedules:{key}: hash of flag key to override schedule JSON
+//   - saved_contexts:{key}: hash of name to saved context JSON
+//   - linked_clones:{key}: set of the keys of projects that are linked clones of the project
+//   - flag_state_history:{key}, override_history:{layer}:{key}: sorted sets scored by recorded time in milliseconds
+//   - aliases: hash of alias to project key
+//   - flag_triggers: hash of trigger ID to flag trigger JSON
+type Redis struct {
+	client *redis.Client
+}
+
+var _ model.Store = &Redis{}
+
+type redisProject struct {
+	Key                  string           `json:"key"`
+	SourceEnvironmentKey string           `json:"sourceEnvironmentKey"`
+	Context              string           `json:"context"`
+	LastSyncTime         time.Time        `json:"lastSyncTime"`
+	AllFlagsState        model.FlagsState `json:"flagState"`
+	Orphaned             *model.Orphaned  `json:"orphaned,omitempty"`
+	// EnvironmentKeys are the prefetched keys for the project's environments, by environment key.
+	EnvironmentKeys map[string]model.EnvironmentKeys `json:"environmentKeys,omitempty"`
+	ArchivedAt      *time.Time                       `json:"archivedAt,omitempty"`
+	SyncStatus      *model.SyncStatus                `json:"syncStatus,omitempty"`
+	FlagFilter      model.FlagFilter                 `json:"flagFilter"`
+	BaseProjectKey  string                           `json:"baseProjectKey,omitempty"`
+	FlagMetadata    map[string]model.FlagMetadata    `json:"flagMetadata,omitempty"`
+	ContextKinds    []model.ContextKind              `json:"contextKinds,omitempty"`
+}
+
+type redisOverrideSchedule struct {
+	Value        ldvalue.Value `json:"value"`
+	ActivateAt   *time.Time    `json:"activateAt,omitempty"`
+	DeactivateAt *time.Time    `json:"deactivateAt,omitempty"`
+	ScheduledBy  string        `json:"scheduledBy,omitempty"`
+}
+
+type redisFlagTrigger struct {
+	ProjectKey string              `json:"projectKey"`
+	FlagKey    string              `json:"flagKey"`
+	Action     model.TriggerAction `json:"action"`
+	CreatedAt  time.Time           `json:"createdAt"`
+}
+
+type redisVariation struct {
+	FlagKey     string        `json:"flagKey"`
+	Id          string        `json:"id"`
+	Name        *string       `json:"name,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	Value       ldvalue.Value `json:"value"`
+}
+
+type redisOverride struct {
+	FlagKey string         `json:"flagKey,omitempty"`
+	Value   ldvalue.Value  `json:"value"`
+	Active  bool           `json:"active"`
+	Version int            `json:"version"`
+	Locked  bool           `json:"locked,omitempty"`
+	Rollout *model.Rollout `json:"rollout,omitempty"`
+	// Actor is who made the change, and is only set on history entries.
+	Actor string `json:"actor,omitempty"`
+	// LockChange is only set on the history entries of locking or unlocking the override, to whether it was locked.
+	LockChange *bool `json:"lockChange,omitempty"`
+}
+
+// NewRedis connects to the Redis server at redisURL, e.g. redis://localhost:6379/0.
+func NewRedis(ctx context.Context, redisURL string) (*Redis, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid redis url")
+	}
+	client := redis.NewClient(opts)
+	client.AddHook(redisTxHook{client: client})
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, errors.Wrapf(err, "unable to connect to redis at %s", opts.Addr)
+	}
+	return &Redis{client: client}, nil
+}
+
+// Close disconnects from the Redis server.
+func (s *Redis) Close() error {
+	return s.client.Close()
+}
+
+func redisProjectsKey() string             { return redisKeyPrefix + "projects" }
+func redisProjectKey(key string) string    { return redisKeyPrefix + "project:" + key }
+func redisVariationsKey(key string) string { return redisKeyPrefix + "variations:" + key }
+func redisAliasesKey() string              { return redisKeyPrefix + "aliases" }
+func redisFlagTriggersKey() string         { return redisKeyPrefix + "flag_triggers" }
+func redisHistorySeqKey() string           { return redisKeyPrefix + "history_seq" }
+func redisFlagStateHistoryKey(key string) string {
+	return redisKeyPrefix + "flag_state_history:" + key
+}
+func redisOverridesKey(layer model.OverrideLayer, key string) string {
+	if layer == model.LayerScenario {
+		return redisKeyPrefix + "scenario_overrides:" + key
+	}
+	return redisKeyPrefix + "overrides:" + key
+}
+func redisOverrideHistoryKey(layer model.OverrideLayer, key string) string {
+	return redisKeyPrefix + "override_history:" + string(layer) + ":" + key
+}
+func redisOverrideSchedulesKey(key string) string {
+	return redisKeyPrefix + "override_schedules:" + key
+}
+func redisSavedContextsKey(key string) string {
+	return redisKeyPrefix + "saved_contexts:" + key
+}
+func redisLinkedClonesKey(key string) string {
+	return redisKeyPrefix + "linked_clones:" + key
+}
+
+// watch runs fn in an optimistic transaction over keys, retrying if another writer changes them first.
+func (s *Redis) watch(ctx context.Context, fn func(tx *redis.Tx) error, keys ...string) error {
+	for i := 0; i < redisTxRetries; i++ {
+		err := s.client.Watch(ctx, fn, keys...)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return errors.New("redis transaction kept conflicting with other writers")
+}
+
+// historyMember builds a sorted set member for a history entry. Members are prefixed with a sequence number so that
+// entries recorded in the same millisecond stay unique and sort in the order they were written.
+func (s *Redis) historyMember(ctx context.Context, entry interface{}) (redis.Z, error) {
+	seq, err := s.client.Incr(ctx, redisHistorySeqKey()).Result()
+	if err != nil {
+		return redis.Z{}, errors.Wrap(err, "unable to allocate history sequence")
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return redis.Z{}, errors.Wrap(err, "unable to marshal history entry")
+	}
+	return redis.Z{
+		Score:  float64(time.Now().UnixMilli()),
+		Member: fmt.Sprintf("%020d:%s", seq, data),
+	}, nil
+}
+
+func parseHistoryMember(member string, entry interface{}) error {
+	_, data, ok := strings.Cut(member, ":")
+	if !ok {
+		return errors.Errorf("invalid history entry %q", member)
+	}
+	return json.Unmarshal([]byte(data), entry)
+}
+
+// historyMemberSeq returns the sequence number a history entry was recorded with.
+func historyMemberSeq(member string) (int64, error) {
+	seq, _, ok := strings.Cut(member, ":")
+	if !ok {
+		return 0, errors.Errorf("invalid history entry %q", member)
+	}
+	return strconv.ParseInt(seq, 10, 64)
+}
+
+func (s *Redis) GetDevProjectKeys(ctx context.Context) ([]string, error) {
+	keys, err := s.client.SMembers(ctx, redisProjectsKey()).Result()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *Redis) GetDevProject(ctx context.Context, key string) (*model.Project, error) {
+	data, err := s.client.Get(ctx, redisProjectKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, model.NewErrNotFound("project", key)
+		}
+		return nil, err
+	}
+	var stored redisProject
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal project data")
+	}
+	project := model.Project{
+		Key:                  stored.Key,
+		SourceEnvironmentKey: stored.SourceEnvironmentKey,
+		LastSyncTime:         stored.LastSyncTime,
+		AllFlagsState:        stored.AllFlagsState,
+		Orphaned:             stored.Orphaned,
+		EnvironmentKeys:      stored.EnvironmentKeys,
+		ArchivedAt:           stored.ArchivedAt,
+		SyncStatus:           stored.SyncStatus,
+		FlagFilter:           stored.FlagFilter,
+		BaseProjectKey:       stored.BaseProjectKey,
+		FlagMetadata:         stored.FlagMetadata,
+		ContextKinds:         stored.ContextKinds,
+	}
+	if err := json.Unmarshal([]byte(stored.Context), &project.Context); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal context data")
+	}
+	cloneKeys, err := s.client.SMembers(ctx, redisLinkedClonesKey(key)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(cloneKeys) > 0 {
+		sort.Strings(cloneKeys)
+		project.LinkedCloneKeys = cloneKeys
+	}
+	return &project, nil
+}
+
+func (s *Redis) OrphanProject(ctx context.Context, projectKey string, orphaned model.Orphaned) (bool, error) {
+	updated, err := s.updateStoredProject(ctx, projectKey, func(stored *redisProject) {
+		stored.Orphaned = &orphaned
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to mark project orphaned")
+	}
+	return updated, nil
+}
+
+func (s *Redis) SetEnvironmentKeys(ctx context.Context, projectKey string, keys map[string]model.EnvironmentKeys) (bool, error) {
+	updated, err := s.updateStoredProject(ctx, projectKey, func(stored *redisProject) {
+		stored.EnvironmentKeys = keys
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to set environment keys")
+	}
+	return updated, nil
+}
+
+func (s *Redis) SetSyncStatus(ctx context.Context, projectKey string, status model.SyncStatus) (bool, error) {
+	updated, err := s.updateStoredProject(ctx, projectKey, func(stored *redisProject) {
+		stored.SyncStatus = &status
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to set sync status")
+	}
+	return updated, nil
+}
+
+func (s *Redis) ArchiveProject(ctx context.Context, projectKey string, archivedAt *time.Time) (bool, error) {
+	updated, err := s.updateStoredProject(ctx, projectKey, func(stored *redisProject) {
+		stored.ArchivedAt = archivedAt
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to archive project")
+	}
+	return updated, nil
+}
+
+// updateStoredProject applies update to the stored project JSON, leaving the rest of the project alone. It returns false
+// if the project doesn't exist.
+func (s *Redis) updateStoredProject(ctx context.Context, projectKey string, update func(stored *redisProject)) (bool, error) {
+	var updated bool
+	err := s.watch(ctx, func(tx *redis.Tx) error {
+		data, err := tx.Get(ctx, redisProjectKey(projectKey)).Bytes()
+		if errors.Is(err, redis.Nil) {
+			updated = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var stored redisProject
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return errors.Wrap(err, "unable to unmarshal project data")
+		}
+		update(&stored)
+		data, err = json.Marshal(stored)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal project")
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, redisProjectKey(projectKey), data, 0)
+			return nil
+		})
+		updated = err == nil
+		return err
+	}, redisProjectKey(projectKey))
+	return updated, err
+}
+
+func marshalProject(project model.Project) (projectJson []byte, variationsJson []byte, err error) {
+	projectJson, err = json.Marshal(redisProject{
+		Key:                  project.Key,
+		SourceEnvironmentKey: project.SourceEnvironmentKey,
+		Context:              project.Context.JSONString(),
+		LastSyncTime:         project.LastSyncTime,
+		AllFlagsState:        project.AllFlagsState,
+		Orphaned:             project.Orphaned,
+		EnvironmentKeys:      project.EnvironmentKeys,
+		ArchivedAt:           project.ArchivedAt,
+		SyncStatus:           project.SyncStatus,
+		FlagFilter:           project.FlagFilter,
+		BaseProjectKey:       project.BaseProjectKey,
+		FlagMetadata:         project.FlagMetadata,
+		ContextKinds:         project.ContextKinds,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to marshal project")
+	}
+	variations := make([]redisVariation, 0, len(project.AvailableVariations))
+	for _, variation := range project.AvailableVariations {
+		variations = append(variations, redisVariation{
+			FlagKey:     variation.FlagKey,
+			Id:          variation.Id,
+			Name:        variation.Name,
+			Description: variation.Description,
+			Value:       variation.Value,
+		})
+	}
+	variationsJson, err = json.Marshal(variations)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to marshal available variations")
+	}
+	return projectJson, variationsJson, nil
+}
+
+func (s *Redis) UpdateProject(ctx context.Context, project model.Project) (bool, error) {
+	history, err := s.historyMember(ctx, project.AllFlagsState)
+	if err != nil {
+		return false, err
+	}
+
+	flagKeys := make(map[string]struct{}, len(project.AvailableVariations))
+	for _, variation := range project.AvailableVariations {
+		flagKeys[variation.FlagKey] = struct{}{}
+	}
+
+	var updated bool
+	userOverridesKey := redisOverridesKey(model.LayerUser, project.Key)
+	err = s.watch(ctx, func(tx *redis.Tx) error {
+		data, err := tx.Get(ctx, redisProjectKey(project.Key)).Bytes()
+		if errors.Is(err, redis.Nil) {
+			updated = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var stored redisProject
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return errors.Wrap(err, "unable to unmarshal project data")
+		}
+		// prefetched environment keys, archiving, and sync status are only changed by SetEnvironmentKeys,
+		// ArchiveProject, and SetSyncStatus
+		project.EnvironmentKeys = stored.EnvironmentKeys
+		project.ArchivedAt = stored.ArchivedAt
+		project.SyncStatus = stored.SyncStatus
+		projectJson, variationsJson, err := marshalProject(project)
+		if err != nil {
+			return err
+		}
+		userOverrides, err := s.getOverrides(ctx, tx, model.LayerUser, project.Key)
+		if err != nil {
+			return err
+		}
+		// Delete all unlocked overrides that are linked to a flag that is no longer in the project
+		var removed []string
+		for _, override := range userOverrides {
+			if _, ok := flagKeys[override.FlagKey]; !ok && !override.Locked {
+				removed = append(removed, override.FlagKey)
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, redisProjectKey(project.Key), projectJson, 0)
+			pipe.Set(ctx, redisVariationsKey(project.Key), variationsJson, 0)
+			if len(removed) > 0 {
+				pipe.HDel(ctx, userOverridesKey, removed...)
+			}
+			pipe.ZAdd(ctx, redisFlagStateHistoryKey(project.Key), history)
+			return nil
+		})
+		updated = err == nil
+		return err
+	}, redisProjectKey(project.Key), userOverridesKey)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to update project")
+	}
+	return updated, nil
+}
+
+func (s *Redis) DeleteDevProject(ctx context.Context, key string) (model.ProjectDeletion, bool, error) {
+	var deletion model.ProjectDeletion
+	var deleted bool
+	keys := []string{
+		redisProjectKey(key),
+		redisVariationsKey(key),
+		redisOverridesKey(model.LayerUser, key),
+		redisOverridesKey(model.LayerScenario, key),
+		redisFlagStateHistoryKey(key),
+		redisOverrideHistoryKey(model.LayerUser, key),
+		redisOverrideHistoryKey(model.LayerScenario, key),
+		redisOverrideSchedulesKey(key),
+		redisSavedContextsKey(key),
+		redisLinkedClonesKey(key),
+	}
+	err := s.watch(ctx, func(tx *redis.Tx) error {
+		deletion = model.ProjectDeletion{}
+		exists, err := tx.Exists(ctx, redisProjectKey(key)).Result()
+		if err != nil {
+			return err
+		}
+		if exists == 0 {
+			deleted = false
+			return nil
+		}
+		projectJson, err := tx.Get(ctx, redisProjectKey(key)).Bytes()
+		if err != nil {
+			return err
+		}
+		var stored redisProject
+		if err := json.Unmarshal(projectJson, &stored); err != nil {
+			return errors.Wrap(err, "unable to unmarshal project data")
+		}
+
+		if deletion.Overrides, err = redisCount(tx.HLen(ctx, redisOverridesKey(model.LayerUser, key))); err != nil {
+			return err
+		}
+		if deletion.ScenarioOverrides, err = redisCount(tx.HLen(ctx, redisOverridesKey(model.LayerScenario, key))); err != nil {
+			return err
+		}
+		if deletion.FlagStateHistory, err = redisCount(tx.ZCard(ctx, redisFlagStateHistoryKey(key))); err != nil {
+			return err
+		}
+		for _, layer := range []model.OverrideLayer{model.LayerUser, model.LayerScenario} {
+			count, err := redisCount(tx.ZCard(ctx, redisOverrideHistoryKey(layer, key)))
+			if err != nil {
+				return err
+			}
+			deletion.OverrideHistory += count
+		}
+		if deletion.OverrideSchedules, err = redisCount(tx.HLen(ctx, redisOverrideSchedulesKey(key))); err != nil {
+			return err
+		}
+		if deletion.SavedContexts, err = redisCount(tx.HLen(ctx, redisSavedContextsKey(key))); err != nil {
+			return err
+		}
+		variationsJson, err := tx.Get(ctx, redisVariationsKey(key)).Bytes()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		if len(variationsJson) > 0 {
+			var variations []json.RawMessage
+			if err := json.Unmarshal(variationsJson, &variations); err != nil {
+				return errors.Wrap(err, "unable to unmarshal available variations")
+			}
+			deletion.AvailableVariations = len(variations)
+		}
+		aliases, err := tx.HGetAll(ctx, redisAliasesKey()).Result()
+		if err != nil {
+			return err
+		}
+		var projectAliases []string
+		for alias, projectKey := range aliases {
+			if projectKey == key {
+				projectAliases = append(projectAliases, alias)
+			}
+		}
+		deletion.Aliases = len(projectAliases)
+		triggers, err := tx.HGetAll(ctx, redisFlagTriggersKey()).Result()
+		if err != nil {
+			return err
+		}
+		var projectTriggers []string
+		for id, data := range triggers {
+			var trigger redisFlagTrigger
+			if err := json.Unmarshal([]byte(data), &trigger); err != nil {
+				return errors.Wrap(err, "unable to unmarshal flag trigger")
+			}
+			if trigger.ProjectKey == key {
+				projectTriggers = append(projectTriggers, id)
+			}
+		}
+		deletion.FlagTriggers = len(projectTriggers)
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, keys...)
+			pipe.SRem(ctx, redisProjectsKey(), key)
+			if stored.BaseProjectKey != "" {
+				pipe.SRem(ctx, redisLinkedClonesKey(stored.BaseProjectKey), key)
+			}
+			if len(projectAliases) > 0 {
+				pipe.HDel(ctx, redisAliasesKey(), projectAliases...)
+			}
+			if len(projectTriggers) > 0 {
+				pipe.HDel(ctx, redisFlagTriggersKey(), projectTriggers...)
+			}
+			return nil
+		})
+		deleted = err == nil
+		return err
+	}, append(keys, redisAliasesKey(), redisFlagTriggersKey())...)
+	if err != nil {
+		return model.ProjectDeletion{}, false, errors.Wrap(err, "unable to delete project")
+	}
+	return deletion, deleted, nil
+}
+
+func redisCount(cmd *redis.IntCmd) (int, error) {
+	count, err := cmd.Result()
+	return int(count), err
+}
+
+func (s *Redis) InsertProject(ctx context.Context, project model.Project) error {
+	projectJson, variationsJson, err := marshalProject(project)
+	if err != nil {
+		return err
+	}
+	history, err := s.historyMember(ctx, project.AllFlagsState)
+	if err != nil {
+		return err
+	}
+	return s.watch(ctx, func(tx *redis.Tx) error {
+		exists, err := tx.Exists(ctx, redisProjectKey(project.Key)).Result()
+		if err != nil {
+			return err
+		}
+		if exists > 0 {
+			return model.NewErrAlreadyExists("project", project.Key)
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, redisProjectKey(project.Key), projectJson, 0)
+			pipe.SAdd(ctx, redisProjectsKey(), project.Key)
+			if project.BaseProjectKey != "" {
+				pipe.SAdd(ctx, redisLinkedClonesKey(project.BaseProjectKey), project.Key)
+			}
+			pipe.Set(ctx, redisVariationsKey(project.Key), variationsJson, 0)
+			pipe.ZAdd(ctx, redisFlagStateHistoryKey(project.Key), history)
+			return nil
+		})
+		return err
+	}, redisProjectKey(project.Key))
+}
+
+func (s *Redis) GetAvailableVariationsForProject(ctx context.Context, projectKey string) (map[string][]model.Variation, error) {
+	availableVariations := make(map[string][]model.Variation)
+	data, err := s.client.Get(ctx, redisVariationsKey(projectKey)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return availableVariations, nil
+		}
+		return nil, err
+	}
+	var stored []redisVariation
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal available variations")
+	}
+	for _, variation := range stored {
+		availableVariations[variation.FlagKey] = append(availableVariations[variation.FlagKey], model.Variation{
+			Id:          variation.Id,
+			Name:        variation.Name,
+			Description: variation.Description,
+			Value:       variation.Value,
+		})
+	}
+	return availableVariations, nil
+}
+
+func (s *Redis) GetOverridesForProject(ctx context.Context, projectKey string) (model.Overrides, error) {
+	return s.getOverrides(ctx, s.client, model.LayerUser, projectKey)
+}
+
+func (s *Redis) GetScenarioOverridesForProject(ctx context.Context, projectKey string) (model.Overrides, error) {
+	return s.getOverrides(ctx, s.client, model.LayerScenario, projectKey)
+}
+
+func (s *Redis) getOverrides(ctx context.Context, cmd redis.Cmdable, layer model.OverrideLayer, projectKey string) (model.Overrides, error) {
+	fields, err := cmd.HGetAll(ctx, redisOverridesKey(layer, projectKey)).Result()
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(model.Overrides, 0, len(fields))
+	for flagKey, data := range fields {
+		var stored redisOverride
+		if err := json.Unmarshal([]byte(data), &stored); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal override")
+		}
+		overrides = append(overrides, model.Override{
+			ProjectKey: projectKey,
+			FlagKey:    flagKey,
+			Value:      stored.Value,
+			Active:     stored.Active,
+			Version:    stored.Version,
+			Locked:     stored.Locked,
+			Rollout:    stored.Rollout,
+		})
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].FlagKey < overrides[j].FlagKey })
+	return overrides, nil
+}
+
+// writeOverride queues the override and its history entry on pipe.
+func (s *Redis) writeOverride(ctx context.Context, pipe redis.Pipeliner, layer model.OverrideLayer, override model.Override, history redis.Z) error {
+	data, err := json.Marshal(redisOverride{Value: override.Value, Active: override.Active, Version: override.Version, Locked: override.Locked, Rollout: override.Rollout})
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal override")
+	}
+	pipe.HSet(ctx, redisOverridesKey(layer, override.ProjectKey), override.FlagKey, data)
+	pipe.ZAdd(ctx, redisOverrideHistoryKey(layer, override.ProjectKey), history)
+	return nil
+}
+
+func (s *Redis) ReplaceScenarioOverrides(ctx context.Context, projectKey string, values map[string]ldvalue.Value) (model.Overrides, error) {
+	key := redisOverridesKey(model.LayerScenario, projectKey)
+	err := s.watch(ctx, func(tx *redis.Tx) error {
+		current, err := s.getOverrides(ctx, tx, model.LayerScenario, projectKey)
+		if err != nil {
+			return err
+		}
+		existing := make(map[string]model.Override, len(current))
+		for _, override := range current {
+			existing[override.FlagKey] = override
+		}
+
+		var changes []model.Override
+		// Deactivate the flags that are no longer part of the scenario
+		for _, override := range current {
+			if _, ok := values[override.FlagKey]; ok || !override.Active {
+				continue
+			}
+			override.Active = false
+			override.Version++
+			changes = append(changes, override)
+		}
+		for flagKey, value := range values {
+			override := model.Override{ProjectKey: projectKey, FlagKey: flagKey, Value: value, Active: true, Version: 1}
+			if previous, ok := existing[flagKey]; ok {
+				override.Version = previous.Version + 1
+			}
+			changes = append(changes, override)
+		}
+
+		histories := make([]redis.Z, len(changes))
+		for i, override := range changes {
+			histories[i], err = s.historyMember(ctx, redisOverride{
+				FlagKey: override.FlagKey,
+				Value:   override.Value,
+				Active:  override.Active,
+				Version: override.Version,
+				Actor:   model.ActorFromContext(ctx),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, override := range changes {
+				if err := s.writeOverride(ctx, pipe, model.LayerScenario, override, histories[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return err
+	}, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to replace scenario overrides")
+	}
+	return s.GetScenarioOverridesForProject(ctx, projectKey)
+}
+
+func (s *Redis) UpsertOverride(ctx context.Context, override model.Override) (model.Override, error) {
+	key := redisOverridesKey(model.LayerUser, override.ProjectKey)
+	err := s.watch(ctx, func(tx *redis.Tx) error {
+		override.Version = 1
+		data, err := tx.HGet(ctx, key, override.FlagKey).Bytes()
+		switch {
+		case err == nil:
+			var previous redisOverride
+			if err := json.Unmarshal(data, &previous); err != nil {
+				return errors.Wrap(err, "unable to unmarshal override")
+			}
+			if previous.Locked {
+				return errors.WithStack(model.NewErrLocked(override.ProjectKey, override.FlagKey))
+			}
+			override.Version = previous.Version + 1
+		case !errors.Is(err, redis.Nil):
+			return err
+		}
+		history, err := s.historyMember(ctx, redisOverride{
+			FlagKey: override.FlagKey,
+			Value:   override.Value,
+			Active:  override.Active,
+			Version: override.Version,
+			Actor:   model.ActorFromContext(ctx),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			return s.writeOverride(ctx, pipe, model.LayerUser, override, history)
+		})
+		return err
+	}, key)
+	if err != nil {
+		return model.Override{}, errors.Wrap(err, "unable to upsert override")
+	}
+	return override, nil
+}
+
+func (s *Redis) SetOverrideLocked(ctx context.Context, projectKey, flagKey string, locked bool) (model.Override, error) {
+	key := redisOverridesKey(model.LayerUser, projectKey)
+	var override model.Override
+	err := s.watch(ctx, func(tx *redis.Tx) error {
+		data, err := tx.HGet(ctx, key, flagKey).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return errors.Wrapf(model.NewErrNotFound("flag", flagKey), "no override in project %s", projectKey)
+			}
+			return err
+		}
+		var stored redisOverride
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return errors.Wrap(err, "unable to unmarshal override")
+		}
+		stored.Locked = locked
+		history, err := s.historyMember(ctx, redisOverride{
+			FlagKey:    flagKey,
+			Value:      stored.Value,
+			Active:     stored.Active,
+			Version:    stored.Version,
+			Actor:      model.ActorFromContext(ctx),
+			LockChange: &locked,
+		})
+		if err != nil {
+			return err
+		}
+		override = model.Override{
+			ProjectKey: projectKey,
+			FlagKey:    flagKey,
+			Value:      stored.Value,
+			Active:     stored.Active,
+			Version:    stored.Version,
+			Locked:     stored.Locked,
+			Rollout:    stored.Rollout,
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			return s.writeOverride(ctx, pipe, model.LayerUser, override, history)
+		})
+		return err
+	}, key)
+	if err != nil {
+		return model.Override{}, errors.Wrap(err, "unable to lock override")
+	}
+	return override, nil
+}
+
+func (s *Redis) DeactivateOverride(ctx context.Context, projectKey, flagKey string) (int, error) {
+	key := redisOverridesKey(model.LayerUser, projectKey)
+	var version int
+	err := s.watch(ctx, func(tx *redis.Tx) error {
+		data, err := tx.HGet(ctx, key, flagKey).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return errors.Wrapf(model.NewErrNotFound("flag", flagKey), "no override in project %s", projectKey)
+			}
+			return err
+		}
+		var stored redisOverride
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return errors.Wrap(err, "unable to unmarshal override")
+		}
+		if stored.Locked {
+			return errors.WithStack(model.NewErrLocked(projectKey, flagKey))
+		}
+		if !stored.Active {
+			return errors.Wrapf(model.NewErrNotFound("flag", flagKey), "no override in project %s", projectKey)
+		}
+		override := model.Override{
+			ProjectKey: projectKey,
+			FlagKey:    flagKey,
+			Value:      stored.Value,
+			Active:     false,
+			Version:    stored.Version + 1,
+		}
+		history, err := s.historyMember(ctx, redisOverride{
+			FlagKey: flagKey,
+			Value:   override.Value,
+			Version: override.Version,
+			Actor:   model.ActorFromContext(ctx),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			return s.writeOverride(ctx, pipe, model.LayerUser, override, history)
+		})
+		version = override.Version
+		return err
+	}, key)
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (s *Redis) GetProjectStateAt(ctx context.Context, projectKey string, at time.Time) (model.FlagsState, model.LayeredOverrides, error) {
+	maxScore := strconv.FormatInt(at.UnixMilli(), 10)
+	members, err := s.client.ZRevRangeByScore(ctx, redisFlagStateHistoryKey(projectKey), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   maxScore,
+		Count: 1,
+	}).Result()
+	if err != nil {
+		return nil, model.LayeredOverrides{}, err
+	}
+	if len(members) == 0 {
+		return nil, model.LayeredOverrides{}, errors.Wrapf(model.NewErrNotFound("project", projectKey), "no history at %s", at.Format(time.RFC3339))
+	}
+	var flagsState model.FlagsState
+	if err := parseHistoryMember(members[0], &flagsState); err != nil {
+		return nil, model.LayeredOverrides{}, errors.Wrap(err, "unable to unmarshal flag state history")
+	}
+
+	scenario, err := s.getOverridesAt(ctx, model.LayerScenario, projectKey, maxScore)
+	if err != nil {
+		return nil, model.LayeredOverrides{}, err
+	}
+	user, err := s.getOverridesAt(ctx, model.LayerUser, projectKey, maxScore)
+	if err != nil {
+		return nil, model.LayeredOverrides{}, err
+	}
+	return flagsState, model.LayeredOverrides{Scenario: scenario, User: user}, nil
+}
+
+func (s *Redis) GetSnapshots(ctx context.Context, projectKey string) ([]model.Snapshot, error) {
+	members, err := s.client.ZRevRangeWithScores(ctx, redisFlagStateHistoryKey(projectKey), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]model.Snapshot, 0, len(members))
+	for _, member := range members {
+		seq, err := historyMemberSeq(member.Member.(string))
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, model.Snapshot{
+			ID:         seq,
+			ProjectKey: projectKey,
+			RecordedAt: time.UnixMilli(int64(member.Score)),
+		})
+	}
+	return snapshots, nil
+}
+
+func (s *Redis) InsertSnapshot(ctx context.Context, projectKey string) (model.Snapshot, error) {
+	project, err := s.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return model.Snapshot{}, err
+	}
+	history, err := s.historyMember(ctx, project.AllFlagsState)
+	if err != nil {
+		return model.Snapshot{}, err
+	}
+	if err := s.client.ZAdd(ctx, redisFlagStateHistoryKey(projectKey), history).Err(); err != nil {
+		return model.Snapshot{}, errors.Wrap(err, "unable to record flag state history")
+	}
+	seq, err := historyMemberSeq(history.Member.(string))
+	if err != nil {
+		return model.Snapshot{}, err
+	}
+	return model.Snapshot{ID: seq, ProjectKey: projectKey, RecordedAt: time.UnixMilli(int64(history.Score))}, nil
+}
+
+func (s *Redis) PruneHistory(ctx context.Context, projectKey string, before time.Time) (int, error) {
+	beforeScore := "(" + strconv.FormatInt(before.UnixMilli(), 10)
+	prunable := func(key string, groupOf func(member string) (string, error)) ([]interface{}, error) {
+		members, err := s.client.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: "-inf", Max: beforeScore}).Result()
+		if err != nil {
+			return nil, err
+		}
+		// members are oldest first, so the last one seen in each group is kept
+		latest := make(map[string]int)
+		for i, member := range members {
+			group, err := groupOf(member)
+			if err != nil {
+				return nil, err
+			}
+			latest[group] = i
+		}
+		var pruned []interface{}
+		for i, member := range members {
+			if group, _ := groupOf(member); latest[group] != i {
+				pruned = append(pruned, member)
+			}
+		}
+		return pruned, nil
+	}
+
+	pipe := s.client.TxPipeline()
+	count := 0
+	flagStates, err := prunable(redisFlagStateHistoryKey(projectKey), func(string) (string, error) { return "", nil })
+	if err != nil {
+		return 0, err
+	}
+	if len(flagStates) > 0 {
+		pipe.ZRem(ctx, redisFlagStateHistoryKey(projectKey), flagStates...)
+		count += len(flagStates)
+	}
+	for _, layer := range []model.OverrideLayer{model.LayerUser, model.LayerScenario} {
+		overrides, err := prunable(redisOverrideHistoryKey(layer, projectKey), func(member string) (string, error) {
+			var entry redisOverride
+			if err := parseHistoryMember(member, &entry); err != nil {
+				return "", errors.Wrap(err, "unable to unmarshal override history")
+			}
+			return entry.FlagKey, nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(overrides) > 0 {
+			pipe.ZRem(ctx, redisOverrideHistoryKey(layer, projectKey), overrides...)
+			count += len(overrides)
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "unable to prune history")
+	}
+	return count, nil
+}
+
+func (s *Redis) GetAuditLog(ctx context.Context, projectKey string, query model.AuditQuery) ([]model.AuditEntry, error) {
+	minScore := "-inf"
+	if !query.Since.IsZero() {
+		minScore = strconv.FormatInt(query.Since.UnixMilli(), 10)
+	}
+	entries := make([]model.AuditEntry, 0)
+	for _, layer := range []model.OverrideLayer{model.LayerUser, model.LayerScenario} {
+		members, err := s.client.ZRevRangeByScoreWithScores(ctx, redisOverrideHistoryKey(layer, projectKey), &redis.ZRangeBy{
+			Min: minScore,
+			Max: "+inf",
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			var entry redisOverride
+			if err := parseHistoryMember(member.Member.(string), &entry); err != nil {
+				return nil, errors.Wrap(err, "unable to unmarshal override history")
+			}
+			if query.FlagKey != "" && entry.FlagKey != query.FlagKey {
+				continue
+			}
+			entries = append(entries, model.AuditEntry{
+				Layer:      layer,
+				FlagKey:    entry.FlagKey,
+				Value:      entry.Value,
+				Active:     entry.Active,
+				Version:    entry.Version,
+				Locked:     entry.LockChange,
+				Actor:      entry.Actor,
+				RecordedAt: time.UnixMilli(int64(member.Score)),
+			})
+		}
+	}
+	// each layer's entries are already newest first, so a stable sort keeps writes made in the same millisecond in order
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].RecordedAt.After(entries[j].RecordedAt) })
+	if query.Limit > 0 && len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+	}
+	return entries, nil
+}
+
+// getOverridesAt returns the most recent change to each override in the layer with a score of at most maxScore.
+func (s *Redis) getOverridesAt(ctx context.Context, layer model.OverrideLayer, projectKey string, maxScore string) (model.Overrides, error) {
+	members, err := s.client.ZRangeByScore(ctx, redisOverrideHistoryKey(layer, projectKey), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: maxScore,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]model.Override)
+	for _, member := range members {
+		var entry redisOverride
+		if err := parseHistoryMember(member, &entry); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal override history")
+		}
+		latest[entry.FlagKey] = model.Override{
+			ProjectKey: projectKey,
+			FlagKey:    entry.FlagKey,
+			Value:      entry.Value,
+			Active:     entry.Active,
+			Version:    entry.Version,
+		}
+	}
+	overrides := make(model.Overrides, 0, len(latest))
+	for _, override := range latest {
+		overrides = append(overrides, override)
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].FlagKey < overrides[j].FlagKey })
+	return overrides, nil
+}
+
+func (s *Redis) GetOverrideSchedules(ctx context.Context, projectKey string) ([]model.OverrideSchedule, error) {
+	fields, err := s.client.HGetAll(ctx, redisOverrideSchedulesKey(projectKey)).Result()
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]model.OverrideSchedule, 0, len(fields))
+	for flagKey, data := range fields {
+		var schedule redisOverrideSchedule
+		if err := json.Unmarshal([]byte(data), &schedule); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal override schedule")
+		}
+		schedules = append(schedules, model.OverrideSchedule{
+			ProjectKey:   projectKey,
+			FlagKey:      flagKey,
+			Value:        schedule.Value,
+			ActivateAt:   schedule.ActivateAt,
+			DeactivateAt: schedule.DeactivateAt,
+			ScheduledBy:  schedule.ScheduledBy,
+		})
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].FlagKey < schedules[j].FlagKey })
+	return schedules, nil
+}
+
+func (s *Redis) UpsertOverrideSchedule(ctx context.Context, schedule model.OverrideSchedule) error {
+	data, err := json.Marshal(redisOverrideSchedule{
+		Value:        schedule.Value,
+		ActivateAt:   schedule.ActivateAt,
+		DeactivateAt: schedule.DeactivateAt,
+		ScheduledBy:  schedule.ScheduledBy,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal override schedule")
+	}
+	err = s.client.HSet(ctx, redisOverrideSchedulesKey(schedule.ProjectKey), schedule.FlagKey, data).Err()
+	return errors.Wrap(err, "unable to upsert override schedule")
+}
+
+func (s *Redis) DeleteOverrideSchedule(ctx context.Context, projectKey, flagKey string) (bool, error) {
+	deleted, err := s.client.HDel(ctx, redisOverrideSchedulesKey(projectKey), flagKey).Result()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func (s *Redis) GetSavedContexts(ctx context.Context, projectKey string) ([]model.SavedContext, error) {
+	fields, err := s.client.HGetAll(ctx, redisSavedContextsKey(projectKey)).Result()
+	if err != nil {
+		return nil, err
+	}
+	savedContexts := make([]model.SavedContext, 0, len(fields))
+	for name, data := range fields {
+		savedContext := model.SavedContext{ProjectKey: projectKey, Name: name}
+		if err := json.Unmarshal([]byte(data), &savedContext.Context); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal saved context")
+		}
+		savedContexts = append(savedContexts, savedContext)
+	}
+	sort.Slice(savedContexts, func(i, j int) bool { return savedContexts[i].Name < savedContexts[j].Name })
+	return savedContexts, nil
+}
+
+func (s *Redis) GetSavedContext(ctx context.Context, projectKey, name string) (model.SavedContext, error) {
+	data, err := s.client.HGet(ctx, redisSavedContextsKey(projectKey), name).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return model.SavedContext{}, model.NewErrNotFound("saved context", name)
+		}
+		return model.SavedContext{}, err
+	}
+	savedContext := model.SavedContext{ProjectKey: projectKey, Name: name}
+	if err := json.Unmarshal([]byte(data), &savedContext.Context); err != nil {
+		return model.SavedContext{}, errors.Wrap(err, "unable to unmarshal saved context")
+	}
+	return savedContext, nil
+}
+
+func (s *Redis) UpsertSavedContext(ctx context.Context, savedContext model.SavedContext) error {
+	err := s.client.HSet(ctx, redisSavedContextsKey(savedContext.ProjectKey), savedContext.Name, savedContext.Context.JSONString()).Err()
+	return errors.Wrap(err, "unable to upsert saved context")
+}
+
+func (s *Redis) DeleteSavedContext(ctx context.Context, projectKey, name string) (bool, error) {
+	deleted, err := s.client.HDel(ctx, redisSavedContextsKey(projectKey), name).Result()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func (s *Redis) GetAliases(ctx context.Context) ([]model.Alias, error) {
+	fields, err := s.client.HGetAll(ctx, redisAliasesKey()).Result()
+	if err != nil {
+		return nil, err
+	}
+	aliases := make([]model.Alias, 0, len(fields))
+	for alias, projectKey := range fields {
+		aliases = append(aliases, model.Alias{Alias: alias, ProjectKey: projectKey})
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Alias < aliases[j].Alias })
+	return aliases, nil
+}
+
+func (s *Redis) GetAlias(ctx context.Context, alias string) (model.Alias, error) {
+	projectKey, err := s.client.HGet(ctx, redisAliasesKey(), alias).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return model.Alias{}, model.NewErrNotFound("alias", alias)
+		}
+		return model.Alias{}, err
+	}
+	return model.Alias{Alias: alias, ProjectKey: projectKey}, nil
+}
+
+func (s *Redis) UpsertAlias(ctx context.Context, alias model.Alias) error {
+	err := s.client.HSet(ctx, redisAliasesKey(), alias.Alias, alias.ProjectKey).Err()
+	return errors.Wrap(err, "unable to upsert alias")
+}
+
+func (s *Redis) DeleteAlias(ctx context.Context, alias string) (bool, error) {
+	deleted, err := s.client.HDel(ctx, redisAliasesKey(), alias).Result()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func (s *Redis) GetFlagTriggers(ctx context.Context, projectKey string) ([]model.FlagTrigger, error) {
+	fields, err := s.client.HGetAll(ctx, redisFlagTriggersKey()).Result()
+	if err != nil {
+		return nil, err
+	}
+	triggers := make([]model.FlagTrigger, 0)
+	for id, data := range fields {
+		trigger, err := unmarshalFlagTrigger(id, data)
+		if err != nil {
+			return nil, err
+		}
+		if trigger.ProjectKey == projectKey {
+			triggers = append(triggers, trigger)
+		}
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		if triggers[i].FlagKey != triggers[j].FlagKey {
+			return triggers[i].FlagKey < triggers[j].FlagKey
+		}
+		if !triggers[i].CreatedAt.Equal(triggers[j].CreatedAt) {
+			return triggers[i].CreatedAt.Before(triggers[j].CreatedAt)
+		}
+		return triggers[i].ID < triggers[j].ID
+	})
+	return triggers, nil
+}
+
+func (s *Redis) GetFlagTrigger(ctx context.Context, id string) (model.FlagTrigger, error) {
+	data, err := s.client.HGet(ctx, redisFlagTriggersKey(), id).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return model.FlagTrigger{}, model.NewErrNotFound("trigger", id)
+		}
+		return model.FlagTrigger{}, err
+	}
+	return unmarshalFlagTrigger(id, data)
+}
+
+func unmarshalFlagTrigger(id, data string) (model.FlagTrigger, error) {
+	var stored redisFlagTrigger
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
+		return model.FlagTrigger{}, errors.Wrap(err, "unable to unmarshal flag trigger")
+	}
+	return model.FlagTrigger{
+		ID:         id,
+		ProjectKey: stored.ProjectKey,
+		FlagKey:    stored.FlagKey,
+		Action:     stored.Action,
+		CreatedAt:  stored.CreatedAt,
+	}, nil
+}
+
+func (s *Redis) InsertFlagTrigger(ctx context.Context, trigger model.FlagTrigger) error {
+	data, err := json.Marshal(redisFlagTrigger{
+		ProjectKey: trigger.ProjectKey,
+		FlagKey:    trigger.FlagKey,
+		Action:     trigger.Action,
+		CreatedAt:  trigger.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	err = s.client.HSet(ctx, redisFlagTriggersKey(), trigger.ID, data).Err()
+	return errors.Wrap(err, "unable to insert flag trigger")
+}
+
+func (s *Redis) DeleteFlagTrigger(ctx context.Context, id string) (bool, error) {
+	deleted, err := s.client.HDel(ctx, redisFlagTriggersKey(), id).Result()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// ErrBackupsNotSupported is returned for backups, which are sqlite database files, by the Redis store and in-memory
+// sqlite stores. Use Redis's own persistence (RDB snapshots or AOF) to back up a Redis store instead.
+var ErrBackupsNotSupported = errors.New("backups are only supported by the sqlite store on disk")
+
+func (s *Redis) CreateBackup(ctx context.Context) (io.ReadCloser, int64, error) {
+	return nil, 0, ErrBackupsNotSupported
+}
+
+func (s *Redis) RestoreBackup(ctx context.Context, stream io.Reader) (string, error) {
+	return "", ErrBackupsNotSupported
+}
@@ -0,0 +1,89 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/devserver/store/storetest"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestRedisFunctions(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+
+	store, err := db.NewRedis(ctx, "redis://"+server.Addr())
+	require.NoError(t, err)
+
+	storetest.Run(t, store)
+
+	t.Run("state survives reconnecting", func(t *testing.T) {
+		reconnected, err := db.NewRedis(ctx, "redis://"+server.Addr())
+		require.NoError(t, err)
+
+		overrides, err := reconnected.GetOverridesForProject(ctx, "main-test-proj")
+		require.NoError(t, err)
+		assert.Len(t, overrides, 2)
+	})
+
+	t.Run("WithTx restores the keys written in a transaction that fails", func(t *testing.T) {
+		before, err := store.GetOverridesForProject(ctx, "main-test-proj")
+		require.NoError(t, err)
+		override := before[0]
+		override.Value = ldvalue.String("rolled back")
+
+		err = store.WithTx(ctx, func(ctx context.Context) error {
+			if _, err := store.UpsertOverride(ctx, override); err != nil {
+				return err
+			}
+			if err := store.InsertProject(ctx, model.Project{Key: "rolled-back-proj"}); err != nil {
+				return err
+			}
+			return errors.New("failed")
+		})
+		assert.EqualError(t, err, "failed")
+
+		after, err := store.GetOverridesForProject(ctx, "main-test-proj")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, before, after)
+		_, err = store.GetDevProject(ctx, "rolled-back-proj")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		for _, key := range server.Keys() {
+			assert.NotContains(t, key, ":tx:", "backups are deleted")
+		}
+	})
+
+	t.Run("deleting a project deletes the set of its linked clones", func(t *testing.T) {
+		require.NoError(t, store.InsertProject(ctx, model.Project{Key: "base-proj"}))
+		require.NoError(t, store.InsertProject(ctx, model.Project{Key: "clone-proj", BaseProjectKey: "base-proj"}))
+
+		_, deleted, err := store.DeleteDevProject(ctx, "base-proj")
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		for _, key := range server.Keys() {
+			assert.NotContains(t, key, "base-proj")
+		}
+
+		_, _, err = store.DeleteDevProject(ctx, "clone-proj")
+		require.NoError(t, err)
+	})
+
+	t.Run("backups are not supported", func(t *testing.T) {
+		_, _, err := store.CreateBackup(ctx)
+		assert.ErrorIs(t, err, db.ErrBackupsNotSupported)
+	})
+}
+
+func TestNewRedis(t *testing.T) {
+	t.Run("rejects invalid urls", func(t *testing.T) {
+		_, err := db.NewRedis(context.Background(), "localhost:6379")
+		assert.ErrorContains(t, err, "invalid redis url")
+	})
+}
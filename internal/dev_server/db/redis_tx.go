@@ -0,0 +1,195 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const ctxKeyRedisTx = ctxKey("db.RedisTx")
+
+// redisTxBackupTTL is how long the backups of the keys written in a transaction are kept, so that the backups of a
+// dev server that stops in the middle of a transaction don't stay around.
+const redisTxBackupTTL = time.Hour
+
+// redisWriteCommands are the commands the store writes with, whose keys are backed up before they're first written in
+// a transaction. DEL is the only one that can write to more than one key.
+var redisWriteCommands = map[string]bool{
+	"del":              true,
+	"hdel":             true,
+	"hset":             true,
+	"sadd":             true,
+	"srem":             true,
+	"set":              true,
+	"zadd":             true,
+	"zrem":             true,
+	"zremrangebyscore": true,
+}
+
+// redisTx is a transaction started by WithTx. Redis transactions can't read what's been written in them, which the
+// model's operations need to do, so instead each key is copied before it's first written, and the copies are restored
+// if the transaction is rolled back.
+//
+// Writes aren't isolated from other dev servers sharing the Redis server, and rolling back restores the keys to how
+// they were before the transaction, so it also undoes what other dev servers wrote to them in the meantime.
+type redisTx struct {
+	id string
+
+	mu sync.Mutex
+	// keys are the keys written in the transaction, in the order they were first written
+	keys []string
+	// existed is whether each key existed before it was first written
+	existed map[string]bool
+}
+
+// WithTx runs fn in a transaction. Nested transactions are part of the outermost one, and are only committed or rolled
+// back with it. Rolling back needs the COPY command, from Redis 6.2.
+func (s *Redis) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(ctxKeyRedisTx).(*redisTx); ok && tx != nil {
+		return fn(ctx)
+	}
+
+	tx := &redisTx{id: uuid.NewString(), existed: make(map[string]bool)}
+	err := fn(context.WithValue(ctx, ctxKeyRedisTx, tx))
+	// the backups are cleaned up even if fn's context was canceled
+	cleanupCtx := withoutRedisTx(context.WithoutCancel(ctx))
+	if err != nil {
+		if rollbackErr := tx.rollback(cleanupCtx, s.client); rollbackErr != nil {
+			return errors.Wrapf(err, "unable to roll back transaction (%s)", rollbackErr)
+		}
+		return err
+	}
+	return tx.commit(cleanupCtx, s.client)
+}
+
+// withoutRedisTx returns a context whose store calls aren't part of a transaction, for the commands that back up and
+// restore keys.
+func withoutRedisTx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyRedisTx, (*redisTx)(nil))
+}
+
+func (tx *redisTx) backupKey(key string) string {
+	return redisKeyPrefix + "tx:" + tx.id + ":" + key
+}
+
+// backUp copies each key that hasn't been written in the transaction yet.
+func (tx *redisTx) backUp(ctx context.Context, client *redis.Client, keys ...string) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	ctx = withoutRedisTx(ctx)
+	for _, key := range keys {
+		if _, ok := tx.existed[key]; ok {
+			continue
+		}
+		var copied *redis.IntCmd
+		_, err := client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			copied = pipe.Copy(ctx, key, tx.backupKey(key), client.Options().DB, true)
+			pipe.Expire(ctx, tx.backupKey(key), redisTxBackupTTL)
+			return nil
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to back up %s", key)
+		}
+		tx.keys = append(tx.keys, key)
+		tx.existed[key] = copied.Val() == 1
+	}
+	return nil
+}
+
+// rollback restores the keys written in the transaction from their backups, or deletes them if they didn't exist.
+func (tx *redisTx) rollback(ctx context.Context, client *redis.Client) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range slices.Backward(tx.keys) {
+			if tx.existed[key] {
+				pipe.Copy(ctx, tx.backupKey(key), key, client.Options().DB, true)
+				pipe.Persist(ctx, key)
+			} else {
+				pipe.Del(ctx, key)
+			}
+			pipe.Del(ctx, tx.backupKey(key))
+		}
+		return nil
+	})
+	return err
+}
+
+// commit deletes the backups of the keys written in the transaction.
+func (tx *redisTx) commit(ctx context.Context, client *redis.Client) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if len(tx.keys) == 0 {
+		return nil
+	}
+	backups := make([]string, 0, len(tx.keys))
+	for _, key := range tx.keys {
+		backups = append(backups, tx.backupKey(key))
+	}
+	return client.Del(ctx, backups...).Err()
+}
+
+// redisTxHook backs up the keys that store calls made in a transaction write to, before they're written.
+type redisTxHook struct {
+	client *redis.Client
+}
+
+var _ redis.Hook = redisTxHook{}
+
+func (h redisTxHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h redisTxHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.backUp(ctx, cmd); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+func (h redisTxHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			if err := h.backUp(ctx, cmd); err != nil {
+				for _, cmd := range cmds {
+					cmd.SetErr(err)
+				}
+				return err
+			}
+		}
+		return next(ctx, cmds)
+	}
+}
+
+func (h redisTxHook) backUp(ctx context.Context, cmd redis.Cmder) error {
+	tx, ok := ctx.Value(ctxKeyRedisTx).(*redisTx)
+	if !ok || tx == nil || !redisWriteCommands[cmd.Name()] {
+		return nil
+	}
+	args := cmd.Args()
+	if len(args) < 2 {
+		return nil
+	}
+	last := 2
+	if cmd.Name() == "del" {
+		last = len(args)
+	}
+	keys := make([]string, 0, last-1)
+	for _, arg := range args[1:last] {
+		if key, ok := arg.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	return tx.backUp(ctx, h.client, keys...)
+}
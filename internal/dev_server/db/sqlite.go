@@ -4,8 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/pkg/errors"
@@ -25,7 +28,7 @@ type Sqlite struct {
 var _ model.Store = &Sqlite{}
 
 func (s *Sqlite) GetDevProjectKeys(ctx context.Context) ([]string, error) {
-	rows, err := s.database.Query("select key from projects")
+	rows, err := s.conn(ctx).QueryContext(ctx, "select key from projects")
 	if err != nil {
 		return nil, err
 	}
@@ -42,17 +45,51 @@ func (s *Sqlite) GetDevProjectKeys(ctx context.Context) ([]string, error) {
 }
 
 func (s *Sqlite) GetDevProject(ctx context.Context, key string) (*model.Project, error) {
+	project, err := s.getDevProject(ctx, key, "orphaned_detail, orphaned_at, environment_keys, archived_at, sync_attempted_at, sync_duration_ms, sync_error, flag_filter, base_project_key, flag_metadata, context_kinds")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.conn(ctx).QueryContext(ctx, "SELECT key FROM projects WHERE base_project_key = ? ORDER BY key", key)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query linked clones")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var cloneKey string
+		if err := rows.Scan(&cloneKey); err != nil {
+			return nil, err
+		}
+		project.LinkedCloneKeys = append(project.LinkedCloneKeys, cloneKey)
+	}
+	return project, rows.Err()
+}
+
+// getDevProject fetches the project, reading whether it's orphaned, its prefetched environment keys, whether it's
+// archived, its sync status, its flag filter, its base project, its flags' metadata, and its context kinds from
+// laterColumns. Databases from before those were tracked don't have the columns, so they can be replaced with defaults.
+func (s *Sqlite) getDevProject(ctx context.Context, key string, laterColumns string) (*model.Project, error) {
 	var project model.Project
 	var contextData string
 	var flagStateData string
 
-	row := s.database.QueryRowContext(ctx, `
-        SELECT key, source_environment_key, context, last_sync_time, flag_state 
+	var orphanedDetail string
+	var orphanedAt sql.NullTime
+	var environmentKeysData string
+	var archivedAt sql.NullTime
+	var syncAttemptedAt sql.NullTime
+	var syncDurationMs int64
+	var syncError string
+	var flagFilterData string
+	var flagMetadataData string
+	var contextKindsData string
+
+	row := s.conn(ctx).QueryRowContext(ctx, `
+        SELECT key, source_environment_key, context, last_sync_time, flag_state, `+laterColumns+`
         FROM projects 
         WHERE key = ?
     `, key)
 
-	if err := row.Scan(&project.Key, &project.SourceEnvironmentKey, &contextData, &project.LastSyncTime, &flagStateData); err != nil {
+	if err := row.Scan(&project.Key, &project.SourceEnvironmentKey, &contextData, &project.LastSyncTime, &flagStateData, &orphanedDetail, &orphanedAt, &environmentKeysData, &archivedAt, &syncAttemptedAt, &syncDurationMs, &syncError, &flagFilterData, &project.BaseProjectKey, &flagMetadataData, &contextKindsData); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, model.NewErrNotFound("project", key)
 		}
@@ -69,16 +106,150 @@ func (s *Sqlite) GetDevProject(ctx context.Context, key string) (*model.Project,
 		return nil, errors.Wrap(err, "unable to unmarshal flag state data")
 	}
 
+	if orphanedAt.Valid {
+		project.Orphaned = &model.Orphaned{Detail: orphanedDetail, Since: orphanedAt.Time}
+	}
+
+	if err := json.Unmarshal([]byte(environmentKeysData), &project.EnvironmentKeys); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal environment keys")
+	}
+
+	if archivedAt.Valid {
+		project.ArchivedAt = &archivedAt.Time
+	}
+
+	if syncAttemptedAt.Valid {
+		project.SyncStatus = &model.SyncStatus{
+			AttemptedAt: syncAttemptedAt.Time,
+			Duration:    time.Duration(syncDurationMs) * time.Millisecond,
+			Error:       syncError,
+		}
+	}
+
+	if err := json.Unmarshal([]byte(flagFilterData), &project.FlagFilter); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal flag filter")
+	}
+
+	if err := json.Unmarshal([]byte(flagMetadataData), &project.FlagMetadata); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal flag metadata")
+	}
+
+	if err := json.Unmarshal([]byte(contextKindsData), &project.ContextKinds); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal context kinds")
+	}
+
 	return &project, nil
 }
 
+// syncStatusColumns returns the values of the sync_attempted_at, sync_duration_ms, and sync_error columns for status.
+func syncStatusColumns(status *model.SyncStatus) (sql.NullTime, int64, string) {
+	if status == nil {
+		return sql.NullTime{}, 0, ""
+	}
+	return sql.NullTime{Time: status.AttemptedAt, Valid: true}, status.Duration.Milliseconds(), status.Error
+}
+
+// orphanedColumns returns the values of the orphaned_detail and orphaned_at columns for orphaned.
+func orphanedColumns(orphaned *model.Orphaned) (string, sql.NullTime) {
+	if orphaned == nil {
+		return "", sql.NullTime{}
+	}
+	return orphaned.Detail, sql.NullTime{Time: orphaned.Since, Valid: true}
+}
+
+func (s *Sqlite) OrphanProject(ctx context.Context, projectKey string, orphaned model.Orphaned) (bool, error) {
+	detail, at := orphanedColumns(&orphaned)
+	result, err := s.conn(ctx).ExecContext(ctx, `
+		UPDATE projects
+		SET orphaned_detail = ?, orphaned_at = ?
+		WHERE key = ?
+	`, detail, at, projectKey)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to mark project orphaned")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *Sqlite) SetEnvironmentKeys(ctx context.Context, projectKey string, keys map[string]model.EnvironmentKeys) (bool, error) {
+	keysJson, err := json.Marshal(keys)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to marshal environment keys")
+	}
+	result, err := s.conn(ctx).ExecContext(ctx, `
+		UPDATE projects
+		SET environment_keys = ?
+		WHERE key = ?
+	`, string(keysJson), projectKey)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to set environment keys")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *Sqlite) ArchiveProject(ctx context.Context, projectKey string, archivedAt *time.Time) (bool, error) {
+	var at sql.NullTime
+	if archivedAt != nil {
+		at = sql.NullTime{Time: *archivedAt, Valid: true}
+	}
+	result, err := s.conn(ctx).ExecContext(ctx, `
+		UPDATE projects
+		SET archived_at = ?
+		WHERE key = ?
+	`, at, projectKey)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to archive project")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *Sqlite) SetSyncStatus(ctx context.Context, projectKey string, status model.SyncStatus) (bool, error) {
+	attemptedAt, durationMs, syncError := syncStatusColumns(&status)
+	result, err := s.conn(ctx).ExecContext(ctx, `
+		UPDATE projects
+		SET sync_attempted_at = ?, sync_duration_ms = ?, sync_error = ?
+		WHERE key = ?
+	`, attemptedAt, durationMs, syncError, projectKey)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to set sync status")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
 func (s *Sqlite) UpdateProject(ctx context.Context, project model.Project) (bool, error) {
 	flagsStateJson, err := json.Marshal(project.AllFlagsState)
 	if err != nil {
 		return false, errors.Wrap(err, "unable to marshal flags state when updating project")
 	}
+	flagFilterJson, err := json.Marshal(project.FlagFilter)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to marshal flag filter when updating project")
+	}
+	flagMetadataJson, err := json.Marshal(project.FlagMetadata)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to marshal flag metadata when updating project")
+	}
+	contextKindsJson, err := json.Marshal(project.ContextKinds)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to marshal context kinds when updating project")
+	}
 
-	tx, err := s.database.BeginTx(ctx, nil)
+	tx, err := s.beginTx(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -87,11 +258,12 @@ func (s *Sqlite) UpdateProject(ctx context.Context, project model.Project) (bool
 			_ = tx.Rollback()
 		}
 	}()
+	orphanedDetail, orphanedAt := orphanedColumns(project.Orphaned)
 	result, err := tx.ExecContext(ctx, `
 		UPDATE projects
-		SET flag_state = ?, last_sync_time = ?, context=?, source_environment_key=?
+		SET flag_state = ?, last_sync_time = ?, context=?, source_environment_key=?, orphaned_detail=?, orphaned_at=?, flag_filter=?, flag_metadata=?, context_kinds=?
 		WHERE key = ?;
-	`, flagsStateJson, project.LastSyncTime, project.Context.JSONString(), project.SourceEnvironmentKey, project.Key)
+	`, flagsStateJson, project.LastSyncTime, project.Context.JSONString(), project.SourceEnvironmentKey, orphanedDetail, orphanedAt, string(flagFilterJson), string(flagMetadataJson), string(contextKindsJson), project.Key)
 	if err != nil {
 		return false, errors.Wrap(err, "unable to execute update project")
 	}
@@ -105,7 +277,7 @@ func (s *Sqlite) UpdateProject(ctx context.Context, project model.Project) (bool
 		return false, err
 	}
 
-	err = InsertAvailableVariations(ctx, tx, project)
+	err = InsertAvailableVariations(ctx, tx.Tx, project)
 	if err != nil {
 		return false, err
 	}
@@ -114,21 +286,28 @@ func (s *Sqlite) UpdateProject(ctx context.Context, project model.Project) (bool
 	// https://github.com/launchdarkly/ldcli/issues/541#issuecomment-2920512092
 	_, err = tx.ExecContext(ctx, `
 		DELETE FROM overrides
-		WHERE project_key = ? AND flag_key NOT IN (SELECT flag_key FROM available_variations WHERE project_key = ?)
+		WHERE project_key = ? AND NOT locked AND flag_key NOT IN (SELECT flag_key FROM available_variations WHERE project_key = ?)
 	`, project.Key, project.Key)
 	if err != nil {
 		return false, err
 	}
 
-	err = tx.Commit()
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return false, err
 	}
+	if rowsAffected > 0 {
+		err = insertFlagStateHistory(ctx, tx.Tx, project.Key, flagsStateJson)
+		if err != nil {
+			return false, err
+		}
+	}
 
-	rowsAffected, err := result.RowsAffected()
+	err = tx.Commit()
 	if err != nil {
 		return false, err
 	}
+
 	if rowsAffected == 0 {
 		return false, nil
 	}
@@ -136,20 +315,64 @@ func (s *Sqlite) UpdateProject(ctx context.Context, project model.Project) (bool
 	return true, nil
 }
 
-func (s *Sqlite) DeleteDevProject(ctx context.Context, key string) (bool, error) {
-	result, err := s.database.Exec("DELETE FROM projects where key=?", key)
+func (s *Sqlite) DeleteDevProject(ctx context.Context, key string) (model.ProjectDeletion, bool, error) {
+	tx, err := s.beginTx(ctx)
 	if err != nil {
-		return false, err
+		return model.ProjectDeletion{}, false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// foreign keys would cascade these, but deleting them explicitly counts what was removed
+	var deletion model.ProjectDeletion
+	for _, dependent := range []struct {
+		table string
+		count *int
+	}{
+		{"overrides", &deletion.Overrides},
+		{"scenario_overrides", &deletion.ScenarioOverrides},
+		{"available_variations", &deletion.AvailableVariations},
+		{"aliases", &deletion.Aliases},
+		{"flag_state_history", &deletion.FlagStateHistory},
+		{"override_history", &deletion.OverrideHistory},
+		{"override_schedules", &deletion.OverrideSchedules},
+		{"saved_contexts", &deletion.SavedContexts},
+		{"flag_triggers", &deletion.FlagTriggers},
+	} {
+		var result sql.Result
+		result, err = tx.ExecContext(ctx, "DELETE FROM "+dependent.table+" WHERE project_key = ?", key)
+		if err != nil {
+			return model.ProjectDeletion{}, false, errors.Wrapf(err, "unable to delete project %s", dependent.table)
+		}
+		var rowsAffected int64
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return model.ProjectDeletion{}, false, err
+		}
+		*dependent.count = int(rowsAffected)
 	}
 
+	result, err := tx.ExecContext(ctx, "DELETE FROM projects WHERE key = ?", key)
+	if err != nil {
+		return model.ProjectDeletion{}, false, errors.Wrap(err, "unable to delete project")
+	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return false, err
+		return model.ProjectDeletion{}, false, err
 	}
 	if rowsAffected == 0 {
-		return false, nil
+		_ = tx.Rollback()
+		return model.ProjectDeletion{}, false, nil
 	}
-	return true, nil
+
+	err = tx.Commit()
+	if err != nil {
+		return model.ProjectDeletion{}, false, err
+	}
+	return deletion, true, nil
 }
 
 func InsertAvailableVariations(ctx context.Context, tx *sql.Tx, project model.Project) (err error) {
@@ -175,7 +398,19 @@ func (s *Sqlite) InsertProject(ctx context.Context, project model.Project) (err
 	if err != nil {
 		return errors.Wrap(err, "unable to marshal flags state when writing project")
 	}
-	tx, err := s.database.BeginTx(ctx, nil)
+	flagFilterJson, err := json.Marshal(project.FlagFilter)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal flag filter when writing project")
+	}
+	flagMetadataJson, err := json.Marshal(project.FlagMetadata)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal flag metadata when writing project")
+	}
+	contextKindsJson, err := json.Marshal(project.ContextKinds)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal context kinds when writing project")
+	}
+	tx, err := s.beginTx(ctx)
 	if err != nil {
 		return
 	}
@@ -199,21 +434,34 @@ SELECT 1 FROM projects WHERE key = ?
 	if err != nil {
 		return
 	}
+	syncAttemptedAt, syncDurationMs, syncError := syncStatusColumns(project.SyncStatus)
 	_, err = tx.Exec(`
-INSERT INTO projects (key, source_environment_key, context, last_sync_time, flag_state)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO projects (key, source_environment_key, context, last_sync_time, flag_state, sync_attempted_at, sync_duration_ms, sync_error, flag_filter, base_project_key, flag_metadata, context_kinds)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `,
 		project.Key,
 		project.SourceEnvironmentKey,
 		project.Context.JSONString(),
 		project.LastSyncTime,
 		string(flagsStateJson),
+		syncAttemptedAt,
+		syncDurationMs,
+		syncError,
+		string(flagFilterJson),
+		project.BaseProjectKey,
+		string(flagMetadataJson),
+		string(contextKindsJson),
 	)
 	if err != nil {
 		return
 	}
 
-	err = InsertAvailableVariations(ctx, tx, project)
+	err = InsertAvailableVariations(ctx, tx.Tx, project)
+	if err != nil {
+		return err
+	}
+
+	err = insertFlagStateHistory(ctx, tx.Tx, project.Key, flagsStateJson)
 	if err != nil {
 		return err
 	}
@@ -221,7 +469,7 @@ VALUES (?, ?, ?, ?, ?)
 }
 
 func (s *Sqlite) GetAvailableVariationsForProject(ctx context.Context, projectKey string) (map[string][]model.Variation, error) {
-	rows, err := s.database.QueryContext(ctx, `
+	rows, err := s.conn(ctx).QueryContext(ctx, `
 			SELECT flag_key, id, name, description, value
 			FROM available_variations
 			WHERE project_key = ?
@@ -268,8 +516,8 @@ func (s *Sqlite) GetAvailableVariationsForProject(ctx context.Context, projectKe
 }
 
 func (s *Sqlite) GetOverridesForProject(ctx context.Context, projectKey string) (model.Overrides, error) {
-	rows, err := s.database.QueryContext(ctx, `
-        SELECT  flag_key, active, value, version
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+        SELECT  flag_key, active, value, version, locked, rollout
         FROM overrides 
         WHERE project_key = ?
     `, projectKey)
@@ -279,14 +527,22 @@ func (s *Sqlite) GetOverridesForProject(ctx context.Context, projectKey string)
 	}
 	defer rows.Close()
 
+	return scanOverrides(rows, projectKey)
+}
+
+// scanOverrides reads overrides from rows of flag_key, active, value, version, locked, rollout. Only user overrides can
+// be locked or have rollouts, so other queries select FALSE for locked and an empty rollout.
+func scanOverrides(rows *sql.Rows, projectKey string) (model.Overrides, error) {
 	overrides := make(model.Overrides, 0)
 	for rows.Next() {
 		var flagKey string
 		var active bool
 		var value string
 		var version int
+		var locked bool
+		var rolloutData string
 
-		err = rows.Scan(&flagKey, &active, &value, &version)
+		err := rows.Scan(&flagKey, &active, &value, &version, &locked, &rolloutData)
 		if err != nil {
 			return nil, err
 		}
@@ -296,19 +552,155 @@ func (s *Sqlite) GetOverridesForProject(ctx context.Context, projectKey string)
 		if err != nil {
 			return nil, err
 		}
+		rollout, err := unmarshalRollout(rolloutData)
+		if err != nil {
+			return nil, err
+		}
 		overrides = append(overrides, model.Override{
 			ProjectKey: projectKey,
 			FlagKey:    flagKey,
 			Value:      ldValue,
 			Active:     active,
 			Version:    version,
+			Locked:     locked,
+			Rollout:    rollout,
 		})
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// marshalRollout stores an override's rollout as JSON, or as an empty string if it doesn't have one.
+func marshalRollout(rollout *model.Rollout) (string, error) {
+	if rollout == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(rollout)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to marshal rollout")
+	}
+	return string(data), nil
+}
+
+func unmarshalRollout(data string) (*model.Rollout, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var rollout model.Rollout
+	if err := json.Unmarshal([]byte(data), &rollout); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal rollout")
+	}
+	return &rollout, nil
+}
+
+func (s *Sqlite) GetScenarioOverridesForProject(ctx context.Context, projectKey string) (model.Overrides, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT flag_key, active, value, version, FALSE, ''
+		FROM scenario_overrides
+		WHERE project_key = ?
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOverrides(rows, projectKey)
+}
+
+func (s *Sqlite) ReplaceScenarioOverrides(ctx context.Context, projectKey string, values map[string]ldvalue.Value) (model.Overrides, error) {
+	tx, err := s.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT flag_key, active, value, version, FALSE, ''
+		FROM scenario_overrides
+		WHERE project_key = ? AND active = true
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	current, err := scanOverrides(rows, projectKey)
+	_ = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// Deactivate the flags that are no longer part of the scenario
+	for _, override := range current {
+		if _, ok := values[override.FlagKey]; ok {
+			continue
+		}
+		var valueJson []byte
+		var version int
+		err = tx.QueryRowContext(ctx, `
+			UPDATE scenario_overrides
+			SET active = false, version = version+1
+			WHERE project_key = ? AND flag_key = ?
+			RETURNING value, version
+		`, projectKey, override.FlagKey).Scan(&valueJson, &version)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to deactivate scenario override")
+		}
+		err = insertOverrideHistory(ctx, tx.Tx, model.LayerScenario, projectKey, override.FlagKey, valueJson, false, version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for flagKey, value := range values {
+		var valueJson []byte
+		valueJson, err = value.MarshalJSON()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to marshal scenario override value")
+		}
+		var version int
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO scenario_overrides (project_key, flag_key, value, active)
+			VALUES (?, ?, ?, true)
+				ON CONFLICT(project_key, flag_key) DO UPDATE SET
+					value=excluded.value,
+					active=true,
+					version=version+1
+			RETURNING version
+		`, projectKey, flagKey, valueJson).Scan(&version)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to upsert scenario override")
+		}
+		err = insertOverrideHistory(ctx, tx.Tx, model.LayerScenario, projectKey, flagKey, valueJson, true, version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err = tx.QueryContext(ctx, `
+		SELECT flag_key, active, value, version, FALSE, ''
+		FROM scenario_overrides
+		WHERE project_key = ?
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := scanOverrides(rows, projectKey)
+	_ = rows.Close()
+	if err != nil {
 		return nil, err
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
 	return overrides, nil
 }
 
@@ -317,87 +709,682 @@ func (s *Sqlite) UpsertOverride(ctx context.Context, override model.Override) (m
 	if err != nil {
 		return model.Override{}, errors.Wrap(err, "unable to marshal override value when writing override")
 	}
-	row := s.database.QueryRowContext(ctx, `
-		INSERT INTO overrides (project_key, flag_key, value, active)
-		VALUES (?, ?, ?, ?)
+	tx, err := s.beginTx(ctx)
+	if err != nil {
+		return model.Override{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	rolloutJson, err := marshalRollout(override.Rollout)
+	if err != nil {
+		return model.Override{}, err
+	}
+	row := tx.QueryRowContext(ctx, `
+		INSERT INTO overrides (project_key, flag_key, value, active, rollout)
+		VALUES (?, ?, ?, ?, ?)
 			ON CONFLICT(flag_key, project_key) DO UPDATE SET
 			    value=excluded.value,
 			    active=excluded.active,
+			    rollout=excluded.rollout,
 			    version=version+1
+			WHERE NOT overrides.locked
 		RETURNING project_key, flag_key, active, value, version;
 	`,
 		override.ProjectKey,
 		override.FlagKey,
 		valueJson,
 		override.Active,
+		rolloutJson,
 	)
 	var tempValue []byte
-	if err := row.Scan(&override.ProjectKey, &override.FlagKey, &override.Active, &tempValue, &override.Version); err != nil {
+	if err = row.Scan(&override.ProjectKey, &override.FlagKey, &override.Active, &tempValue, &override.Version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// the conflicting override is locked, so nothing was written
+			return model.Override{}, errors.WithStack(model.NewErrLocked(override.ProjectKey, override.FlagKey))
+		}
 		return model.Override{}, errors.Wrap(err, "unable to upsert override")
 	}
-	if err := json.Unmarshal(tempValue, &override.Value); err != nil {
+	if err = json.Unmarshal(tempValue, &override.Value); err != nil {
 		return model.Override{}, errors.Wrap(err, "unable to unmarshal override value")
 	}
+	if err = insertOverrideHistory(ctx, tx.Tx, model.LayerUser, override.ProjectKey, override.FlagKey, tempValue, override.Active, override.Version); err != nil {
+		return model.Override{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return model.Override{}, err
+	}
 	return override, nil
 }
 
 func (s *Sqlite) DeactivateOverride(ctx context.Context, projectKey, flagKey string) (int, error) {
-	row := s.database.QueryRowContext(ctx, `
+	tx, err := s.beginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var locked bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT locked FROM overrides WHERE project_key = ? and flag_key = ?
+	`, projectKey, flagKey).Scan(&locked)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	if locked {
+		err = errors.WithStack(model.NewErrLocked(projectKey, flagKey))
+		return 0, err
+	}
+	row := tx.QueryRowContext(ctx, `
 		UPDATE overrides
 		set active = false, version = version+1
 		where project_key = ? and flag_key = ? and active = true
-		returning version
+		returning value, version
 	`,
 		projectKey,
 		flagKey,
 	)
+	var value []byte
 	var version int
-	if err := row.Scan(&version); err != nil {
+	if err = row.Scan(&value, &version); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return 0, errors.Wrapf(model.NewErrNotFound("flag", flagKey), "no override in project %s", projectKey)
 		}
 		return 0, err
 	}
+	if err = insertOverrideHistory(ctx, tx.Tx, model.LayerUser, projectKey, flagKey, value, false, version); err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
 
 	return version, nil
 }
 
-func (s *Sqlite) RestoreBackup(ctx context.Context, stream io.Reader) (string, error) {
-	filepath, err := s.backupManager.RestoreToFile(ctx, stream)
+func (s *Sqlite) SetOverrideLocked(ctx context.Context, projectKey, flagKey string, locked bool) (model.Override, error) {
+	tx, err := s.beginTx(ctx)
 	if err != nil {
-		return "", errors.Wrap(err, "unable to restore backup db")
+		return model.Override{}, err
 	}
-	err = s.database.Close()
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE overrides
+		SET locked = ?
+		WHERE project_key = ? AND flag_key = ?
+		RETURNING flag_key, active, value, version, locked, rollout
+	`, locked, projectKey, flagKey)
 	if err != nil {
-		return "", errors.Wrap(err, "unable to close database before restoring backup")
+		return model.Override{}, errors.Wrap(err, "unable to lock override")
 	}
-	err = os.Rename(filepath, s.dbPath)
+	overrides, err := scanOverrides(rows, projectKey)
+	_ = rows.Close()
 	if err != nil {
-		//panic because this would really leave the app in an invalid state
-		panic(err)
+		return model.Override{}, err
 	}
-	s.database, err = sql.Open("sqlite3", s.dbPath)
-	if err != nil {
-		//panic because this would really leave the app in an invalid state
-		panic(err)
+	if len(overrides) == 0 {
+		err = errors.Wrapf(model.NewErrNotFound("flag", flagKey), "no override in project %s", projectKey)
+		return model.Override{}, err
 	}
-
-	err = s.runMigrations(ctx)
+	override := overrides[0]
+	valueJson, err := override.Value.MarshalJSON()
 	if err != nil {
-		return "", errors.Wrap(err, "unable to run migrations after restoring backup")
+		return model.Override{}, errors.Wrap(err, "unable to marshal override value")
+	}
+	if err = insertOverrideLockHistory(ctx, tx.Tx, projectKey, flagKey, valueJson, override.Active, override.Version, locked); err != nil {
+		return model.Override{}, err
 	}
+	if err = tx.Commit(); err != nil {
+		return model.Override{}, err
+	}
+	return override, nil
+}
 
-	return filepath, err
+// insertFlagStateHistory records the flag state synced from the source environment so that it can be reconstructed
+// later by GetProjectStateAt.
+func insertFlagStateHistory(ctx context.Context, tx *sql.Tx, projectKey string, flagsStateJson []byte) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO flag_state_history (project_key, flag_state, recorded_at)
+		VALUES (?, ?, ?)
+	`, projectKey, string(flagsStateJson), time.Now().UnixMilli())
+	return errors.Wrap(err, "unable to record flag state history")
 }
 
-func (s *Sqlite) CreateBackup(ctx context.Context) (io.ReadCloser, int64, error) {
-	backupPath, err := s.backupManager.MakeBackupFile(ctx)
-	if err != nil {
-		return nil, 0, errors.Wrapf(err, "unable to make backup file, %s", backupPath)
-	}
-	fi, err := os.Open(backupPath)
-	if err != nil {
-		return nil, 0, errors.Wrapf(err, "unable to open backup db at %s", backupPath)
+// insertOverrideHistory records a change to an override so that it can be reconstructed later by GetProjectStateAt.
+// The change is attributed to the actor on ctx.
+func insertOverrideHistory(ctx context.Context, tx *sql.Tx, layer model.OverrideLayer, projectKey, flagKey string, valueJson []byte, active bool, version int) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO override_history (layer, project_key, flag_key, value, active, version, actor, recorded_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, string(layer), projectKey, flagKey, string(valueJson), active, version, model.ActorFromContext(ctx), time.Now().UnixMilli())
+	return errors.Wrap(err, "unable to record override history")
+}
+
+// insertOverrideLockHistory records that the user override was locked or unlocked, attributed to the actor on ctx.
+func insertOverrideLockHistory(ctx context.Context, tx *sql.Tx, projectKey, flagKey string, valueJson []byte, active bool, version int, locked bool) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO override_history (layer, project_key, flag_key, value, active, version, actor, locked, recorded_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, string(model.LayerUser), projectKey, flagKey, string(valueJson), active, version, model.ActorFromContext(ctx), locked, time.Now().UnixMilli())
+	return errors.Wrap(err, "unable to record override history")
+}
+
+func (s *Sqlite) GetProjectStateAt(ctx context.Context, projectKey string, at time.Time) (model.FlagsState, model.LayeredOverrides, error) {
+	var flagStateData string
+	row := s.conn(ctx).QueryRowContext(ctx, `
+		SELECT flag_state
+		FROM flag_state_history
+		WHERE project_key = ? AND recorded_at <= ?
+		ORDER BY recorded_at DESC, id DESC
+		LIMIT 1
+	`, projectKey, at.UnixMilli())
+	if err := row.Scan(&flagStateData); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.LayeredOverrides{}, errors.Wrapf(model.NewErrNotFound("project", projectKey), "no history at %s", at.Format(time.RFC3339))
+		}
+		return nil, model.LayeredOverrides{}, err
+	}
+	var flagsState model.FlagsState
+	if err := json.Unmarshal([]byte(flagStateData), &flagsState); err != nil {
+		return nil, model.LayeredOverrides{}, errors.Wrap(err, "unable to unmarshal flag state history")
+	}
+
+	scenario, err := s.getOverridesAt(ctx, model.LayerScenario, projectKey, at)
+	if err != nil {
+		return nil, model.LayeredOverrides{}, err
+	}
+	user, err := s.getOverridesAt(ctx, model.LayerUser, projectKey, at)
+	if err != nil {
+		return nil, model.LayeredOverrides{}, err
+	}
+	return flagsState, model.LayeredOverrides{Scenario: scenario, User: user}, nil
+}
+
+func (s *Sqlite) GetSnapshots(ctx context.Context, projectKey string) ([]model.Snapshot, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT id, recorded_at
+		FROM flag_state_history
+		WHERE project_key = ?
+		ORDER BY recorded_at DESC, id DESC
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshots := make([]model.Snapshot, 0)
+	for rows.Next() {
+		var id, recordedAt int64
+		if err := rows.Scan(&id, &recordedAt); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, model.Snapshot{
+			ID:         id,
+			ProjectKey: projectKey,
+			RecordedAt: time.UnixMilli(recordedAt),
+		})
+	}
+	return snapshots, rows.Err()
+}
+
+func (s *Sqlite) InsertSnapshot(ctx context.Context, projectKey string) (model.Snapshot, error) {
+	recordedAt := time.Now().UnixMilli()
+	result, err := s.conn(ctx).ExecContext(ctx, `
+		INSERT INTO flag_state_history (project_key, flag_state, recorded_at)
+		SELECT key, flag_state, ?
+		FROM projects
+		WHERE key = ?
+	`, recordedAt, projectKey)
+	if err != nil {
+		return model.Snapshot{}, errors.Wrap(err, "unable to record flag state history")
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return model.Snapshot{}, err
+	}
+	if inserted == 0 {
+		return model.Snapshot{}, model.NewErrNotFound("project", projectKey)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return model.Snapshot{}, err
+	}
+	return model.Snapshot{ID: id, ProjectKey: projectKey, RecordedAt: time.UnixMilli(recordedAt)}, nil
+}
+
+func (s *Sqlite) PruneHistory(ctx context.Context, projectKey string, before time.Time) (int, error) {
+	tx, err := s.beginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	pruned := 0
+	for _, statement := range []string{`
+		DELETE FROM flag_state_history
+		WHERE project_key = ? AND recorded_at < ? AND id != (
+			SELECT id FROM flag_state_history
+			WHERE project_key = ? AND recorded_at < ?
+			ORDER BY recorded_at DESC, id DESC
+			LIMIT 1
+		)`, `
+		DELETE FROM override_history
+		WHERE project_key = ? AND recorded_at < ? AND id != (
+			SELECT id FROM override_history h
+			WHERE h.layer = override_history.layer AND h.project_key = ? AND h.flag_key = override_history.flag_key
+				AND h.recorded_at < ?
+			ORDER BY h.recorded_at DESC, h.id DESC
+			LIMIT 1
+		)`,
+	} {
+		var result sql.Result
+		result, err = tx.ExecContext(ctx, statement, projectKey, before.UnixMilli(), projectKey, before.UnixMilli())
+		if err != nil {
+			return 0, errors.Wrap(err, "unable to prune history")
+		}
+		var rowsAffected int64
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		pruned += int(rowsAffected)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+func (s *Sqlite) GetAuditLog(ctx context.Context, projectKey string, query model.AuditQuery) ([]model.AuditEntry, error) {
+	sqlQuery := `
+		SELECT layer, flag_key, value, active, version, actor, locked, recorded_at
+		FROM override_history
+		WHERE project_key = ?`
+	args := []interface{}{projectKey}
+	if query.FlagKey != "" {
+		sqlQuery += " AND flag_key = ?"
+		args = append(args, query.FlagKey)
+	}
+	if !query.Since.IsZero() {
+		sqlQuery += " AND recorded_at >= ?"
+		args = append(args, query.Since.UnixMilli())
+	}
+	sqlQuery += " ORDER BY recorded_at DESC, id DESC"
+	if query.Limit > 0 {
+		sqlQuery += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+	rows, err := s.conn(ctx).QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]model.AuditEntry, 0)
+	for rows.Next() {
+		var entry model.AuditEntry
+		var layer, valueData string
+		var locked sql.NullBool
+		var recordedAt int64
+		if err := rows.Scan(&layer, &entry.FlagKey, &valueData, &entry.Active, &entry.Version, &entry.Actor, &locked, &recordedAt); err != nil {
+			return nil, err
+		}
+		if locked.Valid {
+			entry.Locked = &locked.Bool
+		}
+		if err := json.Unmarshal([]byte(valueData), &entry.Value); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal override history value")
+		}
+		entry.Layer = model.OverrideLayer(layer)
+		entry.RecordedAt = time.UnixMilli(recordedAt)
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// getOverridesAt returns the most recent change to each override in the layer at or before the given time.
+func (s *Sqlite) getOverridesAt(ctx context.Context, layer model.OverrideLayer, projectKey string, at time.Time) (model.Overrides, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT flag_key, active, value, version, FALSE, ''
+		FROM override_history h
+		WHERE layer = ? AND project_key = ? AND id = (
+			SELECT id FROM override_history
+			WHERE layer = h.layer AND project_key = h.project_key AND flag_key = h.flag_key AND recorded_at <= ?
+			ORDER BY recorded_at DESC, id DESC
+			LIMIT 1
+		)
+	`, string(layer), projectKey, at.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOverrides(rows, projectKey)
+}
+
+func (s *Sqlite) GetOverrideSchedules(ctx context.Context, projectKey string) ([]model.OverrideSchedule, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT flag_key, value, activate_at, deactivate_at, scheduled_by
+		FROM override_schedules
+		WHERE project_key = ?
+		ORDER BY flag_key
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := make([]model.OverrideSchedule, 0)
+	for rows.Next() {
+		schedule := model.OverrideSchedule{ProjectKey: projectKey}
+		var valueData string
+		var activateAt, deactivateAt sql.NullInt64
+		if err := rows.Scan(&schedule.FlagKey, &valueData, &activateAt, &deactivateAt, &schedule.ScheduledBy); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(valueData), &schedule.Value); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal scheduled override value")
+		}
+		schedule.ActivateAt = timeFromNullMillis(activateAt)
+		schedule.DeactivateAt = timeFromNullMillis(deactivateAt)
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+func (s *Sqlite) UpsertOverrideSchedule(ctx context.Context, schedule model.OverrideSchedule) error {
+	valueJson, err := schedule.Value.MarshalJSON()
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal scheduled override value")
+	}
+	_, err = s.conn(ctx).ExecContext(ctx, `
+		INSERT INTO override_schedules (project_key, flag_key, value, activate_at, deactivate_at, scheduled_by)
+		VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT(project_key, flag_key) DO UPDATE SET
+				value=excluded.value,
+				activate_at=excluded.activate_at,
+				deactivate_at=excluded.deactivate_at,
+				scheduled_by=excluded.scheduled_by
+	`, schedule.ProjectKey, schedule.FlagKey, string(valueJson), nullMillis(schedule.ActivateAt), nullMillis(schedule.DeactivateAt), schedule.ScheduledBy)
+	return errors.Wrap(err, "unable to upsert override schedule")
+}
+
+func (s *Sqlite) DeleteOverrideSchedule(ctx context.Context, projectKey, flagKey string) (bool, error) {
+	result, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM override_schedules WHERE project_key = ? AND flag_key = ?", projectKey, flagKey)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *Sqlite) GetSavedContexts(ctx context.Context, projectKey string) ([]model.SavedContext, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT name, context
+		FROM saved_contexts
+		WHERE project_key = ?
+		ORDER BY name
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	savedContexts := make([]model.SavedContext, 0)
+	for rows.Next() {
+		savedContext := model.SavedContext{ProjectKey: projectKey}
+		var contextData string
+		if err := rows.Scan(&savedContext.Name, &contextData); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(contextData), &savedContext.Context); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal saved context")
+		}
+		savedContexts = append(savedContexts, savedContext)
+	}
+	return savedContexts, rows.Err()
+}
+
+func (s *Sqlite) GetSavedContext(ctx context.Context, projectKey, name string) (model.SavedContext, error) {
+	savedContext := model.SavedContext{ProjectKey: projectKey, Name: name}
+	var contextData string
+	row := s.conn(ctx).QueryRowContext(ctx, "SELECT context FROM saved_contexts WHERE project_key = ? AND name = ?", projectKey, name)
+	if err := row.Scan(&contextData); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.SavedContext{}, model.NewErrNotFound("saved context", name)
+		}
+		return model.SavedContext{}, err
+	}
+	if err := json.Unmarshal([]byte(contextData), &savedContext.Context); err != nil {
+		return model.SavedContext{}, errors.Wrap(err, "unable to unmarshal saved context")
+	}
+	return savedContext, nil
+}
+
+func (s *Sqlite) UpsertSavedContext(ctx context.Context, savedContext model.SavedContext) error {
+	_, err := s.conn(ctx).ExecContext(ctx, `
+		INSERT INTO saved_contexts (project_key, name, context)
+		VALUES (?, ?, ?)
+			ON CONFLICT(project_key, name) DO UPDATE SET context=excluded.context
+	`, savedContext.ProjectKey, savedContext.Name, savedContext.Context.JSONString())
+	return errors.Wrap(err, "unable to upsert saved context")
+}
+
+func (s *Sqlite) DeleteSavedContext(ctx context.Context, projectKey, name string) (bool, error) {
+	result, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM saved_contexts WHERE project_key = ? AND name = ?", projectKey, name)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *Sqlite) GetFlagTriggers(ctx context.Context, projectKey string) ([]model.FlagTrigger, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT id, flag_key, action, created_at
+		FROM flag_triggers
+		WHERE project_key = ?
+		ORDER BY flag_key, created_at, id
+	`, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	triggers := make([]model.FlagTrigger, 0)
+	for rows.Next() {
+		trigger := model.FlagTrigger{ProjectKey: projectKey}
+		var createdAt int64
+		if err := rows.Scan(&trigger.ID, &trigger.FlagKey, &trigger.Action, &createdAt); err != nil {
+			return nil, err
+		}
+		trigger.CreatedAt = time.UnixMilli(createdAt)
+		triggers = append(triggers, trigger)
+	}
+	return triggers, rows.Err()
+}
+
+func (s *Sqlite) GetFlagTrigger(ctx context.Context, id string) (model.FlagTrigger, error) {
+	trigger := model.FlagTrigger{ID: id}
+	var createdAt int64
+	row := s.conn(ctx).QueryRowContext(ctx, "SELECT project_key, flag_key, action, created_at FROM flag_triggers WHERE id = ?", id)
+	if err := row.Scan(&trigger.ProjectKey, &trigger.FlagKey, &trigger.Action, &createdAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.FlagTrigger{}, model.NewErrNotFound("trigger", id)
+		}
+		return model.FlagTrigger{}, err
+	}
+	trigger.CreatedAt = time.UnixMilli(createdAt)
+	return trigger, nil
+}
+
+func (s *Sqlite) InsertFlagTrigger(ctx context.Context, trigger model.FlagTrigger) error {
+	_, err := s.conn(ctx).ExecContext(ctx, `
+		INSERT INTO flag_triggers (id, project_key, flag_key, action, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, trigger.ID, trigger.ProjectKey, trigger.FlagKey, string(trigger.Action), trigger.CreatedAt.UnixMilli())
+	return errors.Wrap(err, "unable to insert flag trigger")
+}
+
+func (s *Sqlite) DeleteFlagTrigger(ctx context.Context, id string) (bool, error) {
+	result, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM flag_triggers WHERE id = ?", id)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// nullMillis stores an optional time as milliseconds since the epoch.
+func nullMillis(t *time.Time) sql.NullInt64 {
+	if t == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: t.UnixMilli(), Valid: true}
+}
+
+func timeFromNullMillis(millis sql.NullInt64) *time.Time {
+	if !millis.Valid {
+		return nil
+	}
+	t := time.UnixMilli(millis.Int64)
+	return &t
+}
+
+func (s *Sqlite) GetAliases(ctx context.Context) ([]model.Alias, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `
+		SELECT alias, project_key
+		FROM aliases
+		ORDER BY alias
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aliases := make([]model.Alias, 0)
+	for rows.Next() {
+		var alias model.Alias
+		if err := rows.Scan(&alias.Alias, &alias.ProjectKey); err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return aliases, nil
+}
+
+func (s *Sqlite) GetAlias(ctx context.Context, alias string) (model.Alias, error) {
+	result := model.Alias{Alias: alias}
+	row := s.conn(ctx).QueryRowContext(ctx, `
+		SELECT project_key
+		FROM aliases
+		WHERE alias = ?
+	`, alias)
+	if err := row.Scan(&result.ProjectKey); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Alias{}, model.NewErrNotFound("alias", alias)
+		}
+		return model.Alias{}, err
+	}
+	return result, nil
+}
+
+func (s *Sqlite) UpsertAlias(ctx context.Context, alias model.Alias) error {
+	_, err := s.conn(ctx).ExecContext(ctx, `
+		INSERT INTO aliases (alias, project_key)
+		VALUES (?, ?)
+			ON CONFLICT(alias) DO UPDATE SET project_key=excluded.project_key
+	`, alias.Alias, alias.ProjectKey)
+	return errors.Wrap(err, "unable to upsert alias")
+}
+
+func (s *Sqlite) DeleteAlias(ctx context.Context, alias string) (bool, error) {
+	result, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM aliases WHERE alias = ?", alias)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *Sqlite) RestoreBackup(ctx context.Context, stream io.Reader) (string, error) {
+	if s.backupManager == nil {
+		return "", ErrBackupsNotSupported
+	}
+	filepath, err := s.backupManager.RestoreToFile(ctx, stream)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to restore backup db")
+	}
+	err = s.database.Close()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to close database before restoring backup")
+	}
+	// the replaced database's write-ahead log would otherwise be applied to the restored one
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(s.dbPath + suffix); err != nil && !os.IsNotExist(err) {
+			return "", errors.Wrap(err, "unable to remove write-ahead log before restoring backup")
+		}
+	}
+	err = os.Rename(filepath, s.dbPath)
+	if err != nil {
+		//panic because this would really leave the app in an invalid state
+		panic(err)
+	}
+	s.database, err = openSqlite(s.dbPath)
+	if err != nil {
+		//panic because this would really leave the app in an invalid state
+		panic(err)
+	}
+
+	err = s.runMigrations(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to run migrations after restoring backup")
+	}
+
+	return filepath, err
+}
+
+func (s *Sqlite) CreateBackup(ctx context.Context) (io.ReadCloser, int64, error) {
+	if s.backupManager == nil {
+		return nil, 0, ErrBackupsNotSupported
+	}
+	backupPath, err := s.backupManager.MakeBackupFile(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "unable to make backup file, %s", backupPath)
+	}
+	fi, err := os.Open(backupPath)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "unable to open backup db at %s", backupPath)
 	}
 	stat, err := fi.Stat()
 	if err != nil {
@@ -411,7 +1398,7 @@ func NewSqlite(ctx context.Context, dbPath string) (*Sqlite, error) {
 	store.dbPath = dbPath
 	store.backupManager = backup.NewManager(dbPath, "main", "ld_cli_*.bak", "ld_cli_restore_*.db")
 	store.backupManager.AddValidationQueries(validationQueries...)
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := openSqlite(dbPath)
 	if err != nil {
 		return &Sqlite{}, err
 	}
@@ -423,6 +1410,181 @@ func NewSqlite(ctx context.Context, dbPath string) (*Sqlite, error) {
 	return store, nil
 }
 
+// NewMemorySqlite returns a store that keeps everything in memory, for dev servers that shouldn't outlive the process
+// running them, like ones embedded in tests. Backups aren't supported.
+func NewMemorySqlite(ctx context.Context) (*Sqlite, error) {
+	db, err := sql.Open("sqlite3", ":memory:?_foreign_keys=on")
+	if err != nil {
+		return &Sqlite{}, err
+	}
+	// each connection would get a database of its own, and the database goes when its connection does
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	store := &Sqlite{database: db}
+	err = store.runMigrations(ctx)
+	if err != nil {
+		return &Sqlite{}, err
+	}
+	return store, nil
+}
+
+// addColumnIfMissing adds a column to a table created by an older version of the schema.
+func addColumnIfMissing(tx *sql.Tx, table, column, definition string) error {
+	var exists bool
+	err := tx.QueryRow(`SELECT COUNT(1) > 0 FROM pragma_table_info(?) WHERE name = ?`, table, column).Scan(&exists)
+	if err != nil {
+		return errors.Wrapf(err, "unable to inspect table %s", table)
+	}
+	if exists {
+		return nil
+	}
+	_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return errors.Wrapf(err, "unable to add column %s to table %s", column, table)
+}
+
+// addProjectForeignKeyIfMissing rebuilds a table created by an older version of the schema, which didn't reference
+// projects, with columns that do so that its rows are deleted along with their project. Rows left behind by projects
+// that were already deleted are dropped.
+func addProjectForeignKeyIfMissing(tx *sql.Tx, table, columns string) error {
+	var exists bool
+	err := tx.QueryRow(`SELECT COUNT(1) > 0 FROM pragma_foreign_key_list(?) WHERE "table" = 'projects'`, table).Scan(&exists)
+	if err != nil {
+		return errors.Wrapf(err, "unable to inspect table %s", table)
+	}
+	if exists {
+		return nil
+	}
+
+	rows, err := tx.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return errors.Wrapf(err, "unable to inspect table %s", table)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		names = append(names, name)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	columnList := strings.Join(names, ", ")
+
+	rebuilt := table + "_with_foreign_key"
+	for _, statement := range []string{
+		"CREATE TABLE " + rebuilt + " " + columns,
+		fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s WHERE project_key IN (SELECT key FROM projects)", rebuilt, columnList, columnList, table),
+		"DROP TABLE " + table,
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", rebuilt, table),
+	} {
+		if _, err := tx.Exec(statement); err != nil {
+			return errors.Wrapf(err, "unable to add foreign key to table %s", table)
+		}
+	}
+	return nil
+}
+
+// The columns of tables that reference projects, shared by creating them and adding the reference to older databases.
+const (
+	overridesColumns = `(
+		project_key text NOT NULL,
+		flag_key text NOT NULL,
+		value text NOT NULL,
+		active boolean NOT NULL default TRUE,
+		version integer NOT NULL default 1,
+		locked boolean NOT NULL default FALSE,
+		rollout text NOT NULL default '',
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE,
+		UNIQUE (project_key, flag_key) ON CONFLICT REPLACE
+	)`
+	scenarioOverridesColumns = `(
+		project_key text NOT NULL,
+		flag_key text NOT NULL,
+		value text NOT NULL,
+		active boolean NOT NULL default TRUE,
+		version integer NOT NULL default 1,
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE,
+		UNIQUE (project_key, flag_key)
+	)`
+	flagStateHistoryColumns = `(
+		id integer PRIMARY KEY AUTOINCREMENT,
+		project_key text NOT NULL,
+		flag_state text NOT NULL,
+		recorded_at integer NOT NULL,
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE
+	)`
+	overrideSchedulesColumns = `(
+		project_key text NOT NULL,
+		flag_key text NOT NULL,
+		value text NOT NULL,
+		activate_at integer,
+		deactivate_at integer,
+		scheduled_by text NOT NULL DEFAULT '',
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE,
+		PRIMARY KEY (project_key, flag_key)
+	)`
+	savedContextsColumns = `(
+		project_key text NOT NULL,
+		name text NOT NULL,
+		context text NOT NULL,
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE,
+		PRIMARY KEY (project_key, name)
+	)`
+	flagTriggersColumns = `(
+		id text PRIMARY KEY,
+		project_key text NOT NULL,
+		flag_key text NOT NULL,
+		action text NOT NULL,
+		created_at integer NOT NULL,
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE
+	)`
+	overrideHistoryColumns = `(
+		id integer PRIMARY KEY AUTOINCREMENT,
+		layer text NOT NULL DEFAULT 'user',
+		project_key text NOT NULL,
+		flag_key text NOT NULL,
+		value text NOT NULL,
+		active boolean NOT NULL,
+		version integer NOT NULL,
+		actor text NOT NULL DEFAULT '',
+		locked boolean,
+		recorded_at integer NOT NULL,
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE
+	)`
+)
+
+const (
+	// sqliteBusyTimeout is how long a connection waits for another to release its lock on the database before failing
+	// with SQLITE_BUSY.
+	sqliteBusyTimeout = 5 * time.Second
+	// sqliteMaxOpenConns bounds the connection pool. SQLite only has one writer at a time, so more connections would
+	// just wait on each other.
+	sqliteMaxOpenConns = 8
+)
+
+// sqliteDSN is the data source name for the database at dbPath. Foreign keys are enforced on every connection so that
+// deleting a project cascades to everything that references it. The UI, SDK streams, and syncs all use the database at
+// once, so it's in WAL mode, where readers and the writer don't block each other, and transactions take the write lock
+// when they begin, where waiting for it is covered by the busy timeout, rather than when they first write.
+func sqliteDSN(dbPath string) string {
+	return fmt.Sprintf("%s?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=%d&_txlock=immediate", dbPath, sqliteBusyTimeout.Milliseconds())
+}
+
+func openSqlite(dbPath string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", sqliteDSN(dbPath))
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(sqliteMaxOpenConns)
+	db.SetMaxIdleConns(sqliteMaxOpenConns)
+	return db, nil
+}
+
 var validationQueries = []string{
 	"SELECT COUNT(1) from projects",
 	"SELECT COUNT(1) from overrides",
@@ -445,21 +1607,91 @@ func (s *Sqlite) runMigrations(ctx context.Context) error {
 		source_environment_key text NOT NULL,
 		context text NOT NULL,
 		last_sync_time timestamp NOT NULL,
-		flag_state TEXT NOT NULL
+		flag_state TEXT NOT NULL,
+		orphaned_detail text NOT NULL DEFAULT '',
+		orphaned_at timestamp,
+		environment_keys text NOT NULL DEFAULT '{}',
+		archived_at timestamp,
+		sync_attempted_at timestamp,
+		sync_duration_ms integer NOT NULL DEFAULT 0,
+		sync_error text NOT NULL DEFAULT '',
+		flag_filter text NOT NULL DEFAULT '{}',
+		base_project_key text NOT NULL DEFAULT '',
+		flag_metadata text NOT NULL DEFAULT '{}',
+		context_kinds text NOT NULL DEFAULT '[]'
 	)`)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.Exec(`
-	CREATE TABLE IF NOT EXISTS overrides (
-		project_key text NOT NULL,
-		flag_key text NOT NULL,
-		value text NOT NULL,
-		active boolean NOT NULL default TRUE,
-		version integer NOT NULL default 1,
-		UNIQUE (project_key, flag_key) ON CONFLICT REPLACE
-	)`)
+	// databases from before orphaned projects were tracked
+	err = addColumnIfMissing(tx, "projects", "orphaned_detail", "text NOT NULL DEFAULT ''")
+	if err != nil {
+		return err
+	}
+	err = addColumnIfMissing(tx, "projects", "orphaned_at", "timestamp")
+	if err != nil {
+		return err
+	}
+	// databases from before environment keys were prefetched
+	err = addColumnIfMissing(tx, "projects", "environment_keys", "text NOT NULL DEFAULT '{}'")
+	if err != nil {
+		return err
+	}
+	// databases from before projects could be archived
+	err = addColumnIfMissing(tx, "projects", "archived_at", "timestamp")
+	if err != nil {
+		return err
+	}
+	// databases from before sync attempts were tracked
+	err = addColumnIfMissing(tx, "projects", "sync_attempted_at", "timestamp")
+	if err != nil {
+		return err
+	}
+	err = addColumnIfMissing(tx, "projects", "sync_duration_ms", "integer NOT NULL DEFAULT 0")
+	if err != nil {
+		return err
+	}
+	err = addColumnIfMissing(tx, "projects", "sync_error", "text NOT NULL DEFAULT ''")
+	if err != nil {
+		return err
+	}
+	// databases from before projects could filter their flags
+	err = addColumnIfMissing(tx, "projects", "flag_filter", "text NOT NULL DEFAULT '{}'")
+	if err != nil {
+		return err
+	}
+	// databases from before projects could be linked clones
+	err = addColumnIfMissing(tx, "projects", "base_project_key", "text NOT NULL DEFAULT ''")
+	if err != nil {
+		return err
+	}
+	// databases from before flags' metadata was synced
+	err = addColumnIfMissing(tx, "projects", "flag_metadata", "text NOT NULL DEFAULT '{}'")
+	if err != nil {
+		return err
+	}
+	// databases from before context kinds were synced
+	err = addColumnIfMissing(tx, "projects", "context_kinds", "text NOT NULL DEFAULT '[]'")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS overrides " + overridesColumns)
+	if err != nil {
+		return err
+	}
+	err = addProjectForeignKeyIfMissing(tx, "overrides", overridesColumns)
+	if err != nil {
+		return err
+	}
+	// databases from before overrides could be locked
+	err = addColumnIfMissing(tx, "overrides", "locked", "boolean NOT NULL DEFAULT FALSE")
+	if err != nil {
+		return err
+	}
+	// databases from before overrides could be percentage rollouts
+	err = addColumnIfMissing(tx, "overrides", "rollout", "text NOT NULL DEFAULT ''")
 	if err != nil {
 		return err
 	}
@@ -479,5 +1711,90 @@ func (s *Sqlite) runMigrations(ctx context.Context) error {
 		return err
 	}
 
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS scenario_overrides " + scenarioOverridesColumns)
+	if err != nil {
+		return err
+	}
+	err = addProjectForeignKeyIfMissing(tx, "scenario_overrides", scenarioOverridesColumns)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+	CREATE TABLE IF NOT EXISTS aliases (
+		alias text PRIMARY KEY,
+		project_key text NOT NULL,
+		FOREIGN KEY (project_key) REFERENCES projects (key) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS flag_state_history " + flagStateHistoryColumns)
+	if err != nil {
+		return err
+	}
+	err = addProjectForeignKeyIfMissing(tx, "flag_state_history", flagStateHistoryColumns)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+	CREATE INDEX IF NOT EXISTS flag_state_history_project_key_recorded_at
+	ON flag_state_history (project_key, recorded_at)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS override_history " + overrideHistoryColumns)
+	if err != nil {
+		return err
+	}
+
+	// databases from before actors were recorded have history without them
+	err = addColumnIfMissing(tx, "override_history", "actor", "text NOT NULL DEFAULT ''")
+	if err != nil {
+		return err
+	}
+	// and before locking and unlocking were recorded
+	err = addColumnIfMissing(tx, "override_history", "locked", "boolean")
+	if err != nil {
+		return err
+	}
+	err = addProjectForeignKeyIfMissing(tx, "override_history", overrideHistoryColumns)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS override_schedules " + overrideSchedulesColumns)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS saved_contexts " + savedContextsColumns)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS flag_triggers " + flagTriggersColumns)
+	if err != nil {
+		return err
+	}
+
+	// these always referenced projects, but foreign keys weren't enforced, so rows were left behind by deleted projects
+	for _, table := range []string{"available_variations", "aliases"} {
+		_, err = tx.Exec("DELETE FROM " + table + " WHERE project_key NOT IN (SELECT key FROM projects)")
+		if err != nil {
+			return errors.Wrapf(err, "unable to clean up table %s", table)
+		}
+	}
+
+	_, err = tx.Exec(`
+	CREATE INDEX IF NOT EXISTS override_history_layer_project_key_flag_key_recorded_at
+	ON override_history (layer, project_key, flag_key, recorded_at)`)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
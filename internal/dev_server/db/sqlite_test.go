@@ -2,400 +2,254 @@ package db_test
 
 import (
 	"context"
-	"os"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
-	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/devserver/store/storetest"
 	"github.com/launchdarkly/ldcli/internal/dev_server/db"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
 func TestDBFunctions(t *testing.T) {
 	ctx := context.Background()
-	dbName := "test.db"
-
-	store, err := db.NewSqlite(ctx, dbName)
+	// the store's write-ahead log is left beside the database, so it goes in a directory that's cleaned up
+	store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "test.db"))
 	require.NoError(t, err)
 
-	defer func() {
-		require.NoError(t, os.Remove(dbName))
-	}()
+	storetest.Run(t, store)
+}
 
-	ldContext := ldcontext.New(t.Name())
-	now := time.Now()
+func TestMemorySqliteFunctions(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
 
-	projects := []model.Project{
-		{
-			Key:                  "main-test-proj",
-			SourceEnvironmentKey: "env-1",
-			Context:              ldContext,
-			LastSyncTime:         now,
-			AllFlagsState: model.FlagsState{
-				"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 2},
-				"flag-2": model.FlagState{Value: ldvalue.String("cool"), Version: 2},
-			},
-			AvailableVariations: []model.FlagVariation{
-				{
-					FlagKey: "flag-1",
-					Variation: model.Variation{
-						Id:    "1",
-						Value: ldvalue.Bool(true),
-					},
-				},
-				{
-					FlagKey: "flag-1",
-					Variation: model.Variation{
-						Id:    "2",
-						Value: ldvalue.Bool(false),
-					},
-				},
-				{
-					FlagKey: "flag-2",
-					Variation: model.Variation{
-						Id:          "3",
-						Description: lo.ToPtr("cool description"),
-						Name:        lo.ToPtr("cool name"),
-						Value:       ldvalue.String("Cool"),
-					},
-				},
-			},
-		},
-		{
-			Key:                  "proj-to-delete",
-			SourceEnvironmentKey: "env-2",
-			Context:              ldContext,
-			LastSyncTime:         now,
-			AllFlagsState: model.FlagsState{
-				"flag-1": model.FlagState{Value: ldvalue.Int(123), Version: 2},
-				"flag-2": model.FlagState{Value: ldvalue.Float64(99.99), Version: 2},
-			},
-			AvailableVariations: []model.FlagVariation{
-				{
-					FlagKey: "flag-1",
-					Variation: model.Variation{
-						Id:    "1",
-						Value: ldvalue.Int(123),
-					},
-				},
-				{
-					FlagKey: "flag-2",
-					Variation: model.Variation{
-						Id:    "2",
-						Value: ldvalue.Float64(99.99),
-					},
-				},
-			},
-		},
-		{
-			Key:                  "proj-to-test-override-deletion-on-update",
-			SourceEnvironmentKey: "env-3",
-			Context:              ldContext,
-			LastSyncTime:         now,
-			AllFlagsState: model.FlagsState{
-				"flag-1": model.FlagState{Value: ldvalue.Int(123), Version: 2},
-				"flag-2": model.FlagState{Value: ldvalue.Float64(99.99), Version: 2},
-			},
-			AvailableVariations: []model.FlagVariation{
-				{
-					FlagKey: "flag-1",
-					Variation: model.Variation{
-						Id:    "1",
-						Value: ldvalue.Bool(true),
-					},
-				},
-				{
-					FlagKey: "flag-1",
-					Variation: model.Variation{
-						Id:    "2",
-						Value: ldvalue.Bool(false),
-					},
-				},
-				{
-					FlagKey: "flag-2",
-					Variation: model.Variation{
-						Id:          "3",
-						Description: lo.ToPtr("cool description"),
-						Name:        lo.ToPtr("cool name"),
-						Value:       ldvalue.String("Cool"),
-					},
-				},
-			},
-		},
-	}
-	actualProjectKeys := make(map[string]bool, len(projects))
+	storetest.Run(t, store)
 
-	for _, proj := range projects {
-		err := store.InsertProject(ctx, proj)
-		require.NoError(t, err)
-		actualProjectKeys[proj.Key] = true
-	}
-
-	t.Run("InsertProject returns ErrAlreadyExists if the project already exists", func(t *testing.T) {
-		err := store.InsertProject(ctx, projects[0])
-		assert.ErrorAs(t, err, &model.ErrAlreadyExists{})
+	t.Run("backups are not supported", func(t *testing.T) {
+		_, _, err := store.CreateBackup(ctx)
+		assert.ErrorIs(t, err, db.ErrBackupsNotSupported)
 	})
+}
 
-	t.Run("GetDevProjectKeys returns keys in projects", func(t *testing.T) {
-		keys, err := store.GetDevProjectKeys(ctx)
-		assert.NoError(t, err)
-		assert.Len(t, keys, len(projects))
-
-		for _, key := range keys {
-			_, ok := actualProjectKeys[key]
-			assert.True(t, ok)
-		}
-	})
+func TestCachingStoreFunctions(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
 
-	t.Run("GetDevProject returns ErrNotFound for fake project keys", func(t *testing.T) {
-		p, err := store.GetDevProject(ctx, "THIS-DOES-NOT-EXIST")
-		assert.Nil(t, p)
-		assert.ErrorAs(t, err, &model.ErrNotFound{})
-	})
+	storetest.Run(t, model.NewCachingStore(store))
+}
 
-	t.Run("GetDevProject returns project", func(t *testing.T) {
-		expected := projects[0]
-		p, err := store.GetDevProject(ctx, expected.Key)
+func TestSqliteWithTx(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	project := model.Project{
+		Key:                  "proj",
+		SourceEnvironmentKey: "env",
+		Context:              ldcontext.New("user"),
+		LastSyncTime:         time.Now(),
+		AllFlagsState:        model.FlagsState{"flag-1": model.FlagState{Value: ldvalue.Bool(true), Version: 1}},
+		AvailableVariations:  []model.FlagVariation{{FlagKey: "flag-1", Variation: model.Variation{Id: "1", Value: ldvalue.Bool(true)}}},
+	}
+	override := model.Override{ProjectKey: project.Key, FlagKey: "flag-1", Value: ldvalue.Bool(false), Active: true, Version: 1}
+
+	t.Run("rolls back everything if fn fails", func(t *testing.T) {
+		err := store.WithTx(ctx, func(ctx context.Context) error {
+			require.NoError(t, store.InsertProject(ctx, project))
+			_, err := store.UpsertOverride(ctx, override)
+			require.NoError(t, err)
+			return errors.New("failed")
+		})
+		assert.EqualError(t, err, "failed")
 
-		assert.NoError(t, err)
-		assert.NotNil(t, p)
-		assert.Equal(t, expected.Key, p.Key)
-		assert.Equal(t, expected.AllFlagsState, p.AllFlagsState)
-		assert.Equal(t, expected.SourceEnvironmentKey, p.SourceEnvironmentKey)
-		assert.Equal(t, expected.Context, p.Context)
-		assert.True(t, expected.LastSyncTime.Equal(p.LastSyncTime))
+		_, err = store.GetDevProject(ctx, project.Key)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
 	})
 
-	t.Run("GetAvailableVariations returns variations", func(t *testing.T) {
-		availableVariations, err := store.GetAvailableVariationsForProject(ctx, projects[0].Key)
-		require.NoError(t, err)
-		require.Len(t, availableVariations, 2)
-		flag1Variations := availableVariations["flag-1"]
-		assert.Len(t, flag1Variations, 2)
-		flag2Variations := availableVariations["flag-2"]
-		assert.Len(t, flag2Variations, 1)
-
-		expectedFlagVariations := projects[0].AvailableVariations
-		assert.Equal(t, expectedFlagVariations[2].Id, flag2Variations[0].Id)
-		assert.Equal(t, *expectedFlagVariations[2].Name, *flag2Variations[0].Name)
-		assert.Equal(t, *expectedFlagVariations[2].Description, *flag2Variations[0].Description)
-		assert.Equal(t, expectedFlagVariations[2].Value.String(), flag2Variations[0].Value.String())
-		assert.Equal(t, ldvalue.StringType, flag2Variations[0].Value.Type())
-		for _, variation := range flag1Variations {
-			if variation.Value.BoolValue() {
-				assert.Equal(t, expectedFlagVariations[0].Variation, variation)
-			} else {
-				assert.Equal(t, expectedFlagVariations[1].Variation, variation)
+	t.Run("commits if fn succeeds, keeping what failed calls in it rolled back", func(t *testing.T) {
+		err := store.WithTx(ctx, func(ctx context.Context) error {
+			if err := store.InsertProject(ctx, project); err != nil {
+				return err
 			}
-		}
-	})
-
-	t.Run("UpdateProject updates flag state, sync time, context and source environment key", func(t *testing.T) {
-		project := projects[0]
-		project.Context = ldcontext.New(t.Name() + "blah")
-		project.AllFlagsState = model.FlagsState{
-			"flag-1": model.FlagState{Value: ldvalue.Bool(false), Version: 3},
-			"flag-2": model.FlagState{Value: ldvalue.String("cool beeans"), Version: 3},
-		}
-		project.LastSyncTime = time.Now().Add(time.Hour)
-		project.SourceEnvironmentKey = "new-env"
-		project.AvailableVariations = []model.FlagVariation{
-			{
-				FlagKey: "flag-1",
-				Variation: model.Variation{
-					Id:    "1",
-					Value: ldvalue.Bool(true),
-				},
-			},
-			{
-				FlagKey: "flag-1",
-				Variation: model.Variation{
-					Id:    "2",
-					Value: ldvalue.Bool(false),
-				},
-			},
-			{
-				FlagKey: "flag-2",
-				Variation: model.Variation{
-					Id:          "3",
-					Description: lo.ToPtr("cool description"),
-					Name:        lo.ToPtr("cool name"),
-					Value:       ldvalue.String("cool beans"),
-				},
-			},
-		}
-
-		updated, err := store.UpdateProject(ctx, project)
-		assert.NoError(t, err)
-		assert.True(t, updated)
-
-		newProj, err := store.GetDevProject(ctx, project.Key)
-		assert.NoError(t, err)
-		assert.NotNil(t, newProj)
-		assert.Equal(t, project.Key, newProj.Key)
-		assert.Equal(t, project.AllFlagsState, newProj.AllFlagsState)
-		assert.Equal(t, project.SourceEnvironmentKey, newProj.SourceEnvironmentKey)
-		assert.Equal(t, project.Context, newProj.Context)
-		assert.True(t, project.LastSyncTime.Equal(newProj.LastSyncTime))
-
-		availableVariations, err := store.GetAvailableVariationsForProject(ctx, projects[0].Key)
+			// fails, but only undoes its own writes
+			err := store.InsertProject(ctx, project)
+			assert.ErrorAs(t, err, &model.ErrAlreadyExists{})
+			_, err = store.UpsertOverride(ctx, override)
+			return err
+		})
 		require.NoError(t, err)
-		require.Len(t, availableVariations, 2)
-		flag1Variations := availableVariations["flag-1"]
-		assert.Len(t, flag1Variations, 2)
-		flag2Variations := availableVariations["flag-2"]
-		assert.Len(t, flag2Variations, 1)
-
-		expectedFlagVariation := project.AvailableVariations[2]
-		assert.Equal(t, expectedFlagVariation.Id, flag2Variations[0].Id)
-		assert.Equal(t, *expectedFlagVariation.Name, *flag2Variations[0].Name)
-		assert.Equal(t, *expectedFlagVariation.Description, *flag2Variations[0].Description)
-		assert.Equal(t, expectedFlagVariation.Value.String(), flag2Variations[0].Value.String())
-		assert.Equal(t, ldvalue.StringType, flag2Variations[0].Value.Type())
-	})
-
-	t.Run("UpdateProject returns false if project does not exist", func(t *testing.T) {
-		updated, err := store.UpdateProject(ctx, model.Project{Key: "nope"})
-		assert.NoError(t, err)
-		assert.False(t, updated)
-	})
 
-	t.Run("DeleteProject returns false if project does not exist", func(t *testing.T) {
-		deleted, err := store.DeleteDevProject(ctx, "nope")
-		assert.NoError(t, err)
-		assert.False(t, deleted)
-	})
-
-	t.Run("DeleteProject succeeds if project exists", func(t *testing.T) {
-		deleted, err := store.DeleteDevProject(ctx, projects[1].Key)
-		assert.NoError(t, err)
-		assert.True(t, deleted)
+		overrides, err := store.GetOverridesForProject(ctx, project.Key)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, ldvalue.Bool(false), overrides[0].Value)
 	})
+}
 
-	flagKeys := []string{"flag-1", "flag-2"}
-
-	overrides := map[string]model.Override{
-		flagKeys[0]: {
-			ProjectKey: projects[0].Key,
-			FlagKey:    flagKeys[0],
-			Value:      ldvalue.Bool(true),
-			Active:     true,
-			Version:    1,
-		},
-		flagKeys[1]: {
-			ProjectKey: projects[0].Key,
-			FlagKey:    flagKeys[1],
-			Value:      ldvalue.Int(100),
-			Active:     true,
-			Version:    1,
-		},
+func TestSqliteConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := db.NewSqlite(ctx, dbPath)
+	require.NoError(t, err)
+	// a second store stands in for the UI, SDK streams and syncs each holding their own connections
+	other, err := db.NewSqlite(ctx, dbPath)
+	require.NoError(t, err)
+	project := model.Project{
+		Key:           "proj",
+		Context:       ldcontext.New("user"),
+		LastSyncTime:  time.Now(),
+		AllFlagsState: model.FlagsState{},
 	}
-
-	// test inserts
-	for _, o := range overrides {
-		_, err := store.UpsertOverride(ctx, o)
-		require.NoError(t, err)
+	for i := range 10 {
+		flagKey := fmt.Sprintf("flag-%d", i)
+		project.AllFlagsState[flagKey] = model.FlagState{Value: ldvalue.Bool(false), Version: 1}
+		project.AvailableVariations = append(project.AvailableVariations, model.FlagVariation{FlagKey: flagKey, Variation: model.Variation{Id: "1", Value: ldvalue.Bool(true)}})
 	}
-
-	overridesResult, err := store.GetOverridesForProject(ctx, projects[0].Key)
-	require.NoError(t, err)
-	require.Len(t, overridesResult, 2)
-
-	for _, r := range overridesResult {
-		originalOverride, ok := overrides[r.FlagKey]
-		require.True(t, ok)
-		require.Equal(t, originalOverride, r)
+	require.NoError(t, store.InsertProject(ctx, project))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i := range 20 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := store.UpsertOverride(ctx, model.Override{ProjectKey: project.Key, FlagKey: fmt.Sprintf("flag-%d", i%10), Value: ldvalue.Bool(true), Active: true, Version: i})
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			errs <- other.WithTx(ctx, func(ctx context.Context) error {
+				_, err := other.UpdateProject(ctx, project)
+				return err
+			})
+		}()
 	}
-
-	t.Run("UpsertOverride updates when override exists", func(t *testing.T) {
-		updated := overrides[flagKeys[1]]
-		updated.Value = ldvalue.Int(101)
-
-		_, err := store.UpsertOverride(ctx, updated)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
 		assert.NoError(t, err)
+	}
+}
 
-		overridesResult, err := store.GetOverridesForProject(ctx, projects[0].Key)
-		assert.NoError(t, err)
-		assert.Len(t, overridesResult, 2)
-
-		found := false // prevent test from erroneously succeeding because override not in array
-		for _, r := range overridesResult {
-			if r.FlagKey != flagKeys[1] {
-				continue
-			}
-
-			found = true
-			assert.Equal(t, updated.Value, r.Value)
-		}
+func TestSqliteRecordsActors(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
 
-		assert.True(t, found)
-	})
+	// history written before actors were recorded
+	legacy, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`CREATE TABLE override_history (
+		id integer PRIMARY KEY AUTOINCREMENT,
+		layer text NOT NULL DEFAULT 'user',
+		project_key text NOT NULL,
+		flag_key text NOT NULL,
+		value text NOT NULL,
+		active boolean NOT NULL,
+		version integer NOT NULL,
+		recorded_at integer NOT NULL
+	)`)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`CREATE TABLE projects (
+		key text PRIMARY KEY,
+		source_environment_key text NOT NULL,
+		context text NOT NULL,
+		last_sync_time timestamp NOT NULL,
+		flag_state TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO projects VALUES ('proj', 'env', '{"kind":"user","key":"user"}', '2024-01-02 03:04:05', '{}')`)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO override_history (project_key, flag_key, value, active, version, recorded_at)
+		VALUES ('proj', 'flag-1', 'true', true, 1, 1)`)
+	require.NoError(t, err)
+	require.NoError(t, legacy.Close())
 
-	t.Run("DeactivateOverride returns error when override not found", func(t *testing.T) {
-		_, err := store.DeactivateOverride(ctx, projects[0].Key, "nope")
-		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	store, err := db.NewSqlite(ctx, dbPath)
+	require.NoError(t, err)
+	_, err = store.UpsertOverride(model.ContextWithActor(ctx, "alice"), model.Override{
+		ProjectKey: "proj",
+		FlagKey:    "flag-1",
+		Value:      ldvalue.Bool(true),
+		Active:     true,
+		Version:    1,
 	})
+	require.NoError(t, err)
 
-	t.Run("DeactivateOverride sets the override inactive and returns the current version", func(t *testing.T) {
-		toDelete := overrides[flagKeys[0]]
-		version, err := store.DeactivateOverride(ctx, toDelete.ProjectKey, toDelete.FlagKey)
-		assert.NoError(t, err)
-
-		result, err := store.GetOverridesForProject(ctx, toDelete.ProjectKey)
-		assert.NoError(t, err)
-		assert.Len(t, result, 2)
-
-		found := false // prevent test from erroneously succeeding because override not in array
-		for _, r := range result {
-			if r.FlagKey != toDelete.FlagKey {
-				continue
-			}
-
-			found = true
-			assert.False(t, r.Active)
-			assert.Equal(t, version, r.Version)
-		}
-
-		assert.True(t, found)
-	})
+	check, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	defer check.Close()
+	rows, err := check.Query(`SELECT actor FROM override_history ORDER BY id`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var actors []string
+	for rows.Next() {
+		var actor string
+		require.NoError(t, rows.Scan(&actor))
+		actors = append(actors, actor)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"", "alice"}, actors)
+}
 
-	t.Run("UpdateProject deletes overrides for flags that are no longer in the project", func(t *testing.T) {
-		project := projects[2]
+func TestSqliteAddsProjectForeignKeys(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
 
-		// create a new override for flag-1
-		override, err := store.UpsertOverride(ctx, model.Override{
-			ProjectKey: project.Key,
-			FlagKey:    "flag-1",
-			Value:      ldvalue.Bool(false),
-			Active:     true,
-			Version:    1,
-		})
+	// overrides written before they referenced projects, including some left behind by a deleted project
+	legacy, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	for _, statement := range []string{
+		`CREATE TABLE projects (
+			key text PRIMARY KEY,
+			source_environment_key text NOT NULL,
+			context text NOT NULL,
+			last_sync_time timestamp NOT NULL,
+			flag_state TEXT NOT NULL
+		)`,
+		`CREATE TABLE overrides (
+			project_key text NOT NULL,
+			flag_key text NOT NULL,
+			value text NOT NULL,
+			active boolean NOT NULL default TRUE,
+			version integer NOT NULL default 1,
+			UNIQUE (project_key, flag_key) ON CONFLICT REPLACE
+		)`,
+		`INSERT INTO projects VALUES ('proj', 'env', '{"kind":"user","key":"user"}', '2024-01-02 03:04:05', '{}')`,
+		`INSERT INTO overrides VALUES ('proj', 'flag-1', 'true', true, 2)`,
+		`INSERT INTO overrides VALUES ('deleted-proj', 'flag-1', 'true', true, 1)`,
+	} {
+		_, err = legacy.Exec(statement)
 		require.NoError(t, err)
+	}
+	require.NoError(t, legacy.Close())
 
-		// verify the override was created
-		overrides, err := store.GetOverridesForProject(ctx, project.Key)
-		require.NoError(t, err)
-		require.Len(t, overrides, 1)
-		assert.Equal(t, override, overrides[0])
+	store, err := db.NewSqlite(ctx, dbPath)
+	require.NoError(t, err)
 
-		// update the project to remove flag-1
-		project.AvailableVariations = []model.FlagVariation{
-			{
-				FlagKey: "flag-2",
-			},
-		}
-		updated, err := store.UpdateProject(ctx, project)
-		assert.NoError(t, err)
-		assert.True(t, updated)
+	overrides, err := store.GetOverridesForProject(ctx, "proj")
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, 2, overrides[0].Version)
+	overrides, err = store.GetOverridesForProject(ctx, "deleted-proj")
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
 
-		// verify the override for flag-1 was deleted
-		overrides, err = store.GetOverridesForProject(ctx, project.Key)
-		require.NoError(t, err)
-		require.Len(t, overrides, 0)
-	})
+	_, err = store.UpsertOverride(ctx, model.Override{ProjectKey: "deleted-proj", FlagKey: "flag-1", Value: ldvalue.Bool(true), Active: true})
+	assert.ErrorContains(t, err, "FOREIGN KEY constraint failed")
+
+	deletion, deleted, err := store.DeleteDevProject(ctx, "proj")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, 1, deletion.Overrides)
 }
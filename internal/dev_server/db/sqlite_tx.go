@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+type ctxKey string
+
+const ctxKeySqliteTx = ctxKey("db.SqliteTx")
+
+// sqlConn is what the store's queries run on: the database, or the transaction started by WithTx.
+type sqlConn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// sqliteTx is a transaction, or a savepoint within the transaction started by WithTx, so that store methods that need
+// their own writes to be atomic work the same inside WithTx.
+type sqliteTx struct {
+	*sql.Tx
+	ctx context.Context
+	// savepoint is empty for a transaction of its own
+	savepoint string
+}
+
+var savepointCounter atomic.Uint64
+
+func (s *Sqlite) WithTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, err := s.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if err = fn(context.WithValue(ctx, ctxKeySqliteTx, tx.Tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// conn returns the transaction started by WithTx, if ctx is in one, and otherwise the database.
+func (s *Sqlite) conn(ctx context.Context) sqlConn {
+	if tx, ok := ctx.Value(ctxKeySqliteTx).(*sql.Tx); ok {
+		return tx
+	}
+	return retryingDB{s.database}
+}
+
+// beginTx starts a transaction, or a savepoint if ctx is already in one started by WithTx.
+func (s *Sqlite) beginTx(ctx context.Context) (sqliteTx, error) {
+	if tx, ok := ctx.Value(ctxKeySqliteTx).(*sql.Tx); ok {
+		savepoint := fmt.Sprintf("store_%d", savepointCounter.Add(1))
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+			return sqliteTx{}, errors.Wrap(err, "unable to create savepoint")
+		}
+		return sqliteTx{Tx: tx, ctx: ctx, savepoint: savepoint}, nil
+	}
+	var tx *sql.Tx
+	err := retryOnBusy(ctx, func() (err error) {
+		tx, err = s.database.BeginTx(ctx, nil)
+		return err
+	})
+	if err != nil {
+		return sqliteTx{}, err
+	}
+	return sqliteTx{Tx: tx, ctx: ctx}, nil
+}
+
+func (tx sqliteTx) Commit() error {
+	if tx.savepoint == "" {
+		return tx.Tx.Commit()
+	}
+	_, err := tx.ExecContext(tx.ctx, "RELEASE "+tx.savepoint)
+	return err
+}
+
+func (tx sqliteTx) Rollback() error {
+	if tx.savepoint == "" {
+		return tx.Tx.Rollback()
+	}
+	// rolling back to a savepoint leaves it open, so it has to be released too
+	_, err := tx.ExecContext(tx.ctx, fmt.Sprintf("ROLLBACK TO %s; RELEASE %s", tx.savepoint, tx.savepoint))
+	return err
+}
+
+// retryingDB retries statements that fail because other connections kept the database locked for longer than the busy
+// timeout. Statements in transactions aren't retried on their own, but the transactions wait for the lock when they
+// begin.
+type retryingDB struct {
+	*sql.DB
+}
+
+func (db retryingDB) ExecContext(ctx context.Context, query string, args ...any) (result sql.Result, err error) {
+	err = retryOnBusy(ctx, func() error {
+		result, err = db.DB.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+const sqliteBusyRetries = 3
+
+func retryOnBusy(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		var sqliteErr sqlite3.Error
+		if attempt > sqliteBusyRetries || !errors.As(err, &sqliteErr) || sqliteErr.Code != sqlite3.ErrBusy {
+			return err
+		}
+		logs.Printf(logs.Warn, "", "Database is busy, retrying (attempt %d of %d)", attempt, sqliteBusyRetries)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * 100 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// Schema versions of dev server databases written by older versions of ldcli. Each version is a superset of the last.
+const (
+	// SchemaVersionOverridesUnversioned has projects and overrides, but overrides have no active or version columns.
+	SchemaVersionOverridesUnversioned = 1
+	// SchemaVersionOverridesVersioned adds the active and version columns to overrides.
+	SchemaVersionOverridesVersioned = 2
+	// SchemaVersionAvailableVariations adds the available_variations table.
+	SchemaVersionAvailableVariations = 3
+	// SchemaVersionCurrent adds aliases, scenario overrides, and history.
+	SchemaVersionCurrent = 4
+)
+
+// UpgradeReport describes what Upgrade migrated.
+type UpgradeReport struct {
+	SchemaVersion int
+	Migrated      []MigratedProject
+	// Skipped are the keys of projects that already exist in the target database and were left alone.
+	Skipped []string
+	Aliases int
+}
+
+// MigratedProject is a project copied by Upgrade, with counts of what was copied for it.
+type MigratedProject struct {
+	Key                 string
+	Flags               int
+	Overrides           int
+	AvailableVariations int
+}
+
+// Upgrade copies the projects, overrides, available variations, and aliases from the dev server database at fromPath
+// into s. The database at fromPath may have been written by any earlier version of ldcli and is only read from.
+// Projects that already exist in s are skipped. Each migrated project is read back afterward to verify that nothing
+// was lost.
+func (s *Sqlite) Upgrade(ctx context.Context, fromPath string) (UpgradeReport, error) {
+	if err := checkNotSameFile(fromPath, s.dbPath); err != nil {
+		return UpgradeReport{}, err
+	}
+	if _, err := os.Stat(fromPath); err != nil {
+		return UpgradeReport{}, errors.Wrapf(err, "unable to open legacy database %s", fromPath)
+	}
+	legacyDB, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro", fromPath))
+	if err != nil {
+		return UpgradeReport{}, errors.Wrapf(err, "unable to open legacy database %s", fromPath)
+	}
+	defer legacyDB.Close()
+	legacy := &Sqlite{database: legacyDB, dbPath: fromPath}
+
+	version, err := legacy.detectSchemaVersion(ctx)
+	if err != nil {
+		return UpgradeReport{}, err
+	}
+	report := UpgradeReport{SchemaVersion: version}
+
+	projectKeys, err := legacy.GetDevProjectKeys(ctx)
+	if err != nil {
+		return report, errors.Wrap(err, "unable to read legacy projects")
+	}
+	for _, projectKey := range projectKeys {
+		project, err := legacy.getLegacyProject(ctx, version, projectKey)
+		if err != nil {
+			return report, errors.Wrapf(err, "unable to read legacy project %s", projectKey)
+		}
+		overrides, err := legacy.getLegacyOverrides(ctx, version, projectKey)
+		if err != nil {
+			return report, errors.Wrapf(err, "unable to read legacy overrides for project %s", projectKey)
+		}
+
+		err = s.InsertProject(ctx, project)
+		if errors.As(err, &model.ErrAlreadyExists{}) {
+			report.Skipped = append(report.Skipped, projectKey)
+			continue
+		}
+		if err != nil {
+			return report, errors.Wrapf(err, "unable to migrate project %s", projectKey)
+		}
+		if err := s.insertMigratedOverrides(ctx, overrides); err != nil {
+			return report, errors.Wrapf(err, "unable to migrate overrides for project %s", projectKey)
+		}
+		if err := s.verifyMigratedProject(ctx, project, overrides); err != nil {
+			return report, errors.Wrapf(err, "integrity check failed for project %s", projectKey)
+		}
+		report.Migrated = append(report.Migrated, MigratedProject{
+			Key:                 projectKey,
+			Flags:               len(project.AllFlagsState),
+			Overrides:           len(overrides),
+			AvailableVariations: len(project.AvailableVariations),
+		})
+	}
+
+	if version >= SchemaVersionCurrent {
+		aliases, err := legacy.GetAliases(ctx)
+		if err != nil {
+			return report, errors.Wrap(err, "unable to read legacy aliases")
+		}
+		for _, alias := range aliases {
+			if _, err := s.GetAlias(ctx, alias.Alias); err == nil {
+				continue
+			}
+			if err := s.UpsertAlias(ctx, alias); err != nil {
+				return report, errors.Wrapf(err, "unable to migrate alias %s", alias.Alias)
+			}
+			report.Aliases++
+		}
+	}
+
+	return report, nil
+}
+
+func checkNotSameFile(fromPath, toPath string) error {
+	fromAbs, err := filepath.Abs(fromPath)
+	if err != nil {
+		return err
+	}
+	toAbs, err := filepath.Abs(toPath)
+	if err != nil {
+		return err
+	}
+	if fromAbs == toAbs {
+		return errors.Errorf("%s is the current database; nothing to upgrade", fromPath)
+	}
+	return nil
+}
+
+func (s *Sqlite) detectSchemaVersion(ctx context.Context) (int, error) {
+	tables, err := s.tableNames(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !tables["projects"] || !tables["overrides"] {
+		return 0, errors.Errorf("%s is not a dev server database", s.dbPath)
+	}
+	if tables["aliases"] {
+		return SchemaVersionCurrent, nil
+	}
+	if tables["available_variations"] {
+		return SchemaVersionAvailableVariations, nil
+	}
+	columns, err := s.columnNames(ctx, "overrides")
+	if err != nil {
+		return 0, err
+	}
+	if columns["active"] && columns["version"] {
+		return SchemaVersionOverridesVersioned, nil
+	}
+	return SchemaVersionOverridesUnversioned, nil
+}
+
+func (s *Sqlite) tableNames(ctx context.Context) (map[string]bool, error) {
+	rows, err := s.database.QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table'")
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read schema of %s", s.dbPath)
+	}
+	defer rows.Close()
+	tables := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables[name] = true
+	}
+	return tables, rows.Err()
+}
+
+func (s *Sqlite) columnNames(ctx context.Context, table string) (map[string]bool, error) {
+	rows, err := s.database.QueryContext(ctx, "SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read columns of %s", table)
+	}
+	defer rows.Close()
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns[name] = true
+	}
+	return columns, rows.Err()
+}
+
+func (s *Sqlite) getLegacyProject(ctx context.Context, version int, projectKey string) (model.Project, error) {
+	// orphaned projects weren't tracked by any legacy schema version
+	project, err := s.getDevProject(ctx, projectKey, "'', NULL, '{}', NULL, NULL, 0, '', '{}', '', '{}', '[]'")
+	if err != nil {
+		return model.Project{}, err
+	}
+	if version < SchemaVersionAvailableVariations {
+		return *project, nil
+	}
+	availableVariations, err := s.GetAvailableVariationsForProject(ctx, projectKey)
+	if err != nil {
+		return model.Project{}, err
+	}
+	for flagKey, variations := range availableVariations {
+		for _, variation := range variations {
+			project.AvailableVariations = append(project.AvailableVariations, model.FlagVariation{
+				FlagKey:   flagKey,
+				Variation: variation,
+			})
+		}
+	}
+	return *project, nil
+}
+
+func (s *Sqlite) getLegacyOverrides(ctx context.Context, version int, projectKey string) (model.Overrides, error) {
+	// Legacy databases predate locked and rollout overrides.
+	query := `
+		SELECT flag_key, active, value, version, FALSE, ''
+		FROM overrides
+		WHERE project_key = ?
+	`
+	if version < SchemaVersionOverridesVersioned {
+		// Before overrides were versioned, removing an override deleted its row, so every row is active.
+		query = `
+			SELECT flag_key, TRUE, value, 1, FALSE, ''
+			FROM overrides
+			WHERE project_key = ?
+		`
+	}
+	rows, err := s.database.QueryContext(ctx, query, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanOverrides(rows, projectKey)
+}
+
+// insertMigratedOverrides writes overrides as-is, keeping their versions so that SDKs connected before the upgrade
+// don't see older versions of flags afterward.
+func (s *Sqlite) insertMigratedOverrides(ctx context.Context, overrides model.Overrides) (err error) {
+	tx, err := s.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, override := range overrides {
+		var valueJson []byte
+		valueJson, err = override.Value.MarshalJSON()
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal override value")
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO overrides (project_key, flag_key, value, active, version)
+			VALUES (?, ?, ?, ?, ?)
+		`, override.ProjectKey, override.FlagKey, string(valueJson), override.Active, override.Version)
+		if err != nil {
+			return err
+		}
+		err = insertOverrideHistory(ctx, tx, model.LayerUser, override.ProjectKey, override.FlagKey, valueJson, override.Active, override.Version)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *Sqlite) verifyMigratedProject(ctx context.Context, expected model.Project, expectedOverrides model.Overrides) error {
+	project, err := s.GetDevProject(ctx, expected.Key)
+	if err != nil {
+		return err
+	}
+	if len(project.AllFlagsState) != len(expected.AllFlagsState) {
+		return errors.Errorf("expected %d flags, found %d", len(expected.AllFlagsState), len(project.AllFlagsState))
+	}
+	for flagKey, state := range expected.AllFlagsState {
+		migrated, ok := project.AllFlagsState[flagKey]
+		if !ok || !migrated.Value.Equal(state.Value) || migrated.Version != state.Version {
+			return errors.Errorf("flag %s was not migrated intact", flagKey)
+		}
+	}
+
+	availableVariations, err := s.GetAvailableVariationsForProject(ctx, expected.Key)
+	if err != nil {
+		return err
+	}
+	variationCount := 0
+	for _, variations := range availableVariations {
+		variationCount += len(variations)
+	}
+	if variationCount != len(expected.AvailableVariations) {
+		return errors.Errorf("expected %d available variations, found %d", len(expected.AvailableVariations), variationCount)
+	}
+
+	overrides, err := s.GetOverridesForProject(ctx, expected.Key)
+	if err != nil {
+		return err
+	}
+	if len(overrides) != len(expectedOverrides) {
+		return errors.Errorf("expected %d overrides, found %d", len(expectedOverrides), len(overrides))
+	}
+	for _, expectedOverride := range expectedOverrides {
+		override, ok := overrides.GetFlag(expectedOverride.FlagKey)
+		if !ok || override.Active != expectedOverride.Active || override.Version != expectedOverride.Version || !override.Value.Equal(expectedOverride.Value) {
+			return errors.Errorf("override for flag %s was not migrated intact", expectedOverride.FlagKey)
+		}
+	}
+	return nil
+}
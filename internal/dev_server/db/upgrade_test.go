@@ -0,0 +1,186 @@
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestUpgrade(t *testing.T) {
+	ctx := context.Background()
+
+	createLegacyDB := func(t *testing.T, schema ...string) string {
+		path := filepath.Join(t.TempDir(), "legacy.db")
+		legacy, err := sql.Open("sqlite3", path)
+		require.NoError(t, err)
+		defer legacy.Close()
+		for _, statement := range schema {
+			_, err = legacy.Exec(statement)
+			require.NoError(t, err)
+		}
+		return path
+	}
+	projectsTable := `CREATE TABLE projects (
+		key text PRIMARY KEY,
+		source_environment_key text NOT NULL,
+		context text NOT NULL,
+		last_sync_time timestamp NOT NULL,
+		flag_state TEXT NOT NULL
+	)`
+	insertProject := `INSERT INTO projects VALUES (
+		'legacy-proj', 'env-1', '{"kind":"user","key":"legacy-user"}', '2024-01-02 03:04:05',
+		'{"flag-1":{"value":true,"version":3},"flag-2":{"value":"cool","version":1}}'
+	)`
+
+	t.Run("migrates unversioned overrides as active", func(t *testing.T) {
+		legacyPath := createLegacyDB(t,
+			projectsTable,
+			`CREATE TABLE overrides (project_key text NOT NULL, flag_key text NOT NULL, value text NOT NULL)`,
+			insertProject,
+			`INSERT INTO overrides VALUES ('legacy-proj', 'flag-1', 'false')`,
+		)
+		store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "current.db"))
+		require.NoError(t, err)
+
+		report, err := store.Upgrade(ctx, legacyPath)
+		require.NoError(t, err)
+		assert.Equal(t, db.SchemaVersionOverridesUnversioned, report.SchemaVersion)
+		assert.Equal(t, []db.MigratedProject{{Key: "legacy-proj", Flags: 2, Overrides: 1}}, report.Migrated)
+
+		project, err := store.GetDevProject(ctx, "legacy-proj")
+		require.NoError(t, err)
+		assert.Equal(t, "env-1", project.SourceEnvironmentKey)
+		assert.Equal(t, ldcontext.New("legacy-user"), project.Context)
+		assert.Equal(t, model.FlagState{Value: ldvalue.Bool(true), Version: 3}, project.AllFlagsState["flag-1"])
+
+		overrides, err := store.GetOverridesForProject(ctx, "legacy-proj")
+		require.NoError(t, err)
+		assert.Equal(t, model.Overrides{{
+			ProjectKey: "legacy-proj",
+			FlagKey:    "flag-1",
+			Value:      ldvalue.Bool(false),
+			Active:     true,
+			Version:    1,
+		}}, overrides)
+	})
+
+	t.Run("keeps override versions and available variations", func(t *testing.T) {
+		legacyPath := createLegacyDB(t,
+			projectsTable,
+			`CREATE TABLE overrides (
+				project_key text NOT NULL,
+				flag_key text NOT NULL,
+				value text NOT NULL,
+				active boolean NOT NULL default TRUE,
+				version integer NOT NULL default 1
+			)`,
+			`CREATE TABLE available_variations (
+				project_key text NOT NULL,
+				flag_key text NOT NULL,
+				id text NOT NULL,
+				value text NOT NULL,
+				description text,
+				name text
+			)`,
+			insertProject,
+			`INSERT INTO overrides VALUES ('legacy-proj', 'flag-2', '"uncool"', FALSE, 4)`,
+			`INSERT INTO available_variations VALUES ('legacy-proj', 'flag-1', 'a', 'true', NULL, 'On')`,
+			`INSERT INTO available_variations VALUES ('legacy-proj', 'flag-1', 'b', 'false', NULL, 'Off')`,
+		)
+		store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "current.db"))
+		require.NoError(t, err)
+
+		report, err := store.Upgrade(ctx, legacyPath)
+		require.NoError(t, err)
+		assert.Equal(t, db.SchemaVersionAvailableVariations, report.SchemaVersion)
+		assert.Equal(t, []db.MigratedProject{{Key: "legacy-proj", Flags: 2, Overrides: 1, AvailableVariations: 2}}, report.Migrated)
+
+		overrides, err := store.GetOverridesForProject(ctx, "legacy-proj")
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.False(t, overrides[0].Active)
+		assert.Equal(t, 4, overrides[0].Version)
+
+		variations, err := store.GetAvailableVariationsForProject(ctx, "legacy-proj")
+		require.NoError(t, err)
+		assert.Len(t, variations["flag-1"], 2)
+	})
+
+	t.Run("skips projects that already exist", func(t *testing.T) {
+		legacyPath := createLegacyDB(t,
+			projectsTable,
+			`CREATE TABLE overrides (project_key text NOT NULL, flag_key text NOT NULL, value text NOT NULL)`,
+			insertProject,
+		)
+		store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "current.db"))
+		require.NoError(t, err)
+		require.NoError(t, store.InsertProject(ctx, model.Project{
+			Key:                  "legacy-proj",
+			SourceEnvironmentKey: "env-2",
+			Context:              ldcontext.New("current-user"),
+			LastSyncTime:         time.Now(),
+			AllFlagsState:        model.FlagsState{},
+		}))
+
+		report, err := store.Upgrade(ctx, legacyPath)
+		require.NoError(t, err)
+		assert.Empty(t, report.Migrated)
+		assert.Equal(t, []string{"legacy-proj"}, report.Skipped)
+
+		project, err := store.GetDevProject(ctx, "legacy-proj")
+		require.NoError(t, err)
+		assert.Equal(t, "env-2", project.SourceEnvironmentKey)
+	})
+
+	t.Run("migrates aliases from current databases", func(t *testing.T) {
+		legacyPath := filepath.Join(t.TempDir(), "legacy.db")
+		legacy, err := db.NewSqlite(ctx, legacyPath)
+		require.NoError(t, err)
+		require.NoError(t, legacy.InsertProject(ctx, model.Project{
+			Key:           "proj",
+			Context:       ldcontext.New("user"),
+			LastSyncTime:  time.Now(),
+			AllFlagsState: model.FlagsState{},
+		}))
+		require.NoError(t, legacy.UpsertAlias(ctx, model.Alias{Alias: "sdk-123", ProjectKey: "proj"}))
+
+		store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "current.db"))
+		require.NoError(t, err)
+		report, err := store.Upgrade(ctx, legacyPath)
+		require.NoError(t, err)
+		assert.Equal(t, db.SchemaVersionCurrent, report.SchemaVersion)
+		assert.Equal(t, 1, report.Aliases)
+
+		alias, err := store.GetAlias(ctx, "sdk-123")
+		require.NoError(t, err)
+		assert.Equal(t, "proj", alias.ProjectKey)
+	})
+
+	t.Run("rejects databases that aren't dev server databases", func(t *testing.T) {
+		legacyPath := createLegacyDB(t, `CREATE TABLE something_else (id integer)`)
+		store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "current.db"))
+		require.NoError(t, err)
+
+		_, err = store.Upgrade(ctx, legacyPath)
+		assert.ErrorContains(t, err, "is not a dev server database")
+	})
+
+	t.Run("rejects upgrading the current database from itself", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "current.db")
+		store, err := db.NewSqlite(ctx, path)
+		require.NoError(t, err)
+
+		_, err = store.Upgrade(ctx, path)
+		assert.ErrorContains(t, err, "is the current database")
+	})
+}
@@ -6,34 +6,156 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/adrg/xdg"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	devstore "github.com/launchdarkly/ldcli/devserver/store"
 	"github.com/launchdarkly/ldcli/internal/client"
 	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
 	"github.com/launchdarkly/ldcli/internal/dev_server/api"
 	"github.com/launchdarkly/ldcli/internal/dev_server/api/events"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api/gql"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api/live"
 	"github.com/launchdarkly/ldcli/internal/dev_server/db"
 	"github.com/launchdarkly/ldcli/internal/dev_server/events_db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/launchdarkly/ldcli/internal/dev_server/sdk"
 	"github.com/launchdarkly/ldcli/internal/dev_server/ui"
 )
 
+// eventsBufferCapacity is how many of the most recent SDK events are kept in memory for `GET /dev/events`.
+const eventsBufferCapacity = 1000
+
+// evaluationRequestsCapacity is how many of the most recent evaluations reported by SDKs are kept in memory for
+// `GET /dev/projects/{projectKey}/requests`.
+const evaluationRequestsCapacity = 1000
+
+// logsBufferCapacity is how many of the most recent log messages are kept in memory for `GET /dev/logs`.
+const logsBufferCapacity = 5000
+
+// etagCacheCapacity is how many responses from the LaunchDarkly API are kept to revalidate with their ETags.
+const etagCacheCapacity = 1000
+
+// idempotencyKeyTTL is how long the response to a request with an Idempotency-Key header is kept for retries of it.
+const idempotencyKeyTTL = 24 * time.Hour
+
+// overrideSchedulerInterval is how often scheduled overrides are checked, and so how late they can be applied.
+const overrideSchedulerInterval = time.Second
+
+// historyPruneInterval is how often history older than ServerParams.HistoryRetention is pruned.
+const historyPruneInterval = time.Hour
+
 type Client interface {
 	RunServer(ctx context.Context, serverParams ServerParams)
 }
 
+// Stores the dev server can keep projects and overrides in. StoreRedis uses the Redis server at ServerParams.StoreURL.
+// Others can be added with devserver/store.Register.
+const (
+	StoreSqlite = "sqlite"
+	StoreRedis  = "redis"
+)
+
+func init() {
+	devstore.Register(StoreSqlite, func(ctx context.Context, config devstore.Config) (devstore.Store, error) {
+		dbFilePath, err := DBPath(config.URL)
+		if err != nil {
+			return nil, err
+		}
+		logs.Printf(logs.Info, "", "Using database at %s", dbFilePath)
+		store, err := db.NewSqlite(ctx, dbFilePath)
+		if err != nil {
+			return nil, err
+		}
+		// a redis store may be shared with other dev servers, whose writes the cache wouldn't see
+		return model.NewCachingStore(store), nil
+	})
+	devstore.Register(StoreRedis, func(ctx context.Context, config devstore.Config) (devstore.Store, error) {
+		logs.Printf(logs.Info, "", "Using redis store")
+		return db.NewRedis(ctx, config.URL)
+	})
+}
+
 type ServerParams struct {
-	AccessToken            string
-	BaseURI                string
-	DevStreamURI           string
-	Port                   string
-	CorsEnabled            bool
-	CorsOrigin             string
+	AccessToken  string
+	BaseURI      string
+	DevStreamURI string
+	// DashboardURL is where the LaunchDarkly dashboard that responses link projects and flags to is served. It's
+	// BaseURI if empty.
+	DashboardURL string
+	// Proxy is the proxy URL for connections to LaunchDarkly. If empty, the proxy environment variables are used.
+	Proxy string
+	Port  string
+	// Listen is more addresses to serve on besides Port, such as unix:///tmp/ldcli.sock. See ParseListenAddress.
+	Listen                []string
+	CorsEnabled           bool
+	CorsOrigin            string
+	SecureModeSecret      string
+	ContextEnrichmentHook string
+	NotificationDebounce  time.Duration
+	ReloadHookURL         string
+	ReloadHookFlags       []string
+	Store                 string
+	// StoreURL locates the store's data for backends that need it, such as the Redis server for StoreRedis. For
+	// StoreSqlite it's the path to the database, which defaults to DBPath's.
+	StoreURL string
+	// ExecHooks are shell commands to run when flags change. See model.ExecHook.
+	ExecHooks []model.ExecHookConfig
+	// ActorResolver identifies who made each request so changes can be attributed in history. It may be nil, in which
+	// case changes made through the API are unattributed.
+	ActorResolver model.ActorResolver
+	// NamespaceResolver picks the namespace of each request, so that developers sharing the server each get their own
+	// overrides. See model.ResolveNamespacedProjectKey. It may be nil, in which case there are no namespaces.
+	NamespaceResolver model.ActorResolver
+	// ReloadAccessToken is called on SIGHUP to get the access token to switch to, e.g. by re-reading the config file. It
+	// may be nil, in which case the token can only be replaced with PUT /dev/access-token.
+	ReloadAccessToken func() (string, error)
+	// AutoConfigKey is a Relay Proxy auto-configuration key. If set, a project is created and kept in sync for every
+	// project the key has access to, sourced from AutoConfigEnvironment where the key has access to it.
+	AutoConfigKey          string
+	AutoConfigEnvironment  string
 	InitialProjectSettings model.InitialProjectSettings
+	// Seed is created after the initial project, if set. The server exits if any of it can't be.
+	Seed *model.Seed
+	// SdkKeys maps placeholder SDK keys that apps are configured with, e.g. local-dev-key-frontend, to the projects they
+	// select. They're created as aliases after the seed.
+	SdkKeys map[string]string
+	// StaleAfter is how long after its last sync a project is considered stale, flagged to SDKs with the X-LD-Stale
+	// response header. 0 turns this off. With AutoResyncStale, stale projects are resynced in the background when
+	// they're requested.
+	StaleAfter      time.Duration
+	AutoResyncStale bool
+	// StatsdAddress is the host:port of a StatsD server or Datadog agent to send metrics to. Metrics aren't sent if it's
+	// empty. Their names start with StatsdPrefix, and StatsdTags are added to them in model.StatsdFormatDogStatsd.
+	StatsdAddress string
+	StatsdPrefix  string
+	StatsdFormat  string
+	StatsdTags    []string
+	// RecordEvaluationsFile is the path of a file to append every evaluation SDKs report to, one JSON object per line,
+	// so that they can be replayed with `ldcli dev-server replay`. Evaluations aren't recorded if it's empty.
+	RecordEvaluationsFile string
+	// AutoCreateProjects creates a project, sourced from the environment, when an SDK connects with the SDK key, mobile
+	// key, or client-side ID of a LaunchDarkly environment that no project is for.
+	AutoCreateProjects bool
+	// GraphQL turns on the GraphQL API at /dev/graphql.
+	GraphQL bool
+	// SyncInterval is how often every project is synced from LaunchDarkly. 0 turns this off.
+	SyncInterval time.Duration
+	// HistoryRetention is how long flag state and override history is kept for, and so how far back flag state can be
+	// reconstructed and snapshots restored. 0 keeps it forever.
+	HistoryRetention time.Duration
+	// ConfigFile is the path to a devserver.yaml, whose settings take precedence over these. It's re-applied on SIGHUP
+	// and whenever it changes. See ServerConfig.
+	ConfigFile string
 }
 
 type LDClient struct {
@@ -47,10 +169,49 @@ func NewClient(cliVersion string) LDClient {
 }
 
 func (c LDClient) RunServer(ctx context.Context, serverParams ServerParams) {
-	ldClient := client.New(serverParams.AccessToken, serverParams.BaseURI, c.cliVersion)
-	dbPath := getDBPath()
-	log.Printf("Using database at %s", dbPath)
-	sqlStore, err := db.NewSqlite(ctx, getDBPath())
+	// keep recent messages from the start, so `GET /dev/logs` has everything logged while starting up too
+	logsBuffer := logs.NewBuffer(logsBufferCapacity)
+	logs.Capture(logsBuffer)
+	flagParams := serverParams
+	var config ServerConfig
+	if serverParams.ConfigFile != "" {
+		var err error
+		config, err = ReadServerConfig(serverParams.ConfigFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		serverParams, err = config.applyTo(serverParams)
+		if err != nil {
+			log.Fatalf("invalid config file %s: %s", serverParams.ConfigFile, err)
+		}
+		logs.Printf(logs.Info, "", "Using config file %s", serverParams.ConfigFile)
+	}
+	shutdownTracing, err := startTracing(ctx, c.cliVersion)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if shutdownTracing != nil {
+		logs.Printf(logs.Info, "", "Exporting traces with OpenTelemetry")
+		go flushSpansOnSignal(shutdownTracing)
+	}
+	etagCache := adapters.NewETagCache(http.DefaultTransport, etagCacheCapacity)
+	// requests to LaunchDarkly are traced, after the cache so that only the ones that are sent become spans
+	apiTransport := otelhttp.NewTransport(etagCache)
+	accessToken := adapters.NewAccessToken(serverParams.AccessToken, func(token string) ldapi.APIClient {
+		ldClient := client.New(token, serverParams.BaseURI, c.cliVersion)
+		ldClient.GetConfig().HTTPClient = &http.Client{Transport: apiTransport}
+		return *ldClient
+	})
+	if serverParams.ReloadAccessToken != nil {
+		go reloadAccessTokenOnSignal(accessToken, serverParams.ReloadAccessToken)
+	}
+	sdkConfig := adapters.SdkConfig{
+		Endpoints: interfaces.ServiceEndpoints{
+			Streaming: serverParams.DevStreamURI,
+		},
+		ProxyURL: serverParams.Proxy,
+	}
+	store, err := newStore(ctx, serverParams)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -61,17 +222,202 @@ func (c LDClient) RunServer(ctx context.Context, serverParams ServerParams) {
 	}
 
 	observers := model.NewObservers()
+	if serverParams.NotificationDebounce > 0 {
+		observers = model.NewDebouncedObservers(serverParams.NotificationDebounce)
+	}
+	if serverParams.ReloadHookURL != "" {
+		observers.RegisterObserver(model.NewReloadHook(serverParams.ReloadHookURL, serverParams.ReloadHookFlags))
+	}
+	execHooks := registerExecHooks(observers, serverParams.ExecHooks)
+	eventsBuffer := model.NewEventsBuffer(eventsBufferCapacity)
+	var metrics model.Metrics
+	if serverParams.StatsdAddress != "" {
+		statsd, err := model.NewStatsdMetrics(serverParams.StatsdAddress, serverParams.StatsdPrefix, serverParams.StatsdFormat, serverParams.StatsdTags)
+		if err != nil {
+			log.Fatal(err)
+		}
+		logs.Printf(logs.Info, "", "Sending metrics to statsd at %s", serverParams.StatsdAddress)
+		metrics = statsd
+	}
+	evaluationRequests := model.NewEvaluationRequests(evaluationRequestsCapacity)
+	if serverParams.RecordEvaluationsFile != "" {
+		recording, err := os.OpenFile(serverParams.RecordEvaluationsFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer recording.Close()
+		evaluationRequests.RecordTo(recording)
+		logs.Printf(logs.Info, "", "Recording evaluations to %s", serverParams.RecordEvaluationsFile)
+	}
+	staleness := model.NewStaleness(serverParams.StaleAfter, serverParams.AutoResyncStale)
+	bigSegments := model.NewBigSegments()
+	dashboardURL := serverParams.DashboardURL
+	if dashboardURL == "" {
+		dashboardURL = serverParams.BaseURI
+	}
+	var autoCreator *model.ProjectAutoCreator
+	if serverParams.AutoCreateProjects {
+		autoCreator = model.NewProjectAutoCreator()
+	}
+	var contextEnricher model.ContextEnricher
+	if serverParams.ContextEnrichmentHook != "" {
+		contextEnricher = model.NewContextEnricher(serverParams.ContextEnrichmentHook)
+	}
+	rt := routes{
+		accessToken:        accessToken,
+		sdkConfig:          sdkConfig,
+		store:              store,
+		eventStore:         sqlEventStore,
+		observers:          observers,
+		eventsBuffer:       eventsBuffer,
+		evaluationRequests: evaluationRequests,
+		logsBuffer:         logsBuffer,
+		staleness:          staleness,
+		dashboard:          model.NewDashboard(dashboardURL),
+		bigSegments:        bigSegments,
+		autoCreator:        autoCreator,
+		contextEnricher:    contextEnricher,
+		authTokens:         config.AuthTokens,
+		actorResolver:      withAuthTokens(serverParams.ActorResolver, config.AuthTokens),
+		namespaces:         serverParams.NamespaceResolver,
+		metrics:            metrics,
+		graphQL:            serverParams.GraphQL,
+		secureModeSecret:   serverParams.SecureModeSecret,
+		corsEnabled:        serverParams.CorsEnabled,
+		corsOrigin:         serverParams.CorsOrigin,
+		idempotencyKeys:    api.NewIdempotencyKeys(idempotencyKeyTTL),
+	}
+	router := newSwappableHandler(rt.router())
+
+	ctx = adapters.WithApiAndSdk(ctx, accessToken.Client(), sdkConfig)
+	ctx = model.SetObserversOnContext(ctx, observers)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.ContextWithContextEnricher(ctx, contextEnricher)
+	ctx = model.ContextWithMetrics(ctx, metrics)
+	ctx = model.ContextWithBigSegments(ctx, bigSegments)
+	// overrides given on the command line were set by whoever started the server
+	ctx = model.ContextWithActor(ctx, model.CurrentOSUser())
+	syncErr := model.CreateOrSyncProject(ctx, serverParams.InitialProjectSettings)
+	if syncErr != nil {
+		log.Fatal(syncErr)
+	}
+	if serverParams.Seed != nil {
+		if err := model.SeedProjects(ctx, *serverParams.Seed); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for sdkKey, projectKey := range serverParams.SdkKeys {
+		if err := model.CreateAlias(ctx, model.Alias{Alias: sdkKey, ProjectKey: projectKey}); err != nil {
+			log.Fatalf("unable to map SDK key %s to project %s: %s", sdkKey, projectKey, err)
+		}
+		logs.Printf(logs.Info, projectKey, "SDK key %s selects project [%s]", sdkKey, projectKey)
+	}
+	if serverParams.AutoConfigKey != "" {
+		go runAutoConfig(ctx, serverParams, accessToken, sdkConfig)
+	}
+	go model.RunOverrideScheduler(ctx, overrideSchedulerInterval)
+	if serverParams.HistoryRetention > 0 {
+		go model.RunHistoryPruner(ctx, serverParams.HistoryRetention, historyPruneInterval)
+	}
+	// syncs and seeds pick up the current access token in case it's been rotated
+	currentTokenContext := func() context.Context {
+		return adapters.WithApiAndSdk(ctx, accessToken.Client(), sdkConfig)
+	}
+	periodicSync := newPeriodicSync(serverParams.SyncInterval, func() {
+		model.SyncAllProjects(currentTokenContext())
+	})
+	go periodicSync.run(ctx.Done())
+	if serverParams.ConfigFile != "" {
+		reloader := &configReloader{
+			path:        serverParams.ConfigFile,
+			params:      flagParams,
+			applied:     serverParams,
+			config:      config,
+			routes:      rt,
+			handler:     router,
+			sync:        periodicSync,
+			execHooks:   execHooks,
+			seedContext: currentTokenContext,
+		}
+		go reloader.reloadOnChange()
+	}
+	handler := handlers.CombinedLoggingHandler(os.Stdout, otelhttp.NewHandler(router, tracingServiceName))
+
+	addr := fmt.Sprintf("0.0.0.0:%s", serverParams.Port)
+	logs.Printf(logs.Info, "", "Server running on %s", addr)
+	logs.Printf(logs.Info, "", "Access the UI for toggling overrides at http://localhost:%s/ui or by running `ldcli dev-server ui`", serverParams.Port)
+
+	server := http.Server{
+		Addr:    addr,
+		Handler: handler,
+	}
+	for _, address := range serverParams.Listen {
+		listener, err := listen(address)
+		if err != nil {
+			log.Fatal(err)
+		}
+		logs.Printf(logs.Info, "", "Server also listening on %s", describeListenAddress(address))
+		go func() {
+			log.Fatal(server.Serve(listener))
+		}()
+	}
+	log.Fatal(server.ListenAndServe())
+}
+
+// routes are what the dev server's routes are served with.
+type routes struct {
+	accessToken        *adapters.AccessToken
+	sdkConfig          adapters.SdkConfig
+	store              model.Store
+	eventStore         model.EventStore
+	observers          *model.Observers
+	eventsBuffer       *model.EventsBuffer
+	evaluationRequests *model.EvaluationRequests
+	logsBuffer         *logs.Buffer
+	staleness          *model.Staleness
+	dashboard          *model.Dashboard
+	bigSegments        *model.BigSegments
+	autoCreator        *model.ProjectAutoCreator
+	contextEnricher    model.ContextEnricher
+	authTokens         map[string]string
+	actorResolver      model.ActorResolver
+	namespaces         model.ActorResolver
+	metrics            model.Metrics
+	graphQL            bool
+	secureModeSecret   string
+	corsEnabled        bool
+	corsOrigin         string
+	// idempotencyKeys are kept across rebuilds of the router, so retries are still recognized after a config reload.
+	idempotencyKeys *api.IdempotencyKeys
+}
+
+func (rt routes) router() *mux.Router {
 	ss := api.NewStrictServer()
 	apiServer := api.NewStrictHandlerWithOptions(ss, nil, api.StrictHTTPServerOptions{
 		RequestErrorHandlerFunc:  api.RequestErrorHandler,
 		ResponseErrorHandlerFunc: api.ResponseErrorHandler,
 	})
 	r := mux.NewRouter()
+	r.Use(nameSpanAfterRoute)
 	r.Use(handlers.RecoveryHandler(handlers.PrintRecoveryStack(true)))
-	r.Use(adapters.Middleware(*ldClient, serverParams.DevStreamURI))
-	r.Use(model.EventStoreMiddleware(sqlEventStore))
-	r.Use(model.StoreMiddleware(sqlStore))
-	r.Use(model.ObserversMiddleware(observers))
+	r.Use(requireAuthTokens(rt.authTokens))
+	r.Use(adapters.Middleware(rt.accessToken, rt.sdkConfig))
+	r.Use(model.EventStoreMiddleware(rt.eventStore))
+	r.Use(model.StoreMiddleware(rt.store))
+	r.Use(model.ObserversMiddleware(rt.observers))
+	r.Use(model.EventsBufferMiddleware(rt.eventsBuffer))
+	r.Use(model.EvaluationRequestsMiddleware(rt.evaluationRequests))
+	r.Use(logs.BufferMiddleware(rt.logsBuffer))
+	r.Use(logs.AccessLogMiddleware(rt.logsBuffer))
+	r.Use(model.StalenessMiddleware(rt.staleness))
+	r.Use(model.DashboardMiddleware(rt.dashboard))
+	r.Use(model.BigSegmentsMiddleware(rt.bigSegments))
+	r.Use(model.ProjectAutoCreatorMiddleware(rt.autoCreator))
+	r.Use(model.ContextEnricherMiddleware(rt.contextEnricher))
+	r.Use(model.ActorMiddleware(rt.actorResolver))
+	r.Use(model.NamespaceMiddleware(rt.namespaces))
+	r.Use(model.MetricsMiddleware(rt.metrics))
+	r.Use(sdk.SecureModeMiddleware(rt.secureModeSecret))
 	r.Handle("/", http.RedirectHandler("/ui/", http.StatusFound))
 	r.Handle("/ui", http.RedirectHandler("/ui/", http.StatusMovedPermanently))
 	r.Handle("/ui/{_}.svg", http.StripPrefix("/ui/", ui.AssetHandler))
@@ -82,13 +428,22 @@ func (c LDClient) RunServer(ctx context.Context, serverParams ServerParams) {
 
 	events.BindRoutes(r)
 	sdk.BindRoutes(r)
+	var wsOrigin string
+	if rt.corsEnabled {
+		wsOrigin = rt.corsOrigin
+	}
+	// bound before the API's subrouter, which would otherwise match it first
+	live.BindRoutes(r, wsOrigin)
+	if rt.graphQL {
+		gql.BindRoutes(r)
+	}
 
 	apiRouter := r.PathPrefix("/dev").Subrouter()
-	if serverParams.CorsEnabled {
+	if rt.corsEnabled {
 		apiRouter.Use(handlers.CORS(
-			handlers.AllowedOrigins([]string{serverParams.CorsOrigin}),
-			handlers.AllowedHeaders([]string{"Content-Type", "Content-Length", "Accept-Encoding", "X-Requested-With"}),
-			handlers.ExposedHeaders([]string{"Date", "Content-Length"}),
+			handlers.AllowedOrigins([]string{rt.corsOrigin}),
+			handlers.AllowedHeaders([]string{"Content-Type", "Content-Length", "Accept-Encoding", "X-Requested-With", api.IdempotencyKeyHeader}),
+			handlers.ExposedHeaders([]string{"Date", "Content-Length", api.IdempotentReplayedHeader}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}),
 			handlers.MaxAge(300),
 		))
@@ -98,39 +453,71 @@ func (c LDClient) RunServer(ctx context.Context, serverParams ServerParams) {
 			panic("options handler running. This indicates a misconfiguration of routes")
 		})
 	}
+	apiRouter.Use(rt.idempotencyKeys.Middleware)
+	apiRouter.Use(api.JSONPatchMiddleware)
+	apiRouter.Use(api.NamespaceMiddleware)
 	api.HandlerFromMux(apiServer, apiRouter) // this method actually mutates the passed router.
+	return r
+}
 
-	ctx = adapters.WithApiAndSdk(ctx, *ldClient, serverParams.DevStreamURI)
-	ctx = model.SetObserversOnContext(ctx, observers)
-	ctx = model.ContextWithStore(ctx, sqlStore)
-	syncErr := model.CreateOrSyncProject(ctx, serverParams.InitialProjectSettings)
-	if syncErr != nil {
-		log.Fatal(syncErr)
+func runAutoConfig(ctx context.Context, serverParams ServerParams, accessToken *adapters.AccessToken, sdkConfig adapters.SdkConfig) {
+	autoConfig := model.NewAutoConfig(serverParams.AutoConfigEnvironment)
+	err := adapters.StreamAutoConfig(ctx, serverParams.DevStreamURI, serverParams.AutoConfigKey, func(environments adapters.AutoConfigEnvironments) {
+		// pick up the current access token in case it's been rotated
+		autoConfig.Apply(adapters.WithApiAndSdk(ctx, accessToken.Client(), sdkConfig), environments)
+	})
+	if err != nil {
+		logs.Printf(logs.Warn, "", "Auto-config stopped: %s", err)
 	}
-	handler := handlers.CombinedLoggingHandler(os.Stdout, r)
+}
 
-	addr := fmt.Sprintf("0.0.0.0:%s", serverParams.Port)
-	log.Printf("Server running on %s", addr)
-	log.Printf("Access the UI for toggling overrides at http://localhost:%s/ui or by running `ldcli dev-server ui`", serverParams.Port)
+func reloadAccessTokenOnSignal(accessToken *adapters.AccessToken, reload func() (string, error)) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		token, err := reload()
+		switch {
+		case err != nil:
+			logs.Printf(logs.Error, "", "Unable to reload access token: %s", err)
+		case token == "":
+			logs.Printf(logs.Warn, "", "Not reloading access token: no access token is configured")
+		case accessToken.Rotate(token):
+			logs.Printf(logs.Info, "", "Access token reloaded")
+		default:
+			logs.Printf(logs.Info, "", "Access token is unchanged")
+		}
+	}
+}
 
-	server := http.Server{
-		Addr:    addr,
-		Handler: handler,
+func newStore(ctx context.Context, serverParams ServerParams) (model.Store, error) {
+	backend := serverParams.Store
+	if backend == "" {
+		backend = StoreSqlite
 	}
-	log.Fatal(server.ListenAndServe())
+	store, err := devstore.New(ctx, backend, devstore.Config{URL: serverParams.StoreURL})
+	if err != nil {
+		return nil, err
+	}
+	return model.TraceStore(store), nil
 }
 
-func getDBPath() string {
+// DBPath resolves where the SQLite store's database is: path if it's set, and otherwise dev_server.db in the ldcli
+// state directory, which is created if needed. Commands that open the database directly should resolve it the same
+// way the server does, so that they see the server's projects.
+func DBPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
 	dbFilePath, err := xdg.StateFile("ldcli/dev_server.db")
-	log.Printf("Using database at %s", dbFilePath)
 	if err != nil {
-		log.Fatalf("Unable to create state directory: %s", err)
+		return "", fmt.Errorf("unable to create state directory: %w", err)
 	}
-	return dbFilePath
+	return dbFilePath, nil
 }
+
 func getEventsDBPath() string {
 	dbFilePath, err := xdg.StateFile("ldcli/dev_server_events.db")
-	log.Printf("Using database at %s", dbFilePath)
+	logs.Printf(logs.Info, "", "Using database at %s", dbFilePath)
 	if err != nil {
 		log.Fatalf("Unable to create state directory: %s", err)
 	}
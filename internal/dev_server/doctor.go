@@ -0,0 +1,227 @@
+package dev_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+)
+
+// DoctorStatus is how a doctor check turned out.
+type DoctorStatus string
+
+const (
+	DoctorStatusOK   DoctorStatus = "ok"
+	DoctorStatusWarn DoctorStatus = "warn"
+	DoctorStatusFail DoctorStatus = "fail"
+)
+
+// maxClockSkew is how far the local clock can be from LaunchDarkly's before the doctor complains. Timestamps on
+// events, audit entries and schedules are all taken from the local clock.
+const maxClockSkew = time.Minute
+
+// doctorTimeout limits how long each network check waits.
+const doctorTimeout = 5 * time.Second
+
+// DoctorCheck is the result of one of the doctor's checks, with how to fix it if it didn't pass.
+type DoctorCheck struct {
+	Name   string
+	Status DoctorStatus
+	Detail string
+	Fix    string
+}
+
+// DoctorParams describes the dev server to check, as it would be started.
+type DoctorParams struct {
+	Port string
+	// Store is StoreSqlite or StoreRedis. DBPath is the SQLite database, and StoreURL the Redis server.
+	Store        string
+	DBPath       string
+	StoreURL     string
+	AccessToken  string
+	BaseURI      string
+	DevStreamURI string
+	// HTTPClient is used to reach LaunchDarkly. http.DefaultClient is used if it's nil.
+	HTTPClient *http.Client
+	// Now is the local time to compare to LaunchDarkly's. time.Now is used if it's nil.
+	Now func() time.Time
+}
+
+// Diagnose checks what commonly stops the dev server from starting or syncing: the port, the store, the access token,
+// the connection to LaunchDarkly's streaming service, and the local clock.
+func Diagnose(ctx context.Context, params DoctorParams) []DoctorCheck {
+	if params.HTTPClient == nil {
+		params.HTTPClient = http.DefaultClient
+	}
+	if params.Now == nil {
+		params.Now = time.Now
+	}
+	tokenCheck, serverTime := checkAccessToken(ctx, params)
+	return []DoctorCheck{
+		checkPort(ctx, params),
+		checkStore(ctx, params),
+		tokenCheck,
+		checkStreaming(ctx, params),
+		checkClockSkew(params, serverTime),
+	}
+}
+
+func checkPort(ctx context.Context, params DoctorParams) DoctorCheck {
+	check := DoctorCheck{Name: "port"}
+	listener, err := net.Listen("tcp", "0.0.0.0:"+params.Port)
+	if err == nil {
+		_ = listener.Close()
+		check.Status = DoctorStatusOK
+		check.Detail = fmt.Sprintf("port %s is free", params.Port)
+		return check
+	}
+	if res, err := doctorRequest(ctx, params.HTTPClient, fmt.Sprintf("http://localhost:%s/dev/projects", params.Port), nil); err == nil {
+		_ = res.Body.Close()
+		if res.StatusCode == http.StatusOK {
+			check.Status = DoctorStatusOK
+			check.Detail = fmt.Sprintf("a dev server is already running on port %s", params.Port)
+			return check
+		}
+	}
+	check.Status = DoctorStatusFail
+	check.Detail = fmt.Sprintf("port %s is in use: %v", params.Port, err)
+	check.Fix = fmt.Sprintf("stop whatever is listening on port %s, or start the dev server with --port", params.Port)
+	return check
+}
+
+func checkStore(ctx context.Context, params DoctorParams) DoctorCheck {
+	check := DoctorCheck{Name: "store"}
+	if params.Store == StoreRedis {
+		store, err := db.NewRedis(ctx, params.StoreURL)
+		if err != nil {
+			check.Status = DoctorStatusFail
+			check.Detail = err.Error()
+			check.Fix = "check that Redis is running and that --redis-url points at it"
+			return check
+		}
+		_ = store.Close()
+		check.Status = DoctorStatusOK
+		check.Detail = "connected to Redis"
+		return check
+	}
+
+	if _, err := os.Stat(params.DBPath); errors.Is(err, fs.ErrNotExist) {
+		check.Status = DoctorStatusOK
+		check.Detail = fmt.Sprintf("%s doesn't exist yet, and will be created when the dev server starts", params.DBPath)
+		return check
+	}
+	problems, err := db.CheckSqliteIntegrity(ctx, params.DBPath)
+	switch {
+	case err != nil:
+		check.Status = DoctorStatusFail
+		check.Detail = err.Error()
+	case len(problems) > 0:
+		check.Status = DoctorStatusFail
+		check.Detail = fmt.Sprintf("%s is corrupt: %s", params.DBPath, strings.Join(problems, "; "))
+	default:
+		check.Status = DoctorStatusOK
+		check.Detail = fmt.Sprintf("%s passed SQLite's integrity check", params.DBPath)
+		return check
+	}
+	check.Fix = fmt.Sprintf("restore a backup from the dev server's /dev/backup endpoint, or move %s aside so the dev server starts with an empty database", params.DBPath)
+	return check
+}
+
+// checkAccessToken asks LaunchDarkly who the access token belongs to, and returns LaunchDarkly's time from the
+// response, if there was one.
+func checkAccessToken(ctx context.Context, params DoctorParams) (DoctorCheck, time.Time) {
+	check := DoctorCheck{Name: "access token"}
+	url := strings.TrimSuffix(params.BaseURI, "/") + "/api/v2/caller-identity"
+	header := http.Header{}
+	if params.AccessToken != "" {
+		header.Set("Authorization", params.AccessToken)
+	}
+	res, err := doctorRequest(ctx, params.HTTPClient, url, header)
+	if err != nil {
+		check.Status = DoctorStatusFail
+		check.Detail = fmt.Sprintf("unable to reach %s: %v", params.BaseURI, err)
+		check.Fix = "check your network connection and proxy settings, and that --base-uri is right"
+		return check, time.Time{}
+	}
+	_ = res.Body.Close()
+	serverTime, _ := http.ParseTime(res.Header.Get("Date"))
+
+	switch {
+	case params.AccessToken == "":
+		check.Status = DoctorStatusWarn
+		check.Detail = "no access token is set, so projects can't be synced from LaunchDarkly"
+		check.Fix = "run `ldcli login`, or `ldcli config --set access-token <token>`"
+	case res.StatusCode == http.StatusUnauthorized:
+		check.Status = DoctorStatusFail
+		check.Detail = "LaunchDarkly rejected the access token, which may have expired or been revoked"
+		check.Fix = "run `ldcli login` again, or set a new token with `ldcli config --set access-token <token>`"
+	case res.StatusCode >= 300:
+		check.Status = DoctorStatusFail
+		check.Detail = fmt.Sprintf("LaunchDarkly responded with %s", res.Status)
+		check.Fix = "check that --base-uri is your LaunchDarkly instance"
+	default:
+		check.Status = DoctorStatusOK
+		check.Detail = "LaunchDarkly accepted the access token"
+	}
+	return check, serverTime
+}
+
+// checkStreaming makes sure the streaming service responds. Without an SDK key it turns the request away, which is
+// enough to know it can be reached.
+func checkStreaming(ctx context.Context, params DoctorParams) DoctorCheck {
+	check := DoctorCheck{Name: "streaming"}
+	res, err := doctorRequest(ctx, params.HTTPClient, strings.TrimSuffix(params.DevStreamURI, "/")+"/all", nil)
+	if err != nil {
+		check.Status = DoctorStatusFail
+		check.Detail = fmt.Sprintf("unable to reach %s: %v", params.DevStreamURI, err)
+		check.Fix = "check your network connection and proxy settings, and that --dev-stream-uri is right"
+		return check
+	}
+	_ = res.Body.Close()
+	check.Status = DoctorStatusOK
+	check.Detail = fmt.Sprintf("%s is reachable", params.DevStreamURI)
+	return check
+}
+
+func checkClockSkew(params DoctorParams, serverTime time.Time) DoctorCheck {
+	check := DoctorCheck{Name: "clock"}
+	if serverTime.IsZero() {
+		check.Status = DoctorStatusWarn
+		check.Detail = "LaunchDarkly's time is unknown, so the local clock couldn't be checked"
+		return check
+	}
+	// the Date header is only accurate to the second
+	skew := params.Now().Sub(serverTime).Truncate(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxClockSkew {
+		check.Status = DoctorStatusFail
+		check.Detail = fmt.Sprintf("the local clock is %s off from LaunchDarkly's", skew)
+		check.Fix = "turn on automatic time syncing (NTP) for this machine"
+		return check
+	}
+	check.Status = DoctorStatusOK
+	check.Detail = "the local clock agrees with LaunchDarkly's"
+	return check
+}
+
+func doctorRequest(ctx context.Context, client *http.Client, url string, header http.Header) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	return client.Do(req)
+}
@@ -0,0 +1,94 @@
+package dev_server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnose(t *testing.T) {
+	ctx := context.Background()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "api-good" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer api.Close()
+	stream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer stream.Close()
+
+	// the stream server's port is taken by something that isn't a dev server
+	usedPort := strconv.Itoa(stream.Listener.Addr().(*net.TCPAddr).Port)
+
+	params := DoctorParams{
+		Port:         "0",
+		Store:        StoreSqlite,
+		DBPath:       filepath.Join(t.TempDir(), "dev_server.db"),
+		AccessToken:  "api-good",
+		BaseURI:      api.URL,
+		DevStreamURI: stream.URL,
+	}
+	statuses := func(checks []DoctorCheck) map[string]DoctorStatus {
+		statuses := make(map[string]DoctorStatus, len(checks))
+		for _, check := range checks {
+			statuses[check.Name] = check.Status
+		}
+		return statuses
+	}
+
+	t.Run("passes for a healthy setup", func(t *testing.T) {
+		checks := Diagnose(ctx, params)
+		assert.Equal(t, map[string]DoctorStatus{
+			"port":         DoctorStatusOK,
+			"store":        DoctorStatusOK,
+			"access token": DoctorStatusOK,
+			"streaming":    DoctorStatusOK,
+			"clock":        DoctorStatusOK,
+		}, statuses(checks))
+	})
+
+	t.Run("fails with fixes for everything that's wrong", func(t *testing.T) {
+		broken := params
+		broken.Port = usedPort
+		broken.DBPath = filepath.Join(t.TempDir(), "corrupt.db")
+		require.NoError(t, os.WriteFile(broken.DBPath, []byte("this is not a database, just some text that's long enough to have a header"), 0o644))
+		broken.AccessToken = "api-revoked"
+		broken.Now = func() time.Time { return time.Now().Add(10 * time.Minute) }
+
+		checks := Diagnose(ctx, broken)
+		for _, check := range checks {
+			if check.Name == "streaming" {
+				continue
+			}
+			assert.Equal(t, DoctorStatusFail, check.Status, check.Name)
+			assert.NotEmpty(t, check.Fix, check.Name)
+		}
+	})
+
+	t.Run("warns when there's no access token", func(t *testing.T) {
+		noToken := params
+		noToken.AccessToken = ""
+		assert.Equal(t, DoctorStatusWarn, statuses(Diagnose(ctx, noToken))["access token"])
+	})
+
+	t.Run("fails when LaunchDarkly can't be reached", func(t *testing.T) {
+		unreachable := params
+		unreachable.BaseURI = "http://127.0.0.1:1"
+		unreachable.DevStreamURI = "http://127.0.0.1:1"
+		checks := statuses(Diagnose(ctx, unreachable))
+		assert.Equal(t, DoctorStatusFail, checks["access token"])
+		assert.Equal(t, DoctorStatusFail, checks["streaming"])
+		assert.Equal(t, DoctorStatusWarn, checks["clock"])
+	})
+}
@@ -0,0 +1,67 @@
+package dev_server
+
+import (
+	"context"
+	"net/http"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/api"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/events_db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// EmbeddedServer is a dev server run inside another program, such as a test, rather than by the CLI. It keeps
+// everything in memory and has no access token, so its projects have to be imported rather than synced.
+type EmbeddedServer struct {
+	// Handler serves the SDK routes, the API and the UI, like the CLI's server does.
+	Handler http.Handler
+	ctx     context.Context
+}
+
+// NewEmbeddedServer creates an embedded server. Scheduled overrides are applied until ctx is done.
+func NewEmbeddedServer(ctx context.Context) (*EmbeddedServer, error) {
+	store, err := db.NewMemorySqlite(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eventStore, err := events_db.NewMemorySqlite(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accessToken := adapters.NewAccessToken("", func(string) ldapi.APIClient {
+		return *ldapi.NewAPIClient(ldapi.NewConfiguration())
+	})
+	observers := model.NewObservers()
+	bigSegments := model.NewBigSegments()
+
+	ctx = adapters.WithApiAndSdk(ctx, accessToken.Client(), adapters.SdkConfig{})
+	ctx = model.SetObserversOnContext(ctx, observers)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.ContextWithBigSegments(ctx, bigSegments)
+	go model.RunOverrideScheduler(ctx, overrideSchedulerInterval)
+
+	return &EmbeddedServer{
+		Handler: routes{
+			accessToken:        accessToken,
+			store:              store,
+			eventStore:         eventStore,
+			observers:          observers,
+			eventsBuffer:       model.NewEventsBuffer(eventsBufferCapacity),
+			evaluationRequests: model.NewEvaluationRequests(evaluationRequestsCapacity),
+			logsBuffer:         logs.NewBuffer(logsBufferCapacity),
+			bigSegments:        bigSegments,
+			idempotencyKeys:    api.NewIdempotencyKeys(idempotencyKeyTTL),
+		}.router(),
+		ctx: ctx,
+	}, nil
+}
+
+// Context returns a context for changing the server's projects and overrides with the model package. It's done when
+// the context the server was created with is.
+func (s *EmbeddedServer) Context() context.Context {
+	return s.ctx
+}
@@ -205,6 +205,25 @@ func NewSqlite(ctx context.Context, dbPath string) (*Sqlite, error) {
 	return store, nil
 }
 
+// NewMemorySqlite returns an event store that keeps events in memory.
+func NewMemorySqlite(ctx context.Context) (*Sqlite, error) {
+	db, err := sql.Open("sqlite3", ":memory:?_foreign_keys=on")
+	if err != nil {
+		return &Sqlite{}, err
+	}
+	// each connection would get a database of its own, and the database goes when its connection does
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	store := &Sqlite{database: db}
+	err = store.runMigrations(ctx)
+	if err != nil {
+		return &Sqlite{}, err
+	}
+	return store, nil
+}
+
 func (s *Sqlite) runMigrations(ctx context.Context) error {
 	tx, err := s.database.BeginTx(ctx, nil)
 	if err != nil {
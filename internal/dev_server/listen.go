@@ -0,0 +1,76 @@
+package dev_server
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// ParseListenAddress splits an address to listen on into its network and address, e.g. unix:///tmp/ldcli.sock into
+// unix and /tmp/ldcli.sock or tcp://127.0.0.1:9000 into tcp and 127.0.0.1:9000.
+func ParseListenAddress(address string) (network, addr string, err error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid listen address %q: %w", address, err)
+	}
+	switch u.Scheme {
+	case "unix":
+		// unix://relative/path parses "relative" as the host
+		addr = u.Host + u.Path
+		if addr == "" {
+			return "", "", fmt.Errorf("invalid listen address %q: missing socket path", address)
+		}
+		return "unix", addr, nil
+	case "tcp":
+		if u.Host == "" {
+			return "", "", fmt.Errorf("invalid listen address %q: missing host and port", address)
+		}
+		return "tcp", u.Host, nil
+	default:
+		return "", "", fmt.Errorf("invalid listen address %q: expected unix:///path/to.sock or tcp://host:port", address)
+	}
+}
+
+// listen opens a listener for an address accepted by ParseListenAddress. A socket file left behind by a server that
+// didn't shut down cleanly is replaced, but not one that a server is still listening on.
+func listen(address string) (net.Listener, error) {
+	network, addr, err := ParseListenAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		if err := removeStaleSocket(addr); err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen(network, addr)
+}
+
+func removeStaleSocket(path string) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s already exists and isn't a socket", path)
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("another server is already listening on %s", path)
+	}
+	return os.Remove(path)
+}
+
+// describeListenAddress is how a listen address is logged, with a hint on connecting to unix sockets.
+func describeListenAddress(address string) string {
+	network, addr, err := ParseListenAddress(address)
+	if err != nil || network != "unix" {
+		return strings.TrimPrefix(address, "tcp://")
+	}
+	return fmt.Sprintf("%s (e.g. curl --unix-socket %s http://localhost/dev/projects)", addr, addr)
+}
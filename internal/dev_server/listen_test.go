@@ -0,0 +1,61 @@
+package dev_server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListenAddress(t *testing.T) {
+	valid := map[string][2]string{
+		"unix:///tmp/ldcli.sock":  {"unix", "/tmp/ldcli.sock"},
+		"unix://ldcli.sock":       {"unix", "ldcli.sock"},
+		"tcp://127.0.0.1:9000":    {"tcp", "127.0.0.1:9000"},
+		"tcp://[::1]:9000":        {"tcp", "[::1]:9000"},
+		"unix://relative/to.sock": {"unix", "relative/to.sock"},
+	}
+	for address, expected := range valid {
+		network, addr, err := ParseListenAddress(address)
+		require.NoError(t, err, address)
+		assert.Equal(t, expected, [2]string{network, addr}, address)
+	}
+
+	for _, address := range []string{"/tmp/ldcli.sock", "unix://", "tcp://", "http://localhost:8765"} {
+		_, _, err := ParseListenAddress(address)
+		assert.Error(t, err, address)
+	}
+}
+
+func TestListenOnUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ldcli.sock")
+	address := "unix://" + path
+
+	listener, err := listen(address)
+	require.NoError(t, err)
+
+	t.Run("won't take over a socket that's in use", func(t *testing.T) {
+		_, err := listen(address)
+		assert.ErrorContains(t, err, "already listening")
+	})
+
+	t.Run("replaces a socket left behind", func(t *testing.T) {
+		// closing a unix listener normally removes its socket, as a crashed server wouldn't
+		listener.(*net.UnixListener).SetUnlinkOnClose(false)
+		require.NoError(t, listener.Close())
+
+		listener, err := listen(address)
+		require.NoError(t, err)
+		assert.NoError(t, listener.Close())
+	})
+
+	t.Run("won't replace files that aren't sockets", func(t *testing.T) {
+		other := filepath.Join(t.TempDir(), "not-a-socket")
+		require.NoError(t, os.WriteFile(other, nil, 0o600))
+		_, err := listen("unix://" + other)
+		assert.ErrorContains(t, err, "isn't a socket")
+	})
+}
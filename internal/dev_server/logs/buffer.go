@@ -0,0 +1,167 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+)
+
+type ctxKey string
+
+const ctxKeyBuffer = ctxKey("logs.Buffer")
+
+// Entry is a message the server logged, held in memory for inspection.
+type Entry struct {
+	ID         int64
+	Time       time.Time
+	Level      Level
+	ProjectKey string
+	Message    string
+}
+
+// Buffer is a rolling buffer of the most recent messages the server logged. Once full, the oldest messages are
+// dropped. It's an io.Writer so that it can be added to the standard logger's output.
+type Buffer struct {
+	mu       sync.Mutex
+	entries  []Entry
+	capacity int
+	nextID   int64
+}
+
+func NewBuffer(capacity int) *Buffer {
+	return &Buffer{
+		entries:  make([]Entry, 0, capacity),
+		capacity: capacity,
+		nextID:   1,
+	}
+}
+
+// logTimestampLength is the length of the date and time the standard logger prefixes messages with by default.
+const logTimestampLength = len("2006/01/02 15:04:05 ")
+
+// Write adds a message written to the standard logger at the info level, since its level and project aren't known.
+// The standard logger makes a single call per message.
+func (b *Buffer) Write(p []byte) (int, error) {
+	message := strings.TrimRight(string(p), "\n")
+	if len(message) >= logTimestampLength {
+		if _, err := time.Parse("2006/01/02 15:04:05 ", message[:logTimestampLength]); err == nil {
+			message = message[logTimestampLength:]
+		}
+	}
+	b.Add(Info, "", message)
+	return len(p), nil
+}
+
+func (b *Buffer) Add(level Level, projectKey string, message string) Entry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry := Entry{
+		ID:         b.nextID,
+		Time:       time.Now(),
+		Level:      level,
+		ProjectKey: projectKey,
+		Message:    message,
+	}
+	b.nextID++
+	if len(b.entries) >= b.capacity {
+		b.entries = append(b.entries[1:], entry)
+	} else {
+		b.entries = append(b.entries, entry)
+	}
+	return entry
+}
+
+// Query filters the messages returned from the buffer. Zero values match everything.
+type Query struct {
+	// AfterID pages through the messages: only messages logged after the one with this ID are returned.
+	AfterID int64
+	// Level is the least severe level to include.
+	Level      Level
+	ProjectKey string
+	Grep       *regexp.Regexp
+	Limit      int
+}
+
+// Query returns the buffered messages matching the query, oldest first, along with the ID to pass as AfterID to get
+// the messages after them. When there are more matching messages than the limit, the oldest ones after AfterID are
+// returned if it's set, so that following the log doesn't skip any, and otherwise the most recent ones are.
+func (b *Buffer) Query(query Query) ([]Entry, int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	matching := make([]Entry, 0)
+	next := query.AfterID
+	for _, entry := range b.entries {
+		if entry.ID <= query.AfterID {
+			continue
+		}
+		if query.AfterID > 0 && query.Limit > 0 && len(matching) == query.Limit {
+			break
+		}
+		next = entry.ID
+		if entry.Level < query.Level {
+			continue
+		}
+		if query.ProjectKey != "" && entry.ProjectKey != query.ProjectKey {
+			continue
+		}
+		if query.Grep != nil && !query.Grep.MatchString(entry.Message) {
+			continue
+		}
+		matching = append(matching, entry)
+	}
+	if query.Limit > 0 && len(matching) > query.Limit {
+		matching = matching[len(matching)-query.Limit:]
+	}
+	return matching, next
+}
+
+func ContextWithBuffer(ctx context.Context, buffer *Buffer) context.Context {
+	return context.WithValue(ctx, ctxKeyBuffer, buffer)
+}
+
+func BufferFromContext(ctx context.Context) *Buffer {
+	return ctx.Value(ctxKeyBuffer).(*Buffer)
+}
+
+func BufferMiddleware(buffer *Buffer) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithBuffer(r.Context(), buffer)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AccessLogMiddleware records each request the server handles in the buffer once it's done, about the project in its
+// route if it has one. Requests that succeed are recorded at the debug level, those with client errors at warn, and
+// those with server errors at error.
+func AccessLogMiddleware(buffer *Buffer) mux.MiddlewareFunc {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			metrics := httpsnoop.CaptureMetrics(handler, w, r)
+			level := Debug
+			switch {
+			case metrics.Code >= http.StatusInternalServerError:
+				level = Error
+			case metrics.Code >= http.StatusBadRequest:
+				level = Warn
+			}
+			buffer.Add(level, mux.Vars(r)["projectKey"], fmt.Sprintf(
+				"%s %s %d %dB %s",
+				r.Method,
+				r.URL.RequestURI(),
+				metrics.Code,
+				metrics.Written,
+				metrics.Duration.Round(time.Millisecond),
+			))
+		})
+	}
+}
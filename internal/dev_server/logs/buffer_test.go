@@ -0,0 +1,136 @@
+package logs_test
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+func TestBuffer(t *testing.T) {
+	t.Run("records messages from the standard logger at the info level", func(t *testing.T) {
+		buffer := logs.NewBuffer(10)
+		logger := log.New(buffer, "", log.LstdFlags)
+		logger.Printf("WARNING: project [my-proj] is orphaned")
+
+		entries, _ := buffer.Query(logs.Query{})
+		require.Len(t, entries, 1)
+		assert.Equal(t, "WARNING: project [my-proj] is orphaned", entries[0].Message)
+		assert.Equal(t, logs.Info, entries[0].Level)
+		assert.Equal(t, "", entries[0].ProjectKey)
+	})
+
+	t.Run("drops the oldest messages once full", func(t *testing.T) {
+		buffer := logs.NewBuffer(2)
+		buffer.Add(logs.Info, "", "one")
+		buffer.Add(logs.Info, "", "two")
+		buffer.Add(logs.Info, "", "three")
+
+		entries, _ := buffer.Query(logs.Query{})
+		require.Len(t, entries, 2)
+		assert.Equal(t, int64(2), entries[0].ID)
+		assert.Equal(t, int64(3), entries[1].ID)
+	})
+
+	t.Run("filters by id, level, project and pattern", func(t *testing.T) {
+		buffer := logs.NewBuffer(10)
+		buffer.Add(logs.Warn, "proj", "override is stale")
+		buffer.Add(logs.Warn, "other", "override is stale")
+		buffer.Add(logs.Info, "proj", "synced")
+		buffer.Add(logs.Warn, "proj", "something else")
+		buffer.Add(logs.Error, "proj", "override failed")
+
+		entries, next := buffer.Query(logs.Query{
+			AfterID:    1,
+			Level:      logs.Warn,
+			ProjectKey: "proj",
+			Grep:       regexp.MustCompile("override"),
+		})
+		require.Len(t, entries, 1)
+		assert.Equal(t, int64(5), entries[0].ID)
+		assert.Equal(t, int64(5), next)
+	})
+
+	t.Run("limit keeps the most recent matches", func(t *testing.T) {
+		buffer := logs.NewBuffer(10)
+		buffer.Add(logs.Info, "", "one")
+		buffer.Add(logs.Info, "", "two")
+		buffer.Add(logs.Info, "", "three")
+
+		entries, next := buffer.Query(logs.Query{Limit: 2})
+		require.Len(t, entries, 2)
+		assert.Equal(t, "two", entries[0].Message)
+		assert.Equal(t, "three", entries[1].Message)
+		assert.Equal(t, int64(3), next)
+	})
+
+	t.Run("pages forward from AfterID without skipping messages", func(t *testing.T) {
+		buffer := logs.NewBuffer(10)
+		for _, message := range []string{"one", "two", "three", "four", "five"} {
+			buffer.Add(logs.Info, "", message)
+		}
+
+		entries, next := buffer.Query(logs.Query{AfterID: 1, Limit: 2})
+		require.Len(t, entries, 2)
+		assert.Equal(t, "two", entries[0].Message)
+		assert.Equal(t, "three", entries[1].Message)
+
+		entries, next = buffer.Query(logs.Query{AfterID: next, Limit: 2})
+		require.Len(t, entries, 2)
+		assert.Equal(t, "four", entries[0].Message)
+		assert.Equal(t, "five", entries[1].Message)
+
+		entries, next = buffer.Query(logs.Query{AfterID: next, Limit: 2})
+		assert.Empty(t, entries)
+		assert.Equal(t, int64(5), next)
+	})
+
+	t.Run("next skips messages that were filtered out", func(t *testing.T) {
+		buffer := logs.NewBuffer(10)
+		buffer.Add(logs.Error, "", "failed")
+		buffer.Add(logs.Info, "", "fine")
+		buffer.Add(logs.Info, "", "also fine")
+
+		entries, next := buffer.Query(logs.Query{AfterID: 1, Level: logs.Error})
+		assert.Empty(t, entries)
+		assert.Equal(t, int64(3), next)
+	})
+}
+
+func TestAccessLogMiddleware(t *testing.T) {
+	buffer := logs.NewBuffer(10)
+	router := mux.NewRouter()
+	router.Use(logs.AccessLogMiddleware(buffer))
+	router.HandleFunc("/dev/projects/{projectKey}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	router.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/dev/projects/proj?expand=overrides", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	entries, _ := buffer.Query(logs.Query{})
+	require.Len(t, entries, 2)
+	assert.Equal(t, logs.Warn, entries[0].Level)
+	assert.Equal(t, "proj", entries[0].ProjectKey)
+	assert.Regexp(t, `^GET /dev/projects/proj\?expand=overrides 404 0B \S+$`, entries[0].Message)
+	assert.Equal(t, logs.Debug, entries[1].Level)
+	assert.Equal(t, "", entries[1].ProjectKey)
+	assert.Regexp(t, `^GET /status 200 0B`, entries[1].Message)
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := logs.ParseLevel("WARN")
+	require.NoError(t, err)
+	assert.Equal(t, logs.Warn, level)
+
+	_, err = logs.ParseLevel("loud")
+	assert.EqualError(t, err, `invalid log level "loud", must be one of debug, info, warn, error`)
+}
@@ -0,0 +1,69 @@
+// Package logs is how the dev server logs messages, so that they can be inspected with `GET /dev/logs` as well as on
+// the console. Messages are logged with their level and the project they're about, rather than leaving them to be
+// worked out from the text.
+package logs
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// Level is how severe a logged message is. Levels are ordered, so filtering on one includes every level above it.
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+func ParseLevel(s string) (Level, error) {
+	for i, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return Level(i), nil
+		}
+	}
+	return 0, errors.Errorf("invalid log level %q, must be one of %s", s, strings.Join(levelNames, ", "))
+}
+
+// capture is the buffer the server's messages are recorded in, along with the logger that writes them to the console.
+type capture struct {
+	buffer  *Buffer
+	console *log.Logger
+}
+
+var captured atomic.Pointer[capture]
+
+// Capture records every message the server logs in buffer from now on. Messages logged with Printf are recorded with
+// their level and project. Those written to the standard logger, by packages that don't use Printf, are recorded at
+// the info level without a project.
+func Capture(buffer *Buffer) {
+	console := log.Writer()
+	log.SetOutput(io.MultiWriter(console, buffer))
+	captured.Store(&capture{buffer: buffer, console: log.New(console, log.Prefix(), log.Flags())})
+}
+
+// Printf logs a message at the level, about the project with the key if it isn't empty. It's written to the standard
+// logger's output, and recorded in the buffer given to Capture.
+func Printf(level Level, projectKey string, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	c := captured.Load()
+	if c == nil {
+		_ = log.Output(2, message)
+		return
+	}
+	c.buffer.Add(level, projectKey, message)
+	_ = c.console.Output(2, message)
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/user"
+	"strings"
+)
+
+const ctxKeyActor = ctxKey("model.Actor")
+
+// ActorHeaderDefault is the request header HeaderActorResolver reads unless told otherwise.
+const ActorHeaderDefault = "X-LD-Actor"
+
+// ActorResolver identifies who made a request to the dev server, so that the changes it makes can be attributed in
+// history records. Embedders can implement it to plug in their own SSO identity. An empty actor means unknown.
+type ActorResolver interface {
+	ResolveActor(request *http.Request) string
+}
+
+// ActorResolverFunc adapts a function to an ActorResolver.
+type ActorResolverFunc func(request *http.Request) string
+
+func (f ActorResolverFunc) ResolveActor(request *http.Request) string {
+	return f(request)
+}
+
+// HeaderActorResolver uses the value of a request header, e.g. one set by an authenticating proxy in front of a shared
+// dev server.
+func HeaderActorResolver(header string) ActorResolver {
+	return ActorResolverFunc(func(request *http.Request) string {
+		return strings.TrimSpace(request.Header.Get(header))
+	})
+}
+
+// TokenActorResolver uses the name of the bearer token in the request's Authorization header. names maps each token to
+// the name it's attributed to; requests with other tokens are unknown.
+func TokenActorResolver(names map[string]string) ActorResolver {
+	return ActorResolverFunc(func(request *http.Request) string {
+		token := strings.TrimSpace(request.Header.Get("Authorization"))
+		token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+		if token == "" {
+			return ""
+		}
+		return names[token]
+	})
+}
+
+// OSUserActorResolver attributes every request to the OS user running the dev server, which is right when the server
+// is only used locally.
+func OSUserActorResolver() ActorResolver {
+	actor := CurrentOSUser()
+	return ActorResolverFunc(func(*http.Request) string {
+		return actor
+	})
+}
+
+// ChainActorResolvers tries each resolver in turn and uses the first actor found.
+func ChainActorResolvers(resolvers ...ActorResolver) ActorResolver {
+	return ActorResolverFunc(func(request *http.Request) string {
+		for _, resolver := range resolvers {
+			if actor := resolver.ResolveActor(request); actor != "" {
+				return actor
+			}
+		}
+		return ""
+	})
+}
+
+// CurrentOSUser returns user@hostname for the OS user running the dev server, or as much of it as can be determined.
+func CurrentOSUser() string {
+	username := os.Getenv("USER")
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		username = u.Username
+	}
+	hostname, _ := os.Hostname()
+	switch {
+	case username == "":
+		return hostname
+	case hostname == "":
+		return username
+	default:
+		return username + "@" + hostname
+	}
+}
+
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, ctxKeyActor, actor)
+}
+
+// ActorFromContext returns the actor changes made with ctx are attributed to, or an empty string if it's unknown.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(ctxKeyActor).(string)
+	return actor
+}
+
+func ActorMiddleware(resolver ActorResolver) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if resolver == nil {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			ctx := ContextWithActor(r.Context(), resolver.ResolveActor(r))
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
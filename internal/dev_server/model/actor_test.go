@@ -0,0 +1,55 @@
+package model_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestActorResolvers(t *testing.T) {
+	newRequest := func(headers map[string]string) *http.Request {
+		request := httptest.NewRequest(http.MethodPatch, "/dev/projects/proj/overrides/flag", nil)
+		for name, value := range headers {
+			request.Header.Set(name, value)
+		}
+		return request
+	}
+
+	t.Run("header resolver reads the header", func(t *testing.T) {
+		resolver := model.HeaderActorResolver(model.ActorHeaderDefault)
+		assert.Equal(t, "alice", resolver.ResolveActor(newRequest(map[string]string{model.ActorHeaderDefault: " alice "})))
+		assert.Equal(t, "", resolver.ResolveActor(newRequest(nil)))
+	})
+
+	t.Run("token resolver names known bearer tokens", func(t *testing.T) {
+		resolver := model.TokenActorResolver(map[string]string{"secret-1": "ci"})
+		assert.Equal(t, "ci", resolver.ResolveActor(newRequest(map[string]string{"Authorization": "Bearer secret-1"})))
+		assert.Equal(t, "ci", resolver.ResolveActor(newRequest(map[string]string{"Authorization": "secret-1"})))
+		assert.Equal(t, "", resolver.ResolveActor(newRequest(map[string]string{"Authorization": "Bearer other"})))
+		assert.Equal(t, "", resolver.ResolveActor(newRequest(nil)))
+	})
+
+	t.Run("chain uses the first actor found", func(t *testing.T) {
+		resolver := model.ChainActorResolvers(
+			model.HeaderActorResolver(model.ActorHeaderDefault),
+			model.ActorResolverFunc(func(*http.Request) string { return "fallback" }),
+		)
+		assert.Equal(t, "alice", resolver.ResolveActor(newRequest(map[string]string{model.ActorHeaderDefault: "alice"})))
+		assert.Equal(t, "fallback", resolver.ResolveActor(newRequest(nil)))
+	})
+
+	t.Run("middleware puts the actor on the request context", func(t *testing.T) {
+		var actor string
+		handler := model.ActorMiddleware(model.HeaderActorResolver(model.ActorHeaderDefault))(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actor = model.ActorFromContext(r.Context())
+			}),
+		)
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest(map[string]string{model.ActorHeaderDefault: "alice"}))
+		assert.Equal(t, "alice", actor)
+	})
+}
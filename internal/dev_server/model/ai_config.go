@@ -0,0 +1,147 @@
+package model
+
+import (
+	"context"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// aiConfigMetaKey is where AI SDKs find whether an AI Config is enabled and which of its variations they got.
+const aiConfigMetaKey = "_ldMeta"
+
+// AIConfigOverride overrides an AI Config with one of its variations, its model or prompt messages changed, or both.
+type AIConfigOverride struct {
+	// VariationKey picks the variation to serve. The AI Config's current value is used if it's empty.
+	VariationKey string
+	// Model replaces the model's name and parameters if it's set.
+	Model *ldvalue.Value
+	// Messages replace the prompt messages if they're set.
+	Messages []adapters.AIConfigMessage
+}
+
+// aiConfigVariations returns the variations of the AI Configs the project includes, valued the way AI SDKs are
+// served them, along with their metadata.
+func (project Project) aiConfigVariations(ctx context.Context) ([]FlagVariation, map[string]FlagMetadata, error) {
+	configs, err := adapters.GetApi(ctx).GetAllAIConfigs(ctx, project.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	var variations []FlagVariation
+	metadata := make(map[string]FlagMetadata)
+	for _, config := range configs {
+		if !project.FlagFilter.Includes(config.Key, config.Tags) {
+			continue
+		}
+		metadata[config.Key] = FlagMetadata{
+			Name:        config.Name,
+			Description: config.Description,
+			Tags:        lo.Ternary(len(config.Tags) > 0, config.Tags, nil),
+			AIConfig:    true,
+		}
+		for _, variation := range config.Variations {
+			name := variation.Name
+			variations = append(variations, FlagVariation{
+				FlagKey: config.Key,
+				Variation: Variation{
+					Id:    variation.Key,
+					Name:  &name,
+					Value: aiConfigValue(variation),
+				},
+			})
+		}
+	}
+	return variations, metadata, nil
+}
+
+// aiConfigValue is what AI SDKs are served for an AI Config's variation.
+func aiConfigValue(variation adapters.AIConfigVariation) ldvalue.Value {
+	value := ldvalue.ObjectBuild().
+		Set(aiConfigMetaKey, ldvalue.ObjectBuild().
+			Set("enabled", ldvalue.Bool(true)).
+			Set("variationKey", ldvalue.String(variation.Key)).
+			Set("version", ldvalue.Int(variation.Version)).
+			Build())
+	if variation.Model != nil {
+		value.Set("model", ldvalue.CopyArbitraryValue(variation.Model))
+	}
+	if variation.Messages != nil {
+		value.Set("messages", aiConfigMessagesValue(variation.Messages))
+	}
+	if variation.Provider != nil {
+		value.Set("provider", ldvalue.ObjectBuild().Set("name", ldvalue.String(variation.Provider.Name)).Build())
+	}
+	return value.Build()
+}
+
+func aiConfigMessagesValue(messages []adapters.AIConfigMessage) ldvalue.Value {
+	array := ldvalue.ArrayBuild()
+	for _, message := range messages {
+		array.Add(ldvalue.ObjectBuild().
+			Set("role", ldvalue.String(message.Role)).
+			Set("content", ldvalue.String(message.Content)).
+			Build())
+	}
+	return array.Build()
+}
+
+// disabledAIConfigValue is served for AI Configs that LaunchDarkly didn't evaluate, so that AI SDKs use their
+// defaults for them until they're overridden.
+func disabledAIConfigValue() ldvalue.Value {
+	return ldvalue.ObjectBuild().
+		Set(aiConfigMetaKey, ldvalue.ObjectBuild().Set("enabled", ldvalue.Bool(false)).Build()).
+		Build()
+}
+
+// OverrideAIConfig overrides an AI Config, so that AI SDKs are served a different model or prompt.
+func OverrideAIConfig(ctx context.Context, projectKey, configKey string, override AIConfigOverride) (Override, error) {
+	project, err := getProjectWithFlag(ctx, projectKey, configKey)
+	if err != nil {
+		return Override{}, err
+	}
+	if !project.FlagMetadata[configKey].AIConfig {
+		return Override{}, NewErrNotFound("AI Config", configKey)
+	}
+
+	base := project.AllFlagsState[configKey].Value
+	if override.VariationKey != "" {
+		availableVariations, err := StoreFromContext(ctx).GetAvailableVariationsForProject(ctx, projectKey)
+		if err != nil {
+			return Override{}, err
+		}
+		variation, ok := lo.Find(availableVariations[configKey], func(variation Variation) bool {
+			return variation.Id == override.VariationKey
+		})
+		if !ok {
+			return Override{}, errors.WithStack(NewErrNotFound("AI Config variation", override.VariationKey))
+		}
+		base = variation.Value
+	}
+
+	value := ldvalue.ObjectBuildWithCapacity(base.Count() + 2)
+	for key, field := range base.AsValueMap().AsMap() {
+		value.Set(key, field)
+	}
+	meta := ldvalue.ObjectBuild()
+	for key, field := range base.GetByKey(aiConfigMetaKey).AsValueMap().AsMap() {
+		meta.Set(key, field)
+	}
+	value.Set(aiConfigMetaKey, meta.Set("enabled", ldvalue.Bool(true)).Build())
+	if override.Model != nil {
+		value.Set("model", *override.Model)
+	}
+	if override.Messages != nil {
+		value.Set("messages", aiConfigMessagesValue(override.Messages))
+	}
+
+	result, err := UpsertOverride(ctx, projectKey, configKey, value.Build())
+	if err != nil {
+		return Override{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Overrode AI Config [%s] in project [%s]", configKey, projectKey)
+	return result, nil
+}
@@ -0,0 +1,87 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestAIConfigs(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	evaluated := ldvalue.Parse([]byte(`{"_ldMeta":{"enabled":true,"variationKey":"fast","version":2},"model":{"name":"small"},"messages":[{"role":"system","content":"Be brief"}]}`))
+	api.EXPECT().GetSdkKey(gomock.Any(), "proj", "env").Return("sdk-key", nil)
+	sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-key").Return(flagstate.NewAllFlagsBuilder().
+		AddFlag("assistant", flagstate.FlagState{Value: evaluated, Version: 4}).
+		Build(), nil)
+	api.EXPECT().GetAllFlags(gomock.Any(), "proj", gomock.Any()).Return(nil, nil)
+	api.EXPECT().GetContextKinds(gomock.Any(), "proj").Return(nil, nil)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), "proj").Return([]adapters.AIConfig{
+		{
+			Key:  "assistant",
+			Name: "Assistant",
+			Tags: []string{"ai"},
+			Variations: []adapters.AIConfigVariation{
+				{Key: "fast", Name: "Fast", Version: 2, Model: map[string]any{"name": "small"}, Messages: []adapters.AIConfigMessage{{Role: "system", Content: "Be brief"}}},
+				{Key: "smart", Name: "Smart", Version: 1, Model: map[string]any{"name": "large"}, Provider: &adapters.AIConfigProvider{Name: "openai"}},
+			},
+		},
+		{Key: "unevaluated", Name: "Unevaluated"},
+	}, nil)
+
+	project, err := model.CreateProject(ctx, "proj", "env", nil, model.FlagFilter{})
+	require.NoError(t, err)
+
+	t.Run("syncs AI Configs with their variations", func(t *testing.T) {
+		assert.Equal(t, model.FlagMetadata{Name: "Assistant", Tags: []string{"ai"}, AIConfig: true}, project.FlagMetadata["assistant"])
+		variations := lo.Filter(project.AvailableVariations, func(variation model.FlagVariation, _ int) bool {
+			return variation.FlagKey == "assistant"
+		})
+		require.Len(t, variations, 2)
+		assert.Equal(t, "fast", variations[0].Id)
+		assert.JSONEq(t, evaluated.JSONString(), variations[0].Value.JSONString())
+		assert.JSONEq(t, `{"_ldMeta":{"enabled":true,"variationKey":"smart","version":1},"model":{"name":"large"},"provider":{"name":"openai"}}`, variations[1].Value.JSONString())
+	})
+
+	t.Run("serves AI Configs LaunchDarkly didn't evaluate as disabled", func(t *testing.T) {
+		assert.JSONEq(t, `{"_ldMeta":{"enabled":false}}`, project.AllFlagsState["unevaluated"].Value.JSONString())
+	})
+
+	t.Run("overrides an AI Config with another variation", func(t *testing.T) {
+		override, err := model.OverrideAIConfig(ctx, "proj", "assistant", model.AIConfigOverride{VariationKey: "smart"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"_ldMeta":{"enabled":true,"variationKey":"smart","version":1},"model":{"name":"large"},"provider":{"name":"openai"}}`, override.Value.JSONString())
+	})
+
+	t.Run("overrides an AI Config's model and prompt", func(t *testing.T) {
+		modelValue := ldvalue.Parse([]byte(`{"name":"local","parameters":{"temperature":0}}`))
+		override, err := model.OverrideAIConfig(ctx, "proj", "unevaluated", model.AIConfigOverride{
+			Model:    &modelValue,
+			Messages: []adapters.AIConfigMessage{{Role: "user", Content: "Hi"}},
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"_ldMeta":{"enabled":true},"model":{"name":"local","parameters":{"temperature":0}},"messages":[{"role":"user","content":"Hi"}]}`, override.Value.JSONString())
+	})
+
+	t.Run("returns ErrNotFound for variations the AI Config doesn't have", func(t *testing.T) {
+		_, err := model.OverrideAIConfig(ctx, "proj", "assistant", model.AIConfigOverride{VariationKey: "nope"})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
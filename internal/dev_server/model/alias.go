@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Alias maps a credential that an SDK sends, such as a production mobile key or client-side ID, to a dev project.
+type Alias struct {
+	Alias      string
+	ProjectKey string
+}
+
+// CreateAlias points the alias at the project, replacing whatever it pointed at before. ErrNotFound is returned if the
+// project doesn't exist.
+func CreateAlias(ctx context.Context, alias Alias) error {
+	store := StoreFromContext(ctx)
+	_, err := store.GetDevProject(ctx, alias.ProjectKey)
+	if err != nil {
+		return err
+	}
+	return store.UpsertAlias(ctx, alias)
+}
+
+// ResolveProjectKey returns the project key that an SDK credential refers to. If no alias exists for the credential,
+// it's assumed to be a project key.
+func ResolveProjectKey(ctx context.Context, credential string) (string, error) {
+	alias, err := StoreFromContext(ctx).GetAlias(ctx, credential)
+	if err != nil {
+		if errors.As(err, &ErrNotFound{}) {
+			return credential, nil
+		}
+		return "", err
+	}
+	return alias.ProjectKey, nil
+}
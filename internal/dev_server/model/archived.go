@@ -0,0 +1,105 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// ErrArchived is returned instead of syncing or serving an archived project.
+type ErrArchived struct {
+	projectKey string
+	since      time.Time
+}
+
+func NewErrArchived(projectKey string, since time.Time) ErrArchived {
+	return ErrArchived{projectKey: projectKey, since: since}
+}
+
+func (e ErrArchived) Error() string {
+	return fmt.Sprintf("project %s is archived since %s. Unarchive it to use it again", e.projectKey, e.since.Format(time.RFC3339))
+}
+
+// ErrNotArchived is returned when purging a project that hasn't been archived first.
+type ErrNotArchived struct {
+	projectKey string
+}
+
+func (e ErrNotArchived) Error() string {
+	return fmt.Sprintf("project %s must be archived before it can be purged", e.projectKey)
+}
+
+// ArchiveProject soft deletes the project: it stops syncing and being served to SDKs, but keeps its overrides and
+// history so that it can be unarchived.
+func ArchiveProject(ctx context.Context, projectKey string) error {
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+	if project.ArchivedAt != nil {
+		return nil
+	}
+	now := time.Now()
+	if err := setArchivedAt(ctx, projectKey, &now); err != nil {
+		return err
+	}
+	logs.Printf(logs.Info, projectKey, "Archived project [%s]", projectKey)
+	return nil
+}
+
+// UnarchiveProject makes an archived project available again. It isn't synced until it's next updated.
+func UnarchiveProject(ctx context.Context, projectKey string) error {
+	if err := setArchivedAt(ctx, projectKey, nil); err != nil {
+		return err
+	}
+	logs.Printf(logs.Info, projectKey, "Unarchived project [%s]", projectKey)
+	return nil
+}
+
+func setArchivedAt(ctx context.Context, projectKey string, archivedAt *time.Time) error {
+	updated, err := StoreFromContext(ctx).ArchiveProject(ctx, projectKey, archivedAt)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return errors.WithStack(NewErrNotFound("project", projectKey))
+	}
+	return nil
+}
+
+// PurgeProject permanently deletes an archived project along with its overrides and history. Projects with linked
+// clones can't be purged.
+func PurgeProject(ctx context.Context, projectKey string) (ProjectDeletion, error) {
+	var deletion ProjectDeletion
+	err := withTx(ctx, func(ctx context.Context) error {
+		store := StoreFromContext(ctx)
+		project, err := store.GetDevProject(ctx, projectKey)
+		if err != nil {
+			return err
+		}
+		if project.ArchivedAt == nil {
+			return errors.WithStack(ErrNotArchived{projectKey: projectKey})
+		}
+		if err := project.checkNoLinkedClones(); err != nil {
+			return err
+		}
+		var deleted bool
+		deletion, deleted, err = store.DeleteDevProject(ctx, projectKey)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return errors.WithStack(NewErrNotFound("project", projectKey))
+		}
+		return nil
+	})
+	if err != nil {
+		return ProjectDeletion{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Purged project [%s]", projectKey)
+	return deletion, nil
+}
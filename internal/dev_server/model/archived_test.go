@@ -0,0 +1,89 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestArchiveProject(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, _, _ = adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	archivedAt := time.Now()
+
+	t.Run("archives the project", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj"}, nil)
+		store.EXPECT().ArchiveProject(gomock.Any(), "proj", gomock.Not(gomock.Nil())).Return(true, nil)
+
+		assert.NoError(t, model.ArchiveProject(ctx, "proj"))
+	})
+
+	t.Run("leaves an archived project alone", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj", ArchivedAt: &archivedAt}, nil)
+
+		assert.NoError(t, model.ArchiveProject(ctx, "proj"))
+	})
+
+	t.Run("unarchives the project", func(t *testing.T) {
+		store.EXPECT().ArchiveProject(gomock.Any(), "proj", nil).Return(true, nil)
+
+		assert.NoError(t, model.UnarchiveProject(ctx, "proj"))
+	})
+
+	t.Run("returns ErrNotFound when unarchiving a missing project", func(t *testing.T) {
+		store.EXPECT().ArchiveProject(gomock.Any(), "nope", nil).Return(false, nil)
+
+		err := model.UnarchiveProject(ctx, "nope")
+		assert.True(t, errors.As(err, &model.ErrNotFound{}))
+	})
+
+	t.Run("doesn't sync an archived project", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj", ArchivedAt: &archivedAt}, nil)
+
+		_, err := model.UpdateProject(ctx, "proj", nil, nil, nil)
+		assert.True(t, errors.As(err, &model.ErrArchived{}))
+	})
+}
+
+func TestPurgeProject(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
+	ctx = model.ContextWithStore(ctx, store)
+	archivedAt := time.Now()
+
+	t.Run("purges an archived project", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj", ArchivedAt: &archivedAt}, nil)
+		store.EXPECT().DeleteDevProject(gomock.Any(), "proj").Return(model.ProjectDeletion{Overrides: 2}, true, nil)
+
+		deletion, err := model.PurgeProject(ctx, "proj")
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProjectDeletion{Overrides: 2}, deletion)
+	})
+
+	t.Run("requires the project to be archived first", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj"}, nil)
+
+		_, err := model.PurgeProject(ctx, "proj")
+		assert.True(t, errors.As(err, &model.ErrNotArchived{}))
+	})
+
+	t.Run("doesn't purge a project with linked clones", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj", ArchivedAt: &archivedAt, LinkedCloneKeys: []string{"clone"}}, nil)
+
+		_, err := model.PurgeProject(ctx, "proj")
+		assert.True(t, errors.As(err, &model.ErrHasLinkedClones{}))
+	})
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+// AuditEntry is a change to one of a project's overrides, attributed to whoever made it.
+type AuditEntry struct {
+	Layer   OverrideLayer
+	FlagKey string
+	Value   ldvalue.Value
+	Active  bool
+	Version int
+	// Locked is set on changes that locked or unlocked the override, to whether it was locked.
+	Locked *bool
+	// Actor is who made the change. It's empty if they couldn't be identified.
+	Actor      string
+	RecordedAt time.Time
+}
+
+// AuditQuery narrows down which audit entries are returned. Zero values don't filter.
+type AuditQuery struct {
+	FlagKey string
+	Since   time.Time
+	Limit   int
+}
+
+// GetAuditLog returns the changes to the project's overrides that match query, most recent first.
+func GetAuditLog(ctx context.Context, projectKey string, query AuditQuery) ([]AuditEntry, error) {
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, projectKey); err != nil {
+		return nil, err
+	}
+	return store.GetAuditLog(ctx, projectKey, query)
+}
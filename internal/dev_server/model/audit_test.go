@@ -0,0 +1,37 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestGetAuditLog(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+	query := model.AuditQuery{FlagKey: "flg", Limit: 10}
+
+	t.Run("returns the project's audit entries", func(t *testing.T) {
+		entries := []model.AuditEntry{{FlagKey: "flg", Actor: "alice", Active: true}}
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj"}, nil)
+		store.EXPECT().GetAuditLog(gomock.Any(), "proj", query).Return(entries, nil)
+
+		result, err := model.GetAuditLog(ctx, "proj", query)
+		assert.NoError(t, err)
+		assert.Equal(t, entries, result)
+	})
+
+	t.Run("returns ErrNotFound for a missing project", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "nope").Return(nil, model.NewErrNotFound("project", "nope"))
+
+		_, err := model.GetAuditLog(ctx, "nope", query)
+		assert.True(t, errors.As(err, &model.ErrNotFound{}))
+	})
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// AutoConfig keeps a dev server project for each LaunchDarkly project a Relay Proxy auto-configuration key has access
+// to, so that large orgs don't have to add dozens of projects by hand. Each project is sourced from one of its
+// environments: the preferred one if the key has access to it, otherwise the first by key. Projects are synced again
+// whenever their source environment changes, and are left in place if the key loses access to them.
+type AutoConfig struct {
+	preferredEnvironmentKey string
+
+	mu sync.Mutex
+	// synced is the source environment each project was last synced from.
+	synced map[string]adapters.AutoConfigEnvironment
+}
+
+func NewAutoConfig(preferredEnvironmentKey string) *AutoConfig {
+	return &AutoConfig{
+		preferredEnvironmentKey: preferredEnvironmentKey,
+		synced:                  make(map[string]adapters.AutoConfigEnvironment),
+	}
+}
+
+// Apply creates or syncs projects for the environments the auto-configuration key currently has access to. A project
+// that fails to sync is logged and tried again the next time the environments change.
+func (a *AutoConfig) Apply(ctx context.Context, environments adapters.AutoConfigEnvironments) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	sources := a.sources(environments)
+	for projectKey := range a.synced {
+		if _, ok := sources[projectKey]; !ok {
+			logs.Printf(logs.Warn, projectKey, "Auto-config no longer has access to project [%s]; leaving it in place", projectKey)
+			delete(a.synced, projectKey)
+		}
+	}
+	for projectKey, env := range sources {
+		if synced, ok := a.synced[projectKey]; ok && synced == env {
+			continue
+		}
+		if err := syncAutoConfigProject(ctx, projectKey, env.EnvKey); err != nil {
+			logs.Printf(logs.Error, projectKey, "Unable to sync auto-config project [%s] from env [%s]: %s", projectKey, env.EnvKey, err)
+			continue
+		}
+		a.synced[projectKey] = env
+	}
+}
+
+// sources picks the source environment for each project.
+func (a *AutoConfig) sources(environments adapters.AutoConfigEnvironments) map[string]adapters.AutoConfigEnvironment {
+	envIDs := make([]string, 0, len(environments))
+	for envID := range environments {
+		envIDs = append(envIDs, envID)
+	}
+	// iterate in a stable order so the same environments always pick the same sources
+	sort.Slice(envIDs, func(i, j int) bool {
+		return environments[envIDs[i]].EnvKey < environments[envIDs[j]].EnvKey
+	})
+
+	sources := make(map[string]adapters.AutoConfigEnvironment)
+	for _, envID := range envIDs {
+		env := environments[envID]
+		current, ok := sources[env.ProjKey]
+		if !ok || (env.EnvKey == a.preferredEnvironmentKey && current.EnvKey != a.preferredEnvironmentKey) {
+			sources[env.ProjKey] = env
+		}
+	}
+	return sources
+}
+
+func syncAutoConfigProject(ctx context.Context, projectKey, envKey string) error {
+	store := StoreFromContext(ctx)
+	_, err := store.GetDevProject(ctx, projectKey)
+	switch {
+	case errors.As(err, &ErrNotFound{}):
+		if _, err := CreateProject(ctx, projectKey, envKey, nil, FlagFilter{}); err != nil {
+			return err
+		}
+		logs.Printf(logs.Info, projectKey, "Auto-config created project [%s] from env [%s]", projectKey, envKey)
+	case err != nil:
+		return err
+	default:
+		if _, err := UpdateProject(ctx, projectKey, nil, &envKey, nil); err != nil {
+			return err
+		}
+		logs.Printf(logs.Info, projectKey, "Auto-config synced project [%s] from env [%s]", projectKey, envKey)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestAutoConfig(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	allFlagsState := flagstate.NewAllFlagsBuilder().Build()
+	expectSync := func(projectKey, envKey string) {
+		api.EXPECT().GetSdkKey(gomock.Any(), projectKey, envKey).Return(projectKey+"-"+envKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), projectKey+"-"+envKey).Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projectKey, gomock.Any()).Return(nil, nil)
+	}
+
+	environments := adapters.AutoConfigEnvironments{
+		"a-test":       {EnvID: "a-test", EnvKey: "test", ProjKey: "proj-a", Version: 1},
+		"a-production": {EnvID: "a-production", EnvKey: "production", ProjKey: "proj-a", Version: 1},
+		"b-test":       {EnvID: "b-test", EnvKey: "test", ProjKey: "proj-b", Version: 1},
+		"b-staging":    {EnvID: "b-staging", EnvKey: "staging", ProjKey: "proj-b", Version: 1},
+	}
+	autoConfig := model.NewAutoConfig("production")
+
+	t.Run("creates missing projects and syncs existing ones", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj-a").Return(nil, model.NewErrNotFound("project", "proj-a"))
+		expectSync("proj-a", "production")
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		// without the preferred environment, proj-b is sourced from its first environment by key
+		existing := model.Project{Key: "proj-b", SourceEnvironmentKey: "test"}
+		store.EXPECT().GetDevProject(gomock.Any(), "proj-b").Return(&existing, nil).Times(2)
+		expectSync("proj-b", "staging")
+		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().SetSyncStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "proj-b").Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj-b").Return(nil, nil)
+
+		autoConfig.Apply(ctx, environments)
+	})
+
+	t.Run("doesn't sync projects whose source is unchanged", func(t *testing.T) {
+		environments["a-test"] = adapters.AutoConfigEnvironment{EnvID: "a-test", EnvKey: "test", ProjKey: "proj-a", Version: 2}
+
+		autoConfig.Apply(ctx, environments)
+	})
+
+	t.Run("syncs a project again when its source changes", func(t *testing.T) {
+		environments["b-staging"] = adapters.AutoConfigEnvironment{EnvID: "b-staging", EnvKey: "staging", ProjKey: "proj-b", Version: 2}
+		existing := model.Project{Key: "proj-b", SourceEnvironmentKey: "staging"}
+		store.EXPECT().GetDevProject(gomock.Any(), "proj-b").Return(&existing, nil).Times(2)
+		expectSync("proj-b", "staging")
+		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().SetSyncStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "proj-b").Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj-b").Return(nil, nil)
+
+		autoConfig.Apply(ctx, environments)
+	})
+
+	t.Run("leaves projects the key loses access to alone", func(t *testing.T) {
+		delete(environments, "b-test")
+		delete(environments, "b-staging")
+
+		autoConfig.Apply(ctx, environments)
+	})
+}
@@ -0,0 +1,220 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const ctxKeyProjectAutoCreator = ctxKey("model.ProjectAutoCreator")
+
+// autoCreateReindexAfter is how long the credentials found by looking through every project in LaunchDarkly are reused
+// for, so SDKs connecting with new or unknown credentials don't each start another look.
+const autoCreateReindexAfter = time.Minute
+
+var clientSideIdPattern = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
+// ProjectAutoCreator creates a project the first time an SDK connects with the SDK key, mobile key, or client-side ID
+// of a LaunchDarkly environment the dev server has no project for, so that pointing a new service at the dev server is
+// all the setup it needs. The project is sourced from that environment, and the credential becomes an alias for it. A
+// nil *ProjectAutoCreator never creates projects.
+type ProjectAutoCreator struct {
+	// indexing is held while looking through LaunchDarkly, so SDKs that connect at the same time share one look.
+	indexing sync.Mutex
+
+	mu sync.Mutex
+	// index maps every credential seen when LaunchDarkly was last looked through, at indexedAt, to its environment.
+	index     map[string]environmentRef
+	indexedAt time.Time
+	// creating holds a lock for each project, held while it's created so SDKs that connect at the same time create it
+	// once.
+	creating map[string]*sync.Mutex
+}
+
+// environmentRef is a LaunchDarkly environment a credential is for.
+type environmentRef struct {
+	projectKey     string
+	environmentKey string
+}
+
+func NewProjectAutoCreator() *ProjectAutoCreator {
+	return &ProjectAutoCreator{creating: make(map[string]*sync.Mutex)}
+}
+
+// ResolveProjectKey is like the function of the same name, but first creates a project for the credential if it's for
+// an environment with none. Failing to find or create one isn't an error, since whoever asked for the project reports
+// that it's missing; it's logged instead.
+func (a *ProjectAutoCreator) ResolveProjectKey(ctx context.Context, credential string) (string, error) {
+	projectKey, err := ResolveProjectKey(ctx, credential)
+	if err != nil || a == nil || !looksLikeEnvironmentCredential(credential) {
+		return projectKey, err
+	}
+	if exists, err := projectExists(ctx, projectKey); err != nil || exists {
+		return projectKey, err
+	}
+
+	// the SDK disconnecting shouldn't leave the project half created
+	ctx = context.WithoutCancel(ctx)
+	environment, ok := a.findEnvironment(ctx, credential)
+	if !ok {
+		return projectKey, nil
+	}
+
+	creating := a.creatingLock(environment.projectKey)
+	creating.Lock()
+	defer creating.Unlock()
+	// another SDK may have created it while this one waited
+	projectKey, err = ResolveProjectKey(ctx, credential)
+	if err != nil {
+		return "", err
+	}
+	if exists, err := projectExists(ctx, projectKey); err != nil || exists {
+		return projectKey, err
+	}
+	if err := autoCreateProject(ctx, credential, environment); err != nil {
+		logs.Printf(logs.Error, "", "Unable to auto-create a project for an SDK credential: %+v", err)
+		return projectKey, nil
+	}
+	return environment.projectKey, nil
+}
+
+// findEnvironment returns the environment the credential is for, looking through LaunchDarkly again if it hasn't been
+// for a while.
+func (a *ProjectAutoCreator) findEnvironment(ctx context.Context, credential string) (environmentRef, bool) {
+	if environment, ok, fresh := a.lookUp(credential); fresh {
+		return environment, ok
+	}
+	a.indexing.Lock()
+	defer a.indexing.Unlock()
+	// another SDK may have looked while this one waited
+	if environment, ok, fresh := a.lookUp(credential); fresh {
+		return environment, ok
+	}
+
+	index, err := indexEnvironmentCredentials(ctx)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err != nil {
+		// keep the credentials that were found before, and don't look again until it's time to
+		logs.Printf(logs.Error, "", "Unable to look up SDK credentials to auto-create projects: %+v", err)
+	} else {
+		a.index = index
+	}
+	a.indexedAt = time.Now()
+	environment, ok := a.index[credential]
+	return environment, ok
+}
+
+// lookUp returns the credential's environment from the index, and whether the index is recent enough to go by.
+func (a *ProjectAutoCreator) lookUp(credential string) (environment environmentRef, ok bool, fresh bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	environment, ok = a.index[credential]
+	return environment, ok, time.Since(a.indexedAt) < autoCreateReindexAfter
+}
+
+func (a *ProjectAutoCreator) creatingLock(projectKey string) *sync.Mutex {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	lock, ok := a.creating[projectKey]
+	if !ok {
+		lock = &sync.Mutex{}
+		a.creating[projectKey] = lock
+	}
+	return lock
+}
+
+// autoCreateProject creates a project sourced from the environment, unless there already is one, and makes the
+// credential an alias for it.
+func autoCreateProject(ctx context.Context, credential string, environment environmentRef) error {
+	projectKey, environmentKey := environment.projectKey, environment.environmentKey
+	exists, err := projectExists(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+	if exists {
+		// the project was already added from another environment; the credential still selects it
+		logs.Printf(logs.Warn, projectKey, "An SDK connected with a credential for env [%s] of project [%s], which is already sourced from another environment", environmentKey, projectKey)
+	} else {
+		if _, err := CreateProject(ctx, projectKey, environmentKey, nil, FlagFilter{}); err != nil {
+			return errors.Wrapf(err, "unable to auto-create project %s", projectKey)
+		}
+		logs.Printf(logs.Info, projectKey, "Auto-created project [%s] from env [%s] for a new SDK connection", projectKey, environmentKey)
+	}
+	return CreateAlias(ctx, Alias{Alias: credential, ProjectKey: projectKey})
+}
+
+// indexEnvironmentCredentials maps the SDK key, mobile key, and client-side ID of every environment the access token
+// can see to the environment.
+func indexEnvironmentCredentials(ctx context.Context) (map[string]environmentRef, error) {
+	api := adapters.GetApi(ctx)
+	projects, err := api.GetAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]environmentRef)
+	for _, project := range projects {
+		environments := project.GetEnvironments()
+		items := environments.Items
+		if environments.TotalCount != nil && int(*environments.TotalCount) > len(items) {
+			items, err = api.GetAllEnvironments(ctx, project.Key)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, environment := range items {
+			ref := environmentRef{projectKey: project.Key, environmentKey: environment.Key}
+			for _, credential := range []string{environment.ApiKey, environment.MobileKey, environment.Id} {
+				if credential != "" {
+					index[credential] = ref
+				}
+			}
+		}
+	}
+	return index, nil
+}
+
+// looksLikeEnvironmentCredential is true for credentials in the format of an SDK key, mobile key, or client-side ID,
+// so placeholders and mistyped project keys aren't looked up in LaunchDarkly.
+func looksLikeEnvironmentCredential(credential string) bool {
+	return strings.HasPrefix(credential, "sdk-") || strings.HasPrefix(credential, "mob-") || clientSideIdPattern.MatchString(credential)
+}
+
+func projectExists(ctx context.Context, projectKey string) (bool, error) {
+	_, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	switch {
+	case errors.As(err, &ErrNotFound{}):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+func ContextWithProjectAutoCreator(ctx context.Context, autoCreator *ProjectAutoCreator) context.Context {
+	return context.WithValue(ctx, ctxKeyProjectAutoCreator, autoCreator)
+}
+
+// ProjectAutoCreatorFromContext returns the project auto-creator, or nil if projects aren't auto-created.
+func ProjectAutoCreatorFromContext(ctx context.Context) *ProjectAutoCreator {
+	autoCreator, _ := ctx.Value(ctxKeyProjectAutoCreator).(*ProjectAutoCreator)
+	return autoCreator
+}
+
+func ProjectAutoCreatorMiddleware(autoCreator *ProjectAutoCreator) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithProjectAutoCreator(r.Context(), autoCreator)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
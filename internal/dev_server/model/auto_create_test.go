@@ -0,0 +1,104 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestProjectAutoCreator(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	total := int32(3)
+	projects := []ldapi.Project{
+		{Key: "other", Environments: &ldapi.Environments{Items: []ldapi.Environment{
+			{Key: "test", ApiKey: "sdk-other-test", MobileKey: "mob-other-test", Id: "000000000000000000000000"},
+		}}},
+		{Key: "proj", Environments: &ldapi.Environments{TotalCount: &total, Items: []ldapi.Environment{
+			{Key: "production", ApiKey: "sdk-proj-production"},
+		}}},
+	}
+	// proj has more environments than fit on the first page
+	allProjEnvironments := []ldapi.Environment{
+		{Key: "production", ApiKey: "sdk-proj-production"},
+		{Key: "staging", ApiKey: "sdk-proj-staging"},
+		{Key: "test", ApiKey: "sdk-proj-test", Id: "0123456789abcdef01234567"},
+	}
+	autoCreator := model.NewProjectAutoCreator()
+
+	t.Run("creates a project for a new environment's credential", func(t *testing.T) {
+		api.EXPECT().GetAllProjects(gomock.Any()).Return(projects, nil)
+		api.EXPECT().GetAllEnvironments(gomock.Any(), "proj").Return(allProjEnvironments, nil)
+		api.EXPECT().GetSdkKey(gomock.Any(), "proj", "test").Return("sdk-proj-test", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-proj-test").Return(flagstate.NewAllFlagsBuilder().Build(), nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), "proj", gomock.Any()).Return(nil, nil)
+
+		projectKey, err := autoCreator.ResolveProjectKey(ctx, "0123456789abcdef01234567")
+		require.NoError(t, err)
+		assert.Equal(t, "proj", projectKey)
+
+		project, err := store.GetDevProject(ctx, "proj")
+		require.NoError(t, err)
+		assert.Equal(t, "test", project.SourceEnvironmentKey)
+	})
+
+	t.Run("uses the created project for the credential from then on", func(t *testing.T) {
+		projectKey, err := autoCreator.ResolveProjectKey(ctx, "0123456789abcdef01234567")
+		require.NoError(t, err)
+		assert.Equal(t, "proj", projectKey)
+	})
+
+	t.Run("maps credentials for other environments of the same project to it", func(t *testing.T) {
+		projectKey, err := autoCreator.ResolveProjectKey(ctx, "sdk-proj-staging")
+		require.NoError(t, err)
+		assert.Equal(t, "proj", projectKey)
+	})
+
+	t.Run("creates projects for other credentials without looking through LaunchDarkly again", func(t *testing.T) {
+		api.EXPECT().GetSdkKey(gomock.Any(), "other", "test").Return("sdk-other-test", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-other-test").Return(flagstate.NewAllFlagsBuilder().Build(), nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), "other", gomock.Any()).Return(nil, nil)
+
+		projectKey, err := autoCreator.ResolveProjectKey(ctx, "mob-other-test")
+		require.NoError(t, err)
+		assert.Equal(t, "other", projectKey)
+	})
+
+	t.Run("doesn't look up unknown credentials again right away", func(t *testing.T) {
+		for range 2 {
+			projectKey, err := autoCreator.ResolveProjectKey(ctx, "sdk-unknown")
+			require.NoError(t, err)
+			assert.Equal(t, "sdk-unknown", projectKey)
+		}
+	})
+
+	t.Run("doesn't look up placeholders", func(t *testing.T) {
+		projectKey, err := autoCreator.ResolveProjectKey(ctx, "local-dev-key-frontend")
+		require.NoError(t, err)
+		assert.Equal(t, "local-dev-key-frontend", projectKey)
+	})
+
+	t.Run("does nothing when turned off", func(t *testing.T) {
+		var off *model.ProjectAutoCreator
+		projectKey, err := off.ResolveProjectKey(ctx, "sdk-other-test")
+		require.NoError(t, err)
+		assert.Equal(t, "sdk-other-test", projectKey)
+	})
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const ctxKeyBigSegments = ctxKey("model.BigSegments")
+
+// bigSegmentGeneration is the generation used in segment references. Segments in the dev server are never regenerated.
+const bigSegmentGeneration = 1
+
+// BigSegmentMembership lists the context keys that are explicitly included in or excluded from a big segment.
+type BigSegmentMembership struct {
+	Included []string
+	Excluded []string
+}
+
+// BigSegments is an in-process stand-in for the persistent store that SDKs read big segment membership from. Since
+// it's always current, it always reports being up to date.
+type BigSegments struct {
+	mu sync.RWMutex
+	// projectKey -> segmentKey -> membership
+	memberships map[string]map[string]BigSegmentMembership
+}
+
+func NewBigSegments() *BigSegments {
+	return &BigSegments{memberships: make(map[string]map[string]BigSegmentMembership)}
+}
+
+// SetMembership replaces the membership of the segment in the project.
+func (b *BigSegments) SetMembership(projectKey, segmentKey string, membership BigSegmentMembership) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.memberships[projectKey] == nil {
+		b.memberships[projectKey] = make(map[string]BigSegmentMembership)
+	}
+	b.memberships[projectKey][segmentKey] = membership
+}
+
+// DeleteMembership removes the segment from the project, returning false if it didn't exist.
+func (b *BigSegments) DeleteMembership(projectKey, segmentKey string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.memberships[projectKey][segmentKey]; !ok {
+		return false
+	}
+	delete(b.memberships[projectKey], segmentKey)
+	return true
+}
+
+// GetMemberships returns the membership of every big segment in the project, keyed by segment key.
+func (b *BigSegments) GetMemberships(projectKey string) map[string]BigSegmentMembership {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	memberships := make(map[string]BigSegmentMembership, len(b.memberships[projectKey]))
+	for segmentKey, membership := range b.memberships[projectKey] {
+		memberships[segmentKey] = membership
+	}
+	return memberships
+}
+
+// GetMembershipForContextHash returns the segments the context is included in (true) or excluded from (false),
+// keyed by segment reference. SDKs identify contexts by the base64 encoded SHA-256 hash of the context key.
+func (b *BigSegments) GetMembershipForContextHash(projectKey, contextHash string) map[string]bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	result := make(map[string]bool)
+	for segmentKey, membership := range b.memberships[projectKey] {
+		ref := BigSegmentRef(segmentKey)
+		for _, key := range membership.Excluded {
+			if BigSegmentContextHash(key) == contextHash {
+				result[ref] = false
+			}
+		}
+		// Included takes precedence over excluded, same as in LaunchDarkly
+		for _, key := range membership.Included {
+			if BigSegmentContextHash(key) == contextHash {
+				result[ref] = true
+			}
+		}
+	}
+	return result
+}
+
+// LastUpToDate is when the store was last synchronized, which is always now.
+func (b *BigSegments) LastUpToDate() time.Time {
+	return time.Now()
+}
+
+// BigSegmentRef is how SDKs refer to a segment when checking membership.
+func BigSegmentRef(segmentKey string) string {
+	return fmt.Sprintf("%s.g%d", segmentKey, bigSegmentGeneration)
+}
+
+// BigSegmentContextHash is how SDKs identify a context when checking membership.
+func BigSegmentContextHash(contextKey string) string {
+	hash := sha256.Sum256([]byte(contextKey))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func ContextWithBigSegments(ctx context.Context, bigSegments *BigSegments) context.Context {
+	return context.WithValue(ctx, ctxKeyBigSegments, bigSegments)
+}
+
+func BigSegmentsFromContext(ctx context.Context) *BigSegments {
+	return ctx.Value(ctxKeyBigSegments).(*BigSegments)
+}
+
+// ResyncForBigSegments syncs the project again after its big segment membership changed, so that its flags' values
+// for its context reflect the change. Failures only get logged, since the membership was changed.
+func ResyncForBigSegments(ctx context.Context, projectKey string) {
+	_, err := UpdateProject(ctx, projectKey, nil, nil, nil)
+	if err != nil {
+		logs.Printf(logs.Warn, projectKey, "unable to sync project [%s] after its big segments changed: %+v", projectKey, err)
+	}
+}
+
+// withBigSegmentMembership has the SDK evaluate the project's flags with the big segment membership set for it, if the
+// server emulates big segments.
+func (project Project) withBigSegmentMembership(ctx context.Context) context.Context {
+	bigSegments, ok := ctx.Value(ctxKeyBigSegments).(*BigSegments)
+	if !ok || bigSegments == nil {
+		return ctx
+	}
+	return adapters.WithBigSegmentMembership(ctx, func(contextHash string) map[string]bool {
+		return bigSegments.GetMembershipForContextHash(project.Key, contextHash)
+	})
+}
+
+func BigSegmentsMiddleware(bigSegments *BigSegments) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithBigSegments(r.Context(), bigSegments)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
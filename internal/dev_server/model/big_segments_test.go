@@ -0,0 +1,46 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestBigSegments(t *testing.T) {
+	bigSegments := model.NewBigSegments()
+	bigSegments.SetMembership("proj", "beta-users", model.BigSegmentMembership{
+		Included: []string{"alice", "bob"},
+		Excluded: []string{"bob", "carol"},
+	})
+	bigSegments.SetMembership("other-proj", "beta-users", model.BigSegmentMembership{
+		Included: []string{"carol"},
+	})
+
+	t.Run("lists memberships for the project", func(t *testing.T) {
+		memberships := bigSegments.GetMemberships("proj")
+		assert.Len(t, memberships, 1)
+		assert.Equal(t, []string{"alice", "bob"}, memberships["beta-users"].Included)
+	})
+
+	t.Run("looks up membership by context hash", func(t *testing.T) {
+		ref := model.BigSegmentRef("beta-users")
+		assert.Equal(t, "beta-users.g1", ref)
+		assert.Equal(t, map[string]bool{ref: true}, bigSegments.GetMembershipForContextHash("proj", model.BigSegmentContextHash("alice")))
+		assert.Equal(t, map[string]bool{ref: false}, bigSegments.GetMembershipForContextHash("proj", model.BigSegmentContextHash("carol")))
+		assert.Empty(t, bigSegments.GetMembershipForContextHash("proj", model.BigSegmentContextHash("dave")))
+	})
+
+	t.Run("included takes precedence over excluded", func(t *testing.T) {
+		ref := model.BigSegmentRef("beta-users")
+		assert.Equal(t, map[string]bool{ref: true}, bigSegments.GetMembershipForContextHash("proj", model.BigSegmentContextHash("bob")))
+	})
+
+	t.Run("delete removes the segment", func(t *testing.T) {
+		assert.True(t, bigSegments.DeleteMembership("proj", "beta-users"))
+		assert.False(t, bigSegments.DeleteMembership("proj", "beta-users"))
+		assert.Empty(t, bigSegments.GetMemberships("proj"))
+		assert.Len(t, bigSegments.GetMemberships("other-proj"), 1)
+	})
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// Chaos configures an override that serves a random one of the flag's variations, for seeing how an app copes with
+// flag values it doesn't expect.
+type Chaos struct {
+	// Seed makes the values served repeatable: the same seed buckets contexts the same way and picks the same
+	// sequence of values.
+	Seed int64
+	// PerContext buckets each context into a random variation that it keeps, rather than picking a new value each
+	// time flags are served.
+	PerContext bool
+}
+
+// UpsertChaosOverride overrides the flag with an equal split between its available variations. ErrNotFound is returned
+// if the flag isn't in the project or has no variations to choose between.
+func UpsertChaosOverride(ctx context.Context, projectKey, flagKey string, chaos Chaos) (Override, error) {
+	availableVariations, err := StoreFromContext(ctx).GetAvailableVariationsForProject(ctx, projectKey)
+	if err != nil {
+		return Override{}, err
+	}
+	variations := availableVariations[flagKey]
+	if len(variations) == 0 {
+		return Override{}, NewErrNotFound("variations for flag", flagKey)
+	}
+
+	rollout := Rollout{
+		Variations:    make([]WeightedValue, 0, len(variations)),
+		Seed:          chaos.Seed,
+		PerEvaluation: !chaos.PerContext,
+	}
+	for i, variation := range variations {
+		weight := RolloutTotalWeight / len(variations)
+		if i < RolloutTotalWeight%len(variations) {
+			weight++
+		}
+		rollout.Variations = append(rollout.Variations, WeightedValue{Value: variation.Value, Weight: weight})
+	}
+
+	// start the sequence of values over, so that re-applying a seed repeats it
+	randomValues.reset(projectKey, flagKey)
+	override, err := UpsertRolloutOverride(ctx, projectKey, flagKey, rollout)
+	if err != nil {
+		return Override{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Chaos override for flag [%s] in project [%s] with seed %d", flagKey, projectKey, chaos.Seed)
+	return override, nil
+}
+
+// randomValueSources picks values for PerEvaluation rollouts. Each flag gets its own source seeded by its rollout,
+// so that a seed produces the same sequence of values until the dev server restarts or the seed is re-applied.
+type randomValueSources struct {
+	mu      sync.Mutex
+	sources map[randomValueSourceKey]*rand.Rand
+}
+
+type randomValueSourceKey struct {
+	projectKey string
+	flagKey    string
+	seed       int64
+}
+
+var randomValues = &randomValueSources{sources: map[randomValueSourceKey]*rand.Rand{}}
+
+func (r *randomValueSources) next(projectKey, flagKey string, rollout Rollout) ldvalue.Value {
+	key := randomValueSourceKey{projectKey: projectKey, flagKey: flagKey, seed: rollout.Seed}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	source, ok := r.sources[key]
+	if !ok {
+		source = rand.New(rand.NewSource(rollout.Seed)) //nolint:gosec // repeatable values are the point
+		r.sources[key] = source
+	}
+	return rollout.Variations[rollout.variationIndexForBucket(source.Float32())].Value
+}
+
+func (r *randomValueSources) reset(projectKey, flagKey string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key := range r.sources {
+		if key.projectKey == projectKey && key.flagKey == flagKey {
+			delete(r.sources, key)
+		}
+	}
+}
+
+// resetProject drops the sources of every flag in the project, once it's deleted.
+func (r *randomValueSources) resetProject(projectKey string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key := range r.sources {
+		if key.projectKey == projectKey {
+			delete(r.sources, key)
+		}
+	}
+}
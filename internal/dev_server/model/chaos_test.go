@@ -0,0 +1,138 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestUpsertChaosOverride(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	observers := model.NewObservers()
+	observer := mocks.NewMockObserver(mockController)
+	observers.RegisterObserver(observer)
+	ctx = model.SetObserversOnContext(ctx, observers)
+	project := &model.Project{
+		Key:           "proj",
+		AllFlagsState: model.FlagsState{"flg": model.FlagState{Value: ldvalue.String("a"), Version: 1}},
+	}
+	variations := map[string][]model.Variation{
+		"flg": {{Value: ldvalue.String("a")}, {Value: ldvalue.String("b")}, {Value: ldvalue.String("c")}},
+	}
+
+	t.Run("returns ErrNotFound for a flag without variations", func(t *testing.T) {
+		store.EXPECT().GetAvailableVariationsForProject(gomock.Any(), "proj").Return(variations, nil)
+
+		_, err := model.UpsertChaosOverride(ctx, "proj", "other", model.Chaos{})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("splits the flag equally between its variations", func(t *testing.T) {
+		store.EXPECT().GetAvailableVariationsForProject(gomock.Any(), "proj").Return(variations, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, override model.Override) (model.Override, error) {
+			return override, nil
+		})
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+		observer.EXPECT().Handle(gomock.Any())
+
+		override, err := model.UpsertChaosOverride(ctx, "proj", "flg", model.Chaos{Seed: 7})
+		require.NoError(t, err)
+		require.NotNil(t, override.Rollout)
+		assert.Equal(t, []model.WeightedValue{
+			{Value: ldvalue.String("a"), Weight: 33334},
+			{Value: ldvalue.String("b"), Weight: 33333},
+			{Value: ldvalue.String("c"), Weight: 33333},
+		}, override.Rollout.Variations)
+		assert.Equal(t, int64(7), override.Rollout.Seed)
+		assert.True(t, override.Rollout.PerEvaluation)
+	})
+}
+
+func TestPerEvaluationRollout(t *testing.T) {
+	rollout := &model.Rollout{
+		Variations: []model.WeightedValue{
+			{Value: ldvalue.String("a"), Weight: 50000},
+			{Value: ldvalue.String("b"), Weight: 50000},
+		},
+		Seed:          42,
+		PerEvaluation: true,
+	}
+	state := model.FlagState{Value: ldvalue.String("a"), Rollout: rollout}
+	ldCtx := ldcontext.New("some-user")
+
+	served := map[string]int{}
+	for i := 0; i < 100; i++ {
+		served[state.ForContext(t.Name(), "flg", ldCtx).Value.StringValue()]++
+	}
+	assert.Len(t, served, 2, "the same context gets different values")
+
+	t.Run("a seed gives the same values as another project with that seed", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			assert.Equal(t,
+				state.ForContext(t.Name()+"-1", "flg", ldCtx).Value,
+				state.ForContext(t.Name()+"-2", "flg", ldCtx).Value,
+			)
+		}
+	})
+}
+
+func TestChaosValuesArePrunedWithTheirOverride(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+	rollout := &model.Rollout{
+		Variations: []model.WeightedValue{
+			{Value: ldvalue.String("a"), Weight: 50000},
+			{Value: ldvalue.String("b"), Weight: 50000},
+		},
+		Seed:          42,
+		PerEvaluation: true,
+	}
+	state := model.FlagState{Value: ldvalue.String("a"), Rollout: rollout}
+	ldCtx := ldcontext.New("some-user")
+	values := func(projectKey string) []ldvalue.Value {
+		var served []ldvalue.Value
+		for i := 0; i < 10; i++ {
+			served = append(served, state.ForContext(projectKey, "flg", ldCtx).Value)
+		}
+		return served
+	}
+	project := &model.Project{
+		Key:           t.Name(),
+		AllFlagsState: model.FlagsState{"flg": model.FlagState{Value: ldvalue.String("a"), Version: 1}},
+	}
+	first := values(project.Key)
+
+	t.Run("removing the override starts its values over", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), project.Key).Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), project.Key, "flg").Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), project.Key).Return(nil, nil)
+
+		require.NoError(t, model.DeleteOverride(ctx, project.Key, "flg"))
+		assert.Equal(t, first, values(project.Key))
+	})
+
+	t.Run("deleting the project starts its values over", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), project.Key).Return(project, nil)
+		store.EXPECT().DeleteDevProject(gomock.Any(), project.Key).Return(model.ProjectDeletion{}, true, nil)
+
+		_, err := model.DeleteProject(ctx, project.Key)
+		require.NoError(t, err)
+		assert.Equal(t, first, values(project.Key))
+	})
+}
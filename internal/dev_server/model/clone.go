@@ -0,0 +1,169 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// ErrLinkedClone is returned for changes that linked clones can't have made to them directly, because they get their
+// source environment, context, and flag filter from their base project.
+type ErrLinkedClone struct {
+	projectKey     string
+	baseProjectKey string
+}
+
+func NewErrLinkedClone(projectKey, baseProjectKey string) ErrLinkedClone {
+	return ErrLinkedClone{projectKey: projectKey, baseProjectKey: baseProjectKey}
+}
+
+func (e ErrLinkedClone) Error() string {
+	return fmt.Sprintf("project %s is a linked clone of %s and syncs from it", e.projectKey, e.baseProjectKey)
+}
+
+// ErrHasLinkedClones is returned when deleting a project that linked clones sync from, since they'd be left without a
+// base. The clones have to be deleted first.
+type ErrHasLinkedClones struct {
+	projectKey string
+	cloneKeys  []string
+}
+
+func (e ErrHasLinkedClones) Error() string {
+	return fmt.Sprintf("project %s has linked clones that sync from it, delete them first: %s", e.projectKey, strings.Join(e.cloneKeys, ", "))
+}
+
+// checkNoLinkedClones returns ErrHasLinkedClones if the project has linked clones.
+func (project Project) checkNoLinkedClones() error {
+	if len(project.LinkedCloneKeys) == 0 {
+		return nil
+	}
+	return errors.WithStack(ErrHasLinkedClones{projectKey: project.Key, cloneKeys: project.LinkedCloneKeys})
+}
+
+// CloneProject creates a linked clone of the base project. The clone starts with the base's flags, is synced whenever
+// the base is, and inherits the base's overrides as they change. Overrides set on the clone shadow the inherited ones.
+// Clones of linked clones would have to be kept in sync in chains, so they aren't allowed.
+func CloneProject(ctx context.Context, projectKey, baseProjectKey string) (Project, error) {
+	clone := Project{
+		Key:            projectKey,
+		BaseProjectKey: baseProjectKey,
+	}
+	// the base is read in the same transaction so that a sync can't land between reading its flags and its variations
+	err := withTx(ctx, func(ctx context.Context) error {
+		store := StoreFromContext(ctx)
+		base, err := store.GetDevProject(ctx, baseProjectKey)
+		if err != nil {
+			return err
+		}
+		if base.BaseProjectKey != "" {
+			return errors.WithStack(NewErrLinkedClone(base.Key, base.BaseProjectKey))
+		}
+		if err := clone.copyFromBase(ctx, *base); err != nil {
+			return err
+		}
+		return store.InsertProject(ctx, clone)
+	})
+	if err != nil {
+		return Project{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Cloned project [%s] from [%s]", projectKey, baseProjectKey)
+	return clone, nil
+}
+
+// syncFromBase refreshes a linked clone's flags from its base project.
+func (project *Project) syncFromBase(ctx context.Context) error {
+	base, err := StoreFromContext(ctx).GetDevProject(ctx, project.BaseProjectKey)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get base project %s", project.BaseProjectKey)
+	}
+	return project.copyFromBase(ctx, *base)
+}
+
+func (project *Project) copyFromBase(ctx context.Context, base Project) error {
+	availableVariations, err := StoreFromContext(ctx).GetAvailableVariationsForProject(ctx, base.Key)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get available variations for base project %s", base.Key)
+	}
+	flagKeys := lo.Keys(availableVariations)
+	sort.Strings(flagKeys)
+	project.AvailableVariations = nil
+	for _, flagKey := range flagKeys {
+		for _, variation := range availableVariations[flagKey] {
+			project.AvailableVariations = append(project.AvailableVariations, FlagVariation{FlagKey: flagKey, Variation: variation})
+		}
+	}
+	project.SourceEnvironmentKey = base.SourceEnvironmentKey
+	project.Context = base.Context
+	project.FlagFilter = base.FlagFilter
+	project.AllFlagsState = base.AllFlagsState
+	project.FlagMetadata = base.FlagMetadata
+	project.ContextKinds = base.ContextKinds
+	project.LastSyncTime = base.LastSyncTime
+	return nil
+}
+
+// linkedClones fetches the base project's linked clones.
+func linkedClones(ctx context.Context, base Project) ([]Project, error) {
+	store := StoreFromContext(ctx)
+	clones := make([]Project, 0, len(base.LinkedCloneKeys))
+	for _, cloneKey := range base.LinkedCloneKeys {
+		clone, err := store.GetDevProject(ctx, cloneKey)
+		if err != nil {
+			return nil, err
+		}
+		clones = append(clones, *clone)
+	}
+	return clones, nil
+}
+
+// syncLinkedClones syncs the base project's linked clones after the base itself has been synced.
+func syncLinkedClones(ctx context.Context, base Project) {
+	for _, cloneKey := range base.LinkedCloneKeys {
+		_, err := UpdateProject(ctx, cloneKey, nil, nil, nil)
+		if errors.As(err, &ErrArchived{}) {
+			continue
+		}
+		if err != nil {
+			logs.Printf(logs.Error, cloneKey, "unable to sync linked clone %s of project %s: %+v", cloneKey, base.Key, err)
+		}
+	}
+}
+
+// notifyLinkedClones tells observers about the effective state of the flags in each of the base project's linked
+// clones after the base's overrides for them changed. Failures only get logged, since the base's change was made.
+func notifyLinkedClones(ctx context.Context, base Project, flagKeys []string) {
+	if len(base.LinkedCloneKeys) == 0 {
+		return
+	}
+	clones, err := linkedClones(ctx, base)
+	if err != nil {
+		logs.Printf(logs.Error, base.Key, "unable to get linked clones of project %s: %+v", base.Key, err)
+		return
+	}
+	observers := GetObserversFromContext(ctx)
+	for _, clone := range clones {
+		overrides, err := getLayeredOverrides(ctx, clone)
+		if err != nil {
+			logs.Printf(logs.Error, clone.Key, "unable to get overrides for linked clone %s: %+v", clone.Key, err)
+			continue
+		}
+		for _, flagKey := range lo.Uniq(flagKeys) {
+			state, ok := clone.AllFlagsState[flagKey]
+			if !ok {
+				continue
+			}
+			state, _ = overrides.Apply(flagKey, state)
+			observers.Notify(OverrideEvent{
+				FlagKey:    flagKey,
+				ProjectKey: clone.Key,
+				FlagState:  state,
+			})
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestCloneProject(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
+	ctx = model.ContextWithStore(ctx, store)
+
+	base := model.Project{
+		Key:                  "base",
+		SourceEnvironmentKey: "env",
+		Context:              ldcontext.New("user"),
+		LastSyncTime:         time.Now(),
+		AllFlagsState:        model.FlagsState{"flg": model.FlagState{Value: ldvalue.Bool(true), Version: 1}},
+	}
+	variations := map[string][]model.Variation{
+		"flg": {{Id: "1", Value: ldvalue.Bool(true)}, {Id: "2", Value: ldvalue.Bool(false)}},
+	}
+
+	t.Run("clones the base project's flags and source", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "base").Return(&base, nil)
+		store.EXPECT().GetAvailableVariationsForProject(gomock.Any(), "base").Return(variations, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		clone, err := model.CloneProject(ctx, "clone", "base")
+		require.NoError(t, err)
+		assert.Equal(t, "clone", clone.Key)
+		assert.Equal(t, "base", clone.BaseProjectKey)
+		assert.Equal(t, base.SourceEnvironmentKey, clone.SourceEnvironmentKey)
+		assert.Equal(t, base.Context, clone.Context)
+		assert.Equal(t, base.AllFlagsState, clone.AllFlagsState)
+		assert.Equal(t, []model.FlagVariation{
+			{FlagKey: "flg", Variation: variations["flg"][0]},
+			{FlagKey: "flg", Variation: variations["flg"][1]},
+		}, clone.AvailableVariations)
+	})
+
+	t.Run("doesn't clone a linked clone", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "clone").Return(&model.Project{Key: "clone", BaseProjectKey: "base"}, nil)
+
+		_, err := model.CloneProject(ctx, "clone-2", "clone")
+		assert.ErrorAs(t, err, &model.ErrLinkedClone{})
+	})
+
+	t.Run("a linked clone's context can't be changed directly", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "clone").Return(&model.Project{Key: "clone", BaseProjectKey: "base"}, nil)
+
+		ldCtx := ldcontext.New("other")
+		_, err := model.UpdateProject(ctx, "clone", &ldCtx, nil, nil)
+		assert.ErrorAs(t, err, &model.ErrLinkedClone{})
+	})
+
+	t.Run("a base project can't be deleted while it has linked clones", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "base").Return(&model.Project{Key: "base", LinkedCloneKeys: []string{"clone"}}, nil)
+
+		_, err := model.DeleteProject(ctx, "base")
+		assert.EqualError(t, err, "project base has linked clones that sync from it, delete them first: clone")
+		assert.ErrorAs(t, err, &model.ErrHasLinkedClones{})
+	})
+
+	t.Run("a base project can be deleted once its linked clones are", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "base").Return(&model.Project{Key: "base"}, nil)
+		store.EXPECT().DeleteDevProject(gomock.Any(), "base").Return(model.ProjectDeletion{Overrides: 1}, true, nil)
+
+		deletion, err := model.DeleteProject(ctx, "base")
+		require.NoError(t, err)
+		assert.Equal(t, model.ProjectDeletion{Overrides: 1}, deletion)
+	})
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+)
+
+const ctxKeyContextEnricher = ctxKey("model.ContextEnricher")
+
+// contextEnrichmentTimeout bounds how long a hook can take, since it runs on every sync and client-side SDK request.
+const contextEnrichmentTimeout = 10 * time.Second
+
+// ContextEnricher adds attributes to an evaluation context before flags are evaluated for it, the way an edge service
+// in front of LaunchDarkly might in production.
+type ContextEnricher interface {
+	Enrich(ctx context.Context, ldCtx ldcontext.Context) (ldcontext.Context, error)
+}
+
+// NewContextEnricher returns an enricher for hook. If hook is an http or https URL, the context JSON is POSTed to it;
+// otherwise hook is run as a shell command with the context JSON on stdin. Either way the response must be the
+// enriched context JSON.
+func NewContextEnricher(hook string) ContextEnricher {
+	if strings.HasPrefix(hook, "http://") || strings.HasPrefix(hook, "https://") {
+		return httpContextEnricher{url: hook, client: &http.Client{Timeout: contextEnrichmentTimeout}}
+	}
+	return commandContextEnricher{command: hook}
+}
+
+type httpContextEnricher struct {
+	url    string
+	client *http.Client
+}
+
+func (e httpContextEnricher) Enrich(ctx context.Context, ldCtx ldcontext.Context) (ldcontext.Context, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, strings.NewReader(ldCtx.JSONString()))
+	if err != nil {
+		return ldcontext.Context{}, errors.Wrap(err, "unable to build context enrichment request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return ldcontext.Context{}, errors.Wrapf(err, "context enrichment hook %s failed", e.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ldcontext.Context{}, errors.Errorf("context enrichment hook %s returned %s", e.url, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ldcontext.Context{}, errors.Wrapf(err, "unable to read response from context enrichment hook %s", e.url)
+	}
+	return parseEnrichedContext(body)
+}
+
+type commandContextEnricher struct {
+	command string
+}
+
+func (e commandContextEnricher) Enrich(ctx context.Context, ldCtx ldcontext.Context) (ldcontext.Context, error) {
+	ctx, cancel := context.WithTimeout(ctx, contextEnrichmentTimeout)
+	defer cancel()
+	cmd := shellCommand(ctx, e.command)
+	cmd.Stdin = strings.NewReader(ldCtx.JSONString())
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return ldcontext.Context{}, errors.Wrapf(err, "context enrichment hook `%s` failed: %s", e.command, strings.TrimSpace(stderr.String()))
+	}
+	return parseEnrichedContext(output)
+}
+
+func parseEnrichedContext(data []byte) (ldcontext.Context, error) {
+	var enriched ldcontext.Context
+	if err := enriched.UnmarshalJSON(bytes.TrimSpace(data)); err != nil {
+		return ldcontext.Context{}, errors.Wrap(err, "context enrichment hook returned an invalid context")
+	}
+	return enriched, nil
+}
+
+// EnrichContext runs the enricher on the context, if one was configured, and otherwise returns the context unchanged.
+func EnrichContext(ctx context.Context, ldCtx ldcontext.Context) (ldcontext.Context, error) {
+	enricher, ok := ctx.Value(ctxKeyContextEnricher).(ContextEnricher)
+	if !ok || enricher == nil {
+		return ldCtx, nil
+	}
+	return enricher.Enrich(ctx, ldCtx)
+}
+
+func ContextWithContextEnricher(ctx context.Context, enricher ContextEnricher) context.Context {
+	return context.WithValue(ctx, ctxKeyContextEnricher, enricher)
+}
+
+func ContextEnricherMiddleware(enricher ContextEnricher) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithContextEnricher(r.Context(), enricher)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package model_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestContextEnrichment(t *testing.T) {
+	ldCtx := ldcontext.New("user-key")
+	enriched := ldcontext.NewBuilder("user-key").SetString("tier", "gold").Build()
+
+	t.Run("returns the context unchanged without an enricher", func(t *testing.T) {
+		result, err := model.EnrichContext(context.Background(), ldCtx)
+		require.NoError(t, err)
+		assert.Equal(t, ldCtx, result)
+	})
+
+	t.Run("posts the context to http hooks", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, ldCtx.JSONString(), string(body))
+			_, _ = w.Write([]byte(enriched.JSONString()))
+		}))
+		defer server.Close()
+		ctx := model.ContextWithContextEnricher(context.Background(), model.NewContextEnricher(server.URL))
+
+		result, err := model.EnrichContext(ctx, ldCtx)
+		require.NoError(t, err)
+		assert.Equal(t, enriched, result)
+	})
+
+	t.Run("returns an error when http hooks fail", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		ctx := model.ContextWithContextEnricher(context.Background(), model.NewContextEnricher(server.URL))
+
+		_, err := model.EnrichContext(ctx, ldCtx)
+		assert.ErrorContains(t, err, "500")
+	})
+
+	t.Run("runs command hooks with the context on stdin", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a POSIX shell")
+		}
+		// the hook echoes stdin back, with the tier attribute spliced in before the closing brace
+		hook := `sed 's/}$/,"tier":"gold"}/'`
+		ctx := model.ContextWithContextEnricher(context.Background(), model.NewContextEnricher(hook))
+
+		result, err := model.EnrichContext(ctx, ldCtx)
+		require.NoError(t, err)
+		assert.Equal(t, enriched, result)
+	})
+
+	t.Run("returns an error when command hooks print something other than a context", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a POSIX shell")
+		}
+		ctx := model.ContextWithContextEnricher(context.Background(), model.NewContextEnricher("echo nope"))
+
+		_, err := model.EnrichContext(ctx, ldCtx)
+		assert.ErrorContains(t, err, "invalid context")
+	})
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+)
+
+// maxContextKindSuggestionDistance is how many edits a kind can be from a configured one for it to be suggested.
+const maxContextKindSuggestionDistance = 2
+
+// ContextKind is a context kind configured for the project in LaunchDarkly.
+type ContextKind struct {
+	Key         string `json:"key"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ErrUnknownContextKind is returned when a project's context has a kind that isn't configured for the project, which
+// would otherwise be evaluated without matching any targeting for the kind the context was meant to have.
+type ErrUnknownContextKind struct {
+	Kind       string
+	Suggestion string
+	kinds      []string
+}
+
+func (e ErrUnknownContextKind) Error() string {
+	message := fmt.Sprintf("context kind %q isn't configured for the project, which has %s", e.Kind, strings.Join(e.kinds, ", "))
+	if e.Suggestion != "" {
+		message += fmt.Sprintf("; did you mean %q?", e.Suggestion)
+	}
+	return message
+}
+
+// fetchContextKinds returns the context kinds that can be targeted in the project, leaving out archived ones.
+func (project Project) fetchContextKinds(ctx context.Context) ([]ContextKind, error) {
+	kinds, err := adapters.GetApi(ctx).GetContextKinds(ctx, project.Key)
+	if err != nil {
+		return nil, err
+	}
+	var result []ContextKind
+	for _, kind := range kinds {
+		if lo.FromPtr(kind.Archived) {
+			continue
+		}
+		result = append(result, ContextKind{Key: kind.Key, Name: kind.Name, Description: kind.Description})
+	}
+	return result, nil
+}
+
+// checkContextKinds returns ErrUnknownContextKind for the first of the context's kinds that isn't one of the
+// project's context kinds. Projects whose context kinds couldn't be fetched accept any kind.
+func (project Project) checkContextKinds(ldCtx ldcontext.Context) error {
+	if len(project.ContextKinds) == 0 {
+		return nil
+	}
+	keys := lo.Map(project.ContextKinds, func(kind ContextKind, _ int) string { return kind.Key })
+	for _, kind := range ldCtx.GetAllIndividualContexts(nil) {
+		if !lo.Contains(keys, string(kind.Kind())) {
+			return ErrUnknownContextKind{Kind: string(kind.Kind()), Suggestion: suggestContextKind(string(kind.Kind()), keys), kinds: keys}
+		}
+	}
+	return nil
+}
+
+// suggestContextKind returns the configured kind closest to kind, if one is close enough to be a typo of it.
+func suggestContextKind(kind string, keys []string) string {
+	suggestion, best := "", maxContextKindSuggestionDistance+1
+	for _, key := range keys {
+		if distance := editDistance(strings.ToLower(kind), strings.ToLower(key)); distance < best {
+			suggestion, best = key, distance
+		}
+	}
+	return suggestion
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
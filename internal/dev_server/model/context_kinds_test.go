@@ -0,0 +1,98 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestContextKinds(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	api.EXPECT().GetSdkKey(gomock.Any(), gomock.Any(), gomock.Any()).Return("sdk-key", nil).AnyTimes()
+	sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-key").Return(flagstate.NewAllFlagsBuilder().Build(), nil).AnyTimes()
+	api.EXPECT().GetAllFlags(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), "proj").Return([]ldapi.ContextKindRep{
+		{Key: "user", Name: "User"},
+		{Key: "org", Name: "Organization", Description: "a customer"},
+		{Key: "device", Name: "Device", Archived: lo.ToPtr(true)},
+	}, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), "unconfigured").Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), "flaky").Return([]ldapi.ContextKindRep{{Key: "user", Name: "User"}}, nil)
+	api.EXPECT().GetContextKinds(gomock.Any(), "flaky").Return(nil, errors.New("403 Forbidden")).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), "forbidden").Return(nil, errors.New("403 Forbidden")).AnyTimes()
+
+	t.Run("rejects contexts of kinds the project doesn't have, suggesting the one meant", func(t *testing.T) {
+		users := ldcontext.NewWithKind("users", "dev")
+		_, err := model.CreateProject(ctx, "proj", "env", &users, model.FlagFilter{})
+		var unknown model.ErrUnknownContextKind
+		require.ErrorAs(t, err, &unknown)
+		assert.Equal(t, "users", unknown.Kind)
+		assert.Equal(t, "user", unknown.Suggestion)
+		assert.Contains(t, err.Error(), `did you mean "user"?`)
+	})
+
+	t.Run("syncs the project's context kinds, leaving out archived ones", func(t *testing.T) {
+		project, err := model.CreateProject(ctx, "proj", "env", nil, model.FlagFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, []model.ContextKind{
+			{Key: "user", Name: "User"},
+			{Key: "org", Name: "Organization", Description: "a customer"},
+		}, project.ContextKinds)
+	})
+
+	t.Run("checks each kind of multi-kind contexts", func(t *testing.T) {
+		multi := ldcontext.NewMulti(ldcontext.New("dev"), ldcontext.NewWithKind("orgs", "acme"))
+		_, err := model.UpdateProject(ctx, "proj", &multi, nil, nil)
+		var unknown model.ErrUnknownContextKind
+		require.ErrorAs(t, err, &unknown)
+		assert.Equal(t, "orgs", unknown.Kind)
+		assert.Equal(t, "org", unknown.Suggestion)
+
+		multi = ldcontext.NewMulti(ldcontext.New("dev"), ldcontext.NewWithKind("org", "acme"))
+		project, err := model.UpdateProject(ctx, "proj", &multi, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, multi, project.Context)
+	})
+
+	t.Run("accepts any kind for projects without context kinds", func(t *testing.T) {
+		device := ldcontext.NewWithKind("device", "laptop")
+		_, err := model.CreateProject(ctx, "unconfigured", "env", &device, model.FlagFilter{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("syncs a project whose context kinds can't be fetched, keeping the ones it had", func(t *testing.T) {
+		_, err := model.CreateProject(ctx, "flaky", "env", nil, model.FlagFilter{})
+		require.NoError(t, err)
+
+		project, err := model.UpdateProject(ctx, "flaky", nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []model.ContextKind{{Key: "user", Name: "User"}}, project.ContextKinds)
+	})
+
+	t.Run("adds a project whose context kinds can't be fetched, accepting any kind", func(t *testing.T) {
+		device := ldcontext.NewWithKind("device", "laptop")
+		project, err := model.CreateProject(ctx, "forbidden", "env", &device, model.FlagFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, project.ContextKinds)
+	})
+}
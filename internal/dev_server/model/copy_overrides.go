@@ -0,0 +1,80 @@
+package model
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// OverridesCopy is the result of copying overrides from one project to another.
+type OverridesCopy struct {
+	// Copied are the flag keys whose overrides were copied.
+	Copied []string
+	// Skipped maps the flag keys of overrides that weren't copied to why not.
+	Skipped map[string]string
+}
+
+// CopyOverrides copies the source project's active overrides that match filter into the target project, replacing
+// the target's overrides for those flags. Filters can't include tags, since flags' tags aren't kept locally.
+// Overrides for flags the target doesn't have, or whose override in the target is locked, are skipped. Any other
+// failure leaves the target's overrides as they were.
+func CopyOverrides(ctx context.Context, targetKey, sourceKey string, filter FlagFilter) (OverridesCopy, error) {
+	if len(filter.Tags) > 0 {
+		return OverridesCopy{}, errors.New("overrides can't be copied by tag")
+	}
+	var result OverridesCopy
+	err := withTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = copyOverrides(ctx, targetKey, sourceKey, filter)
+		return err
+	})
+	if err != nil {
+		return OverridesCopy{}, err
+	}
+	logs.Printf(logs.Info, targetKey, "Copied %d overrides from project [%s] to project [%s]", len(result.Copied), sourceKey, targetKey)
+	return result, nil
+}
+
+func copyOverrides(ctx context.Context, targetKey, sourceKey string, filter FlagFilter) (OverridesCopy, error) {
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, targetKey); err != nil {
+		return OverridesCopy{}, err
+	}
+	if _, err := store.GetDevProject(ctx, sourceKey); err != nil {
+		return OverridesCopy{}, err
+	}
+	overrides, err := store.GetOverridesForProject(ctx, sourceKey)
+	if err != nil {
+		return OverridesCopy{}, err
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].FlagKey < overrides[j].FlagKey
+	})
+
+	result := OverridesCopy{Copied: []string{}, Skipped: map[string]string{}}
+	for _, override := range overrides {
+		if !override.Active || !filter.Includes(override.FlagKey, nil) {
+			continue
+		}
+		_, err := upsertOverride(ctx, Override{
+			ProjectKey: targetKey,
+			FlagKey:    override.FlagKey,
+			Value:      override.Value,
+			Rollout:    override.Rollout,
+			Active:     true,
+			Version:    1,
+		})
+		switch {
+		case errors.As(err, &ErrLocked{}), errors.As(err, &ErrNotFound{}), errors.As(err, &ErrInvalidMigrationStage{}):
+			result.Skipped[override.FlagKey] = err.Error()
+		case err != nil:
+			return OverridesCopy{}, errors.Wrapf(err, "unable to copy override for flag %s", override.FlagKey)
+		default:
+			result.Copied = append(result.Copied, override.FlagKey)
+		}
+	}
+	return result, nil
+}
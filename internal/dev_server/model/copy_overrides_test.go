@@ -0,0 +1,71 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestCopyOverrides(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
+	ctx = model.ContextWithStore(ctx, store)
+	observers := model.NewObservers()
+	ctx = model.SetObserversOnContext(ctx, observers)
+
+	target := &model.Project{
+		Key: "target",
+		AllFlagsState: model.FlagsState{
+			"flag-a":      model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+			"flag-locked": model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+			"other":       model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+		},
+	}
+	source := &model.Project{Key: "source"}
+	sourceOverrides := model.Overrides{
+		{ProjectKey: "source", FlagKey: "flag-missing", Value: ldvalue.Bool(true), Active: true, Version: 1},
+		{ProjectKey: "source", FlagKey: "flag-a", Value: ldvalue.Bool(true), Active: true, Version: 3},
+		{ProjectKey: "source", FlagKey: "flag-inactive", Value: ldvalue.Null(), Active: false, Version: 2},
+		{ProjectKey: "source", FlagKey: "flag-locked", Value: ldvalue.Bool(true), Active: true, Version: 1},
+		{ProjectKey: "source", FlagKey: "other", Value: ldvalue.Bool(true), Active: true, Version: 1},
+	}
+
+	t.Run("copies matching active overrides and reports the ones skipped", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "target").Return(target, nil).AnyTimes()
+		store.EXPECT().GetDevProject(gomock.Any(), "source").Return(source, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "source").Return(sourceOverrides, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), model.Override{
+			ProjectKey: "target", FlagKey: "flag-a", Value: ldvalue.Bool(true), Active: true, Version: 1,
+		}).Return(model.Override{ProjectKey: "target", FlagKey: "flag-a", Value: ldvalue.Bool(true), Active: true, Version: 1}, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), gomock.Any()).Return(model.Override{}, model.NewErrLocked("target", "flag-locked"))
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "target").Return(nil, nil)
+
+		copied, err := model.CopyOverrides(ctx, "target", "source", model.FlagFilter{KeyPrefixes: []string{"flag-"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"flag-a"}, copied.Copied)
+		assert.Len(t, copied.Skipped, 2)
+		assert.Contains(t, copied.Skipped, "flag-locked")
+		assert.Contains(t, copied.Skipped, "flag-missing")
+	})
+
+	t.Run("returns ErrNotFound if the source project doesn't exist", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "nope").Return(nil, model.NewErrNotFound("project", "nope"))
+
+		_, err := model.CopyOverrides(ctx, "target", "nope", model.FlagFilter{})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("can't filter by tag", func(t *testing.T) {
+		_, err := model.CopyOverrides(ctx, "target", "source", model.FlagFilter{Tags: []string{"frontend"}})
+		assert.Error(t, err)
+	})
+}
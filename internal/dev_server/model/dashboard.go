@@ -0,0 +1,89 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const ctxKeyDashboard = ctxKey("model.Dashboard")
+
+// Dashboard links projects and flags to their pages in the LaunchDarkly dashboard, so that developers can go from a
+// local override to the flag's real targeting. A nil *Dashboard has no links.
+type Dashboard struct {
+	baseURL string
+}
+
+// NewDashboard links to the dashboard served at baseURL, e.g. https://app.launchdarkly.com.
+func NewDashboard(baseURL string) *Dashboard {
+	return &Dashboard{baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// ProjectURL is the page listing the project's flags in its source environment, or empty if the project has no source
+// environment to link to.
+func (d *Dashboard) ProjectURL(project Project) string {
+	if d == nil || d.baseURL == "" || project.SourceEnvironmentKey == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/projects/%s/flags?%s", d.baseURL, url.PathEscape(cloudProjectKey(project)), d.envQuery(project))
+}
+
+// FlagURL is the flag's targeting page in the project's source environment, or empty if the project has no source
+// environment to link to.
+func (d *Dashboard) FlagURL(project Project, flagKey string) string {
+	if d == nil || d.baseURL == "" || project.SourceEnvironmentKey == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/projects/%s/flags/%s/targeting?%s", d.baseURL, url.PathEscape(cloudProjectKey(project)), url.PathEscape(flagKey), d.envQuery(project))
+}
+
+// FlagURLs are the targeting pages of each of the flags, by flag key, or nil if the project has no source environment
+// to link to.
+func (d *Dashboard) FlagURLs(project Project, flags FlagsState) map[string]string {
+	if d.ProjectURL(project) == "" {
+		return nil
+	}
+	urls := make(map[string]string, len(flags))
+	for flagKey := range flags {
+		urls[flagKey] = d.FlagURL(project, flagKey)
+	}
+	return urls
+}
+
+func (d *Dashboard) envQuery(project Project) string {
+	return url.Values{
+		"env":          {project.SourceEnvironmentKey},
+		"selected-env": {project.SourceEnvironmentKey},
+	}.Encode()
+}
+
+// cloudProjectKey is the key of the LaunchDarkly project that the project's flags come from. Linked clones get their
+// flags from their base project.
+func cloudProjectKey(project Project) string {
+	if project.BaseProjectKey != "" {
+		return project.BaseProjectKey
+	}
+	return project.Key
+}
+
+func ContextWithDashboard(ctx context.Context, dashboard *Dashboard) context.Context {
+	return context.WithValue(ctx, ctxKeyDashboard, dashboard)
+}
+
+// DashboardFromContext returns the dashboard set on the context, or nil if there isn't one.
+func DashboardFromContext(ctx context.Context) *Dashboard {
+	dashboard, _ := ctx.Value(ctxKeyDashboard).(*Dashboard)
+	return dashboard
+}
+
+func DashboardMiddleware(dashboard *Dashboard) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithDashboard(r.Context(), dashboard)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
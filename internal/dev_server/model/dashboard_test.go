@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestDashboard(t *testing.T) {
+	dashboard := model.NewDashboard("https://app.launchdarkly.com/")
+	project := model.Project{Key: "proj", SourceEnvironmentKey: "test"}
+
+	assert.Equal(t, "https://app.launchdarkly.com/projects/proj/flags?env=test&selected-env=test", dashboard.ProjectURL(project))
+	assert.Equal(t, "https://app.launchdarkly.com/projects/proj/flags/new-checkout/targeting?env=test&selected-env=test", dashboard.FlagURL(project, "new-checkout"))
+	assert.Equal(t, map[string]string{
+		"new-checkout": "https://app.launchdarkly.com/projects/proj/flags/new-checkout/targeting?env=test&selected-env=test",
+	}, dashboard.FlagURLs(project, model.FlagsState{"new-checkout": {}}))
+
+	t.Run("links linked clones to their base project", func(t *testing.T) {
+		clone := model.Project{Key: "proj~alice", BaseProjectKey: "proj", SourceEnvironmentKey: "test"}
+		assert.Equal(t, "https://app.launchdarkly.com/projects/proj/flags?env=test&selected-env=test", dashboard.ProjectURL(clone))
+	})
+
+	t.Run("doesn't link projects without a source environment", func(t *testing.T) {
+		imported := model.Project{Key: "imported"}
+		assert.Empty(t, dashboard.ProjectURL(imported))
+		assert.Empty(t, dashboard.FlagURL(imported, "new-checkout"))
+		assert.Nil(t, dashboard.FlagURLs(imported, model.FlagsState{"new-checkout": {}}))
+	})
+
+	t.Run("has no links without a dashboard", func(t *testing.T) {
+		var unset *model.Dashboard
+		assert.Empty(t, unset.ProjectURL(project))
+		assert.Empty(t, unset.FlagURL(project, "new-checkout"))
+	})
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// EnvironmentKeys are the keys for one of a project's environments, cached so that switching the project's source
+// environment doesn't have to look them up in LaunchDarkly first.
+type EnvironmentKeys struct {
+	SdkKey       string `json:"sdkKey"`
+	MobileKey    string `json:"mobileKey"`
+	ClientSideId string `json:"clientSideId"`
+}
+
+// PrefetchEnvironmentKeys fetches and stores the keys for every environment of the project. It's opt-in since it costs
+// extra API calls for projects with many environments.
+func PrefetchEnvironmentKeys(ctx context.Context, projectKey string) error {
+	environments, err := adapters.GetApi(ctx).GetAllEnvironments(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]EnvironmentKeys, len(environments))
+	for _, environment := range environments {
+		keys[environment.Key] = EnvironmentKeys{
+			SdkKey:       environment.ApiKey,
+			MobileKey:    environment.MobileKey,
+			ClientSideId: environment.Id,
+		}
+	}
+	updated, err := StoreFromContext(ctx).SetEnvironmentKeys(ctx, projectKey, keys)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return errors.WithStack(NewErrNotFound("project", projectKey))
+	}
+	logs.Printf(logs.Debug, projectKey, "Prefetched keys for %d environments of project [%s]", len(keys), projectKey)
+	return nil
+}
+
+// PrefetchEnvironmentKeysInBackground runs PrefetchEnvironmentKeys without blocking the caller, logging if it fails.
+func PrefetchEnvironmentKeysInBackground(ctx context.Context, projectKey string) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := PrefetchEnvironmentKeys(ctx, projectKey); err != nil {
+			logs.Printf(logs.Error, projectKey, "Unable to prefetch environment keys for project [%s]: %+v", projectKey, err)
+		}
+	}()
+}
+
+// sdkKey returns the SDK key for the project's source environment, preferring a prefetched one. cached is true if it
+// didn't come from LaunchDarkly just now.
+func (project Project) sdkKey(ctx context.Context) (sdkKey string, cached bool, err error) {
+	return project.environmentSdkKey(ctx, project.SourceEnvironmentKey)
+}
+
+// environmentSdkKey returns the SDK key of one of the environments of the project's LaunchDarkly project, and whether
+// it was prefetched rather than just looked up.
+func (project Project) environmentSdkKey(ctx context.Context, environmentKey string) (sdkKey string, cached bool, err error) {
+	if keys, ok := project.EnvironmentKeys[environmentKey]; ok && keys.SdkKey != "" {
+		return keys.SdkKey, true, nil
+	}
+	sdkKey, err = adapters.GetApi(ctx).GetSdkKey(ctx, project.Key, environmentKey)
+	return sdkKey, false, err
+}
+
+// replaceCachedSdkKey stores a rotated SDK key for the project's source environment so the stale one isn't tried again.
+func (project Project) replaceCachedSdkKey(ctx context.Context, sdkKey string) {
+	keys := make(map[string]EnvironmentKeys, len(project.EnvironmentKeys))
+	for envKey, envKeys := range project.EnvironmentKeys {
+		keys[envKey] = envKeys
+	}
+	envKeys := keys[project.SourceEnvironmentKey]
+	envKeys.SdkKey = sdkKey
+	keys[project.SourceEnvironmentKey] = envKeys
+	if _, err := StoreFromContext(ctx).SetEnvironmentKeys(ctx, project.Key, keys); err != nil {
+		logs.Printf(logs.Error, project.Key, "Unable to replace the cached SDK key for project [%s]: %+v", project.Key, err)
+	}
+}
@@ -0,0 +1,102 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestPrefetchEnvironmentKeys(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, _ := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+
+	t.Run("stores the keys for every environment", func(t *testing.T) {
+		api.EXPECT().GetAllEnvironments(gomock.Any(), "proj").Return([]ldapi.Environment{
+			{Key: "test", ApiKey: "sdk-test", MobileKey: "mob-test", Id: "id-test"},
+			{Key: "production", ApiKey: "sdk-production", MobileKey: "mob-production", Id: "id-production"},
+		}, nil)
+		store.EXPECT().SetEnvironmentKeys(gomock.Any(), "proj", map[string]model.EnvironmentKeys{
+			"test":       {SdkKey: "sdk-test", MobileKey: "mob-test", ClientSideId: "id-test"},
+			"production": {SdkKey: "sdk-production", MobileKey: "mob-production", ClientSideId: "id-production"},
+		}).Return(true, nil)
+
+		assert.NoError(t, model.PrefetchEnvironmentKeys(ctx, "proj"))
+	})
+
+	t.Run("returns ErrNotFound if the project is gone", func(t *testing.T) {
+		api.EXPECT().GetAllEnvironments(gomock.Any(), "proj").Return(nil, nil)
+		store.EXPECT().SetEnvironmentKeys(gomock.Any(), "proj", gomock.Any()).Return(false, nil)
+
+		err := model.PrefetchEnvironmentKeys(ctx, "proj")
+		assert.True(t, errors.As(err, &model.ErrNotFound{}))
+	})
+}
+
+func TestUpdateProjectWithPrefetchedKeys(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	allFlagsState := flagstate.NewAllFlagsBuilder().Build()
+	prefetched := func() *model.Project {
+		return &model.Project{
+			Key:                  "proj",
+			SourceEnvironmentKey: "test",
+			EnvironmentKeys: map[string]model.EnvironmentKeys{
+				"test":       {SdkKey: "sdk-test"},
+				"production": {SdkKey: "sdk-production"},
+			},
+		}
+	}
+	expectUpdate := func() {
+		api.EXPECT().GetAllFlags(gomock.Any(), "proj", gomock.Any()).Return(nil, nil)
+		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().SetSyncStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "proj").Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+	}
+	production := "production"
+
+	t.Run("switches environments without looking up the SDK key", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(prefetched(), nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-production").Return(allFlagsState, nil)
+		expectUpdate()
+
+		project, err := model.UpdateProject(ctx, "proj", nil, &production, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "production", project.SourceEnvironmentKey)
+	})
+
+	t.Run("replaces a prefetched SDK key that's been rotated", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(prefetched(), nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-production").Return(flagstate.AllFlags{}, errors.New("invalid sdk key"))
+		api.EXPECT().GetSdkKey(gomock.Any(), "proj", "production").Return("sdk-rotated", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-rotated").Return(allFlagsState, nil)
+		store.EXPECT().SetEnvironmentKeys(gomock.Any(), "proj", map[string]model.EnvironmentKeys{
+			"test":       {SdkKey: "sdk-test"},
+			"production": {SdkKey: "sdk-rotated"},
+		}).Return(true, nil)
+		expectUpdate()
+
+		_, err := model.UpdateProject(ctx, "proj", nil, &production, nil)
+		require.NoError(t, err)
+	})
+}
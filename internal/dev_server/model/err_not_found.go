@@ -17,3 +17,8 @@ func NewErrNotFound(kind, key string) ErrNotFound {
 		key:  key,
 	}
 }
+
+// Kind is the kind of thing that wasn't found, e.g. "project" or "flag".
+func (e ErrNotFound) Kind() string {
+	return e.kind
+}
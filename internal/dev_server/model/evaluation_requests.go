@@ -0,0 +1,211 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const ctxKeyEvaluationRequests = ctxKey("model.EvaluationRequests")
+
+// EvaluationSource is where the value an SDK evaluated a flag to came from.
+type EvaluationSource string
+
+const (
+	// EvaluationSourceOverride is a value served from an override in any layer.
+	EvaluationSourceOverride EvaluationSource = "override"
+	// EvaluationSourceCloud is a value synced from LaunchDarkly.
+	EvaluationSourceCloud EvaluationSource = "cloud"
+	// EvaluationSourceFallback is the SDK's default value, which it falls back to for flags the project doesn't have.
+	EvaluationSourceFallback EvaluationSource = "fallback"
+)
+
+// EvaluationRequest is an SDK's evaluation of a flag, as reported in the events it sends.
+type EvaluationRequest struct {
+	ID         int64
+	Time       time.Time
+	ProjectKey string
+	FlagKey    string
+	// Context is undefined when the SDK only reported how many times it evaluated the flag, as in summary events.
+	Context ldcontext.Context
+	Value   ldvalue.Value
+	Source  EvaluationSource
+	// Count is how many times the flag was evaluated to Value. Summary events count many evaluations at once.
+	Count int64
+}
+
+// EvaluationRequests is a rolling buffer of the most recent evaluations reported by SDKs, so that developers can
+// check which flags their app is evaluating. Once full, the oldest evaluations are dropped.
+type EvaluationRequests struct {
+	mu       sync.Mutex
+	requests []EvaluationRequest
+	capacity int
+	nextID   int64
+	// recording is where every evaluation is written as it's added, if anywhere
+	recording io.Writer
+	// usage counts every evaluation by the hour, long after it's dropped from requests
+	usage map[usageBucketKey]*usageBucket
+}
+
+func NewEvaluationRequests(capacity int) *EvaluationRequests {
+	return &EvaluationRequests{
+		requests: make([]EvaluationRequest, 0, capacity),
+		capacity: capacity,
+		nextID:   1,
+		usage:    make(map[usageBucketKey]*usageBucket),
+	}
+}
+
+func (b *EvaluationRequests) Add(request EvaluationRequest) EvaluationRequest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	request.ID = b.nextID
+	if request.Time.IsZero() {
+		request.Time = time.Now()
+	}
+	b.nextID++
+	if len(b.requests) >= b.capacity {
+		b.requests = append(b.requests[1:], request)
+	} else {
+		b.requests = append(b.requests, request)
+	}
+	b.countUsage(request)
+	if b.recording != nil {
+		b.record(request)
+	}
+	return request
+}
+
+// RecordedEvaluation is an evaluation as it's written to a recording, one JSON object per line, so that it can be
+// replayed with `ldcli dev-server replay`.
+type RecordedEvaluation struct {
+	Time       time.Time          `json:"time"`
+	ProjectKey string             `json:"projectKey"`
+	FlagKey    string             `json:"flagKey"`
+	Context    *ldcontext.Context `json:"context,omitempty"`
+	Value      ldvalue.Value      `json:"value"`
+	Source     EvaluationSource   `json:"source"`
+	Count      int64              `json:"count"`
+}
+
+// RecordTo writes every evaluation added from now on to w, so that the evaluations can be replayed once the flags or
+// overrides they depend on have changed.
+func (b *EvaluationRequests) RecordTo(w io.Writer) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.recording = w
+}
+
+func (b *EvaluationRequests) record(request EvaluationRequest) {
+	recorded := RecordedEvaluation{
+		Time:       request.Time,
+		ProjectKey: request.ProjectKey,
+		FlagKey:    request.FlagKey,
+		Value:      request.Value,
+		Source:     request.Source,
+		Count:      request.Count,
+	}
+	if request.Context.IsDefined() {
+		recorded.Context = &request.Context
+	}
+	data, err := json.Marshal(recorded)
+	if err == nil {
+		_, err = b.recording.Write(append(data, '\n'))
+	}
+	if err != nil {
+		logs.Printf(logs.Error, "", "unable to record evaluation of flag [%s]: %v", request.FlagKey, err)
+	}
+}
+
+// EvaluationRequestsQuery filters the evaluations returned from the buffer. Zero values match everything.
+type EvaluationRequestsQuery struct {
+	AfterID    int64
+	ProjectKey string
+	FlagKey    string
+	Source     EvaluationSource
+	Limit      int
+}
+
+// Query returns the buffered evaluations matching the query, oldest first. When there are more matching evaluations
+// than the limit, the most recent ones are returned.
+func (b *EvaluationRequests) Query(query EvaluationRequestsQuery) []EvaluationRequest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	matching := make([]EvaluationRequest, 0)
+	for _, request := range b.requests {
+		if request.ID <= query.AfterID {
+			continue
+		}
+		if query.ProjectKey != "" && request.ProjectKey != query.ProjectKey {
+			continue
+		}
+		if query.FlagKey != "" && request.FlagKey != query.FlagKey {
+			continue
+		}
+		if query.Source != "" && request.Source != query.Source {
+			continue
+		}
+		matching = append(matching, request)
+	}
+	if query.Limit > 0 && len(matching) > query.Limit {
+		matching = matching[len(matching)-query.Limit:]
+	}
+	return matching
+}
+
+// RecordEvaluationRequests adds evaluations an SDK reported for the project to the buffer on the context, working out
+// whether the project served each value from an override or from LaunchDarkly. Evaluations marked with
+// EvaluationSourceFallback are kept as they are. Nothing is recorded if there's no buffer on the context.
+func RecordEvaluationRequests(ctx context.Context, projectKey string, requests []EvaluationRequest) {
+	buffer := EvaluationRequestsFromContext(ctx)
+	if buffer == nil || len(requests) == 0 {
+		return
+	}
+	var layers map[string]OverrideLayer
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err == nil {
+		_, layers, err = project.GetFlagStateWithLayersForProject(ctx)
+	}
+	if err != nil && !errors.As(err, &ErrNotFound{}) {
+		logs.Printf(logs.Error, projectKey, "unable to find where evaluations in project [%s] came from: %v", projectKey, err)
+	}
+	for _, request := range requests {
+		request.ProjectKey = projectKey
+		if request.Source != EvaluationSourceFallback {
+			request.Source = EvaluationSourceCloud
+			if layer, ok := layers[request.FlagKey]; ok && layer != LayerSource {
+				request.Source = EvaluationSourceOverride
+			}
+		}
+		buffer.Add(request)
+	}
+}
+
+func ContextWithEvaluationRequests(ctx context.Context, buffer *EvaluationRequests) context.Context {
+	return context.WithValue(ctx, ctxKeyEvaluationRequests, buffer)
+}
+
+// EvaluationRequestsFromContext returns the buffer set on the context, or nil if there isn't one.
+func EvaluationRequestsFromContext(ctx context.Context) *EvaluationRequests {
+	buffer, _ := ctx.Value(ctxKeyEvaluationRequests).(*EvaluationRequests)
+	return buffer
+}
+
+func EvaluationRequestsMiddleware(buffer *EvaluationRequests) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithEvaluationRequests(r.Context(), buffer)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestEvaluationRequests(t *testing.T) {
+	evaluation := func(projectKey, flagKey string, source model.EvaluationSource) model.EvaluationRequest {
+		return model.EvaluationRequest{ProjectKey: projectKey, FlagKey: flagKey, Value: ldvalue.Bool(true), Source: source, Count: 1}
+	}
+
+	t.Run("drops the oldest evaluations once full", func(t *testing.T) {
+		buffer := model.NewEvaluationRequests(2)
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceCloud))
+		buffer.Add(evaluation("proj", "b", model.EvaluationSourceCloud))
+		buffer.Add(evaluation("proj", "c", model.EvaluationSourceCloud))
+
+		requests := buffer.Query(model.EvaluationRequestsQuery{})
+		require.Len(t, requests, 2)
+		assert.Equal(t, int64(2), requests[0].ID)
+		assert.Equal(t, "c", requests[1].FlagKey)
+		assert.False(t, requests[1].Time.IsZero())
+	})
+
+	t.Run("filters by id, project, flag and source", func(t *testing.T) {
+		buffer := model.NewEvaluationRequests(10)
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceOverride))
+		buffer.Add(evaluation("other", "a", model.EvaluationSourceOverride))
+		buffer.Add(evaluation("proj", "b", model.EvaluationSourceOverride))
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceCloud))
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceOverride))
+
+		requests := buffer.Query(model.EvaluationRequestsQuery{AfterID: 1, ProjectKey: "proj", FlagKey: "a", Source: model.EvaluationSourceOverride})
+		require.Len(t, requests, 1)
+		assert.Equal(t, int64(5), requests[0].ID)
+	})
+
+	t.Run("limit keeps the most recent matches", func(t *testing.T) {
+		buffer := model.NewEvaluationRequests(10)
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceCloud))
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceCloud))
+		buffer.Add(evaluation("proj", "a", model.EvaluationSourceCloud))
+
+		requests := buffer.Query(model.EvaluationRequestsQuery{Limit: 2})
+		require.Len(t, requests, 2)
+		assert.Equal(t, int64(2), requests[0].ID)
+	})
+
+	t.Run("records evaluations as JSON lines once recording", func(t *testing.T) {
+		buffer := model.NewEvaluationRequests(10)
+		buffer.Add(evaluation("proj", "before", model.EvaluationSourceCloud))
+		var recording bytes.Buffer
+		buffer.RecordTo(&recording)
+		withContext := evaluation("proj", "a", model.EvaluationSourceOverride)
+		withContext.Context = ldcontext.New("alice")
+		buffer.Add(withContext)
+		buffer.Add(evaluation("proj", "b", model.EvaluationSourceCloud))
+
+		lines := strings.Split(strings.TrimSpace(recording.String()), "\n")
+		require.Len(t, lines, 2)
+		var recorded model.RecordedEvaluation
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &recorded))
+		assert.Equal(t, "a", recorded.FlagKey)
+		assert.Equal(t, model.EvaluationSourceOverride, recorded.Source)
+		require.NotNil(t, recorded.Context)
+		assert.Equal(t, ldcontext.New("alice"), *recorded.Context)
+		var withoutContext model.RecordedEvaluation
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &withoutContext))
+		assert.Equal(t, "b", withoutContext.FlagKey)
+		assert.Nil(t, withoutContext.Context)
+	})
+}
@@ -1,14 +1,58 @@
 package model
 
-// Event for individual flag overrides
+import "context"
+
+// Event for a change to an individual flag's effective state, whether from an override, a scenario, or a sync
 type OverrideEvent struct {
 	FlagKey    string
 	ProjectKey string
 	FlagState  FlagState
 }
 
-// Event for full project sync
+// Event for a flag that no longer exists in the project after a sync
+type FlagDeletedEvent struct {
+	FlagKey    string
+	ProjectKey string
+	Version    int
+}
+
+// Event for replacing a project's entire flag state, e.g. after restoring a backup. Routine changes are sent per flag
+// with notifyFlagsStateChanges instead, so connected SDKs only get full state when they (re)connect.
 type SyncEvent struct {
 	ProjectKey    string
 	AllFlagsState FlagsState
+	// PreviousFlagsState is the project's effective flag state before it was replaced, so observers that act on
+	// individual flags can tell which ones changed. It's nil for a project that didn't exist before.
+	PreviousFlagsState FlagsState
+}
+
+// notifyFlagsStateChanges tells observers about each flag whose effective state differs between previous and current.
+func notifyFlagsStateChanges(ctx context.Context, projectKey string, previous, current FlagsState) {
+	observers := GetObserversFromContext(ctx)
+	for flagKey, state := range current {
+		if !flagStateChanged(previous, flagKey, state) {
+			continue
+		}
+		observers.Notify(OverrideEvent{
+			FlagKey:    flagKey,
+			ProjectKey: projectKey,
+			FlagState:  state,
+		})
+	}
+	for flagKey, previousState := range previous {
+		if _, ok := current[flagKey]; ok {
+			continue
+		}
+		observers.Notify(FlagDeletedEvent{
+			FlagKey:    flagKey,
+			ProjectKey: projectKey,
+			Version:    previousState.Version + 1,
+		})
+	}
+}
+
+// flagStateChanged is whether a flag's effective state differs from the one it had in previous.
+func flagStateChanged(previous FlagsState, flagKey string, state FlagState) bool {
+	previousState, ok := previous[flagKey]
+	return !ok || previousState.Version != state.Version || previousState.TrackEvents != state.TrackEvents || previousState.Archived != state.Archived || !previousState.Value.Equal(state.Value)
 }
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const ctxKeyEventsBuffer = ctxKey("model.EventsBuffer")
+
+// BufferedEvent is an analytics event received from an SDK and held in memory for inspection.
+type BufferedEvent struct {
+	ID         int64
+	ReceivedAt time.Time
+	ProjectKey string
+	Kind       string
+	Data       json.RawMessage
+}
+
+// EventsBuffer is a rolling buffer of the most recent SDK events. Once full, the oldest events are dropped.
+type EventsBuffer struct {
+	mu       sync.Mutex
+	events   []BufferedEvent
+	capacity int
+	nextID   int64
+}
+
+func NewEventsBuffer(capacity int) *EventsBuffer {
+	return &EventsBuffer{
+		events:   make([]BufferedEvent, 0, capacity),
+		capacity: capacity,
+		nextID:   1,
+	}
+}
+
+func (b *EventsBuffer) Add(projectKey, kind string, data json.RawMessage) BufferedEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	event := BufferedEvent{
+		ID:         b.nextID,
+		ReceivedAt: time.Now(),
+		ProjectKey: projectKey,
+		Kind:       kind,
+		Data:       data,
+	}
+	b.nextID++
+	if len(b.events) >= b.capacity {
+		b.events = append(b.events[1:], event)
+	} else {
+		b.events = append(b.events, event)
+	}
+	return event
+}
+
+// EventsQuery filters the events returned from the buffer. Zero values match everything.
+type EventsQuery struct {
+	AfterID    int64
+	Kind       string
+	ProjectKey string
+	Limit      int
+}
+
+// Query returns the buffered events matching the query, oldest first. When there are more matching events than the
+// limit, the oldest ones after AfterID are returned if it's set, so tailing doesn't skip any, and the most recent ones
+// otherwise.
+func (b *EventsBuffer) Query(query EventsQuery) []BufferedEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	matching := make([]BufferedEvent, 0)
+	for _, event := range b.events {
+		if event.ID <= query.AfterID {
+			continue
+		}
+		if query.Kind != "" && event.Kind != query.Kind {
+			continue
+		}
+		if query.ProjectKey != "" && event.ProjectKey != query.ProjectKey {
+			continue
+		}
+		if query.AfterID > 0 && query.Limit > 0 && len(matching) == query.Limit {
+			break
+		}
+		matching = append(matching, event)
+	}
+	if query.Limit > 0 && len(matching) > query.Limit {
+		matching = matching[len(matching)-query.Limit:]
+	}
+	return matching
+}
+
+func ContextWithEventsBuffer(ctx context.Context, buffer *EventsBuffer) context.Context {
+	return context.WithValue(ctx, ctxKeyEventsBuffer, buffer)
+}
+
+func EventsBufferFromContext(ctx context.Context) *EventsBuffer {
+	return ctx.Value(ctxKeyEventsBuffer).(*EventsBuffer)
+}
+
+func EventsBufferMiddleware(buffer *EventsBuffer) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithEventsBuffer(r.Context(), buffer)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestEventsBuffer(t *testing.T) {
+	data := json.RawMessage(`{}`)
+
+	t.Run("drops the oldest events once full", func(t *testing.T) {
+		buffer := model.NewEventsBuffer(2)
+		buffer.Add("proj", "feature", data)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("proj", "identify", data)
+
+		events := buffer.Query(model.EventsQuery{})
+		require.Len(t, events, 2)
+		assert.Equal(t, int64(2), events[0].ID)
+		assert.Equal(t, int64(3), events[1].ID)
+	})
+
+	t.Run("filters by id, kind and project", func(t *testing.T) {
+		buffer := model.NewEventsBuffer(10)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("other", "custom", data)
+		buffer.Add("proj", "feature", data)
+		buffer.Add("proj", "custom", data)
+
+		events := buffer.Query(model.EventsQuery{AfterID: 1, Kind: "custom", ProjectKey: "proj"})
+		require.Len(t, events, 1)
+		assert.Equal(t, int64(4), events[0].ID)
+	})
+
+	t.Run("limit keeps the most recent matches", func(t *testing.T) {
+		buffer := model.NewEventsBuffer(10)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("proj", "custom", data)
+
+		events := buffer.Query(model.EventsQuery{Limit: 2})
+		require.Len(t, events, 2)
+		assert.Equal(t, int64(2), events[0].ID)
+	})
+
+	t.Run("limit keeps the oldest matches after AfterID", func(t *testing.T) {
+		buffer := model.NewEventsBuffer(10)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("proj", "custom", data)
+		buffer.Add("proj", "custom", data)
+
+		events := buffer.Query(model.EventsQuery{AfterID: 1, Limit: 2})
+		require.Len(t, events, 2)
+		assert.Equal(t, int64(2), events[0].ID)
+		assert.Equal(t, int64(3), events[1].ID)
+	})
+}
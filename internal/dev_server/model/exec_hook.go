@@ -0,0 +1,161 @@
+package model
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// execHookQuietPeriod is how long an exec hook waits for further changes before running, so that a flag toggled
+// several times in a row, or changed again by a sync right after being overridden, runs the command once.
+const execHookQuietPeriod = 100 * time.Millisecond
+
+// execHookTimeout bounds how long a hook's command can run, generously since it may restart a service or run tests.
+const execHookTimeout = 5 * time.Minute
+
+// ExecHookConfig is a shell command to run when flags change.
+type ExecHookConfig struct {
+	Command string `json:"command"`
+	// Flags are the keys of the flags whose changes run the command. Every flag's do if it's empty.
+	Flags []string `json:"flags,omitempty"`
+}
+
+// ExecHook is an Observer that runs a shell command whenever one of its flags changes, e.g. to restart a service that
+// reads flags at start time, bust a cache, or trigger tests. The command is run once for each changed flag, with the
+// flag in these environment variables:
+//   - LD_PROJECT_KEY: the project the flag changed in
+//   - LD_FLAG_KEY: the flag's key
+//   - LD_FLAG_VALUE: the flag's new value as JSON, or empty if it was deleted
+//   - LD_FLAG_VERSION: the flag's new version
+//   - LD_FLAG_DELETED: true if the flag was deleted, otherwise false
+type ExecHook struct {
+	command  string
+	flagKeys map[string]struct{}
+	wait     time.Duration
+
+	mu      sync.Mutex
+	changed map[execHookFlag]execHookChange
+	timer   *time.Timer
+}
+
+type execHookFlag struct {
+	projectKey string
+	flagKey    string
+}
+
+type execHookChange struct {
+	state   FlagState
+	deleted bool
+}
+
+// NewExecHook returns a hook that runs config's command when any of its flags change.
+func NewExecHook(config ExecHookConfig) *ExecHook {
+	hook := &ExecHook{
+		command: config.Command,
+		wait:    execHookQuietPeriod,
+		changed: make(map[execHookFlag]execHookChange),
+	}
+	if len(config.Flags) > 0 {
+		hook.flagKeys = make(map[string]struct{}, len(config.Flags))
+		for _, flagKey := range config.Flags {
+			hook.flagKeys[flagKey] = struct{}{}
+		}
+	}
+	return hook
+}
+
+func (h *ExecHook) Handle(event interface{}) {
+	switch event := event.(type) {
+	case OverrideEvent:
+		h.flagChanged(event.ProjectKey, event.FlagKey, execHookChange{state: event.FlagState})
+	case FlagDeletedEvent:
+		h.flagChanged(event.ProjectKey, event.FlagKey, execHookChange{state: FlagState{Version: event.Version}, deleted: true})
+	case SyncEvent:
+		for flagKey, state := range event.AllFlagsState {
+			if flagStateChanged(event.PreviousFlagsState, flagKey, state) {
+				h.flagChanged(event.ProjectKey, flagKey, execHookChange{state: state})
+			}
+		}
+		for flagKey, previousState := range event.PreviousFlagsState {
+			if _, ok := event.AllFlagsState[flagKey]; !ok {
+				h.flagChanged(event.ProjectKey, flagKey, execHookChange{state: FlagState{Version: previousState.Version + 1}, deleted: true})
+			}
+		}
+	}
+}
+
+func (h *ExecHook) flagChanged(projectKey, flagKey string, change execHookChange) {
+	if h.flagKeys != nil {
+		if _, ok := h.flagKeys[flagKey]; !ok {
+			return
+		}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.changed[execHookFlag{projectKey: projectKey, flagKey: flagKey}] = change
+	if h.timer != nil {
+		h.timer.Stop()
+	}
+	h.timer = time.AfterFunc(h.wait, h.fire)
+}
+
+func (h *ExecHook) fire() {
+	h.mu.Lock()
+	changed := h.changed
+	h.changed = make(map[execHookFlag]execHookChange)
+	h.timer = nil
+	h.mu.Unlock()
+
+	flags := make([]execHookFlag, 0, len(changed))
+	for flag := range changed {
+		flags = append(flags, flag)
+	}
+	sort.Slice(flags, func(i, j int) bool {
+		if flags[i].projectKey != flags[j].projectKey {
+			return flags[i].projectKey < flags[j].projectKey
+		}
+		return flags[i].flagKey < flags[j].flagKey
+	})
+	for _, flag := range flags {
+		h.run(flag, changed[flag])
+	}
+}
+
+func (h *ExecHook) run(flag execHookFlag, change execHookChange) {
+	ctx, cancel := context.WithTimeout(context.Background(), execHookTimeout)
+	defer cancel()
+	value := ""
+	if !change.deleted {
+		value = change.state.Value.JSONString()
+	}
+	cmd := shellCommand(ctx, h.command)
+	cmd.Env = append(os.Environ(),
+		"LD_PROJECT_KEY="+flag.projectKey,
+		"LD_FLAG_KEY="+flag.flagKey,
+		"LD_FLAG_VALUE="+value,
+		"LD_FLAG_VERSION="+strconv.Itoa(change.state.Version),
+		"LD_FLAG_DELETED="+strconv.FormatBool(change.deleted),
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		logs.Printf(logs.Error, flag.projectKey, "exec hook: `%s` failed for flag [%s] in project [%s]: %s: %s", h.command, flag.flagKey, flag.projectKey, err, strings.TrimSpace(string(output)))
+		return
+	}
+	logs.Printf(logs.Info, flag.projectKey, "exec hook: ran `%s` for flag [%s] in project [%s]", h.command, flag.flagKey, flag.projectKey)
+}
+
+// shellCommand runs command with the platform's shell.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestExecHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	startHook := func(t *testing.T, flags []string) (*model.ExecHook, func() []string) {
+		path := filepath.Join(t.TempDir(), "runs")
+		hook := model.NewExecHook(model.ExecHookConfig{
+			Command: `echo "$LD_PROJECT_KEY $LD_FLAG_KEY $LD_FLAG_VALUE $LD_FLAG_VERSION $LD_FLAG_DELETED" >> ` + path,
+			Flags:   flags,
+		})
+		return hook, func() []string {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil
+			}
+			return strings.Split(strings.TrimSpace(string(data)), "\n")
+		}
+	}
+
+	t.Run("runs the command once for each changed flag with its latest state", func(t *testing.T) {
+		hook, runs := startHook(t, nil)
+
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "flag-b", FlagState: model.FlagState{Value: ldvalue.Bool(true), Version: 2}})
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "flag-b", FlagState: model.FlagState{Value: ldvalue.Bool(false), Version: 3}})
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "flag-a", FlagState: model.FlagState{Value: ldvalue.String("red"), Version: 1}})
+		hook.Handle(model.FlagDeletedEvent{ProjectKey: "proj", FlagKey: "flag-c", Version: 4})
+
+		require.Eventually(t, func() bool { return len(runs()) == 3 }, 2*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{
+			`proj flag-a "red" 1 false`,
+			`proj flag-b false 3 false`,
+			`proj flag-c  4 true`,
+		}, runs())
+	})
+
+	t.Run("only runs the command for the configured flags", func(t *testing.T) {
+		hook, runs := startHook(t, []string{"cache-version"})
+
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "other-flag", FlagState: model.FlagState{Value: ldvalue.Bool(true)}})
+		hook.Handle(model.SyncEvent{ProjectKey: "proj", AllFlagsState: model.FlagsState{
+			"cache-version": {Value: ldvalue.Int(7), Version: 5},
+			"other-flag":    {Value: ldvalue.Bool(true), Version: 1},
+		}})
+
+		require.Eventually(t, func() bool { return len(runs()) > 0 }, 2*time.Second, 10*time.Millisecond)
+		time.Sleep(200 * time.Millisecond)
+		assert.Equal(t, []string{"proj cache-version 7 5 false"}, runs())
+	})
+
+	t.Run("only runs the command for the flags a sync changed", func(t *testing.T) {
+		hook, runs := startHook(t, nil)
+
+		hook.Handle(model.SyncEvent{
+			ProjectKey: "proj",
+			AllFlagsState: model.FlagsState{
+				"changed-flag":   {Value: ldvalue.Bool(false), Version: 2},
+				"unchanged-flag": {Value: ldvalue.Bool(true), Version: 1},
+			},
+			PreviousFlagsState: model.FlagsState{
+				"changed-flag":   {Value: ldvalue.Bool(true), Version: 3},
+				"unchanged-flag": {Value: ldvalue.Bool(true), Version: 1},
+				"removed-flag":   {Value: ldvalue.Bool(true), Version: 4},
+			},
+		})
+
+		require.Eventually(t, func() bool { return len(runs()) == 2 }, 2*time.Second, 10*time.Millisecond)
+		time.Sleep(200 * time.Millisecond)
+		assert.Equal(t, []string{
+			"proj changed-flag false 2 false",
+			"proj removed-flag  5 true",
+		}, runs())
+	})
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// ExperimentSimulation is how an experiment's rollout would allocate contexts between its treatments.
+type ExperimentSimulation struct {
+	Rollout     Rollout
+	Assignments []ExperimentAssignment
+	// Counts are how many of the contexts each treatment got, in the order of the rollout's variations.
+	Counts []int
+}
+
+// ExperimentAssignment is the treatment a context gets.
+type ExperimentAssignment struct {
+	Context ldcontext.Context
+	// Bucket is where the context hashed to, from 0 up to 1. It's how the treatment was picked unless Forced is set.
+	Bucket float32
+	// Variation is the index of the rollout's variation the context gets.
+	Variation int
+	Value     ldvalue.Value
+	Forced    bool
+}
+
+// SimulateExperiment buckets the contexts with the rollout the way SDKs would, without serving anything. The flag's
+// rollout override is used if rollout is nil.
+func SimulateExperiment(ctx context.Context, projectKey, flagKey string, rollout *Rollout, contexts []ldcontext.Context) (ExperimentSimulation, error) {
+	project, err := getProjectWithFlag(ctx, projectKey, flagKey)
+	if err != nil {
+		return ExperimentSimulation{}, err
+	}
+	if rollout == nil {
+		flagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+		if err != nil {
+			return ExperimentSimulation{}, err
+		}
+		rollout = flagsState[flagKey].Rollout
+		if rollout == nil {
+			return ExperimentSimulation{}, errors.WithStack(NewErrNotFound("rollout override for flag", flagKey))
+		}
+	}
+	if err := rollout.Validate(); err != nil {
+		return ExperimentSimulation{}, err
+	}
+
+	simulation := ExperimentSimulation{
+		Rollout:     *rollout,
+		Assignments: make([]ExperimentAssignment, 0, len(contexts)),
+		Counts:      make([]int, len(rollout.Variations)),
+	}
+	for _, ldCtx := range contexts {
+		assignment := ExperimentAssignment{Context: ldCtx, Bucket: rollout.bucket(flagKey, ldCtx)}
+		assignment.Variation, assignment.Forced = rollout.forcedVariation(ldCtx)
+		if !assignment.Forced {
+			assignment.Variation = rollout.variationIndexForBucket(assignment.Bucket)
+		}
+		assignment.Value = rollout.Variations[assignment.Variation].Value
+		simulation.Assignments = append(simulation.Assignments, assignment)
+		simulation.Counts[assignment.Variation]++
+	}
+	return simulation, nil
+}
+
+// ForceTreatment puts a context in one of the variations of the flag's rollout override, replacing the variation it
+// was forced into before, so that a treatment of an experiment can be tried out without finding a context that's
+// bucketed into it.
+func ForceTreatment(ctx context.Context, projectKey, flagKey string, treatment ForcedTreatment) (Override, error) {
+	return updateForcedTreatments(ctx, projectKey, flagKey, func(rollout *Rollout) error {
+		if treatment.Variation < 0 || treatment.Variation >= len(rollout.Variations) {
+			return errors.WithStack(NewErrNotFound("rollout variation", strconv.Itoa(treatment.Variation)))
+		}
+		rollout.ForcedTreatments = append(withoutForcedTreatment(rollout.ForcedTreatments, treatment.ContextKind, treatment.ContextKey), treatment)
+		return nil
+	})
+}
+
+// UnforceTreatment lets the context be bucketed into a variation of the flag's rollout override again.
+func UnforceTreatment(ctx context.Context, projectKey, flagKey, contextKind, contextKey string) (Override, error) {
+	return updateForcedTreatments(ctx, projectKey, flagKey, func(rollout *Rollout) error {
+		rollout.ForcedTreatments = withoutForcedTreatment(rollout.ForcedTreatments, contextKind, contextKey)
+		return nil
+	})
+}
+
+func updateForcedTreatments(ctx context.Context, projectKey, flagKey string, update func(*Rollout) error) (Override, error) {
+	if _, err := getProjectWithFlag(ctx, projectKey, flagKey); err != nil {
+		return Override{}, err
+	}
+	overrides, err := StoreFromContext(ctx).GetOverridesForProject(ctx, projectKey)
+	if err != nil {
+		return Override{}, err
+	}
+	override, ok := lo.Find(overrides, func(override Override) bool {
+		return override.FlagKey == flagKey && override.Active && override.Rollout != nil
+	})
+	if !ok {
+		return Override{}, errors.WithStack(NewErrNotFound("rollout override for flag", flagKey))
+	}
+
+	rollout := *override.Rollout
+	rollout.ForcedTreatments = append([]ForcedTreatment(nil), rollout.ForcedTreatments...)
+	if err := update(&rollout); err != nil {
+		return Override{}, err
+	}
+	override.Rollout = &rollout
+	override, err = upsertOverride(ctx, override)
+	if err != nil {
+		return Override{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Updated forced treatments of flag [%s] in project [%s]", flagKey, projectKey)
+	return override, nil
+}
+
+func withoutForcedTreatment(treatments []ForcedTreatment, contextKind, contextKey string) []ForcedTreatment {
+	kind := ldcontext.Kind(contextKind)
+	if kind == "" {
+		kind = ldcontext.DefaultKind
+	}
+	return lo.Reject(treatments, func(forced ForcedTreatment, _ int) bool {
+		forcedKind := ldcontext.Kind(forced.ContextKind)
+		if forcedKind == "" {
+			forcedKind = ldcontext.DefaultKind
+		}
+		return forcedKind == kind && forced.ContextKey == contextKey
+	})
+}
@@ -0,0 +1,95 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestExperiments(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("dev"),
+		AllFlagsState: model.FlagsState{
+			"checkout": {Value: ldvalue.String("control"), Version: 1},
+			"banner":   {Value: ldvalue.String("blue"), Version: 1},
+		},
+	}))
+	rollout := model.Rollout{Variations: []model.WeightedValue{
+		{Value: ldvalue.String("control"), Weight: 50000},
+		{Value: ldvalue.String("treatment"), Weight: 50000},
+	}}
+	contexts := []ldcontext.Context{ldcontext.New("alice"), ldcontext.New("bob"), ldcontext.New("carol"), ldcontext.New("dave")}
+
+	t.Run("simulates the given rollout the way SDKs bucket contexts", func(t *testing.T) {
+		simulation, err := model.SimulateExperiment(ctx, "proj", "checkout", &rollout, contexts)
+		require.NoError(t, err)
+		require.Len(t, simulation.Assignments, len(contexts))
+		counts := make([]int, len(rollout.Variations))
+		for i, assignment := range simulation.Assignments {
+			assert.Equal(t, contexts[i], assignment.Context)
+			assert.Equal(t, rollout.ValueFor("checkout", contexts[i]), assignment.Value)
+			assert.Equal(t, rollout.Variations[assignment.Variation].Value, assignment.Value)
+			assert.False(t, assignment.Forced)
+			counts[assignment.Variation]++
+		}
+		assert.Equal(t, counts, simulation.Counts)
+	})
+
+	t.Run("rejects an invalid rollout", func(t *testing.T) {
+		_, err := model.SimulateExperiment(ctx, "proj", "checkout", &model.Rollout{}, contexts)
+		assert.Error(t, err)
+	})
+
+	t.Run("returns ErrNotFound without a rollout override to simulate", func(t *testing.T) {
+		_, err := model.SimulateExperiment(ctx, "proj", "banner", nil, contexts)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("forces contexts into a treatment of the rollout override", func(t *testing.T) {
+		_, err := model.UpsertRolloutOverride(ctx, "proj", "checkout", rollout)
+		require.NoError(t, err)
+		alice := ldcontext.New("alice")
+		simulation, err := model.SimulateExperiment(ctx, "proj", "checkout", nil, []ldcontext.Context{alice})
+		require.NoError(t, err)
+		forced := 1 - simulation.Assignments[0].Variation
+
+		override, err := model.ForceTreatment(ctx, "proj", "checkout", model.ForcedTreatment{ContextKey: "alice", Variation: forced})
+		require.NoError(t, err)
+		require.NotNil(t, override.Rollout)
+		assert.Equal(t, []model.ForcedTreatment{{ContextKey: "alice", Variation: forced}}, override.Rollout.ForcedTreatments)
+		assert.Equal(t, rollout.Variations[forced].Value, override.Rollout.ValueFor("checkout", alice))
+
+		simulation, err = model.SimulateExperiment(ctx, "proj", "checkout", nil, []ldcontext.Context{alice})
+		require.NoError(t, err)
+		assert.True(t, simulation.Assignments[0].Forced)
+		assert.Equal(t, forced, simulation.Assignments[0].Variation)
+
+		override, err = model.UnforceTreatment(ctx, "proj", "checkout", "user", "alice")
+		require.NoError(t, err)
+		assert.Empty(t, override.Rollout.ForcedTreatments)
+	})
+
+	t.Run("returns ErrNotFound for variations the rollout doesn't have", func(t *testing.T) {
+		_, err := model.ForceTreatment(ctx, "proj", "checkout", model.ForcedTreatment{ContextKey: "alice", Variation: 2})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("returns ErrNotFound for flags without a rollout override", func(t *testing.T) {
+		_, err := model.ForceTreatment(ctx, "proj", "banner", model.ForcedTreatment{ContextKey: "alice"})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+// FlagExplanation is how the dev server arrived at the value it serves a context for a flag, for answering "why am I
+// seeing this value?" without piecing it together from the project's overrides.
+type FlagExplanation struct {
+	FlagKey string
+	// Context is the context the flag was explained for.
+	Context ldcontext.Context
+	// Source is what LaunchDarkly evaluated the flag to for the project's context when the project was last synced.
+	// It's the same for every context, since the dev server doesn't re-evaluate flags itself.
+	Source       FlagState
+	LastSyncTime time.Time
+	// Override is the override that replaced the source value, if there is one.
+	Override *AppliedOverride
+	// Prerequisites are how the flags LaunchDarkly checked before evaluating this one turned out.
+	Prerequisites []PrerequisiteOutcome
+	// Value is what's served to the context.
+	Value ldvalue.Value
+	Layer OverrideLayer
+	// Reason says why Value is served.
+	Reason string
+}
+
+// AppliedOverride is the override that produced a flag's effective value.
+type AppliedOverride struct {
+	Layer   OverrideLayer
+	Value   ldvalue.Value
+	Rollout *Rollout
+}
+
+// PrerequisiteOutcome is what a prerequisite flag evaluated to. Overriding a prerequisite changes what it's served,
+// but not the value of the flags that depend on it, which LaunchDarkly already evaluated.
+type PrerequisiteOutcome struct {
+	FlagKey string
+	// SourceValue is what LaunchDarkly evaluated the prerequisite to. It's null if the prerequisite isn't in the
+	// project, e.g. because the project's flag filter leaves it out.
+	SourceValue ldvalue.Value
+	// Value is what's served to the context for the prerequisite now.
+	Value ldvalue.Value
+	Layer OverrideLayer
+}
+
+// ExplainFlag explains the value the project serves ldCtx for the flag. The project's own context is used if ldCtx
+// is nil.
+func ExplainFlag(ctx context.Context, projectKey, flagKey string, ldCtx *ldcontext.Context) (FlagExplanation, error) {
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err != nil {
+		return FlagExplanation{}, err
+	}
+	flagsState, layers, err := project.GetFlagStateWithLayersForProject(ctx)
+	if err != nil {
+		return FlagExplanation{}, err
+	}
+	return explainFlag(flagKey, project.Context, ldCtx, project.AllFlagsState, project.LastSyncTime, flagsState, layers)
+}
+
+// ExplainFlagAt explains the value the project served ldCtx for the flag at the given time, from the snapshot of the
+// flag state that was current then and the overrides as they were. LastSyncTime is when that snapshot was recorded.
+func ExplainFlagAt(ctx context.Context, projectKey, flagKey string, ldCtx *ldcontext.Context, at time.Time) (FlagExplanation, error) {
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return FlagExplanation{}, err
+	}
+	snapshot, err := GetSnapshotAt(ctx, projectKey, at)
+	if err != nil {
+		return FlagExplanation{}, err
+	}
+	sourceState, overrides, err := store.GetProjectStateAt(ctx, projectKey, at)
+	if err != nil {
+		return FlagExplanation{}, err
+	}
+	flagsState, layers := overrides.ApplyAll(sourceState)
+	return explainFlag(flagKey, project.Context, ldCtx, sourceState, snapshot.RecordedAt, flagsState, layers)
+}
+
+// explainFlag explains the flag's value in flagsState, which is sourceState with overrides applied. ldCtx defaults
+// to projectContext.
+func explainFlag(flagKey string, projectContext ldcontext.Context, ldCtx *ldcontext.Context, sourceState FlagsState, lastSyncTime time.Time, flagsState FlagsState, layers map[string]OverrideLayer) (FlagExplanation, error) {
+	source, ok := sourceState[flagKey]
+	if !ok {
+		return FlagExplanation{}, NewErrNotFound("flag", flagKey)
+	}
+
+	explanation := FlagExplanation{
+		FlagKey:      flagKey,
+		Context:      projectContext,
+		Source:       source,
+		LastSyncTime: lastSyncTime,
+		Layer:        layers[flagKey],
+	}
+	if ldCtx != nil {
+		explanation.Context = *ldCtx
+	}
+	state := flagsState[flagKey]
+	explanation.Value = servedValue(flagKey, state, explanation.Context)
+	if explanation.Layer != LayerSource {
+		explanation.Override = &AppliedOverride{Layer: explanation.Layer, Value: state.Value, Rollout: state.Rollout}
+	}
+	for _, prerequisiteKey := range source.Prerequisites {
+		explanation.Prerequisites = append(explanation.Prerequisites, PrerequisiteOutcome{
+			FlagKey:     prerequisiteKey,
+			SourceValue: sourceState[prerequisiteKey].Value,
+			Value:       servedValue(prerequisiteKey, flagsState[prerequisiteKey], explanation.Context),
+			Layer:       layers[prerequisiteKey],
+		})
+	}
+
+	switch {
+	case explanation.Layer == LayerSource && source.Reason != nil:
+		explanation.Reason = fmt.Sprintf("LaunchDarkly served this value to the project's context: %s", source.Reason)
+	case explanation.Layer == LayerSource:
+		explanation.Reason = "LaunchDarkly served this value to the project's context"
+	case state.Rollout != nil && state.Rollout.PerEvaluation:
+		explanation.Reason = fmt.Sprintf("overridden in the %s layer with a rollout that picks a value at random each time flags are served", explanation.Layer)
+	case state.Rollout != nil:
+		explanation.Reason = fmt.Sprintf("overridden in the %s layer with a percentage rollout that buckets the context into this value", explanation.Layer)
+	default:
+		explanation.Reason = fmt.Sprintf("overridden in the %s layer", explanation.Layer)
+	}
+	return explanation, nil
+}
+
+// servedValue is the value the context gets for the flag. Values picked at random for each evaluation can't be known
+// ahead of time, so those flags are given the value served without a context, without using up a random pick.
+func servedValue(flagKey string, state FlagState, ldCtx ldcontext.Context) ldvalue.Value {
+	if state.Rollout == nil || state.Rollout.PerEvaluation {
+		return state.Value
+	}
+	return state.Rollout.ValueFor(flagKey, ldCtx)
+}
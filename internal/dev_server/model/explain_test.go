@@ -0,0 +1,85 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldreason"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestExplainFlag(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	ruleMatch := ldreason.NewEvalReasonRuleMatch(0, "rule-id")
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("dev"),
+		AllFlagsState: model.FlagsState{
+			"new-checkout": {Value: ldvalue.Bool(false), Version: 1, Reason: &ruleMatch, Prerequisites: []string{"payments"}},
+			"payments":     {Value: ldvalue.Bool(false), Version: 1},
+			"banner":       {Value: ldvalue.String("blue"), Version: 1},
+		},
+	}))
+
+	t.Run("source value has LaunchDarkly's reason and prerequisites", func(t *testing.T) {
+		_, err := model.UpsertOverride(ctx, "proj", "payments", ldvalue.Bool(true))
+		require.NoError(t, err)
+
+		explanation, err := model.ExplainFlag(ctx, "proj", "new-checkout", nil)
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.Bool(false), explanation.Value)
+		assert.Equal(t, model.LayerSource, explanation.Layer)
+		assert.Nil(t, explanation.Override)
+		assert.Equal(t, &ruleMatch, explanation.Source.Reason)
+		assert.Contains(t, explanation.Reason, "RULE_MATCH")
+		assert.Equal(t, []model.PrerequisiteOutcome{
+			{FlagKey: "payments", SourceValue: ldvalue.Bool(false), Value: ldvalue.Bool(true), Layer: model.LayerUser},
+		}, explanation.Prerequisites)
+	})
+
+	t.Run("overrides are explained", func(t *testing.T) {
+		_, err := model.UpsertOverride(ctx, "proj", "banner", ldvalue.String("red"))
+		require.NoError(t, err)
+
+		explanation, err := model.ExplainFlag(ctx, "proj", "banner", nil)
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.String("red"), explanation.Value)
+		assert.Equal(t, ldvalue.String("blue"), explanation.Source.Value)
+		require.NotNil(t, explanation.Override)
+		assert.Equal(t, model.LayerUser, explanation.Override.Layer)
+		assert.Equal(t, "overridden in the user layer", explanation.Reason)
+	})
+
+	t.Run("rollouts are bucketed for the context", func(t *testing.T) {
+		rollout := model.Rollout{Variations: []model.WeightedValue{
+			{Value: ldvalue.String("a"), Weight: 50000},
+			{Value: ldvalue.String("b"), Weight: 50000},
+		}}
+		_, err := model.UpsertRolloutOverride(ctx, "proj", "banner", rollout)
+		require.NoError(t, err)
+
+		for _, key := range []string{"alice", "bob", "carol"} {
+			ldCtx := ldcontext.New(key)
+			explanation, err := model.ExplainFlag(ctx, "proj", "banner", &ldCtx)
+			require.NoError(t, err)
+			assert.Equal(t, ldCtx, explanation.Context)
+			assert.Equal(t, rollout.ValueFor("banner", ldCtx), explanation.Value)
+		}
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		_, err := model.ExplainFlag(ctx, "proj", "nope", nil)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// FlagFilter limits which of the source project's flags a dev server project syncs and serves. A flag is included if it
+// matches any of the criteria. An empty filter includes every flag.
+type FlagFilter struct {
+	Keys        []string `json:"keys,omitempty"`
+	KeyPrefixes []string `json:"keyPrefixes,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+func (f FlagFilter) IsEmpty() bool {
+	return len(f.Keys) == 0 && len(f.KeyPrefixes) == 0 && len(f.Tags) == 0
+}
+
+// Includes reports whether the flag with the given key and tags is included by the filter.
+func (f FlagFilter) Includes(flagKey string, tags []string) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if lo.Contains(f.Keys, flagKey) {
+		return true
+	}
+	for _, prefix := range f.KeyPrefixes {
+		if strings.HasPrefix(flagKey, prefix) {
+			return true
+		}
+	}
+	for _, tag := range tags {
+		if lo.Contains(f.Tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiFilters are LaunchDarkly flag list filters that together match at least the flags the filter includes, so that
+// syncs only fetch those. The API can only find keys by substring, so Includes still has the final say.
+func (f FlagFilter) apiFilters() []string {
+	var filters []string
+	for _, tag := range f.Tags {
+		filters = append(filters, "tags:"+tag)
+	}
+	for _, key := range f.Keys {
+		filters = append(filters, "query:"+key)
+	}
+	for _, prefix := range f.KeyPrefixes {
+		filters = append(filters, "query:"+prefix)
+	}
+	return filters
+}
+
+// onlyFlags returns the flags in state that are also in keys.
+func (state FlagsState) onlyFlags(keys map[string]struct{}) FlagsState {
+	filtered := make(FlagsState, len(keys))
+	for key, flagState := range state {
+		if _, ok := keys[key]; ok {
+			filtered[key] = flagState
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/samber/lo"
+)
+
+// FlagMetadata is what LaunchDarkly says about a flag besides its variations, so that what a flag is for can be seen
+// without visiting the dashboard. It's synced along with the flag.
+type FlagMetadata struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// Maintainer is the email address of the member who maintains the flag, or the name of the team that does.
+	Maintainer string `json:"maintainer,omitempty"`
+	// MigrationStages are the stages of a migration flag, in order. It's only set for migration flags.
+	MigrationStages []string `json:"migrationStages,omitempty"`
+	// AIConfig is set for AI Configs, whose values are the model and prompt messages AI SDKs are configured with.
+	AIConfig bool `json:"aiConfig,omitempty"`
+}
+
+func flagMetadataOf(flag ldapi.FeatureFlag) FlagMetadata {
+	metadata := FlagMetadata{
+		Name:            flag.Name,
+		Description:     lo.FromPtr(flag.Description),
+		Tags:            flag.Tags,
+		MigrationStages: migrationStagesOf(flag),
+	}
+	switch {
+	case flag.Maintainer != nil:
+		metadata.Maintainer = flag.Maintainer.Email
+	case flag.MaintainerTeam != nil:
+		metadata.Maintainer = lo.CoalesceOrEmpty(lo.FromPtr(flag.MaintainerTeam.Name), lo.FromPtr(flag.MaintainerTeam.Key))
+	case flag.MaintainerTeamKey != nil:
+		metadata.Maintainer = *flag.MaintainerTeamKey
+	}
+	if len(metadata.Tags) == 0 {
+		metadata.Tags = nil
+	}
+	if len(metadata.MigrationStages) == 0 {
+		metadata.MigrationStages = nil
+	}
+	return metadata
+}
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// FlagKind is the type of a flag's variations, as LaunchDarkly names them.
+type FlagKind string
+
+const (
+	FlagKindBoolean FlagKind = "boolean"
+	FlagKindString  FlagKind = "string"
+	FlagKindNumber  FlagKind = "number"
+	FlagKindJson    FlagKind = "json"
+)
+
+// FlagKindOf returns the kind of flag that serves value.
+func FlagKindOf(value ldvalue.Value) FlagKind {
+	switch value.Type() {
+	case ldvalue.BoolType:
+		return FlagKindBoolean
+	case ldvalue.StringType:
+		return FlagKindString
+	case ldvalue.NumberType:
+		return FlagKindNumber
+	default:
+		return FlagKindJson
+	}
+}
+
+// FlagQuery picks out some of a project's flags. Its zero value matches every flag.
+type FlagQuery struct {
+	// Search matches flags whose key or name contains it, ignoring case.
+	Search    string
+	KeyPrefix string
+	Kind      FlagKind
+	// Overridden matches only flags whose effective value comes from an override in any layer.
+	Overridden bool
+	// Tags matches flags with any of the tags.
+	Tags []string
+	// Archived matches only flags that are archived in LaunchDarkly. Otherwise they're only matched with
+	// IncludeArchived.
+	Archived        bool
+	IncludeArchived bool
+	// Offset skips that many of the matching flags, and Limit is the most to return after that. A Limit of 0 returns
+	// them all.
+	Offset int
+	Limit  int
+}
+
+func (q FlagQuery) matches(flagKey string, state FlagState, layer OverrideLayer, metadata FlagMetadata) bool {
+	if q.Search != "" && !containsFold(flagKey, q.Search) && !containsFold(metadata.Name, q.Search) {
+		return false
+	}
+	if q.KeyPrefix != "" && !strings.HasPrefix(flagKey, q.KeyPrefix) {
+		return false
+	}
+	if q.Kind != "" && FlagKindOf(state.Value) != q.Kind {
+		return false
+	}
+	if q.Overridden && layer == LayerSource {
+		return false
+	}
+	if len(q.Tags) > 0 && !lo.Some(metadata.Tags, q.Tags) {
+		return false
+	}
+	if state.Archived && !q.Archived && !q.IncludeArchived {
+		return false
+	}
+	if q.Archived && !state.Archived {
+		return false
+	}
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// FoundFlag is a flag that matched a FlagQuery, with its effective state.
+type FoundFlag struct {
+	Key      string
+	State    FlagState
+	Layer    OverrideLayer
+	Metadata FlagMetadata
+	// DashboardURL is the flag's targeting page in the LaunchDarkly dashboard, if there's a dashboard on the context.
+	DashboardURL string
+}
+
+// FlagSearchResult is a page of the flags that matched a FlagQuery, ordered by key.
+type FlagSearchResult struct {
+	Flags []FoundFlag
+	// TotalCount is how many flags matched, including the ones outside the page.
+	TotalCount int
+}
+
+// SearchFlags returns the project's flags that match query, with overrides applied, so that finding a flag in a large
+// project doesn't take downloading its whole flag state.
+func SearchFlags(ctx context.Context, projectKey string, query FlagQuery) (FlagSearchResult, error) {
+	if query.Offset < 0 || query.Limit < 0 {
+		return FlagSearchResult{}, errors.New("offset and limit can't be negative")
+	}
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err != nil {
+		return FlagSearchResult{}, err
+	}
+	flagsState, layers, err := project.GetFlagStateWithLayersForProject(ctx)
+	if err != nil {
+		return FlagSearchResult{}, err
+	}
+
+	var found []FoundFlag
+	for flagKey, state := range flagsState {
+		layer := layers[flagKey]
+		metadata := project.FlagMetadata[flagKey]
+		if query.matches(flagKey, state, layer, metadata) {
+			found = append(found, FoundFlag{Key: flagKey, State: state, Layer: layer, Metadata: metadata})
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Key < found[j].Key })
+
+	result := FlagSearchResult{TotalCount: len(found)}
+	found = found[min(query.Offset, len(found)):]
+	if query.Limit > 0 && len(found) > query.Limit {
+		found = found[:query.Limit]
+	}
+	dashboard := DashboardFromContext(ctx)
+	for i := range found {
+		found[i].DashboardURL = dashboard.FlagURL(*project, found[i].Key)
+	}
+	result.Flags = found
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestSearchFlags(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("user"),
+		AllFlagsState: model.FlagsState{
+			"checkout-redesign": {Value: ldvalue.Bool(true), Version: 1},
+			"checkout-timeout":  {Value: ldvalue.Int(30), Version: 1},
+			"search-ranking":    {Value: ldvalue.String("v2"), Version: 1},
+			"search-config":     {Value: ldvalue.ObjectBuild().Set("size", ldvalue.Int(10)).Build(), Version: 1},
+			"old-banner":        {Value: ldvalue.Bool(false), Version: 1, Archived: true},
+		},
+		FlagMetadata: map[string]model.FlagMetadata{
+			"checkout-redesign": {Name: "New checkout page", Tags: []string{"frontend", "checkout"}},
+			"search-ranking":    {Name: "Search ranking algorithm", Tags: []string{"backend"}},
+		},
+	}))
+	_, err = store.UpsertOverride(ctx, model.Override{ProjectKey: "proj", FlagKey: "search-ranking", Value: ldvalue.String("v3"), Active: true, Version: 1})
+	require.NoError(t, err)
+
+	keys := func(result model.FlagSearchResult) []string {
+		var keys []string
+		for _, flag := range result.Flags {
+			keys = append(keys, flag.Key)
+		}
+		return keys
+	}
+
+	cases := map[string]struct {
+		query    model.FlagQuery
+		expected []string
+	}{
+		"everything":          {model.FlagQuery{}, []string{"checkout-redesign", "checkout-timeout", "search-config", "search-ranking"}},
+		"search ignores case": {model.FlagQuery{Search: "TIME"}, []string{"checkout-timeout"}},
+		"search by name":      {model.FlagQuery{Search: "algorithm"}, []string{"search-ranking"}},
+		"key prefix":          {model.FlagQuery{KeyPrefix: "search-"}, []string{"search-config", "search-ranking"}},
+		"kind":                {model.FlagQuery{Kind: model.FlagKindJson}, []string{"search-config"}},
+		"overridden":          {model.FlagQuery{Overridden: true}, []string{"search-ranking"}},
+		"including archived":  {model.FlagQuery{IncludeArchived: true, KeyPrefix: "old-"}, []string{"old-banner"}},
+		"only archived":       {model.FlagQuery{Archived: true}, []string{"old-banner"}},
+		"any tag":             {model.FlagQuery{Tags: []string{"checkout", "backend"}}, []string{"checkout-redesign", "search-ranking"}},
+		"combined":            {model.FlagQuery{KeyPrefix: "checkout-", Kind: model.FlagKindNumber}, []string{"checkout-timeout"}},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := model.SearchFlags(ctx, "proj", c.query)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, keys(result))
+			assert.Equal(t, len(c.expected), result.TotalCount)
+		})
+	}
+
+	t.Run("flags have their effective state and metadata", func(t *testing.T) {
+		result, err := model.SearchFlags(ctx, "proj", model.FlagQuery{Search: "ranking"})
+		require.NoError(t, err)
+		require.Len(t, result.Flags, 1)
+		assert.Equal(t, ldvalue.String("v3"), result.Flags[0].State.Value)
+		assert.Equal(t, model.LayerUser, result.Flags[0].Layer)
+		assert.Equal(t, []string{"backend"}, result.Flags[0].Metadata.Tags)
+	})
+
+	t.Run("pages count every match", func(t *testing.T) {
+		result, err := model.SearchFlags(ctx, "proj", model.FlagQuery{Offset: 1, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"checkout-timeout", "search-config"}, keys(result))
+		assert.Equal(t, 4, result.TotalCount)
+
+		result, err = model.SearchFlags(ctx, "proj", model.FlagQuery{Offset: 10})
+		require.NoError(t, err)
+		assert.Empty(t, result.Flags)
+		assert.Equal(t, 4, result.TotalCount)
+	})
+
+	t.Run("missing projects aren't found", func(t *testing.T) {
+		_, err := model.SearchFlags(ctx, "other", model.FlagQuery{})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"sort"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldreason"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
 )
@@ -9,10 +12,49 @@ type FlagState struct {
 	Value       ldvalue.Value `json:"value"`
 	Version     int           `json:"version"`
 	TrackEvents bool          `json:"trackEvents"`
+	// Rollout is set when the flag is overridden with a percentage rollout. Value is what's served without a context.
+	Rollout *Rollout `json:"rollout,omitempty"`
+	// Reason is why LaunchDarkly served Value when the project was synced. It's left out once the flag is overridden.
+	Reason *ldreason.EvaluationReason `json:"reason,omitempty"`
+	// Prerequisites are the keys of the flags LaunchDarkly checked before evaluating this one, in the order it checked
+	// them.
+	Prerequisites []string `json:"prerequisites,omitempty"`
+	// Archived flags are kept so that their overrides can still be seen, but aren't sent to SDKs, just as LaunchDarkly
+	// doesn't send them.
+	Archived bool `json:"archived,omitempty"`
 }
 
 type FlagsState map[string]FlagState
 
+// Page returns the flags from offset on in key order, at most limit of them. A limit of 0 doesn't limit them.
+func (state FlagsState) Page(offset, limit int) FlagsState {
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	keys = keys[min(max(offset, 0), len(keys)):]
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+	page := make(FlagsState, len(keys))
+	for _, key := range keys {
+		page[key] = state[key]
+	}
+	return page
+}
+
+// WithoutArchived returns the flags that aren't archived.
+func (state FlagsState) WithoutArchived() FlagsState {
+	live := make(FlagsState, len(state))
+	for key, flagState := range state {
+		if !flagState.Archived {
+			live[key] = flagState
+		}
+	}
+	return live
+}
+
 func FromAllFlags(sdkFlags flagstate.AllFlags) FlagsState {
 	flags := sdkFlags.ToValuesMap()
 	flagsState := make(FlagsState, len(flags))
@@ -22,10 +64,15 @@ func FromAllFlags(sdkFlags flagstate.AllFlags) FlagsState {
 			// panic because we're iterating over the same set of keys
 			panic("flag '" + key + "' not found")
 		}
-		flagsState[key] = FlagState{
-			Value:   value,
-			Version: sdkFlag.Version,
+		flagState := FlagState{
+			Value:         value,
+			Version:       sdkFlag.Version,
+			Prerequisites: sdkFlag.Prerequisites,
+		}
+		if sdkFlag.Reason.IsDefined() {
+			flagState.Reason = &sdkFlag.Reason
 		}
+		flagsState[key] = flagState
 	}
 	return flagsState
 }
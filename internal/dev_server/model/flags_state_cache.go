@@ -0,0 +1,118 @@
+package model
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+// CachingStore caches each project's flag state with its overrides applied, so that SDKs polling or reconnecting
+// don't have every override fetched and the flag state rebuilt for each request. The cache is dropped whenever flags
+// or overrides are written through the store. Linked clones inherit their base project's overrides, so rather than
+// tracking which projects a write affects, every project's cached state is dropped.
+//
+// Writes made to the underlying store by anything else, such as another dev server sharing a Redis store, aren't
+// seen, so it should only wrap stores the dev server has to itself.
+type CachingStore struct {
+	Store
+
+	mu sync.Mutex
+	// generation is increased by every write, so that state computed from reads made before a write isn't cached
+	generation uint64
+	entries    map[string]flagsStateCacheEntry
+}
+
+type flagsStateCacheEntry struct {
+	generation uint64
+	// lastSyncTime is the sync the state was computed from, since the project may have been fetched before a sync
+	// that the generation doesn't account for
+	lastSyncTime time.Time
+	flagsState   FlagsState
+	layers       map[string]OverrideLayer
+}
+
+var _ Store = (*CachingStore)(nil)
+
+func NewCachingStore(store Store) *CachingStore {
+	return &CachingStore{Store: store, entries: make(map[string]flagsStateCacheEntry)}
+}
+
+// flagsStateWithLayers returns the project's cached flag state, computing it if it isn't cached. The returned maps are
+// shared, so they mustn't be changed.
+func (s *CachingStore) flagsStateWithLayers(ctx context.Context, project Project) (FlagsState, map[string]OverrideLayer, error) {
+	s.mu.Lock()
+	generation := s.generation
+	entry, ok := s.entries[project.Key]
+	s.mu.Unlock()
+	if ok && entry.generation == generation && entry.lastSyncTime.Equal(project.LastSyncTime) {
+		return entry.flagsState, entry.layers, nil
+	}
+
+	flagsState, layers, err := computeFlagStateWithLayers(ctx, project)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation == generation {
+		s.entries[project.Key] = flagsStateCacheEntry{
+			generation:   generation,
+			lastSyncTime: project.LastSyncTime,
+			flagsState:   flagsState,
+			layers:       layers,
+		}
+	}
+	return flagsState, layers, nil
+}
+
+func (s *CachingStore) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	clear(s.entries)
+}
+
+func (s *CachingStore) DeactivateOverride(ctx context.Context, projectKey, flagKey string) (int, error) {
+	defer s.invalidate()
+	return s.Store.DeactivateOverride(ctx, projectKey, flagKey)
+}
+
+func (s *CachingStore) UpdateProject(ctx context.Context, project Project) (bool, error) {
+	defer s.invalidate()
+	return s.Store.UpdateProject(ctx, project)
+}
+
+func (s *CachingStore) DeleteDevProject(ctx context.Context, projectKey string) (ProjectDeletion, bool, error) {
+	defer s.invalidate()
+	return s.Store.DeleteDevProject(ctx, projectKey)
+}
+
+func (s *CachingStore) InsertProject(ctx context.Context, project Project) error {
+	defer s.invalidate()
+	return s.Store.InsertProject(ctx, project)
+}
+
+func (s *CachingStore) UpsertOverride(ctx context.Context, override Override) (Override, error) {
+	defer s.invalidate()
+	return s.Store.UpsertOverride(ctx, override)
+}
+
+func (s *CachingStore) ReplaceScenarioOverrides(ctx context.Context, projectKey string, values map[string]ldvalue.Value) (Overrides, error) {
+	defer s.invalidate()
+	return s.Store.ReplaceScenarioOverrides(ctx, projectKey, values)
+}
+
+// WithTx drops the cache again once the transaction is over, since state computed while it was open may have been read
+// from before its writes were committed.
+func (s *CachingStore) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	defer s.invalidate()
+	return s.Store.WithTx(ctx, fn)
+}
+
+func (s *CachingStore) RestoreBackup(ctx context.Context, stream io.Reader) (string, error) {
+	defer s.invalidate()
+	return s.Store.RestoreBackup(ctx, stream)
+}
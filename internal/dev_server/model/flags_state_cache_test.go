@@ -0,0 +1,115 @@
+package model_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestCachingStore(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, model.NewCachingStore(store))
+
+	project := model.Project{
+		Key:           "proj",
+		LastSyncTime:  time.Now(),
+		AllFlagsState: model.FlagsState{"flg": model.FlagState{Value: ldvalue.Bool(false), Version: 1}},
+	}
+	override := model.Override{ProjectKey: "proj", FlagKey: "flg", Value: ldvalue.Bool(true), Active: true, Version: 1}
+
+	t.Run("only fetches overrides the first time", func(t *testing.T) {
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "proj").Return(model.Overrides{override}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+
+		for range 3 {
+			flagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, ldvalue.Bool(true), flagsState["flg"].Value)
+		}
+	})
+
+	t.Run("refetches overrides after they're written", func(t *testing.T) {
+		updated := override
+		updated.Value = ldvalue.Bool(false)
+		updated.Version = 2
+		store.EXPECT().UpsertOverride(gomock.Any(), updated).Return(updated, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "proj").Return(model.Overrides{updated}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+
+		_, err := model.StoreFromContext(ctx).UpsertOverride(ctx, updated)
+		require.NoError(t, err)
+		flagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.Bool(false), flagsState["flg"].Value)
+	})
+
+	t.Run("refetches overrides for a project from a later sync", func(t *testing.T) {
+		synced := project
+		synced.LastSyncTime = project.LastSyncTime.Add(time.Minute)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+
+		flagsState, err := synced.GetFlagStateWithOverridesForProject(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, synced.AllFlagsState, flagsState)
+	})
+}
+
+// BenchmarkGetFlagStateWithOverrides measures what an SDK poll costs for a project with 5,000 flags, 200 of which are
+// overridden, with and without the cache.
+func BenchmarkGetFlagStateWithOverrides(b *testing.B) {
+	ctx := context.Background()
+	sqlite, err := db.NewSqlite(ctx, filepath.Join(b.TempDir(), "bench.db"))
+	require.NoError(b, err)
+
+	project := model.Project{
+		Key:           "proj",
+		LastSyncTime:  time.Now(),
+		AllFlagsState: make(model.FlagsState, 5000),
+	}
+	for i := range 5000 {
+		project.AllFlagsState[fmt.Sprintf("flag-%d", i)] = model.FlagState{Value: ldvalue.Bool(false), Version: 1}
+	}
+	require.NoError(b, sqlite.InsertProject(ctx, project))
+	for i := range 200 {
+		_, err := sqlite.UpsertOverride(ctx, model.Override{
+			ProjectKey: project.Key,
+			FlagKey:    fmt.Sprintf("flag-%d", i),
+			Value:      ldvalue.Bool(true),
+			Active:     true,
+			Version:    1,
+		})
+		require.NoError(b, err)
+	}
+
+	for _, bench := range []struct {
+		name  string
+		store model.Store
+	}{
+		{"uncached", sqlite},
+		{"cached", model.NewCachingStore(sqlite)},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			ctx := model.ContextWithStore(ctx, bench.store)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := project.GetFlagStateWithOverridesForProject(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
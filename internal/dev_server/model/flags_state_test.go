@@ -1,8 +1,10 @@
 package model_test
 
 import (
+	"sort"
 	"testing"
 
+	"github.com/launchdarkly/go-sdk-common/v3/ldreason"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
@@ -43,3 +45,39 @@ func TestFromAllFlags(t *testing.T) {
 		assert.True(t, expectedVal.Equal(state.Value))
 	}
 }
+
+func TestFromAllFlagsKeepsReasonsAndPrerequisites(t *testing.T) {
+	sdkFlags := flagstate.NewAllFlagsBuilder(flagstate.OptionWithReasons()).
+		AddFlag("withReason", flagstate.FlagState{Value: ldvalue.Bool(true), Version: 1, Reason: ldreason.NewEvalReasonFallthrough(), Prerequisites: []string{"prereq"}}).
+		AddFlag("withoutReason", flagstate.FlagState{Value: ldvalue.Bool(true), Version: 1}).
+		Build()
+
+	flagsState := model.FromAllFlags(sdkFlags)
+
+	fallthroughReason := ldreason.NewEvalReasonFallthrough()
+	assert.Equal(t, &fallthroughReason, flagsState["withReason"].Reason)
+	assert.Equal(t, []string{"prereq"}, flagsState["withReason"].Prerequisites)
+	assert.Nil(t, flagsState["withoutReason"].Reason)
+}
+
+func TestFlagsStatePage(t *testing.T) {
+	flags := model.FlagsState{
+		"c": {Value: ldvalue.Bool(true)},
+		"a": {Value: ldvalue.Bool(true)},
+		"d": {Value: ldvalue.Bool(true)},
+		"b": {Value: ldvalue.Bool(true)},
+	}
+	keys := func(page model.FlagsState) []string {
+		keys := make([]string, 0, len(page))
+		for key := range page {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	assert.Equal(t, []string{"b", "c"}, keys(flags.Page(1, 2)))
+	assert.Equal(t, []string{"c", "d"}, keys(flags.Page(2, 0)), "a limit of 0 returns the rest")
+	assert.Equal(t, []string{"a", "b", "c", "d"}, keys(flags.Page(0, 10)))
+	assert.Empty(t, flags.Page(10, 1))
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// gitBranchCacheTTL is how long the checked out branch is remembered, so that requests don't each run git.
+const gitBranchCacheTTL = time.Second
+
+const gitBranchTimeout = 5 * time.Second
+
+// invalidNamespaceChars are the characters branch names can have that namespaces can't, such as the '/' in
+// feature/login.
+var invalidNamespaceChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// gitBranchResolver picks the namespace of every request from the git branch checked out in a directory.
+type gitBranchResolver struct {
+	dir string
+
+	mu        sync.Mutex
+	namespace string
+	checkedAt time.Time
+}
+
+// GitBranchNamespaceResolver uses the git branch checked out in dir, or the working directory if dir is empty, as every
+// request's namespace, so that each feature branch gets its own overrides on top of the shared projects as soon as it's
+// checked out, with nothing to configure in SDKs. Characters branch names can have that namespaces can't, such as
+// '/', are replaced with '-'. There's no namespace if dir isn't in a git repository or HEAD is detached.
+func GitBranchNamespaceResolver(dir string) ActorResolver {
+	return &gitBranchResolver{dir: dir}
+}
+
+func (r *gitBranchResolver) ResolveActor(request *http.Request) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checkedAt) < gitBranchCacheTTL {
+		return r.namespace
+	}
+	namespace := branchNamespace(r.currentBranch(request.Context()))
+	if namespace != r.namespace {
+		if namespace == "" {
+			logs.Printf(logs.Info, "", "Not on a git branch; requests use the shared projects")
+		} else {
+			logs.Printf(logs.Info, "", "Using namespace [%s] for the checked out git branch", namespace)
+		}
+	}
+	r.namespace = namespace
+	r.checkedAt = time.Now()
+	return namespace
+}
+
+func (r *gitBranchResolver) currentBranch(ctx context.Context) string {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), gitBranchTimeout)
+	defer cancel()
+	// unlike rev-parse, symbolic-ref knows the branch of a repository without commits yet, and fails if HEAD is detached
+	cmd := exec.CommandContext(ctx, "git", "symbolic-ref", "--short", "--quiet", "HEAD")
+	cmd.Dir = r.dir
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// branchNamespace is the namespace for a git branch, with the characters namespaces can't have replaced with '-'.
+func branchNamespace(branch string) string {
+	return strings.Trim(invalidNamespaceChars.ReplaceAllString(branch, "-"), "-")
+}
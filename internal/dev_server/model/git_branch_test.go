@@ -0,0 +1,33 @@
+package model_test
+
+import (
+	"net/http/httptest"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestGitBranchNamespaceResolver(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("needs git")
+	}
+	request := httptest.NewRequest("GET", "/", nil)
+
+	t.Run("uses the checked out branch, with characters namespaces can't have replaced", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, exec.Command("git", "init", "--quiet", dir).Run())
+		checkout := exec.Command("git", "symbolic-ref", "HEAD", "refs/heads/feature/login+signup")
+		checkout.Dir = dir
+		require.NoError(t, checkout.Run())
+
+		assert.Equal(t, "feature-login-signup", model.GitBranchNamespaceResolver(dir).ResolveActor(request))
+	})
+
+	t.Run("has no namespace outside of a git repository", func(t *testing.T) {
+		assert.Equal(t, "", model.GitBranchNamespaceResolver(t.TempDir()).ResolveActor(request))
+	})
+}
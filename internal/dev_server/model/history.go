@@ -0,0 +1,270 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// Snapshot is a copy of the flag state synced from the source environment, kept so that flags can be evaluated as they
+// were configured at the time. Along with the project's override history, it's also a restore point. One is recorded
+// every time the project is synced, and more can be taken on demand.
+type Snapshot struct {
+	ID         int64
+	ProjectKey string
+	RecordedAt time.Time
+}
+
+// GetFlagStateAt reconstructs the effective flag state for the project, with overrides applied, as it was at the
+// given time. It also returns which layer produced each flag's value.
+func GetFlagStateAt(ctx context.Context, projectKey string, at time.Time) (FlagsState, map[string]OverrideLayer, error) {
+	store := StoreFromContext(ctx)
+	flagsState, overrides, err := store.GetProjectStateAt(ctx, projectKey, at)
+	if err != nil {
+		return nil, nil, err
+	}
+	withOverrides, layers := overrides.ApplyAll(flagsState)
+	return withOverrides, layers, nil
+}
+
+// GetSnapshots returns the project's snapshots, most recent first. ErrNotFound is returned if the project doesn't
+// exist.
+func GetSnapshots(ctx context.Context, projectKey string) ([]Snapshot, error) {
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, projectKey); err != nil {
+		return nil, err
+	}
+	return store.GetSnapshots(ctx, projectKey)
+}
+
+// GetSnapshot returns the project's snapshot with the given ID. ErrNotFound is returned if there isn't one.
+func GetSnapshot(ctx context.Context, projectKey string, id int64) (Snapshot, error) {
+	snapshots, err := GetSnapshots(ctx, projectKey)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.ID == id {
+			return snapshot, nil
+		}
+	}
+	return Snapshot{}, errors.Wrapf(NewErrNotFound("snapshot", strconv.FormatInt(id, 10)), "in project %s", projectKey)
+}
+
+// GetSnapshotAt returns the project's most recent snapshot that was recorded by the given time, which is the one its
+// flag state is reconstructed from. ErrNotFound is returned if the project has no snapshot that old.
+func GetSnapshotAt(ctx context.Context, projectKey string, at time.Time) (Snapshot, error) {
+	snapshots, err := GetSnapshots(ctx, projectKey)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	for _, snapshot := range snapshots {
+		if !snapshot.RecordedAt.After(at) {
+			return snapshot, nil
+		}
+	}
+	return Snapshot{}, errors.Wrapf(NewErrNotFound("project", projectKey), "no snapshot at %s", at.Format(time.RFC3339))
+}
+
+// TakeSnapshot records the project's current flag state as a snapshot, without syncing it first.
+func TakeSnapshot(ctx context.Context, projectKey string) (Snapshot, error) {
+	snapshot, err := StoreFromContext(ctx).InsertSnapshot(ctx, projectKey)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Took snapshot [%d] of project [%s]", snapshot.ID, projectKey)
+	return snapshot, nil
+}
+
+// PruneHistory deletes every project's history from before the cutoff, other than what's needed to reconstruct the
+// project as of the cutoff. Snapshots from before it can no longer be restored, except for the latest.
+func PruneHistory(ctx context.Context, before time.Time) error {
+	store := StoreFromContext(ctx)
+	projectKeys, err := store.GetDevProjectKeys(ctx)
+	if err != nil {
+		return err
+	}
+	for _, projectKey := range projectKeys {
+		pruned, err := store.PruneHistory(ctx, projectKey, before)
+		if err != nil {
+			return errors.Wrapf(err, "unable to prune history for project %s", projectKey)
+		}
+		if pruned > 0 {
+			logs.Printf(logs.Debug, projectKey, "Pruned %d history entries recorded before %s from project [%s]", pruned, before.Format(time.RFC3339), projectKey)
+		}
+	}
+	return nil
+}
+
+// RunHistoryPruner keeps history for retention, pruning anything older right away and then every interval until ctx
+// is done.
+func RunHistoryPruner(ctx context.Context, retention, interval time.Duration) {
+	prune := func(now time.Time) {
+		if err := PruneHistory(ctx, now.Add(-retention)); err != nil {
+			logs.Printf(logs.Error, "", "unable to prune history: %+v", err)
+		}
+	}
+	prune(time.Now())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			prune(now)
+		}
+	}
+}
+
+// SnapshotRestore is the result of restoring a project to a snapshot.
+type SnapshotRestore struct {
+	Snapshot Snapshot
+	// Skipped maps the flag keys of overrides that weren't restored to why not.
+	Skipped map[string]string
+}
+
+// RestoreSnapshot rolls the project back to the flag state recorded in the snapshot and the overrides it had at the
+// time, so that a destructive test can be undone. Locked overrides are kept as they are. Linked clones share their
+// base project's flag state, so only their overrides are restored. Any other failure leaves the project as it was.
+func RestoreSnapshot(ctx context.Context, projectKey string, id int64) (SnapshotRestore, error) {
+	var result SnapshotRestore
+	var project Project
+	err := withTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, project, err = restoreSnapshot(ctx, projectKey, id)
+		return err
+	})
+	if err != nil {
+		return SnapshotRestore{}, err
+	}
+	if project.BaseProjectKey == "" {
+		syncLinkedClones(ctx, project)
+	}
+	notifyLinkedClones(ctx, project, lo.Keys(project.AllFlagsState))
+	logs.Printf(logs.Info, projectKey, "Restored project [%s] to snapshot [%d], skipping %d overrides", projectKey, id, len(result.Skipped))
+	return result, nil
+}
+
+func restoreSnapshot(ctx context.Context, projectKey string, id int64) (SnapshotRestore, Project, error) {
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+	previousFlagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+	if err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+	snapshot, err := GetSnapshot(ctx, projectKey, id)
+	if err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+	flagsState, restored, err := store.GetProjectStateAt(ctx, projectKey, snapshot.RecordedAt)
+	if err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+
+	if project.BaseProjectKey == "" {
+		// variations aren't kept in snapshots, so flags only get the ones they have now
+		availableVariations, err := store.GetAvailableVariationsForProject(ctx, projectKey)
+		if err != nil {
+			return SnapshotRestore{}, Project{}, err
+		}
+		flagKeys := lo.Keys(flagsState)
+		sort.Strings(flagKeys)
+		project.AvailableVariations = nil
+		for _, flagKey := range flagKeys {
+			for _, variation := range availableVariations[flagKey] {
+				project.AvailableVariations = append(project.AvailableVariations, FlagVariation{FlagKey: flagKey, Variation: variation})
+			}
+		}
+		project.AllFlagsState = flagsState
+		if _, err := store.UpdateProject(ctx, *project); err != nil {
+			return SnapshotRestore{}, Project{}, err
+		}
+	}
+
+	scenario := make(map[string]ldvalue.Value)
+	for _, override := range restored.Scenario {
+		if override.Active {
+			scenario[override.FlagKey] = override.Value
+		}
+	}
+	if _, err := store.ReplaceScenarioOverrides(ctx, projectKey, scenario); err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+
+	skipped, err := restoreUserOverrides(ctx, *project, restored.User)
+	if err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+
+	flagsStateWithOverrides, err := project.GetFlagStateWithOverridesForProject(ctx)
+	if err != nil {
+		return SnapshotRestore{}, Project{}, err
+	}
+	// restored flags can have lower versions than SDKs have seen, so SDKs are sent the whole flag state to replace theirs
+	GetObserversFromContext(ctx).Notify(SyncEvent{
+		ProjectKey:         projectKey,
+		AllFlagsState:      flagsStateWithOverrides,
+		PreviousFlagsState: previousFlagsState,
+	})
+	return SnapshotRestore{Snapshot: snapshot, Skipped: skipped}, *project, nil
+}
+
+// restoreUserOverrides makes the project's user overrides the active ones in restored, returning the flag keys of the
+// overrides that were left as they are and why.
+func restoreUserOverrides(ctx context.Context, project Project, restored Overrides) (map[string]string, error) {
+	store := StoreFromContext(ctx)
+	current, err := store.GetOverridesForProject(ctx, project.Key)
+	if err != nil {
+		return nil, err
+	}
+	skipped := make(map[string]string)
+	for _, override := range restored {
+		if !override.Active {
+			continue
+		}
+		if existing, ok := current.GetFlag(override.FlagKey); ok && existing.Locked {
+			skipped[override.FlagKey] = NewErrLocked(project.Key, override.FlagKey).Error()
+			continue
+		}
+		if _, ok := project.AllFlagsState[override.FlagKey]; !ok {
+			skipped[override.FlagKey] = NewErrNotFound("flag", override.FlagKey).Error()
+			continue
+		}
+		_, err := store.UpsertOverride(ctx, Override{
+			ProjectKey: project.Key,
+			FlagKey:    override.FlagKey,
+			Value:      override.Value,
+			Rollout:    override.Rollout,
+			Active:     true,
+			Version:    1,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to restore override for flag %s", override.FlagKey)
+		}
+	}
+	for _, override := range current {
+		if restoredOverride, ok := restored.GetFlag(override.FlagKey); !override.Active || ok && restoredOverride.Active {
+			continue
+		}
+		if override.Locked {
+			skipped[override.FlagKey] = NewErrLocked(project.Key, override.FlagKey).Error()
+			continue
+		}
+		if _, err := store.DeactivateOverride(ctx, project.Key, override.FlagKey); err != nil {
+			return nil, errors.Wrapf(err, "unable to remove override for flag %s", override.FlagKey)
+		}
+		randomValues.reset(project.Key, override.FlagKey)
+	}
+	return skipped, nil
+}
@@ -0,0 +1,180 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestSnapshots(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	project := model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("dev"),
+		AllFlagsState: model.FlagsState{
+			"banner": {Value: ldvalue.String("blue"), Version: 1},
+		},
+	}
+	require.NoError(t, store.InsertProject(ctx, project))
+	lastTuesday, err := model.TakeSnapshot(ctx, "proj")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	project.AllFlagsState = model.FlagsState{
+		"banner": {Value: ldvalue.String("green"), Version: 2},
+	}
+	_, err = store.UpdateProject(ctx, project)
+	require.NoError(t, err)
+	_, err = model.UpsertOverride(ctx, "proj", "banner", ldvalue.String("red"))
+	require.NoError(t, err)
+
+	t.Run("snapshots can be found by ID or by when they were current", func(t *testing.T) {
+		snapshot, err := model.GetSnapshot(ctx, "proj", lastTuesday.ID)
+		require.NoError(t, err)
+		assert.Equal(t, lastTuesday, snapshot)
+
+		snapshot, err = model.GetSnapshotAt(ctx, "proj", lastTuesday.RecordedAt.Add(time.Millisecond))
+		require.NoError(t, err)
+		assert.Equal(t, lastTuesday, snapshot)
+
+		_, err = model.GetSnapshot(ctx, "proj", 12345)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		_, err = model.GetSnapshotAt(ctx, "proj", time.Now().Add(-time.Hour))
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		_, err = model.GetSnapshots(ctx, "nope")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("flags are explained as they were configured at the time", func(t *testing.T) {
+		explanation, err := model.ExplainFlagAt(ctx, "proj", "banner", nil, lastTuesday.RecordedAt)
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.String("blue"), explanation.Value)
+		assert.Equal(t, model.LayerSource, explanation.Layer)
+		assert.Equal(t, lastTuesday.RecordedAt, explanation.LastSyncTime)
+
+		explanation, err = model.ExplainFlagAt(ctx, "proj", "banner", nil, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.String("red"), explanation.Value)
+		assert.Equal(t, ldvalue.String("green"), explanation.Source.Value)
+		assert.Equal(t, model.LayerUser, explanation.Layer)
+	})
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	observers := model.NewObservers()
+	observer := mocks.NewMockObserver(gomock.NewController(t))
+	var syncs []model.SyncEvent
+	observer.EXPECT().Handle(gomock.Any()).Do(func(event interface{}) {
+		if sync, ok := event.(model.SyncEvent); ok {
+			syncs = append(syncs, sync)
+		}
+	}).AnyTimes()
+	observers.RegisterObserver(observer)
+	ctx = model.SetObserversOnContext(ctx, observers)
+
+	variations := []model.FlagVariation{
+		{FlagKey: "banner", Variation: model.Variation{Id: "blue", Value: ldvalue.String("blue")}},
+		{FlagKey: "checkout", Variation: model.Variation{Id: "off", Value: ldvalue.Bool(false)}},
+		{FlagKey: "search", Variation: model.Variation{Id: "off", Value: ldvalue.Bool(false)}},
+	}
+	project := model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("dev"),
+		AllFlagsState: model.FlagsState{
+			"banner":   {Value: ldvalue.String("blue"), Version: 1},
+			"checkout": {Value: ldvalue.Bool(false), Version: 1},
+			"search":   {Value: ldvalue.Bool(false), Version: 1},
+		},
+		AvailableVariations: variations,
+	}
+	require.NoError(t, store.InsertProject(ctx, project))
+	_, err = model.UpsertOverride(ctx, "proj", "banner", ldvalue.String("red"))
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	checkpoint, err := model.TakeSnapshot(ctx, "proj")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	project.AllFlagsState = model.FlagsState{
+		"banner":   {Value: ldvalue.String("green"), Version: 2},
+		"checkout": {Value: ldvalue.Bool(false), Version: 1},
+		"search":   {Value: ldvalue.Bool(false), Version: 1},
+		"new-flag": {Value: ldvalue.Bool(true), Version: 1},
+	}
+	_, err = store.UpdateProject(ctx, project)
+	require.NoError(t, err)
+	_, err = model.UpsertOverride(ctx, "proj", "banner", ldvalue.String("yellow"))
+	require.NoError(t, err)
+	_, err = model.UpsertOverride(ctx, "proj", "checkout", ldvalue.Bool(true))
+	require.NoError(t, err)
+	_, err = model.UpsertOverride(ctx, "proj", "search", ldvalue.Bool(true))
+	require.NoError(t, err)
+	_, err = model.SetOverrideLocked(ctx, "proj", "search", true)
+	require.NoError(t, err)
+
+	restore, err := model.RestoreSnapshot(ctx, "proj", checkpoint.ID)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint, restore.Snapshot)
+	assert.Equal(t, []string{"search"}, lo.Keys(restore.Skipped))
+
+	restored, err := store.GetDevProject(ctx, "proj")
+	require.NoError(t, err)
+	flagsState, layers, err := restored.GetFlagStateWithLayersForProject(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, flagsState, "new-flag")
+	assert.Equal(t, ldvalue.String("red"), flagsState["banner"].Value)
+	assert.Equal(t, model.LayerUser, layers["banner"])
+	assert.Equal(t, ldvalue.Bool(false), flagsState["checkout"].Value)
+	assert.Equal(t, model.LayerSource, layers["checkout"])
+	assert.Equal(t, ldvalue.Bool(true), flagsState["search"].Value)
+
+	require.Len(t, syncs, 1)
+	assert.Equal(t, flagsState, syncs[0].AllFlagsState)
+
+	_, err = model.RestoreSnapshot(ctx, "proj", 12345)
+	assert.ErrorAs(t, err, &model.ErrNotFound{})
+}
+
+func TestPruneHistory(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+	cutoff := time.Now().Add(-30 * 24 * time.Hour)
+
+	t.Run("prunes every project's history", func(t *testing.T) {
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj", "other"}, nil)
+		store.EXPECT().PruneHistory(gomock.Any(), "proj", cutoff).Return(3, nil)
+		store.EXPECT().PruneHistory(gomock.Any(), "other", cutoff).Return(0, nil)
+
+		assert.NoError(t, model.PruneHistory(ctx, cutoff))
+	})
+
+	t.Run("stops at the first project that can't be pruned", func(t *testing.T) {
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj", "other"}, nil)
+		store.EXPECT().PruneHistory(gomock.Any(), "proj", cutoff).Return(0, errors.New("disk full"))
+
+		err := model.PruneHistory(ctx, cutoff)
+		assert.EqualError(t, err, "unable to prune history for project proj: disk full")
+	})
+}
@@ -18,6 +18,7 @@ type ImportData struct {
 	FlagsState           FlagsState                    `json:"flagsState"`
 	Overrides            *FlagsState                   `json:"overrides,omitempty"`
 	AvailableVariations  *map[string][]ImportVariation `json:"availableVariations,omitempty"`
+	FlagFilter           FlagFilter                    `json:"flagFilter,omitempty"`
 }
 
 // ImportVariation represents a variation in the import data format
@@ -29,8 +30,14 @@ type ImportVariation struct {
 }
 
 // ImportProject imports a project from import data into the database.
-// Returns an error if the project already exists.
+// Returns an error if the project already exists. If any of it can't be imported, none of it is.
 func ImportProject(ctx context.Context, projectKey string, importData ImportData) error {
+	return withTx(ctx, func(ctx context.Context) error {
+		return importProject(ctx, projectKey, importData)
+	})
+}
+
+func importProject(ctx context.Context, projectKey string, importData ImportData) error {
 	store := StoreFromContext(ctx)
 
 	// Check if project already exists
@@ -53,6 +60,7 @@ func ImportProject(ctx context.Context, projectKey string, importData ImportData
 		Context:              importData.Context,
 		AllFlagsState:        importData.FlagsState,
 		AvailableVariations:  []FlagVariation{},
+		FlagFilter:           importData.FlagFilter,
 	}
 
 	// Convert available variations if present
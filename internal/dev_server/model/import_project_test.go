@@ -22,6 +22,7 @@ func TestImportProject(t *testing.T) {
 	ctx := context.Background()
 	mockController := gomock.NewController(t)
 	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
 	ctx = model.ContextWithStore(ctx, store)
 
 	projectKey := "test-project"
@@ -169,6 +170,7 @@ func TestImportProjectFromFile(t *testing.T) {
 	ctx := context.Background()
 	mockController := gomock.NewController(t)
 	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
 	ctx = model.ContextWithStore(ctx, store)
 
 	projectKey := "test-project"
@@ -0,0 +1,196 @@
+package model
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+// OverrideLayer identifies what produced a flag's effective value. Layers take precedence in this order:
+//   - user: overrides set by hand, e.g. from the UI or `ldcli dev-server add-override`
+//   - scenario: overrides applied as a set by PUT /dev/projects/{projectKey}/scenario
+//   - inherited: a linked clone's view of its base project's scenario and user overrides
+//   - source: the value synced from the source environment
+//
+// so applying or clearing a scenario never clobbers a manual override.
+type OverrideLayer string
+
+const (
+	LayerSource    OverrideLayer = "source"
+	LayerInherited OverrideLayer = "inherited"
+	LayerScenario  OverrideLayer = "scenario"
+	LayerUser      OverrideLayer = "user"
+)
+
+// LayeredOverrides are a project's overrides in each layer above the source environment.
+type LayeredOverrides struct {
+	// Inherited is only set for linked clones. It holds the base project's effective override for each flag.
+	Inherited Overrides
+	Scenario  Overrides
+	User      Overrides
+}
+
+// Apply returns the effective state of a flag along with the layer that produced its value. The version is the sum of
+// the versions in every layer so that a change to any layer is seen as newer by SDKs.
+func (l LayeredOverrides) Apply(flagKey string, state FlagState) (FlagState, OverrideLayer) {
+	inherited, hasInherited := l.Inherited.GetFlag(flagKey)
+	scenario, hasScenario := l.Scenario.GetFlag(flagKey)
+	user, hasUser := l.User.GetFlag(flagKey)
+	if !hasInherited && !hasScenario && !hasUser {
+		return state, LayerSource
+	}
+
+	layer := LayerSource
+	value := state.Value
+	var rollout *Rollout
+	if inherited.Active {
+		layer = LayerInherited
+		value = inherited.Value
+		rollout = inherited.Rollout
+	}
+	if scenario.Active {
+		layer = LayerScenario
+		value = scenario.Value
+		rollout = nil
+	}
+	if user.Active {
+		layer = LayerUser
+		value = user.Value
+		rollout = user.Rollout
+	}
+	return FlagState{
+		Value:       value,
+		Version:     state.Version + inherited.Version + scenario.Version + user.Version,
+		TrackEvents: inherited.Active || scenario.Active || user.Active,
+		Rollout:     rollout,
+		Archived:    state.Archived,
+	}, layer
+}
+
+// flatten combines the scenario and user layers into one override per flag, which is how a linked clone inherits
+// them.
+func (l LayeredOverrides) flatten() Overrides {
+	flagKeys := make(map[string]struct{})
+	for _, override := range append(append(Overrides{}, l.Scenario...), l.User...) {
+		flagKeys[override.FlagKey] = struct{}{}
+	}
+	flattened := make(Overrides, 0, len(flagKeys))
+	for flagKey := range flagKeys {
+		scenario, _ := l.Scenario.GetFlag(flagKey)
+		user, _ := l.User.GetFlag(flagKey)
+		override := Override{
+			FlagKey: flagKey,
+			Value:   ldvalue.Null(),
+			Active:  scenario.Active || user.Active,
+			Version: scenario.Version + user.Version,
+		}
+		if scenario.Active {
+			override.Value = scenario.Value
+		}
+		if user.Active {
+			override.Value = user.Value
+			override.Rollout = user.Rollout
+		}
+		flattened = append(flattened, override)
+	}
+	return flattened
+}
+
+// ApplyAll applies the overrides to every flag, returning the effective flag states and the layer each came from.
+func (l LayeredOverrides) ApplyAll(flagsState FlagsState) (FlagsState, map[string]OverrideLayer) {
+	withOverrides := make(FlagsState, len(flagsState))
+	layers := make(map[string]OverrideLayer, len(flagsState))
+	for flagKey, flagState := range flagsState {
+		withOverrides[flagKey], layers[flagKey] = l.Apply(flagKey, flagState)
+	}
+	return withOverrides, layers
+}
+
+// getLayeredOverrides fetches the project's overrides in every layer, including the ones it inherits if it's a linked
+// clone.
+func getLayeredOverrides(ctx context.Context, project Project) (LayeredOverrides, error) {
+	overrides, err := getOwnLayeredOverrides(ctx, project.Key)
+	if err != nil {
+		return LayeredOverrides{}, err
+	}
+	overrides.Inherited, err = getInheritedOverrides(ctx, project)
+	if err != nil {
+		return LayeredOverrides{}, err
+	}
+	return overrides, nil
+}
+
+func getOwnLayeredOverrides(ctx context.Context, projectKey string) (LayeredOverrides, error) {
+	store := StoreFromContext(ctx)
+	user, err := store.GetOverridesForProject(ctx, projectKey)
+	if err != nil {
+		return LayeredOverrides{}, errors.Wrapf(err, "unable to fetch overrides for project %s", projectKey)
+	}
+	scenario, err := store.GetScenarioOverridesForProject(ctx, projectKey)
+	if err != nil {
+		return LayeredOverrides{}, errors.Wrapf(err, "unable to fetch scenario overrides for project %s", projectKey)
+	}
+	return LayeredOverrides{Scenario: scenario, User: user}, nil
+}
+
+// getInheritedOverrides returns the base project's overrides for a linked clone, and nothing for other projects.
+func getInheritedOverrides(ctx context.Context, project Project) (Overrides, error) {
+	if project.BaseProjectKey == "" {
+		return nil, nil
+	}
+	base, err := getOwnLayeredOverrides(ctx, project.BaseProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	return base.flatten(), nil
+}
+
+// ApplyScenario replaces the project's scenario layer with the given flag values. Flags missing from values fall back
+// to their manual override or source value. Passing no values clears the scenario.
+func ApplyScenario(ctx context.Context, projectKey string, values map[string]ldvalue.Value) error {
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+	for flagKey := range values {
+		if _, ok := project.AllFlagsState[flagKey]; !ok {
+			return NewErrNotFound("flag", flagKey)
+		}
+	}
+	previous, err := getLayeredOverrides(ctx, *project)
+	if err != nil {
+		return err
+	}
+	scenario, err := store.ReplaceScenarioOverrides(ctx, projectKey, values)
+	if err != nil {
+		return err
+	}
+
+	previousFlagsState, _ := previous.ApplyAll(project.AllFlagsState)
+	currentFlagsState, _ := LayeredOverrides{Inherited: previous.Inherited, Scenario: scenario, User: previous.User}.ApplyAll(project.AllFlagsState)
+	notifyFlagsStateChanges(ctx, projectKey, previousFlagsState, currentFlagsState)
+	notifyLinkedClones(ctx, *project, append(previous.Scenario.FlagKeys(), scenario.FlagKeys()...))
+	return nil
+}
+
+// GetFlagStateWithLayersForProject is like GetFlagStateWithOverridesForProject, but also returns which layer produced
+// each flag's value.
+// The result may be shared with other callers, so it mustn't be changed.
+func (project Project) GetFlagStateWithLayersForProject(ctx context.Context) (FlagsState, map[string]OverrideLayer, error) {
+	if cache, ok := StoreFromContext(ctx).(*CachingStore); ok {
+		return cache.flagsStateWithLayers(ctx, project)
+	}
+	return computeFlagStateWithLayers(ctx, project)
+}
+
+func computeFlagStateWithLayers(ctx context.Context, project Project) (FlagsState, map[string]OverrideLayer, error) {
+	overrides, err := getLayeredOverrides(ctx, project)
+	if err != nil {
+		return FlagsState{}, nil, err
+	}
+	flagsState, layers := overrides.ApplyAll(project.AllFlagsState)
+	return flagsState, layers, nil
+}
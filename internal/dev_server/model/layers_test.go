@@ -0,0 +1,148 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestLayeredOverridesApply(t *testing.T) {
+	flagKey := "flg"
+	source := model.FlagState{Value: ldvalue.String("source"), Version: 1}
+	scenario := model.Override{FlagKey: flagKey, Value: ldvalue.String("scenario"), Active: true, Version: 2}
+	user := model.Override{FlagKey: flagKey, Value: ldvalue.String("user"), Active: true, Version: 3}
+
+	t.Run("no overrides uses the source value", func(t *testing.T) {
+		state, layer := model.LayeredOverrides{}.Apply(flagKey, source)
+		assert.Equal(t, source, state)
+		assert.Equal(t, model.LayerSource, layer)
+	})
+
+	t.Run("scenario overrides the source value", func(t *testing.T) {
+		state, layer := model.LayeredOverrides{Scenario: model.Overrides{scenario}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("scenario"), Version: 3, TrackEvents: true}, state)
+		assert.Equal(t, model.LayerScenario, layer)
+	})
+
+	t.Run("user overrides the scenario", func(t *testing.T) {
+		state, layer := model.LayeredOverrides{Scenario: model.Overrides{scenario}, User: model.Overrides{user}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("user"), Version: 6, TrackEvents: true}, state)
+		assert.Equal(t, model.LayerUser, layer)
+	})
+
+	t.Run("inactive user override falls back to the scenario", func(t *testing.T) {
+		inactive := user
+		inactive.Active = false
+		state, layer := model.LayeredOverrides{Scenario: model.Overrides{scenario}, User: model.Overrides{inactive}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("scenario"), Version: 6, TrackEvents: true}, state)
+		assert.Equal(t, model.LayerScenario, layer)
+	})
+
+	t.Run("scenario and user overrides shadow inherited ones", func(t *testing.T) {
+		inherited := model.Override{FlagKey: flagKey, Value: ldvalue.String("inherited"), Active: true, Version: 4}
+		state, layer := model.LayeredOverrides{Inherited: model.Overrides{inherited}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("inherited"), Version: 5, TrackEvents: true}, state)
+		assert.Equal(t, model.LayerInherited, layer)
+
+		state, layer = model.LayeredOverrides{Inherited: model.Overrides{inherited}, Scenario: model.Overrides{scenario}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("scenario"), Version: 7, TrackEvents: true}, state)
+		assert.Equal(t, model.LayerScenario, layer)
+
+		state, layer = model.LayeredOverrides{Inherited: model.Overrides{inherited}, User: model.Overrides{user}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("user"), Version: 8, TrackEvents: true}, state)
+		assert.Equal(t, model.LayerUser, layer)
+	})
+
+	t.Run("a scenario hides an inherited rollout", func(t *testing.T) {
+		rollout := &model.Rollout{Variations: []model.WeightedValue{{Value: ldvalue.String("a"), Weight: model.RolloutTotalWeight}}}
+		inherited := model.Override{FlagKey: flagKey, Value: ldvalue.Null(), Rollout: rollout, Active: true, Version: 1}
+		state, _ := model.LayeredOverrides{Inherited: model.Overrides{inherited}}.Apply(flagKey, source)
+		assert.Equal(t, rollout, state.Rollout)
+
+		state, _ = model.LayeredOverrides{Inherited: model.Overrides{inherited}, Scenario: model.Overrides{scenario}}.Apply(flagKey, source)
+		assert.Nil(t, state.Rollout)
+	})
+
+	t.Run("inactive overrides fall back to the source value", func(t *testing.T) {
+		inactiveScenario := scenario
+		inactiveScenario.Active = false
+		state, layer := model.LayeredOverrides{Scenario: model.Overrides{inactiveScenario}}.Apply(flagKey, source)
+		assert.Equal(t, model.FlagState{Value: ldvalue.String("source"), Version: 3}, state)
+		assert.Equal(t, model.LayerSource, layer)
+	})
+}
+
+func TestApplyScenario(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	observers := model.NewObservers()
+	observer := mocks.NewMockObserver(mockController)
+	observers.RegisterObserver(observer)
+	ctx = model.SetObserversOnContext(ctx, observers)
+
+	projKey := "proj"
+	project := &model.Project{
+		Key: projKey,
+		AllFlagsState: model.FlagsState{
+			"flg":   model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+			"other": model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+		},
+	}
+
+	t.Run("returns ErrNotFound for flags that aren't in the project", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
+
+		err := model.ApplyScenario(ctx, projKey, map[string]ldvalue.Value{"nope": ldvalue.Bool(true)})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("applies the scenario under user overrides and notifies observers of each changed flag", func(t *testing.T) {
+		values := map[string]ldvalue.Value{"flg": ldvalue.Bool(true), "other": ldvalue.Bool(true)}
+		scenario := model.Overrides{
+			{ProjectKey: projKey, FlagKey: "flg", Value: ldvalue.Bool(true), Active: true, Version: 1},
+			{ProjectKey: projKey, FlagKey: "other", Value: ldvalue.Bool(true), Active: true, Version: 1},
+		}
+		user := model.Overrides{
+			{ProjectKey: projKey, FlagKey: "other", Value: ldvalue.Bool(false), Active: true, Version: 1},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
+		store.EXPECT().ReplaceScenarioOverrides(gomock.Any(), projKey, values).Return(scenario, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(user, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
+		observer.EXPECT().Handle(model.OverrideEvent{
+			ProjectKey: projKey,
+			FlagKey:    "flg",
+			FlagState:  model.FlagState{Value: ldvalue.Bool(true), Version: 2, TrackEvents: true},
+		})
+		observer.EXPECT().Handle(model.OverrideEvent{
+			ProjectKey: projKey,
+			FlagKey:    "other",
+			FlagState:  model.FlagState{Value: ldvalue.Bool(false), Version: 3, TrackEvents: true},
+		})
+
+		err := model.ApplyScenario(ctx, projKey, values)
+		assert.NoError(t, err)
+	})
+
+	t.Run("doesn't notify observers of flags whose state didn't change", func(t *testing.T) {
+		values := map[string]ldvalue.Value{"flg": ldvalue.Bool(true)}
+		scenario := model.Overrides{
+			{ProjectKey: projKey, FlagKey: "flg", Value: ldvalue.Bool(true), Active: true, Version: 1},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
+		store.EXPECT().ReplaceScenarioOverrides(gomock.Any(), projKey, values).Return(scenario, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(scenario, nil)
+
+		err := model.ApplyScenario(ctx, projKey, values)
+		assert.NoError(t, err)
+	})
+}
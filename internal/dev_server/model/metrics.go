@@ -0,0 +1,62 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const ctxKeyMetrics = ctxKey("model.Metrics")
+
+// Names of the metrics the dev server reports, before any prefix is added.
+const (
+	// MetricFlagEvaluations counts evaluations reported by SDKs in their summary events, tagged with the project and
+	// flag.
+	MetricFlagEvaluations = "flag_evaluations"
+	// MetricSyncDuration is how long each sync of a project from LaunchDarkly took, tagged with the project and whether
+	// it succeeded.
+	MetricSyncDuration = "sync.duration"
+	// MetricStreamClients is how many SDKs are connected to the flag streams.
+	MetricStreamClients = "stream.clients"
+)
+
+// Metrics is where the dev server reports what it's doing, for teams that push metrics to their monitoring rather than
+// scraping them. Tags are key:value pairs.
+type Metrics interface {
+	Count(name string, value int64, tags ...string)
+	Gauge(name string, value float64, tags ...string)
+	Timing(name string, d time.Duration, tags ...string)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) Count(string, int64, ...string)          {}
+func (noopMetrics) Gauge(string, float64, ...string)        {}
+func (noopMetrics) Timing(string, time.Duration, ...string) {}
+
+func ContextWithMetrics(ctx context.Context, metrics Metrics) context.Context {
+	return context.WithValue(ctx, ctxKeyMetrics, metrics)
+}
+
+// MetricsFromContext returns the metrics set on the context, or metrics that go nowhere if there aren't any.
+func MetricsFromContext(ctx context.Context) Metrics {
+	if metrics, ok := ctx.Value(ctxKeyMetrics).(Metrics); ok && metrics != nil {
+		return metrics
+	}
+	return noopMetrics{}
+}
+
+func MetricsMiddleware(metrics Metrics) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithMetrics(r.Context(), metrics)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Tag formats a metric tag.
+func Tag(key, value string) string {
+	return key + ":" + value
+}
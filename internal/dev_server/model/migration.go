@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ldapi "github.com/launchdarkly/api-client-go/v14"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// MigrationStages are the stages a migration flag can be in, in the order a migration goes through them. Migration
+// flags with fewer stages use a subset of them.
+var MigrationStages = []string{"off", "dualwrite", "shadow", "live", "rampdown", "complete"}
+
+const flagPurposeMigration = "migration"
+
+// ErrInvalidMigrationStage is returned when a migration flag is overridden with a value that isn't one of its stages.
+type ErrInvalidMigrationStage struct {
+	flagKey string
+	value   ldvalue.Value
+	stages  []string
+}
+
+func (e ErrInvalidMigrationStage) Error() string {
+	return fmt.Sprintf("%s isn't a stage of migration flag %s, which can be one of %s", e.value.JSONString(), e.flagKey, strings.Join(e.stages, ", "))
+}
+
+// migrationStagesOf returns the stages of a migration flag, in order, or nil if the flag isn't a migration flag.
+func migrationStagesOf(flag ldapi.FeatureFlag) []string {
+	if flag.GetPurpose() != flagPurposeMigration {
+		return nil
+	}
+	values := lo.FilterMap(flag.Variations, func(variation ldapi.Variation, _ int) (string, bool) {
+		stage, ok := variation.Value.(string)
+		return stage, ok
+	})
+	return lo.Filter(MigrationStages, func(stage string, _ int) bool {
+		return lo.Contains(values, stage)
+	})
+}
+
+// checkMigrationStages returns ErrInvalidMigrationStage if the override is for a migration flag and would serve a
+// value that isn't one of the flag's stages.
+func (project Project) checkMigrationStages(override Override) error {
+	stages := project.FlagMetadata[override.FlagKey].MigrationStages
+	if len(stages) == 0 {
+		return nil
+	}
+	values := []ldvalue.Value{override.Value}
+	if override.Rollout != nil {
+		values = lo.Map(override.Rollout.Variations, func(variation WeightedValue, _ int) ldvalue.Value {
+			return variation.Value
+		})
+	}
+	for _, value := range values {
+		if !value.IsString() || !lo.Contains(stages, value.StringValue()) {
+			return errors.WithStack(ErrInvalidMigrationStage{flagKey: override.FlagKey, value: value, stages: stages})
+		}
+	}
+	return nil
+}
+
+// PinMigrationStage overrides a migration flag with one of its stages, so that SDKs run the migration in that stage.
+func PinMigrationStage(ctx context.Context, projectKey, flagKey, stage string) (Override, error) {
+	project, err := getProjectWithFlag(ctx, projectKey, flagKey)
+	if err != nil {
+		return Override{}, err
+	}
+	if len(project.FlagMetadata[flagKey].MigrationStages) == 0 {
+		return Override{}, NewErrNotFound("migration flag", flagKey)
+	}
+	override, err := UpsertOverride(ctx, projectKey, flagKey, ldvalue.String(stage))
+	if err != nil {
+		return Override{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Pinned migration flag [%s] in project [%s] to stage [%s]", flagKey, projectKey, stage)
+	return override, nil
+}
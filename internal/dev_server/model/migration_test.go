@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestMigrationStageOverrides(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	project := &model.Project{
+		Key: "proj",
+		AllFlagsState: model.FlagsState{
+			"migration": model.FlagState{Value: ldvalue.String("off"), Version: 1},
+			"bool":      model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+		},
+		FlagMetadata: map[string]model.FlagMetadata{
+			"migration": {MigrationStages: []string{"off", "dualwrite", "shadow", "live"}},
+		},
+	}
+	store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil).AnyTimes()
+	store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil).AnyTimes()
+
+	t.Run("pins a migration flag to a stage", func(t *testing.T) {
+		expected := model.Override{ProjectKey: "proj", FlagKey: "migration", Value: ldvalue.String("shadow"), Active: true, Version: 1}
+		store.EXPECT().UpsertOverride(gomock.Any(), expected).Return(expected, nil)
+
+		override, err := model.PinMigrationStage(ctx, "proj", "migration", "shadow")
+		require.NoError(t, err)
+		assert.Equal(t, expected, override)
+	})
+
+	t.Run("rejects stages the flag doesn't have", func(t *testing.T) {
+		_, err := model.PinMigrationStage(ctx, "proj", "migration", "rampdown")
+		assert.ErrorAs(t, err, &model.ErrInvalidMigrationStage{})
+	})
+
+	t.Run("rejects overriding a migration flag with something other than a stage", func(t *testing.T) {
+		_, err := model.UpsertOverride(ctx, "proj", "migration", ldvalue.Bool(true))
+		assert.ErrorAs(t, err, &model.ErrInvalidMigrationStage{})
+
+		_, err = model.UpsertRolloutOverride(ctx, "proj", "migration", model.Rollout{Variations: []model.WeightedValue{
+			{Value: ldvalue.String("off"), Weight: model.RolloutTotalWeight / 2},
+			{Value: ldvalue.String("nope"), Weight: model.RolloutTotalWeight / 2},
+		}})
+		assert.ErrorAs(t, err, &model.ErrInvalidMigrationStage{})
+	})
+
+	t.Run("only pins migration flags", func(t *testing.T) {
+		_, err := model.PinMigrationStage(ctx, "proj", "bool", "off")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// MirrorOverrides overrides the project's flags that match filter with the values LaunchDarkly serves the project's
+// context in another environment of the same LaunchDarkly project, replacing any overrides for those flags. Flags the
+// project doesn't have are left out, and flags whose override is locked are skipped. Any other failure leaves the
+// project's overrides as they were.
+func MirrorOverrides(ctx context.Context, projectKey, environmentKey string, filter FlagFilter) (OverridesCopy, error) {
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err != nil {
+		return OverridesCopy{}, err
+	}
+	flagsState, err := project.fetchEnvironmentFlagState(ctx, environmentKey)
+	if err != nil {
+		return OverridesCopy{}, errors.Wrapf(err, "unable to fetch flags for environment %s", environmentKey)
+	}
+	flagKeys := make([]string, 0, len(flagsState))
+	for flagKey := range flagsState {
+		if _, ok := project.AllFlagsState[flagKey]; !ok {
+			continue
+		}
+		if !filter.Includes(flagKey, project.FlagMetadata[flagKey].Tags) {
+			continue
+		}
+		flagKeys = append(flagKeys, flagKey)
+	}
+	sort.Strings(flagKeys)
+
+	result := OverridesCopy{Copied: []string{}, Skipped: map[string]string{}}
+	err = withTx(ctx, func(ctx context.Context) error {
+		for _, flagKey := range flagKeys {
+			_, err := upsertOverride(ctx, Override{
+				ProjectKey: projectKey,
+				FlagKey:    flagKey,
+				Value:      flagsState[flagKey].Value,
+				Active:     true,
+				Version:    1,
+			})
+			switch {
+			case errors.As(err, &ErrLocked{}), errors.As(err, &ErrNotFound{}), errors.As(err, &ErrInvalidMigrationStage{}):
+				result.Skipped[flagKey] = err.Error()
+			case err != nil:
+				return errors.Wrapf(err, "unable to mirror flag %s", flagKey)
+			default:
+				result.Copied = append(result.Copied, flagKey)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return OverridesCopy{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Mirrored %d flags from environment [%s] into project [%s]", len(result.Copied), environmentKey, projectKey)
+	return result, nil
+}
+
+// fetchEnvironmentFlagState evaluates the project's flags for its context in one of the environments of its
+// LaunchDarkly project.
+func (project Project) fetchEnvironmentFlagState(ctx context.Context, environmentKey string) (FlagsState, error) {
+	sdkKey, _, err := project.environmentSdkKey(ctx, environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	evalContext, err := EnrichContext(ctx, project.Context)
+	if err != nil {
+		return nil, err
+	}
+	ctx = project.withBigSegmentMembership(ctx)
+	sdkFlags, err := adapters.GetSdk(ctx).GetAllFlagsState(ctx, evalContext, sdkKey)
+	if err != nil {
+		return nil, err
+	}
+	return FromAllFlags(sdkFlags), nil
+}
@@ -0,0 +1,84 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestMirrorOverrides(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	project := &model.Project{
+		Key:                  "proj",
+		SourceEnvironmentKey: "test",
+		EnvironmentKeys:      map[string]model.EnvironmentKeys{"staging": {SdkKey: "staging-sdk-key"}},
+		AllFlagsState: model.FlagsState{
+			"flag-a":      model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+			"flag-locked": model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+			"other":       model.FlagState{Value: ldvalue.String("local"), Version: 1},
+			"tagged":      model.FlagState{Value: ldvalue.Int(1), Version: 1},
+		},
+		FlagMetadata: map[string]model.FlagMetadata{"tagged": {Tags: []string{"frontend"}}},
+	}
+	stagingFlags := flagstate.NewAllFlagsBuilder().
+		AddFlag("flag-a", flagstate.FlagState{Value: ldvalue.Bool(true)}).
+		AddFlag("flag-locked", flagstate.FlagState{Value: ldvalue.Bool(true)}).
+		AddFlag("flag-not-local", flagstate.FlagState{Value: ldvalue.Bool(true)}).
+		AddFlag("other", flagstate.FlagState{Value: ldvalue.String("staging")}).
+		AddFlag("tagged", flagstate.FlagState{Value: ldvalue.Int(2)}).
+		Build()
+	store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil).AnyTimes()
+	store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil).AnyTimes()
+
+	t.Run("overrides matching flags with the environment's values and reports the ones skipped", func(t *testing.T) {
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "staging-sdk-key").Return(stagingFlags, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), model.Override{
+			ProjectKey: "proj", FlagKey: "flag-a", Value: ldvalue.Bool(true), Active: true, Version: 1,
+		}).Return(model.Override{ProjectKey: "proj", FlagKey: "flag-a", Value: ldvalue.Bool(true), Active: true, Version: 1}, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), gomock.Any()).Return(model.Override{}, model.NewErrLocked("proj", "flag-locked"))
+		store.EXPECT().UpsertOverride(gomock.Any(), model.Override{
+			ProjectKey: "proj", FlagKey: "tagged", Value: ldvalue.Int(2), Active: true, Version: 1,
+		}).Return(model.Override{ProjectKey: "proj", FlagKey: "tagged", Value: ldvalue.Int(2), Active: true, Version: 1}, nil)
+
+		mirrored, err := model.MirrorOverrides(ctx, "proj", "staging", model.FlagFilter{
+			KeyPrefixes: []string{"flag-"},
+			Tags:        []string{"frontend"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"flag-a", "tagged"}, mirrored.Copied)
+		assert.Len(t, mirrored.Skipped, 1)
+		assert.Contains(t, mirrored.Skipped, "flag-locked")
+	})
+
+	t.Run("looks up the SDK key of environments that weren't prefetched", func(t *testing.T) {
+		api.EXPECT().GetSdkKey(gomock.Any(), "proj", "production").Return("production-sdk-key", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "production-sdk-key").Return(flagstate.NewAllFlagsBuilder().Build(), nil)
+
+		mirrored, err := model.MirrorOverrides(ctx, "proj", "production", model.FlagFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, mirrored.Copied)
+	})
+
+	t.Run("returns ErrNotFound if the project doesn't exist", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "nope").Return(nil, model.NewErrNotFound("project", "nope"))
+
+		_, err := model.MirrorOverrides(ctx, "nope", "staging", model.FlagFilter{})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
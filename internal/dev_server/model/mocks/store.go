@@ -13,7 +13,9 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
+	ldvalue "github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	model "github.com/launchdarkly/ldcli/internal/dev_server/model"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +44,21 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// ArchiveProject mocks base method.
+func (m *MockStore) ArchiveProject(ctx context.Context, projectKey string, archivedAt *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveProject", ctx, projectKey, archivedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveProject indicates an expected call of ArchiveProject.
+func (mr *MockStoreMockRecorder) ArchiveProject(ctx, projectKey, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveProject", reflect.TypeOf((*MockStore)(nil).ArchiveProject), ctx, projectKey, archivedAt)
+}
+
 // CreateBackup mocks base method.
 func (m *MockStore) CreateBackup(ctx context.Context) (io.ReadCloser, int64, error) {
 	m.ctrl.T.Helper()
@@ -73,21 +90,127 @@ func (mr *MockStoreMockRecorder) DeactivateOverride(ctx, projectKey, flagKey any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateOverride", reflect.TypeOf((*MockStore)(nil).DeactivateOverride), ctx, projectKey, flagKey)
 }
 
-// DeleteDevProject mocks base method.
-func (m *MockStore) DeleteDevProject(ctx context.Context, projectKey string) (bool, error) {
+// DeleteAlias mocks base method.
+func (m *MockStore) DeleteAlias(ctx context.Context, alias string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDevProject", ctx, projectKey)
+	ret := m.ctrl.Call(m, "DeleteAlias", ctx, alias)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// DeleteAlias indicates an expected call of DeleteAlias.
+func (mr *MockStoreMockRecorder) DeleteAlias(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockStore)(nil).DeleteAlias), ctx, alias)
+}
+
+// DeleteDevProject mocks base method.
+func (m *MockStore) DeleteDevProject(ctx context.Context, projectKey string) (model.ProjectDeletion, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevProject", ctx, projectKey)
+	ret0, _ := ret[0].(model.ProjectDeletion)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // DeleteDevProject indicates an expected call of DeleteDevProject.
 func (mr *MockStoreMockRecorder) DeleteDevProject(ctx, projectKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevProject", reflect.TypeOf((*MockStore)(nil).DeleteDevProject), ctx, projectKey)
 }
 
+// DeleteFlagTrigger mocks base method.
+func (m *MockStore) DeleteFlagTrigger(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlagTrigger", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFlagTrigger indicates an expected call of DeleteFlagTrigger.
+func (mr *MockStoreMockRecorder) DeleteFlagTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlagTrigger", reflect.TypeOf((*MockStore)(nil).DeleteFlagTrigger), ctx, id)
+}
+
+// DeleteOverrideSchedule mocks base method.
+func (m *MockStore) DeleteOverrideSchedule(ctx context.Context, projectKey, flagKey string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOverrideSchedule", ctx, projectKey, flagKey)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOverrideSchedule indicates an expected call of DeleteOverrideSchedule.
+func (mr *MockStoreMockRecorder) DeleteOverrideSchedule(ctx, projectKey, flagKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOverrideSchedule", reflect.TypeOf((*MockStore)(nil).DeleteOverrideSchedule), ctx, projectKey, flagKey)
+}
+
+// DeleteSavedContext mocks base method.
+func (m *MockStore) DeleteSavedContext(ctx context.Context, projectKey, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedContext", ctx, projectKey, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedContext indicates an expected call of DeleteSavedContext.
+func (mr *MockStoreMockRecorder) DeleteSavedContext(ctx, projectKey, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedContext", reflect.TypeOf((*MockStore)(nil).DeleteSavedContext), ctx, projectKey, name)
+}
+
+// GetAlias mocks base method.
+func (m *MockStore) GetAlias(ctx context.Context, alias string) (model.Alias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlias", ctx, alias)
+	ret0, _ := ret[0].(model.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlias indicates an expected call of GetAlias.
+func (mr *MockStoreMockRecorder) GetAlias(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlias", reflect.TypeOf((*MockStore)(nil).GetAlias), ctx, alias)
+}
+
+// GetAliases mocks base method.
+func (m *MockStore) GetAliases(ctx context.Context) ([]model.Alias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliases", ctx)
+	ret0, _ := ret[0].([]model.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliases indicates an expected call of GetAliases.
+func (mr *MockStoreMockRecorder) GetAliases(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliases", reflect.TypeOf((*MockStore)(nil).GetAliases), ctx)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockStore) GetAuditLog(ctx context.Context, projectKey string, query model.AuditQuery) ([]model.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, projectKey, query)
+	ret0, _ := ret[0].([]model.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockStoreMockRecorder) GetAuditLog(ctx, projectKey, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockStore)(nil).GetAuditLog), ctx, projectKey, query)
+}
+
 // GetAvailableVariationsForProject mocks base method.
 func (m *MockStore) GetAvailableVariationsForProject(ctx context.Context, projectKey string) (map[string][]model.Variation, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +256,51 @@ func (mr *MockStoreMockRecorder) GetDevProjectKeys(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevProjectKeys", reflect.TypeOf((*MockStore)(nil).GetDevProjectKeys), ctx)
 }
 
+// GetFlagTrigger mocks base method.
+func (m *MockStore) GetFlagTrigger(ctx context.Context, id string) (model.FlagTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlagTrigger", ctx, id)
+	ret0, _ := ret[0].(model.FlagTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlagTrigger indicates an expected call of GetFlagTrigger.
+func (mr *MockStoreMockRecorder) GetFlagTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlagTrigger", reflect.TypeOf((*MockStore)(nil).GetFlagTrigger), ctx, id)
+}
+
+// GetFlagTriggers mocks base method.
+func (m *MockStore) GetFlagTriggers(ctx context.Context, projectKey string) ([]model.FlagTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlagTriggers", ctx, projectKey)
+	ret0, _ := ret[0].([]model.FlagTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlagTriggers indicates an expected call of GetFlagTriggers.
+func (mr *MockStoreMockRecorder) GetFlagTriggers(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlagTriggers", reflect.TypeOf((*MockStore)(nil).GetFlagTriggers), ctx, projectKey)
+}
+
+// GetOverrideSchedules mocks base method.
+func (m *MockStore) GetOverrideSchedules(ctx context.Context, projectKey string) ([]model.OverrideSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverrideSchedules", ctx, projectKey)
+	ret0, _ := ret[0].([]model.OverrideSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverrideSchedules indicates an expected call of GetOverrideSchedules.
+func (mr *MockStoreMockRecorder) GetOverrideSchedules(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverrideSchedules", reflect.TypeOf((*MockStore)(nil).GetOverrideSchedules), ctx, projectKey)
+}
+
 // GetOverridesForProject mocks base method.
 func (m *MockStore) GetOverridesForProject(ctx context.Context, projectKey string) (model.Overrides, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +316,96 @@ func (mr *MockStoreMockRecorder) GetOverridesForProject(ctx, projectKey any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverridesForProject", reflect.TypeOf((*MockStore)(nil).GetOverridesForProject), ctx, projectKey)
 }
 
+// GetProjectStateAt mocks base method.
+func (m *MockStore) GetProjectStateAt(ctx context.Context, projectKey string, at time.Time) (model.FlagsState, model.LayeredOverrides, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectStateAt", ctx, projectKey, at)
+	ret0, _ := ret[0].(model.FlagsState)
+	ret1, _ := ret[1].(model.LayeredOverrides)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectStateAt indicates an expected call of GetProjectStateAt.
+func (mr *MockStoreMockRecorder) GetProjectStateAt(ctx, projectKey, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectStateAt", reflect.TypeOf((*MockStore)(nil).GetProjectStateAt), ctx, projectKey, at)
+}
+
+// GetSavedContext mocks base method.
+func (m *MockStore) GetSavedContext(ctx context.Context, projectKey, name string) (model.SavedContext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedContext", ctx, projectKey, name)
+	ret0, _ := ret[0].(model.SavedContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedContext indicates an expected call of GetSavedContext.
+func (mr *MockStoreMockRecorder) GetSavedContext(ctx, projectKey, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedContext", reflect.TypeOf((*MockStore)(nil).GetSavedContext), ctx, projectKey, name)
+}
+
+// GetSavedContexts mocks base method.
+func (m *MockStore) GetSavedContexts(ctx context.Context, projectKey string) ([]model.SavedContext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedContexts", ctx, projectKey)
+	ret0, _ := ret[0].([]model.SavedContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedContexts indicates an expected call of GetSavedContexts.
+func (mr *MockStoreMockRecorder) GetSavedContexts(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedContexts", reflect.TypeOf((*MockStore)(nil).GetSavedContexts), ctx, projectKey)
+}
+
+// GetScenarioOverridesForProject mocks base method.
+func (m *MockStore) GetScenarioOverridesForProject(ctx context.Context, projectKey string) (model.Overrides, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScenarioOverridesForProject", ctx, projectKey)
+	ret0, _ := ret[0].(model.Overrides)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScenarioOverridesForProject indicates an expected call of GetScenarioOverridesForProject.
+func (mr *MockStoreMockRecorder) GetScenarioOverridesForProject(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScenarioOverridesForProject", reflect.TypeOf((*MockStore)(nil).GetScenarioOverridesForProject), ctx, projectKey)
+}
+
+// GetSnapshots mocks base method.
+func (m *MockStore) GetSnapshots(ctx context.Context, projectKey string) ([]model.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshots", ctx, projectKey)
+	ret0, _ := ret[0].([]model.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshots indicates an expected call of GetSnapshots.
+func (mr *MockStoreMockRecorder) GetSnapshots(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshots", reflect.TypeOf((*MockStore)(nil).GetSnapshots), ctx, projectKey)
+}
+
+// InsertFlagTrigger mocks base method.
+func (m *MockStore) InsertFlagTrigger(ctx context.Context, trigger model.FlagTrigger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertFlagTrigger", ctx, trigger)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertFlagTrigger indicates an expected call of InsertFlagTrigger.
+func (mr *MockStoreMockRecorder) InsertFlagTrigger(ctx, trigger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertFlagTrigger", reflect.TypeOf((*MockStore)(nil).InsertFlagTrigger), ctx, trigger)
+}
+
 // InsertProject mocks base method.
 func (m *MockStore) InsertProject(ctx context.Context, project model.Project) error {
 	m.ctrl.T.Helper()
@@ -162,6 +420,66 @@ func (mr *MockStoreMockRecorder) InsertProject(ctx, project any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertProject", reflect.TypeOf((*MockStore)(nil).InsertProject), ctx, project)
 }
 
+// InsertSnapshot mocks base method.
+func (m *MockStore) InsertSnapshot(ctx context.Context, projectKey string) (model.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSnapshot", ctx, projectKey)
+	ret0, _ := ret[0].(model.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSnapshot indicates an expected call of InsertSnapshot.
+func (mr *MockStoreMockRecorder) InsertSnapshot(ctx, projectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSnapshot", reflect.TypeOf((*MockStore)(nil).InsertSnapshot), ctx, projectKey)
+}
+
+// OrphanProject mocks base method.
+func (m *MockStore) OrphanProject(ctx context.Context, projectKey string, orphaned model.Orphaned) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrphanProject", ctx, projectKey, orphaned)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrphanProject indicates an expected call of OrphanProject.
+func (mr *MockStoreMockRecorder) OrphanProject(ctx, projectKey, orphaned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrphanProject", reflect.TypeOf((*MockStore)(nil).OrphanProject), ctx, projectKey, orphaned)
+}
+
+// PruneHistory mocks base method.
+func (m *MockStore) PruneHistory(ctx context.Context, projectKey string, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneHistory", ctx, projectKey, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneHistory indicates an expected call of PruneHistory.
+func (mr *MockStoreMockRecorder) PruneHistory(ctx, projectKey, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneHistory", reflect.TypeOf((*MockStore)(nil).PruneHistory), ctx, projectKey, before)
+}
+
+// ReplaceScenarioOverrides mocks base method.
+func (m *MockStore) ReplaceScenarioOverrides(ctx context.Context, projectKey string, values map[string]ldvalue.Value) (model.Overrides, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceScenarioOverrides", ctx, projectKey, values)
+	ret0, _ := ret[0].(model.Overrides)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceScenarioOverrides indicates an expected call of ReplaceScenarioOverrides.
+func (mr *MockStoreMockRecorder) ReplaceScenarioOverrides(ctx, projectKey, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceScenarioOverrides", reflect.TypeOf((*MockStore)(nil).ReplaceScenarioOverrides), ctx, projectKey, values)
+}
+
 // RestoreBackup mocks base method.
 func (m *MockStore) RestoreBackup(ctx context.Context, stream io.Reader) (string, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +495,51 @@ func (mr *MockStoreMockRecorder) RestoreBackup(ctx, stream any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackup", reflect.TypeOf((*MockStore)(nil).RestoreBackup), ctx, stream)
 }
 
+// SetEnvironmentKeys mocks base method.
+func (m *MockStore) SetEnvironmentKeys(ctx context.Context, projectKey string, keys map[string]model.EnvironmentKeys) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnvironmentKeys", ctx, projectKey, keys)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEnvironmentKeys indicates an expected call of SetEnvironmentKeys.
+func (mr *MockStoreMockRecorder) SetEnvironmentKeys(ctx, projectKey, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnvironmentKeys", reflect.TypeOf((*MockStore)(nil).SetEnvironmentKeys), ctx, projectKey, keys)
+}
+
+// SetOverrideLocked mocks base method.
+func (m *MockStore) SetOverrideLocked(ctx context.Context, projectKey, flagKey string, locked bool) (model.Override, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverrideLocked", ctx, projectKey, flagKey, locked)
+	ret0, _ := ret[0].(model.Override)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOverrideLocked indicates an expected call of SetOverrideLocked.
+func (mr *MockStoreMockRecorder) SetOverrideLocked(ctx, projectKey, flagKey, locked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverrideLocked", reflect.TypeOf((*MockStore)(nil).SetOverrideLocked), ctx, projectKey, flagKey, locked)
+}
+
+// SetSyncStatus mocks base method.
+func (m *MockStore) SetSyncStatus(ctx context.Context, projectKey string, status model.SyncStatus) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSyncStatus", ctx, projectKey, status)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSyncStatus indicates an expected call of SetSyncStatus.
+func (mr *MockStoreMockRecorder) SetSyncStatus(ctx, projectKey, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSyncStatus", reflect.TypeOf((*MockStore)(nil).SetSyncStatus), ctx, projectKey, status)
+}
+
 // UpdateProject mocks base method.
 func (m *MockStore) UpdateProject(ctx context.Context, project model.Project) (bool, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +555,20 @@ func (mr *MockStoreMockRecorder) UpdateProject(ctx, project any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockStore)(nil).UpdateProject), ctx, project)
 }
 
+// UpsertAlias mocks base method.
+func (m *MockStore) UpsertAlias(ctx context.Context, alias model.Alias) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlias", ctx, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAlias indicates an expected call of UpsertAlias.
+func (mr *MockStoreMockRecorder) UpsertAlias(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlias", reflect.TypeOf((*MockStore)(nil).UpsertAlias), ctx, alias)
+}
+
 // UpsertOverride mocks base method.
 func (m *MockStore) UpsertOverride(ctx context.Context, override model.Override) (model.Override, error) {
 	m.ctrl.T.Helper()
@@ -206,3 +583,45 @@ func (mr *MockStoreMockRecorder) UpsertOverride(ctx, override any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOverride", reflect.TypeOf((*MockStore)(nil).UpsertOverride), ctx, override)
 }
+
+// UpsertOverrideSchedule mocks base method.
+func (m *MockStore) UpsertOverrideSchedule(ctx context.Context, schedule model.OverrideSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOverrideSchedule", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertOverrideSchedule indicates an expected call of UpsertOverrideSchedule.
+func (mr *MockStoreMockRecorder) UpsertOverrideSchedule(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOverrideSchedule", reflect.TypeOf((*MockStore)(nil).UpsertOverrideSchedule), ctx, schedule)
+}
+
+// UpsertSavedContext mocks base method.
+func (m *MockStore) UpsertSavedContext(ctx context.Context, savedContext model.SavedContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSavedContext", ctx, savedContext)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSavedContext indicates an expected call of UpsertSavedContext.
+func (mr *MockStoreMockRecorder) UpsertSavedContext(ctx, savedContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSavedContext", reflect.TypeOf((*MockStore)(nil).UpsertSavedContext), ctx, savedContext)
+}
+
+// WithTx mocks base method.
+func (m *MockStore) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockStoreMockRecorder) WithTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockStore)(nil).WithTx), ctx, fn)
+}
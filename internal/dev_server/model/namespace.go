@@ -0,0 +1,109 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const ctxKeyNamespace = ctxKey("model.Namespace")
+
+// NamespaceHeaderDefault is the request header that selects a namespace when namespaces come from a header.
+const NamespaceHeaderDefault = "X-LD-Namespace"
+
+// namespaceSeparator joins a project's key and a namespace into the key of the namespace's project. It can't be in a
+// namespace, so namespaced projects can't be mistaken for each other.
+const namespaceSeparator = "~"
+
+var namespacePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// namespaceClones is held while a namespace's project is created, so that requests arriving together create it once.
+var namespaceClones sync.Mutex
+
+// NamespacedProjectKey is the key of the project a namespace gets for the project with the given key.
+func NamespacedProjectKey(projectKey, namespace string) string {
+	return projectKey + namespaceSeparator + namespace
+}
+
+// ResolveNamespacedProjectKey returns the key of the project that the request's namespace has in place of the given
+// one. Namespaces let developers sharing a dev server each have their own overrides: the first time a namespace uses a
+// project, it gets a linked clone of it, which shares the project's synced flags and overrides but keeps overrides set
+// in the namespace to itself. SDKs that can't send a header can name the namespace in their key instead, e.g.
+// my-project~alice. Without a namespace, or for projects that don't exist or are clones themselves, the key is returned
+// as it is, as it is when namespaces are off.
+func ResolveNamespacedProjectKey(ctx context.Context, projectKey string) (string, error) {
+	namespace, enabled := ctx.Value(ctxKeyNamespace).(string)
+	if !enabled {
+		return projectKey, nil
+	}
+	if namespace == "" {
+		base, inKey, ok := strings.Cut(projectKey, namespaceSeparator)
+		if !ok || !namespacePattern.MatchString(inKey) {
+			return projectKey, nil
+		}
+		projectKey, namespace = base, inKey
+	}
+	namespacedKey := NamespacedProjectKey(projectKey, namespace)
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, namespacedKey); !errors.As(err, &ErrNotFound{}) {
+		return namespacedKey, err
+	}
+
+	namespaceClones.Lock()
+	defer namespaceClones.Unlock()
+	base, err := store.GetDevProject(ctx, projectKey)
+	switch {
+	case errors.As(err, &ErrNotFound{}):
+		return projectKey, nil
+	case err != nil:
+		return "", err
+	case base.BaseProjectKey != "":
+		return projectKey, nil
+	}
+	if _, err := store.GetDevProject(ctx, namespacedKey); !errors.As(err, &ErrNotFound{}) {
+		return namespacedKey, err
+	}
+	if _, err := CloneProject(context.WithoutCancel(ctx), namespacedKey, projectKey); err != nil {
+		return "", errors.Wrapf(err, "unable to create project %s for namespace %s", projectKey, namespace)
+	}
+	logs.Printf(logs.Info, namespacedKey, "Created project [%s] for namespace [%s]", namespacedKey, namespace)
+	return namespacedKey, nil
+}
+
+func ContextWithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, ctxKeyNamespace, namespace)
+}
+
+// NamespaceFromContext returns the namespace of the request ctx is for, or an empty string if it has none.
+func NamespaceFromContext(ctx context.Context) string {
+	namespace, _ := ctx.Value(ctxKeyNamespace).(string)
+	return namespace
+}
+
+// NamespaceMiddleware puts each request's namespace on its context. The resolver picks the namespace the same way an
+// ActorResolver picks an actor, e.g. HeaderActorResolver(NamespaceHeaderDefault), or TokenActorResolver to give each
+// developer's token its own namespace. A nil resolver turns namespaces off.
+func NamespaceMiddleware(resolver ActorResolver) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if resolver == nil {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			namespace := resolver.ResolveActor(r)
+			if namespace != "" && !namespacePattern.MatchString(namespace) {
+				http.Error(w, fmt.Sprintf("invalid namespace %q: only letters, digits, '.', '_', and '-' are allowed", namespace), http.StatusBadRequest)
+				return
+			}
+			r = r.WithContext(ContextWithNamespace(r.Context(), namespace))
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
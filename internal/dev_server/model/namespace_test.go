@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestResolveNamespacedProjectKey(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+	require.NoError(t, model.ImportProject(ctx, "proj", model.ImportDataFromValues(map[string]ldvalue.Value{"flag": ldvalue.Bool(false)})))
+
+	t.Run("leaves keys alone when namespaces are off", func(t *testing.T) {
+		projectKey, err := model.ResolveNamespacedProjectKey(ctx, "proj~alice")
+		require.NoError(t, err)
+		assert.Equal(t, "proj~alice", projectKey)
+	})
+
+	t.Run("gives the namespace a linked clone of the project", func(t *testing.T) {
+		aliceCtx := model.ContextWithNamespace(ctx, "alice")
+		projectKey, err := model.ResolveNamespacedProjectKey(aliceCtx, "proj")
+		require.NoError(t, err)
+		assert.Equal(t, "proj~alice", projectKey)
+
+		clone, err := store.GetDevProject(ctx, "proj~alice")
+		require.NoError(t, err)
+		assert.Equal(t, "proj", clone.BaseProjectKey)
+
+		// overrides in the namespace don't change the shared project
+		_, err = model.UpsertOverride(ctx, projectKey, "flag", ldvalue.Bool(true))
+		require.NoError(t, err)
+		overrides, err := store.GetOverridesForProject(ctx, "proj")
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+
+		projectKey, err = model.ResolveNamespacedProjectKey(aliceCtx, "proj")
+		require.NoError(t, err)
+		assert.Equal(t, "proj~alice", projectKey)
+	})
+
+	t.Run("reads the namespace from the key without one on the request", func(t *testing.T) {
+		projectKey, err := model.ResolveNamespacedProjectKey(model.ContextWithNamespace(ctx, ""), "proj~bob")
+		require.NoError(t, err)
+		assert.Equal(t, "proj~bob", projectKey)
+		_, err = store.GetDevProject(ctx, "proj~bob")
+		assert.NoError(t, err)
+	})
+
+	t.Run("leaves keys of missing projects and clones alone", func(t *testing.T) {
+		aliceCtx := model.ContextWithNamespace(ctx, "alice")
+		for _, key := range []string{"missing", "proj~bob"} {
+			projectKey, err := model.ResolveNamespacedProjectKey(aliceCtx, key)
+			require.NoError(t, err)
+			assert.Equal(t, key, projectKey)
+		}
+	})
+}
+
+func TestNamespaceMiddleware(t *testing.T) {
+	var namespace string
+	handler := model.NamespaceMiddleware(model.HeaderActorResolver(model.NamespaceHeaderDefault))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace = model.NamespaceFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(model.NamespaceHeaderDefault, "alice")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "alice", namespace)
+
+	req.Header.Set(model.NamespaceHeaderDefault, "proj~alice")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,6 +15,21 @@ type Observer interface {
 
 type Observers struct {
 	observers sync.Map
+
+	// debounceWindow is how long override events are held so that later changes to the same flag replace them. Zero
+	// delivers every event immediately.
+	debounceWindow time.Duration
+	// deliverMu keeps a flush of held events from interleaving with events that must come after them.
+	deliverMu sync.Mutex
+	mu        sync.Mutex
+	pending   map[pendingOverrideKey]OverrideEvent
+	order     []pendingOverrideKey
+	timer     *time.Timer
+}
+
+type pendingOverrideKey struct {
+	projectKey string
+	flagKey    string
 }
 
 func NewObservers() *Observers {
@@ -22,6 +38,15 @@ func NewObservers() *Observers {
 	return observers
 }
 
+// NewDebouncedObservers returns observers that coalesce override events for the same flag arriving within window of
+// the first one, delivering only the latest, so that scripted setup doesn't send SDKs a patch for every step.
+func NewDebouncedObservers(window time.Duration) *Observers {
+	observers := NewObservers()
+	observers.debounceWindow = window
+	observers.pending = make(map[pendingOverrideKey]OverrideEvent)
+	return observers
+}
+
 func (o *Observers) DeregisterObserver(observerId uuid.UUID) bool {
 	_, exists := o.observers.LoadAndDelete(observerId)
 	return exists
@@ -34,6 +59,65 @@ func (o *Observers) RegisterObserver(observer Observer) uuid.UUID {
 }
 
 func (o *Observers) Notify(event interface{}) {
+	if o.debounceWindow == 0 {
+		o.notifyNow(event)
+		return
+	}
+	switch event := event.(type) {
+	case OverrideEvent:
+		o.hold(event)
+	case SyncEvent, FlagDeletedEvent:
+		// these replace or remove flag state, so anything held has to go out first
+		o.deliverMu.Lock()
+		defer o.deliverMu.Unlock()
+		o.deliverHeld()
+		o.notifyNow(event)
+	default:
+		o.notifyNow(event)
+	}
+}
+
+// flush delivers any held override events now.
+func (o *Observers) flush() {
+	o.deliverMu.Lock()
+	defer o.deliverMu.Unlock()
+	o.deliverHeld()
+}
+
+func (o *Observers) hold(event OverrideEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	key := pendingOverrideKey{projectKey: event.ProjectKey, flagKey: event.FlagKey}
+	if _, ok := o.pending[key]; !ok {
+		o.order = append(o.order, key)
+	}
+	o.pending[key] = event
+	if o.timer == nil {
+		o.timer = time.AfterFunc(o.debounceWindow, o.flush)
+	}
+}
+
+// deliverHeld must be called with deliverMu held.
+func (o *Observers) deliverHeld() {
+	o.mu.Lock()
+	events := make([]OverrideEvent, 0, len(o.order))
+	for _, key := range o.order {
+		events = append(events, o.pending[key])
+	}
+	o.pending = make(map[pendingOverrideKey]OverrideEvent)
+	o.order = nil
+	if o.timer != nil {
+		o.timer.Stop()
+		o.timer = nil
+	}
+	o.mu.Unlock()
+
+	for _, event := range events {
+		o.notifyNow(event)
+	}
+}
+
+func (o *Observers) notifyNow(event interface{}) {
 	o.observers.Range(func(_, observer any) bool {
 		observer.(Observer).Handle(event)
 		return true
@@ -3,10 +3,12 @@ package model_test
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testObserver struct {
@@ -58,3 +60,56 @@ func TestObservers(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestDebouncedObservers(t *testing.T) {
+	recordEvents := func(observers *model.Observers) func() []interface{} {
+		var mu sync.Mutex
+		var events []interface{}
+		observers.RegisterObserver(testObserver{handle: func(event interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		}})
+		return func() []interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]interface{}{}, events...)
+		}
+	}
+	override := func(flagKey string, version int) model.OverrideEvent {
+		return model.OverrideEvent{ProjectKey: "proj", FlagKey: flagKey, FlagState: model.FlagState{Version: version}}
+	}
+
+	t.Run("coalesces changes to the same flag within the window", func(t *testing.T) {
+		observers := model.NewDebouncedObservers(20 * time.Millisecond)
+		events := recordEvents(observers)
+
+		observers.Notify(override("a", 1))
+		observers.Notify(override("b", 1))
+		observers.Notify(override("a", 2))
+		assert.Empty(t, events(), "events are held until the window passes")
+
+		require.Eventually(t, func() bool { return len(events()) == 2 }, time.Second, time.Millisecond)
+		assert.Equal(t, []interface{}{override("a", 2), override("b", 1)}, events())
+	})
+
+	t.Run("delivers held changes before deletes and syncs", func(t *testing.T) {
+		observers := model.NewDebouncedObservers(time.Hour)
+		events := recordEvents(observers)
+		deleted := model.FlagDeletedEvent{ProjectKey: "proj", FlagKey: "a", Version: 3}
+
+		observers.Notify(override("a", 2))
+		observers.Notify(deleted)
+
+		assert.Equal(t, []interface{}{override("a", 2), deleted}, events())
+	})
+
+	t.Run("doesn't hold other events", func(t *testing.T) {
+		observers := model.NewDebouncedObservers(time.Hour)
+		events := recordEvents(observers)
+
+		observers.Notify("sdk event")
+
+		assert.Equal(t, []interface{}{"sdk event"}, events())
+	})
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// Orphaned records that the LaunchDarkly project or environment a dev server project syncs from has been deleted or
+// renamed. The project keeps the flag state from its last successful sync.
+type Orphaned struct {
+	// Detail is the error that showed the source was gone.
+	Detail string
+	Since  time.Time
+}
+
+// ErrOrphaned is returned instead of syncing an orphaned project, so that clients retrying a failed sync don't keep
+// calling the LaunchDarkly API for something that isn't there.
+type ErrOrphaned struct {
+	projectKey string
+	orphaned   Orphaned
+}
+
+func (e ErrOrphaned) Error() string {
+	return fmt.Sprintf(
+		"project %s is orphaned since %s: %s. Set its source environment to sync it again",
+		e.projectKey,
+		e.orphaned.Since.Format(time.RFC3339),
+		e.orphaned.Detail,
+	)
+}
+
+// orphanIfSourceNotFound marks the project orphaned if err shows that its source is gone from LaunchDarkly.
+func orphanIfSourceNotFound(ctx context.Context, projectKey string, err error) {
+	if !errors.As(err, &adapters.ErrSourceNotFound{}) {
+		return
+	}
+	orphaned := Orphaned{Detail: err.Error(), Since: time.Now()}
+	if _, storeErr := StoreFromContext(ctx).OrphanProject(ctx, projectKey, orphaned); storeErr != nil {
+		logs.Printf(logs.Error, projectKey, "unable to mark project %s orphaned: %+v", projectKey, storeErr)
+		return
+	}
+	logs.Printf(logs.Warn, projectKey, "Project [%s] is orphaned: %s", projectKey, orphaned.Detail)
+}
@@ -2,8 +2,11 @@ package model
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 type Override struct {
@@ -12,17 +15,59 @@ type Override struct {
 	Value      ldvalue.Value
 	Active     bool
 	Version    int
+	// Locked overrides can't be changed or removed until they're unlocked, are skipped when removing all of a project's
+	// overrides, and are kept even if a sync finds their flag gone.
+	Locked bool
+	// Rollout, if set, splits the flag between values by percentage instead of always serving Value.
+	Rollout *Rollout
+}
+
+// ErrLocked is returned when changing or removing a locked override.
+type ErrLocked struct {
+	projectKey string
+	flagKey    string
+}
+
+func NewErrLocked(projectKey, flagKey string) ErrLocked {
+	return ErrLocked{projectKey: projectKey, flagKey: flagKey}
+}
+
+func (e ErrLocked) Error() string {
+	return fmt.Sprintf("override for flag %s in project %s is locked. Unlock it to change it", e.flagKey, e.projectKey)
+}
+
+// SetOverrideLocked locks or unlocks the flag's override. It doesn't change the flag's value, so SDKs aren't notified.
+func SetOverrideLocked(ctx context.Context, projectKey, flagKey string, locked bool) (Override, error) {
+	override, err := StoreFromContext(ctx).SetOverrideLocked(ctx, projectKey, flagKey, locked)
+	if err != nil {
+		return Override{}, err
+	}
+	if locked {
+		logs.Printf(logs.Info, projectKey, "Locked override for flag [%s] in project [%s]", flagKey, projectKey)
+	} else {
+		logs.Printf(logs.Info, projectKey, "Unlocked override for flag [%s] in project [%s]", flagKey, projectKey)
+	}
+	return override, nil
 }
 
 // getFlagStateForFlagAndProject fetches state from the store so that it can later be used to apply an override and
 // construct an update. You want to call this before you write the override so that written overrides don't
 // less often don't cause updates.
 func getFlagStateForFlagAndProject(ctx context.Context, projectKey, flagKey string) (FlagState, error) {
+	project, err := getProjectWithFlag(ctx, projectKey, flagKey)
+	if err != nil {
+		return FlagState{}, err
+	}
+	return project.AllFlagsState[flagKey], nil
+}
+
+// getProjectWithFlag fetches the project, returning ErrNotFound if it doesn't have the flag.
+func getProjectWithFlag(ctx context.Context, projectKey, flagKey string) (*Project, error) {
 	store := StoreFromContext(ctx)
 
 	project, err := store.GetDevProject(ctx, projectKey)
 	if err != nil {
-		return FlagState{}, err
+		return nil, err
 	}
 
 	var flagExists bool
@@ -33,23 +78,45 @@ func getFlagStateForFlagAndProject(ctx context.Context, projectKey, flagKey stri
 		}
 	}
 	if !flagExists {
-		return FlagState{}, NewErrNotFound("flag", flagKey)
+		return nil, NewErrNotFound("flag", flagKey)
 	}
-	return project.AllFlagsState[flagKey], nil
+	return project, nil
 }
 
 func UpsertOverride(ctx context.Context, projectKey, flagKey string, value ldvalue.Value) (Override, error) {
-	flagState, err := getFlagStateForFlagAndProject(ctx, projectKey, flagKey)
-	if err != nil {
+	return upsertOverride(ctx, Override{
+		ProjectKey: projectKey,
+		FlagKey:    flagKey,
+		Value:      value,
+		Active:     true,
+		Version:    1,
+	})
+}
+
+// UpsertRolloutOverride overrides the flag with a percentage rollout. SDKs that don't send a context, such as the UI,
+// see the rollout's first value.
+func UpsertRolloutOverride(ctx context.Context, projectKey, flagKey string, rollout Rollout) (Override, error) {
+	if err := rollout.Validate(); err != nil {
 		return Override{}, err
 	}
-
-	override := Override{
+	return upsertOverride(ctx, Override{
 		ProjectKey: projectKey,
 		FlagKey:    flagKey,
-		Value:      value,
+		Value:      rollout.Variations[0].Value,
+		Rollout:    &rollout,
 		Active:     true,
 		Version:    1,
+	})
+}
+
+func upsertOverride(ctx context.Context, override Override) (Override, error) {
+	projectKey, flagKey := override.ProjectKey, override.FlagKey
+	project, err := getProjectWithFlag(ctx, projectKey, flagKey)
+	if err != nil {
+		return Override{}, err
+	}
+	if err := project.checkMigrationStages(override); err != nil {
+		return Override{}, err
 	}
 
 	store := StoreFromContext(ctx)
@@ -57,17 +124,26 @@ func UpsertOverride(ctx context.Context, projectKey, flagKey string, value ldval
 	if err != nil {
 		return Override{}, err
 	}
+	if override.Rollout == nil || !override.Rollout.PerEvaluation {
+		// a chaos override this replaced has no more use for its values
+		randomValues.reset(projectKey, flagKey)
+	}
 
+	flagState, err := effectiveFlagState(ctx, *project, override)
+	if err != nil {
+		return Override{}, err
+	}
 	GetObserversFromContext(ctx).Notify(OverrideEvent{
 		FlagKey:    flagKey,
 		ProjectKey: projectKey,
-		FlagState:  override.Apply(flagState),
+		FlagState:  flagState,
 	})
+	notifyLinkedClones(ctx, *project, []string{flagKey})
 	return override, nil
 }
 
 func DeleteOverride(ctx context.Context, projectKey, flagKey string) error {
-	flagState, err := getFlagStateForFlagAndProject(ctx, projectKey, flagKey)
+	project, err := getProjectWithFlag(ctx, projectKey, flagKey)
 	if err != nil {
 		return err
 	}
@@ -76,6 +152,7 @@ func DeleteOverride(ctx context.Context, projectKey, flagKey string) error {
 	if err != nil {
 		return err
 	}
+	randomValues.reset(projectKey, flagKey)
 	override := Override{
 		ProjectKey: projectKey,
 		FlagKey:    flagKey,
@@ -83,16 +160,42 @@ func DeleteOverride(ctx context.Context, projectKey, flagKey string) error {
 		Active:     false,
 		Version:    version,
 	}
+	flagState, err := effectiveFlagState(ctx, *project, override)
+	if err != nil {
+		return err
+	}
 	GetObserversFromContext(ctx).Notify(OverrideEvent{
 		FlagKey:    flagKey,
 		ProjectKey: projectKey,
-		FlagState:  override.Apply(flagState),
+		FlagState:  flagState,
 	})
-	return err
+	notifyLinkedClones(ctx, *project, []string{flagKey})
+	return nil
+}
+
+// effectiveFlagState applies a user override that was just written to the project on top of the flag's scenario and
+// inherited overrides, if any.
+func effectiveFlagState(ctx context.Context, project Project, override Override) (FlagState, error) {
+	scenario, err := StoreFromContext(ctx).GetScenarioOverridesForProject(ctx, override.ProjectKey)
+	if err != nil {
+		return FlagState{}, err
+	}
+	inherited, err := getInheritedOverrides(ctx, project)
+	if err != nil {
+		return FlagState{}, err
+	}
+	state, _ := LayeredOverrides{Inherited: inherited, Scenario: scenario, User: Overrides{override}}.Apply(override.FlagKey, project.AllFlagsState[override.FlagKey])
+	return state, nil
 }
 
+// DeleteOverrides removes all of the project's unlocked overrides, or none of them if any can't be removed.
 func DeleteOverrides(ctx context.Context, projectKey string) error {
+	return withTx(ctx, func(ctx context.Context) error {
+		return deleteOverrides(ctx, projectKey)
+	})
+}
 
+func deleteOverrides(ctx context.Context, projectKey string) error {
 	store := StoreFromContext(ctx)
 	overrides, err := store.GetOverridesForProject(ctx, projectKey)
 	if err != nil {
@@ -100,6 +203,10 @@ func DeleteOverrides(ctx context.Context, projectKey string) error {
 	}
 
 	for _, override := range overrides {
+		if override.Locked {
+			logs.Printf(logs.Info, projectKey, "Keeping locked override for flag [%s] in project [%s]", override.FlagKey, projectKey)
+			continue
+		}
 		err := DeleteOverride(ctx, projectKey, override.FlagKey)
 		if err != nil {
 			return err
@@ -112,18 +219,30 @@ func DeleteOverrides(ctx context.Context, projectKey string) error {
 func (o Override) Apply(state FlagState) FlagState {
 	flagVersion := state.Version + o.Version
 	flagValue := state.Value
+	var rollout *Rollout
 	if o.Active {
 		flagValue = o.Value
+		rollout = o.Rollout
 	}
 	return FlagState{
 		Value:       flagValue,
 		Version:     flagVersion,
 		TrackEvents: o.Active,
+		Rollout:     rollout,
+		Archived:    state.Archived,
 	}
 }
 
 type Overrides []Override
 
+func (o Overrides) FlagKeys() []string {
+	keys := make([]string, 0, len(o))
+	for _, override := range o {
+		keys = append(keys, override.FlagKey)
+	}
+	return keys
+}
+
 func (o Overrides) GetFlag(key string) (Override, bool) {
 	for _, override := range o {
 		if override.FlagKey == key {
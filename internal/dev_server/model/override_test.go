@@ -73,6 +73,7 @@ func TestUpsertOverride(t *testing.T) {
 	t.Run("override is applied, observers are notified", func(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
 		store.EXPECT().UpsertOverride(gomock.Any(), override).Return(override, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		observer.
 			EXPECT().
 			Handle(model.OverrideEvent{
@@ -128,6 +129,7 @@ func TestDeleteOverride(t *testing.T) {
 	t.Run("override is applied, observers are notified", func(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
 		store.EXPECT().DeactivateOverride(gomock.Any(), projKey, flagKey).Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		observer.
 			EXPECT().
 			Handle(model.OverrideEvent{
@@ -149,6 +151,7 @@ func TestDeleteOverrides(t *testing.T) {
 	defer mockController.Finish()
 
 	store := mocks.NewMockStore(mockController)
+	expectTransactions(store)
 	ctx := context.Background()
 	projKey := "proj"
 	flagKey := "flg"
@@ -188,6 +191,22 @@ func TestDeleteOverrides(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("doesn't notify observers of deletions that are rolled back", func(t *testing.T) {
+		overrides := model.Overrides{
+			{ProjectKey: projKey, FlagKey: flagKey},
+			{ProjectKey: projKey, FlagKey: "flag2"},
+		}
+		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(overrides, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), projKey, flagKey).Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), projKey, "flag2").Return(0, errors.New("delete error"))
+
+		err := model.DeleteOverrides(ctx, projKey)
+		assert.Error(t, err)
+	})
+
 	t.Run("Successfully deletes all overrides", func(t *testing.T) {
 		overrides := model.Overrides{
 			{ProjectKey: projKey, FlagKey: flagKey},
@@ -198,11 +217,28 @@ func TestDeleteOverrides(t *testing.T) {
 		// Expectations for first override
 		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
 		store.EXPECT().DeactivateOverride(gomock.Any(), projKey, flagKey).Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		observer.EXPECT().Handle(gomock.Any())
 
 		// Expectations for second override
 		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
 		store.EXPECT().DeactivateOverride(gomock.Any(), projKey, "flag2").Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
+		observer.EXPECT().Handle(gomock.Any())
+
+		err := model.DeleteOverrides(ctx, projKey)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Keeps locked overrides", func(t *testing.T) {
+		overrides := model.Overrides{
+			{ProjectKey: projKey, FlagKey: flagKey, Locked: true},
+			{ProjectKey: projKey, FlagKey: "flag2"},
+		}
+		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(overrides, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), projKey, "flag2").Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		observer.EXPECT().Handle(gomock.Any())
 
 		err := model.DeleteOverrides(ctx, projKey)
@@ -210,6 +246,28 @@ func TestDeleteOverrides(t *testing.T) {
 	})
 }
 
+func TestSetOverrideLocked(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+
+	t.Run("locks the override", func(t *testing.T) {
+		locked := model.Override{ProjectKey: "proj", FlagKey: "flg", Active: true, Locked: true}
+		store.EXPECT().SetOverrideLocked(gomock.Any(), "proj", "flg", true).Return(locked, nil)
+
+		override, err := model.SetOverrideLocked(ctx, "proj", "flg", true)
+		assert.NoError(t, err)
+		assert.Equal(t, locked, override)
+	})
+
+	t.Run("returns the store's error", func(t *testing.T) {
+		store.EXPECT().SetOverrideLocked(gomock.Any(), "proj", "nope", false).Return(model.Override{}, model.NewErrNotFound("flag", "nope"))
+
+		_, err := model.SetOverrideLocked(ctx, "proj", "nope", false)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
+
 func TestOverrideApply(t *testing.T) {
 	projKey := "proj"
 	flagKey := "flg"
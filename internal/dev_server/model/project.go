@@ -4,11 +4,14 @@ import (
 	"context"
 	"time"
 
+	ldapi "github.com/launchdarkly/api-client-go/v14"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 type Project struct {
@@ -18,13 +21,47 @@ type Project struct {
 	LastSyncTime         time.Time
 	AllFlagsState        FlagsState
 	AvailableVariations  []FlagVariation
+	// Orphaned is set if the project's source was found to be gone from LaunchDarkly during a sync.
+	Orphaned *Orphaned
+	// EnvironmentKeys are the prefetched keys for the environments of the source project, by environment key.
+	EnvironmentKeys map[string]EnvironmentKeys
+	// ArchivedAt is set while the project is archived: it isn't synced or served to SDKs, but can be unarchived.
+	ArchivedAt *time.Time
+	// SyncStatus is the result of the most recent attempt to sync the project, if it's been recorded.
+	SyncStatus *SyncStatus
+	// FlagFilter limits which flags are synced from the source environment and served.
+	FlagFilter FlagFilter
+	// BaseProjectKey is set on linked clones to the project they were cloned from. Clones sync from their base rather
+	// than from LaunchDarkly, and inherit its overrides beneath their own.
+	BaseProjectKey string
+	// LinkedCloneKeys are the keys of the project's linked clones. Stores fill them in from the clones' BaseProjectKey.
+	LinkedCloneKeys []string
+	// FlagMetadata is what LaunchDarkly says about the project's flags, by flag key.
+	FlagMetadata map[string]FlagMetadata
+	// ContextKinds are the context kinds configured for the project in LaunchDarkly, which its context's kinds are
+	// checked against. They're empty if they couldn't be fetched.
+	ContextKinds []ContextKind
 }
 
-// CreateProject creates a project and adds it to the database.
-func CreateProject(ctx context.Context, projectKey, sourceEnvironmentKey string, ldCtx *ldcontext.Context) (Project, error) {
+// ProjectDeletion counts what was removed along with a deleted project.
+type ProjectDeletion struct {
+	Overrides           int
+	ScenarioOverrides   int
+	AvailableVariations int
+	Aliases             int
+	FlagStateHistory    int
+	OverrideHistory     int
+	OverrideSchedules   int
+	SavedContexts       int
+	FlagTriggers        int
+}
+
+// CreateProject creates a project and adds it to the database. Only the flags included by flagFilter are synced.
+func CreateProject(ctx context.Context, projectKey, sourceEnvironmentKey string, ldCtx *ldcontext.Context, flagFilter FlagFilter) (Project, error) {
 	project := Project{
 		Key:                  projectKey,
 		SourceEnvironmentKey: sourceEnvironmentKey,
+		FlagFilter:           flagFilter,
 	}
 
 	if ldCtx == nil {
@@ -32,10 +69,13 @@ func CreateProject(ctx context.Context, projectKey, sourceEnvironmentKey string,
 	} else {
 		project.Context = *ldCtx
 	}
-	err := project.refreshExternalState(ctx)
+	_, err := project.syncWithStatus(ctx)
 	if err != nil {
 		return Project{}, err
 	}
+	if err := project.checkContextKinds(project.Context); err != nil {
+		return Project{}, err
+	}
 	store := StoreFromContext(ctx)
 	err = store.InsertProject(ctx, project)
 	if err != nil {
@@ -45,110 +85,274 @@ func CreateProject(ctx context.Context, projectKey, sourceEnvironmentKey string,
 }
 
 func (project *Project) refreshExternalState(ctx context.Context) error {
+	if project.BaseProjectKey != "" {
+		return project.syncFromBase(ctx)
+	}
 	flagsState, err := project.fetchFlagState(ctx)
 	if err != nil {
 		return err
 	}
-	project.AllFlagsState = flagsState
-	project.LastSyncTime = time.Now()
 
-	availableVariations, err := project.fetchAvailableVariations(ctx)
+	availableVariations, flagMetadata, archivedFlags, err := project.fetchAvailableVariations(ctx)
+	if err != nil {
+		return err
+	}
+	aiConfigVariations, aiConfigMetadata, err := project.aiConfigVariations(ctx)
 	if err != nil {
 		return err
 	}
+	contextKinds, err := project.fetchContextKinds(ctx)
+	if err != nil {
+		// context kinds only check contexts, so without them the project keeps the ones it had rather than failing to sync
+		logs.Printf(logs.Warn, project.Key, "unable to fetch context kinds for project [%s], keeping the ones it had: %s", project.Key, err)
+		contextKinds = project.ContextKinds
+	}
+	if len(aiConfigMetadata) > 0 {
+		// AI Configs' flags are described by the AI Configs rather than by their flags' variations
+		availableVariations = lo.Reject(availableVariations, func(variation FlagVariation, _ int) bool {
+			_, ok := aiConfigMetadata[variation.FlagKey]
+			return ok
+		})
+		availableVariations = append(availableVariations, aiConfigVariations...)
+		for configKey, metadata := range aiConfigMetadata {
+			flagMetadata[configKey] = metadata
+		}
+	}
+	if !project.FlagFilter.IsEmpty() {
+		// the SDK streams the whole environment, so its flag state is narrowed to the flags the API fetch included
+		included := make(map[string]struct{})
+		for _, variation := range availableVariations {
+			included[variation.FlagKey] = struct{}{}
+		}
+		flagsState = flagsState.onlyFlags(included)
+	}
+	for flagKey, state := range archivedFlags {
+		flagsState[flagKey] = state
+	}
+	for configKey := range aiConfigMetadata {
+		if _, ok := flagsState[configKey]; !ok {
+			flagsState[configKey] = FlagState{Value: disabledAIConfigValue(), Version: 1}
+		}
+	}
+	project.AllFlagsState = flagsState
+	project.LastSyncTime = time.Now()
 	project.AvailableVariations = availableVariations
+	project.FlagMetadata = flagMetadata
+	project.ContextKinds = contextKinds
 	return nil
 }
 
-func UpdateProject(ctx context.Context, projectKey string, context *ldcontext.Context, sourceEnvironmentKey *string) (Project, error) {
+// UpdateProject syncs the project from its source environment, first replacing whichever of its context, source
+// environment, and flag filter are given.
+func UpdateProject(ctx context.Context, projectKey string, context *ldcontext.Context, sourceEnvironmentKey *string, flagFilter *FlagFilter) (Project, error) {
 	store := StoreFromContext(ctx)
 	project, err := store.GetDevProject(ctx, projectKey)
 	if err != nil {
 		return Project{}, err
 	}
+	if project.BaseProjectKey != "" && (context != nil || sourceEnvironmentKey != nil || flagFilter != nil) {
+		return Project{}, errors.WithStack(NewErrLinkedClone(projectKey, project.BaseProjectKey))
+	}
 	if context != nil {
 		project.Context = *context
 	}
+	if flagFilter != nil {
+		project.FlagFilter = *flagFilter
+	}
+
+	if project.ArchivedAt != nil {
+		return Project{}, errors.WithStack(NewErrArchived(projectKey, *project.ArchivedAt))
+	}
 
 	if sourceEnvironmentKey != nil {
 		project.SourceEnvironmentKey = *sourceEnvironmentKey
+	} else if project.Orphaned != nil {
+		if context == nil || flagFilter != nil {
+			// the source is known to be gone, so only try again if it's been pointed somewhere new
+			return Project{}, errors.WithStack(ErrOrphaned{projectKey: projectKey, orphaned: *project.Orphaned})
+		}
+		// changing only the context doesn't need the source, so it's saved without syncing for when there's one again
+		return updateOrphanedProjectContext(ctx, *project)
 	}
 
-	err = project.refreshExternalState(ctx)
+	previousFlagsState := project.AllFlagsState
+	status, err := project.syncWithStatus(ctx)
 	if err != nil {
+		recordSyncStatus(ctx, projectKey, status)
+		orphanIfSourceNotFound(ctx, projectKey, err)
 		return Project{}, err
 	}
+	project.Orphaned = nil
+	if context != nil {
+		if err := project.checkContextKinds(project.Context); err != nil {
+			return Project{}, err
+		}
+	}
 
-	updated, err := store.UpdateProject(ctx, *project)
+	updated, err := writeProject(ctx, *project)
 	if err != nil {
 		return Project{}, err
 	}
 	if !updated {
 		return Project{}, errors.New("Project not updated")
 	}
+	recordSyncStatus(ctx, projectKey, status)
 
-	allFlagsWithOverrides, err := project.GetFlagStateWithOverridesForProject(ctx)
+	overrides, err := getLayeredOverrides(ctx, *project)
 	if err != nil {
 		return Project{}, errors.Wrapf(err, "unable to get overrides for project, %s", projectKey)
 	}
 
-	GetObserversFromContext(ctx).Notify(SyncEvent{
-		ProjectKey:    project.Key,
-		AllFlagsState: allFlagsWithOverrides,
-	})
+	previousWithOverrides, _ := overrides.ApplyAll(previousFlagsState)
+	currentWithOverrides, _ := overrides.ApplyAll(project.AllFlagsState)
+	notifyFlagsStateChanges(ctx, project.Key, previousWithOverrides, currentWithOverrides)
+	syncLinkedClones(ctx, *project)
 	return *project, nil
 }
 
-func (project Project) GetFlagStateWithOverridesForProject(ctx context.Context) (FlagsState, error) {
-	store := StoreFromContext(ctx)
-	overrides, err := store.GetOverridesForProject(ctx, project.Key)
+func updateOrphanedProjectContext(ctx context.Context, project Project) (Project, error) {
+	if err := project.checkContextKinds(project.Context); err != nil {
+		return Project{}, err
+	}
+	updated, err := writeProject(ctx, project)
 	if err != nil {
-		return FlagsState{}, errors.Wrapf(err, "unable to fetch overrides for project %s", project.Key)
+		return Project{}, err
+	}
+	if !updated {
+		return Project{}, errors.New("Project not updated")
 	}
-	withOverrides := make(FlagsState, len(project.AllFlagsState))
-	for flagKey, flagState := range project.AllFlagsState {
-		if override, ok := overrides.GetFlag(flagKey); ok {
-			flagState = override.Apply(flagState)
+	return project, nil
+}
+
+// DeleteProject deletes the project along with its overrides and history. Projects with linked clones can't be
+// deleted, since the clones would be left without a base to sync from.
+func DeleteProject(ctx context.Context, projectKey string) (ProjectDeletion, error) {
+	var deletion ProjectDeletion
+	err := withTx(ctx, func(ctx context.Context) error {
+		store := StoreFromContext(ctx)
+		project, err := store.GetDevProject(ctx, projectKey)
+		if err != nil {
+			return err
+		}
+		if err := project.checkNoLinkedClones(); err != nil {
+			return err
+		}
+		var deleted bool
+		deletion, deleted, err = store.DeleteDevProject(ctx, projectKey)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return errors.WithStack(NewErrNotFound("project", projectKey))
 		}
-		withOverrides[flagKey] = flagState
+		return nil
+	})
+	if err != nil {
+		return ProjectDeletion{}, err
 	}
-	return withOverrides, nil
+	randomValues.resetProject(projectKey)
+	return deletion, nil
+}
+
+func (project Project) GetFlagStateWithOverridesForProject(ctx context.Context) (FlagsState, error) {
+	withOverrides, _, err := project.GetFlagStateWithLayersForProject(ctx)
+	return withOverrides, err
 }
 
-func (project Project) fetchAvailableVariations(ctx context.Context) ([]FlagVariation, error) {
+// fetchAvailableVariations returns the variations of the flags the project includes, along with their metadata and
+// the state of the archived ones, which LaunchDarkly doesn't evaluate.
+func (project Project) fetchAvailableVariations(ctx context.Context) ([]FlagVariation, map[string]FlagMetadata, FlagsState, error) {
 	apiAdapter := adapters.GetApi(ctx)
-	flags, err := apiAdapter.GetAllFlags(ctx, project.Key)
+	flags, err := apiAdapter.GetAllFlags(ctx, project.Key, project.FlagFilter.apiFilters())
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	var allVariations []FlagVariation
+	flagMetadata := make(map[string]FlagMetadata)
+	archivedFlags := make(FlagsState)
 	for _, flag := range flags {
+		if !project.FlagFilter.Includes(flag.Key, flag.Tags) {
+			continue
+		}
 		flagKey := flag.Key
-		for _, variation := range flag.Variations {
+		flagMetadata[flagKey] = flagMetadataOf(flag)
+		if flag.Archived {
+			archivedFlags[flagKey] = archivedFlagState(flag, project.AllFlagsState)
+		}
+		synthesized := false
+		for i, variation := range flag.Variations {
+			var id string
+			if variation.Id != nil {
+				id = *variation.Id
+			} else {
+				id = synthesizeVariationId(i)
+				synthesized = true
+			}
+			name := variation.Name
+			if stage, ok := variation.Value.(string); ok && name == nil && len(flagMetadata[flagKey].MigrationStages) > 0 {
+				// migration flags' variations are their stages, so name them after the stage if LaunchDarkly didn't
+				name = &stage
+			}
 			allVariations = append(allVariations, FlagVariation{
 				FlagKey: flagKey,
 				Variation: Variation{
-					Id:          *variation.Id,
+					Id:          id,
 					Description: variation.Description,
-					Name:        variation.Name,
+					Name:        name,
 					Value:       ldvalue.CopyArbitraryValue(variation.Value),
 				},
 			})
 		}
+		if synthesized {
+			logs.Printf(logs.Warn, project.Key, "WARNING: flag [%s] in project [%s] has variations without IDs; using synthesized IDs", flagKey, project.Key)
+		}
+	}
+	return allVariations, flagMetadata, archivedFlags, nil
+}
+
+// archivedFlagState is the state of an archived flag: its value from before it was archived, if the project had it,
+// or else its off variation.
+func archivedFlagState(flag ldapi.FeatureFlag, previous FlagsState) FlagState {
+	state, ok := previous[flag.Key]
+	if !ok {
+		state = FlagState{Value: ldvalue.Null(), Version: int(flag.Version)}
+		if flag.Defaults != nil && int(flag.Defaults.OffVariation) < len(flag.Variations) {
+			state.Value = ldvalue.CopyArbitraryValue(flag.Variations[flag.Defaults.OffVariation].Value)
+		}
 	}
-	return allVariations, nil
+	state.Archived = true
+	state.Reason = nil
+	return state
 }
 
 func (project Project) fetchFlagState(ctx context.Context) (FlagsState, error) {
-	apiAdapter := adapters.GetApi(ctx)
-	sdkKey, err := apiAdapter.GetSdkKey(ctx, project.Key, project.SourceEnvironmentKey)
+	sdkKey, cached, err := project.sdkKey(ctx)
 	flagsState := make(FlagsState)
 	if err != nil {
 		return flagsState, err
 	}
 
+	evalContext, err := EnrichContext(ctx, project.Context)
+	if err != nil {
+		return flagsState, err
+	}
+
+	ctx = project.withBigSegmentMembership(ctx)
 	sdkAdapter := adapters.GetSdk(ctx)
-	sdkFlags, err := sdkAdapter.GetAllFlagsState(ctx, project.Context, sdkKey)
+	sdkFlags, err := sdkAdapter.GetAllFlagsState(ctx, evalContext, sdkKey)
+	if err != nil && cached {
+		// the prefetched key may have been rotated, or the environment deleted, so check with LaunchDarkly
+		freshKey, keyErr := adapters.GetApi(ctx).GetSdkKey(ctx, project.Key, project.SourceEnvironmentKey)
+		if keyErr != nil {
+			return flagsState, keyErr
+		}
+		if freshKey != sdkKey {
+			sdkFlags, err = sdkAdapter.GetAllFlagsState(ctx, evalContext, freshKey)
+			if err == nil {
+				project.replaceCachedSdkKey(ctx, freshKey)
+			}
+		}
+	}
 	if err != nil {
 		return flagsState, err
 	}
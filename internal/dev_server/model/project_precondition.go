@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const ctxKeyProjectPrecondition = ctxKey("model.ProjectPrecondition")
+
+// ErrProjectChanged is returned when an update's precondition doesn't hold, because someone else changed the project
+// since the update read it.
+type ErrProjectChanged struct {
+	projectKey string
+}
+
+func (e ErrProjectChanged) Error() string {
+	return fmt.Sprintf("project %s changed since it was read", e.projectKey)
+}
+
+// ProjectPrecondition reports whether the project is still as it was when an update to it was worked out.
+type ProjectPrecondition func(current Project) bool
+
+// ContextWithProjectPrecondition has UpdateProject check precondition against the stored project in the same
+// transaction as it writes the project, and fail with ErrProjectChanged if it doesn't hold.
+func ContextWithProjectPrecondition(ctx context.Context, precondition ProjectPrecondition) context.Context {
+	return context.WithValue(ctx, ctxKeyProjectPrecondition, precondition)
+}
+
+// writeProject updates the stored project, checking the precondition on ctx first if there is one.
+func writeProject(ctx context.Context, project Project) (bool, error) {
+	precondition, ok := ctx.Value(ctxKeyProjectPrecondition).(ProjectPrecondition)
+	if !ok || precondition == nil {
+		return StoreFromContext(ctx).UpdateProject(ctx, project)
+	}
+	var updated bool
+	err := withTx(ctx, func(ctx context.Context) error {
+		store := StoreFromContext(ctx)
+		current, err := store.GetDevProject(ctx, project.Key)
+		if err != nil {
+			return err
+		}
+		if !precondition(*current) {
+			return errors.WithStack(ErrProjectChanged{projectKey: project.Key})
+		}
+		updated, err = store.UpdateProject(ctx, project)
+		return err
+	})
+	return updated, err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ import (
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
+	"github.com/launchdarkly/ldcli/internal/dev_server/adapters"
 	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
@@ -23,6 +25,8 @@ func TestCreateProject(t *testing.T) {
 	ctx := context.Background()
 	mockController := gomock.NewController(t)
 	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	store := mocks.NewMockStore(mockController)
 	ctx = model.ContextWithStore(ctx, store)
 	projKey := "proj"
@@ -52,7 +56,7 @@ func TestCreateProject(t *testing.T) {
 
 	t.Run("Returns error if it cant fetch flag state", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return("", errors.New("fetch flag state fails"))
-		_, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil)
+		_, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
 		assert.NotNil(t, err)
 		assert.Equal(t, "fetch flag state fails", err.Error())
 	})
@@ -60,8 +64,8 @@ func TestCreateProject(t *testing.T) {
 	t.Run("Returns error if it can't fetch flags", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(nil, errors.New("fetch flags failed"))
-		_, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(nil, errors.New("fetch flags failed"))
+		_, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
 		assert.NotNil(t, err)
 		assert.Equal(t, "fetch flags failed", err.Error())
 	})
@@ -69,10 +73,10 @@ func TestCreateProject(t *testing.T) {
 	t.Run("Returns error if it fails to insert the project", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(errors.New("insert fails"))
 
-		_, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil)
+		_, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
 		assert.NotNil(t, err)
 		assert.Equal(t, "insert fails", err.Error())
 	})
@@ -80,10 +84,10 @@ func TestCreateProject(t *testing.T) {
 	t.Run("Successfully creates project", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
 
-		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil)
+		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
 		assert.Nil(t, err)
 
 		expectedProj := model.Project{
@@ -97,8 +101,163 @@ func TestCreateProject(t *testing.T) {
 		assert.Equal(t, expectedProj.SourceEnvironmentKey, p.SourceEnvironmentKey)
 		assert.Equal(t, expectedProj.Context, p.Context)
 		assert.Equal(t, expectedProj.AllFlagsState, p.AllFlagsState)
+		require.NotNil(t, p.SyncStatus)
+		assert.True(t, p.SyncStatus.Succeeded())
 		//TODO add assertion on AvailableVariations
 	})
+
+	t.Run("Evaluates flags for the enriched context", func(t *testing.T) {
+		enriched := ldcontext.NewBuilder("dev-environment").SetString("tier", "gold").Build()
+		enrichedCtx := model.ContextWithContextEnricher(ctx, testContextEnricher{enriched})
+		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), enriched, sdkKey).Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		p, err := model.CreateProject(enrichedCtx, projKey, sourceEnvKey, nil, model.FlagFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, ldcontext.NewBuilder("user").Key("dev-environment").Build(), p.Context, "the project keeps the context it was configured with")
+	})
+
+	t.Run("Synthesizes IDs for variations without them", func(t *testing.T) {
+		flagsWithoutIds := []ldapi.FeatureFlag{{
+			Key: "boolFlag",
+			Variations: []ldapi.Variation{
+				{Value: true},
+				{Value: false},
+			},
+		}}
+		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(flagsWithoutIds, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
+		require.NoError(t, err)
+
+		require.Len(t, p.AvailableVariations, 2)
+		assert.True(t, p.AvailableVariations[0].HasSynthesizedId())
+		assert.True(t, p.AvailableVariations[1].HasSynthesizedId())
+		assert.NotEqual(t, p.AvailableVariations[0].Id, p.AvailableVariations[1].Id)
+		assert.Equal(t, ldvalue.Bool(true), p.AvailableVariations[0].Value)
+	})
+
+	t.Run("Only syncs flags included by the flag filter", func(t *testing.T) {
+		taggedFlags := []ldapi.FeatureFlag{
+			{Key: "checkout-button", Variations: []ldapi.Variation{{Id: &trueVariationId, Value: true}}},
+			{Key: "search-ranking", Tags: []string{"search"}, Variations: []ldapi.Variation{{Id: &trueVariationId, Value: true}}},
+			{Key: "unrelated", Variations: []ldapi.Variation{{Id: &trueVariationId, Value: true}}},
+		}
+		taggedFlagsState := flagstate.NewAllFlagsBuilder().
+			AddFlag("checkout-button", flagstate.FlagState{Value: ldvalue.Bool(true)}).
+			AddFlag("search-ranking", flagstate.FlagState{Value: ldvalue.Bool(true)}).
+			AddFlag("unrelated", flagstate.FlagState{Value: ldvalue.Bool(true)}).
+			Build()
+		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(taggedFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, []string{"tags:search", "query:checkout-"}).Return(taggedFlags, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		filter := model.FlagFilter{KeyPrefixes: []string{"checkout-"}, Tags: []string{"search"}}
+		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, filter)
+		require.NoError(t, err)
+
+		assert.Equal(t, filter, p.FlagFilter)
+		assert.ElementsMatch(t, []string{"checkout-button", "search-ranking"}, lo.Keys(p.AllFlagsState))
+		require.Len(t, p.AvailableVariations, 2)
+		assert.ElementsMatch(t, []string{"checkout-button", "search-ranking"}, lo.Keys(p.FlagMetadata))
+	})
+
+	t.Run("Syncs flags' metadata", func(t *testing.T) {
+		teamName := "Payments"
+		flags := []ldapi.FeatureFlag{
+			{
+				Key:         "boolFlag",
+				Name:        "Bool flag",
+				Description: lo.ToPtr("Turns on the new thing"),
+				Tags:        []string{"frontend"},
+				Maintainer:  &ldapi.MemberSummary{Email: "dev@example.com"},
+				Variations:  []ldapi.Variation{{Id: &trueVariationId, Value: true}},
+			},
+			{
+				Key:            "teamFlag",
+				Name:           "Team flag",
+				Tags:           []string{},
+				MaintainerTeam: &ldapi.MaintainerTeam{Name: &teamName},
+				Variations:     []ldapi.Variation{{Id: &trueVariationId, Value: true}},
+			},
+		}
+		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(flags, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]model.FlagMetadata{
+			"boolFlag": {Name: "Bool flag", Description: "Turns on the new thing", Tags: []string{"frontend"}, Maintainer: "dev@example.com"},
+			"teamFlag": {Name: "Team flag", Maintainer: "Payments"},
+		}, p.FlagMetadata)
+	})
+
+	t.Run("Recognizes migration flags and names their variations after their stages", func(t *testing.T) {
+		offId, shadowId, liveId := "off", "shadow", "live"
+		flags := []ldapi.FeatureFlag{{
+			Key:     "migrationFlag",
+			Purpose: lo.ToPtr("migration"),
+			Variations: []ldapi.Variation{
+				{Id: &offId, Value: "off"},
+				{Id: &liveId, Value: "live", Name: lo.ToPtr("Live!")},
+				{Id: &shadowId, Value: "shadow"},
+			},
+		}}
+		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(flags, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"off", "shadow", "live"}, p.FlagMetadata["migrationFlag"].MigrationStages)
+		names := lo.Map(p.AvailableVariations, func(variation model.FlagVariation, _ int) string {
+			return lo.FromPtr(variation.Name)
+		})
+		assert.Equal(t, []string{"off", "Live!", "shadow"}, names)
+	})
+
+	t.Run("Keeps archived flags at their off variation", func(t *testing.T) {
+		flags := append(allFlags, ldapi.FeatureFlag{
+			Key:      "archivedFlag",
+			Archived: true,
+			Version:  3,
+			Defaults: &ldapi.Defaults{OnVariation: 0, OffVariation: 1},
+			Variations: []ldapi.Variation{
+				{Id: &trueVariationId, Value: true},
+				{Id: &falseVariationId, Value: false},
+			},
+		})
+		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(flags, nil)
+		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
+
+		p, err := model.CreateProject(ctx, projKey, sourceEnvKey, nil, model.FlagFilter{})
+		require.NoError(t, err)
+
+		assert.Equal(t, model.FlagState{Value: ldvalue.Bool(false), Version: 3, Archived: true}, p.AllFlagsState["archivedFlag"])
+		assert.False(t, p.AllFlagsState["boolFlag"].Archived)
+		assert.ElementsMatch(t, []string{"boolFlag"}, lo.Keys(p.AllFlagsState.WithoutArchived()))
+	})
+}
+
+type testContextEnricher struct {
+	enriched ldcontext.Context
+}
+
+func (e testContextEnricher) Enrich(context.Context, ldcontext.Context) (ldcontext.Context, error) {
+	return e.enriched, nil
 }
 
 func TestUpdateProject(t *testing.T) {
@@ -106,6 +265,8 @@ func TestUpdateProject(t *testing.T) {
 	store := mocks.NewMockStore(mockController)
 	ctx := model.ContextWithStore(context.Background(), store)
 	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	observer := mocks.NewMockObserver(mockController)
 	observers := model.NewObservers()
@@ -139,7 +300,7 @@ func TestUpdateProject(t *testing.T) {
 
 	t.Run("Returns error if GetDevProject fails", func(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&model.Project{}, errors.New("GetDevProject fails"))
-		_, err := model.UpdateProject(ctx, proj.Key, nil, nil)
+		_, err := model.UpdateProject(ctx, proj.Key, nil, nil, nil)
 		assert.NotNil(t, err)
 		assert.Equal(t, "GetDevProject fails", err.Error())
 	})
@@ -147,8 +308,14 @@ func TestUpdateProject(t *testing.T) {
 	t.Run("returns error if the fetch flag state fails", func(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&proj, nil)
 		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, proj.SourceEnvironmentKey).Return("", errors.New("FetchFlagState fails"))
+		store.EXPECT().SetSyncStatus(gomock.Any(), proj.Key, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, status model.SyncStatus) (bool, error) {
+				assert.False(t, status.Succeeded())
+				assert.Equal(t, "FetchFlagState fails", status.Error)
+				return true, nil
+			})
 
-		_, err := model.UpdateProject(ctx, proj.Key, &ldCtx, nil)
+		_, err := model.UpdateProject(ctx, proj.Key, &ldCtx, nil, nil)
 		assert.NotNil(t, err)
 		assert.Equal(t, "FetchFlagState fails", err.Error())
 	})
@@ -157,10 +324,10 @@ func TestUpdateProject(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&proj, nil)
 		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, newSrcEnv).Return("sdkKey", nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdkKey").Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(false, errors.New("UpdateProject fails"))
 
-		_, err := model.UpdateProject(ctx, proj.Key, nil, &newSrcEnv)
+		_, err := model.UpdateProject(ctx, proj.Key, nil, &newSrcEnv, nil)
 		assert.NotNil(t, err)
 		assert.Equal(t, "UpdateProject fails", err.Error())
 	})
@@ -169,31 +336,170 @@ func TestUpdateProject(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&proj, nil)
 		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, proj.SourceEnvironmentKey).Return("sdkKey", nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdkKey").Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		_, err := model.UpdateProject(ctx, proj.Key, nil, nil)
+		_, err := model.UpdateProject(ctx, proj.Key, nil, nil, nil)
 		assert.NotNil(t, err)
 		assert.Equal(t, "Project not updated", err.Error())
 	})
 
 	t.Run("Return successfully", func(t *testing.T) {
+		// earlier subtests refresh proj in place, so start from an unsynced project to see the flag added
+		proj.AllFlagsState = nil
 		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&proj, nil)
 		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, proj.SourceEnvironmentKey).Return("sdkKey", nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdkKey").Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().SetSyncStatus(gomock.Any(), proj.Key, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, status model.SyncStatus) (bool, error) {
+				assert.True(t, status.Succeeded())
+				assert.False(t, status.AttemptedAt.IsZero())
+				return true, nil
+			})
 		store.EXPECT().GetOverridesForProject(gomock.Any(), proj.Key).Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), proj.Key).Return(nil, nil)
 		observer.
 			EXPECT().
-			Handle(model.SyncEvent{
-				ProjectKey:    proj.Key,
-				AllFlagsState: model.FromAllFlags(allFlagsState),
+			Handle(model.OverrideEvent{
+				ProjectKey: proj.Key,
+				FlagKey:    "stringFlag",
+				FlagState:  model.FromAllFlags(allFlagsState)["stringFlag"],
 			})
 
-		project, err := model.UpdateProject(ctx, proj.Key, nil, nil)
+		project, err := model.UpdateProject(ctx, proj.Key, nil, nil, nil)
 		require.Nil(t, err)
 		assert.Equal(t, proj, project)
+		require.NotNil(t, project.SyncStatus)
+		assert.True(t, project.SyncStatus.Succeeded())
+	})
+
+	t.Run("Fails without writing if the precondition no longer holds", func(t *testing.T) {
+		expectTransactions(store)
+		readSourceEnvironmentKey := proj.SourceEnvironmentKey
+		changed := proj
+		changed.SourceEnvironmentKey = "changedConcurrently"
+		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&proj, nil)
+		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, newSrcEnv).Return("sdkKey", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdkKey").Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key, gomock.Any()).Return(allFlags, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&changed, nil)
+
+		preconditionCtx := model.ContextWithProjectPrecondition(ctx, func(current model.Project) bool {
+			return current.SourceEnvironmentKey == readSourceEnvironmentKey
+		})
+		_, err := model.UpdateProject(preconditionCtx, proj.Key, nil, &newSrcEnv, nil)
+		assert.ErrorAs(t, err, &model.ErrProjectChanged{})
+	})
+
+	t.Run("Marks the project orphaned if its source is gone", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&model.Project{Key: proj.Key, SourceEnvironmentKey: "srcEnvKey"}, nil)
+		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, "srcEnvKey").
+			Return("", adapters.NewErrSourceNotFound("project or environment", proj.Key+"/srcEnvKey"))
+		store.EXPECT().SetSyncStatus(gomock.Any(), proj.Key, gomock.Any()).Return(true, nil)
+		store.EXPECT().OrphanProject(gomock.Any(), proj.Key, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, orphaned model.Orphaned) (bool, error) {
+				assert.Equal(t, "project or environment projKey/srcEnvKey not found in LaunchDarkly", orphaned.Detail)
+				return true, nil
+			})
+
+		_, err := model.UpdateProject(ctx, proj.Key, nil, nil, nil)
+		assert.ErrorAs(t, err, &adapters.ErrSourceNotFound{})
+	})
+
+	t.Run("Doesn't sync orphaned projects unless the source environment is set", func(t *testing.T) {
+		orphaned := model.Project{
+			Key:                  proj.Key,
+			SourceEnvironmentKey: "srcEnvKey",
+			Orphaned:             &model.Orphaned{Detail: "gone", Since: time.Now()},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&orphaned, nil)
+
+		_, err := model.UpdateProject(ctx, proj.Key, nil, nil, nil)
+		assert.ErrorAs(t, err, &model.ErrOrphaned{})
+	})
+
+	t.Run("Saves just the context of orphaned projects without syncing", func(t *testing.T) {
+		orphaned := model.Project{
+			Key:                  proj.Key,
+			SourceEnvironmentKey: "srcEnvKey",
+			Orphaned:             &model.Orphaned{Detail: "gone", Since: time.Now()},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&orphaned, nil)
+		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, project model.Project) (bool, error) {
+				assert.Equal(t, ldCtx, project.Context)
+				assert.NotNil(t, project.Orphaned)
+				return true, nil
+			})
+
+		project, err := model.UpdateProject(ctx, proj.Key, &ldCtx, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, ldCtx, project.Context)
+	})
+
+	t.Run("Clears the orphaned mark after syncing from a new source environment", func(t *testing.T) {
+		orphaned := model.Project{
+			Key:                  proj.Key,
+			SourceEnvironmentKey: "srcEnvKey",
+			Context:              ldCtx,
+			Orphaned:             &model.Orphaned{Detail: "gone", Since: time.Now()},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), proj.Key).Return(&orphaned, nil)
+		api.EXPECT().GetSdkKey(gomock.Any(), proj.Key, newSrcEnv).Return("sdkKey", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdkKey").Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), proj.Key, gomock.Any()).Return(allFlags, nil)
+		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, project model.Project) (bool, error) {
+				assert.Nil(t, project.Orphaned)
+				return true, nil
+			})
+		store.EXPECT().SetSyncStatus(gomock.Any(), proj.Key, gomock.Any()).Return(true, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), proj.Key).Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), proj.Key).Return(nil, nil)
+		observer.
+			EXPECT().
+			Handle(model.OverrideEvent{
+				ProjectKey: proj.Key,
+				FlagKey:    "stringFlag",
+				FlagState:  model.FromAllFlags(allFlagsState)["stringFlag"],
+			})
+
+		project, err := model.UpdateProject(ctx, proj.Key, nil, &newSrcEnv, nil)
+		require.NoError(t, err)
+		assert.Nil(t, project.Orphaned)
+		assert.Equal(t, newSrcEnv, project.SourceEnvironmentKey)
+	})
+
+	t.Run("Notifies observers only of flags that changed or were deleted", func(t *testing.T) {
+		previous := model.Project{
+			Key:                  "projKey",
+			SourceEnvironmentKey: "srcEnvKey",
+			Context:              ldcontext.New(t.Name()),
+			AllFlagsState: model.FlagsState{
+				"stringFlag":  model.FromAllFlags(allFlagsState)["stringFlag"],
+				"deletedFlag": model.FlagState{Value: ldvalue.Bool(true), Version: 4},
+			},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), previous.Key).Return(&previous, nil)
+		api.EXPECT().GetSdkKey(gomock.Any(), previous.Key, previous.SourceEnvironmentKey).Return("sdkKey", nil)
+		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdkKey").Return(allFlagsState, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), previous.Key, gomock.Any()).Return(allFlags, nil)
+		store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(true, nil)
+		store.EXPECT().SetSyncStatus(gomock.Any(), previous.Key, gomock.Any()).Return(true, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), previous.Key).Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), previous.Key).Return(nil, nil)
+		observer.
+			EXPECT().
+			Handle(model.FlagDeletedEvent{
+				ProjectKey: previous.Key,
+				FlagKey:    "deletedFlag",
+				Version:    5,
+			})
+
+		_, err := model.UpdateProject(ctx, previous.Key, nil, nil, nil)
+		require.NoError(t, err)
 	})
 }
 
@@ -227,6 +533,7 @@ func TestGetFlagStateWithOverridesForProject(t *testing.T) {
 		}
 
 		store.EXPECT().GetOverridesForProject(gomock.Any(), proj.Key).Return(overrides, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), proj.Key).Return(nil, nil)
 
 		withOverrides, err := proj.GetFlagStateWithOverridesForProject(ctx)
 		assert.Nil(t, err)
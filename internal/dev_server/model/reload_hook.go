@@ -0,0 +1,121 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// reloadHookQuietPeriod is how long the reload hook waits for further changes before calling out, so that a sync or
+// scenario that changes many flags reloads the frontend once.
+const reloadHookQuietPeriod = 100 * time.Millisecond
+
+const reloadHookTimeout = 10 * time.Second
+
+// ReloadHookPayload is POSTed to the reload hook URL.
+type ReloadHookPayload struct {
+	ProjectKey string   `json:"projectKey"`
+	FlagKeys   []string `json:"flagKeys"`
+}
+
+// ReloadHook is an Observer that POSTs to a local URL, e.g. an endpoint a Vite or webpack dev server plugin exposes to
+// trigger a full reload, whenever flags change. It's for frontend apps that read flags at build or start time and so
+// don't pick up changes from a streaming SDK.
+type ReloadHook struct {
+	url      string
+	flagKeys map[string]struct{}
+	client   *http.Client
+	wait     time.Duration
+
+	mu      sync.Mutex
+	changed map[string]map[string]struct{}
+	timer   *time.Timer
+}
+
+// NewReloadHook returns a hook that calls url when any of flagKeys change, or when any flag changes if flagKeys is
+// empty.
+func NewReloadHook(url string, flagKeys []string) *ReloadHook {
+	hook := &ReloadHook{
+		url:     url,
+		client:  &http.Client{Timeout: reloadHookTimeout},
+		wait:    reloadHookQuietPeriod,
+		changed: make(map[string]map[string]struct{}),
+	}
+	if len(flagKeys) > 0 {
+		hook.flagKeys = make(map[string]struct{}, len(flagKeys))
+		for _, flagKey := range flagKeys {
+			hook.flagKeys[flagKey] = struct{}{}
+		}
+	}
+	return hook
+}
+
+func (h *ReloadHook) Handle(event interface{}) {
+	switch event := event.(type) {
+	case OverrideEvent:
+		h.flagChanged(event.ProjectKey, event.FlagKey)
+	case FlagDeletedEvent:
+		h.flagChanged(event.ProjectKey, event.FlagKey)
+	case SyncEvent:
+		for flagKey := range event.AllFlagsState {
+			h.flagChanged(event.ProjectKey, flagKey)
+		}
+	}
+}
+
+func (h *ReloadHook) flagChanged(projectKey, flagKey string) {
+	if h.flagKeys != nil {
+		if _, ok := h.flagKeys[flagKey]; !ok {
+			return
+		}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.changed[projectKey] == nil {
+		h.changed[projectKey] = make(map[string]struct{})
+	}
+	h.changed[projectKey][flagKey] = struct{}{}
+	if h.timer != nil {
+		h.timer.Stop()
+	}
+	h.timer = time.AfterFunc(h.wait, h.fire)
+}
+
+func (h *ReloadHook) fire() {
+	h.mu.Lock()
+	changed := h.changed
+	h.changed = make(map[string]map[string]struct{})
+	h.timer = nil
+	h.mu.Unlock()
+
+	for projectKey, flagKeys := range changed {
+		payload := ReloadHookPayload{ProjectKey: projectKey, FlagKeys: make([]string, 0, len(flagKeys))}
+		for flagKey := range flagKeys {
+			payload.FlagKeys = append(payload.FlagKeys, flagKey)
+		}
+		sort.Strings(payload.FlagKeys)
+		h.call(payload)
+	}
+}
+
+func (h *ReloadHook) call(payload ReloadHookPayload) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logs.Printf(logs.Error, "", "reload hook: unable to marshal payload: %s", err)
+		return
+	}
+	resp, err := h.client.Post(h.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logs.Printf(logs.Warn, "", "reload hook: request to %s failed: %s", h.url, err)
+		return
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		logs.Printf(logs.Warn, "", "reload hook: %s returned %s", h.url, resp.Status)
+	}
+}
@@ -0,0 +1,69 @@
+package model_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestReloadHook(t *testing.T) {
+	startServer := func(t *testing.T) (string, func() []model.ReloadHookPayload) {
+		var mu sync.Mutex
+		var payloads []model.ReloadHookPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var payload model.ReloadHookPayload
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			mu.Lock()
+			defer mu.Unlock()
+			payloads = append(payloads, payload)
+		}))
+		t.Cleanup(server.Close)
+		return server.URL, func() []model.ReloadHookPayload {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]model.ReloadHookPayload{}, payloads...)
+		}
+	}
+
+	t.Run("calls the hook once for a burst of changes", func(t *testing.T) {
+		url, received := startServer(t)
+		hook := model.NewReloadHook(url, nil)
+
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "flag-b", FlagState: model.FlagState{Value: ldvalue.Bool(true)}})
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "flag-a", FlagState: model.FlagState{Value: ldvalue.Bool(true)}})
+		hook.Handle(model.FlagDeletedEvent{ProjectKey: "proj", FlagKey: "flag-c"})
+
+		require.Eventually(t, func() bool { return len(received()) > 0 }, 2*time.Second, 10*time.Millisecond)
+		time.Sleep(200 * time.Millisecond)
+		assert.Equal(t, []model.ReloadHookPayload{
+			{ProjectKey: "proj", FlagKeys: []string{"flag-a", "flag-b", "flag-c"}},
+		}, received())
+	})
+
+	t.Run("only calls the hook for the configured flags", func(t *testing.T) {
+		url, received := startServer(t)
+		hook := model.NewReloadHook(url, []string{"build-time-flag"})
+
+		hook.Handle(model.OverrideEvent{ProjectKey: "proj", FlagKey: "runtime-flag"})
+		time.Sleep(200 * time.Millisecond)
+		assert.Empty(t, received())
+
+		hook.Handle(model.SyncEvent{ProjectKey: "proj", AllFlagsState: model.FlagsState{
+			"build-time-flag": model.FlagState{Value: ldvalue.Bool(true)},
+			"runtime-flag":    model.FlagState{Value: ldvalue.Bool(true)},
+		}})
+		require.Eventually(t, func() bool { return len(received()) > 0 }, 2*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []model.ReloadHookPayload{
+			{ProjectKey: "proj", FlagKeys: []string{"build-time-flag"}},
+		}, received())
+	})
+}
@@ -7,7 +7,11 @@ import (
 
 func RestoreDb(ctx context.Context, stream io.Reader) error {
 	store := StoreFromContext(ctx)
-	_, err := store.RestoreBackup(ctx, stream)
+	previousFlagsStates, err := projectFlagsStates(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = store.RestoreBackup(ctx, stream)
 	if err != nil {
 		return err
 	}
@@ -29,10 +33,32 @@ func RestoreDb(ctx context.Context, stream io.Reader) error {
 			return err
 		}
 		observers.Notify(SyncEvent{
-			ProjectKey:    project.Key,
-			AllFlagsState: allFlagsWithOverrides,
+			ProjectKey:         project.Key,
+			AllFlagsState:      allFlagsWithOverrides,
+			PreviousFlagsState: previousFlagsStates[project.Key],
 		})
 	}
 
 	return nil
 }
+
+// projectFlagsStates is the effective flag state of every project, keyed by project key.
+func projectFlagsStates(ctx context.Context) (map[string]FlagsState, error) {
+	store := StoreFromContext(ctx)
+	projectKeys, err := store.GetDevProjectKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flagsStates := make(map[string]FlagsState, len(projectKeys))
+	for _, projectKey := range projectKeys {
+		project, err := store.GetDevProject(ctx, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		flagsStates[projectKey], err = project.GetFlagStateWithOverridesForProject(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return flagsStates, nil
+}
@@ -41,6 +41,7 @@ func TestRestoreDb(t *testing.T) {
 	}
 
 	t.Run("Returns error if restore fails", func(t *testing.T) {
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return(nil, nil)
 		store.EXPECT().RestoreBackup(gomock.Any(), gomock.Any()).Return("", errors.New("restore failed"))
 
 		err := model.RestoreDb(ctx, strings.NewReader(""))
@@ -48,12 +49,27 @@ func TestRestoreDb(t *testing.T) {
 	})
 
 	t.Run("Notifies Projects if restore completes", func(t *testing.T) {
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{projKey}, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(&proj, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(model.Overrides{{
+			ProjectKey: projKey,
+			FlagKey:    "boolFlag",
+			Value:      ldvalue.Bool(true),
+			Active:     true,
+			Version:    1,
+		}}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		store.EXPECT().RestoreBackup(gomock.Any(), gomock.Any()).Return("restore.db", nil)
 		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{projKey}, nil)
 		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(&proj, nil)
 		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(model.Overrides{}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		observer := mocks.NewMockObserver(mockController)
-		observer.EXPECT().Handle(model.SyncEvent{ProjectKey: projKey, AllFlagsState: proj.AllFlagsState})
+		observer.EXPECT().Handle(model.SyncEvent{
+			ProjectKey:         projKey,
+			AllFlagsState:      proj.AllFlagsState,
+			PreviousFlagsState: model.FlagsState{"boolFlag": {Version: 1, Value: ldvalue.Bool(true), TrackEvents: true}},
+		})
 
 		observers.RegisterObserver(observer)
 
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"crypto/sha1" //nolint:gosec // used for bucketing like LaunchDarkly's SDKs, not for security
+	"encoding/hex"
+	"strconv"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldattr"
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+)
+
+// RolloutTotalWeight is what the weights of a rollout's variations add up to, as in LaunchDarkly rollouts, so each unit
+// is a thousandth of a percent.
+const RolloutTotalWeight = 100000
+
+// RolloutSalt is mixed into the bucketing hash. Server-side SDKs are sent it with the flag so that they bucket
+// contexts the same way the dev server does for client-side SDKs.
+const RolloutSalt = "ldcli-dev-server"
+
+// Rollout splits a flag's override between values by percentage. Contexts are bucketed by a hash of the flag key and
+// an attribute, the same way LaunchDarkly percentage rollouts do, so a context keeps getting the same value.
+type Rollout struct {
+	Variations []WeightedValue `json:"variations"`
+	// ContextKind is the kind of context that's bucketed. It defaults to user.
+	ContextKind string `json:"contextKind,omitempty"`
+	// BucketBy is the attribute contexts are bucketed by. It defaults to key.
+	BucketBy string `json:"bucketBy,omitempty"`
+	// Seed is mixed into the bucketing hash, so that different seeds bucket contexts differently, and seeds the random
+	// values picked for PerEvaluation rollouts.
+	Seed int64 `json:"seed,omitempty"`
+	// PerEvaluation picks a value at random each time flags are served to a client-side SDK rather than bucketing by
+	// context. Server-side SDKs evaluate flags themselves, so they still bucket by context.
+	PerEvaluation bool `json:"perEvaluation,omitempty"`
+	// ForcedTreatments put particular contexts in a variation whatever their bucket, so that each treatment of an
+	// experiment can be tried out.
+	ForcedTreatments []ForcedTreatment `json:"forcedTreatments,omitempty"`
+}
+
+// ForcedTreatment puts the context of the given kind and key in one of a rollout's variations.
+type ForcedTreatment struct {
+	// ContextKind defaults to user.
+	ContextKind string `json:"contextKind,omitempty"`
+	ContextKey  string `json:"contextKey"`
+	// Variation is the index of the rollout's variation the context gets.
+	Variation int `json:"variation"`
+}
+
+type WeightedValue struct {
+	Value ldvalue.Value `json:"value"`
+	// Weight is the share of contexts that get Value, out of RolloutTotalWeight.
+	Weight int `json:"weight"`
+}
+
+func (r Rollout) Validate() error {
+	if len(r.Variations) == 0 {
+		return errors.New("a rollout needs at least one variation")
+	}
+	total := 0
+	for _, variation := range r.Variations {
+		if variation.Weight < 0 {
+			return errors.New("rollout weights can't be negative")
+		}
+		total += variation.Weight
+	}
+	if total != RolloutTotalWeight {
+		return errors.Errorf("rollout weights add up to %d rather than %d", total, RolloutTotalWeight)
+	}
+	for _, forced := range r.ForcedTreatments {
+		if forced.Variation < 0 || forced.Variation >= len(r.Variations) {
+			return errors.Errorf("context %s is forced into variation %d, but the rollout has %d variations", forced.ContextKey, forced.Variation, len(r.Variations))
+		}
+	}
+	return nil
+}
+
+// Salt is mixed into the bucketing hash along with the flag key.
+func (r Rollout) Salt() string {
+	if r.Seed == 0 {
+		return RolloutSalt
+	}
+	return RolloutSalt + "." + strconv.FormatInt(r.Seed, 10)
+}
+
+// ValueFor returns the value the rollout serves the context for the flag.
+func (r Rollout) ValueFor(flagKey string, ldCtx ldcontext.Context) ldvalue.Value {
+	if forced, ok := r.forcedVariation(ldCtx); ok {
+		return r.Variations[forced].Value
+	}
+	return r.Variations[r.variationIndexForBucket(r.bucket(flagKey, ldCtx))].Value
+}
+
+// forcedVariation returns the index of the variation the context is forced into, if it is.
+func (r Rollout) forcedVariation(ldCtx ldcontext.Context) (int, bool) {
+	for _, forced := range r.ForcedTreatments {
+		individual := ldCtx.IndividualContextByKind(ldcontext.Kind(forced.ContextKind))
+		if individual.IsDefined() && individual.Key() == forced.ContextKey {
+			return forced.Variation, true
+		}
+	}
+	return 0, false
+}
+
+func (r Rollout) variationIndexForBucket(bucket float32) int {
+	var sum float32
+	for i, variation := range r.Variations {
+		sum += float32(variation.Weight) / RolloutTotalWeight
+		if bucket < sum {
+			return i
+		}
+	}
+	// rounding can leave the bucket just past the last weight
+	return len(r.Variations) - 1
+}
+
+// bucket hashes the context into [0, 1) the way LaunchDarkly SDKs bucket rollouts. Contexts that can't be bucketed,
+// e.g. because they're a different kind, are put in bucket 0.
+func (r Rollout) bucket(flagKey string, ldCtx ldcontext.Context) float32 {
+	individual := ldCtx.IndividualContextByKind(ldcontext.Kind(r.ContextKind))
+	if !individual.IsDefined() {
+		return 0
+	}
+	bucketBy := r.BucketBy
+	if bucketBy == "" {
+		bucketBy = ldattr.KeyAttr
+	}
+	value := individual.GetValueForRef(ldattr.NewRef(bucketBy))
+	var id string
+	switch {
+	case value.IsString():
+		id = value.StringValue()
+	case value.IsInt():
+		id = strconv.Itoa(value.IntValue())
+	default:
+		return 0
+	}
+
+	hash := sha1.Sum([]byte(flagKey + "." + r.Salt() + "." + id)) //nolint:gosec
+	intVal, _ := strconv.ParseUint(hex.EncodeToString(hash[:])[:15], 16, 64)
+	return float32(intVal) / float32(0xFFFFFFFFFFFFFFF)
+}
+
+// ForContext resolves the flag's rollout, if it has one, into the value the context gets.
+func (state FlagState) ForContext(projectKey, flagKey string, ldCtx ldcontext.Context) FlagState {
+	if state.Rollout == nil {
+		return state
+	}
+	if forced, ok := state.Rollout.forcedVariation(ldCtx); ok {
+		state.Value = state.Rollout.Variations[forced].Value
+	} else if state.Rollout.PerEvaluation {
+		state.Value = randomValues.next(projectKey, flagKey, *state.Rollout)
+	} else {
+		state.Value = state.Rollout.ValueFor(flagKey, ldCtx)
+	}
+	state.Rollout = nil
+	return state
+}
+
+// ForContext resolves every flag's rollout into the value the context gets, for SDKs that receive flag values rather
+// than flag configurations.
+func (state FlagsState) ForContext(projectKey string, ldCtx ldcontext.Context) FlagsState {
+	resolved := make(FlagsState, len(state))
+	for flagKey, flagState := range state {
+		resolved[flagKey] = flagState.ForContext(projectKey, flagKey, ldCtx)
+	}
+	return resolved
+}
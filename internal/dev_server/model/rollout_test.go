@@ -0,0 +1,152 @@
+package model_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestRolloutValidate(t *testing.T) {
+	t.Run("weights must add up to the total", func(t *testing.T) {
+		rollout := model.Rollout{Variations: []model.WeightedValue{
+			{Value: ldvalue.Bool(true), Weight: 20000},
+			{Value: ldvalue.Bool(false), Weight: 70000},
+		}}
+		assert.EqualError(t, rollout.Validate(), "rollout weights add up to 90000 rather than 100000")
+	})
+
+	t.Run("weights can't be negative", func(t *testing.T) {
+		rollout := model.Rollout{Variations: []model.WeightedValue{
+			{Value: ldvalue.Bool(true), Weight: 110000},
+			{Value: ldvalue.Bool(false), Weight: -10000},
+		}}
+		assert.Error(t, rollout.Validate())
+	})
+
+	t.Run("needs a variation", func(t *testing.T) {
+		assert.Error(t, model.Rollout{}.Validate())
+	})
+
+	t.Run("accepts weights that add up to the total", func(t *testing.T) {
+		rollout := model.Rollout{Variations: []model.WeightedValue{
+			{Value: ldvalue.Bool(true), Weight: 20000},
+			{Value: ldvalue.Bool(false), Weight: 80000},
+		}}
+		assert.NoError(t, rollout.Validate())
+	})
+}
+
+func TestRolloutValueFor(t *testing.T) {
+	rollout := model.Rollout{Variations: []model.WeightedValue{
+		{Value: ldvalue.String("a"), Weight: 25000},
+		{Value: ldvalue.String("b"), Weight: 75000},
+	}}
+
+	t.Run("serves a context the same value every time", func(t *testing.T) {
+		ldCtx := ldcontext.New("some-user")
+		first := rollout.ValueFor("flg", ldCtx)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, first, rollout.ValueFor("flg", ldCtx))
+		}
+	})
+
+	t.Run("splits contexts roughly by weight", func(t *testing.T) {
+		served := map[string]int{}
+		for i := 0; i < 10000; i++ {
+			served[rollout.ValueFor("flg", ldcontext.New(fmt.Sprintf("user-%d", i))).StringValue()]++
+		}
+		assert.InDelta(t, 2500, served["a"], 200)
+		assert.InDelta(t, 7500, served["b"], 200)
+	})
+
+	t.Run("serves contexts of another kind the first value", func(t *testing.T) {
+		orgRollout := rollout
+		orgRollout.ContextKind = "org"
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, ldvalue.String("a"), orgRollout.ValueFor("flg", ldcontext.New(fmt.Sprintf("user-%d", i))))
+		}
+	})
+
+	t.Run("buckets by the given attribute", func(t *testing.T) {
+		byTeam := rollout
+		byTeam.BucketBy = "team"
+		first := byTeam.ValueFor("flg", ldcontext.NewBuilder("user-1").SetString("team", "blue").Build())
+		for i := 2; i < 10; i++ {
+			ldCtx := ldcontext.NewBuilder(fmt.Sprintf("user-%d", i)).SetString("team", "blue").Build()
+			assert.Equal(t, first, byTeam.ValueFor("flg", ldCtx))
+		}
+	})
+}
+
+func TestFlagsStateForContext(t *testing.T) {
+	rollout := &model.Rollout{Variations: []model.WeightedValue{
+		{Value: ldvalue.Bool(true), Weight: 50000},
+		{Value: ldvalue.Bool(false), Weight: 50000},
+	}}
+	state := model.FlagsState{
+		"rolled-out": model.FlagState{Value: ldvalue.Bool(true), Version: 2, Rollout: rollout},
+		"plain":      model.FlagState{Value: ldvalue.String("x"), Version: 1},
+	}
+	ldCtx := ldcontext.New("some-user")
+
+	resolved := state.ForContext("proj", ldCtx)
+	assert.Equal(t, model.FlagState{Value: rollout.ValueFor("rolled-out", ldCtx), Version: 2}, resolved["rolled-out"])
+	assert.Equal(t, state["plain"], resolved["plain"])
+	assert.NotNil(t, state["rolled-out"].Rollout, "original state is left alone")
+}
+
+func TestUpsertRolloutOverride(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx = model.ContextWithStore(ctx, store)
+	observers := model.NewObservers()
+	observer := mocks.NewMockObserver(mockController)
+	observers.RegisterObserver(observer)
+	ctx = model.SetObserversOnContext(ctx, observers)
+	project := &model.Project{
+		Key:           "proj",
+		AllFlagsState: model.FlagsState{"flg": model.FlagState{Value: ldvalue.Bool(false), Version: 1}},
+	}
+	rollout := model.Rollout{Variations: []model.WeightedValue{
+		{Value: ldvalue.Bool(true), Weight: 20000},
+		{Value: ldvalue.Bool(false), Weight: 80000},
+	}}
+
+	t.Run("rejects an invalid rollout", func(t *testing.T) {
+		_, err := model.UpsertRolloutOverride(ctx, "proj", "flg", model.Rollout{})
+		assert.Error(t, err)
+	})
+
+	t.Run("stores the rollout and notifies observers", func(t *testing.T) {
+		override := model.Override{
+			ProjectKey: "proj",
+			FlagKey:    "flg",
+			Value:      ldvalue.Bool(true),
+			Rollout:    &rollout,
+			Active:     true,
+			Version:    1,
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), override).Return(override, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+		observer.EXPECT().Handle(model.OverrideEvent{
+			FlagKey:    "flg",
+			ProjectKey: "proj",
+			FlagState:  model.FlagState{Value: ldvalue.Bool(true), Version: 2, TrackEvents: true, Rollout: &rollout},
+		})
+
+		o, err := model.UpsertRolloutOverride(ctx, "proj", "flg", rollout)
+		require.NoError(t, err)
+		assert.Equal(t, override, o)
+	})
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"context"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// SavedContext is a context saved with a project under a name, so that test personas can be shared and picked by
+// name rather than pasted around as JSON.
+type SavedContext struct {
+	ProjectKey string
+	Name       string
+	Context    ldcontext.Context
+}
+
+// SaveContext saves the context under its name, replacing whichever context was saved under it before. ErrNotFound is
+// returned if the project doesn't exist, and ErrUnknownContextKind if the context has a kind the project doesn't.
+func SaveContext(ctx context.Context, savedContext SavedContext) (SavedContext, error) {
+	if err := savedContext.Context.Err(); err != nil {
+		return SavedContext{}, errors.Wrap(err, "invalid context")
+	}
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, savedContext.ProjectKey)
+	if err != nil {
+		return SavedContext{}, err
+	}
+	if err := project.checkContextKinds(savedContext.Context); err != nil {
+		return SavedContext{}, err
+	}
+	if err := store.UpsertSavedContext(ctx, savedContext); err != nil {
+		return SavedContext{}, err
+	}
+	logs.Printf(logs.Info, savedContext.ProjectKey, "Saved context [%s] in project [%s]", savedContext.Name, savedContext.ProjectKey)
+	return savedContext, nil
+}
+
+// GetSavedContexts returns the contexts saved with the project, ordered by name. ErrNotFound is returned if the
+// project doesn't exist.
+func GetSavedContexts(ctx context.Context, projectKey string) ([]SavedContext, error) {
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, projectKey); err != nil {
+		return nil, err
+	}
+	return store.GetSavedContexts(ctx, projectKey)
+}
+
+// GetSavedContext returns the context saved with the project under name. Linked clones, such as namespaces' projects,
+// also find the contexts saved with their base project. ErrNotFound is returned if there isn't one.
+func GetSavedContext(ctx context.Context, projectKey, name string) (SavedContext, error) {
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return SavedContext{}, err
+	}
+	savedContext, err := store.GetSavedContext(ctx, projectKey, name)
+	if errors.As(err, &ErrNotFound{}) && project.BaseProjectKey != "" {
+		return store.GetSavedContext(ctx, project.BaseProjectKey, name)
+	}
+	return savedContext, err
+}
+
+// DeleteSavedContext removes the context saved with the project under name. ErrNotFound is returned if there isn't
+// one.
+func DeleteSavedContext(ctx context.Context, projectKey, name string) error {
+	deleted, err := StoreFromContext(ctx).DeleteSavedContext(ctx, projectKey, name)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.WithStack(NewErrNotFound("saved context", name))
+	}
+	logs.Printf(logs.Info, projectKey, "Deleted saved context [%s] from project [%s]", name, projectKey)
+	return nil
+}
@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestSavedContexts(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:          "proj",
+		Context:      ldcontext.New("dev"),
+		LastSyncTime: time.Now(),
+		ContextKinds: []model.ContextKind{{Key: "user"}, {Key: "org"}},
+	}))
+	require.NoError(t, store.InsertProject(ctx, model.Project{Key: "clone", BaseProjectKey: "proj", Context: ldcontext.New("dev"), LastSyncTime: time.Now()}))
+	tester := ldcontext.NewBuilder("beta").SetBool("beta", true).Build()
+
+	t.Run("saves contexts by name", func(t *testing.T) {
+		_, err := model.SaveContext(ctx, model.SavedContext{ProjectKey: "proj", Name: "tester", Context: tester})
+		require.NoError(t, err)
+
+		savedContexts, err := model.GetSavedContexts(ctx, "proj")
+		require.NoError(t, err)
+		assert.Equal(t, []model.SavedContext{{ProjectKey: "proj", Name: "tester", Context: tester}}, savedContexts)
+		savedContext, err := model.GetSavedContext(ctx, "proj", "tester")
+		require.NoError(t, err)
+		assert.Equal(t, tester, savedContext.Context)
+	})
+
+	t.Run("rejects contexts of kinds the project doesn't have", func(t *testing.T) {
+		_, err := model.SaveContext(ctx, model.SavedContext{ProjectKey: "proj", Name: "device", Context: ldcontext.NewWithKind("device", "laptop")})
+		assert.ErrorAs(t, err, &model.ErrUnknownContextKind{})
+	})
+
+	t.Run("rejects invalid contexts", func(t *testing.T) {
+		_, err := model.SaveContext(ctx, model.SavedContext{ProjectKey: "proj", Name: "empty", Context: ldcontext.New("")})
+		assert.Error(t, err)
+	})
+
+	t.Run("linked clones find the contexts saved with their base project", func(t *testing.T) {
+		savedContext, err := model.GetSavedContext(ctx, "clone", "tester")
+		require.NoError(t, err)
+		assert.Equal(t, tester, savedContext.Context)
+	})
+
+	t.Run("returns ErrNotFound for projects that don't exist", func(t *testing.T) {
+		_, err := model.GetSavedContexts(ctx, "nope")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("deletes saved contexts", func(t *testing.T) {
+		require.NoError(t, model.DeleteSavedContext(ctx, "proj", "tester"))
+		_, err := model.GetSavedContext(ctx, "proj", "tester")
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+		assert.ErrorAs(t, model.DeleteSavedContext(ctx, "proj", "tester"), &model.ErrNotFound{})
+	})
+}
@@ -0,0 +1,182 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// OverrideSchedule overrides a flag with Value at ActivateAt and removes the override at DeactivateAt, so that
+// time-based rollouts can be rehearsed without anyone flipping flags by hand. Either time may be nil, but not both.
+type OverrideSchedule struct {
+	ProjectKey   string
+	FlagKey      string
+	Value        ldvalue.Value
+	ActivateAt   *time.Time
+	DeactivateAt *time.Time
+	// ScheduledBy is the actor who created the schedule. The changes the scheduler makes are attributed to them.
+	ScheduledBy string
+}
+
+// ScheduleOverride replaces the flag's schedule, if any. ErrNotFound is returned if the flag isn't in the project, and
+// ErrInvalidMigrationStage if it's a migration flag and the value to activate isn't one of its stages.
+func ScheduleOverride(ctx context.Context, schedule OverrideSchedule) (OverrideSchedule, error) {
+	project, err := getProjectWithFlag(ctx, schedule.ProjectKey, schedule.FlagKey)
+	if err != nil {
+		return OverrideSchedule{}, err
+	}
+	if schedule.ActivateAt != nil {
+		if err := project.checkMigrationStages(Override{FlagKey: schedule.FlagKey, Value: schedule.Value}); err != nil {
+			return OverrideSchedule{}, err
+		}
+	}
+	schedule.ScheduledBy = ActorFromContext(ctx)
+	if err := StoreFromContext(ctx).UpsertOverrideSchedule(ctx, schedule); err != nil {
+		return OverrideSchedule{}, err
+	}
+	logs.Printf(logs.Info, schedule.ProjectKey, "Scheduled override for flag [%s] in project [%s]", schedule.FlagKey, schedule.ProjectKey)
+	return schedule, nil
+}
+
+// UnscheduleOverride cancels the flag's schedule without touching its override. ErrNotFound is returned if the flag
+// has no schedule.
+func UnscheduleOverride(ctx context.Context, projectKey, flagKey string) error {
+	deleted, err := StoreFromContext(ctx).DeleteOverrideSchedule(ctx, projectKey, flagKey)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return NewErrNotFound("schedule", flagKey)
+	}
+	return nil
+}
+
+// GetOverrideSchedules returns the project's pending schedules. ErrNotFound is returned if the project doesn't exist.
+func GetOverrideSchedules(ctx context.Context, projectKey string) ([]OverrideSchedule, error) {
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, projectKey); err != nil {
+		return nil, err
+	}
+	return store.GetOverrideSchedules(ctx, projectKey)
+}
+
+// RunOverrideScheduler applies schedules as they come due, checking every interval until ctx is done.
+func RunOverrideScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := ApplyDueSchedules(ctx, now); err != nil {
+				logs.Printf(logs.Error, "", "unable to apply override schedules: %+v", err)
+			}
+		}
+	}
+}
+
+// ApplyDueSchedules activates and deactivates the overrides whose scheduled times are at or before now. A schedule
+// is removed once nothing is left for it to do. A schedule or project that fails is logged and retried next time,
+// without holding up the others.
+func ApplyDueSchedules(ctx context.Context, now time.Time) error {
+	store := StoreFromContext(ctx)
+	projectKeys, err := store.GetDevProjectKeys(ctx)
+	if err != nil {
+		return err
+	}
+	for _, projectKey := range projectKeys {
+		schedules, err := store.GetOverrideSchedules(ctx, projectKey)
+		if err != nil {
+			logs.Printf(logs.Error, projectKey, "unable to get schedules for project %s: %+v", projectKey, err)
+			continue
+		}
+		for _, schedule := range schedules {
+			if !schedule.isDue(now) {
+				continue
+			}
+			err := withTx(ctx, func(ctx context.Context) error {
+				return applySchedule(ctx, projectKey, schedule.FlagKey, now)
+			})
+			if err != nil {
+				logs.Printf(logs.Error, projectKey, "unable to apply schedule for flag %s in project %s: %+v", schedule.FlagKey, projectKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isDue is whether the schedule has an activation or deactivation to apply at now.
+func (schedule OverrideSchedule) isDue(now time.Time) bool {
+	return (schedule.ActivateAt != nil && !now.Before(*schedule.ActivateAt)) ||
+		(schedule.DeactivateAt != nil && !now.Before(*schedule.DeactivateAt))
+}
+
+// isPermanentScheduleError is whether an activation or deactivation failed in a way that retrying won't fix, so it's
+// dropped from the schedule instead of being retried every time the scheduler runs.
+func isPermanentScheduleError(err error) bool {
+	return errors.As(err, &ErrLocked{}) || errors.As(err, &ErrNotFound{}) || errors.As(err, &ErrInvalidMigrationStage{})
+}
+
+// applySchedule applies the flag's schedule as it is now in the store, rather than as it was when the scheduler listed
+// it, so that a schedule replaced in the meantime isn't overwritten. It's run in a transaction.
+func applySchedule(ctx context.Context, projectKey, flagKey string, now time.Time) error {
+	store := StoreFromContext(ctx)
+	schedules, err := store.GetOverrideSchedules(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+	var schedule *OverrideSchedule
+	for i := range schedules {
+		if schedules[i].FlagKey == flagKey {
+			schedule = &schedules[i]
+			break
+		}
+	}
+	if schedule == nil {
+		return nil
+	}
+	changed := false
+	actorCtx := ContextWithActor(ctx, schedule.ScheduledBy)
+
+	if schedule.ActivateAt != nil && !now.Before(*schedule.ActivateAt) {
+		_, err := UpsertOverride(actorCtx, schedule.ProjectKey, schedule.FlagKey, schedule.Value)
+		switch {
+		case isPermanentScheduleError(err):
+			logs.Printf(logs.Warn, schedule.ProjectKey, "Skipping scheduled activation of override for flag [%s] in project [%s]: %s", schedule.FlagKey, schedule.ProjectKey, err)
+		case err != nil:
+			return err
+		default:
+			logs.Printf(logs.Info, schedule.ProjectKey, "Activated scheduled override for flag [%s] in project [%s]", schedule.FlagKey, schedule.ProjectKey)
+		}
+		schedule.ActivateAt = nil
+		changed = true
+	}
+	// a deactivation waits for the activation before it, even if both are due
+	if schedule.ActivateAt == nil && schedule.DeactivateAt != nil && !now.Before(*schedule.DeactivateAt) {
+		err := DeleteOverride(actorCtx, schedule.ProjectKey, schedule.FlagKey)
+		switch {
+		case isPermanentScheduleError(err):
+			logs.Printf(logs.Warn, schedule.ProjectKey, "Skipping scheduled deactivation of override for flag [%s] in project [%s]: %s", schedule.FlagKey, schedule.ProjectKey, err)
+		case err != nil:
+			return err
+		default:
+			logs.Printf(logs.Info, schedule.ProjectKey, "Deactivated scheduled override for flag [%s] in project [%s]", schedule.FlagKey, schedule.ProjectKey)
+		}
+		schedule.DeactivateAt = nil
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	if schedule.ActivateAt == nil && schedule.DeactivateAt == nil {
+		_, err := store.DeleteOverrideSchedule(ctx, schedule.ProjectKey, schedule.FlagKey)
+		return err
+	}
+	return store.UpsertOverrideSchedule(ctx, *schedule)
+}
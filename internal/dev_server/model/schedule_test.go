@@ -0,0 +1,176 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestApplyDueSchedules(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+	observers := model.NewObservers()
+	observer := mocks.NewMockObserver(mockController)
+	observers.RegisterObserver(observer)
+	ctx = model.SetObserversOnContext(ctx, observers)
+	expectTransactions(store)
+
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+	later := now.Add(time.Hour)
+	project := &model.Project{
+		Key:           "proj",
+		AllFlagsState: model.FlagsState{"flg": model.FlagState{Value: ldvalue.Bool(false), Version: 1}},
+	}
+
+	t.Run("activates a due override and keeps the pending deactivation", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", Value: ldvalue.Bool(true), ActivateAt: &earlier, DeactivateAt: &later, ScheduledBy: "alice"}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil).Times(2)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().UpsertOverride(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, override model.Override) (model.Override, error) {
+			assert.Equal(t, "alice", model.ActorFromContext(ctx))
+			assert.Equal(t, ldvalue.Bool(true), override.Value)
+			return override, nil
+		})
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+		observer.EXPECT().Handle(gomock.Any())
+		pending := schedule
+		pending.ActivateAt = nil
+		store.EXPECT().UpsertOverrideSchedule(gomock.Any(), pending).Return(nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("deactivates a due override and removes the finished schedule", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", DeactivateAt: &earlier}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil).Times(2)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), "proj", "flg").Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+		observer.EXPECT().Handle(gomock.Any())
+		store.EXPECT().DeleteOverrideSchedule(gomock.Any(), "proj", "flg").Return(true, nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("skips a locked override", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", DeactivateAt: &earlier}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil).Times(2)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), "proj", "flg").Return(0, model.NewErrLocked("proj", "flg"))
+		store.EXPECT().DeleteOverrideSchedule(gomock.Any(), "proj", "flg").Return(true, nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("applies the schedule as it is when it's applied", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", Value: ldvalue.Bool(true), ActivateAt: &earlier}
+		replaced := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", Value: ldvalue.Bool(true), ActivateAt: &later}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{replaced}, nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("keeps applying other projects' schedules after one fails", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", DeactivateAt: &earlier}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"broken", "proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "broken").Return(nil, errors.New("broken"))
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil).Times(2)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), "proj", "flg").Return(2, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), "proj").Return(nil, nil)
+		observer.EXPECT().Handle(gomock.Any())
+		store.EXPECT().DeleteOverrideSchedule(gomock.Any(), "proj", "flg").Return(true, nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("keeps a schedule that failed to retry it", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", DeactivateAt: &earlier}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil).Times(2)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().DeactivateOverride(gomock.Any(), "proj", "flg").Return(0, errors.New("database is locked"))
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("drops an activation that isn't a migration stage", func(t *testing.T) {
+		migrationProject := &model.Project{
+			Key:           "proj",
+			AllFlagsState: model.FlagsState{"flg": model.FlagState{Value: ldvalue.String("off"), Version: 1}},
+			FlagMetadata:  map[string]model.FlagMetadata{"flg": {MigrationStages: []string{"off", "live"}}},
+		}
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", Value: ldvalue.Bool(true), ActivateAt: &earlier}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil).Times(2)
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(migrationProject, nil)
+		store.EXPECT().DeleteOverrideSchedule(gomock.Any(), "proj", "flg").Return(true, nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+
+	t.Run("leaves schedules that aren't due", func(t *testing.T) {
+		schedule := model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", Value: ldvalue.Bool(true), ActivateAt: &later}
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		store.EXPECT().GetOverrideSchedules(gomock.Any(), "proj").Return([]model.OverrideSchedule{schedule}, nil)
+
+		assert.NoError(t, model.ApplyDueSchedules(ctx, now))
+	})
+}
+
+func TestScheduleOverride(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+	at := time.Now().Add(time.Hour)
+
+	t.Run("records who scheduled the override", func(t *testing.T) {
+		project := &model.Project{Key: "proj", AllFlagsState: model.FlagsState{"flg": model.FlagState{}}}
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+		store.EXPECT().UpsertOverrideSchedule(gomock.Any(), gomock.Any()).Return(nil)
+
+		schedule, err := model.ScheduleOverride(model.ContextWithActor(ctx, "alice"), model.OverrideSchedule{ProjectKey: "proj", FlagKey: "flg", ActivateAt: &at})
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", schedule.ScheduledBy)
+	})
+
+	t.Run("returns ErrNotFound for a flag that isn't in the project", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(&model.Project{Key: "proj"}, nil)
+
+		_, err := model.ScheduleOverride(ctx, model.OverrideSchedule{ProjectKey: "proj", FlagKey: "nope", ActivateAt: &at})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("returns ErrInvalidMigrationStage for a value that isn't a stage of a migration flag", func(t *testing.T) {
+		project := &model.Project{
+			Key:           "proj",
+			AllFlagsState: model.FlagsState{"migration": model.FlagState{Value: ldvalue.String("off")}},
+			FlagMetadata:  map[string]model.FlagMetadata{"migration": {MigrationStages: []string{"off", "live"}}},
+		}
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(project, nil)
+
+		_, err := model.ScheduleOverride(ctx, model.OverrideSchedule{ProjectKey: "proj", FlagKey: "migration", Value: ldvalue.Bool(true), ActivateAt: &at})
+		assert.ErrorAs(t, err, &model.ErrInvalidMigrationStage{})
+	})
+
+	t.Run("returns ErrNotFound when cancelling a missing schedule", func(t *testing.T) {
+		store.EXPECT().DeleteOverrideSchedule(gomock.Any(), "proj", "flg").Return(false, nil)
+
+		assert.ErrorAs(t, model.UnscheduleOverride(ctx, "proj", "flg"), &model.ErrNotFound{})
+	})
+}
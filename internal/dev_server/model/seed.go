@@ -0,0 +1,163 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// Seed declares the projects and overrides the dev server should have when it starts, e.g. for a dev server run in CI.
+type Seed struct {
+	Projects []SeedProject `json:"projects"`
+}
+
+// SeedProject is a project to create when seeding. Its flags are either synced from SourceEnvironmentKey, which needs
+// an access token, or served with the values in Flags, which doesn't.
+type SeedProject struct {
+	Key                  string                   `json:"key"`
+	SourceEnvironmentKey string                   `json:"sourceEnvironmentKey,omitempty"`
+	Context              *ldcontext.Context       `json:"context,omitempty"`
+	Flags                map[string]ldvalue.Value `json:"flags,omitempty"`
+	Overrides            map[string]FlagValue     `json:"overrides,omitempty"`
+	// SdkKeys are placeholder SDK keys, e.g. local-dev-key-frontend, that select the project, as aliases for it.
+	SdkKeys []string `json:"sdkKeys,omitempty"`
+}
+
+// ReadSeedFile reads and validates a seed from a JSON file.
+func ReadSeedFile(path string) (Seed, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Seed{}, errors.Wrapf(err, "unable to read seed file %s", path)
+	}
+	var seed Seed
+	if err := json.Unmarshal(data, &seed); err != nil {
+		return Seed{}, errors.Wrapf(err, "unable to parse seed file %s", path)
+	}
+	if err := seed.Validate(); err != nil {
+		return Seed{}, errors.Wrapf(err, "invalid seed file %s", path)
+	}
+	return seed, nil
+}
+
+// Validate checks that every project has a key, used once, and a source for its flags, and that no SDK key selects
+// more than one project.
+func (s Seed) Validate() error {
+	seen := make(map[string]bool, len(s.Projects))
+	sdkKeys := make(map[string]string)
+	for i, project := range s.Projects {
+		switch {
+		case project.Key == "":
+			return errors.Errorf("project %d has no key", i)
+		case seen[project.Key]:
+			return errors.Errorf("project %s is declared more than once", project.Key)
+		case project.SourceEnvironmentKey == "" && len(project.Flags) == 0:
+			return errors.Errorf("project %s needs either a sourceEnvironmentKey or flags", project.Key)
+		case project.SourceEnvironmentKey != "" && len(project.Flags) > 0:
+			return errors.Errorf("project %s can't have both a sourceEnvironmentKey and flags", project.Key)
+		}
+		seen[project.Key] = true
+		for _, sdkKey := range project.SdkKeys {
+			switch other, ok := sdkKeys[sdkKey]; {
+			case sdkKey == "":
+				return errors.Errorf("project %s has an empty SDK key", project.Key)
+			case ok && other != project.Key:
+				return errors.Errorf("SDK key %s is given to both project %s and project %s", sdkKey, other, project.Key)
+			}
+			sdkKeys[sdkKey] = project.Key
+		}
+	}
+	for sdkKey, projectKey := range sdkKeys {
+		if seen[sdkKey] && sdkKey != projectKey {
+			return errors.Errorf("SDK key %s given to project %s is the key of another project", sdkKey, projectKey)
+		}
+	}
+	return nil
+}
+
+// SeedProjects creates the seed's projects and sets their overrides, stopping at the first that fails. Projects that
+// already exist, e.g. from a previous run with the same database, are kept: synced projects are refreshed and
+// projects with flags are left as they are, and either way their overrides are set again.
+func SeedProjects(ctx context.Context, seed Seed) error {
+	for _, project := range seed.Projects {
+		if err := seedProject(ctx, project); err != nil {
+			return errors.Wrapf(err, "unable to seed project %s", project.Key)
+		}
+		logs.Printf(logs.Info, project.Key, "Seeded project [%s]", project.Key)
+	}
+	return nil
+}
+
+func seedProject(ctx context.Context, project SeedProject) error {
+	if project.SourceEnvironmentKey != "" {
+		err := CreateOrSyncProject(ctx, InitialProjectSettings{
+			Enabled:    true,
+			ProjectKey: project.Key,
+			EnvKey:     project.SourceEnvironmentKey,
+			Context:    project.Context,
+			Overrides:  project.Overrides,
+		})
+		if err != nil {
+			return err
+		}
+		return seedSdkKeys(ctx, project)
+	}
+
+	_, err := StoreFromContext(ctx).GetDevProject(ctx, project.Key)
+	switch {
+	case errors.As(err, &ErrNotFound{}):
+		importData := ImportDataFromValues(project.Flags)
+		if project.Context != nil {
+			importData.Context = *project.Context
+		}
+		if err := ImportProject(ctx, project.Key, importData); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+	for flagKey, value := range project.Overrides {
+		if _, err := UpsertOverride(ctx, project.Key, flagKey, value); err != nil {
+			return err
+		}
+	}
+	return seedSdkKeys(ctx, project)
+}
+
+func seedSdkKeys(ctx context.Context, project SeedProject) error {
+	for _, sdkKey := range project.SdkKeys {
+		if err := CreateAlias(ctx, Alias{Alias: sdkKey, ProjectKey: project.Key}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportDataFromValues makes import data for a project that serves the given flag values rather than ones synced
+// from LaunchDarkly. Boolean flags get both values as variations, and other flags just their value.
+func ImportDataFromValues(flags map[string]ldvalue.Value) ImportData {
+	flagsState := make(FlagsState, len(flags))
+	variations := make(map[string][]ImportVariation, len(flags))
+	for flagKey, value := range flags {
+		flagsState[flagKey] = FlagState{Value: value, Version: 1}
+		if value.IsBool() {
+			variations[flagKey] = []ImportVariation{
+				{Id: "true", Value: ldvalue.Bool(true)},
+				{Id: "false", Value: ldvalue.Bool(false)},
+			}
+		} else {
+			variations[flagKey] = []ImportVariation{{Id: "initial", Value: value}}
+		}
+	}
+	return ImportData{
+		Context:             ldcontext.New("dev-server"),
+		FlagsState:          flagsState,
+		AvailableVariations: &variations,
+	}
+}
@@ -0,0 +1,129 @@
+package model_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestReadSeedFile(t *testing.T) {
+	writeSeed := func(t *testing.T, contents string) string {
+		path := filepath.Join(t.TempDir(), "seed.json")
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+		return path
+	}
+
+	t.Run("reads projects", func(t *testing.T) {
+		seed, err := model.ReadSeedFile(writeSeed(t, `{"projects": [
+			{"key": "synced", "sourceEnvironmentKey": "test", "overrides": {"flag": true}},
+			{"key": "local", "flags": {"flag": "value"}}
+		]}`))
+		require.NoError(t, err)
+		require.Len(t, seed.Projects, 2)
+		assert.Equal(t, "test", seed.Projects[0].SourceEnvironmentKey)
+		assert.Equal(t, ldvalue.Bool(true), seed.Projects[0].Overrides["flag"])
+		assert.Equal(t, ldvalue.String("value"), seed.Projects[1].Flags["flag"])
+	})
+
+	invalid := map[string]string{
+		"missing key":            `{"projects": [{"flags": {"flag": true}}]}`,
+		"duplicate key":          `{"projects": [{"key": "p", "flags": {"flag": true}}, {"key": "p", "sourceEnvironmentKey": "test"}]}`,
+		"no flags":               `{"projects": [{"key": "p"}]}`,
+		"both sources of flags":  `{"projects": [{"key": "p", "sourceEnvironmentKey": "test", "flags": {"flag": true}}]}`,
+		"malformed JSON":         `{"projects": [`,
+		"wrong type for project": `{"projects": {"key": "p"}}`,
+		"empty SDK key":          `{"projects": [{"key": "p", "flags": {"flag": true}, "sdkKeys": [""]}]}`,
+		"SDK key for two projects": `{"projects": [{"key": "p", "flags": {"flag": true}, "sdkKeys": ["dev-key"]},
+			{"key": "q", "flags": {"flag": true}, "sdkKeys": ["dev-key"]}]}`,
+		"SDK key of another project": `{"projects": [{"key": "p", "flags": {"flag": true}, "sdkKeys": ["q"]},
+			{"key": "q", "flags": {"flag": true}}]}`,
+	}
+	for name, contents := range invalid {
+		t.Run("rejects "+name, func(t *testing.T) {
+			_, err := model.ReadSeedFile(writeSeed(t, contents))
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("rejects a missing file", func(t *testing.T) {
+		_, err := model.ReadSeedFile(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+	})
+}
+
+func TestSeedProjects(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	seed := model.Seed{Projects: []model.SeedProject{{
+		Key:       "proj",
+		Flags:     map[string]ldvalue.Value{"bool-flag": ldvalue.Bool(false), "string-flag": ldvalue.String("initial")},
+		Overrides: map[string]model.FlagValue{"bool-flag": ldvalue.Bool(true)},
+	}}}
+
+	flagValues := func(t *testing.T) map[string]ldvalue.Value {
+		project, err := store.GetDevProject(ctx, "proj")
+		require.NoError(t, err)
+		flagsState, err := project.GetFlagStateWithOverridesForProject(ctx)
+		require.NoError(t, err)
+		values := make(map[string]ldvalue.Value)
+		for flagKey, state := range flagsState {
+			values[flagKey] = state.Value
+		}
+		return values
+	}
+
+	t.Run("creates projects with their flags and overrides", func(t *testing.T) {
+		require.NoError(t, model.SeedProjects(ctx, seed))
+		assert.Equal(t, map[string]ldvalue.Value{
+			"bool-flag":   ldvalue.Bool(true),
+			"string-flag": ldvalue.String("initial"),
+		}, flagValues(t))
+	})
+
+	t.Run("keeps existing projects and sets their overrides again", func(t *testing.T) {
+		_, err := model.UpsertOverride(ctx, "proj", "bool-flag", ldvalue.Bool(false))
+		require.NoError(t, err)
+		_, err = model.UpsertOverride(ctx, "proj", "string-flag", ldvalue.String("changed"))
+		require.NoError(t, err)
+
+		require.NoError(t, model.SeedProjects(ctx, seed))
+		assert.Equal(t, map[string]ldvalue.Value{
+			"bool-flag":   ldvalue.Bool(true),
+			"string-flag": ldvalue.String("changed"),
+		}, flagValues(t))
+	})
+
+	t.Run("maps SDK keys to the project", func(t *testing.T) {
+		withKeys := seed
+		withKeys.Projects = []model.SeedProject{seed.Projects[0]}
+		withKeys.Projects[0].SdkKeys = []string{"local-dev-key-frontend", "local-dev-key-backend"}
+		require.NoError(t, model.SeedProjects(ctx, withKeys))
+
+		for _, sdkKey := range withKeys.Projects[0].SdkKeys {
+			projectKey, err := model.ResolveProjectKey(ctx, sdkKey)
+			require.NoError(t, err)
+			assert.Equal(t, "proj", projectKey)
+		}
+	})
+
+	t.Run("fails for overrides of flags the project doesn't have", func(t *testing.T) {
+		err := model.SeedProjects(ctx, model.Seed{Projects: []model.SeedProject{{
+			Key:       "other",
+			Flags:     map[string]ldvalue.Value{"flag": ldvalue.Bool(false)},
+			Overrides: map[string]model.FlagValue{"missing": ldvalue.Bool(true)},
+		}}})
+		assert.ErrorContains(t, err, "other")
+	})
+}
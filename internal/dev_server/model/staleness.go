@@ -0,0 +1,154 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const ctxKeyStaleness = ctxKey("model.Staleness")
+
+const (
+	// stalenessRecheckInterval is how long Check goes by what it last read of a project, since it's called for every
+	// SDK request.
+	stalenessRecheckInterval = 5 * time.Second
+	// staleResyncBackoff is how long to wait before resyncing a project again after a resync fails. It doubles with
+	// each failure in a row, up to maxStaleResyncBackoff.
+	staleResyncBackoff    = time.Minute
+	maxStaleResyncBackoff = time.Hour
+)
+
+// Staleness decides when a project's flag values are old enough that developers should be told, and optionally resyncs
+// stale projects in the background. A nil *Staleness never considers projects stale.
+type Staleness struct {
+	ttl        time.Duration
+	autoResync bool
+	// resyncing holds the keys of projects with a background resync in flight, so each is only started once
+	resyncing sync.Map
+
+	mu       sync.Mutex
+	projects map[string]stalenessEntry
+}
+
+// stalenessEntry is what Check last read of a project, and how its resyncs have gone.
+type stalenessEntry struct {
+	checkedAt    time.Time
+	lastSyncTime time.Time
+	archived     bool
+	// resyncable is false for projects without a source environment to sync from, or whose source is gone.
+	resyncable bool
+	// failures is how many resyncs in a row have failed, and retryAt when to try again.
+	failures int
+	retryAt  time.Time
+}
+
+// NewStaleness returns a policy that considers projects stale once they haven't been synced for ttl. A ttl of 0 turns
+// staleness detection off.
+func NewStaleness(ttl time.Duration, autoResync bool) *Staleness {
+	return &Staleness{ttl: ttl, autoResync: autoResync, projects: make(map[string]stalenessEntry)}
+}
+
+func (s *Staleness) IsStale(project Project, now time.Time) bool {
+	if s == nil || s.ttl <= 0 {
+		return false
+	}
+	return now.Sub(project.LastSyncTime) > s.ttl
+}
+
+// Check reports whether the project is stale. If it is and auto-resync is on, a background resync of the project is
+// started unless one is already running, the project can't be synced, or the last resync failed too recently. The
+// project is only read from the store every stalenessRecheckInterval.
+func (s *Staleness) Check(ctx context.Context, projectKey string) bool {
+	if s == nil || s.ttl <= 0 {
+		return false
+	}
+	now := time.Now()
+	entry, ok := s.read(ctx, projectKey, now)
+	if !ok || entry.archived || now.Sub(entry.lastSyncTime) <= s.ttl {
+		return false
+	}
+	if s.autoResync && entry.resyncable && !now.Before(entry.retryAt) {
+		s.resyncInBackground(ctx, projectKey)
+	}
+	return true
+}
+
+// read returns what's known of the project, reading it from the store if it hasn't been for a while.
+func (s *Staleness) read(ctx context.Context, projectKey string, now time.Time) (stalenessEntry, bool) {
+	s.mu.Lock()
+	entry, ok := s.projects[projectKey]
+	s.mu.Unlock()
+	if ok && now.Sub(entry.checkedAt) < stalenessRecheckInterval {
+		return entry, true
+	}
+
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		delete(s.projects, projectKey)
+		return stalenessEntry{}, false
+	}
+	entry = s.projects[projectKey]
+	entry.checkedAt = now
+	entry.lastSyncTime = project.LastSyncTime
+	entry.archived = project.ArchivedAt != nil
+	entry.resyncable = project.SourceEnvironmentKey != "" && project.Orphaned == nil
+	s.projects[projectKey] = entry
+	return entry, true
+}
+
+func (s *Staleness) resyncInBackground(ctx context.Context, projectKey string) {
+	if _, running := s.resyncing.LoadOrStore(projectKey, struct{}{}); running {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer s.resyncing.Delete(projectKey)
+		logs.Printf(logs.Info, projectKey, "Project [%s] is stale, resyncing", projectKey)
+		_, err := UpdateProject(ctx, projectKey, nil, nil, nil)
+		s.resynced(projectKey, err, time.Now())
+		if err != nil {
+			logs.Printf(logs.Error, projectKey, "unable to resync stale project %s: %+v", projectKey, err)
+		}
+	}()
+}
+
+// resynced records how a resync went, backing off from the project after failures and reading it again after it's
+// synced.
+func (s *Staleness) resynced(projectKey string, err error, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.projects, projectKey)
+		return
+	}
+	entry := s.projects[projectKey]
+	backoff := staleResyncBackoff << min(entry.failures, 6)
+	entry.failures++
+	entry.retryAt = now.Add(min(backoff, maxStaleResyncBackoff))
+	s.projects[projectKey] = entry
+}
+
+func ContextWithStaleness(ctx context.Context, staleness *Staleness) context.Context {
+	return context.WithValue(ctx, ctxKeyStaleness, staleness)
+}
+
+// StalenessFromContext returns the staleness policy, or nil if none was set.
+func StalenessFromContext(ctx context.Context) *Staleness {
+	staleness, _ := ctx.Value(ctxKeyStaleness).(*Staleness)
+	return staleness
+}
+
+func StalenessMiddleware(staleness *Staleness) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := ContextWithStaleness(r.Context(), staleness)
+			r = r.WithContext(ctx)
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
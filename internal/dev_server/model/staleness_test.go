@@ -0,0 +1,71 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestStaleness(t *testing.T) {
+	now := time.Now()
+	project := model.Project{Key: "proj", LastSyncTime: now.Add(-2 * time.Hour)}
+
+	assert.True(t, model.NewStaleness(time.Hour, false).IsStale(project, now))
+	assert.False(t, model.NewStaleness(3*time.Hour, false).IsStale(project, now))
+	assert.False(t, model.NewStaleness(0, false).IsStale(project, now), "a ttl of 0 turns detection off")
+
+	var unset *model.Staleness
+	assert.False(t, unset.IsStale(project, now))
+}
+
+func TestStalenessCheck(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+
+	t.Run("reads each project once in a while", func(t *testing.T) {
+		staleness := model.NewStaleness(time.Hour, false)
+		store.EXPECT().GetDevProject(gomock.Any(), "fresh").Return(&model.Project{Key: "fresh", LastSyncTime: time.Now()}, nil).Times(1)
+
+		assert.False(t, staleness.Check(ctx, "fresh"))
+		assert.False(t, staleness.Check(ctx, "fresh"))
+	})
+
+	t.Run("doesn't resync projects it can't sync", func(t *testing.T) {
+		staleness := model.NewStaleness(time.Hour, true)
+		lastSyncTime := time.Now().Add(-2 * time.Hour)
+		orphaned := &model.Project{Key: "orphaned", SourceEnvironmentKey: "test", LastSyncTime: lastSyncTime, Orphaned: &model.Orphaned{}}
+		store.EXPECT().GetDevProject(gomock.Any(), "orphaned").Return(orphaned, nil).Times(1)
+		store.EXPECT().GetDevProject(gomock.Any(), "local").Return(&model.Project{Key: "local", LastSyncTime: lastSyncTime}, nil).Times(1)
+
+		assert.True(t, staleness.Check(ctx, "orphaned"))
+		assert.True(t, staleness.Check(ctx, "local"))
+		// give a resync, which would read the project again, a chance to start
+		time.Sleep(20 * time.Millisecond)
+	})
+
+	t.Run("backs off after a resync fails", func(t *testing.T) {
+		staleness := model.NewStaleness(time.Hour, true)
+		stale := &model.Project{Key: "stale", SourceEnvironmentKey: "test", LastSyncTime: time.Now().Add(-2 * time.Hour)}
+		resyncs := make(chan struct{}, 2)
+		store.EXPECT().GetDevProject(gomock.Any(), "stale").Return(stale, nil).Times(1)
+		store.EXPECT().GetDevProject(gomock.Any(), "stale").DoAndReturn(func(context.Context, string) (*model.Project, error) {
+			resyncs <- struct{}{}
+			return nil, errors.New("unavailable")
+		}).Times(1)
+
+		assert.True(t, staleness.Check(ctx, "stale"))
+		<-resyncs
+		time.Sleep(20 * time.Millisecond)
+		assert.True(t, staleness.Check(ctx, "stale"))
+		time.Sleep(20 * time.Millisecond)
+		assert.Empty(t, resyncs, "the project was resynced again right after a failure")
+	})
+}
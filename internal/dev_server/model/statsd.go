@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// Formats StatsdMetrics can send metrics in.
+const (
+	// StatsdFormatStatsd is plain StatsD, which has no tags, so they're left off.
+	StatsdFormatStatsd = "statsd"
+	// StatsdFormatDogStatsd is DogStatsD, the Datadog agent's extension of StatsD with tags.
+	StatsdFormatDogStatsd = "dogstatsd"
+)
+
+// StatsdMetrics sends metrics over UDP to a StatsD server or Datadog agent. Sending never blocks the server: metrics
+// that can't be sent are dropped.
+type StatsdMetrics struct {
+	conn      net.Conn
+	prefix    string
+	dogstatsd bool
+	tags      []string
+	// failing is set once sending fails, so that a missing agent is only logged about once
+	failing atomic.Bool
+}
+
+var _ Metrics = &StatsdMetrics{}
+
+// NewStatsdMetrics sends metrics to the StatsD server at address, a host:port. Each metric's name starts with prefix,
+// and with StatsdFormatDogStatsd it's tagged with tags as well as its own.
+func NewStatsdMetrics(address, prefix, format string, tags []string) (*StatsdMetrics, error) {
+	if format != StatsdFormatStatsd && format != StatsdFormatDogStatsd {
+		return nil, fmt.Errorf("unknown statsd format %q, expected %s or %s", format, StatsdFormatStatsd, StatsdFormatDogStatsd)
+	}
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &StatsdMetrics{conn: conn, prefix: prefix, dogstatsd: format == StatsdFormatDogStatsd, tags: tags}, nil
+}
+
+func (s *StatsdMetrics) Count(name string, value int64, tags ...string) {
+	s.send(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+func (s *StatsdMetrics) Gauge(name string, value float64, tags ...string) {
+	s.send(name, strconv.FormatFloat(value, 'f', -1, 64), "g", tags)
+}
+
+func (s *StatsdMetrics) Timing(name string, d time.Duration, tags ...string) {
+	s.send(name, strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', -1, 64), "ms", tags)
+}
+
+func (s *StatsdMetrics) Close() error {
+	return s.conn.Close()
+}
+
+func (s *StatsdMetrics) send(name, value, metricType string, tags []string) {
+	var line strings.Builder
+	line.WriteString(s.prefix)
+	line.WriteString(name)
+	line.WriteString(":")
+	line.WriteString(value)
+	line.WriteString("|")
+	line.WriteString(metricType)
+	if s.dogstatsd && len(s.tags)+len(tags) > 0 {
+		line.WriteString("|#")
+		line.WriteString(strings.Join(append(append([]string{}, s.tags...), tags...), ","))
+	}
+	if _, err := s.conn.Write([]byte(line.String())); err != nil {
+		if !s.failing.Swap(true) {
+			logs.Printf(logs.Warn, "", "Unable to send metrics to statsd: %s", err)
+		}
+		return
+	}
+	s.failing.Store(false)
+}
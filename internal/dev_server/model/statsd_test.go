@@ -0,0 +1,53 @@
+package model_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestStatsdMetrics(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := func(t *testing.T) string {
+		require.NoError(t, listener.SetReadDeadline(time.Now().Add(time.Second)))
+		buf := make([]byte, 1024)
+		n, _, err := listener.ReadFrom(buf)
+		require.NoError(t, err)
+		return string(buf[:n])
+	}
+
+	t.Run("sends DogStatsD with tags", func(t *testing.T) {
+		metrics, err := model.NewStatsdMetrics(listener.LocalAddr().String(), "ldcli.", model.StatsdFormatDogStatsd, []string{"env:ci"})
+		require.NoError(t, err)
+		defer metrics.Close()
+
+		metrics.Count(model.MetricFlagEvaluations, 3, model.Tag("flag", "new-checkout"))
+		assert.Equal(t, "ldcli.flag_evaluations:3|c|#env:ci,flag:new-checkout", received(t))
+		metrics.Gauge(model.MetricStreamClients, 2)
+		assert.Equal(t, "ldcli.stream.clients:2|g|#env:ci", received(t))
+		metrics.Timing(model.MetricSyncDuration, 1500*time.Microsecond)
+		assert.Equal(t, "ldcli.sync.duration:1.5|ms|#env:ci", received(t))
+	})
+
+	t.Run("leaves tags off plain StatsD", func(t *testing.T) {
+		metrics, err := model.NewStatsdMetrics(listener.LocalAddr().String(), "", model.StatsdFormatStatsd, []string{"env:ci"})
+		require.NoError(t, err)
+		defer metrics.Close()
+
+		metrics.Count(model.MetricFlagEvaluations, 1, model.Tag("flag", "new-checkout"))
+		assert.Equal(t, "flag_evaluations:1|c", received(t))
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		_, err := model.NewStatsdMetrics(listener.LocalAddr().String(), "", "graphite", nil)
+		assert.Error(t, err)
+	})
+}
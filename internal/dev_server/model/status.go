@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// ProjectStatus reports problems with a project's data that the dev server worked around rather than failing on.
+type ProjectStatus struct {
+	// FlagsWithSynthesizedVariationIds are the keys of flags whose variations had no IDs in LaunchDarkly. Their
+	// variations can still be overridden by value, but their IDs won't match any variation in LaunchDarkly.
+	FlagsWithSynthesizedVariationIds []string
+	// Orphaned is set if the project's source was deleted or renamed in LaunchDarkly.
+	Orphaned *Orphaned
+	// SyncStatus is the result of the most recent attempt to sync the project, if it's been recorded.
+	SyncStatus *SyncStatus
+	// Stale is set if the project hasn't been synced within the configured staleness threshold.
+	Stale bool
+}
+
+func GetProjectStatus(ctx context.Context, projectKey string) (ProjectStatus, error) {
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return ProjectStatus{}, err
+	}
+	availableVariations, err := store.GetAvailableVariationsForProject(ctx, projectKey)
+	if err != nil {
+		return ProjectStatus{}, err
+	}
+	status := ProjectStatus{FlagsWithSynthesizedVariationIds: []string{}, Orphaned: project.Orphaned, SyncStatus: project.SyncStatus}
+	status.Stale = StalenessFromContext(ctx).IsStale(*project, time.Now())
+	for flagKey, variations := range availableVariations {
+		for _, variation := range variations {
+			if variation.HasSynthesizedId() {
+				status.FlagsWithSynthesizedVariationIds = append(status.FlagsWithSynthesizedVariationIds, flagKey)
+				break
+			}
+		}
+	}
+	sort.Strings(status.FlagsWithSynthesizedVariationIds)
+	return status, nil
+}
@@ -0,0 +1,45 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestGetProjectStatus(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+	projKey := "proj"
+
+	t.Run("returns ErrNotFound for missing projects", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(nil, model.NewErrNotFound("project", projKey))
+
+		_, err := model.GetProjectStatus(ctx, projKey)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("lists flags with synthesized variation IDs", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(&model.Project{Key: projKey}, nil)
+		store.EXPECT().GetAvailableVariationsForProject(gomock.Any(), projKey).Return(map[string][]model.Variation{
+			"with-ids": {
+				{Id: "abc", Value: ldvalue.Bool(true)},
+			},
+			"without-ids": {
+				{Id: "ldcli-synthesized-0", Value: ldvalue.Bool(true)},
+				{Id: "ldcli-synthesized-1", Value: ldvalue.Bool(false)},
+			},
+		}, nil)
+
+		status, err := model.GetProjectStatus(ctx, projKey)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"without-ids"}, status.FlagsWithSynthesizedVariationIds)
+	})
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 )
 
 type ctxKey string
@@ -16,18 +19,91 @@ const ctxKeyStore = ctxKey("model.Store")
 
 type Store interface {
 	// DeactivateOverride deactivates the override for the flag, returning the updated version of the override.
-	// ErrNotFound is returned if there isn't an override for the flag.
+	// ErrNotFound is returned if there isn't an override for the flag, and ErrLocked if it's locked.
 	DeactivateOverride(ctx context.Context, projectKey, flagKey string) (int, error)
 	GetDevProjectKeys(ctx context.Context) ([]string, error)
 	// GetDevProject fetches the project based on the projectKey. If it doesn't exist, ErrNotFound is returned
 	GetDevProject(ctx context.Context, projectKey string) (*Project, error)
+	// UpdateProject replaces the project's synced state, removing unlocked overrides for flags that are no longer in it.
 	UpdateProject(ctx context.Context, project Project) (bool, error)
-	DeleteDevProject(ctx context.Context, projectKey string) (bool, error)
+	// OrphanProject marks the project as orphaned, leaving the rest of it alone. The mark is cleared when the project is
+	// next updated with UpdateProject. It returns false if the project doesn't exist.
+	OrphanProject(ctx context.Context, projectKey string, orphaned Orphaned) (bool, error)
+	// SetEnvironmentKeys replaces the project's prefetched environment keys, leaving the rest of it alone. UpdateProject
+	// doesn't change them. It returns false if the project doesn't exist.
+	SetEnvironmentKeys(ctx context.Context, projectKey string, keys map[string]EnvironmentKeys) (bool, error)
+	// ArchiveProject sets when the project was archived, or with nil unarchives it, leaving the rest of it alone.
+	// UpdateProject doesn't change it. It returns false if the project doesn't exist.
+	ArchiveProject(ctx context.Context, projectKey string, archivedAt *time.Time) (bool, error)
+	// SetSyncStatus records the result of the latest attempt to sync the project, leaving the rest of it alone.
+	// UpdateProject doesn't change it, but InsertProject stores the project's initial status. It returns false if the
+	// project doesn't exist.
+	SetSyncStatus(ctx context.Context, projectKey string, status SyncStatus) (bool, error)
+	// DeleteDevProject deletes the project along with its overrides, available variations, aliases, history, schedules,
+	// and saved contexts, all or nothing, and returns how many of each were removed. It returns false if the project
+	// doesn't exist.
+	DeleteDevProject(ctx context.Context, projectKey string) (ProjectDeletion, bool, error)
 	// InsertProject inserts the project. If it already exists, ErrAlreadyExists is returned
 	InsertProject(ctx context.Context, project Project) error
+	// UpsertOverride writes the override. ErrLocked is returned if the flag's existing override is locked.
 	UpsertOverride(ctx context.Context, override Override) (Override, error)
+	// SetOverrideLocked locks or unlocks the flag's override without changing its version. ErrNotFound is returned if
+	// there isn't an override for the flag.
+	SetOverrideLocked(ctx context.Context, projectKey, flagKey string, locked bool) (Override, error)
+	// GetOverridesForProject returns the manual overrides for the project, which make up the user layer.
 	GetOverridesForProject(ctx context.Context, projectKey string) (Overrides, error)
+	GetScenarioOverridesForProject(ctx context.Context, projectKey string) (Overrides, error)
+	// ReplaceScenarioOverrides makes values the project's scenario layer. Scenario overrides for flags that aren't in
+	// values are deactivated rather than removed so that flag versions keep increasing.
+	ReplaceScenarioOverrides(ctx context.Context, projectKey string, values map[string]ldvalue.Value) (Overrides, error)
 	GetAvailableVariationsForProject(ctx context.Context, projectKey string) (map[string][]Variation, error)
+	// GetProjectStateAt returns the flag state that was synced from the source environment as of the given time, along
+	// with the overrides as they were at that time. ErrNotFound is returned if the project has no history that old.
+	GetProjectStateAt(ctx context.Context, projectKey string, at time.Time) (FlagsState, LayeredOverrides, error)
+	// GetSnapshots returns the flag states recorded for the project that GetProjectStateAt reconstructs it from, most
+	// recent first.
+	GetSnapshots(ctx context.Context, projectKey string) ([]Snapshot, error)
+	// InsertSnapshot records the project's current flag state as a snapshot. ErrNotFound is returned if the project
+	// doesn't exist.
+	InsertSnapshot(ctx context.Context, projectKey string) (Snapshot, error)
+	// PruneHistory deletes the project's flag state and override history recorded before the cutoff, except for the
+	// latest flag state and latest change to each override before it, which GetProjectStateAt needs to reconstruct the
+	// project as of the cutoff. It returns how many entries were deleted.
+	PruneHistory(ctx context.Context, projectKey string, before time.Time) (int, error)
+	// GetAuditLog returns the recorded changes to the project's overrides in both layers that match query, most recent
+	// first.
+	GetAuditLog(ctx context.Context, projectKey string, query AuditQuery) ([]AuditEntry, error)
+
+	// GetOverrideSchedules returns the project's pending override schedules, ordered by flag key.
+	GetOverrideSchedules(ctx context.Context, projectKey string) ([]OverrideSchedule, error)
+	// UpsertOverrideSchedule writes the schedule, replacing any the flag already has.
+	UpsertOverrideSchedule(ctx context.Context, schedule OverrideSchedule) error
+	DeleteOverrideSchedule(ctx context.Context, projectKey, flagKey string) (bool, error)
+
+	// GetSavedContexts returns the contexts saved with the project, ordered by name.
+	GetSavedContexts(ctx context.Context, projectKey string) ([]SavedContext, error)
+	// GetSavedContext fetches the context saved with the project under name. If there isn't one, ErrNotFound is returned
+	GetSavedContext(ctx context.Context, projectKey, name string) (SavedContext, error)
+	// UpsertSavedContext writes the saved context, replacing whichever the project had under the same name.
+	UpsertSavedContext(ctx context.Context, savedContext SavedContext) error
+	DeleteSavedContext(ctx context.Context, projectKey, name string) (bool, error)
+
+	// GetFlagTriggers returns the project's triggers, ordered by flag key and then by when they were created.
+	GetFlagTriggers(ctx context.Context, projectKey string) ([]FlagTrigger, error)
+	// GetFlagTrigger fetches the trigger with the ID from any project. If there isn't one, ErrNotFound is returned
+	GetFlagTrigger(ctx context.Context, id string) (FlagTrigger, error)
+	InsertFlagTrigger(ctx context.Context, trigger FlagTrigger) error
+	DeleteFlagTrigger(ctx context.Context, id string) (bool, error)
+
+	GetAliases(ctx context.Context) ([]Alias, error)
+	// GetAlias fetches the alias. If it doesn't exist, ErrNotFound is returned
+	GetAlias(ctx context.Context, alias string) (Alias, error)
+	UpsertAlias(ctx context.Context, alias Alias) error
+	DeleteAlias(ctx context.Context, alias string) (bool, error)
+
+	// WithTx runs fn in a transaction, committing it if fn returns nil and rolling it back otherwise. Store calls made
+	// with the context fn is given are part of the transaction, including ones that are transactions of their own.
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 
 	CreateBackup(ctx context.Context) (io.ReadCloser, int64, error)
 	RestoreBackup(ctx context.Context, stream io.Reader) (string, error)
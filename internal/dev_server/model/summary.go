@@ -0,0 +1,61 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// ProjectSummary is a handful of counts about a project, small enough for status bar widgets to poll every few
+// seconds.
+type ProjectSummary struct {
+	TotalFlags int
+	// ActiveOverrides is the number of flags with an active override in any layer.
+	ActiveOverrides int
+	// Conflicts is the number of flags with active overrides in both the scenario and user layers, where the user
+	// override hides the scenario's value.
+	Conflicts int
+	// StalenessSeconds is how long it's been since the project was synced from LaunchDarkly.
+	StalenessSeconds int
+	// Orphaned is whether the project's source was deleted or renamed in LaunchDarkly, in which case it's no longer
+	// synced.
+	Orphaned bool
+}
+
+func GetProjectSummary(ctx context.Context, projectKey string) (ProjectSummary, error) {
+	store := StoreFromContext(ctx)
+	project, err := store.GetDevProject(ctx, projectKey)
+	if err != nil {
+		return ProjectSummary{}, err
+	}
+	user, err := store.GetOverridesForProject(ctx, projectKey)
+	if err != nil {
+		return ProjectSummary{}, err
+	}
+	scenario, err := store.GetScenarioOverridesForProject(ctx, projectKey)
+	if err != nil {
+		return ProjectSummary{}, err
+	}
+
+	summary := ProjectSummary{
+		TotalFlags:       len(project.AllFlagsState),
+		StalenessSeconds: int(time.Since(project.LastSyncTime).Seconds()),
+		Orphaned:         project.Orphaned != nil,
+	}
+	overridden := make(map[string]bool)
+	for _, override := range scenario {
+		if override.Active {
+			overridden[override.FlagKey] = false
+		}
+	}
+	for _, override := range user {
+		if !override.Active {
+			continue
+		}
+		if _, inScenario := overridden[override.FlagKey]; inScenario {
+			summary.Conflicts++
+		}
+		overridden[override.FlagKey] = true
+	}
+	summary.ActiveOverrides = len(overridden)
+	return summary, nil
+}
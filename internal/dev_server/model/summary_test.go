@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestGetProjectSummary(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	ctx := model.ContextWithStore(context.Background(), store)
+	projKey := "proj"
+
+	t.Run("returns ErrNotFound for missing projects", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(nil, model.NewErrNotFound("project", projKey))
+
+		_, err := model.GetProjectSummary(ctx, projKey)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+
+	t.Run("counts flags, overrides, and conflicts", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(&model.Project{
+			Key:          projKey,
+			LastSyncTime: time.Now().Add(-90 * time.Second),
+			AllFlagsState: model.FlagsState{
+				"a": model.FlagState{Value: ldvalue.Bool(true)},
+				"b": model.FlagState{Value: ldvalue.Bool(true)},
+				"c": model.FlagState{Value: ldvalue.Bool(true)},
+				"d": model.FlagState{Value: ldvalue.Bool(true)},
+			},
+		}, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), projKey).Return(model.Overrides{
+			{ProjectKey: projKey, FlagKey: "a", Value: ldvalue.Bool(false), Active: true},
+			{ProjectKey: projKey, FlagKey: "b", Value: ldvalue.Bool(false), Active: true},
+			{ProjectKey: projKey, FlagKey: "d", Value: ldvalue.Bool(false), Active: false},
+		}, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(model.Overrides{
+			{ProjectKey: projKey, FlagKey: "b", Value: ldvalue.Bool(false), Active: true},
+			{ProjectKey: projKey, FlagKey: "c", Value: ldvalue.Bool(false), Active: true},
+		}, nil)
+
+		summary, err := model.GetProjectSummary(ctx, projKey)
+		require.NoError(t, err)
+		assert.Equal(t, 4, summary.TotalFlags)
+		assert.Equal(t, 3, summary.ActiveOverrides)
+		assert.Equal(t, 1, summary.Conflicts)
+		assert.InDelta(t, 90, summary.StalenessSeconds, 2)
+	})
+}
@@ -2,12 +2,14 @@ package model
 
 import (
 	"context"
-	"log"
 
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 type FlagValue = ldvalue.Value
@@ -19,6 +21,10 @@ type InitialProjectSettings struct {
 	Context    *ldcontext.Context   `json:"context,omitempty"`
 	Overrides  map[string]FlagValue `json:"overrides,omitempty"`
 	SyncOnce   bool
+	// PrefetchKeys fetches and caches the keys for every environment of the project once it's created or synced.
+	PrefetchKeys bool
+	// FlagFilter limits which flags are synced. If nil, an existing project keeps its filter.
+	FlagFilter *FlagFilter
 }
 
 func CreateOrSyncProject(ctx context.Context, settings InitialProjectSettings) error {
@@ -26,9 +32,9 @@ func CreateOrSyncProject(ctx context.Context, settings InitialProjectSettings) e
 		return nil
 	}
 
-	log.Printf("Initial project [%s] with env [%s]", settings.ProjectKey, settings.EnvKey)
+	logs.Printf(logs.Info, settings.ProjectKey, "Initial project [%s] with env [%s]", settings.ProjectKey, settings.EnvKey)
 	var project Project
-	project, createError := CreateProject(ctx, settings.ProjectKey, settings.EnvKey, settings.Context)
+	project, createError := CreateProject(ctx, settings.ProjectKey, settings.EnvKey, settings.Context, lo.FromPtr(settings.FlagFilter))
 	if createError != nil {
 		if !errors.As(createError, &ErrAlreadyExists{}) {
 			return createError
@@ -37,13 +43,13 @@ func CreateOrSyncProject(ctx context.Context, settings InitialProjectSettings) e
 		// If set, don't resync and don't apply overrides because whatever you have locally
 		// is already set up with what you want.
 		if settings.SyncOnce {
-			log.Printf("Project [%s] exists, but --sync-once flag is set, skipping refresh", settings.ProjectKey)
+			logs.Printf(logs.Info, settings.ProjectKey, "Project [%s] exists, but --sync-once flag is set, skipping refresh", settings.ProjectKey)
 			return nil
 		}
 
-		log.Printf("Project [%s] exists, refreshing data", settings.ProjectKey)
+		logs.Printf(logs.Info, settings.ProjectKey, "Project [%s] exists, refreshing data", settings.ProjectKey)
 		var updateErr error
-		project, updateErr = UpdateProject(ctx, settings.ProjectKey, settings.Context, &settings.EnvKey)
+		project, updateErr = UpdateProject(ctx, settings.ProjectKey, settings.Context, &settings.EnvKey, settings.FlagFilter)
 		if updateErr != nil {
 			return updateErr
 		}
@@ -55,6 +61,35 @@ func CreateOrSyncProject(ctx context.Context, settings InitialProjectSettings) e
 		}
 	}
 
-	log.Printf("Successfully synced Initial project [%s]", project.Key)
+	if settings.PrefetchKeys {
+		PrefetchEnvironmentKeysInBackground(ctx, project.Key)
+	}
+
+	logs.Printf(logs.Info, project.Key, "Successfully synced Initial project [%s]", project.Key)
 	return nil
 }
+
+// SyncAllProjects syncs every project from its source environment, e.g. on a schedule. Projects that can't be synced on
+// their own are skipped: archived and orphaned projects, projects imported without a source, and linked clones, which
+// are synced along with their base. A project that fails to sync doesn't stop the others.
+func SyncAllProjects(ctx context.Context) {
+	store := StoreFromContext(ctx)
+	keys, err := store.GetDevProjectKeys(ctx)
+	if err != nil {
+		logs.Printf(logs.Error, "", "unable to list projects to sync: %+v", err)
+		return
+	}
+	for _, key := range keys {
+		project, err := store.GetDevProject(ctx, key)
+		if err != nil {
+			logs.Printf(logs.Error, key, "unable to get project %s to sync: %+v", key, err)
+			continue
+		}
+		if project.ArchivedAt != nil || project.Orphaned != nil || project.SourceEnvironmentKey == "" || project.BaseProjectKey != "" {
+			continue
+		}
+		if _, err := UpdateProject(ctx, key, nil, nil, nil); err != nil {
+			logs.Printf(logs.Error, key, "unable to sync project %s: %+v", key, err)
+		}
+	}
+}
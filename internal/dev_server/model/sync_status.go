@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// SyncStatus records the most recent attempt to sync a project from its source environment, whether or not it
+// succeeded, so that a failing background sync doesn't go unnoticed.
+type SyncStatus struct {
+	AttemptedAt time.Time
+	Duration    time.Duration
+	// Error is the reason the sync failed. It's empty if the sync succeeded.
+	Error string
+}
+
+func (s SyncStatus) Succeeded() bool {
+	return s.Error == ""
+}
+
+// syncWithStatus refreshes the project from its source environment and returns the status of the attempt along with
+// the error, if any.
+func (project *Project) syncWithStatus(ctx context.Context) (SyncStatus, error) {
+	ctx, span := startSpan(ctx, "sync project", ProjectKeyAttribute.String(project.Key), SourceEnvironmentKeyAttribute.String(project.SourceEnvironmentKey))
+	start := time.Now()
+	err := project.refreshExternalState(ctx)
+	endSpan(span, err)
+	status := SyncStatus{AttemptedAt: start, Duration: time.Since(start)}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	MetricsFromContext(ctx).Timing(MetricSyncDuration, status.Duration,
+		Tag("project", project.Key), Tag("succeeded", strconv.FormatBool(status.Succeeded())))
+	project.SyncStatus = &status
+	return status, err
+}
+
+// recordSyncStatus stores the status of a sync attempt. Failing to record it only gets logged so that it doesn't hide
+// the result of the sync itself.
+func recordSyncStatus(ctx context.Context, projectKey string, status SyncStatus) {
+	if _, err := StoreFromContext(ctx).SetSyncStatus(ctx, projectKey, status); err != nil {
+		logs.Printf(logs.Error, projectKey, "unable to record sync status for project %s: %+v", projectKey, err)
+	}
+	if !status.Succeeded() {
+		logs.Printf(logs.Error, projectKey, "ERROR: sync of project [%s] failed after %s: %s", projectKey, status.Duration, status.Error)
+	}
+}
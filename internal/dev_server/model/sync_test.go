@@ -3,9 +3,11 @@ package model_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	ldapi "github.com/launchdarkly/api-client-go/v14"
@@ -13,6 +15,7 @@ import (
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/go-server-sdk/v7/interfaces/flagstate"
 	adapters_mocks "github.com/launchdarkly/ldcli/internal/dev_server/adapters/mocks"
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
 )
@@ -23,6 +26,8 @@ func TestInitialSync(t *testing.T) {
 	mockController := gomock.NewController(t)
 	observers := model.NewObservers()
 	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	store := mocks.NewMockStore(mockController)
 	ctx = model.ContextWithStore(ctx, store)
 	ctx = model.SetObserversOnContext(ctx, observers)
@@ -80,7 +85,7 @@ func TestInitialSync(t *testing.T) {
 	t.Run("Returns error if it can't fetch flags", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(nil, errors.New("fetch flags failed"))
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(nil, errors.New("fetch flags failed"))
 		input := model.InitialProjectSettings{
 			Enabled:    true,
 			ProjectKey: projKey,
@@ -96,7 +101,7 @@ func TestInitialSync(t *testing.T) {
 	t.Run("Returns error if it fails to insert the project", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(errors.New("insert fails"))
 
 		input := model.InitialProjectSettings{
@@ -114,7 +119,7 @@ func TestInitialSync(t *testing.T) {
 	t.Run("Successfully creates project", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
 
 		input := model.InitialProjectSettings{
@@ -151,9 +156,10 @@ func TestInitialSync(t *testing.T) {
 
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(nil)
 		store.EXPECT().UpsertOverride(gomock.Any(), override).Return(override, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), projKey).Return(nil, nil)
 		store.EXPECT().GetDevProject(gomock.Any(), projKey).Return(&proj, nil)
 
 		input := model.InitialProjectSettings{
@@ -173,7 +179,7 @@ func TestInitialSync(t *testing.T) {
 	t.Run("If SyncOnce is set and the project already exists, return early", func(t *testing.T) {
 		api.EXPECT().GetSdkKey(gomock.Any(), projKey, sourceEnvKey).Return(sdkKey, nil)
 		sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), sdkKey).Return(allFlagsState, nil)
-		api.EXPECT().GetAllFlags(gomock.Any(), projKey).Return(allFlags, nil)
+		api.EXPECT().GetAllFlags(gomock.Any(), projKey, gomock.Any()).Return(allFlags, nil)
 		store.EXPECT().InsertProject(gomock.Any(), gomock.Any()).Return(model.NewErrAlreadyExists("project", projKey))
 
 		input := model.InitialProjectSettings{
@@ -189,3 +195,43 @@ func TestInitialSync(t *testing.T) {
 	})
 
 }
+
+func TestSyncAllProjects(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	ctx, api, sdk := adapters_mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	for _, project := range []model.Project{
+		{Key: "synced", SourceEnvironmentKey: "env"},
+		{Key: "imported"},
+		{Key: "archived", SourceEnvironmentKey: "env"},
+		{Key: "orphaned", SourceEnvironmentKey: "env"},
+		{Key: "clone", SourceEnvironmentKey: "env", BaseProjectKey: "synced"},
+	} {
+		project.Context = ldcontext.New("user")
+		project.AllFlagsState = model.FlagsState{}
+		require.NoError(t, store.InsertProject(ctx, project))
+	}
+	archivedAt := time.Now()
+	_, err = store.ArchiveProject(ctx, "archived", &archivedAt)
+	require.NoError(t, err)
+	_, err = store.OrphanProject(ctx, "orphaned", model.Orphaned{Detail: "project not found", Since: archivedAt})
+	require.NoError(t, err)
+
+	api.EXPECT().GetSdkKey(gomock.Any(), "synced", "env").Return("sdk-key", nil)
+	sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), "sdk-key").Return(
+		flagstate.NewAllFlagsBuilder().AddFlag("flag", flagstate.FlagState{Value: ldvalue.Bool(true)}).Build(), nil)
+	api.EXPECT().GetAllFlags(gomock.Any(), "synced", gomock.Any()).Return(nil, nil)
+
+	model.SyncAllProjects(ctx)
+
+	project, err := store.GetDevProject(ctx, "synced")
+	require.NoError(t, err)
+	assert.Equal(t, ldvalue.Bool(true), project.AllFlagsState["flag"].Value)
+}
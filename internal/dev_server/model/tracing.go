@@ -0,0 +1,288 @@
+package model
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+var tracer = otel.Tracer("github.com/launchdarkly/ldcli/internal/dev_server/model")
+
+// Attributes the dev server's spans describe what they're for with.
+const (
+	ProjectKeyAttribute           = attribute.Key("ldcli.project.key")
+	SourceEnvironmentKeyAttribute = attribute.Key("ldcli.source_environment.key")
+	FlagKeyAttribute              = attribute.Key("ldcli.flag.key")
+)
+
+// startSpan starts a span with the global tracer provider, which doesn't record anything unless the dev server was
+// started with tracing configured.
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// endSpan ends the span, marking it failed if err is. Not finding something is often expected, e.g. when checking
+// whether a project exists, so ErrNotFound doesn't count.
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.As(err, &ErrNotFound{}) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceStore wraps the store so that each call to it is a span. A CachingStore keeps caching, with the store it wraps
+// traced instead, so only calls that reach the underlying store are traced.
+func TraceStore(store Store) Store {
+	if cache, ok := store.(*CachingStore); ok {
+		cache.Store = tracingStore{Store: cache.Store}
+		return cache
+	}
+	return tracingStore{Store: store}
+}
+
+type tracingStore struct {
+	Store
+}
+
+func (s tracingStore) DeactivateOverride(ctx context.Context, projectKey, flagKey string) (version int, err error) {
+	ctx, span := startSpan(ctx, "store.DeactivateOverride", ProjectKeyAttribute.String(projectKey), FlagKeyAttribute.String(flagKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.DeactivateOverride(ctx, projectKey, flagKey)
+}
+
+func (s tracingStore) GetDevProjectKeys(ctx context.Context) (keys []string, err error) {
+	ctx, span := startSpan(ctx, "store.GetDevProjectKeys")
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetDevProjectKeys(ctx)
+}
+
+func (s tracingStore) GetDevProject(ctx context.Context, projectKey string) (project *Project, err error) {
+	ctx, span := startSpan(ctx, "store.GetDevProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetDevProject(ctx, projectKey)
+}
+
+func (s tracingStore) UpdateProject(ctx context.Context, project Project) (updated bool, err error) {
+	ctx, span := startSpan(ctx, "store.UpdateProject", ProjectKeyAttribute.String(project.Key))
+	defer func() { endSpan(span, err) }()
+	return s.Store.UpdateProject(ctx, project)
+}
+
+func (s tracingStore) OrphanProject(ctx context.Context, projectKey string, orphaned Orphaned) (updated bool, err error) {
+	ctx, span := startSpan(ctx, "store.OrphanProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.OrphanProject(ctx, projectKey, orphaned)
+}
+
+func (s tracingStore) SetEnvironmentKeys(ctx context.Context, projectKey string, keys map[string]EnvironmentKeys) (updated bool, err error) {
+	ctx, span := startSpan(ctx, "store.SetEnvironmentKeys", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.SetEnvironmentKeys(ctx, projectKey, keys)
+}
+
+func (s tracingStore) ArchiveProject(ctx context.Context, projectKey string, archivedAt *time.Time) (updated bool, err error) {
+	ctx, span := startSpan(ctx, "store.ArchiveProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.ArchiveProject(ctx, projectKey, archivedAt)
+}
+
+func (s tracingStore) SetSyncStatus(ctx context.Context, projectKey string, status SyncStatus) (updated bool, err error) {
+	ctx, span := startSpan(ctx, "store.SetSyncStatus", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.SetSyncStatus(ctx, projectKey, status)
+}
+
+func (s tracingStore) DeleteDevProject(ctx context.Context, projectKey string) (deletion ProjectDeletion, deleted bool, err error) {
+	ctx, span := startSpan(ctx, "store.DeleteDevProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.DeleteDevProject(ctx, projectKey)
+}
+
+func (s tracingStore) InsertProject(ctx context.Context, project Project) (err error) {
+	ctx, span := startSpan(ctx, "store.InsertProject", ProjectKeyAttribute.String(project.Key))
+	defer func() { endSpan(span, err) }()
+	return s.Store.InsertProject(ctx, project)
+}
+
+func (s tracingStore) UpsertOverride(ctx context.Context, override Override) (upserted Override, err error) {
+	ctx, span := startSpan(ctx, "store.UpsertOverride", ProjectKeyAttribute.String(override.ProjectKey), FlagKeyAttribute.String(override.FlagKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.UpsertOverride(ctx, override)
+}
+
+func (s tracingStore) SetOverrideLocked(ctx context.Context, projectKey, flagKey string, locked bool) (override Override, err error) {
+	ctx, span := startSpan(ctx, "store.SetOverrideLocked", ProjectKeyAttribute.String(projectKey), FlagKeyAttribute.String(flagKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.SetOverrideLocked(ctx, projectKey, flagKey, locked)
+}
+
+func (s tracingStore) GetOverridesForProject(ctx context.Context, projectKey string) (overrides Overrides, err error) {
+	ctx, span := startSpan(ctx, "store.GetOverridesForProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetOverridesForProject(ctx, projectKey)
+}
+
+func (s tracingStore) GetScenarioOverridesForProject(ctx context.Context, projectKey string) (overrides Overrides, err error) {
+	ctx, span := startSpan(ctx, "store.GetScenarioOverridesForProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetScenarioOverridesForProject(ctx, projectKey)
+}
+
+func (s tracingStore) ReplaceScenarioOverrides(ctx context.Context, projectKey string, values map[string]ldvalue.Value) (overrides Overrides, err error) {
+	ctx, span := startSpan(ctx, "store.ReplaceScenarioOverrides", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.ReplaceScenarioOverrides(ctx, projectKey, values)
+}
+
+func (s tracingStore) GetAvailableVariationsForProject(ctx context.Context, projectKey string) (variations map[string][]Variation, err error) {
+	ctx, span := startSpan(ctx, "store.GetAvailableVariationsForProject", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetAvailableVariationsForProject(ctx, projectKey)
+}
+
+func (s tracingStore) GetProjectStateAt(ctx context.Context, projectKey string, at time.Time) (flagsState FlagsState, overrides LayeredOverrides, err error) {
+	ctx, span := startSpan(ctx, "store.GetProjectStateAt", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetProjectStateAt(ctx, projectKey, at)
+}
+
+func (s tracingStore) GetSnapshots(ctx context.Context, projectKey string) (snapshots []Snapshot, err error) {
+	ctx, span := startSpan(ctx, "store.GetSnapshots", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetSnapshots(ctx, projectKey)
+}
+
+func (s tracingStore) InsertSnapshot(ctx context.Context, projectKey string) (snapshot Snapshot, err error) {
+	ctx, span := startSpan(ctx, "store.InsertSnapshot", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.InsertSnapshot(ctx, projectKey)
+}
+
+func (s tracingStore) PruneHistory(ctx context.Context, projectKey string, before time.Time) (pruned int, err error) {
+	ctx, span := startSpan(ctx, "store.PruneHistory", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.PruneHistory(ctx, projectKey, before)
+}
+
+func (s tracingStore) GetAuditLog(ctx context.Context, projectKey string, query AuditQuery) (entries []AuditEntry, err error) {
+	ctx, span := startSpan(ctx, "store.GetAuditLog", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetAuditLog(ctx, projectKey, query)
+}
+
+func (s tracingStore) GetOverrideSchedules(ctx context.Context, projectKey string) (schedules []OverrideSchedule, err error) {
+	ctx, span := startSpan(ctx, "store.GetOverrideSchedules", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetOverrideSchedules(ctx, projectKey)
+}
+
+func (s tracingStore) UpsertOverrideSchedule(ctx context.Context, schedule OverrideSchedule) (err error) {
+	ctx, span := startSpan(ctx, "store.UpsertOverrideSchedule", ProjectKeyAttribute.String(schedule.ProjectKey), FlagKeyAttribute.String(schedule.FlagKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.UpsertOverrideSchedule(ctx, schedule)
+}
+
+func (s tracingStore) DeleteOverrideSchedule(ctx context.Context, projectKey, flagKey string) (deleted bool, err error) {
+	ctx, span := startSpan(ctx, "store.DeleteOverrideSchedule", ProjectKeyAttribute.String(projectKey), FlagKeyAttribute.String(flagKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.DeleteOverrideSchedule(ctx, projectKey, flagKey)
+}
+
+func (s tracingStore) GetSavedContexts(ctx context.Context, projectKey string) (savedContexts []SavedContext, err error) {
+	ctx, span := startSpan(ctx, "store.GetSavedContexts", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetSavedContexts(ctx, projectKey)
+}
+
+func (s tracingStore) GetSavedContext(ctx context.Context, projectKey, name string) (savedContext SavedContext, err error) {
+	ctx, span := startSpan(ctx, "store.GetSavedContext", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetSavedContext(ctx, projectKey, name)
+}
+
+func (s tracingStore) UpsertSavedContext(ctx context.Context, savedContext SavedContext) (err error) {
+	ctx, span := startSpan(ctx, "store.UpsertSavedContext", ProjectKeyAttribute.String(savedContext.ProjectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.UpsertSavedContext(ctx, savedContext)
+}
+
+func (s tracingStore) DeleteSavedContext(ctx context.Context, projectKey, name string) (deleted bool, err error) {
+	ctx, span := startSpan(ctx, "store.DeleteSavedContext", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.DeleteSavedContext(ctx, projectKey, name)
+}
+
+func (s tracingStore) GetFlagTriggers(ctx context.Context, projectKey string) (triggers []FlagTrigger, err error) {
+	ctx, span := startSpan(ctx, "store.GetFlagTriggers", ProjectKeyAttribute.String(projectKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetFlagTriggers(ctx, projectKey)
+}
+
+func (s tracingStore) GetFlagTrigger(ctx context.Context, id string) (trigger FlagTrigger, err error) {
+	ctx, span := startSpan(ctx, "store.GetFlagTrigger")
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetFlagTrigger(ctx, id)
+}
+
+func (s tracingStore) InsertFlagTrigger(ctx context.Context, trigger FlagTrigger) (err error) {
+	ctx, span := startSpan(ctx, "store.InsertFlagTrigger", ProjectKeyAttribute.String(trigger.ProjectKey), FlagKeyAttribute.String(trigger.FlagKey))
+	defer func() { endSpan(span, err) }()
+	return s.Store.InsertFlagTrigger(ctx, trigger)
+}
+
+func (s tracingStore) DeleteFlagTrigger(ctx context.Context, id string) (deleted bool, err error) {
+	ctx, span := startSpan(ctx, "store.DeleteFlagTrigger")
+	defer func() { endSpan(span, err) }()
+	return s.Store.DeleteFlagTrigger(ctx, id)
+}
+
+func (s tracingStore) GetAliases(ctx context.Context) (aliases []Alias, err error) {
+	ctx, span := startSpan(ctx, "store.GetAliases")
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetAliases(ctx)
+}
+
+func (s tracingStore) GetAlias(ctx context.Context, alias string) (found Alias, err error) {
+	ctx, span := startSpan(ctx, "store.GetAlias")
+	defer func() { endSpan(span, err) }()
+	return s.Store.GetAlias(ctx, alias)
+}
+
+func (s tracingStore) UpsertAlias(ctx context.Context, alias Alias) (err error) {
+	ctx, span := startSpan(ctx, "store.UpsertAlias")
+	defer func() { endSpan(span, err) }()
+	return s.Store.UpsertAlias(ctx, alias)
+}
+
+func (s tracingStore) DeleteAlias(ctx context.Context, alias string) (deleted bool, err error) {
+	ctx, span := startSpan(ctx, "store.DeleteAlias")
+	defer func() { endSpan(span, err) }()
+	return s.Store.DeleteAlias(ctx, alias)
+}
+
+func (s tracingStore) WithTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	ctx, span := startSpan(ctx, "store.WithTx")
+	defer func() { endSpan(span, err) }()
+	return s.Store.WithTx(ctx, fn)
+}
+
+func (s tracingStore) CreateBackup(ctx context.Context) (backup io.ReadCloser, size int64, err error) {
+	ctx, span := startSpan(ctx, "store.CreateBackup")
+	defer func() { endSpan(span, err) }()
+	return s.Store.CreateBackup(ctx)
+}
+
+func (s tracingStore) RestoreBackup(ctx context.Context, stream io.Reader) (path string, err error) {
+	ctx, span := startSpan(ctx, "store.RestoreBackup")
+	defer func() { endSpan(span, err) }()
+	return s.Store.RestoreBackup(ctx, stream)
+}
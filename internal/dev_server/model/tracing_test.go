@@ -0,0 +1,81 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestTraceStore(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+
+	lastSpan := func(t *testing.T) sdktrace.ReadOnlySpan {
+		spans := recorder.Ended()
+		require.NotEmpty(t, spans)
+		return spans[len(spans)-1]
+	}
+
+	t.Run("makes each call a span", func(t *testing.T) {
+		store.EXPECT().DeactivateOverride(gomock.Any(), "proj", "flg").Return(2, nil)
+
+		version, err := model.TraceStore(store).DeactivateOverride(ctx, "proj", "flg")
+		require.NoError(t, err)
+		assert.Equal(t, 2, version)
+
+		span := lastSpan(t)
+		assert.Equal(t, "store.DeactivateOverride", span.Name())
+		assert.ElementsMatch(t, []attribute.KeyValue{
+			model.ProjectKeyAttribute.String("proj"),
+			model.FlagKeyAttribute.String("flg"),
+		}, span.Attributes())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+	})
+
+	t.Run("marks spans of failed calls", func(t *testing.T) {
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return(nil, errors.New("database is locked"))
+
+		_, err := model.TraceStore(store).GetDevProjectKeys(ctx)
+		require.Error(t, err)
+
+		span := lastSpan(t)
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "database is locked", span.Status().Description)
+	})
+
+	t.Run("doesn't mark spans for things that aren't found", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), "proj").Return(nil, model.NewErrNotFound("project", "proj"))
+
+		_, err := model.TraceStore(store).GetDevProject(ctx, "proj")
+		require.Error(t, err)
+
+		assert.Equal(t, codes.Unset, lastSpan(t).Status().Code)
+	})
+
+	t.Run("traces the store a caching store wraps", func(t *testing.T) {
+		cache := model.NewCachingStore(store)
+
+		traced := model.TraceStore(cache)
+		assert.Same(t, cache, traced)
+
+		store.EXPECT().GetDevProjectKeys(gomock.Any()).Return([]string{"proj"}, nil)
+		_, err := traced.GetDevProjectKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "store.GetDevProjectKeys", lastSpan(t).Name())
+	})
+}
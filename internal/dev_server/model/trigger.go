@@ -0,0 +1,115 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+// TriggerAction is what firing a flag trigger does to its flag, named like the instructions of LaunchDarkly's flag
+// triggers.
+type TriggerAction string
+
+const (
+	TriggerActionTurnFlagOn  TriggerAction = "turnFlagOn"
+	TriggerActionTurnFlagOff TriggerAction = "turnFlagOff"
+)
+
+// FlagTrigger is a local version of a LaunchDarkly flag trigger: a URL that automation, such as a load test harness
+// killing a feature, can POST to without credentials. Firing it overrides its boolean flag to true or false.
+type FlagTrigger struct {
+	ID         string
+	ProjectKey string
+	FlagKey    string
+	Action     TriggerAction
+	CreatedAt  time.Time
+}
+
+// ErrInvalidTrigger is returned for triggers with an unknown action or on flags that aren't boolean, which are the only
+// flags that can be turned on and off with an override.
+type ErrInvalidTrigger struct {
+	message string
+}
+
+func (e ErrInvalidTrigger) Error() string {
+	return e.message
+}
+
+// CreateFlagTrigger adds a trigger for the flag. ErrNotFound is returned if the project or flag doesn't exist.
+func CreateFlagTrigger(ctx context.Context, projectKey, flagKey string, action TriggerAction) (FlagTrigger, error) {
+	if action != TriggerActionTurnFlagOn && action != TriggerActionTurnFlagOff {
+		return FlagTrigger{}, errors.WithStack(ErrInvalidTrigger{
+			message: fmt.Sprintf("unknown trigger action %q, expected %s or %s", action, TriggerActionTurnFlagOn, TriggerActionTurnFlagOff),
+		})
+	}
+	project, err := getProjectWithFlag(ctx, projectKey, flagKey)
+	if err != nil {
+		return FlagTrigger{}, err
+	}
+	if !project.AllFlagsState[flagKey].Value.IsBool() {
+		return FlagTrigger{}, errors.WithStack(ErrInvalidTrigger{
+			message: fmt.Sprintf("flag %s isn't a boolean flag, so it can't be turned on and off by a trigger", flagKey),
+		})
+	}
+	trigger := FlagTrigger{
+		ID:         uuid.NewString(),
+		ProjectKey: projectKey,
+		FlagKey:    flagKey,
+		Action:     action,
+		CreatedAt:  time.Now(),
+	}
+	if err := StoreFromContext(ctx).InsertFlagTrigger(ctx, trigger); err != nil {
+		return FlagTrigger{}, err
+	}
+	logs.Printf(logs.Info, projectKey, "Added trigger to %s flag [%s] in project [%s]", action, flagKey, projectKey)
+	return trigger, nil
+}
+
+// GetFlagTriggers returns the project's triggers, ordered by flag key and then by when they were created. ErrNotFound
+// is returned if the project doesn't exist.
+func GetFlagTriggers(ctx context.Context, projectKey string) ([]FlagTrigger, error) {
+	store := StoreFromContext(ctx)
+	if _, err := store.GetDevProject(ctx, projectKey); err != nil {
+		return nil, err
+	}
+	return store.GetFlagTriggers(ctx, projectKey)
+}
+
+// DeleteFlagTrigger removes one of the project's triggers, so its URL stops working. ErrNotFound is returned if the
+// project doesn't have it.
+func DeleteFlagTrigger(ctx context.Context, projectKey, id string) error {
+	store := StoreFromContext(ctx)
+	trigger, err := store.GetFlagTrigger(ctx, id)
+	if err != nil {
+		return err
+	}
+	if trigger.ProjectKey != projectKey {
+		return errors.WithStack(NewErrNotFound("trigger", id))
+	}
+	if _, err := store.DeleteFlagTrigger(ctx, id); err != nil {
+		return err
+	}
+	logs.Printf(logs.Info, projectKey, "Deleted trigger for flag [%s] in project [%s]", trigger.FlagKey, projectKey)
+	return nil
+}
+
+// FireFlagTrigger overrides the trigger's flag to true if it turns the flag on, or false if it turns it off.
+// ErrNotFound is returned if there's no trigger with the ID, and ErrLocked if the flag's override is locked.
+func FireFlagTrigger(ctx context.Context, id string) (FlagTrigger, error) {
+	trigger, err := StoreFromContext(ctx).GetFlagTrigger(ctx, id)
+	if err != nil {
+		return FlagTrigger{}, err
+	}
+	value := ldvalue.Bool(trigger.Action == TriggerActionTurnFlagOn)
+	if _, err := UpsertOverride(ctx, trigger.ProjectKey, trigger.FlagKey, value); err != nil {
+		return FlagTrigger{}, err
+	}
+	logs.Printf(logs.Info, trigger.ProjectKey, "Trigger fired to %s flag [%s] in project [%s]", trigger.Action, trigger.FlagKey, trigger.ProjectKey)
+	return trigger, nil
+}
@@ -0,0 +1,81 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestFlagTriggers(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	ctx = model.SetObserversOnContext(ctx, model.NewObservers())
+
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("dev"),
+		AllFlagsState: model.FlagsState{
+			"new-checkout": {Value: ldvalue.Bool(true), Version: 1},
+			"banner":       {Value: ldvalue.String("blue"), Version: 1},
+		},
+	}))
+	require.NoError(t, store.InsertProject(ctx, model.Project{Key: "other", Context: ldcontext.New("dev")}))
+
+	t.Run("firing a trigger overrides its flag", func(t *testing.T) {
+		off, err := model.CreateFlagTrigger(ctx, "proj", "new-checkout", model.TriggerActionTurnFlagOff)
+		require.NoError(t, err)
+		on, err := model.CreateFlagTrigger(ctx, "proj", "new-checkout", model.TriggerActionTurnFlagOn)
+		require.NoError(t, err)
+
+		_, err = model.FireFlagTrigger(ctx, off.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.Bool(false), overrideValue(t, ctx, store, "new-checkout"))
+
+		_, err = model.FireFlagTrigger(ctx, on.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ldvalue.Bool(true), overrideValue(t, ctx, store, "new-checkout"))
+
+		triggers, err := model.GetFlagTriggers(ctx, "proj")
+		require.NoError(t, err)
+		assert.Len(t, triggers, 2)
+	})
+
+	t.Run("rejects triggers on flags that aren't boolean", func(t *testing.T) {
+		_, err := model.CreateFlagTrigger(ctx, "proj", "banner", model.TriggerActionTurnFlagOn)
+		assert.ErrorAs(t, err, &model.ErrInvalidTrigger{})
+	})
+
+	t.Run("rejects unknown actions", func(t *testing.T) {
+		_, err := model.CreateFlagTrigger(ctx, "proj", "new-checkout", model.TriggerAction("toggle"))
+		assert.ErrorAs(t, err, &model.ErrInvalidTrigger{})
+	})
+
+	t.Run("deleting a trigger stops it from firing", func(t *testing.T) {
+		trigger, err := model.CreateFlagTrigger(ctx, "proj", "new-checkout", model.TriggerActionTurnFlagOff)
+		require.NoError(t, err)
+
+		err = model.DeleteFlagTrigger(ctx, "other", trigger.ID)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+
+		require.NoError(t, model.DeleteFlagTrigger(ctx, "proj", trigger.ID))
+		_, err = model.FireFlagTrigger(ctx, trigger.ID)
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
+
+func overrideValue(t *testing.T, ctx context.Context, store model.Store, flagKey string) ldvalue.Value {
+	overrides, err := store.GetOverridesForProject(ctx, "proj")
+	require.NoError(t, err)
+	override, ok := overrides.GetFlag(flagKey)
+	require.True(t, ok)
+	return override.Value
+}
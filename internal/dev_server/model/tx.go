@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+	"sync"
+)
+
+// withTx runs fn in a store transaction. Observers are only told about the changes fn makes once they've been
+// committed, so that SDKs aren't sent values that are then rolled back.
+func withTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	held := &heldEvents{}
+	txObservers := NewObservers()
+	txObservers.RegisterObserver(held)
+	err := StoreFromContext(ctx).WithTx(ctx, func(ctx context.Context) error {
+		return fn(SetObserversOnContext(ctx, txObservers))
+	})
+	if err != nil || len(held.events) == 0 {
+		return err
+	}
+	observers := GetObserversFromContext(ctx)
+	for _, event := range held.events {
+		observers.Notify(event)
+	}
+	return nil
+}
+
+// heldEvents collects the events observers are notified of in a transaction.
+type heldEvents struct {
+	mu     sync.Mutex
+	events []interface{}
+}
+
+func (h *heldEvents) Handle(event interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, event)
+}
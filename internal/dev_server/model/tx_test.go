@@ -0,0 +1,17 @@
+package model_test
+
+import (
+	"context"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+// expectTransactions makes the mock store run whatever it's given in a transaction, returning what it returns the way
+// a store rolling back on errors would.
+func expectTransactions(store *mocks.MockStore) {
+	store.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// usageRetention is how long evaluations are counted towards usage reports.
+const usageRetention = 7 * 24 * time.Hour
+
+type usageBucketKey struct {
+	projectKey string
+	flagKey    string
+	hour       time.Time
+}
+
+type usageBucket struct {
+	evaluations         int64
+	fallbackEvaluations int64
+	lastEvaluated       time.Time
+}
+
+// FlagUsage is how many times SDKs reported evaluating a flag.
+type FlagUsage struct {
+	FlagKey     string
+	Evaluations int64
+	// FallbackEvaluations is how many of the evaluations got the SDK's default value, as they do for flags the project
+	// doesn't have.
+	FallbackEvaluations int64
+	// LastEvaluated is nil if the flag wasn't evaluated.
+	LastEvaluated *time.Time
+	// InProject is whether the project has the flag. Evaluated flags that it doesn't have are usually typos or flags
+	// that were deleted in LaunchDarkly but are still in code.
+	InProject bool
+}
+
+// UsageReport summarizes the evaluations SDKs reported for a project, so that flags that are no longer evaluated,
+// and flags that are evaluated but no longer exist, can be cleaned up.
+type UsageReport struct {
+	// Flags has every flag in the project, whether or not it was evaluated, and every other flag that was evaluated,
+	// ordered by flag key.
+	Flags []FlagUsage
+}
+
+// countUsage adds the evaluation to its hour's counts, dropping counts that are past usageRetention whenever a new
+// hour starts. The caller holds b.mu.
+func (b *EvaluationRequests) countUsage(request EvaluationRequest) {
+	key := usageBucketKey{projectKey: request.ProjectKey, flagKey: request.FlagKey, hour: request.Time.Truncate(time.Hour)}
+	bucket, ok := b.usage[key]
+	if !ok {
+		cutoff := time.Now().Add(-usageRetention)
+		for existing := range b.usage {
+			if existing.hour.Before(cutoff) {
+				delete(b.usage, existing)
+			}
+		}
+		bucket = &usageBucket{}
+		b.usage[key] = bucket
+	}
+	bucket.evaluations += request.Count
+	if request.Source == EvaluationSourceFallback {
+		bucket.fallbackEvaluations += request.Count
+	}
+	if request.Time.After(bucket.lastEvaluated) {
+		bucket.lastEvaluated = request.Time
+	}
+}
+
+// Usage returns the project's evaluations since the given time, by flag key. Evaluations are counted by the hour, so
+// the whole of the hour that since falls in is included.
+func (b *EvaluationRequests) Usage(projectKey string, since time.Time) map[string]FlagUsage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	since = since.Truncate(time.Hour)
+	usage := make(map[string]FlagUsage)
+	for key, bucket := range b.usage {
+		if key.projectKey != projectKey || key.hour.Before(since) {
+			continue
+		}
+		flagUsage := usage[key.flagKey]
+		flagUsage.FlagKey = key.flagKey
+		flagUsage.Evaluations += bucket.evaluations
+		flagUsage.FallbackEvaluations += bucket.fallbackEvaluations
+		if flagUsage.LastEvaluated == nil || bucket.lastEvaluated.After(*flagUsage.LastEvaluated) {
+			lastEvaluated := bucket.lastEvaluated
+			flagUsage.LastEvaluated = &lastEvaluated
+		}
+		usage[key.flagKey] = flagUsage
+	}
+	return usage
+}
+
+// GetUsageReport summarizes the evaluations SDKs reported for the project since the given time, from the buffer on
+// the context. A zero since covers every evaluation that's still counted. ErrNotFound is returned if the project
+// doesn't exist.
+func GetUsageReport(ctx context.Context, projectKey string, since time.Time) (UsageReport, error) {
+	project, err := StoreFromContext(ctx).GetDevProject(ctx, projectKey)
+	if err != nil {
+		return UsageReport{}, err
+	}
+	usage := make(map[string]FlagUsage)
+	if buffer := EvaluationRequestsFromContext(ctx); buffer != nil {
+		usage = buffer.Usage(projectKey, since)
+	}
+	for flagKey := range project.AllFlagsState {
+		flagUsage := usage[flagKey]
+		flagUsage.FlagKey = flagKey
+		flagUsage.InProject = true
+		usage[flagKey] = flagUsage
+	}
+
+	report := UsageReport{Flags: make([]FlagUsage, 0, len(usage))}
+	for _, flagUsage := range usage {
+		report.Flags = append(report.Flags, flagUsage)
+	}
+	sort.Slice(report.Flags, func(i, j int) bool {
+		return report.Flags[i].FlagKey < report.Flags[j].FlagKey
+	})
+	return report, nil
+}
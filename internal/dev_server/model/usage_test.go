@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestUsageReport(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:     "proj",
+		Context: ldcontext.New("dev"),
+		AllFlagsState: model.FlagsState{
+			"new-checkout": {Value: ldvalue.Bool(true), Version: 1},
+			"old-banner":   {Value: ldvalue.Bool(false), Version: 1},
+		},
+	}))
+
+	buffer := model.NewEvaluationRequests(1)
+	ctx = model.ContextWithEvaluationRequests(ctx, buffer)
+	now := time.Now()
+	yesterday := now.Add(-30 * time.Hour)
+	buffer.Add(model.EvaluationRequest{Time: yesterday, ProjectKey: "proj", FlagKey: "old-banner", Source: model.EvaluationSourceCloud, Count: 4})
+	buffer.Add(model.EvaluationRequest{Time: now, ProjectKey: "proj", FlagKey: "new-checkout", Source: model.EvaluationSourceCloud, Count: 10})
+	buffer.Add(model.EvaluationRequest{Time: now, ProjectKey: "proj", FlagKey: "new-checkout", Source: model.EvaluationSourceOverride, Count: 2})
+	buffer.Add(model.EvaluationRequest{Time: now, ProjectKey: "proj", FlagKey: "deleted-flag", Source: model.EvaluationSourceFallback, Count: 3})
+	buffer.Add(model.EvaluationRequest{Time: now, ProjectKey: "other", FlagKey: "new-checkout", Source: model.EvaluationSourceCloud, Count: 1})
+
+	t.Run("counts evaluations that have left the buffer", func(t *testing.T) {
+		report, err := model.GetUsageReport(ctx, "proj", time.Time{})
+		require.NoError(t, err)
+		require.Len(t, report.Flags, 3)
+		assert.Equal(t, "deleted-flag", report.Flags[0].FlagKey)
+		assert.Equal(t, int64(3), report.Flags[0].FallbackEvaluations)
+		assert.False(t, report.Flags[0].InProject)
+		assert.Equal(t, int64(12), report.Flags[1].Evaluations)
+		assert.True(t, report.Flags[1].InProject)
+		assert.Equal(t, now.UnixMilli(), report.Flags[1].LastEvaluated.UnixMilli())
+		assert.Equal(t, int64(4), report.Flags[2].Evaluations)
+	})
+
+	t.Run("lists flags that weren't evaluated since", func(t *testing.T) {
+		report, err := model.GetUsageReport(ctx, "proj", now.Add(-24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, report.Flags, 3)
+		assert.Equal(t, model.FlagUsage{FlagKey: "old-banner", InProject: true}, report.Flags[2])
+	})
+
+	t.Run("returns ErrNotFound for projects that don't exist", func(t *testing.T) {
+		_, err := model.GetUsageReport(ctx, "nope", time.Time{})
+		assert.ErrorAs(t, err, &model.ErrNotFound{})
+	})
+}
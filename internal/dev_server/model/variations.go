@@ -1,6 +1,15 @@
 package model
 
-import "github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+)
+
+// synthesizedVariationIdPrefix marks variation IDs made up by the dev server for flags whose variations came back from
+// the LaunchDarkly API without IDs, which happens for some flags created through older APIs.
+const synthesizedVariationIdPrefix = "ldcli-synthesized-"
 
 type Variation struct {
 	Id          string
@@ -13,3 +22,14 @@ type FlagVariation struct {
 	FlagKey string
 	Variation
 }
+
+// synthesizeVariationId returns an ID for the variation at index that stays the same across syncs as long as the
+// flag's variations aren't reordered.
+func synthesizeVariationId(index int) string {
+	return fmt.Sprintf("%s%d", synthesizedVariationIdPrefix, index)
+}
+
+// HasSynthesizedId reports whether the dev server made up the variation's ID.
+func (v Variation) HasSynthesizedId() bool {
+	return strings.HasPrefix(v.Id, synthesizedVariationIdPrefix)
+}
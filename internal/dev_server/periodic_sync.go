@@ -0,0 +1,67 @@
+package dev_server
+
+import (
+	"sync"
+	"time"
+)
+
+// periodicSync calls sync every interval. The interval can be changed while it runs, and 0 stops the calls until it's
+// set again.
+type periodicSync struct {
+	sync     func()
+	mu       sync.Mutex
+	interval time.Duration
+	changed  chan struct{}
+}
+
+func newPeriodicSync(interval time.Duration, sync func()) *periodicSync {
+	return &periodicSync{
+		sync:     sync,
+		interval: interval,
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+func (p *periodicSync) setInterval(interval time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if interval == p.interval {
+		return
+	}
+	p.interval = interval
+	select {
+	case p.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (p *periodicSync) getInterval() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.interval
+}
+
+// run calls sync until stop is closed. A changed interval starts counting from when it's set.
+func (p *periodicSync) run(stop <-chan struct{}) {
+	for {
+		var timer *time.Timer
+		var tick <-chan time.Time
+		if interval := p.getInterval(); interval > 0 {
+			timer = time.NewTimer(interval)
+			tick = timer.C
+		}
+		select {
+		case <-stop:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-p.changed:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-tick:
+			p.sync()
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package dev_server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// RepoConfigFileName is the name of the project config that a repository can check in, so that everyone working on it
+// gets the same local project.
+const RepoConfigFileName = ".ldcli.yaml"
+
+// RepoConfig is a repository's .ldcli.yaml. It declares the project its apps use, and is what `add-project` and
+// `start` fall back to when they aren't given one.
+type RepoConfig struct {
+	Project string `json:"project"`
+	// Source is the environment the project's flags are copied from.
+	Source    string                     `json:"source,omitempty"`
+	Context   *ldcontext.Context         `json:"context,omitempty"`
+	Overrides map[string]model.FlagValue `json:"overrides,omitempty"`
+	// SdkKeys are placeholder SDK keys, e.g. local-dev-key-frontend, that the repository's apps are configured with.
+	SdkKeys []string `json:"sdkKeys,omitempty"`
+}
+
+// FindRepoConfig reads the .ldcli.yaml in dir or the closest of its parents that has one, and returns it with its path.
+// The path is empty if there isn't one.
+func FindRepoConfig(dir string) (RepoConfig, string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return RepoConfig{}, "", err
+	}
+	for {
+		path := filepath.Join(dir, RepoConfigFileName)
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			config, err := parseRepoConfig(path, data)
+			return config, path, err
+		case !errors.Is(err, fs.ErrNotExist):
+			return RepoConfig{}, "", fmt.Errorf("unable to read %s: %w", path, err)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return RepoConfig{}, "", nil
+		}
+		dir = parent
+	}
+}
+
+func parseRepoConfig(path string, data []byte) (RepoConfig, error) {
+	var config RepoConfig
+	if err := decodeYAML(data, &config); err != nil {
+		return RepoConfig{}, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	if config.Project == "" {
+		return RepoConfig{}, fmt.Errorf("invalid %s: project is required", path)
+	}
+	for _, sdkKey := range config.SdkKeys {
+		if sdkKey == "" {
+			return RepoConfig{}, fmt.Errorf("invalid %s: sdkKeys can't be empty", path)
+		}
+	}
+	return config, nil
+}
+
+// SeedProject is the project the config declares, to be seeded when the server starts. It needs a source.
+func (c RepoConfig) SeedProject() model.SeedProject {
+	return model.SeedProject{
+		Key:                  c.Project,
+		SourceEnvironmentKey: c.Source,
+		Context:              c.Context,
+		Overrides:            c.Overrides,
+		SdkKeys:              c.SdkKeys,
+	}
+}
@@ -0,0 +1,73 @@
+package dev_server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestFindRepoConfig(t *testing.T) {
+	repo := t.TempDir()
+	nested := filepath.Join(repo, "services", "web")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+
+	t.Run("finds nothing without a .ldcli.yaml", func(t *testing.T) {
+		_, path, err := FindRepoConfig(nested)
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	writeConfig(t, filepath.Join(repo, RepoConfigFileName), `
+project: frontend
+source: test
+context:
+  kind: user
+  key: dev
+overrides:
+  new-checkout: true
+sdkKeys: [local-dev-key-frontend]
+`)
+
+	t.Run("finds the closest parent's", func(t *testing.T) {
+		config, path, err := FindRepoConfig(nested)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(repo, RepoConfigFileName), path)
+		devContext := ldcontext.New("dev")
+		assert.Equal(t, RepoConfig{
+			Project:   "frontend",
+			Source:    "test",
+			Context:   &devContext,
+			Overrides: map[string]model.FlagValue{"new-checkout": ldvalue.Bool(true)},
+			SdkKeys:   []string{"local-dev-key-frontend"},
+		}, config)
+		assert.Equal(t, "frontend", config.SeedProject().Key)
+		assert.Equal(t, []string{"local-dev-key-frontend"}, config.SeedProject().SdkKeys)
+	})
+
+	t.Run("prefers one in the directory itself", func(t *testing.T) {
+		writeConfig(t, filepath.Join(nested, RepoConfigFileName), "project: web\n")
+		config, path, err := FindRepoConfig(nested)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(nested, RepoConfigFileName), path)
+		assert.Equal(t, RepoConfig{Project: "web"}, config)
+	})
+
+	t.Run("rejects a config without a project", func(t *testing.T) {
+		writeConfig(t, filepath.Join(nested, RepoConfigFileName), "source: test\n")
+		_, _, err := FindRepoConfig(nested)
+		assert.ErrorContains(t, err, "project is required")
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		writeConfig(t, filepath.Join(nested, RepoConfigFileName), "project: web\nenvironment: test\n")
+		_, _, err := FindRepoConfig(nested)
+		assert.ErrorContains(t, err, "unknown field")
+	})
+}
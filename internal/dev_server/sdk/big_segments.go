@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+type bigSegmentsMetadata struct {
+	LastUpToDate int64 `json:"lastUpToDate"`
+}
+
+// GetBigSegmentsMetadata tells big segment store adapters when the store was last synchronized, so that SDKs report
+// big segments as healthy.
+func GetBigSegmentsMetadata(w http.ResponseWriter, r *http.Request) {
+	bigSegments := model.BigSegmentsFromContext(r.Context())
+	writeJson(w, r, bigSegmentsMetadata{LastUpToDate: bigSegments.LastUpToDate().UnixMilli()})
+}
+
+// GetBigSegmentsMembership returns the big segment membership for the context identified by the contextHash query
+// parameter, keyed by segment reference.
+func GetBigSegmentsMembership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	contextHash := r.URL.Query().Get("contextHash")
+	if contextHash == "" {
+		http.Error(w, "contextHash query parameter is required", http.StatusBadRequest)
+		return
+	}
+	bigSegments := model.BigSegmentsFromContext(ctx)
+	writeJson(w, r, bigSegments.GetMembershipForContextHash(GetProjectKeyFromContext(ctx), contextHash))
+}
+
+func writeJson(w http.ResponseWriter, r *http.Request, body interface{}) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		WriteError(r.Context(), w, errors.Wrap(err, "failed to marshal response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(jsonBody)
+	if err != nil {
+		WriteError(r.Context(), w, errors.Wrap(err, "unable to write response"))
+		return
+	}
+}
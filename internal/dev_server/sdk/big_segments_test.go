@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestBigSegments(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	bigSegments := model.NewBigSegments()
+	bigSegments.SetMembership(exampleProjectKey, "beta-users", model.BigSegmentMembership{Included: []string{"alice"}})
+
+	router := mux.NewRouter()
+	router.Use(model.StoreMiddleware(store))
+	router.Use(model.BigSegmentsMiddleware(bigSegments))
+	BindRoutes(router)
+
+	store.EXPECT().GetAlias(gomock.Any(), exampleProjectKey).Return(model.Alias{}, model.NewErrNotFound("alias", exampleProjectKey)).AnyTimes()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		req.Header.Set("Authorization", exampleProjectKey)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("metadata reports the store as up to date", func(t *testing.T) {
+		rec := get("/sdk/big-segments/metadata")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var metadata bigSegmentsMetadata
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &metadata))
+		assert.NotZero(t, metadata.LastUpToDate)
+	})
+
+	t.Run("membership is keyed by segment reference", func(t *testing.T) {
+		rec := get("/sdk/big-segments/membership?contextHash=" + url.QueryEscape(model.BigSegmentContextHash("alice")))
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"beta-users.g1": true}`, rec.Body.String())
+	})
+
+	t.Run("membership requires a context hash", func(t *testing.T) {
+		rec := get("/sdk/big-segments/membership")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -8,8 +8,8 @@ var CorsHeaders = handlers.CORS(
 	handlers.AllowedOrigins([]string{"*"}),
 	handlers.AllowedMethods([]string{"GET"}),
 	handlers.AllowCredentials(),
-	handlers.ExposedHeaders([]string{"Date"}),
-	handlers.AllowedHeaders([]string{"Cache-Control", "Content-Type", "Content-Length", "Accept-Encoding", "X-LaunchDarkly-Event-Schema", "X-LaunchDarkly-User-Agent", "X-LaunchDarkly-Payload-ID", "X-LaunchDarkly-Wrapper", "X-LaunchDarkly-Tags"}),
+	handlers.ExposedHeaders([]string{"Date", StaleHeader}),
+	handlers.AllowedHeaders([]string{"Cache-Control", "Content-Type", "Content-Length", "Accept-Encoding", "X-LaunchDarkly-Event-Schema", "X-LaunchDarkly-User-Agent", "X-LaunchDarkly-Payload-ID", "X-LaunchDarkly-Wrapper", "X-LaunchDarkly-Tags", SavedContextHeader}),
 	handlers.MaxAge(300),
 )
 
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// evaluationEvent is the part of feature, debug, and summary events that describes evaluations. Feature events may
+// only have the keys of the context rather than the whole context, and summary events only have a context when SDKs
+// summarize per context.
+type evaluationEvent struct {
+	Kind         string                    `json:"kind"`
+	CreationDate int64                     `json:"creationDate"`
+	EndDate      int64                     `json:"endDate"`
+	Key          string                    `json:"key"`
+	Value        ldvalue.Value             `json:"value"`
+	Variation    *int                      `json:"variation"`
+	Context      *ldcontext.Context        `json:"context"`
+	ContextKeys  map[string]string         `json:"contextKeys"`
+	Features     map[string]summaryFeature `json:"features"`
+}
+
+type summaryFeature struct {
+	Counters []struct {
+		Value   ldvalue.Value `json:"value"`
+		Count   int64         `json:"count"`
+		Unknown bool          `json:"unknown"`
+	} `json:"counters"`
+}
+
+// recordEvaluationRequests records the evaluations described by an SDK's feature, debug, or summary event, so they
+// can be inspected with `GET /dev/projects/{projectKey}/requests`.
+func recordEvaluationRequests(ctx context.Context, projectKey string, msg json.RawMessage) {
+	var event evaluationEvent
+	if err := json.Unmarshal(msg, &event); err != nil {
+		return
+	}
+	var ldCtx ldcontext.Context
+	switch {
+	case event.Context != nil:
+		ldCtx = *event.Context
+	case len(event.ContextKeys) > 0:
+		ldCtx = contextFromKeys(event.ContextKeys)
+	}
+
+	var requests []model.EvaluationRequest
+	switch event.Kind {
+	case "feature", "debug":
+		request := model.EvaluationRequest{
+			Time:    eventTime(event.CreationDate),
+			FlagKey: event.Key,
+			Context: ldCtx,
+			Value:   event.Value,
+			Count:   1,
+		}
+		if event.Variation == nil {
+			// SDKs leave out the variation when they serve their default value
+			request.Source = model.EvaluationSourceFallback
+		}
+		requests = append(requests, request)
+	case "summary":
+		flagKeys := make([]string, 0, len(event.Features))
+		for flagKey := range event.Features {
+			flagKeys = append(flagKeys, flagKey)
+		}
+		sort.Strings(flagKeys)
+		for _, flagKey := range flagKeys {
+			for _, counter := range event.Features[flagKey].Counters {
+				request := model.EvaluationRequest{
+					Time:    eventTime(event.EndDate),
+					FlagKey: flagKey,
+					Context: ldCtx,
+					Value:   counter.Value,
+					Count:   counter.Count,
+				}
+				if counter.Unknown {
+					request.Source = model.EvaluationSourceFallback
+				}
+				requests = append(requests, request)
+			}
+		}
+	}
+	model.RecordEvaluationRequests(ctx, projectKey, requests)
+}
+
+// contextFromKeys builds a context with just the keys of each kind that a feature event was sent for.
+func contextFromKeys(keys map[string]string) ldcontext.Context {
+	builder := ldcontext.NewMultiBuilder()
+	for kind, key := range keys {
+		builder.Add(ldcontext.NewWithKind(ldcontext.Kind(kind), key))
+	}
+	return builder.Build()
+}
+
+// eventTime is when an event was created from its Unix milliseconds, or the zero time if the event didn't say, in which
+// case it's recorded as of when it was received.
+func eventTime(unixMilli int64) time.Time {
+	if unixMilli == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(unixMilli)
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/db"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestRecordEvaluationRequests(t *testing.T) {
+	ctx := context.Background()
+	store, err := db.NewMemorySqlite(ctx)
+	require.NoError(t, err)
+	ctx = model.ContextWithStore(ctx, store)
+	require.NoError(t, store.InsertProject(ctx, model.Project{
+		Key:          exampleProjectKey,
+		Context:      ldcontext.New("dev"),
+		LastSyncTime: time.Now(),
+		AllFlagsState: model.FlagsState{
+			"new-checkout": model.FlagState{Value: ldvalue.Bool(false), Version: 1},
+			"banner":       model.FlagState{Value: ldvalue.String("hello"), Version: 1},
+		},
+	}))
+	_, err = store.UpsertOverride(ctx, model.Override{ProjectKey: exampleProjectKey, FlagKey: "new-checkout", Value: ldvalue.Bool(true), Active: true, Version: 1})
+	require.NoError(t, err)
+
+	t.Run("records feature events with their context and where the value came from", func(t *testing.T) {
+		buffer := model.NewEvaluationRequests(10)
+		ctx := model.ContextWithEvaluationRequests(ctx, buffer)
+
+		recordEvaluationRequests(ctx, exampleProjectKey, json.RawMessage(`{"kind":"feature","creationDate":1700000000000,"key":"new-checkout","value":true,"variation":0,"context":{"kind":"user","key":"alice"}}`))
+		recordEvaluationRequests(ctx, exampleProjectKey, json.RawMessage(`{"kind":"feature","key":"banner","value":"hello","variation":1,"contextKeys":{"user":"bob"}}`))
+		recordEvaluationRequests(ctx, exampleProjectKey, json.RawMessage(`{"kind":"feature","key":"missing","value":"default","contextKeys":{"user":"bob"}}`))
+
+		requests := buffer.Query(model.EvaluationRequestsQuery{})
+		require.Len(t, requests, 3)
+		assert.Equal(t, "new-checkout", requests[0].FlagKey)
+		assert.Equal(t, model.EvaluationSourceOverride, requests[0].Source)
+		assert.Equal(t, ldcontext.New("alice"), requests[0].Context)
+		assert.Equal(t, time.UnixMilli(1700000000000), requests[0].Time)
+		assert.Equal(t, model.EvaluationSourceCloud, requests[1].Source)
+		assert.Equal(t, ldcontext.New("bob"), requests[1].Context)
+		assert.Equal(t, model.EvaluationSourceFallback, requests[2].Source)
+		assert.Equal(t, exampleProjectKey, requests[2].ProjectKey)
+	})
+
+	t.Run("records each counter of summary events", func(t *testing.T) {
+		buffer := model.NewEvaluationRequests(10)
+		ctx := model.ContextWithEvaluationRequests(ctx, buffer)
+
+		recordEvaluationRequests(ctx, exampleProjectKey, json.RawMessage(`{
+			"kind": "summary",
+			"endDate": 1700000000000,
+			"features": {
+				"new-checkout": {"counters": [{"value": true, "variation": 0, "count": 3}]},
+				"missing": {"counters": [{"value": "default", "unknown": true, "count": 2}]}
+			}
+		}`))
+
+		requests := buffer.Query(model.EvaluationRequestsQuery{})
+		require.Len(t, requests, 2)
+		assert.Equal(t, "missing", requests[0].FlagKey)
+		assert.Equal(t, model.EvaluationSourceFallback, requests[0].Source)
+		assert.Equal(t, int64(2), requests[0].Count)
+		assert.False(t, requests[0].Context.IsDefined())
+		assert.Equal(t, "new-checkout", requests[1].FlagKey)
+		assert.Equal(t, model.EvaluationSourceOverride, requests[1].Source)
+		assert.Equal(t, int64(3), requests[1].Count)
+	})
+
+	t.Run("records nothing without a buffer", func(t *testing.T) {
+		recordEvaluationRequests(ctx, exampleProjectKey, json.RawMessage(`{"kind":"feature","key":"banner","value":"hello","variation":1}`))
+	})
+}
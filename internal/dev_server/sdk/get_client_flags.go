@@ -9,12 +9,17 @@ import (
 
 func GetClientFlags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	ldCtx, err := evaluationContext(r)
+	if err != nil {
+		WriteError(ctx, w, err)
+		return
+	}
 	allFlags, err := GetAllFlagsFromContext(ctx)
 	if err != nil {
 		WriteError(ctx, w, errors.Wrap(err, "failed to get flag state"))
 		return
 	}
-	jsonBody, err := json.Marshal(allFlags)
+	jsonBody, err := json.Marshal(allFlags.ForContext(GetProjectKeyFromContext(ctx), ldCtx))
 	if err != nil {
 		WriteError(ctx, w, errors.Wrap(err, "failed to marshal flag state"))
 		return
@@ -20,6 +20,7 @@ func GetServerFlags(w http.ResponseWriter, r *http.Request) {
 		body, ok = ServerFlagsFromFlagsState(allFlags)[flagKey]
 		if !ok {
 			http.Error(w, "flag not found", http.StatusNotFound)
+			return
 		}
 	} else {
 		body = ServerFlagsFromFlagsState(allFlags)
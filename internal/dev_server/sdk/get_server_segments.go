@@ -0,0 +1,17 @@
+package sdk
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetServerSegments serves segments to polling SDKs. Flags are evaluated before they're stored in the dev server, so
+// there are never any segments to serve.
+func GetServerSegments(w http.ResponseWriter, r *http.Request) {
+	if _, ok := mux.Vars(r)["segmentKey"]; ok {
+		http.Error(w, "segment not found", http.StatusNotFound)
+		return
+	}
+	ConstantResponseHandler(http.StatusOK, "{}")(w, r)
+}
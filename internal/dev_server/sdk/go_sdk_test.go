@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http/httptest"
-	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -33,20 +33,19 @@ func TestSDKRoutesViaGoSDK(t *testing.T) {
 
 	// Wire up model dependencies to context
 	ctx := context.Background()
-	store, err := db.NewSqlite(ctx, "test.db")
+	store, err := db.NewSqlite(ctx, filepath.Join(t.TempDir(), "test.db"))
 	require.NoError(t, err)
-	defer func() {
-		require.NoError(t, os.Remove("test.db"))
-	}()
 	ctx = model.ContextWithStore(ctx, store)
 	observers := model.NewObservers()
 	ctx = model.SetObserversOnContext(ctx, observers)
 	// Mock the external LD APIs
 	mockController := gomock.NewController(t)
 	ctx, api, sdk := mocks.WithMockApiAndSdk(ctx, mockController)
+	api.EXPECT().GetAllAIConfigs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	api.EXPECT().GetContextKinds(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	api.EXPECT().GetSdkKey(gomock.Any(), projectKey, environmentKey).Return(testSdkKey, nil).AnyTimes()
-	api.EXPECT().GetAllFlags(gomock.Any(), projectKey).
+	api.EXPECT().GetAllFlags(gomock.Any(), projectKey, gomock.Any()).
 		Return(nil, nil). // Available variations are not used for evaluation
 		AnyTimes()
 
@@ -54,6 +53,7 @@ func TestSDKRoutesViaGoSDK(t *testing.T) {
 	router := mux.NewRouter()
 	router.Use(model.StoreMiddleware(store))
 	router.Use(model.ObserversMiddleware(observers))
+	router.Use(model.EventsBufferMiddleware(model.NewEventsBuffer(100)))
 	BindRoutes(router)
 	require.NoError(t, err)
 	testServer := httptest.NewServer(router)
@@ -68,7 +68,7 @@ func TestSDKRoutesViaGoSDK(t *testing.T) {
 		Build()
 
 	sdk.EXPECT().GetAllFlagsState(gomock.Any(), gomock.Any(), testSdkKey).Return(allFlags, nil)
-	_, err = model.CreateProject(ctx, projectKey, environmentKey, nil)
+	_, err = model.CreateProject(ctx, projectKey, environmentKey, nil, model.FlagFilter{})
 	require.NoError(t, err)
 
 	// Configure go SDK to use test server
@@ -133,7 +133,7 @@ func TestSDKRoutesViaGoSDK(t *testing.T) {
 			trackers[flagKey] = flagUpdateChan
 		}
 
-		_, err := model.UpdateProject(ctx, projectKey, nil, nil)
+		_, err := model.UpdateProject(ctx, projectKey, nil, nil, nil)
 		require.NoError(t, err)
 
 		for flagKey, value := range valuesMap {
@@ -162,4 +162,62 @@ func TestSDKRoutesViaGoSDK(t *testing.T) {
 			assert.Equal(t, value.AsArbitraryValue(), flagUpdate.NewValue.AsArbitraryValue())
 		})
 	}
+
+	t.Run("rollout overrides bucket contexts the same way the SDK does", func(t *testing.T) {
+		rollout := model.Rollout{Variations: []model.WeightedValue{
+			{Value: ldvalue.String("control"), Weight: 30000},
+			{Value: ldvalue.String("treatment"), Weight: 70000},
+		}}
+		flagChangeChan := ld.GetFlagTracker().AddFlagChangeListener()
+		defer ld.GetFlagTracker().RemoveFlagChangeListener(flagChangeChan)
+		_, err := model.UpsertRolloutOverride(ctx, projectKey, "stringFlag", rollout)
+		require.NoError(t, err)
+		for event := range flagChangeChan {
+			if event.Key == "stringFlag" {
+				break
+			}
+		}
+
+		served := map[string]int{}
+		for i := 0; i < 100; i++ {
+			ldContext := ldcontext.New(fmt.Sprintf("context-%d", i))
+			val, err := ld.StringVariation("stringFlag", ldContext, "bad")
+			require.NoError(t, err)
+			assert.Equal(t, rollout.ValueFor("stringFlag", ldContext).StringValue(), val)
+			served[val]++
+		}
+		assert.Len(t, served, 2, "both values are served")
+	})
+
+	t.Run("contexts forced into a treatment get it from the SDK", func(t *testing.T) {
+		flagChangeChan := ld.GetFlagTracker().AddFlagChangeListener()
+		defer ld.GetFlagTracker().RemoveFlagChangeListener(flagChangeChan)
+		forced := []model.ForcedTreatment{
+			{ContextKey: "context-1", Variation: 0},
+			{ContextKey: "context-2", Variation: 1},
+			{ContextKind: "org", ContextKey: "org-1", Variation: 1},
+		}
+		for _, treatment := range forced {
+			_, err := model.ForceTreatment(ctx, projectKey, "stringFlag", treatment)
+			require.NoError(t, err)
+			for event := range flagChangeChan {
+				if event.Key == "stringFlag" {
+					break
+				}
+			}
+		}
+
+		for _, testCase := range []struct {
+			ldContext ldcontext.Context
+			expected  string
+		}{
+			{ldcontext.New("context-1"), "control"},
+			{ldcontext.New("context-2"), "treatment"},
+			{ldcontext.NewWithKind("org", "org-1"), "treatment"},
+		} {
+			val, err := ld.StringVariation("stringFlag", testCase.ldContext, "bad")
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, val, testCase.ldContext.String())
+		}
+	})
 }
@@ -36,9 +36,12 @@ func TestMobileAuth(t *testing.T) {
 	router.Use(model.StoreMiddleware(store))
 	BindRoutes(router)
 
+	store.EXPECT().GetAlias(gomock.Any(), exampleProjectKey).Return(model.Alias{}, model.NewErrNotFound("alias", exampleProjectKey)).AnyTimes()
+
 	t.Run("given project key prefixed with api_key, it should authenticate successfully", func(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(exampleProject, nil)
 		store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
 
 		req := httptest.NewRequest("GET", "/msdk/evalx/eyJrZXkiOiJib2FyZCBjYXQifQ==", nil)
 		req.Header.Set("Authorization", fmt.Sprintf("api_key %s", exampleProjectKey))
@@ -51,6 +54,7 @@ func TestMobileAuth(t *testing.T) {
 	t.Run("given just the project key, it should authenticate successfully", func(t *testing.T) {
 		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(exampleProject, nil)
 		store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
 
 		req := httptest.NewRequest("GET", "/msdk/evalx/eyJrZXkiOiJib2FyZCBjYXQifQ==", nil)
 		req.Header.Set("Authorization", exampleProjectKey)
@@ -59,4 +63,65 @@ func TestMobileAuth(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
+
+	t.Run("given an aliased mobile key, it should serve the aliased project", func(t *testing.T) {
+		const mobileKey = "mob-production-key"
+		store.EXPECT().GetAlias(gomock.Any(), mobileKey).Return(model.Alias{Alias: mobileKey, ProjectKey: exampleProjectKey}, nil)
+		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(exampleProject, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+
+		req := httptest.NewRequest("GET", "/msdk/evalx/eyJrZXkiOiJib2FyZCBjYXQifQ==", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("api_key %s", mobileKey))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestStaleHeader(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	observers := model.NewObservers()
+
+	// each test gets its own staleness policy, which would otherwise go by what it read of the project in the last one
+	newRouter := func() *mux.Router {
+		router := mux.NewRouter()
+		router.Use(model.ObserversMiddleware(observers))
+		router.Use(model.StoreMiddleware(store))
+		router.Use(model.StalenessMiddleware(model.NewStaleness(time.Hour, false)))
+		BindRoutes(router)
+		return router
+	}
+
+	store.EXPECT().GetAlias(gomock.Any(), exampleProjectKey).Return(model.Alias{}, model.NewErrNotFound("alias", exampleProjectKey)).AnyTimes()
+	store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil).AnyTimes()
+	store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil).AnyTimes()
+
+	t.Run("marks responses for projects that haven't synced recently", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(exampleProject, nil).Times(2)
+
+		req := httptest.NewRequest("GET", "/sdk/latest-all", nil)
+		req.Header.Set("Authorization", exampleProjectKey)
+		rec := httptest.NewRecorder()
+		newRouter().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get(StaleHeader))
+	})
+
+	t.Run("doesn't mark freshly synced projects", func(t *testing.T) {
+		fresh := *exampleProject
+		fresh.LastSyncTime = time.Now()
+		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(&fresh, nil).Times(2)
+
+		req := httptest.NewRequest("GET", "/sdk/latest-all", nil)
+		req.Header.Set("Authorization", exampleProjectKey)
+		rec := httptest.NewRecorder()
+		newRouter().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(StaleHeader))
+	})
 }
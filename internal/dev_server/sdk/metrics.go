@@ -0,0 +1,47 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// streamClients is how many SDKs are connected to a flag stream, across all projects.
+var streamClients atomic.Int64
+
+// countStreamClient reports a newly connected SDK, returning a function to call when it disconnects.
+func countStreamClient(ctx context.Context) func() {
+	metrics := model.MetricsFromContext(ctx)
+	metrics.Gauge(model.MetricStreamClients, float64(streamClients.Add(1)))
+	return func() {
+		metrics.Gauge(model.MetricStreamClients, float64(streamClients.Add(-1)))
+	}
+}
+
+type summaryEvent struct {
+	Features map[string]struct {
+		Counters []struct {
+			Count int64 `json:"count"`
+		} `json:"counters"`
+	} `json:"features"`
+}
+
+// countEvaluations reports how many times each flag was evaluated according to an SDK's summary event.
+func countEvaluations(ctx context.Context, projectKey string, msg json.RawMessage) {
+	var summary summaryEvent
+	if err := json.Unmarshal(msg, &summary); err != nil {
+		return
+	}
+	metrics := model.MetricsFromContext(ctx)
+	for flagKey, feature := range summary.Features {
+		var count int64
+		for _, counter := range feature.Counters {
+			count += counter.Count
+		}
+		if count > 0 {
+			metrics.Count(model.MetricFlagEvaluations, count, model.Tag("project", projectKey), model.Tag("flag", flagKey))
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+type recordedCount struct {
+	name  string
+	value int64
+	tags  []string
+}
+
+type recordingMetrics struct {
+	counts []recordedCount
+	gauges []float64
+}
+
+func (m *recordingMetrics) Count(name string, value int64, tags ...string) {
+	m.counts = append(m.counts, recordedCount{name, value, tags})
+}
+
+func (m *recordingMetrics) Gauge(name string, value float64, tags ...string) {
+	m.gauges = append(m.gauges, value)
+}
+
+func (m *recordingMetrics) Timing(string, time.Duration, ...string) {}
+
+func TestCountEvaluations(t *testing.T) {
+	metrics := &recordingMetrics{}
+	ctx := model.ContextWithMetrics(context.Background(), metrics)
+
+	countEvaluations(ctx, exampleProjectKey, json.RawMessage(`{
+		"kind": "summary",
+		"features": {
+			"new-checkout": {"counters": [{"value": true, "count": 3}, {"value": false, "count": 2}]},
+			"unused": {"counters": []}
+		}
+	}`))
+
+	assert.Equal(t, []recordedCount{{
+		name:  model.MetricFlagEvaluations,
+		value: 5,
+		tags:  []string{"project:" + exampleProjectKey, "flag:new-checkout"},
+	}}, metrics.counts)
+}
+
+func TestCountStreamClient(t *testing.T) {
+	metrics := &recordingMetrics{}
+	ctx := model.ContextWithMetrics(context.Background(), metrics)
+	// other tests' SDKs may still be connected
+	connected := float64(streamClients.Load())
+
+	first := countStreamClient(ctx)
+	second := countStreamClient(ctx)
+	second()
+	first()
+
+	assert.Equal(t, []float64{connected + 1, connected + 2, connected + 1, connected}, metrics.gauges)
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestServerSidePolling(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	observers := model.NewObservers()
+
+	router := mux.NewRouter()
+	router.Use(model.ObserversMiddleware(observers))
+	router.Use(model.StoreMiddleware(store))
+	BindRoutes(router)
+
+	project := &model.Project{
+		Key: exampleProjectKey,
+		AllFlagsState: model.FlagsState{
+			"flag-1": model.FlagState{Value: ldvalue.String("cool"), Version: 3},
+		},
+	}
+	store.EXPECT().GetAlias(gomock.Any(), exampleProjectKey).Return(model.Alias{}, model.NewErrNotFound("alias", exampleProjectKey)).AnyTimes()
+	store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(project, nil).AnyTimes()
+	store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil).AnyTimes()
+	store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil).AnyTimes()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		req.Header.Set("Authorization", exampleProjectKey)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, flagsPath := range []string{"/sdk/flags", "/sdk/latest-flags"} {
+		t.Run(flagsPath+" returns all flags", func(t *testing.T) {
+			rec := get(flagsPath)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var flags map[string]ServerFlag
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &flags))
+			assert.Equal(t, 3, flags["flag-1"].Version)
+			assert.Equal(t, []ldvalue.Value{ldvalue.String("cool")}, flags["flag-1"].Variations)
+		})
+
+		t.Run(flagsPath+" returns a single flag by key", func(t *testing.T) {
+			rec := get(flagsPath + "/flag-1")
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var flag ServerFlag
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &flag))
+			assert.Equal(t, "flag-1", flag.Key)
+		})
+
+		t.Run(flagsPath+" returns 404 for unknown flags", func(t *testing.T) {
+			rec := get(flagsPath + "/nope")
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+			assert.Equal(t, "flag not found\n", rec.Body.String())
+		})
+	}
+
+	for _, segmentsPath := range []string{"/sdk/segments", "/sdk/latest-segments"} {
+		t.Run(segmentsPath+" returns no segments", func(t *testing.T) {
+			rec := get(segmentsPath)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, "{}", rec.Body.String())
+
+			rec = get(segmentsPath + "/some-segment")
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
 type ctxKey string
@@ -28,7 +31,13 @@ func GetProjectKeyFromEnvIdParameter(pathParameter string) func(handler http.Han
 				return
 			}
 			ctx := request.Context()
+			projectKey, err := resolveProjectKey(ctx, projectKey)
+			if err != nil {
+				WriteError(ctx, writer, errors.Wrap(err, "unable to resolve project key"))
+				return
+			}
 			ctx = SetProjectKeyOnContext(ctx, projectKey)
+			setStaleHeader(ctx, writer)
 			request = request.WithContext(ctx)
 			handler.ServeHTTP(writer, request)
 		})
@@ -44,8 +53,23 @@ func GetProjectKeyFromAuthorizationHeader(handler http.Handler) http.Handler {
 			http.Error(writer, "project key not on Authorization header", http.StatusUnauthorized)
 			return
 		}
+		projectKey, err := resolveProjectKey(ctx, projectKey)
+		if err != nil {
+			WriteError(ctx, writer, errors.Wrap(err, "unable to resolve project key"))
+			return
+		}
 		ctx = SetProjectKeyOnContext(ctx, projectKey)
+		setStaleHeader(ctx, writer)
 		request = request.WithContext(ctx)
 		handler.ServeHTTP(writer, request)
 	})
 }
+
+// resolveProjectKey returns the key of the project to serve for the credential an SDK sent, in the request's namespace.
+func resolveProjectKey(ctx context.Context, credential string) (string, error) {
+	projectKey, err := model.ProjectAutoCreatorFromContext(ctx).ResolveProjectKey(ctx, credential)
+	if err != nil {
+		return "", err
+	}
+	return model.ResolveNamespacedProjectKey(ctx, projectKey)
+}
@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// SavedContextHeader names one of the project's saved contexts to evaluate client-side flags for in place of the
+// context the SDK sent. It can also be given as the savedContext query parameter.
+const SavedContextHeader = "X-LD-Saved-Context"
+
+// GetContextFromRequest reads the evaluation context that a client-side SDK sent with the request. REPORT requests
+// carry the context JSON as the body, GET requests carry it base64 encoded as the last path segment.
+//
+// The request body is restored after it's read so that downstream handlers can read it again.
+func GetContextFromRequest(r *http.Request) (ldcontext.Context, error) {
+	var ldCtx ldcontext.Context
+	var contextJson []byte
+	if r.Method == "REPORT" {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return ldCtx, errors.Wrap(err, "unable to read request body")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		contextJson = body
+	} else {
+		segments := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+		decoded, err := decodeBase64Context(segments[len(segments)-1])
+		if err != nil {
+			return ldCtx, err
+		}
+		contextJson = decoded
+	}
+	err := ldCtx.UnmarshalJSON(contextJson)
+	if err != nil {
+		return ldCtx, errors.Wrap(err, "unable to parse evaluation context")
+	}
+	return ldCtx, nil
+}
+
+// requestContextOrEmpty returns the context a client-side SDK sent with the request, or an empty context if it didn't
+// send a valid one. Rollouts serve an empty context their first value.
+func requestContextOrEmpty(r *http.Request) ldcontext.Context {
+	ldCtx, err := GetContextFromRequest(r)
+	if err != nil {
+		return ldcontext.Context{}
+	}
+	return ldCtx
+}
+
+// evaluationContext returns the saved context the request selects, if it selects one, and otherwise the context the
+// client-side SDK sent. Either way it's run through the context enrichment hook, if one was configured, the same way
+// the project's context is when it syncs.
+func evaluationContext(r *http.Request) (ldcontext.Context, error) {
+	ctx := r.Context()
+	name := r.URL.Query().Get("savedContext")
+	if name == "" {
+		name = r.Header.Get(SavedContextHeader)
+	}
+	var ldCtx ldcontext.Context
+	if name == "" {
+		ldCtx = requestContextOrEmpty(r)
+	} else {
+		savedContext, err := model.GetSavedContext(ctx, GetProjectKeyFromContext(ctx), name)
+		if err != nil {
+			return ldcontext.Context{}, err
+		}
+		ldCtx = savedContext.Context
+	}
+	if ldCtx.Err() != nil {
+		// there's nothing to enrich
+		return ldCtx, nil
+	}
+	return model.EnrichContext(ctx, ldCtx)
+}
+
+// decodeBase64Context decodes contexts from SDKs which are inconsistent about whether they use the URL or standard
+// alphabet and whether or not they pad.
+func decodeBase64Context(encoded string) ([]byte, error) {
+	encoded = strings.TrimRight(encoded, "=")
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err == nil {
+		return decoded, nil
+	}
+	decoded, err = base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode base64 evaluation context")
+	}
+	return decoded, nil
+}
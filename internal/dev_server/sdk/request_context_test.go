@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+// orgEnricher adds the acme organization to contexts, the way an edge service might look up a user's organization.
+type orgEnricher struct{}
+
+func (orgEnricher) Enrich(_ context.Context, ldCtx ldcontext.Context) (ldcontext.Context, error) {
+	return ldcontext.NewMulti(ldCtx, ldcontext.NewWithKind("org", "acme")), nil
+}
+
+func TestContextEnrichment(t *testing.T) {
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+
+	router := mux.NewRouter()
+	router.Use(model.ObserversMiddleware(model.NewObservers()))
+	router.Use(model.StoreMiddleware(store))
+	router.Use(model.ContextEnricherMiddleware(orgEnricher{}))
+	BindRoutes(router)
+
+	project := *exampleProject
+	project.AllFlagsState = model.FlagsState{
+		"checkout": model.FlagState{Value: ldvalue.String("old"), Version: 1, Rollout: &model.Rollout{
+			Variations: []model.WeightedValue{
+				{Value: ldvalue.String("old"), Weight: model.RolloutTotalWeight},
+				{Value: ldvalue.String("new"), Weight: 0},
+			},
+			ForcedTreatments: []model.ForcedTreatment{{ContextKind: "org", ContextKey: "acme", Variation: 1}},
+		}},
+	}
+	store.EXPECT().GetAlias(gomock.Any(), exampleProjectKey).Return(model.Alias{}, model.NewErrNotFound("alias", exampleProjectKey)).AnyTimes()
+	store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(&project, nil).AnyTimes()
+	store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil).AnyTimes()
+	store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil).AnyTimes()
+
+	t.Run("client-side flags are evaluated for the enriched context", func(t *testing.T) {
+		req := httptest.NewRequest("REPORT", "/msdk/evalx/context", strings.NewReader(`{"kind": "user", "key": "alice"}`))
+		req.Header.Set("Authorization", exampleProjectKey)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"value":"new"`, "the org the enricher added is forced into the new treatment")
+	})
+
+	t.Run("invalid contexts aren't enriched", func(t *testing.T) {
+		req := httptest.NewRequest("REPORT", "/msdk/evalx/context", strings.NewReader(`{}`))
+		req = req.WithContext(model.ContextWithContextEnricher(req.Context(), orgEnricher{}))
+
+		ldCtx, err := evaluationContext(req)
+		require.NoError(t, err)
+		assert.Error(t, ldCtx.Err())
+	})
+}
@@ -2,32 +2,64 @@ package sdk
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 )
 
 var DevNull = ConstantResponseHandler(http.StatusAccepted, "")
 
+// sdkPathPrefixes are the paths of every route BindRoutes binds.
+var sdkPathPrefixes = []string{"/bulk", "/diagnostic", "/events/", "/mobile", "/all", "/sdk/", "/meval", "/msdk/", "/eval/"}
+
+// IsSdkPath reports whether path is one of the SDK routes, which authenticate with SDK keys rather than anything the
+// dev server is configured with.
+func IsSdkPath(path string) bool {
+	for _, prefix := range sdkPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func BindRoutes(router *mux.Router) {
 	// events
 	router.HandleFunc("/bulk", SdkEventsReceiveHandler)
-	router.HandleFunc("/diagnostic", DevNull)
-	router.Methods(http.MethodPost, http.MethodOptions).Path("/events/bulk/{envId}").Handler(EventsCorsHeaders(DevNull))
-	router.Methods(http.MethodPost, http.MethodOptions).Path("/events/diagnostic/{envId}").Handler(EventsCorsHeaders(DevNull))
-	router.HandleFunc("/mobile", DevNull)
-	router.HandleFunc("/mobile/events", DevNull)
+	router.HandleFunc("/diagnostic", SdkEventsReceiveHandler)
+	router.Methods(http.MethodPost, http.MethodOptions).Path("/events/bulk/{envId}").Handler(EventsCorsHeaders(http.HandlerFunc(SdkEventsReceiveHandler)))
+	router.Methods(http.MethodPost, http.MethodOptions).Path("/events/diagnostic/{envId}").Handler(EventsCorsHeaders(http.HandlerFunc(SdkEventsReceiveHandler)))
+	router.Methods(http.MethodPost).Path("/events/bulk").HandlerFunc(SdkEventsReceiveHandler)
+	router.Methods(http.MethodPost).Path("/events/diagnostic").HandlerFunc(SdkEventsReceiveHandler)
+	router.HandleFunc("/mobile", SdkEventsReceiveHandler)
+	router.HandleFunc("/mobile/events", SdkEventsReceiveHandler)
 	router.HandleFunc("/mobile/events/bulk", SdkEventsReceiveHandler)
-	router.HandleFunc("/mobile/events/diagnostic", DevNull)
+	router.HandleFunc("/mobile/events/diagnostic", SdkEventsReceiveHandler)
 
 	router.Handle("/all", GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(StreamServerAllPayload)))
 	router.Handle("/sdk/latest-all", GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(LatestAll)))
 
-	router.PathPrefix("/sdk/flags/{flagKey}").
-		Methods(http.MethodGet).
-		Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetServerFlags)))
-	router.PathPrefix("/sdk/flags").
-		Methods(http.MethodGet).
-		Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetServerFlags)))
+	for _, flagsPath := range []string{"/sdk/flags", "/sdk/latest-flags"} {
+		router.PathPrefix(flagsPath + "/{flagKey}").
+			Methods(http.MethodGet).
+			Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetServerFlags)))
+		router.PathPrefix(flagsPath).
+			Methods(http.MethodGet).
+			Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetServerFlags)))
+	}
+	for _, segmentsPath := range []string{"/sdk/segments", "/sdk/latest-segments"} {
+		router.PathPrefix(segmentsPath + "/{segmentKey}").
+			Methods(http.MethodGet).
+			Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetServerSegments)))
+		router.PathPrefix(segmentsPath).
+			Methods(http.MethodGet).
+			Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetServerSegments)))
+	}
+
+	router.Methods(http.MethodGet).Path("/sdk/big-segments/metadata").
+		Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetBigSegmentsMetadata)))
+	router.Methods(http.MethodGet).Path("/sdk/big-segments/membership").
+		Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetBigSegmentsMembership)))
 
 	router.PathPrefix("/meval").Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(StreamClientFlags)))
 	router.PathPrefix("/msdk/evalx").Handler(GetProjectKeyFromAuthorizationHeader(http.HandlerFunc(GetClientFlags)))
@@ -35,6 +67,7 @@ func BindRoutes(router *mux.Router) {
 	evalRouter := router.PathPrefix("/eval").Subrouter()
 	evalRouter.Use(CorsHeaders)
 	evalRouter.Use(GetProjectKeyFromEnvIdParameter("envId"))
+	evalRouter.Use(ValidateSecureModeHash)
 	evalRouter.PathPrefix("/{envId}").
 		Methods(http.MethodGet, "REPORT", http.MethodOptions).
 		HandlerFunc(StreamClientFlags)
@@ -47,5 +80,6 @@ func BindRoutes(router *mux.Router) {
 	evalXRouter := router.PathPrefix("/sdk/evalx/{envId}").Subrouter()
 	evalXRouter.Use(CorsHeaders)
 	evalXRouter.Use(GetProjectKeyFromEnvIdParameter("envId"))
+	evalXRouter.Use(ValidateSecureModeHash)
 	evalXRouter.Methods(http.MethodGet, http.MethodOptions, "REPORT").HandlerFunc(GetClientFlags)
 }
@@ -3,9 +3,12 @@ package sdk
 import (
 	"encoding/json"
 	"io"
-	"log"
 	"net/http"
+	"strings"
 
+	"github.com/gorilla/mux"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
@@ -16,22 +19,59 @@ type SDKEventBase struct {
 func SdkEventsReceiveHandler(writer http.ResponseWriter, request *http.Request) {
 	bodyStr, err := io.ReadAll(request.Body)
 	if err != nil {
-		log.Printf("SdkEventsReceiveHandler: error reading request body: %v", err)
+		logs.Printf(logs.Error, "", "SdkEventsReceiveHandler: error reading request body: %v", err)
 		return
 	}
 	observers := model.GetObserversFromContext(request.Context())
+	buffer := model.EventsBufferFromContext(request.Context())
+	projectKey := eventsProjectKey(request)
 
 	var arr []json.RawMessage
 	err = json.Unmarshal(bodyStr, &arr)
 
 	if err != nil {
-		log.Printf("SdkEventsReceiveHandler: error unmarshaling request body: %v", err)
+		// diagnostic events are sent one at a time instead of in an array
+		var single json.RawMessage
+		if singleErr := json.Unmarshal(bodyStr, &single); singleErr != nil {
+			logs.Printf(logs.Error, "", "SdkEventsReceiveHandler: error unmarshaling request body: %v", err)
+		} else {
+			arr = []json.RawMessage{single}
+		}
 	}
 
 	for _, msg := range arr {
+		event := SDKEventBase{}
+		if err := json.Unmarshal(msg, &event); err != nil {
+			logs.Printf(logs.Error, "", "SdkEventsReceiveHandler: error unmarshaling event: %v", err)
+		}
+		buffer.Add(projectKey, event.Kind, msg)
+		if event.Kind == "summary" {
+			countEvaluations(request.Context(), projectKey, msg)
+		}
+		if event.Kind == "feature" || event.Kind == "debug" || event.Kind == "summary" {
+			recordEvaluationRequests(request.Context(), projectKey, msg)
+		}
 		observers.Notify(msg)
 	}
 
 	writer.Header().Set("Content-Type", "application/json")
 	writer.WriteHeader(http.StatusAccepted)
 }
+
+// eventsProjectKey makes a best effort to figure out which project sent events. Events endpoints don't require
+// authorization, so this may be empty.
+func eventsProjectKey(request *http.Request) string {
+	credential, ok := mux.Vars(request)["envId"]
+	if !ok {
+		credential = strings.TrimPrefix(request.Header.Get("Authorization"), "api_key ")
+	}
+	if credential == "" {
+		return ""
+	}
+	projectKey, err := model.ResolveProjectKey(request.Context(), credential)
+	if err != nil {
+		logs.Printf(logs.Error, "", "SdkEventsReceiveHandler: unable to resolve project key: %v", err)
+		return credential
+	}
+	return projectKey
+}
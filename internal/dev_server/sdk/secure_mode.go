@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const secureModeSecretContextKey = ctxKey("secureModeSecret")
+
+func SetSecureModeSecretOnContext(ctx context.Context, secret string) context.Context {
+	return context.WithValue(ctx, secureModeSecretContextKey, secret)
+}
+
+// GetSecureModeSecretFromContext returns the configured secure mode secret. An empty string means secure mode is
+// disabled.
+func GetSecureModeSecretFromContext(ctx context.Context) string {
+	secret, _ := ctx.Value(secureModeSecretContextKey).(string)
+	return secret
+}
+
+// SecureModeMiddleware puts the secure mode secret on the context for consumption by the client-side routes.
+func SecureModeMiddleware(secret string) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			ctx := SetSecureModeSecretOnContext(request.Context(), secret)
+			request = request.WithContext(ctx)
+			handler.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// SecureModeHash generates the hash a backend would hand to a client-side SDK for the given context. This matches
+// what the LaunchDarkly server-side SDKs generate in SecureModeHash.
+func SecureModeHash(secret string, ldCtx ldcontext.Context) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write([]byte(ldCtx.FullyQualifiedKey()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidateSecureModeHash rejects client-side requests whose `h` parameter doesn't match the evaluation context. When
+// no secret is configured, the hash is ignored just like an environment without secure mode enabled.
+func ValidateSecureModeHash(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		secret := GetSecureModeSecretFromContext(request.Context())
+		if secret == "" || request.Method == http.MethodOptions {
+			handler.ServeHTTP(writer, request)
+			return
+		}
+		hash := request.URL.Query().Get("h")
+		if hash == "" {
+			http.Error(writer, "secure mode is enabled but no hash was provided", http.StatusBadRequest)
+			return
+		}
+		ldCtx, err := GetContextFromRequest(request)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		expected := SecureModeHash(secret, ldCtx)
+		if !hmac.Equal([]byte(hash), []byte(expected)) {
+			logs.Printf(logs.Warn, "", "Secure mode hash mismatch for context '%s'", ldCtx.FullyQualifiedKey())
+			http.Error(writer, "secure mode hash does not match the evaluation context", http.StatusBadRequest)
+			return
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
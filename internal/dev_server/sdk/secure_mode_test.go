@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model/mocks"
+)
+
+func TestSecureMode(t *testing.T) {
+	const secret = "shh"
+	mockController := gomock.NewController(t)
+	store := mocks.NewMockStore(mockController)
+	observers := model.NewObservers()
+
+	router := mux.NewRouter()
+	router.Use(model.ObserversMiddleware(observers))
+	router.Use(model.StoreMiddleware(store))
+	router.Use(SecureModeMiddleware(secret))
+	BindRoutes(router)
+
+	store.EXPECT().GetAlias(gomock.Any(), exampleProjectKey).Return(model.Alias{}, model.NewErrNotFound("alias", exampleProjectKey)).AnyTimes()
+
+	contextJson := `{"kind":"user","key":"board cat"}`
+	encodedContext := base64.URLEncoding.EncodeToString([]byte(contextJson))
+	validHash := SecureModeHash(secret, ldcontext.New("board cat"))
+
+	t.Run("given a valid hash, it should serve flags", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(exampleProject, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+
+		req := httptest.NewRequest("GET", "/sdk/evalx/"+exampleProjectKey+"/contexts/"+encodedContext+"?h="+validHash, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("given a valid hash on a REPORT request, it should serve flags", func(t *testing.T) {
+		store.EXPECT().GetDevProject(gomock.Any(), exampleProjectKey).Return(exampleProject, nil)
+		store.EXPECT().GetOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+		store.EXPECT().GetScenarioOverridesForProject(gomock.Any(), exampleProjectKey).Return(nil, nil)
+
+		req := httptest.NewRequest("REPORT", "/sdk/evalx/"+exampleProjectKey+"/contexts?h="+validHash, strings.NewReader(contextJson))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("given a mismatched hash, it should reject the request", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/sdk/evalx/"+exampleProjectKey+"/contexts/"+encodedContext+"?h=nope", nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("given no hash, it should reject the request", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/sdk/evalx/"+exampleProjectKey+"/contexts/"+encodedContext, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
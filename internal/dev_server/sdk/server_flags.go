@@ -1,12 +1,41 @@
 package sdk
 
 import (
+	"fmt"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 )
 
 type fallthroughRule struct {
+	Variation *int           `json:"variation,omitempty"`
+	Rollout   *serverRollout `json:"rollout,omitempty"`
+}
+
+type weightedVariation struct {
 	Variation int `json:"variation"`
+	Weight    int `json:"weight"`
+}
+
+type serverRollout struct {
+	Variations  []weightedVariation `json:"variations"`
+	ContextKind string              `json:"contextKind,omitempty"`
+	BucketBy    string              `json:"bucketBy,omitempty"`
+}
+
+// serverTarget serves a variation to the user contexts with the given keys.
+type serverTarget struct {
+	Values    []string `json:"values"`
+	Variation int      `json:"variation"`
+}
+
+// serverContextTarget serves a variation to the contexts of a kind with the given keys. For user contexts, the keys
+// are in the target for the same variation instead.
+type serverContextTarget struct {
+	ContextKind string   `json:"contextKind"`
+	Values      []string `json:"values"`
+	Variation   int      `json:"variation"`
 }
 
 type clientSideAvailability struct {
@@ -18,8 +47,9 @@ type ServerFlag struct {
 	Key                    string                 `json:"key"`
 	On                     bool                   `json:"on"`
 	Prerequisites          []string               `json:"prerequisites"` // this isn't the real model for this, but this will always be empty for us
-	Targets                []string               `json:"targets"`       // this isn't the real model for this, but this will always be empty for us
-	Rules                  []string               `json:"rules"`         // this isn't the real model for this, but this will always be empty for us
+	Targets                []serverTarget         `json:"targets"`
+	ContextTargets         []serverContextTarget  `json:"contextTargets"`
+	Rules                  []string               `json:"rules"` // this isn't the real model for this, but this will always be empty for us
 	Fallthrough            fallthroughRule        `json:"fallthrough"`
 	OffVariation           int                    `json:"offVariation"`
 	Variations             []ldvalue.Value        `json:"variations"`
@@ -60,18 +90,36 @@ func ServerFlagsFromFlagsState(flagsState model.FlagsState) ServerFlags {
 }
 
 func serverFlagFromFlagState(key string, state model.FlagState) ServerFlag {
+	fallthroughVariation := 0
+	served := fallthroughRule{Variation: &fallthroughVariation}
+	variations := []ldvalue.Value{state.Value}
+	salt := ""
+	targets, contextTargets := make([]serverTarget, 0), make([]serverContextTarget, 0)
+	if state.Rollout != nil {
+		// serve the rollout as the flag's fallthrough so that the SDK buckets contexts itself
+		rollout := serverRollout{ContextKind: state.Rollout.ContextKind, BucketBy: state.Rollout.BucketBy}
+		variations = make([]ldvalue.Value, 0, len(state.Rollout.Variations))
+		for i, variation := range state.Rollout.Variations {
+			variations = append(variations, variation.Value)
+			rollout.Variations = append(rollout.Variations, weightedVariation{Variation: i, Weight: variation.Weight})
+		}
+		served = fallthroughRule{Rollout: &rollout}
+		salt = state.Rollout.Salt()
+		targets, contextTargets = serverTargetsFromForcedTreatments(state.Rollout.ForcedTreatments)
+	}
 	return ServerFlag{
 		Key:                    key,
 		On:                     true,
 		Prerequisites:          make([]string, 0),
-		Targets:                make([]string, 0),
+		Targets:                targets,
+		ContextTargets:         contextTargets,
 		Rules:                  make([]string, 0),
-		Fallthrough:            fallthroughRule{Variation: 0},
+		Fallthrough:            served,
 		OffVariation:           0,
-		Variations:             []ldvalue.Value{state.Value},
+		Variations:             variations,
 		ClientSideAvailability: clientSideAvailability{true, true},
 		ClientSide:             true,
-		Salt:                   "",
+		Salt:                   salt,
 		TrackEvents:            state.TrackEvents,
 		TrackEventsFallthrough: state.TrackEvents,
 		DebugEventsUntilDate:   0,
@@ -79,3 +127,46 @@ func serverFlagFromFlagState(key string, state model.FlagState) ServerFlag {
 		Deleted:                false,
 	}
 }
+
+// serverTargetsFromForcedTreatments targets the contexts forced into a rollout's variations, so that SDKs serve them
+// those variations before bucketing anyone.
+func serverTargetsFromForcedTreatments(treatments []model.ForcedTreatment) ([]serverTarget, []serverContextTarget) {
+	targets, contextTargets := make([]serverTarget, 0), make([]serverContextTarget, 0)
+	targetIndexes := make(map[int]int)
+	contextTargetIndexes := make(map[string]int)
+	for _, forced := range treatments {
+		kind := forced.ContextKind
+		if kind == "" {
+			kind = string(ldcontext.DefaultKind)
+		}
+		if kind == string(ldcontext.DefaultKind) {
+			i, ok := targetIndexes[forced.Variation]
+			if !ok {
+				i = len(targets)
+				targetIndexes[forced.Variation] = i
+				targets = append(targets, serverTarget{Variation: forced.Variation})
+			}
+			targets[i].Values = append(targets[i].Values, forced.ContextKey)
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", kind, forced.Variation)
+		i, ok := contextTargetIndexes[key]
+		if !ok {
+			i = len(contextTargets)
+			contextTargetIndexes[key] = i
+			contextTargets = append(contextTargets, serverContextTarget{ContextKind: kind, Variation: forced.Variation})
+		}
+		contextTargets[i].Values = append(contextTargets[i].Values, forced.ContextKey)
+	}
+	if len(contextTargets) > 0 {
+		// once a flag has context targets, SDKs only check user targets that are referred to by one
+		for _, target := range targets {
+			contextTargets = append(contextTargets, serverContextTarget{
+				ContextKind: string(ldcontext.DefaultKind),
+				Values:      make([]string, 0),
+				Variation:   target.Variation,
+			})
+		}
+	}
+	return targets, contextTargets
+}
@@ -0,0 +1,18 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// StaleHeader is set to "true" on flag delivery responses for projects that haven't been synced within the configured
+// staleness threshold.
+const StaleHeader = "X-LD-Stale"
+
+func setStaleHeader(ctx context.Context, writer http.ResponseWriter) {
+	if model.StalenessFromContext(ctx).Check(ctx, GetProjectKeyFromContext(ctx)) {
+		writer.Header().Set(StaleHeader, "true")
+	}
+}
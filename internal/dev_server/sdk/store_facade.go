@@ -2,9 +2,9 @@ package sdk
 
 import (
 	"context"
-	"log"
 	"net/http"
 
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/pkg/errors"
 )
@@ -18,8 +18,12 @@ func WriteError(ctx context.Context, w http.ResponseWriter, err error) {
 	case errors.As(err, &model.ErrNotFound{}):
 		projectKey := GetProjectKeyFromContext(ctx)
 		message := err.Error()
-		log.Println(message)
-		log.Printf("To add your project to the dev server, call `ldcli dev-server add-project --project %s --source {SOURCE_ENV_KEY}", projectKey)
+		logs.Printf(logs.Warn, projectKey, "%s", message)
+		logs.Printf(logs.Warn, projectKey, "To add your project to the dev server, call `ldcli dev-server add-project --project %s --source {SOURCE_ENV_KEY}", projectKey)
+		http.Error(w, message, http.StatusNotFound)
+	case errors.As(err, &model.ErrArchived{}):
+		message := err.Error()
+		logs.Printf(logs.Warn, GetProjectKeyFromContext(ctx), "%s", message)
 		http.Error(w, message, http.StatusNotFound)
 	case err != nil:
 		panic(err)
@@ -33,9 +37,13 @@ func GetAllFlagsFromContext(ctx context.Context) (model.FlagsState, error) {
 	if err != nil {
 		return model.FlagsState{}, errors.Wrap(err, "unable to get dev project")
 	}
+	if project.ArchivedAt != nil {
+		return model.FlagsState{}, errors.WithStack(model.NewErrArchived(projectKey, *project.ArchivedAt))
+	}
 	allFlags, err := project.GetFlagStateWithOverridesForProject(ctx)
 	if err != nil {
 		return model.FlagsState{}, errors.Wrap(err, "unable to get flags for project")
 	}
-	return allFlags, nil
+	// LaunchDarkly doesn't send SDKs archived flags, so neither does the dev server
+	return allFlags.WithoutArchived(), nil
 }
@@ -2,40 +2,53 @@ package sdk
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
+	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/pkg/errors"
 )
 
 func StreamClientFlags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	projectKey := GetProjectKeyFromContext(ctx)
+	ldCtx, err := evaluationContext(r)
+	if err != nil {
+		WriteError(ctx, w, err)
+		return
+	}
 	allFlags, err := GetAllFlagsFromContext(ctx)
 	if err != nil {
 		WriteError(ctx, w, errors.Wrap(err, "failed to get flag state"))
 		return
 	}
-	jsonBody, err := json.Marshal(allFlags)
+	initialMessage, err := clientPutMessage(allFlags.ForContext(projectKey, ldCtx))
 	if err != nil {
-		WriteError(ctx, w, errors.Wrap(err, "failed to marshal flag state"))
+		WriteError(ctx, w, err)
 		return
 	}
-	updateChan, doneChan := OpenStream(
+	defer countStreamClient(ctx)()
+	stream, doneChan := OpenStream(
 		w,
 		r.Context().Done(),
-		Message{Event: TYPE_PUT, Data: jsonBody},
+		initialMessage,
+		func() (Message, error) {
+			allFlags, err := GetAllFlagsFromContext(ctx)
+			if err != nil {
+				return Message{}, errors.Wrap(err, "failed to get flag state")
+			}
+			return clientPutMessage(allFlags.ForContext(projectKey, ldCtx))
+		},
 	)
-	defer close(updateChan)
-	projectKey := GetProjectKeyFromContext(ctx)
-	observer := clientFlagsObserver{updateChan, projectKey}
+	observer := clientFlagsObserver{stream: stream, projectKey: projectKey, ldCtx: ldCtx}
 	observers := model.GetObserversFromContext(ctx)
 	observerId := observers.RegisterObserver(observer)
 	defer func() {
 		ok := observers.DeregisterObserver(observerId)
 		if !ok {
-			log.Printf("unable to remove observer")
+			logs.Printf(logs.Error, "", "unable to remove observer")
 		}
 	}()
 	err = <-doneChan
@@ -45,32 +58,73 @@ func StreamClientFlags(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func clientPutMessage(allFlags model.FlagsState) (Message, error) {
+	jsonBody, err := json.Marshal(allFlags)
+	if err != nil {
+		return Message{}, errors.Wrap(err, "failed to marshal flag state")
+	}
+	return Message{Event: TYPE_PUT, Data: jsonBody}, nil
+}
+
 type clientFlagsObserver struct {
-	updateChan chan<- Message
+	stream     *Stream
 	projectKey string
+	// ldCtx is the context the SDK is evaluating flags for, which rollouts are resolved for
+	ldCtx ldcontext.Context
 }
 
 func (c clientFlagsObserver) Handle(event interface{}) {
 	switch event := event.(type) {
 	case model.OverrideEvent:
-		err := SendMessage(c.updateChan, TYPE_PATCH, clientFlag{
+		if event.ProjectKey != c.projectKey {
+			return
+		}
+
+		if event.FlagState.Archived {
+			err := SendMessage(c.stream, TYPE_DELETE, clientFlag{
+				Key:     event.FlagKey,
+				Version: event.FlagState.Version + 1,
+			})
+			if err != nil {
+				panic(errors.Wrap(err, "failed to marshal flag state in observer"))
+			}
+			return
+		}
+		flagState := event.FlagState.ForContext(c.projectKey, event.FlagKey, c.ldCtx)
+		err := SendMessage(c.stream, TYPE_PATCH, clientFlag{
+			Key:     event.FlagKey,
+			Version: flagState.Version,
+			Value:   flagState.Value,
+		})
+		if err != nil {
+			panic(errors.Wrap(err, "failed to marshal flag state in observer"))
+		}
+	case model.FlagDeletedEvent:
+		if event.ProjectKey != c.projectKey {
+			return
+		}
+
+		err := SendMessage(c.stream, TYPE_DELETE, clientFlag{
 			Key:     event.FlagKey,
-			Version: event.FlagState.Version,
-			Value:   event.FlagState.Value,
+			Version: event.Version,
 		})
 		if err != nil {
 			panic(errors.Wrap(err, "failed to marshal flag state in observer"))
 		}
 	case model.SyncEvent:
+		if event.ProjectKey != c.projectKey {
+			return
+		}
+
 		clientFlags := clientFlags{}
-		for flagKey, flagState := range event.AllFlagsState {
+		for flagKey, flagState := range event.AllFlagsState.WithoutArchived().ForContext(c.projectKey, c.ldCtx) {
 			clientFlags[flagKey] = clientFlag{
 				Version: flagState.Version,
 				Value:   flagState.Value,
 			}
 		}
 
-		err := SendMessage(c.updateChan, TYPE_PUT, clientFlags)
+		err := SendMessage(c.stream, TYPE_PUT, clientFlags)
 		if err != nil {
 			panic(errors.Wrap(err, "failed to marshal flag state in observer"))
 		}
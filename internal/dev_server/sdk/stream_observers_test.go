@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+func TestStreamObservers(t *testing.T) {
+	patch := model.OverrideEvent{ProjectKey: "proj", FlagKey: "flg", FlagState: model.FlagState{Value: ldvalue.Bool(true), Version: 2}}
+	deleted := model.FlagDeletedEvent{ProjectKey: "proj", FlagKey: "gone", Version: 5}
+
+	t.Run("server-side streams get patch and delete messages", func(t *testing.T) {
+		stream := &Stream{messages: make(chan Message, 2)}
+		observer := serverFlagsObserver{stream, "proj"}
+
+		observer.Handle(patch)
+		observer.Handle(deleted)
+
+		msg := <-stream.messages
+		assert.Equal(t, TYPE_PATCH, msg.Event)
+		assert.Contains(t, string(msg.Data), `"path":"/flags/flg"`)
+		msg = <-stream.messages
+		assert.Equal(t, TYPE_DELETE, msg.Event)
+		assert.JSONEq(t, `{"path":"/flags/gone","version":5}`, string(msg.Data))
+	})
+
+	t.Run("client-side streams get patch and delete messages", func(t *testing.T) {
+		stream := &Stream{messages: make(chan Message, 2)}
+		observer := clientFlagsObserver{stream: stream, projectKey: "proj"}
+
+		observer.Handle(patch)
+		observer.Handle(deleted)
+
+		msg := <-stream.messages
+		assert.Equal(t, TYPE_PATCH, msg.Event)
+		assert.JSONEq(t, `{"key":"flg","value":true,"version":2}`, string(msg.Data))
+		msg = <-stream.messages
+		assert.Equal(t, TYPE_DELETE, msg.Event)
+		assert.JSONEq(t, `{"key":"gone","value":null,"version":5}`, string(msg.Data))
+	})
+
+	t.Run("events for other projects are ignored", func(t *testing.T) {
+		stream := &Stream{messages: make(chan Message, 4)}
+		other := patch
+		other.ProjectKey = "other"
+		otherDeleted := deleted
+		otherDeleted.ProjectKey = "other"
+
+		serverFlagsObserver{stream, "proj"}.Handle(other)
+		serverFlagsObserver{stream, "proj"}.Handle(otherDeleted)
+		clientFlagsObserver{stream: stream, projectKey: "proj"}.Handle(other)
+		clientFlagsObserver{stream: stream, projectKey: "proj"}.Handle(otherDeleted)
+
+		require.Len(t, stream.messages, 0)
+	})
+}
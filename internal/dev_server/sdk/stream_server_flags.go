@@ -3,9 +3,9 @@ package sdk
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 	"github.com/launchdarkly/ldcli/internal/dev_server/model"
 	"github.com/pkg/errors"
 )
@@ -18,25 +18,31 @@ func StreamServerAllPayload(w http.ResponseWriter, r *http.Request) {
 		WriteError(ctx, w, errors.Wrap(err, "failed to get flag state"))
 		return
 	}
-	serverFlags := ServerAllPayloadFromFlagsState(allFlags)
-	jsonBody, err := json.Marshal(serverFlags)
+	initialMessage, err := serverPutMessage(allFlags)
 	if err != nil {
-		WriteError(ctx, w, errors.Wrap(err, "failed to marshal flag state"))
+		WriteError(ctx, w, err)
 		return
 	}
-	updateChan, doneChan := OpenStream(
+	defer countStreamClient(ctx)()
+	stream, doneChan := OpenStream(
 		w,
 		r.Context().Done(),
-		Message{Event: TYPE_PUT, Data: jsonBody},
+		initialMessage,
+		func() (Message, error) {
+			allFlags, err := GetAllFlagsFromContext(ctx)
+			if err != nil {
+				return Message{}, errors.Wrap(err, "failed to get flag state")
+			}
+			return serverPutMessage(allFlags)
+		},
 	)
-	defer close(updateChan)
-	observer := serverFlagsObserver{updateChan, projectKey}
+	observer := serverFlagsObserver{stream, projectKey}
 	observers := model.GetObserversFromContext(ctx)
 	observerId := observers.RegisterObserver(observer)
 	defer func() {
 		ok := observers.DeregisterObserver(observerId)
 		if !ok {
-			log.Printf("unable to remove observer")
+			logs.Printf(logs.Error, "", "unable to remove observer")
 		}
 	}()
 	err = <-doneChan
@@ -46,8 +52,16 @@ func StreamServerAllPayload(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func serverPutMessage(allFlags model.FlagsState) (Message, error) {
+	jsonBody, err := json.Marshal(ServerAllPayloadFromFlagsState(allFlags))
+	if err != nil {
+		return Message{}, errors.Wrap(err, "failed to marshal flag state")
+	}
+	return Message{Event: TYPE_PUT, Data: jsonBody}, nil
+}
+
 type serverFlagsObserver struct {
-	updateChan chan<- Message
+	stream     *Stream
 	projectKey string
 }
 
@@ -58,19 +72,41 @@ func (c serverFlagsObserver) Handle(event interface{}) {
 			return
 		}
 
-		err := SendMessage(c.updateChan, TYPE_PATCH, serverSidePatchData{
+		if event.FlagState.Archived {
+			err := SendMessage(c.stream, TYPE_DELETE, serverSideDeleteData{
+				Path:    fmt.Sprintf("/flags/%s", event.FlagKey),
+				Version: event.FlagState.Version + 1,
+			})
+			if err != nil {
+				panic(errors.Wrap(err, "failed to marshal flag state in observer"))
+			}
+			return
+		}
+		err := SendMessage(c.stream, TYPE_PATCH, serverSidePatchData{
 			Path: fmt.Sprintf("/flags/%s", event.FlagKey),
 			Data: serverFlagFromFlagState(event.FlagKey, event.FlagState),
 		})
 		if err != nil {
 			panic(errors.Wrap(err, "failed to marshal flag state in observer"))
 		}
+	case model.FlagDeletedEvent:
+		if event.ProjectKey != c.projectKey {
+			return
+		}
+
+		err := SendMessage(c.stream, TYPE_DELETE, serverSideDeleteData{
+			Path:    fmt.Sprintf("/flags/%s", event.FlagKey),
+			Version: event.Version,
+		})
+		if err != nil {
+			panic(errors.Wrap(err, "failed to marshal flag state in observer"))
+		}
 	case model.SyncEvent:
 		if event.ProjectKey != c.projectKey {
 			return
 		}
 
-		err := SendMessage(c.updateChan, TYPE_PUT, ServerAllPayloadFromFlagsState(event.AllFlagsState))
+		err := SendMessage(c.stream, TYPE_PUT, ServerAllPayloadFromFlagsState(event.AllFlagsState.WithoutArchived()))
 		if err != nil {
 			panic(errors.Wrap(err, "failed to marshal flag state in observer"))
 		}
@@ -81,3 +117,8 @@ type serverSidePatchData struct {
 	Path string     `json:"path"`
 	Data ServerFlag `json:"data"`
 }
+
+type serverSideDeleteData struct {
+	Path    string `json:"path"`
+	Version int    `json:"version"`
+}
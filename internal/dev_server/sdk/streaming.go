@@ -4,18 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
 )
 
 type MessageType string
 
 const (
-	TYPE_PUT   MessageType = "put"
-	TYPE_PATCH MessageType = "patch"
+	TYPE_PUT    MessageType = "put"
+	TYPE_PATCH  MessageType = "patch"
+	TYPE_DELETE MessageType = "delete"
 )
 
+// streamBufferSize is how many messages can be waiting to be written to a client before it's considered slow.
+const streamBufferSize = 100
+
 type Message struct {
 	Event MessageType
 	Data  []byte
@@ -28,10 +35,34 @@ func (m Message) ToPayload() []byte {
 	return payload
 }
 
-// OpenStream sends data to a response using the initial payload and subsequently via the returned write only channel
-func OpenStream(w http.ResponseWriter, done <-chan struct{}, initialMessage Message) (chan<- Message, <-chan error) {
+// ResyncFunc builds a message that replaces everything a client has been sent so far, for clients that fell behind
+// and had messages dropped.
+type ResyncFunc func() (Message, error)
+
+// Stream is one client's connection. Observers send to it from whatever goroutine notified them, so Send never blocks:
+// if the client isn't keeping up and its buffer fills, messages are dropped and the client is resynced with a fresh
+// put once it catches up. That way one slow client can't hold up notifications to everyone else.
+type Stream struct {
+	messages chan Message
+	dropped  atomic.Bool
+}
+
+// Send queues a message for the client, dropping it if the client is too far behind.
+func (s *Stream) Send(msg Message) {
+	select {
+	case s.messages <- msg:
+	default:
+		if !s.dropped.Swap(true) {
+			logs.Printf(logs.Warn, "", "SSE client is not keeping up; dropping messages until it can be resynced")
+		}
+	}
+}
+
+// OpenStream sends data to a response using the initial payload and subsequently via the returned stream. If resync is
+// nil, messages dropped for a slow client are lost.
+func OpenStream(w http.ResponseWriter, done <-chan struct{}, initialMessage Message, resync ResyncFunc) (*Stream, <-chan error) {
 	errChan := make(chan error)
-	updateChan := make(chan Message, 10)
+	stream := &Stream{messages: make(chan Message, streamBufferSize)}
 	go func() {
 		var err error
 		defer func() {
@@ -43,41 +74,60 @@ func OpenStream(w http.ResponseWriter, done <-chan struct{}, initialMessage Mess
 			if !ok {
 				return errors.New("expected http.ResponseWriter to be an http.Flusher")
 			}
+			write := func(payload []byte) error {
+				_, err := w.Write(payload)
+				if err != nil {
+					return errors.Wrap(err, "unable to write response")
+				}
+				flusher.Flush()
+				return nil
+			}
 
 			w.Header().Set("Content-Type", "text/event-stream")
-			_, err = w.Write(initialMessage.ToPayload())
-			if err != nil {
-				return errors.Wrap(err, "unable to write response")
+			if err := write(initialMessage.ToPayload()); err != nil {
+				return err
 			}
-			flusher.Flush()
 			ticker := time.NewTicker(time.Minute)
-		loop:
+			defer ticker.Stop()
 			for {
 				select {
 				case <-ticker.C:
-					_, err = w.Write([]byte(":\n\n"))
-					if err != nil {
-						return errors.Wrap(err, "unable to write response")
+					if err := write([]byte(":\n\n")); err != nil {
+						return err
+					}
+				case msg := <-stream.messages:
+					if err := write(msg.ToPayload()); err != nil {
+						return err
 					}
-					flusher.Flush()
-				case msg := <-updateChan:
-					_, err = w.Write(msg.ToPayload())
-					if err != nil {
-						return errors.Wrap(err, "unable to write response")
+					if err := stream.resyncIfDropped(resync, write); err != nil {
+						return err
 					}
-					flusher.Flush()
 				case <-done:
-					break loop
+					return nil
 				}
 			}
-			return nil
 		}()
 	}()
-	return updateChan, errChan
+	return stream, errChan
+}
+
+// resyncIfDropped replaces whatever is still queued with a full resync message if any messages were dropped.
+func (s *Stream) resyncIfDropped(resync ResyncFunc, write func([]byte) error) error {
+	if resync == nil || !s.dropped.Swap(false) {
+		return nil
+	}
+	for len(s.messages) > 0 {
+		<-s.messages
+	}
+	msg, err := resync()
+	if err != nil {
+		return errors.Wrap(err, "unable to resync slow client")
+	}
+	return write(msg.ToPayload())
 }
 
 func SendMessage(
-	updateChan chan<- Message,
+	stream *Stream,
 	msgType MessageType,
 	data interface{},
 ) error {
@@ -86,10 +136,10 @@ func SendMessage(
 		return err
 	}
 
-	updateChan <- Message{
+	stream.Send(Message{
 		Event: msgType,
 		Data:  payload,
-	}
+	})
 
 	return nil
 }
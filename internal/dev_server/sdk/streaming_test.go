@@ -0,0 +1,142 @@
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/go-sdk-common/v3/ldvalue"
+	"github.com/launchdarkly/ldcli/internal/dev_server/model"
+)
+
+// gatedWriter is a streaming response writer whose writes block until it's opened, like a client that isn't reading.
+type gatedWriter struct {
+	gate   chan struct{}
+	header http.Header
+
+	mu       sync.Mutex
+	payloads []string
+}
+
+func newGatedWriter(open bool) *gatedWriter {
+	w := &gatedWriter{gate: make(chan struct{}), header: http.Header{}}
+	if open {
+		close(w.gate)
+	}
+	return w
+}
+
+func (w *gatedWriter) Header() http.Header { return w.header }
+func (w *gatedWriter) WriteHeader(int)     {}
+func (w *gatedWriter) Flush()              {}
+
+func (w *gatedWriter) Write(payload []byte) (int, error) {
+	<-w.gate
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.payloads = append(w.payloads, string(payload))
+	return len(payload), nil
+}
+
+func (w *gatedWriter) written() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string{}, w.payloads...)
+}
+
+func TestStream(t *testing.T) {
+	t.Run("delivers messages in order", func(t *testing.T) {
+		w := newGatedWriter(true)
+		done := make(chan struct{})
+		stream, errChan := OpenStream(w, done, Message{Event: TYPE_PUT, Data: []byte("{}")}, nil)
+
+		stream.Send(Message{Event: TYPE_PATCH, Data: []byte("1")})
+		stream.Send(Message{Event: TYPE_PATCH, Data: []byte("2")})
+
+		require.Eventually(t, func() bool { return len(w.written()) == 3 }, time.Second, time.Millisecond)
+		close(done)
+		require.NoError(t, <-errChan)
+		assert.Equal(t, []string{"event:put\ndata:{}\n\n", "event:patch\ndata:1\n\n", "event:patch\ndata:2\n\n"}, w.written())
+	})
+
+	t.Run("slow clients don't block senders and are resynced once they catch up", func(t *testing.T) {
+		w := newGatedWriter(false)
+		done := make(chan struct{})
+		stream, errChan := OpenStream(w, done, Message{Event: TYPE_PUT, Data: []byte("initial")}, func() (Message, error) {
+			return Message{Event: TYPE_PUT, Data: []byte("resync")}, nil
+		})
+
+		sent := make(chan struct{})
+		go func() {
+			for i := 0; i < streamBufferSize*2; i++ {
+				stream.Send(Message{Event: TYPE_PATCH, Data: []byte(fmt.Sprint(i))})
+			}
+			close(sent)
+		}()
+		select {
+		case <-sent:
+		case <-time.After(time.Second):
+			require.Fail(t, "Send blocked on a slow client")
+		}
+
+		close(w.gate)
+		require.Eventually(t, func() bool {
+			written := w.written()
+			return len(written) > 0 && written[len(written)-1] == "event:put\ndata:resync\n\n"
+		}, time.Second, time.Millisecond)
+		assert.Less(t, len(w.written()), streamBufferSize, "queued messages are replaced by the resync")
+
+		stream.Send(Message{Event: TYPE_PATCH, Data: []byte("after")})
+		require.Eventually(t, func() bool {
+			written := w.written()
+			return written[len(written)-1] == "event:patch\ndata:after\n\n"
+		}, time.Second, time.Millisecond)
+
+		close(done)
+		require.NoError(t, <-errChan)
+	})
+}
+
+// BenchmarkNotifyManyStreams measures fanning a patch out to 500 connected server-side SDKs, one of which has stopped
+// reading.
+func BenchmarkNotifyManyStreams(b *testing.B) {
+	const clients = 500
+	observers := model.NewObservers()
+	done := make(chan struct{})
+	defer close(done)
+
+	stuck := newGatedWriter(false)
+	stream, _ := OpenStream(stuck, done, Message{Event: TYPE_PUT, Data: []byte("{}")}, nil)
+	observers.RegisterObserver(serverFlagsObserver{stream, "proj"})
+	for i := 1; i < clients; i++ {
+		stream, _ := OpenStream(newDiscardWriter(), done, Message{Event: TYPE_PUT, Data: []byte("{}")}, nil)
+		observers.RegisterObserver(serverFlagsObserver{stream, "proj"})
+	}
+
+	event := model.OverrideEvent{
+		ProjectKey: "proj",
+		FlagKey:    "flg",
+		FlagState:  model.FlagState{Value: ldvalue.String(strings.Repeat("x", 100)), Version: 1},
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		observers.Notify(event)
+	}
+}
+
+type discardWriter struct {
+	header http.Header
+}
+
+func newDiscardWriter() discardWriter { return discardWriter{header: http.Header{}} }
+
+func (w discardWriter) Header() http.Header               { return w.header }
+func (w discardWriter) WriteHeader(int)                   {}
+func (w discardWriter) Flush()                            {}
+func (w discardWriter) Write(payload []byte) (int, error) { return len(payload), nil }
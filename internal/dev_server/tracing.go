@@ -0,0 +1,92 @@
+package dev_server
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/launchdarkly/ldcli/internal/dev_server/logs"
+)
+
+const tracingServiceName = "ldcli-dev-server"
+
+// tracingConfigured is whether an OTLP endpoint for traces is set with the standard OpenTelemetry environment
+// variables, and the SDK hasn't been turned off with OTEL_SDK_DISABLED.
+func tracingConfigured() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// startTracing sends spans over OTLP/HTTP when tracing is configured, returning a function that flushes them. The
+// exporter reads the rest of its configuration, such as headers and timeouts, from the standard environment variables
+// too, and OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the service's default name and attributes. When
+// tracing isn't configured, it returns nil and spans are never recorded.
+func startTracing(ctx context.Context, cliVersion string) (func(context.Context) error, error) {
+	if !tracingConfigured() {
+		return nil, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(tracingServiceName), semconv.ServiceVersion(cliVersion)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// flushSpansOnSignal exports any spans that haven't been yet when the server is stopped, then stops it as the signal
+// would have.
+func flushSpansOnSignal(shutdown func(context.Context) error) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	sig := <-signals
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdown(ctx); err != nil {
+		logs.Printf(logs.Warn, "", "Unable to export spans: %s", err)
+	}
+	signal.Reset(sig)
+	if process, err := os.FindProcess(os.Getpid()); err == nil {
+		_ = process.Signal(sig)
+	}
+}
+
+// nameSpanAfterRoute names each request's span after the route it matched, e.g. GET /dev/projects/{projectKey}, so
+// that requests for different projects and flags are grouped together.
+func nameSpanAfterRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(r.Method + " " + template)
+				span.SetAttributes(semconv.HTTPRoute(template))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
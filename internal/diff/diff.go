@@ -0,0 +1,212 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Diff is the representation ldcli comparisons are output in, like the changes `flags apply` plans and the evaluations
+// `dev-server replay` finds differ, so tooling can parse any of them the same way. Paths are JSON pointers into the
+// compared documents, e.g. /flags/my-flag/on.
+type Diff struct {
+	// From and To name what was compared, e.g. "production" and "staging".
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+	Added   []Value  `json:"added"`
+	Removed []Value  `json:"removed"`
+	Changed []Change `json:"changed"`
+}
+
+// Value is something only one side has.
+type Value struct {
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// Change is something both sides have with different values.
+type Change struct {
+	Path string      `json:"path"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Compare diffs two values that can be marshalled to JSON, named fromName and toName. Objects are compared key by key,
+// recursively. Anything else, including arrays, is compared as a whole.
+func Compare(fromName string, from interface{}, toName string, to interface{}) (Diff, error) {
+	fromValue, err := normalize(from)
+	if err != nil {
+		return Diff{}, err
+	}
+	toValue, err := normalize(to)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	d := Diff{
+		From:    fromName,
+		To:      toName,
+		Added:   []Value{},
+		Removed: []Value{},
+		Changed: []Change{},
+	}
+	d.compare("", fromValue, toValue)
+
+	return d, nil
+}
+
+// normalize round trips v through JSON so that structs and maps of any type compare as generic JSON values.
+func normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+func (d *Diff) compare(path string, from, to interface{}) {
+	fromObject, fromIsObject := from.(map[string]interface{})
+	toObject, toIsObject := to.(map[string]interface{})
+	if !fromIsObject || !toIsObject {
+		if !reflect.DeepEqual(from, to) {
+			d.Changed = append(d.Changed, Change{Path: pathOrRoot(path), From: from, To: to})
+		}
+		return
+	}
+
+	for _, key := range sortedKeys(fromObject, toObject) {
+		keyPath := path + "/" + escapePointer(key)
+		fromValue, inFrom := fromObject[key]
+		toValue, inTo := toObject[key]
+		switch {
+		case !inTo:
+			d.Removed = append(d.Removed, Value{Path: keyPath, Value: fromValue})
+		case !inFrom:
+			d.Added = append(d.Added, Value{Path: keyPath, Value: toValue})
+		default:
+			d.compare(keyPath, fromValue, toValue)
+		}
+	}
+}
+
+func sortedKeys(objects ...map[string]interface{}) []string {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, object := range objects {
+		for key := range object {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+// IsEmpty is true if both sides are the same.
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// JSON returns the diff in its JSON representation.
+func (d Diff) JSON() string {
+	data, _ := json.Marshal(d)
+
+	return string(data)
+}
+
+// String renders the diff for people, without color.
+func (d Diff) String() string {
+	var sb strings.Builder
+	d.Render(&sb, false)
+
+	return sb.String()
+}
+
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+)
+
+// Render writes the diff for people, one line per difference ordered by path: + for added, - for removed, and ~ for
+// changed. With color, additions are green, removals red, and changes yellow.
+func (d Diff) Render(w io.Writer, color bool) {
+	if d.From != "" || d.To != "" {
+		fmt.Fprintf(w, "--- %s\n+++ %s\n", d.From, d.To)
+	}
+	if d.IsEmpty() {
+		fmt.Fprintln(w, "No differences")
+		return
+	}
+
+	type line struct {
+		path string
+		text string
+		code string
+	}
+	lines := make([]line, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	for _, v := range d.Added {
+		lines = append(lines, line{v.Path, fmt.Sprintf("+ %s: %s", v.Path, formatValue(v.Value)), colorGreen})
+	}
+	for _, v := range d.Removed {
+		lines = append(lines, line{v.Path, fmt.Sprintf("- %s: %s", v.Path, formatValue(v.Value)), colorRed})
+	}
+	for _, c := range d.Changed {
+		lines = append(lines, line{c.Path, fmt.Sprintf("~ %s: %s -> %s", c.Path, formatValue(c.From), formatValue(c.To)), colorYellow})
+	}
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].path < lines[j].path })
+
+	for _, l := range lines {
+		if color {
+			fmt.Fprintln(w, l.code+l.text+colorReset)
+		} else {
+			fmt.Fprintln(w, l.text)
+		}
+	}
+}
+
+func formatValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
+
+// ColorEnabled reports whether output to w should be colored: it's a terminal and NO_COLOR isn't set.
+func ColorEnabled(w io.Writer) bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	f, ok := w.(*os.File)
+
+	return ok && term.IsTerminal(int(f.Fd()))
+}
@@ -0,0 +1,97 @@
+package diff_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/diff"
+)
+
+func TestCompare(t *testing.T) {
+	from := map[string]interface{}{
+		"flags": map[string]interface{}{
+			"removed-flag": map[string]interface{}{"on": true},
+			"changed-flag": map[string]interface{}{"on": true, "variations": []string{"a", "b"}},
+			"same-flag":    map[string]interface{}{"on": false},
+		},
+		"a/b~c": 1,
+	}
+	to := struct {
+		Flags map[string]map[string]interface{} `json:"flags"`
+		Name  string                            `json:"name"`
+		Key   int                               `json:"a/b~c"`
+	}{
+		Flags: map[string]map[string]interface{}{
+			"changed-flag": {"on": false, "variations": []string{"a", "b"}},
+			"same-flag":    {"on": false},
+			"added-flag":   {"on": true},
+		},
+		Name: "new",
+		Key:  1,
+	}
+
+	d, err := diff.Compare("production", from, "staging", to)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"from": "production",
+		"to": "staging",
+		"added": [
+			{"path": "/flags/added-flag", "value": {"on": true}},
+			{"path": "/name", "value": "new"}
+		],
+		"removed": [
+			{"path": "/flags/removed-flag", "value": {"on": true}}
+		],
+		"changed": [
+			{"path": "/flags/changed-flag/on", "from": true, "to": false}
+		]
+	}`, d.JSON())
+	assert.False(t, d.IsEmpty())
+}
+
+func TestCompareEscapesPaths(t *testing.T) {
+	d, err := diff.Compare("", map[string]int{"a/b~c": 1}, "", map[string]int{"a/b~c": 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, []diff.Change{{Path: "/a~1b~0c", From: float64(1), To: float64(2)}}, d.Changed)
+}
+
+func TestCompareNoDifferences(t *testing.T) {
+	d, err := diff.Compare("a", map[string]int{"x": 1}, "b", map[string]int{"x": 1})
+
+	require.NoError(t, err)
+	assert.True(t, d.IsEmpty())
+	assert.JSONEq(t, `{"from": "a", "to": "b", "added": [], "removed": [], "changed": []}`, d.JSON())
+	assert.Equal(t, "--- a\n+++ b\nNo differences\n", d.String())
+}
+
+func TestRender(t *testing.T) {
+	d, err := diff.Compare(
+		"production", map[string]interface{}{"b": "old", "c": true},
+		"staging", map[string]interface{}{"a": 1, "b": "new"},
+	)
+	require.NoError(t, err)
+
+	t.Run("without color", func(t *testing.T) {
+		assert.Equal(t, `--- production
++++ staging
++ /a: 1
+~ /b: "old" -> "new"
+- /c: true
+`, d.String())
+	})
+
+	t.Run("with color", func(t *testing.T) {
+		var buf bytes.Buffer
+		d.Render(&buf, true)
+
+		assert.Equal(t, "--- production\n+++ staging\n"+
+			"\x1b[32m+ /a: 1\x1b[0m\n"+
+			"\x1b[33m~ /b: \"old\" -> \"new\"\x1b[0m\n"+
+			"\x1b[31m- /c: true\x1b[0m\n", buf.String())
+	})
+}
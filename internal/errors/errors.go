@@ -45,6 +45,37 @@ func NewErrorWrapped(message string, underlying error) error {
 	})
 }
 
+// ExitError is an error the CLI exits with a particular code for, so that scripts can tell kinds of failure apart.
+type ExitError struct {
+	err  error
+	code int
+}
+
+func (e ExitError) Error() string {
+	return e.err.Error()
+}
+
+func (e ExitError) Unwrap() error {
+	return e.err
+}
+
+func NewExitError(err error, code int) error {
+	return ExitError{
+		err:  err,
+		code: code,
+	}
+}
+
+// ExitCode is the code the CLI exits with for the error: that of an ExitError it wraps, or 1.
+func ExitCode(err error) int {
+	var exitErr ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+
+	return 1
+}
+
 // LDAPIError is an error from the LaunchDarkly API client.
 type LDAPIError interface {
 	Body() []byte
@@ -117,6 +148,19 @@ func normalizeUnauthorizedJSON() ([]byte, error) {
 	return errMsg, nil
 }
 
+// IsNotFound is true for the error the LaunchDarkly API responds with when a resource doesn't exist.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	var body struct {
+		Code string `json:"code"`
+	}
+	_ = json.Unmarshal([]byte(err.Error()), &body)
+
+	return body.Code == "not_found"
+}
+
 func AccessTokenInvalidErrMessage(baseURI string) string {
 	path, _ := url.JoinPath(baseURI, "settings/authorization")
 
@@ -3,6 +3,7 @@ package errors_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	ldapi "github.com/launchdarkly/api-client-go/v14"
@@ -55,3 +56,16 @@ func TestAPIError(t *testing.T) {
 		assert.JSONEq(t, `{"code": "forbidden", "message": "an error"}`, err.Error())
 	})
 }
+
+func TestExitCode(t *testing.T) {
+	t.Run("is the code of a wrapped exit error", func(t *testing.T) {
+		err := fmt.Errorf("running command: %w", errs.NewExitError(errors.New("an error"), 2))
+
+		assert.Equal(t, 2, errs.ExitCode(err))
+		assert.EqualError(t, err, "running command: an error")
+	})
+
+	t.Run("is 1 for other errors", func(t *testing.T) {
+		assert.Equal(t, 1, errs.ExitCode(errors.New("an error")))
+	})
+}
@@ -0,0 +1,272 @@
+package flags
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/launchdarkly/ldcli/internal/diff"
+)
+
+const (
+	KindBoolean      = "boolean"
+	KindMultivariate = "multivariate"
+)
+
+// Manifest declares a set of flags, so that they can be created and updated with `flags apply`.
+type Manifest struct {
+	// Project is the project the flags are in. The --project flag takes precedence over it.
+	Project string         `json:"project,omitempty"`
+	Flags   []ManifestFlag `json:"flags"`
+}
+
+// ManifestFlag is a flag as a manifest declares it. The fields that are left out aren't changed when the flag already
+// exists.
+type ManifestFlag struct {
+	Key                    string                  `json:"key"`
+	Name                   string                  `json:"name"`
+	Description            *string                 `json:"description,omitempty"`
+	Kind                   string                  `json:"kind,omitempty"`
+	Temporary              *bool                   `json:"temporary,omitempty"`
+	Tags                   []string                `json:"tags,omitempty"`
+	ClientSideAvailability *ClientSideAvailability `json:"clientSideAvailability,omitempty"`
+	Variations             []Variation             `json:"variations,omitempty"`
+}
+
+type ClientSideAvailability struct {
+	UsingEnvironmentID bool `json:"usingEnvironmentId"`
+	UsingMobileKey     bool `json:"usingMobileKey"`
+}
+
+type Variation struct {
+	Value       interface{} `json:"value"`
+	Name        string      `json:"name,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+// Flag is the part of a flag from the API that a manifest can declare.
+type Flag struct {
+	Key                    string                 `json:"key"`
+	Name                   string                 `json:"name"`
+	Description            string                 `json:"description"`
+	Kind                   string                 `json:"kind"`
+	Temporary              bool                   `json:"temporary"`
+	Tags                   []string               `json:"tags"`
+	ClientSideAvailability ClientSideAvailability `json:"clientSideAvailability"`
+	Variations             []Variation            `json:"variations"`
+}
+
+// PatchOperation is a JSON Patch operation that updates a flag.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// ReadManifest reads and checks a flag manifest, in YAML or JSON.
+func ReadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("unable to read %s: %w", path, err)
+	}
+
+	// variation values can be any JSON, so the YAML is decoded as JSON to read them the same way as the API's
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return Manifest{}, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	asJSON, err := json.Marshal(document)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	var manifest Manifest
+	decoder := json.NewDecoder(bytes.NewReader(asJSON))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	if err := manifest.validate(); err != nil {
+		return Manifest{}, fmt.Errorf("invalid %s: %w", path, err)
+	}
+
+	return manifest, nil
+}
+
+func (m Manifest) validate() error {
+	if len(m.Flags) == 0 {
+		return fmt.Errorf("flags is required")
+	}
+
+	keys := make(map[string]bool, len(m.Flags))
+	for i, flag := range m.Flags {
+		switch {
+		case flag.Key == "":
+			return fmt.Errorf("flags[%d].key is required", i)
+		case keys[flag.Key]:
+			return fmt.Errorf("flag %s is declared more than once", flag.Key)
+		case flag.Name == "":
+			return fmt.Errorf("flag %s needs a name", flag.Key)
+		}
+		keys[flag.Key] = true
+
+		switch flag.kind() {
+		case KindBoolean:
+			for _, v := range flag.Variations {
+				if _, ok := v.Value.(bool); !ok || len(flag.Variations) != 2 || flag.Variations[0].Value == flag.Variations[1].Value {
+					return fmt.Errorf("flag %s is boolean, so its variations have to be true and false", flag.Key)
+				}
+			}
+		case KindMultivariate:
+			if len(flag.Variations) < 2 {
+				return fmt.Errorf("flag %s is multivariate, so it needs at least two variations", flag.Key)
+			}
+			for j, v := range flag.Variations {
+				if v.Value == nil {
+					return fmt.Errorf("flag %s needs a value for variation %d", flag.Key, j)
+				}
+				if reflect.TypeOf(v.Value) != reflect.TypeOf(flag.Variations[0].Value) {
+					return fmt.Errorf("flag %s's variations have to be the same type", flag.Key)
+				}
+				for _, other := range flag.Variations[:j] {
+					if reflect.DeepEqual(v.Value, other.Value) {
+						return fmt.Errorf("flag %s's variations have to be different", flag.Key)
+					}
+				}
+			}
+		default:
+			return fmt.Errorf("flag %s's kind has to be %s or %s", flag.Key, KindBoolean, KindMultivariate)
+		}
+	}
+
+	return nil
+}
+
+func (f ManifestFlag) kind() string {
+	if f.Kind == "" {
+		return KindBoolean
+	}
+
+	return f.Kind
+}
+
+// NewFlag is the body of the request that creates the flag.
+func (f ManifestFlag) NewFlag() interface{} {
+	variations := f.Variations
+	if len(variations) == 0 {
+		variations = []Variation{{Value: true}, {Value: false}}
+	}
+
+	return struct {
+		Key                    string                  `json:"key"`
+		Name                   string                  `json:"name"`
+		Description            *string                 `json:"description,omitempty"`
+		Temporary              *bool                   `json:"temporary,omitempty"`
+		Tags                   []string                `json:"tags,omitempty"`
+		ClientSideAvailability *ClientSideAvailability `json:"clientSideAvailability,omitempty"`
+		Variations             []Variation             `json:"variations"`
+	}{
+		Key:                    f.Key,
+		Name:                   f.Name,
+		Description:            f.Description,
+		Temporary:              f.Temporary,
+		Tags:                   f.Tags,
+		ClientSideAvailability: f.ClientSideAvailability,
+		Variations:             variations,
+	}
+}
+
+// Diff compares an existing flag with the fields the manifest declares, and returns what's different and the patch
+// that updates the flag to match. Paths in the diff are JSON pointers into the flag, except that new variations are
+// added after the existing ones. A flag's kind can't be changed, and its variations can't be removed, since targeting
+// may serve them.
+func (f ManifestFlag) Diff(existing Flag) (diff.Diff, []PatchOperation, error) {
+	if existing.Kind != "" && existing.Kind != f.kind() {
+		return diff.Diff{}, nil, fmt.Errorf("flag %s is %s, and its kind can't be changed to %s", f.Key, existing.Kind, f.kind())
+	}
+	if len(f.Variations) > 0 && len(f.Variations) < len(existing.Variations) {
+		return diff.Diff{}, nil, fmt.Errorf("flag %s has %d variations, and they can't be removed with a manifest", f.Key, len(existing.Variations))
+	}
+
+	from := map[string]interface{}{"name": existing.Name}
+	to := map[string]interface{}{"name": f.Name}
+	if f.Description != nil {
+		from["description"], to["description"] = existing.Description, *f.Description
+	}
+	if f.Temporary != nil {
+		from["temporary"], to["temporary"] = existing.Temporary, *f.Temporary
+	}
+	if f.Tags != nil {
+		from["tags"], to["tags"] = sortedTags(existing.Tags), sortedTags(f.Tags)
+	}
+	if f.ClientSideAvailability != nil {
+		from["clientSideAvailability"], to["clientSideAvailability"] = existing.ClientSideAvailability, *f.ClientSideAvailability
+	}
+	if len(f.Variations) > 0 {
+		// variations are compared by index, field by field, rather than as a whole list
+		fromVariations := make(map[string]interface{}, len(existing.Variations))
+		toVariations := make(map[string]interface{}, len(f.Variations))
+		for i, v := range f.Variations {
+			toVariations[strconv.Itoa(i)] = v.fields()
+			if i < len(existing.Variations) {
+				fromVariations[strconv.Itoa(i)] = existing.Variations[i].fields()
+			}
+		}
+		from["variations"], to["variations"] = fromVariations, toVariations
+	}
+
+	d, err := diff.Compare(f.Key, from, "manifest", to)
+	if err != nil {
+		return diff.Diff{}, nil, err
+	}
+
+	return d, patchFor(d), nil
+}
+
+// fields are the variation's fields, including the empty ones, so that clearing a name or description is a change to
+// it rather than its removal.
+func (v Variation) fields() map[string]interface{} {
+	return map[string]interface{}{"value": v.Value, "name": v.Name, "description": v.Description}
+}
+
+// patchFor is the patch that makes the changes in d. New variations are added in order after the existing ones.
+func patchFor(d diff.Diff) []PatchOperation {
+	patch := make([]PatchOperation, 0, len(d.Changed)+len(d.Added)+len(d.Removed))
+	for _, c := range d.Changed {
+		patch = append(patch, PatchOperation{Op: "replace", Path: c.Path, Value: c.To})
+	}
+	for _, v := range d.Removed {
+		patch = append(patch, PatchOperation{Op: "remove", Path: v.Path})
+	}
+	newVariations := make(map[int]interface{})
+	for _, v := range d.Added {
+		if i, err := strconv.Atoi(strings.TrimPrefix(v.Path, "/variations/")); err == nil && strings.HasPrefix(v.Path, "/variations/") {
+			newVariations[i] = v.Value
+			continue
+		}
+		patch = append(patch, PatchOperation{Op: "add", Path: v.Path, Value: v.Value})
+	}
+	indexes := make([]int, 0, len(newVariations))
+	for i := range newVariations {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		patch = append(patch, PatchOperation{Op: "add", Path: "/variations/-", Value: newVariations[i]})
+	}
+
+	return patch
+}
+
+func sortedTags(tags []string) []string {
+	sorted := append([]string{}, tags...)
+	sort.Strings(sorted)
+
+	return sorted
+}
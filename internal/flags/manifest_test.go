@@ -0,0 +1,169 @@
+package flags_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/diff"
+	"github.com/launchdarkly/ldcli/internal/flags"
+)
+
+func writeManifest(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "flags.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	return path
+}
+
+func TestReadManifest(t *testing.T) {
+	t.Run("with a valid manifest", func(t *testing.T) {
+		path := writeManifest(t, `
+project: checkout
+flags:
+  - key: new-checkout
+    name: New checkout
+    temporary: true
+    tags: [checkout]
+  - key: checkout-layout
+    name: Checkout layout
+    kind: multivariate
+    variations:
+      - value: one-page
+        name: One page
+      - value: steps
+`)
+
+		manifest, err := flags.ReadManifest(path)
+
+		require.NoError(t, err)
+		temporary := true
+		assert.Equal(t, flags.Manifest{
+			Project: "checkout",
+			Flags: []flags.ManifestFlag{
+				{Key: "new-checkout", Name: "New checkout", Temporary: &temporary, Tags: []string{"checkout"}},
+				{
+					Key:        "checkout-layout",
+					Name:       "Checkout layout",
+					Kind:       flags.KindMultivariate,
+					Variations: []flags.Variation{{Value: "one-page", Name: "One page"}, {Value: "steps"}},
+				},
+			},
+		}, manifest)
+	})
+
+	tests := map[string]struct {
+		manifest string
+		expected string
+	}{
+		"without flags": {
+			manifest: "project: checkout",
+			expected: "flags is required",
+		},
+		"with a duplicate key": {
+			manifest: "flags: [{key: a, name: A}, {key: a, name: A}]",
+			expected: "flag a is declared more than once",
+		},
+		"without a name": {
+			manifest: "flags: [{key: a}]",
+			expected: "flag a needs a name",
+		},
+		"with a boolean flag's variations that aren't true and false": {
+			manifest: "flags: [{key: a, name: A, variations: [{value: true}, {value: true}]}]",
+			expected: "flag a is boolean, so its variations have to be true and false",
+		},
+		"with a multivariate flag's variations of different types": {
+			manifest: "flags: [{key: a, name: A, kind: multivariate, variations: [{value: one}, {value: 2}]}]",
+			expected: "flag a's variations have to be the same type",
+		},
+		"with an unknown kind": {
+			manifest: "flags: [{key: a, name: A, kind: number}]",
+			expected: "flag a's kind has to be boolean or multivariate",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeManifest(t, tt.manifest)
+
+			_, err := flags.ReadManifest(path)
+
+			assert.EqualError(t, err, "invalid "+path+": "+tt.expected)
+		})
+	}
+
+	t.Run("with an unknown field", func(t *testing.T) {
+		path := writeManifest(t, "flags: [{key: a, name: A, colour: blue}]")
+
+		_, err := flags.ReadManifest(path)
+
+		assert.ErrorContains(t, err, `unknown field "colour"`)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	existing := flags.Flag{
+		Key:        "checkout-layout",
+		Name:       "Checkout layout",
+		Kind:       flags.KindMultivariate,
+		Tags:       []string{"b", "a"},
+		Variations: []flags.Variation{{Value: "one-page"}, {Value: "steps"}},
+	}
+
+	t.Run("without differences", func(t *testing.T) {
+		changes, patch, err := flags.ManifestFlag{
+			Key:  "checkout-layout",
+			Name: "Checkout layout",
+			Kind: flags.KindMultivariate,
+			Tags: []string{"a", "b"},
+		}.Diff(existing)
+
+		require.NoError(t, err)
+		assert.True(t, changes.IsEmpty())
+		assert.Empty(t, patch)
+	})
+
+	t.Run("with differences", func(t *testing.T) {
+		description := "How checkout is laid out"
+		changes, patch, err := flags.ManifestFlag{
+			Key:         "checkout-layout",
+			Name:        "Checkout layout",
+			Description: &description,
+			Kind:        flags.KindMultivariate,
+			Variations:  []flags.Variation{{Value: "one-page", Name: "One page"}, {Value: "steps"}, {Value: "tabs"}},
+		}.Diff(existing)
+
+		require.NoError(t, err)
+		tabs := map[string]interface{}{"value": "tabs", "name": "", "description": ""}
+		assert.Equal(t, []diff.Change{
+			{Path: "/description", From: "", To: "How checkout is laid out"},
+			{Path: "/variations/0/name", From: "", To: "One page"},
+		}, changes.Changed)
+		assert.Equal(t, []diff.Value{{Path: "/variations/2", Value: tabs}}, changes.Added)
+		assert.Empty(t, changes.Removed)
+		assert.Equal(t, []flags.PatchOperation{
+			{Op: "replace", Path: "/description", Value: "How checkout is laid out"},
+			{Op: "replace", Path: "/variations/0/name", Value: "One page"},
+			{Op: "add", Path: "/variations/-", Value: tabs},
+		}, patch)
+	})
+
+	t.Run("with another kind", func(t *testing.T) {
+		_, _, err := flags.ManifestFlag{Key: "checkout-layout", Name: "Checkout layout"}.Diff(existing)
+
+		assert.EqualError(t, err, "flag checkout-layout is multivariate, and its kind can't be changed to boolean")
+	})
+
+	t.Run("with fewer variations", func(t *testing.T) {
+		_, _, err := flags.ManifestFlag{
+			Key:        "checkout-layout",
+			Name:       "Checkout layout",
+			Kind:       flags.KindMultivariate,
+			Variations: []flags.Variation{{Value: "one-page"}},
+		}.Diff(existing)
+
+		assert.EqualError(t, err, "flag checkout-layout has 2 variations, and they can't be removed with a manifest")
+	})
+}
@@ -16,15 +16,34 @@ const (
 	TokenInterval         = 1 * time.Second
 )
 
+// slowDownInterval is how much longer to wait between attempts to get the access token each time
+// the server asks to slow down, as in the OAuth device authorization grant (RFC 8628).
+const slowDownInterval = 5 * time.Second
+
 type DeviceAuthorization struct {
-	DeviceCode      string `json:"deviceCode"`
-	ExpiresIn       int    `json:"expiresIn"`
+	DeviceCode string `json:"deviceCode"`
+	ExpiresIn  int    `json:"expiresIn"`
+	// Interval is how many seconds to wait between attempts to get the access token. It's zero if
+	// the server leaves it up to the client.
+	Interval        int    `json:"interval,omitempty"`
 	UserCode        string `json:"userCode"`
 	VerificationURI string `json:"verificationUri"`
 }
 
 type DeviceAuthorizationToken struct {
 	AccessToken string `json:"accessToken"`
+	// ExpiresAt is when a short-lived access token expires, in Unix milliseconds. It's zero for an
+	// access token that doesn't expire.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// TokenOptions scope the access token that is provisioned when the user grants access.
+type TokenOptions struct {
+	// Role is the built-in role the access token has, such as reader or writer. The member's own
+	// role is used if it's empty.
+	Role string
+	// TTL is how long the access token lasts. It doesn't expire if TTL is zero.
+	TTL time.Duration
 }
 
 // FetchDeviceAuthorization makes a request to create a device authorization that will later be
@@ -34,17 +53,21 @@ func FetchDeviceAuthorization(
 	clientID string,
 	deviceName string,
 	baseURI string,
+	options TokenOptions,
 ) (DeviceAuthorization, error) {
 	path := fmt.Sprintf("%s/internal/device-authorization", baseURI)
-	body := fmt.Sprintf(
-		`{
-			"clientId": %q,
-			"deviceName": %q
-		}`,
-		clientID,
-		deviceName,
-	)
-	res, err := client.MakeUnauthenticatedRequest("POST", path, []byte(body))
+	body, _ := json.Marshal(struct {
+		ClientID   string `json:"clientId"`
+		DeviceName string `json:"deviceName"`
+		Role       string `json:"role,omitempty"`
+		TTLSeconds int64  `json:"ttlSeconds,omitempty"`
+	}{
+		ClientID:   clientID,
+		DeviceName: deviceName,
+		Role:       options.Role,
+		TTLSeconds: int64(options.TTL.Seconds()),
+	})
+	res, err := client.MakeUnauthenticatedRequest("POST", path, body)
 	if err != nil {
 		return DeviceAuthorization{}, err
 	}
@@ -67,11 +90,11 @@ func FetchToken(
 	baseURI string,
 	interval time.Duration,
 	maxAttempts int,
-) (string, error) {
+) (DeviceAuthorizationToken, error) {
 	var attempts int
 	for {
 		if attempts > maxAttempts {
-			return "", errors.NewError("The request timed out after too many attempts.")
+			return DeviceAuthorizationToken{}, errors.NewError("The request timed out after too many attempts.")
 		}
 		deviceAuthorizationToken, err := fetchToken(
 			client,
@@ -79,7 +102,7 @@ func FetchToken(
 			baseURI,
 		)
 		if err == nil {
-			return deviceAuthorizationToken.AccessToken, nil
+			return deviceAuthorizationToken, nil
 		}
 
 		var e struct {
@@ -88,17 +111,20 @@ func FetchToken(
 		}
 		err = json.Unmarshal([]byte(err.Error()), &e)
 		if err != nil {
-			return "", errors.NewErrorWrapped("error reading response", err)
+			return DeviceAuthorizationToken{}, errors.NewErrorWrapped("error reading response", err)
 		}
 		switch e.Code {
 		case "authorization_pending":
 			attempts += 1
+		case "slow_down":
+			attempts += 1
+			interval += slowDownInterval
 		case "access_denied":
-			return "", errors.NewError("Your request has been denied.")
+			return DeviceAuthorizationToken{}, errors.NewError("Your request has been denied.")
 		case "expired_token":
-			return "", errors.NewError("Your request has expired. Please try logging in again.")
+			return DeviceAuthorizationToken{}, errors.NewError("Your request has expired. Please try logging in again.")
 		default:
-			return "", errors.NewErrorWrapped(fmt.Sprintf("We cannot complete your request: %s", e.Message), err)
+			return DeviceAuthorizationToken{}, errors.NewErrorWrapped(fmt.Sprintf("We cannot complete your request: %s", e.Message), err)
 		}
 		time.Sleep(interval)
 	}
@@ -127,6 +153,21 @@ func fetchToken(
 	return deviceAuthorizationToken, nil
 }
 
+// PollingSchedule is how long to wait between attempts to get the access token, and how many
+// attempts to make before the device authorization expires. The server's interval and expiry are
+// used if it sent them.
+func (d DeviceAuthorization) PollingSchedule() (time.Duration, int) {
+	interval, maxAttempts := TokenInterval, MaxFetchTokenAttempts
+	if d.Interval > 0 {
+		interval = time.Duration(d.Interval) * time.Second
+	}
+	if d.ExpiresIn > 0 {
+		maxAttempts = int(time.Duration(d.ExpiresIn) * time.Second / interval)
+	}
+
+	return interval, maxAttempts
+}
+
 func GetDeviceName() string {
 	deviceName, err := os.Hostname()
 	if err != nil {
@@ -36,10 +36,7 @@ func TestFetchDeviceAuthorization(t *testing.T) {
 		"MakeUnauthenticatedRequest",
 		"POST",
 		"http://test.com/internal/device-authorization",
-		[]byte(`{
-			"clientId": "test-client-id",
-			"deviceName": "local-device"
-		}`),
+		[]byte(`{"clientId":"test-client-id","deviceName":"local-device"}`),
 	).Return([]byte(`{
 		"deviceCode": "test-device-code",
 		"expiresIn": 1,
@@ -58,12 +55,59 @@ func TestFetchDeviceAuthorization(t *testing.T) {
 		"test-client-id",
 		"local-device",
 		baseURI,
+		login.TokenOptions{},
 	)
 
 	require.NoError(t, err)
 	assert.Equal(t, expected, result)
 }
 
+func TestFetchDeviceAuthorization_WithTokenOptions(t *testing.T) {
+	mockClient := mockClient{}
+	mockClient.On(
+		"MakeUnauthenticatedRequest",
+		"POST",
+		"http://test.com/internal/device-authorization",
+		[]byte(`{"clientId":"test-client-id","deviceName":"local-device","role":"reader","ttlSeconds":28800}`),
+	).Return([]byte(`{
+		"deviceCode": "test-device-code",
+		"expiresIn": 600,
+		"interval": 5,
+		"userCode": "0001",
+		"verificationUri": "/confirm-auth/test-device-code"
+	}`), nil)
+
+	result, err := login.FetchDeviceAuthorization(
+		&mockClient,
+		"test-client-id",
+		"local-device",
+		"http://test.com",
+		login.TokenOptions{
+			Role: "reader",
+			TTL:  8 * time.Hour,
+		},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Interval)
+}
+
+func TestPollingSchedule(t *testing.T) {
+	t.Run("uses the server's interval and expiry", func(t *testing.T) {
+		interval, maxAttempts := login.DeviceAuthorization{ExpiresIn: 600, Interval: 5}.PollingSchedule()
+
+		assert.Equal(t, 5*time.Second, interval)
+		assert.Equal(t, 120, maxAttempts)
+	})
+
+	t.Run("without them uses the defaults", func(t *testing.T) {
+		interval, maxAttempts := login.DeviceAuthorization{}.PollingSchedule()
+
+		assert.Equal(t, login.TokenInterval, interval)
+		assert.Equal(t, login.MaxFetchTokenAttempts, maxAttempts)
+	})
+}
+
 func TestFetchToken(t *testing.T) {
 	t.Run("with a token response", func(t *testing.T) {
 		minimalDuration := 1 * time.Microsecond
@@ -91,7 +135,31 @@ func TestFetchToken(t *testing.T) {
 		)
 
 		require.NoError(t, err)
-		assert.Equal(t, "test-access-token", result)
+		assert.Equal(t, "test-access-token", result.AccessToken)
+	})
+
+	t.Run("with a short-lived token response", func(t *testing.T) {
+		input, _ := json.Marshal(map[string]string{
+			"deviceCode": "test-device-code",
+		})
+		mockClient := mockClient{}
+		mockClient.On(
+			"MakeUnauthenticatedRequest",
+			"POST",
+			"http://test.com/internal/device-authorization/token",
+			input,
+		).Return([]byte(`{"accessToken": "test-access-token", "expiresAt": 1760000000000}`), nil)
+
+		result, err := login.FetchToken(
+			&mockClient,
+			"test-device-code",
+			"http://test.com",
+			1*time.Microsecond,
+			1,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, login.DeviceAuthorizationToken{AccessToken: "test-access-token", ExpiresAt: 1760000000000}, result)
 	})
 }
 
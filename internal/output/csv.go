@@ -0,0 +1,156 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IsCSV is whether the output kind is written as CSV.
+func IsCSV(outputKind string) bool {
+	return outputKind == OutputKindCSV.String()
+}
+
+// CSV formats a list response as CSV, with a header row and then a row for each item. Columns are field names, with
+// dots between the names of nested fields, e.g. "_maintainer.email". Without columns, each of the items' fields that
+// isn't an object or array is a column, in the order the response has them. Objects and arrays are written as JSON.
+// A response with a single resource is written as a single row. Like JSON responses, it doesn't end with a newline.
+func CSV(input []byte, columns []string) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	var response interface{}
+	if err := decoder.Decode(&response); err != nil {
+		return "", err
+	}
+	items := csvItems(response)
+	if len(columns) == 0 {
+		columns = csvDefaultColumns(input, items)
+	}
+
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	if err := w.Write(columns); err != nil {
+		return "", err
+	}
+	for _, item := range items {
+		row := make([]string, 0, len(columns))
+		for _, column := range columns {
+			row = append(row, csvValue(item, column))
+		}
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// csvItems finds the items in a list response, which is either an array or an object with an items array. Items that
+// are scalar values, such as keys, are treated like resources with only a key.
+func csvItems(response interface{}) []map[string]interface{} {
+	var list []interface{}
+	switch r := response.(type) {
+	case []interface{}:
+		list = r
+	case map[string]interface{}:
+		if items, ok := r["items"].([]interface{}); ok {
+			list = items
+		} else {
+			return []map[string]interface{}{r}
+		}
+	}
+
+	items := make([]map[string]interface{}, 0, len(list))
+	for _, i := range list {
+		item, ok := i.(map[string]interface{})
+		if !ok {
+			item = map[string]interface{}{"key": i}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// csvDefaultColumns lists the items' fields that aren't objects or arrays. JSON objects are decoded into maps, which
+// lose the order of their fields, so the response is parsed again as YAML, which keeps it.
+func csvDefaultColumns(input []byte, items []map[string]interface{}) []string {
+	var node yaml.Node
+	if err := yaml.Unmarshal(input, &node); err == nil && len(node.Content) > 0 {
+		root := node.Content[0]
+		itemNodes := []*yaml.Node{root}
+		switch root.Kind {
+		case yaml.SequenceNode:
+			itemNodes = root.Content
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(root.Content); i += 2 {
+				if root.Content[i].Value == "items" && root.Content[i+1].Kind == yaml.SequenceNode {
+					itemNodes = root.Content[i+1].Content
+				}
+			}
+		}
+
+		var columns []string
+		seen := make(map[string]bool)
+		for _, item := range itemNodes {
+			for i := 0; item.Kind == yaml.MappingNode && i+1 < len(item.Content); i += 2 {
+				key, value := item.Content[i].Value, item.Content[i+1]
+				if value.Kind == yaml.ScalarNode && !seen[key] {
+					seen[key] = true
+					columns = append(columns, key)
+				}
+			}
+		}
+		if len(columns) > 0 {
+			return columns
+		}
+	}
+
+	// the items are scalar values
+	seen := make(map[string]bool)
+	var columns []string
+	for _, item := range items {
+		for key := range item {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func csvValue(item map[string]interface{}, column string) string {
+	var value interface{} = item
+	for _, field := range strings.Split(column, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		value = fields[field]
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
@@ -0,0 +1,60 @@
+package output_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/output"
+)
+
+func TestCSV(t *testing.T) {
+	list := `{
+		"items": [
+			{"key": "flag-1", "name": "Flag 1", "temporary": true, "creationDate": 1718000000000, "tags": ["a"], "_maintainer": {"email": "ada@example.com"}},
+			{"key": "flag-2", "name": "Flag, with a comma", "temporary": false, "creationDate": 1718000000001, "description": "new"}
+		],
+		"totalCount": 2
+	}`
+	tests := map[string]struct {
+		input    string
+		columns  []string
+		expected string
+	}{
+		"defaults to the fields that aren't objects or arrays": {
+			input:    list,
+			expected: "key,name,temporary,creationDate,description\nflag-1,Flag 1,true,1718000000000,\nflag-2,\"Flag, with a comma\",false,1718000000001,new",
+		},
+		"with columns": {
+			input:    list,
+			columns:  []string{"key", "_maintainer.email", "tags"},
+			expected: "key,_maintainer.email,tags\nflag-1,ada@example.com,\"[\"\"a\"\"]\"\nflag-2,,",
+		},
+		"with an array": {
+			input:    `[{"key": "proj", "flags": 3}]`,
+			expected: "key,flags\nproj,3",
+		},
+		"with a list of keys": {
+			input:    `{"items": ["a", "b"]}`,
+			expected: "key\na\nb",
+		},
+		"with a single resource": {
+			input:    `{"key": "team-key", "name": "Team Name"}`,
+			expected: "key,name\nteam-key,Team Name",
+		},
+		"without items": {
+			input:    `{"items": []}`,
+			columns:  []string{"key"},
+			expected: "key",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := output.CSV([]byte(tt.input), tt.columns)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// WriteGitHubOutputs sets outputs of the GitHub Actions step the command is run in, for later steps to read. It does
+// nothing outside of GitHub Actions.
+func WriteGitHubOutputs(outputs map[string]string) error {
+	path := os.Getenv("GITHUB_OUTPUT")
+	if path == "" || len(outputs) == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// a random delimiter, as GitHub recommends, so values can have any content, including newlines
+		delimiter := "ghadelimiter_" + uuid.NewString()
+		if _, err := fmt.Fprintf(f, "%s<<%s\n%s\n%s\n", name, delimiter, outputs[name], delimiter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GitHubErrorAnnotation formats the message as a workflow command that GitHub Actions shows as an error annotation.
+func GitHubErrorAnnotation(message string) string {
+	escaped := strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(message)
+	return "::error::" + escaped
+}
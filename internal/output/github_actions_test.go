@@ -0,0 +1,41 @@
+package output_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/output"
+)
+
+func TestWriteGitHubOutputs(t *testing.T) {
+	t.Run("appends outputs to the file GitHub Actions reads them from", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "output")
+		require.NoError(t, os.WriteFile(path, []byte("earlier=step\n"), 0o600))
+		t.Setenv("GITHUB_OUTPUT", path)
+
+		require.NoError(t, output.WriteGitHubOutputs(map[string]string{"value": "true", "result": "line 1\nline 2"}))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		pattern := regexp.MustCompile(`^earlier=step\nresult<<(ghadelimiter_\S+)\nline 1\nline 2\n(ghadelimiter_\S+)\nvalue<<(ghadelimiter_\S+)\ntrue\n(ghadelimiter_\S+)\n$`)
+		matches := pattern.FindStringSubmatch(string(data))
+		require.NotNil(t, matches, string(data))
+		assert.Equal(t, matches[1], matches[2])
+		assert.Equal(t, matches[3], matches[4])
+	})
+
+	t.Run("does nothing outside of GitHub Actions", func(t *testing.T) {
+		t.Setenv("GITHUB_OUTPUT", "")
+
+		assert.NoError(t, output.WriteGitHubOutputs(map[string]string{"value": "true"}))
+	})
+}
+
+func TestGitHubErrorAnnotation(t *testing.T) {
+	assert.Equal(t, "::error::100%25 broken%0Aon two lines", output.GitHubErrorAnnotation("100% broken\non two lines"))
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/launchdarkly/ldcli/internal/errors"
 )
 
-var ErrInvalidOutputKind = errors.NewError("output is invalid. Use 'json' or 'plaintext'")
+var ErrInvalidOutputKind = errors.NewError("output is invalid. Use 'json', 'yaml', 'csv', 'plaintext', or 'github-actions'")
 
 type OutputKind string
 
@@ -15,15 +15,24 @@ func (o OutputKind) String() string {
 }
 
 var (
+	// OutputKindCSV is for list commands, whose items are written as rows.
+	OutputKindCSV       = OutputKind("csv")
 	OutputKindJSON      = OutputKind("json")
 	OutputKindNull      = OutputKind("")
 	OutputKindPlaintext = OutputKind("plaintext")
+	OutputKindYAML      = OutputKind("yaml")
+	// OutputKindGitHubActions is JSON output for GitHub Actions workflows. Errors are also reported as annotations,
+	// and commands that support it set step outputs.
+	OutputKindGitHubActions = OutputKind("github-actions")
 )
 
 func NewOutputKind(s string) (OutputKind, error) {
 	validKinds := map[string]struct{}{
-		OutputKindJSON.String():      {},
-		OutputKindPlaintext.String(): {},
+		OutputKindCSV.String():           {},
+		OutputKindJSON.String():          {},
+		OutputKindPlaintext.String():     {},
+		OutputKindYAML.String():          {},
+		OutputKindGitHubActions.String(): {},
 	}
 	if _, isValid := validKinds[s]; !isValid {
 		return OutputKindNull, ErrInvalidOutputKind
@@ -32,6 +41,11 @@ func NewOutputKind(s string) (OutputKind, error) {
 	return OutputKind(s), nil
 }
 
+// IsJSON is whether the output kind is written as JSON.
+func IsJSON(outputKind string) bool {
+	return outputKind == OutputKindJSON.String() || outputKind == OutputKindGitHubActions.String()
+}
+
 // Outputter defines the different ways a command's response can be formatted based on
 // user input.
 type Outputter interface {
@@ -90,10 +104,14 @@ func CmdOutputSingular(outputKind string, input []byte, fn PlaintextOutputFn) (s
 }
 
 func outputFromKind(outputKind string, o Outputter) (string, error) {
-	switch outputKind {
-	case "json":
+	switch {
+	case IsJSON(outputKind):
 		return o.JSON(), nil
-	case "plaintext":
+	case IsYAML(outputKind):
+		return JSONToYAML([]byte(o.JSON()))
+	case IsCSV(outputKind):
+		return CSV([]byte(o.JSON()), nil)
+	case outputKind == "plaintext":
 		return o.String(), nil
 	}
 
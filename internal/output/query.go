@@ -0,0 +1,47 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// ValidateQuery checks that a JMESPath expression can be parsed, so that a bad --query is caught before a command
+// makes any changes.
+func ValidateQuery(expression string) error {
+	if _, err := jmespath.Compile(expression); err != nil {
+		return fmt.Errorf("query is invalid: %w", err)
+	}
+	return nil
+}
+
+// Query applies a JMESPath expression to JSON, and returns the result as JSON.
+func Query(input []byte, expression string) ([]byte, error) {
+	var data interface{}
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, err
+	}
+	result, err := jmespath.Search(expression, data)
+	if err != nil {
+		return nil, fmt.Errorf("query is invalid: %w", err)
+	}
+	return json.Marshal(result)
+}
+
+// QueryOutput applies a JMESPath expression to a JSON response and formats the result for the output kind. The result
+// no longer has the shape of a resource, so it's written as JSON unless YAML or CSV was asked for.
+func QueryOutput(outputKind string, input []byte, expression string, columns []string) (string, error) {
+	result, err := Query(input, expression)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case IsYAML(outputKind):
+		return JSONToYAML(result)
+	case IsCSV(outputKind):
+		return CSV(result, columns)
+	default:
+		return string(result), nil
+	}
+}
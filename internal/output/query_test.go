@@ -0,0 +1,40 @@
+package output_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/output"
+)
+
+func TestQueryOutput(t *testing.T) {
+	flags := []byte(`{"items": [{"key": "flag-1", "tags": ["beta"]}, {"key": "flag-2", "tags": []}], "totalCount": 2}`)
+
+	t.Run("applies the expression", func(t *testing.T) {
+		result, err := output.QueryOutput("plaintext", flags, "items[?contains(tags, 'beta')].key", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, `["flag-1"]`, result)
+	})
+
+	t.Run("writes the result as YAML", func(t *testing.T) {
+		result, err := output.QueryOutput("yaml", flags, "items[].key", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "- flag-1\n- flag-2", result)
+	})
+
+	t.Run("writes the result as CSV", func(t *testing.T) {
+		result, err := output.QueryOutput("csv", flags, "items[?key == 'flag-2']", []string{"key"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "key\nflag-2", result)
+	})
+
+	t.Run("with an invalid expression", func(t *testing.T) {
+		assert.ErrorContains(t, output.ValidateQuery("items[?"), "query is invalid")
+		assert.NoError(t, output.ValidateQuery("items[].key"))
+	})
+}
@@ -15,8 +15,11 @@ import (
 // CmdOutput returns a response from a resource action formatted based on the output flag along with
 // an optional message based on the action.
 func CmdOutput(action string, outputKind string, input []byte) (string, error) {
-	if outputKind == "json" {
-		return string(input), nil
+	if IsStructured(outputKind) {
+		return FromJSON(outputKind, input)
+	}
+	if IsCSV(outputKind) {
+		return CSV(input, nil)
 	}
 
 	var (
@@ -126,9 +129,13 @@ func CmdOutputError(outputKind string, err error) string {
 	var r resource
 	_ = json.Unmarshal([]byte(output), &r)
 
-	if outputKind == "json" {
+	if IsStructured(outputKind) {
 		// convert to a well-formatted output
 		formattedOutput, _ := json.Marshal(r)
+		if IsYAML(outputKind) {
+			yamlOutput, _ := JSONToYAML(formattedOutput)
+			return yamlOutput
+		}
 
 		return string(formattedOutput)
 	}
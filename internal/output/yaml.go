@@ -0,0 +1,62 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IsYAML is whether the output kind is written as YAML.
+func IsYAML(outputKind string) bool {
+	return outputKind == OutputKindYAML.String()
+}
+
+// IsStructured is whether the output kind is written as data for other tools to read, as JSON or YAML, instead of as
+// plain text.
+func IsStructured(outputKind string) bool {
+	return IsJSON(outputKind) || IsYAML(outputKind)
+}
+
+// FromJSON formats JSON for the output kind. It's converted to YAML for the YAML output kind, and returned as it is
+// otherwise.
+func FromJSON(outputKind string, data []byte) (string, error) {
+	if !IsYAML(outputKind) {
+		return string(data), nil
+	}
+	return JSONToYAML(data)
+}
+
+// JSONToYAML converts a JSON document to YAML, keeping the order of object keys. Like JSON responses, it doesn't end
+// with a newline. Empty input gives empty output, as for responses without a body.
+func JSONToYAML(data []byte) (string, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return "", nil
+	}
+	// JSON is YAML, so it can be parsed as it is. Parsing into a node keeps the keys in order.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return "", err
+	}
+	resetYAMLStyle(&node)
+
+	var b strings.Builder
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// resetYAMLStyle drops the flow style and quoting that parsing JSON gives nodes, so they're written in block style.
+// Strings that need quoting to stay strings, such as "true", are still quoted.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
@@ -0,0 +1,58 @@
+package output_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/errors"
+	"github.com/launchdarkly/ldcli/internal/output"
+)
+
+func TestJSONToYAML(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+	}{
+		"keeps the order of keys": {
+			input:    `{"name": "test-name", "key": "test-key", "tags": ["a", "b"], "enabled": true, "version": 3}`,
+			expected: "name: test-name\nkey: test-key\ntags:\n  - a\n  - b\nenabled: true\nversion: 3",
+		},
+		"quotes strings that would read as other types": {
+			input:    `{"value": "true", "count": "12", "empty": "", "null": null}`,
+			expected: "value: \"true\"\ncount: \"12\"\nempty: \"\"\n\"null\": null",
+		},
+		"nests objects": {
+			input:    `{"items": [{"key": "a", "_links": {"self": {"href": "/a"}}}]}`,
+			expected: "items:\n  - key: a\n    _links:\n      self:\n        href: /a",
+		},
+		"without a body": {
+			input:    "",
+			expected: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := output.JSONToYAML([]byte(tt.input))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCmdOutputYAML(t *testing.T) {
+	t.Run("converts responses", func(t *testing.T) {
+		result, err := output.CmdOutput("create", "yaml", []byte(`{"key": "test-key", "name": "test-name"}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "key: test-key\nname: test-name", result)
+	})
+
+	t.Run("converts errors", func(t *testing.T) {
+		result := output.CmdOutputError("yaml", errors.NewError(`{"code": "not_found", "message": "not found"}`))
+
+		assert.Equal(t, "code: not_found\nmessage: not found", result)
+	})
+}
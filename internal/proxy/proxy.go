@@ -0,0 +1,30 @@
+package proxy
+
+import (
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Func returns a function for http.Transport.Proxy that sends requests through the proxy returned by proxyURL. When
+// proxyURL returns an empty string, the HTTPS_PROXY and HTTP_PROXY environment variables are used instead. Hosts in
+// NO_PROXY always bypass the proxy. proxyURL is called for each request so that it can depend on flags that haven't
+// been parsed yet when the transport is configured.
+func Func(proxyURL func() string) func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		config := httpproxy.FromEnvironment()
+		if explicit := proxyURL(); explicit != "" {
+			config.HTTPProxy = explicit
+			config.HTTPSProxy = explicit
+		}
+		return config.ProxyFunc()(req.URL)
+	}
+}
+
+// UseForDefaultTransport makes every client that relies on http.DefaultTransport use the proxy returned by proxyURL.
+func UseForDefaultTransport(proxyURL func() string) {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport.Proxy = Func(proxyURL)
+	}
+}
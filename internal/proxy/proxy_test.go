@@ -0,0 +1,45 @@
+package proxy_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/proxy"
+)
+
+func TestFunc(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy:3128")
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("NO_PROXY", "internal.example.com")
+	newRequest := func(t *testing.T, url string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("uses the environment without an explicit proxy", func(t *testing.T) {
+		u, err := proxy.Func(func() string { return "" })(newRequest(t, "https://app.launchdarkly.com/api/v2/flags"))
+
+		require.NoError(t, err)
+		require.NotNil(t, u)
+		assert.Equal(t, "http://env-proxy:3128", u.String())
+	})
+
+	t.Run("prefers an explicit proxy", func(t *testing.T) {
+		u, err := proxy.Func(func() string { return "http://corp-proxy:8080" })(newRequest(t, "https://app.launchdarkly.com/api/v2/flags"))
+
+		require.NoError(t, err)
+		require.NotNil(t, u)
+		assert.Equal(t, "http://corp-proxy:8080", u.String())
+	})
+
+	t.Run("bypasses the proxy for NO_PROXY hosts", func(t *testing.T) {
+		u, err := proxy.Func(func() string { return "http://corp-proxy:8080" })(newRequest(t, "https://internal.example.com/"))
+
+		require.NoError(t, err)
+		assert.Nil(t, u)
+	})
+}
@@ -0,0 +1,164 @@
+package queries
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/launchdarkly/ldcli/internal/config"
+	"github.com/launchdarkly/ldcli/internal/errors"
+)
+
+// Queries maps a saved query's name to the ldcli arguments it runs, e.g.
+// "prod-changes" -> "audit-log list --spec 'proj/*:env/production'".
+type Queries map[string]string
+
+// file is the on-disk format, shared by the user's saved queries and exported query files.
+type file struct {
+	Queries Queries `yaml:"queries"`
+}
+
+// GetQueriesFile gets the full path to the saved queries file, which lives next to the config file.
+func GetQueriesFile() string {
+	configFile := config.GetConfigFile()
+	if configFile == "" {
+		return ""
+	}
+
+	return filepath.Join(filepath.Dir(configFile), "queries.yml")
+}
+
+// Load reads queries from filename. A missing file has no queries.
+func Load(filename string) (Queries, error) {
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return Queries{}, nil
+	}
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("unable to read queries file %s", filename))
+	}
+
+	return Parse(data)
+}
+
+// Parse reads queries from the contents of a queries file.
+func Parse(data []byte) (Queries, error) {
+	var f file
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, errors.NewError("queries file is invalid yaml")
+	}
+	if f.Queries == nil {
+		return Queries{}, nil
+	}
+
+	return f.Queries, nil
+}
+
+// Marshal returns q in the queries file format.
+func (q Queries) Marshal() ([]byte, error) {
+	return yaml.Marshal(file{Queries: q})
+}
+
+// Write writes q to filename, creating its directory if needed.
+func (q Queries) Write(filename string) error {
+	data, err := q.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, data, 0o600)
+}
+
+// Names returns the saved query names in alphabetical order.
+func (q Queries) Names() []string {
+	names := make([]string, 0, len(q))
+	for name := range q {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Merge adds the queries from other to q. Queries that already exist with different arguments are conflicts and are
+// left alone unless overwrite is set. It returns the names that were added or changed and the names that conflicted.
+func (q Queries) Merge(other Queries, overwrite bool) (merged []string, conflicts []string) {
+	for _, name := range other.Names() {
+		existing, ok := q[name]
+		switch {
+		case ok && existing == other[name]:
+			continue
+		case ok && !overwrite:
+			conflicts = append(conflicts, name)
+			continue
+		}
+		q[name] = other[name]
+		merged = append(merged, name)
+	}
+
+	return merged, conflicts
+}
+
+// SplitArgs splits a saved query into arguments the way a POSIX shell would split a simple command, honoring single
+// quotes, double quotes, and backslash escapes, so queries can be pasted straight from a terminal.
+func SplitArgs(s string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == '\\':
+			escaped = true
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.NewError(fmt.Sprintf("query has an unterminated quote or escape: %s", s))
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,92 @@
+package queries_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/launchdarkly/ldcli/internal/queries"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected []string
+	}{
+		"splits on whitespace": {
+			input:    "audit-log list  --limit\t10",
+			expected: []string{"audit-log", "list", "--limit", "10"},
+		},
+		"keeps quoted arguments together": {
+			input:    `audit-log list --spec 'proj/*:env/production' --q "my flag"`,
+			expected: []string{"audit-log", "list", "--spec", "proj/*:env/production", "--q", "my flag"},
+		},
+		"handles escapes": {
+			input:    `flags list --filter 'it'\''s' --name a\ b "say \"hi\""`,
+			expected: []string{"flags", "list", "--filter", "it's", "--name", "a b", `say "hi"`},
+		},
+		"keeps empty quoted arguments": {
+			input:    `flags list --filter ''`,
+			expected: []string{"flags", "list", "--filter", ""},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			args, err := queries.SplitArgs(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, args)
+		})
+	}
+
+	t.Run("rejects unterminated quotes", func(t *testing.T) {
+		_, err := queries.SplitArgs(`audit-log list --spec 'proj/*`)
+
+		assert.ErrorContains(t, err, "unterminated quote")
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("a missing file has no queries", func(t *testing.T) {
+		q, err := queries.Load(filepath.Join(t.TempDir(), "queries.yml"))
+
+		require.NoError(t, err)
+		assert.Empty(t, q)
+	})
+
+	t.Run("reads back written queries", func(t *testing.T) {
+		filename := filepath.Join(t.TempDir(), "ldcli", "queries.yml")
+		require.NoError(t, queries.Queries{"prod-changes": "audit-log list"}.Write(filename))
+
+		q, err := queries.Load(filename)
+
+		require.NoError(t, err)
+		assert.Equal(t, queries.Queries{"prod-changes": "audit-log list"}, q)
+	})
+}
+
+func TestMerge(t *testing.T) {
+	imported := queries.Queries{"a": "flags list", "b": "audit-log list", "c": "members list"}
+
+	t.Run("skips conflicting queries", func(t *testing.T) {
+		saved := queries.Queries{"a": "flags list", "b": "audit-log list --limit 5"}
+
+		merged, conflicts := saved.Merge(imported, false)
+
+		assert.Equal(t, []string{"c"}, merged)
+		assert.Equal(t, []string{"b"}, conflicts)
+		assert.Equal(t, "audit-log list --limit 5", saved["b"])
+	})
+
+	t.Run("replaces conflicting queries with overwrite", func(t *testing.T) {
+		saved := queries.Queries{"a": "flags list", "b": "audit-log list --limit 5"}
+
+		merged, conflicts := saved.Merge(imported, true)
+
+		assert.Equal(t, []string{"b", "c"}, merged)
+		assert.Empty(t, conflicts)
+		assert.Equal(t, imported, saved)
+	})
+}
@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const redactedAccessToken = "[REDACTED]"
+
+// DryRunRequest describes a request that a command would send, with the access token redacted.
+type DryRunRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// NewDryRunRequest builds the request MakeRequest would send for the same arguments.
+func NewDryRunRequest(
+	accessToken, method, path, contentType string,
+	query url.Values,
+	data []byte,
+	isBeta bool,
+) DryRunRequest {
+	u := path
+	if encoded := query.Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+	headers := map[string]string{
+		"Content-Type": contentType,
+	}
+	if accessToken != "" {
+		headers["Authorization"] = redactedAccessToken
+	}
+	if isBeta {
+		headers["LD-API-Version"] = "beta"
+	}
+	req := DryRunRequest{
+		Method:  method,
+		URL:     u,
+		Headers: headers,
+	}
+	if len(data) > 0 {
+		if json.Valid(data) {
+			req.Body = json.RawMessage(data)
+		} else {
+			// keep the JSON output valid for bodies that aren't JSON
+			req.Body, _ = json.Marshal(string(data))
+		}
+	}
+
+	return req
+}
+
+// String formats the request the way it would appear on the wire.
+func (r DryRunRequest) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s\n", r.Method, r.URL)
+	for _, name := range []string{"Authorization", "Content-Type", "LD-API-Version"} {
+		if value, ok := r.Headers[name]; ok {
+			fmt.Fprintf(&sb, "%s: %s\n", name, value)
+		}
+	}
+	if len(r.Body) > 0 {
+		var body bytes.Buffer
+		if err := json.Indent(&body, r.Body, "", "  "); err != nil {
+			body.Reset()
+			body.Write(r.Body)
+		}
+		sb.WriteString("\n")
+		sb.WriteString(body.String())
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}